@@ -35,6 +35,21 @@ const (
 	//   `{"email": ["kilgore@kilgore.trout"], "groups": ["devops", "maintainers"]}`
 	AnnotationKeyOIDCClaims = "rbac.kargo.akuity.io/claims"
 
+	// AnnotationKeyAPITokenRole is an annotation key set on a Secret underlying
+	// a Kargo API token to indicate the name of the Kargo Role the token is
+	// bound to.
+	AnnotationKeyAPITokenRole = "rbac.kargo.akuity.io/api-token-role"
+
+	// AnnotationKeyAPITokenExpiresAt is an annotation key set on a Secret
+	// underlying a Kargo API token to indicate the time, in RFC 3339 format,
+	// after which the token is no longer valid.
+	AnnotationKeyAPITokenExpiresAt = "rbac.kargo.akuity.io/api-token-expires-at"
+
+	// AnnotationKeyAPITokenLastUsedAt is an annotation key set on a Secret
+	// underlying a Kargo API token to indicate the approximate time, in RFC
+	// 3339 format, at which the token was last used.
+	AnnotationKeyAPITokenLastUsedAt = "rbac.kargo.akuity.io/api-token-last-used-at"
+
 	// AnnotationValueTrue is a value that can be set on an annotation to indicate
 	// that it applies.
 	AnnotationValueTrue = "true"
@@ -0,0 +1,25 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+type APIToken struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// RoleName is the name of the Kargo Role the token is bound to. A request
+	// authenticated using the token is granted the permissions of that Role,
+	// within the token's Project only.
+	RoleName string `json:"roleName,omitempty" protobuf:"bytes,2,opt,name=roleName"`
+	// ExpiresAt is the time after which the token is no longer valid.
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty" protobuf:"bytes,3,opt,name=expiresAt"`
+	// LastUsedAt is the approximate time at which the token was last used to
+	// authenticate a request. It is not updated on every use.
+	LastUsedAt *metav1.Time `json:"lastUsedAt,omitempty" protobuf:"bytes,4,opt,name=lastUsedAt"`
+}
+
+// IsExpired returns true if the token has expired as of the provided time.
+func (t *APIToken) IsExpired(now metav1.Time) bool {
+	return t.ExpiresAt != nil && !now.Before(t.ExpiresAt)
+}
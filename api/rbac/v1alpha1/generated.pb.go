@@ -10,6 +10,7 @@ import (
 
 	proto "github.com/gogo/protobuf/proto"
 	v11 "k8s.io/api/rbac/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	math "math"
 	math_bits "math/bits"
@@ -28,10 +29,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+func (m *APIToken) Reset()      { *m = APIToken{} }
+func (*APIToken) ProtoMessage() {}
+func (*APIToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ed74b0f425c3672, []int{0}
+}
+func (m *APIToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *APIToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *APIToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_APIToken.Merge(m, src)
+}
+func (m *APIToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *APIToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_APIToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_APIToken proto.InternalMessageInfo
+
 func (m *Claim) Reset()      { *m = Claim{} }
 func (*Claim) ProtoMessage() {}
 func (*Claim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0ed74b0f425c3672, []int{0}
+	return fileDescriptor_0ed74b0f425c3672, []int{1}
 }
 func (m *Claim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -59,7 +88,7 @@ var xxx_messageInfo_Claim proto.InternalMessageInfo
 func (m *ResourceDetails) Reset()      { *m = ResourceDetails{} }
 func (*ResourceDetails) ProtoMessage() {}
 func (*ResourceDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0ed74b0f425c3672, []int{1}
+	return fileDescriptor_0ed74b0f425c3672, []int{2}
 }
 func (m *ResourceDetails) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -87,7 +116,7 @@ var xxx_messageInfo_ResourceDetails proto.InternalMessageInfo
 func (m *Role) Reset()      { *m = Role{} }
 func (*Role) ProtoMessage() {}
 func (*Role) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0ed74b0f425c3672, []int{2}
+	return fileDescriptor_0ed74b0f425c3672, []int{3}
 }
 func (m *Role) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -115,7 +144,7 @@ var xxx_messageInfo_Role proto.InternalMessageInfo
 func (m *RoleResources) Reset()      { *m = RoleResources{} }
 func (*RoleResources) ProtoMessage() {}
 func (*RoleResources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0ed74b0f425c3672, []int{3}
+	return fileDescriptor_0ed74b0f425c3672, []int{4}
 }
 func (m *RoleResources) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -141,6 +170,7 @@ func (m *RoleResources) XXX_DiscardUnknown() {
 var xxx_messageInfo_RoleResources proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterType((*APIToken)(nil), "github.com.akuity.kargo.api.rbac.v1alpha1.APIToken")
 	proto.RegisterType((*Claim)(nil), "github.com.akuity.kargo.api.rbac.v1alpha1.Claim")
 	proto.RegisterType((*ResourceDetails)(nil), "github.com.akuity.kargo.api.rbac.v1alpha1.ResourceDetails")
 	proto.RegisterType((*Role)(nil), "github.com.akuity.kargo.api.rbac.v1alpha1.Role")
@@ -152,46 +182,114 @@ func init() {
 }
 
 var fileDescriptor_0ed74b0f425c3672 = []byte{
-	// 616 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xbf, 0x6e, 0xd4, 0x30,
-	0x1c, 0xbe, 0xdc, 0x3f, 0x5a, 0xf7, 0x5a, 0xa4, 0x08, 0xa1, 0xa8, 0x43, 0x72, 0xca, 0xd4, 0x0e,
-	0x38, 0xb4, 0x42, 0xa8, 0x0c, 0x0c, 0xa4, 0x6c, 0xa8, 0x14, 0x19, 0x54, 0x01, 0x13, 0x8e, 0xcf,
-	0xe4, 0xcc, 0x25, 0x71, 0x64, 0x27, 0x91, 0x6e, 0xe3, 0x11, 0x78, 0x0a, 0xde, 0x83, 0xad, 0x63,
-	0xc7, 0x4e, 0x27, 0x1a, 0x1e, 0x80, 0x57, 0x40, 0x76, 0x72, 0x5c, 0xd2, 0x5e, 0x45, 0x27, 0xa6,
-	0xb3, 0x7f, 0xfe, 0xbe, 0xef, 0xf7, 0xfd, 0x3e, 0xfb, 0x02, 0x9e, 0x85, 0x2c, 0x9b, 0xe6, 0x01,
-	0x24, 0x3c, 0xf6, 0xf0, 0x2c, 0x67, 0xd9, 0xdc, 0x9b, 0x61, 0x11, 0x72, 0x0f, 0xa7, 0xcc, 0x13,
-	0x01, 0x26, 0x5e, 0x71, 0x80, 0xa3, 0x74, 0x8a, 0x0f, 0xbc, 0x90, 0x26, 0x54, 0xe0, 0x8c, 0x4e,
-	0x60, 0x2a, 0x78, 0xc6, 0xcd, 0xfd, 0x15, 0x15, 0x56, 0x54, 0xa8, 0xa9, 0x10, 0xa7, 0x0c, 0x2a,
-	0x2a, 0x5c, 0x52, 0x77, 0x1f, 0x35, 0xba, 0x84, 0x3c, 0xe4, 0x9e, 0x56, 0x08, 0xf2, 0xcf, 0x7a,
-	0xa7, 0x37, 0x7a, 0x55, 0x29, 0xef, 0xba, 0xb3, 0x23, 0x09, 0x59, 0xe5, 0x81, 0x70, 0x41, 0xbd,
-	0xe2, 0x46, 0xf7, 0x16, 0xa6, 0xf6, 0x79, 0x03, 0xf3, 0x64, 0x85, 0x89, 0x31, 0x99, 0xb2, 0x84,
-	0x8a, 0xb9, 0x97, 0xce, 0x42, 0x55, 0x90, 0x5e, 0x4c, 0x33, 0xbc, 0x8e, 0xe5, 0xdd, 0xc6, 0x12,
-	0x79, 0x92, 0xb1, 0x98, 0xde, 0x20, 0x3c, 0xfd, 0x17, 0x41, 0x92, 0x29, 0x8d, 0xf1, 0x75, 0x9e,
-	0x7b, 0x02, 0x06, 0xc7, 0x11, 0x66, 0xb1, 0x39, 0x06, 0xfd, 0x04, 0xc7, 0xd4, 0x32, 0xc6, 0xc6,
-	0xde, 0xa6, 0x3f, 0x3a, 0x5f, 0x38, 0x9d, 0x72, 0xe1, 0xf4, 0x5f, 0xe3, 0x98, 0x22, 0x7d, 0x62,
-	0xba, 0x60, 0x58, 0xe0, 0x28, 0xa7, 0xd2, 0xea, 0x8e, 0x7b, 0x7b, 0x9b, 0x3e, 0x28, 0x17, 0xce,
-	0xf0, 0x4c, 0x57, 0x50, 0x7d, 0xe2, 0x7e, 0x37, 0xc0, 0x7d, 0x44, 0x25, 0xcf, 0x05, 0xa1, 0x2f,
-	0x69, 0x86, 0x59, 0x24, 0xcd, 0x23, 0x30, 0x12, 0x75, 0xe9, 0xdd, 0x3c, 0x5d, 0x76, 0x78, 0x50,
-	0x77, 0x18, 0xa1, 0xc6, 0x19, 0x6a, 0x21, 0x9b, 0x4c, 0xe5, 0xc3, 0xea, 0xae, 0x67, 0x6a, 0x8f,
-	0x2d, 0xa4, 0xe9, 0x80, 0x41, 0x41, 0x45, 0x20, 0xad, 0x9e, 0xb6, 0xba, 0x59, 0x2e, 0x9c, 0xc1,
-	0x99, 0x2a, 0xa0, 0xaa, 0xee, 0xfe, 0xe8, 0x82, 0x3e, 0xe2, 0x11, 0x35, 0x3f, 0x81, 0x0d, 0x75,
-	0x09, 0x13, 0x9c, 0x61, 0xed, 0x6c, 0xeb, 0xf0, 0x31, 0xac, 0xb2, 0x84, 0xcd, 0x2c, 0x61, 0x3a,
-	0x0b, 0x55, 0x41, 0x42, 0x85, 0x86, 0xc5, 0x01, 0x3c, 0x0d, 0xbe, 0x50, 0x92, 0x9d, 0xd0, 0x0c,
-	0xfb, 0x66, 0xed, 0x08, 0xac, 0x6a, 0xe8, 0xaf, 0xaa, 0x9a, 0x42, 0xbf, 0xc9, 0x13, 0x9c, 0xe0,
-	0x90, 0x4e, 0xf4, 0x14, 0x1b, 0xab, 0x29, 0x5e, 0x35, 0xce, 0x50, 0x0b, 0x69, 0xbe, 0x07, 0x43,
-	0xa2, 0x2e, 0x47, 0x5a, 0xf7, 0xc6, 0x3d, 0xed, 0xec, 0xce, 0xcf, 0x1d, 0xea, 0x5b, 0xf5, 0x77,
-	0xea, 0x2e, 0x43, 0xbd, 0x95, 0xa8, 0xd6, 0x33, 0x8f, 0xc1, 0x40, 0xe4, 0x11, 0x95, 0xd6, 0x50,
-	0x0b, 0xdb, 0x8d, 0x91, 0x97, 0x3a, 0xf0, 0x0d, 0x8f, 0x18, 0x99, 0xa3, 0x3c, 0xa2, 0xfe, 0x76,
-	0x2d, 0x33, 0x50, 0x3b, 0x89, 0x2a, 0xae, 0xfb, 0xbb, 0x0b, 0xb6, 0x55, 0x86, 0xcb, 0x7b, 0x90,
-	0xff, 0x21, 0xcc, 0x00, 0xec, 0x48, 0x2a, 0x0a, 0x46, 0xe8, 0x0b, 0x42, 0x78, 0x9e, 0x64, 0x3a,
-	0xce, 0xad, 0x43, 0xb7, 0x39, 0x81, 0xfa, 0xbf, 0x2a, 0xd5, 0xb7, 0x2d, 0xa4, 0xff, 0xb0, 0x56,
-	0xde, 0x69, 0xd7, 0xd1, 0x35, 0x45, 0xf3, 0x39, 0x18, 0x08, 0xae, 0xc2, 0xe9, 0xe9, 0x70, 0xac,
-	0x75, 0xe1, 0xa8, 0xb9, 0x1b, 0xb1, 0xf0, 0x2a, 0x16, 0xf5, 0x63, 0x7e, 0x00, 0x23, 0xb5, 0xf0,
-	0x59, 0x32, 0x61, 0x49, 0x28, 0xad, 0xbe, 0x56, 0x71, 0x6e, 0x55, 0xa9, 0x70, 0x8d, 0x67, 0xdd,
-	0x20, 0xa3, 0x96, 0x94, 0x7f, 0x7a, 0x7e, 0x65, 0x77, 0x2e, 0xae, 0xec, 0xce, 0xe5, 0x95, 0xdd,
-	0xf9, 0x5a, 0xda, 0xc6, 0x79, 0x69, 0x1b, 0x17, 0xa5, 0x6d, 0x5c, 0x96, 0xb6, 0xf1, 0xb3, 0xb4,
-	0x8d, 0x6f, 0xbf, 0xec, 0xce, 0xc7, 0xfd, 0x3b, 0x7f, 0x4f, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff,
-	0xc4, 0x54, 0x11, 0x69, 0x7b, 0x05, 0x00, 0x00,
+	// 706 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x95, 0xcb, 0x6e, 0xd3, 0x4c,
+	0x18, 0x86, 0xe3, 0x9c, 0xfe, 0x64, 0x9a, 0xe6, 0x47, 0x16, 0x42, 0x56, 0x17, 0x76, 0xe4, 0x55,
+	0x8b, 0x60, 0x4c, 0x2b, 0x84, 0xca, 0x82, 0x45, 0x5c, 0x58, 0x20, 0x28, 0xad, 0x86, 0x52, 0xa0,
+	0x2b, 0x26, 0xce, 0xe0, 0x0c, 0xf1, 0x49, 0x9e, 0x71, 0x44, 0x76, 0x5c, 0x02, 0x57, 0xc1, 0x7d,
+	0xb0, 0xeb, 0x8e, 0x2e, 0xbb, 0x8a, 0xa8, 0xb9, 0x00, 0x6e, 0x01, 0xcd, 0xd8, 0x69, 0xec, 0xa6,
+	0x15, 0x65, 0xd3, 0x55, 0x3c, 0xdf, 0xbc, 0xef, 0x33, 0xdf, 0x61, 0xec, 0x80, 0xc7, 0x2e, 0xe5,
+	0xa3, 0x64, 0x00, 0x9d, 0xd0, 0xb7, 0xf0, 0x38, 0xa1, 0x7c, 0x6a, 0x8d, 0x71, 0xec, 0x86, 0x16,
+	0x8e, 0xa8, 0x15, 0x0f, 0xb0, 0x63, 0x4d, 0x36, 0xb1, 0x17, 0x8d, 0xf0, 0xa6, 0xe5, 0x92, 0x80,
+	0xc4, 0x98, 0x93, 0x21, 0x8c, 0xe2, 0x90, 0x87, 0xea, 0xc6, 0xc2, 0x0a, 0x33, 0x2b, 0x94, 0x56,
+	0x88, 0x23, 0x0a, 0x85, 0x15, 0xce, 0xad, 0x6b, 0xf7, 0x0b, 0xa7, 0xb8, 0xa1, 0x1b, 0x5a, 0x92,
+	0x30, 0x48, 0x3e, 0xca, 0x95, 0x5c, 0xc8, 0xa7, 0x8c, 0xbc, 0x66, 0x8e, 0xb7, 0x19, 0xa4, 0x59,
+	0x0e, 0x4e, 0x18, 0x13, 0x6b, 0xb2, 0x74, 0x7a, 0x49, 0x93, 0xe7, 0xb9, 0xa4, 0x79, 0xb8, 0xd0,
+	0xf8, 0xd8, 0x19, 0xd1, 0x80, 0xc4, 0x53, 0x2b, 0x1a, 0xbb, 0x22, 0xc0, 0x2c, 0x9f, 0x70, 0x7c,
+	0x99, 0xcb, 0xba, 0xca, 0x15, 0x27, 0x01, 0xa7, 0x3e, 0x59, 0x32, 0x3c, 0xfa, 0x9b, 0x81, 0x39,
+	0x23, 0xe2, 0xe3, 0x8b, 0x3e, 0xf3, 0x47, 0x15, 0xb4, 0xfa, 0xfb, 0xcf, 0x0f, 0xc2, 0x31, 0x09,
+	0xd4, 0x0f, 0xa0, 0x25, 0x12, 0x1a, 0x62, 0x8e, 0x35, 0xa5, 0xa7, 0xac, 0xaf, 0x6c, 0x3d, 0x80,
+	0x19, 0x17, 0x16, 0xb9, 0x30, 0x1a, 0xbb, 0x22, 0xc0, 0xa0, 0x50, 0xc3, 0xc9, 0x26, 0xdc, 0x1b,
+	0x7c, 0x22, 0x0e, 0xdf, 0x25, 0x1c, 0xdb, 0xea, 0xf1, 0xcc, 0xa8, 0xa4, 0x33, 0x03, 0x2c, 0x62,
+	0xe8, 0x9c, 0xaa, 0xde, 0x03, 0xad, 0x38, 0xf4, 0xc8, 0x2b, 0xec, 0x13, 0xad, 0xda, 0x53, 0xd6,
+	0xdb, 0xf6, 0xad, 0x5c, 0xdf, 0x42, 0x79, 0x1c, 0x9d, 0x2b, 0xd4, 0xb7, 0xa0, 0x4d, 0x3e, 0x47,
+	0x34, 0x26, 0xac, 0xcf, 0xb5, 0x9a, 0x4c, 0xe8, 0xee, 0xf5, 0x12, 0x3a, 0xa0, 0x3e, 0xb1, 0x57,
+	0xd3, 0x99, 0xd1, 0x7e, 0x36, 0x07, 0xa0, 0x05, 0x4b, 0x3d, 0x02, 0xc0, 0xc3, 0x8c, 0xbf, 0x61,
+	0x64, 0xd8, 0xe7, 0x5a, 0xfd, 0x9f, 0xc9, 0x5d, 0x51, 0xe0, 0xcb, 0x73, 0x02, 0x2a, 0xd0, 0xcc,
+	0x5d, 0xd0, 0xd8, 0xf1, 0x30, 0xf5, 0xd5, 0x1e, 0xa8, 0x07, 0xa2, 0x4e, 0x45, 0xd6, 0xd9, 0xc9,
+	0xeb, 0xac, 0xcb, 0x1a, 0xe5, 0x8e, 0x6a, 0x82, 0xe6, 0x04, 0x7b, 0x09, 0x61, 0x5a, 0xb5, 0x57,
+	0x5b, 0x6f, 0xdb, 0x20, 0x9d, 0x19, 0xcd, 0x43, 0x19, 0x41, 0xf9, 0x8e, 0xf9, 0x4d, 0x01, 0xff,
+	0x23, 0xc2, 0xc2, 0x24, 0x76, 0xc8, 0x53, 0xc2, 0x31, 0xf5, 0x98, 0xba, 0x0d, 0x3a, 0x71, 0x1e,
+	0x3a, 0x98, 0x46, 0xf3, 0x13, 0x6e, 0xe7, 0x27, 0x74, 0x50, 0x61, 0x0f, 0x95, 0x94, 0x45, 0x67,
+	0x61, 0x06, 0x4b, 0x4e, 0x99, 0x63, 0x49, 0xa9, 0x1a, 0xa0, 0x31, 0x21, 0xf1, 0x80, 0x69, 0x35,
+	0x99, 0x6a, 0x3b, 0x9d, 0x19, 0x8d, 0x43, 0x11, 0x40, 0x59, 0xdc, 0xfc, 0x5e, 0x05, 0x75, 0x31,
+	0xc3, 0x1b, 0xb8, 0x45, 0xdb, 0xa0, 0x23, 0xdf, 0xf2, 0x5d, 0x1c, 0x60, 0x97, 0x0c, 0x65, 0x15,
+	0xad, 0x45, 0x15, 0x2f, 0x0a, 0x7b, 0xa8, 0xa4, 0x54, 0xdf, 0x81, 0xa6, 0x23, 0x86, 0xc3, 0xb4,
+	0xff, 0x7a, 0x35, 0x99, 0xd9, 0xb5, 0x3f, 0x20, 0x50, 0x4e, 0xd5, 0xee, 0xe6, 0xa7, 0x34, 0xe5,
+	0x92, 0xa1, 0x9c, 0xa7, 0xee, 0x80, 0x46, 0x9c, 0x78, 0x84, 0x69, 0x4d, 0x09, 0xd6, 0x0b, 0x25,
+	0xcf, 0x39, 0x70, 0x3f, 0xf4, 0xa8, 0x33, 0x45, 0x89, 0x47, 0xec, 0xd5, 0x1c, 0xd3, 0x10, 0x2b,
+	0x86, 0x32, 0xaf, 0xf9, 0xbb, 0x0a, 0x56, 0x45, 0x0f, 0xe7, 0x73, 0x60, 0x37, 0xd0, 0xcc, 0x01,
+	0xe8, 0x32, 0x12, 0x4f, 0xa8, 0x43, 0xfa, 0x8e, 0x13, 0x26, 0x01, 0x97, 0xed, 0x5c, 0xd9, 0x32,
+	0x8b, 0x15, 0x88, 0x2f, 0xa0, 0xa0, 0xbe, 0x2e, 0x29, 0xed, 0x3b, 0x39, 0xb9, 0x5b, 0x8e, 0xa3,
+	0x0b, 0x44, 0xf5, 0x09, 0x68, 0x88, 0x97, 0x3a, 0xbb, 0x3c, 0x2b, 0x5b, 0xda, 0x65, 0xcd, 0x41,
+	0x61, 0xa9, 0x2d, 0x61, 0xd6, 0x16, 0xf1, 0xa3, 0xbe, 0x07, 0x1d, 0xf1, 0x60, 0xd3, 0x60, 0x48,
+	0x03, 0x97, 0x69, 0x75, 0x49, 0x31, 0xae, 0xa4, 0x64, 0xba, 0xc2, 0xb5, 0x2e, 0x98, 0x51, 0x09,
+	0x65, 0xef, 0x1d, 0x9f, 0xe9, 0x95, 0x93, 0x33, 0xbd, 0x72, 0x7a, 0xa6, 0x57, 0xbe, 0xa4, 0xba,
+	0x72, 0x9c, 0xea, 0xca, 0x49, 0xaa, 0x2b, 0xa7, 0xa9, 0xae, 0xfc, 0x4c, 0x75, 0xe5, 0xeb, 0x2f,
+	0xbd, 0x72, 0xb4, 0x71, 0xed, 0x7f, 0xa8, 0x3f, 0x03, 0x00, 0xdf, 0x77, 0x01, 0xb5, 0xcd, 0x06,
+	0x00, 0x00,
+}
+
+func (m *APIToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *APIToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *APIToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastUsedAt != nil {
+		{
+			size, err := m.LastUsedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ExpiresAt != nil {
+		{
+			size, err := m.ExpiresAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.RoleName)
+	copy(dAtA[i:], m.RoleName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RoleName)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *Claim) Marshal() (dAtA []byte, err error) {
@@ -424,6 +522,27 @@ func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *APIToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.RoleName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ExpiresAt != nil {
+		l = m.ExpiresAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.LastUsedAt != nil {
+		l = m.LastUsedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *Claim) Size() (n int) {
 	if m == nil {
 		return 0
@@ -515,6 +634,19 @@ func sovGenerated(x uint64) (n int) {
 func sozGenerated(x uint64) (n int) {
 	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (this *APIToken) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&APIToken{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`RoleName:` + fmt.Sprintf("%v", this.RoleName) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Time", "v1.Time", 1) + `,`,
+		`LastUsedAt:` + strings.Replace(fmt.Sprintf("%v", this.LastUsedAt), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Claim) String() string {
 	if this == nil {
 		return "nil"
@@ -592,6 +724,193 @@ func valueToStringGenerated(v interface{}) string {
 	pv := reflect.Indirect(rv).Interface()
 	return fmt.Sprintf("*%v", pv)
 }
+func (m *APIToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: APIToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: APIToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = &v1.Time{}
+			}
+			if err := m.ExpiresAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUsedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastUsedAt == nil {
+				m.LastUsedAt = &v1.Time{}
+			}
+			if err := m.LastUsedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Claim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -28,6 +28,7 @@ var (
 func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(
 		GroupVersion,
+		&APIToken{},
 		&Role{},
 		&RoleResources{},
 	)
@@ -0,0 +1,12 @@
+package v1alpha1
+
+const (
+	// LabelKeyAPIToken is a label key set on a Secret to indicate that it
+	// underlies a Kargo API token. Secrets with this label are managed
+	// exclusively through the Kargo API.
+	LabelKeyAPIToken = "rbac.kargo.akuity.io/api-token" // nolint: gosec
+
+	// LabelValueTrue is a value that can be set on a label to indicate that it
+	// applies.
+	LabelValueTrue = "true"
+)
@@ -5,12 +5,14 @@
 package svcv1alpha1connect
 
 import (
-	connect "connectrpc.com/connect"
 	context "context"
 	errors "errors"
-	v1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
 	http "net/http"
 	strings "strings"
+
+	connect "connectrpc.com/connect"
+
+	v1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
 )
 
 // This is a compile-time assertion to ensure that this generated file and the connect package are
@@ -97,6 +99,12 @@ const (
 	// KargoServiceAbortPromotionProcedure is the fully-qualified name of the KargoService's
 	// AbortPromotion RPC.
 	KargoServiceAbortPromotionProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/AbortPromotion"
+	// KargoServiceGetPromotionLogsProcedure is the fully-qualified name of the KargoService's
+	// GetPromotionLogs RPC.
+	KargoServiceGetPromotionLogsProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/GetPromotionLogs"
+	// KargoServiceResumePromotionProcedure is the fully-qualified name of the KargoService's
+	// ResumePromotion RPC.
+	KargoServiceResumePromotionProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/ResumePromotion"
 	// KargoServiceDeleteProjectProcedure is the fully-qualified name of the KargoService's
 	// DeleteProject RPC.
 	KargoServiceDeleteProjectProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/DeleteProject"
@@ -117,14 +125,23 @@ const (
 	// KargoServiceRefreshProjectConfigProcedure is the fully-qualified name of the KargoService's
 	// RefreshProjectConfig RPC.
 	KargoServiceRefreshProjectConfigProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/RefreshProjectConfig"
+	// KargoServiceGetPromotionWavesProcedure is the fully-qualified name of the KargoService's
+	// GetPromotionWaves RPC.
+	KargoServiceGetPromotionWavesProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/GetPromotionWaves"
 	// KargoServiceApproveFreightProcedure is the fully-qualified name of the KargoService's
 	// ApproveFreight RPC.
 	KargoServiceApproveFreightProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/ApproveFreight"
+	// KargoServiceCreateFreightProcedure is the fully-qualified name of the KargoService's
+	// CreateFreight RPC.
+	KargoServiceCreateFreightProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/CreateFreight"
 	// KargoServiceDeleteFreightProcedure is the fully-qualified name of the KargoService's
 	// DeleteFreight RPC.
 	KargoServiceDeleteFreightProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/DeleteFreight"
 	// KargoServiceGetFreightProcedure is the fully-qualified name of the KargoService's GetFreight RPC.
 	KargoServiceGetFreightProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/GetFreight"
+	// KargoServiceGetFreightLineageProcedure is the fully-qualified name of the KargoService's
+	// GetFreightLineage RPC.
+	KargoServiceGetFreightLineageProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/GetFreightLineage"
 	// KargoServiceWatchFreightProcedure is the fully-qualified name of the KargoService's WatchFreight
 	// RPC.
 	KargoServiceWatchFreightProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/WatchFreight"
@@ -246,6 +263,18 @@ const (
 	KargoServiceRevokeProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/Revoke"
 	// KargoServiceUpdateRoleProcedure is the fully-qualified name of the KargoService's UpdateRole RPC.
 	KargoServiceUpdateRoleProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/UpdateRole"
+	// KargoServiceCreateAPITokenProcedure is the fully-qualified name of the KargoService's
+	// CreateAPIToken RPC.
+	KargoServiceCreateAPITokenProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/CreateAPIToken"
+	// KargoServiceDeleteAPITokenProcedure is the fully-qualified name of the KargoService's
+	// DeleteAPIToken RPC.
+	KargoServiceDeleteAPITokenProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/DeleteAPIToken"
+	// KargoServiceGetAPITokenProcedure is the fully-qualified name of the KargoService's GetAPIToken
+	// RPC.
+	KargoServiceGetAPITokenProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/GetAPIToken"
+	// KargoServiceListAPITokensProcedure is the fully-qualified name of the KargoService's
+	// ListAPITokens RPC.
+	KargoServiceListAPITokensProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/ListAPITokens"
 	// KargoServiceListClusterSecretsProcedure is the fully-qualified name of the KargoService's
 	// ListClusterSecrets RPC.
 	KargoServiceListClusterSecretsProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/ListClusterSecrets"
@@ -286,6 +315,8 @@ var (
 	kargoServiceGetPromotionMethodDescriptor                  = kargoServiceServiceDescriptor.Methods().ByName("GetPromotion")
 	kargoServiceWatchPromotionMethodDescriptor                = kargoServiceServiceDescriptor.Methods().ByName("WatchPromotion")
 	kargoServiceAbortPromotionMethodDescriptor                = kargoServiceServiceDescriptor.Methods().ByName("AbortPromotion")
+	kargoServiceGetPromotionLogsMethodDescriptor              = kargoServiceServiceDescriptor.Methods().ByName("GetPromotionLogs")
+	kargoServiceResumePromotionMethodDescriptor               = kargoServiceServiceDescriptor.Methods().ByName("ResumePromotion")
 	kargoServiceDeleteProjectMethodDescriptor                 = kargoServiceServiceDescriptor.Methods().ByName("DeleteProject")
 	kargoServiceGetProjectMethodDescriptor                    = kargoServiceServiceDescriptor.Methods().ByName("GetProject")
 	kargoServiceListProjectsMethodDescriptor                  = kargoServiceServiceDescriptor.Methods().ByName("ListProjects")
@@ -293,9 +324,12 @@ var (
 	kargoServiceDeleteProjectConfigMethodDescriptor           = kargoServiceServiceDescriptor.Methods().ByName("DeleteProjectConfig")
 	kargoServiceWatchProjectConfigMethodDescriptor            = kargoServiceServiceDescriptor.Methods().ByName("WatchProjectConfig")
 	kargoServiceRefreshProjectConfigMethodDescriptor          = kargoServiceServiceDescriptor.Methods().ByName("RefreshProjectConfig")
+	kargoServiceGetPromotionWavesMethodDescriptor             = kargoServiceServiceDescriptor.Methods().ByName("GetPromotionWaves")
 	kargoServiceApproveFreightMethodDescriptor                = kargoServiceServiceDescriptor.Methods().ByName("ApproveFreight")
+	kargoServiceCreateFreightMethodDescriptor                 = kargoServiceServiceDescriptor.Methods().ByName("CreateFreight")
 	kargoServiceDeleteFreightMethodDescriptor                 = kargoServiceServiceDescriptor.Methods().ByName("DeleteFreight")
 	kargoServiceGetFreightMethodDescriptor                    = kargoServiceServiceDescriptor.Methods().ByName("GetFreight")
+	kargoServiceGetFreightLineageMethodDescriptor             = kargoServiceServiceDescriptor.Methods().ByName("GetFreightLineage")
 	kargoServiceWatchFreightMethodDescriptor                  = kargoServiceServiceDescriptor.Methods().ByName("WatchFreight")
 	kargoServicePromoteToStageMethodDescriptor                = kargoServiceServiceDescriptor.Methods().ByName("PromoteToStage")
 	kargoServicePromoteDownstreamMethodDescriptor             = kargoServiceServiceDescriptor.Methods().ByName("PromoteDownstream")
@@ -339,6 +373,10 @@ var (
 	kargoServiceListRolesMethodDescriptor                     = kargoServiceServiceDescriptor.Methods().ByName("ListRoles")
 	kargoServiceRevokeMethodDescriptor                        = kargoServiceServiceDescriptor.Methods().ByName("Revoke")
 	kargoServiceUpdateRoleMethodDescriptor                    = kargoServiceServiceDescriptor.Methods().ByName("UpdateRole")
+	kargoServiceCreateAPITokenMethodDescriptor                = kargoServiceServiceDescriptor.Methods().ByName("CreateAPIToken")
+	kargoServiceDeleteAPITokenMethodDescriptor                = kargoServiceServiceDescriptor.Methods().ByName("DeleteAPIToken")
+	kargoServiceGetAPITokenMethodDescriptor                   = kargoServiceServiceDescriptor.Methods().ByName("GetAPIToken")
+	kargoServiceListAPITokensMethodDescriptor                 = kargoServiceServiceDescriptor.Methods().ByName("ListAPITokens")
 	kargoServiceListClusterSecretsMethodDescriptor            = kargoServiceServiceDescriptor.Methods().ByName("ListClusterSecrets")
 	kargoServiceCreateClusterSecretMethodDescriptor           = kargoServiceServiceDescriptor.Methods().ByName("CreateClusterSecret")
 	kargoServiceUpdateClusterSecretMethodDescriptor           = kargoServiceServiceDescriptor.Methods().ByName("UpdateClusterSecret")
@@ -395,6 +433,10 @@ type KargoServiceClient interface {
 	WatchPromotion(context.Context, *connect.Request[v1alpha1.WatchPromotionRequest]) (*connect.ServerStreamForClient[v1alpha1.WatchPromotionResponse], error)
 	// AbortPromotion cancels a running promotion process.
 	AbortPromotion(context.Context, *connect.Request[v1alpha1.AbortPromotionRequest]) (*connect.Response[v1alpha1.AbortPromotionResponse], error)
+	// GetPromotionLogs streams logs captured during the execution of a promotion's steps.
+	GetPromotionLogs(context.Context, *connect.Request[v1alpha1.GetPromotionLogsRequest]) (*connect.ServerStreamForClient[v1alpha1.GetPromotionLogsResponse], error)
+	// ResumePromotion creates a promotion which resumes a failed or errored promotion.
+	ResumePromotion(context.Context, *connect.Request[v1alpha1.ResumePromotionRequest]) (*connect.Response[v1alpha1.ResumePromotionResponse], error)
 	// DeleteProject removes a project and all associated resources.
 	DeleteProject(context.Context, *connect.Request[v1alpha1.DeleteProjectRequest]) (*connect.Response[v1alpha1.DeleteProjectResponse], error)
 	// GetProject retrieves details of a specific project.
@@ -409,12 +451,18 @@ type KargoServiceClient interface {
 	WatchProjectConfig(context.Context, *connect.Request[v1alpha1.WatchProjectConfigRequest]) (*connect.ServerStreamForClient[v1alpha1.WatchProjectConfigResponse], error)
 	// RefreshProjectConfig triggers a refresh of project configuration.
 	RefreshProjectConfig(context.Context, *connect.Request[v1alpha1.RefreshProjectConfigRequest]) (*connect.Response[v1alpha1.RefreshProjectConfigResponse], error)
+	// GetPromotionWaves retrieves the progress of freight through the promotion waves defined in project-level configuration.
+	GetPromotionWaves(context.Context, *connect.Request[v1alpha1.GetPromotionWavesRequest]) (*connect.Response[v1alpha1.GetPromotionWavesResponse], error)
 	// ApproveFreight marks freight as approved for promotion to a specific stage.
 	ApproveFreight(context.Context, *connect.Request[v1alpha1.ApproveFreightRequest]) (*connect.Response[v1alpha1.ApproveFreightResponse], error)
+	// CreateFreight creates freight from specific artifacts subscribed to by a warehouse, resolving their details through artifact discovery.
+	CreateFreight(context.Context, *connect.Request[v1alpha1.CreateFreightRequest]) (*connect.Response[v1alpha1.CreateFreightResponse], error)
 	// DeleteFreight removes freight from the system.
 	DeleteFreight(context.Context, *connect.Request[v1alpha1.DeleteFreightRequest]) (*connect.Response[v1alpha1.DeleteFreightResponse], error)
 	// GetFreight retrieves details of specific freight.
 	GetFreight(context.Context, *connect.Request[v1alpha1.GetFreightRequest]) (*connect.Response[v1alpha1.GetFreightResponse], error)
+	// GetFreightLineage retrieves the lineage of specific freight, from the artifacts it references to every stage it has been through.
+	GetFreightLineage(context.Context, *connect.Request[v1alpha1.GetFreightLineageRequest]) (*connect.Response[v1alpha1.GetFreightLineageResponse], error)
 	// WatchFreight provides streaming updates for freight changes.
 	WatchFreight(context.Context, *connect.Request[v1alpha1.WatchFreightRequest]) (*connect.ServerStreamForClient[v1alpha1.WatchFreightResponse], error)
 	// PromoteToStage initiates a promotion of freight to a specific stage.
@@ -501,6 +549,14 @@ type KargoServiceClient interface {
 	Revoke(context.Context, *connect.Request[v1alpha1.RevokeRequest]) (*connect.Response[v1alpha1.RevokeResponse], error)
 	// UpdateRole modifies an existing RBAC role.
 	UpdateRole(context.Context, *connect.Request[v1alpha1.UpdateRoleRequest]) (*connect.Response[v1alpha1.UpdateRoleResponse], error)
+	// CreateAPIToken creates a new API token bound to an RBAC role within a project.
+	CreateAPIToken(context.Context, *connect.Request[v1alpha1.CreateAPITokenRequest]) (*connect.Response[v1alpha1.CreateAPITokenResponse], error)
+	// DeleteAPIToken revokes an API token by removing it from a project.
+	DeleteAPIToken(context.Context, *connect.Request[v1alpha1.DeleteAPITokenRequest]) (*connect.Response[v1alpha1.DeleteAPITokenResponse], error)
+	// GetAPIToken retrieves details of a specific API token.
+	GetAPIToken(context.Context, *connect.Request[v1alpha1.GetAPITokenRequest]) (*connect.Response[v1alpha1.GetAPITokenResponse], error)
+	// ListAPITokens retrieves all API tokens within a project.
+	ListAPITokens(context.Context, *connect.Request[v1alpha1.ListAPITokensRequest]) (*connect.Response[v1alpha1.ListAPITokensResponse], error)
 	// ListClusterSecrets retrieves all cluster-scoped secrets.
 	ListClusterSecrets(context.Context, *connect.Request[v1alpha1.ListClusterSecretsRequest]) (*connect.Response[v1alpha1.ListClusterSecretsResponse], error)
 	// CreateClusterSecret creates a new cluster-scoped secret.
@@ -659,6 +715,18 @@ func NewKargoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(kargoServiceAbortPromotionMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getPromotionLogs: connect.NewClient[v1alpha1.GetPromotionLogsRequest, v1alpha1.GetPromotionLogsResponse](
+			httpClient,
+			baseURL+KargoServiceGetPromotionLogsProcedure,
+			connect.WithSchema(kargoServiceGetPromotionLogsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		resumePromotion: connect.NewClient[v1alpha1.ResumePromotionRequest, v1alpha1.ResumePromotionResponse](
+			httpClient,
+			baseURL+KargoServiceResumePromotionProcedure,
+			connect.WithSchema(kargoServiceResumePromotionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		deleteProject: connect.NewClient[v1alpha1.DeleteProjectRequest, v1alpha1.DeleteProjectResponse](
 			httpClient,
 			baseURL+KargoServiceDeleteProjectProcedure,
@@ -701,12 +769,24 @@ func NewKargoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(kargoServiceRefreshProjectConfigMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getPromotionWaves: connect.NewClient[v1alpha1.GetPromotionWavesRequest, v1alpha1.GetPromotionWavesResponse](
+			httpClient,
+			baseURL+KargoServiceGetPromotionWavesProcedure,
+			connect.WithSchema(kargoServiceGetPromotionWavesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		approveFreight: connect.NewClient[v1alpha1.ApproveFreightRequest, v1alpha1.ApproveFreightResponse](
 			httpClient,
 			baseURL+KargoServiceApproveFreightProcedure,
 			connect.WithSchema(kargoServiceApproveFreightMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		createFreight: connect.NewClient[v1alpha1.CreateFreightRequest, v1alpha1.CreateFreightResponse](
+			httpClient,
+			baseURL+KargoServiceCreateFreightProcedure,
+			connect.WithSchema(kargoServiceCreateFreightMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		deleteFreight: connect.NewClient[v1alpha1.DeleteFreightRequest, v1alpha1.DeleteFreightResponse](
 			httpClient,
 			baseURL+KargoServiceDeleteFreightProcedure,
@@ -719,6 +799,12 @@ func NewKargoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(kargoServiceGetFreightMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getFreightLineage: connect.NewClient[v1alpha1.GetFreightLineageRequest, v1alpha1.GetFreightLineageResponse](
+			httpClient,
+			baseURL+KargoServiceGetFreightLineageProcedure,
+			connect.WithSchema(kargoServiceGetFreightLineageMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		watchFreight: connect.NewClient[v1alpha1.WatchFreightRequest, v1alpha1.WatchFreightResponse](
 			httpClient,
 			baseURL+KargoServiceWatchFreightProcedure,
@@ -977,6 +1063,30 @@ func NewKargoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(kargoServiceUpdateRoleMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		createAPIToken: connect.NewClient[v1alpha1.CreateAPITokenRequest, v1alpha1.CreateAPITokenResponse](
+			httpClient,
+			baseURL+KargoServiceCreateAPITokenProcedure,
+			connect.WithSchema(kargoServiceCreateAPITokenMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAPIToken: connect.NewClient[v1alpha1.DeleteAPITokenRequest, v1alpha1.DeleteAPITokenResponse](
+			httpClient,
+			baseURL+KargoServiceDeleteAPITokenProcedure,
+			connect.WithSchema(kargoServiceDeleteAPITokenMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getAPIToken: connect.NewClient[v1alpha1.GetAPITokenRequest, v1alpha1.GetAPITokenResponse](
+			httpClient,
+			baseURL+KargoServiceGetAPITokenProcedure,
+			connect.WithSchema(kargoServiceGetAPITokenMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listAPITokens: connect.NewClient[v1alpha1.ListAPITokensRequest, v1alpha1.ListAPITokensResponse](
+			httpClient,
+			baseURL+KargoServiceListAPITokensProcedure,
+			connect.WithSchema(kargoServiceListAPITokensMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		listClusterSecrets: connect.NewClient[v1alpha1.ListClusterSecretsRequest, v1alpha1.ListClusterSecretsResponse](
 			httpClient,
 			baseURL+KargoServiceListClusterSecretsProcedure,
@@ -1029,6 +1139,8 @@ type kargoServiceClient struct {
 	getPromotion                  *connect.Client[v1alpha1.GetPromotionRequest, v1alpha1.GetPromotionResponse]
 	watchPromotion                *connect.Client[v1alpha1.WatchPromotionRequest, v1alpha1.WatchPromotionResponse]
 	abortPromotion                *connect.Client[v1alpha1.AbortPromotionRequest, v1alpha1.AbortPromotionResponse]
+	getPromotionLogs              *connect.Client[v1alpha1.GetPromotionLogsRequest, v1alpha1.GetPromotionLogsResponse]
+	resumePromotion               *connect.Client[v1alpha1.ResumePromotionRequest, v1alpha1.ResumePromotionResponse]
 	deleteProject                 *connect.Client[v1alpha1.DeleteProjectRequest, v1alpha1.DeleteProjectResponse]
 	getProject                    *connect.Client[v1alpha1.GetProjectRequest, v1alpha1.GetProjectResponse]
 	listProjects                  *connect.Client[v1alpha1.ListProjectsRequest, v1alpha1.ListProjectsResponse]
@@ -1036,9 +1148,12 @@ type kargoServiceClient struct {
 	deleteProjectConfig           *connect.Client[v1alpha1.DeleteProjectConfigRequest, v1alpha1.DeleteProjectConfigResponse]
 	watchProjectConfig            *connect.Client[v1alpha1.WatchProjectConfigRequest, v1alpha1.WatchProjectConfigResponse]
 	refreshProjectConfig          *connect.Client[v1alpha1.RefreshProjectConfigRequest, v1alpha1.RefreshProjectConfigResponse]
+	getPromotionWaves             *connect.Client[v1alpha1.GetPromotionWavesRequest, v1alpha1.GetPromotionWavesResponse]
 	approveFreight                *connect.Client[v1alpha1.ApproveFreightRequest, v1alpha1.ApproveFreightResponse]
+	createFreight                 *connect.Client[v1alpha1.CreateFreightRequest, v1alpha1.CreateFreightResponse]
 	deleteFreight                 *connect.Client[v1alpha1.DeleteFreightRequest, v1alpha1.DeleteFreightResponse]
 	getFreight                    *connect.Client[v1alpha1.GetFreightRequest, v1alpha1.GetFreightResponse]
+	getFreightLineage             *connect.Client[v1alpha1.GetFreightLineageRequest, v1alpha1.GetFreightLineageResponse]
 	watchFreight                  *connect.Client[v1alpha1.WatchFreightRequest, v1alpha1.WatchFreightResponse]
 	promoteToStage                *connect.Client[v1alpha1.PromoteToStageRequest, v1alpha1.PromoteToStageResponse]
 	promoteDownstream             *connect.Client[v1alpha1.PromoteDownstreamRequest, v1alpha1.PromoteDownstreamResponse]
@@ -1082,6 +1197,10 @@ type kargoServiceClient struct {
 	listRoles                     *connect.Client[v1alpha1.ListRolesRequest, v1alpha1.ListRolesResponse]
 	revoke                        *connect.Client[v1alpha1.RevokeRequest, v1alpha1.RevokeResponse]
 	updateRole                    *connect.Client[v1alpha1.UpdateRoleRequest, v1alpha1.UpdateRoleResponse]
+	createAPIToken                *connect.Client[v1alpha1.CreateAPITokenRequest, v1alpha1.CreateAPITokenResponse]
+	deleteAPIToken                *connect.Client[v1alpha1.DeleteAPITokenRequest, v1alpha1.DeleteAPITokenResponse]
+	getAPIToken                   *connect.Client[v1alpha1.GetAPITokenRequest, v1alpha1.GetAPITokenResponse]
+	listAPITokens                 *connect.Client[v1alpha1.ListAPITokensRequest, v1alpha1.ListAPITokensResponse]
 	listClusterSecrets            *connect.Client[v1alpha1.ListClusterSecretsRequest, v1alpha1.ListClusterSecretsResponse]
 	createClusterSecret           *connect.Client[v1alpha1.CreateClusterSecretRequest, v1alpha1.CreateClusterSecretResponse]
 	updateClusterSecret           *connect.Client[v1alpha1.UpdateClusterSecretRequest, v1alpha1.UpdateClusterSecretResponse]
@@ -1204,6 +1323,16 @@ func (c *kargoServiceClient) AbortPromotion(ctx context.Context, req *connect.Re
 	return c.abortPromotion.CallUnary(ctx, req)
 }
 
+// GetPromotionLogs calls akuity.io.kargo.service.v1alpha1.KargoService.GetPromotionLogs.
+func (c *kargoServiceClient) GetPromotionLogs(ctx context.Context, req *connect.Request[v1alpha1.GetPromotionLogsRequest]) (*connect.ServerStreamForClient[v1alpha1.GetPromotionLogsResponse], error) {
+	return c.getPromotionLogs.CallServerStream(ctx, req)
+}
+
+// ResumePromotion calls akuity.io.kargo.service.v1alpha1.KargoService.ResumePromotion.
+func (c *kargoServiceClient) ResumePromotion(ctx context.Context, req *connect.Request[v1alpha1.ResumePromotionRequest]) (*connect.Response[v1alpha1.ResumePromotionResponse], error) {
+	return c.resumePromotion.CallUnary(ctx, req)
+}
+
 // DeleteProject calls akuity.io.kargo.service.v1alpha1.KargoService.DeleteProject.
 func (c *kargoServiceClient) DeleteProject(ctx context.Context, req *connect.Request[v1alpha1.DeleteProjectRequest]) (*connect.Response[v1alpha1.DeleteProjectResponse], error) {
 	return c.deleteProject.CallUnary(ctx, req)
@@ -1239,11 +1368,21 @@ func (c *kargoServiceClient) RefreshProjectConfig(ctx context.Context, req *conn
 	return c.refreshProjectConfig.CallUnary(ctx, req)
 }
 
+// GetPromotionWaves calls akuity.io.kargo.service.v1alpha1.KargoService.GetPromotionWaves.
+func (c *kargoServiceClient) GetPromotionWaves(ctx context.Context, req *connect.Request[v1alpha1.GetPromotionWavesRequest]) (*connect.Response[v1alpha1.GetPromotionWavesResponse], error) {
+	return c.getPromotionWaves.CallUnary(ctx, req)
+}
+
 // ApproveFreight calls akuity.io.kargo.service.v1alpha1.KargoService.ApproveFreight.
 func (c *kargoServiceClient) ApproveFreight(ctx context.Context, req *connect.Request[v1alpha1.ApproveFreightRequest]) (*connect.Response[v1alpha1.ApproveFreightResponse], error) {
 	return c.approveFreight.CallUnary(ctx, req)
 }
 
+// CreateFreight calls akuity.io.kargo.service.v1alpha1.KargoService.CreateFreight.
+func (c *kargoServiceClient) CreateFreight(ctx context.Context, req *connect.Request[v1alpha1.CreateFreightRequest]) (*connect.Response[v1alpha1.CreateFreightResponse], error) {
+	return c.createFreight.CallUnary(ctx, req)
+}
+
 // DeleteFreight calls akuity.io.kargo.service.v1alpha1.KargoService.DeleteFreight.
 func (c *kargoServiceClient) DeleteFreight(ctx context.Context, req *connect.Request[v1alpha1.DeleteFreightRequest]) (*connect.Response[v1alpha1.DeleteFreightResponse], error) {
 	return c.deleteFreight.CallUnary(ctx, req)
@@ -1254,6 +1393,11 @@ func (c *kargoServiceClient) GetFreight(ctx context.Context, req *connect.Reques
 	return c.getFreight.CallUnary(ctx, req)
 }
 
+// GetFreightLineage calls akuity.io.kargo.service.v1alpha1.KargoService.GetFreightLineage.
+func (c *kargoServiceClient) GetFreightLineage(ctx context.Context, req *connect.Request[v1alpha1.GetFreightLineageRequest]) (*connect.Response[v1alpha1.GetFreightLineageResponse], error) {
+	return c.getFreightLineage.CallUnary(ctx, req)
+}
+
 // WatchFreight calls akuity.io.kargo.service.v1alpha1.KargoService.WatchFreight.
 func (c *kargoServiceClient) WatchFreight(ctx context.Context, req *connect.Request[v1alpha1.WatchFreightRequest]) (*connect.ServerStreamForClient[v1alpha1.WatchFreightResponse], error) {
 	return c.watchFreight.CallServerStream(ctx, req)
@@ -1475,6 +1619,26 @@ func (c *kargoServiceClient) UpdateRole(ctx context.Context, req *connect.Reques
 	return c.updateRole.CallUnary(ctx, req)
 }
 
+// CreateAPIToken calls akuity.io.kargo.service.v1alpha1.KargoService.CreateAPIToken.
+func (c *kargoServiceClient) CreateAPIToken(ctx context.Context, req *connect.Request[v1alpha1.CreateAPITokenRequest]) (*connect.Response[v1alpha1.CreateAPITokenResponse], error) {
+	return c.createAPIToken.CallUnary(ctx, req)
+}
+
+// DeleteAPIToken calls akuity.io.kargo.service.v1alpha1.KargoService.DeleteAPIToken.
+func (c *kargoServiceClient) DeleteAPIToken(ctx context.Context, req *connect.Request[v1alpha1.DeleteAPITokenRequest]) (*connect.Response[v1alpha1.DeleteAPITokenResponse], error) {
+	return c.deleteAPIToken.CallUnary(ctx, req)
+}
+
+// GetAPIToken calls akuity.io.kargo.service.v1alpha1.KargoService.GetAPIToken.
+func (c *kargoServiceClient) GetAPIToken(ctx context.Context, req *connect.Request[v1alpha1.GetAPITokenRequest]) (*connect.Response[v1alpha1.GetAPITokenResponse], error) {
+	return c.getAPIToken.CallUnary(ctx, req)
+}
+
+// ListAPITokens calls akuity.io.kargo.service.v1alpha1.KargoService.ListAPITokens.
+func (c *kargoServiceClient) ListAPITokens(ctx context.Context, req *connect.Request[v1alpha1.ListAPITokensRequest]) (*connect.Response[v1alpha1.ListAPITokensResponse], error) {
+	return c.listAPITokens.CallUnary(ctx, req)
+}
+
 // ListClusterSecrets calls akuity.io.kargo.service.v1alpha1.KargoService.ListClusterSecrets.
 func (c *kargoServiceClient) ListClusterSecrets(ctx context.Context, req *connect.Request[v1alpha1.ListClusterSecretsRequest]) (*connect.Response[v1alpha1.ListClusterSecretsResponse], error) {
 	return c.listClusterSecrets.CallUnary(ctx, req)
@@ -1546,6 +1710,10 @@ type KargoServiceHandler interface {
 	WatchPromotion(context.Context, *connect.Request[v1alpha1.WatchPromotionRequest], *connect.ServerStream[v1alpha1.WatchPromotionResponse]) error
 	// AbortPromotion cancels a running promotion process.
 	AbortPromotion(context.Context, *connect.Request[v1alpha1.AbortPromotionRequest]) (*connect.Response[v1alpha1.AbortPromotionResponse], error)
+	// GetPromotionLogs streams logs captured during the execution of a promotion's steps.
+	GetPromotionLogs(context.Context, *connect.Request[v1alpha1.GetPromotionLogsRequest], *connect.ServerStream[v1alpha1.GetPromotionLogsResponse]) error
+	// ResumePromotion creates a promotion which resumes a failed or errored promotion.
+	ResumePromotion(context.Context, *connect.Request[v1alpha1.ResumePromotionRequest]) (*connect.Response[v1alpha1.ResumePromotionResponse], error)
 	// DeleteProject removes a project and all associated resources.
 	DeleteProject(context.Context, *connect.Request[v1alpha1.DeleteProjectRequest]) (*connect.Response[v1alpha1.DeleteProjectResponse], error)
 	// GetProject retrieves details of a specific project.
@@ -1560,12 +1728,18 @@ type KargoServiceHandler interface {
 	WatchProjectConfig(context.Context, *connect.Request[v1alpha1.WatchProjectConfigRequest], *connect.ServerStream[v1alpha1.WatchProjectConfigResponse]) error
 	// RefreshProjectConfig triggers a refresh of project configuration.
 	RefreshProjectConfig(context.Context, *connect.Request[v1alpha1.RefreshProjectConfigRequest]) (*connect.Response[v1alpha1.RefreshProjectConfigResponse], error)
+	// GetPromotionWaves retrieves the progress of freight through the promotion waves defined in project-level configuration.
+	GetPromotionWaves(context.Context, *connect.Request[v1alpha1.GetPromotionWavesRequest]) (*connect.Response[v1alpha1.GetPromotionWavesResponse], error)
 	// ApproveFreight marks freight as approved for promotion to a specific stage.
 	ApproveFreight(context.Context, *connect.Request[v1alpha1.ApproveFreightRequest]) (*connect.Response[v1alpha1.ApproveFreightResponse], error)
+	// CreateFreight creates freight from specific artifacts subscribed to by a warehouse, resolving their details through artifact discovery.
+	CreateFreight(context.Context, *connect.Request[v1alpha1.CreateFreightRequest]) (*connect.Response[v1alpha1.CreateFreightResponse], error)
 	// DeleteFreight removes freight from the system.
 	DeleteFreight(context.Context, *connect.Request[v1alpha1.DeleteFreightRequest]) (*connect.Response[v1alpha1.DeleteFreightResponse], error)
 	// GetFreight retrieves details of specific freight.
 	GetFreight(context.Context, *connect.Request[v1alpha1.GetFreightRequest]) (*connect.Response[v1alpha1.GetFreightResponse], error)
+	// GetFreightLineage retrieves the lineage of specific freight, from the artifacts it references to every stage it has been through.
+	GetFreightLineage(context.Context, *connect.Request[v1alpha1.GetFreightLineageRequest]) (*connect.Response[v1alpha1.GetFreightLineageResponse], error)
 	// WatchFreight provides streaming updates for freight changes.
 	WatchFreight(context.Context, *connect.Request[v1alpha1.WatchFreightRequest], *connect.ServerStream[v1alpha1.WatchFreightResponse]) error
 	// PromoteToStage initiates a promotion of freight to a specific stage.
@@ -1652,6 +1826,14 @@ type KargoServiceHandler interface {
 	Revoke(context.Context, *connect.Request[v1alpha1.RevokeRequest]) (*connect.Response[v1alpha1.RevokeResponse], error)
 	// UpdateRole modifies an existing RBAC role.
 	UpdateRole(context.Context, *connect.Request[v1alpha1.UpdateRoleRequest]) (*connect.Response[v1alpha1.UpdateRoleResponse], error)
+	// CreateAPIToken creates a new API token bound to an RBAC role within a project.
+	CreateAPIToken(context.Context, *connect.Request[v1alpha1.CreateAPITokenRequest]) (*connect.Response[v1alpha1.CreateAPITokenResponse], error)
+	// DeleteAPIToken revokes an API token by removing it from a project.
+	DeleteAPIToken(context.Context, *connect.Request[v1alpha1.DeleteAPITokenRequest]) (*connect.Response[v1alpha1.DeleteAPITokenResponse], error)
+	// GetAPIToken retrieves details of a specific API token.
+	GetAPIToken(context.Context, *connect.Request[v1alpha1.GetAPITokenRequest]) (*connect.Response[v1alpha1.GetAPITokenResponse], error)
+	// ListAPITokens retrieves all API tokens within a project.
+	ListAPITokens(context.Context, *connect.Request[v1alpha1.ListAPITokensRequest]) (*connect.Response[v1alpha1.ListAPITokensResponse], error)
 	// ListClusterSecrets retrieves all cluster-scoped secrets.
 	ListClusterSecrets(context.Context, *connect.Request[v1alpha1.ListClusterSecretsRequest]) (*connect.Response[v1alpha1.ListClusterSecretsResponse], error)
 	// CreateClusterSecret creates a new cluster-scoped secret.
@@ -1806,6 +1988,18 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(kargoServiceAbortPromotionMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	kargoServiceGetPromotionLogsHandler := connect.NewServerStreamHandler(
+		KargoServiceGetPromotionLogsProcedure,
+		svc.GetPromotionLogs,
+		connect.WithSchema(kargoServiceGetPromotionLogsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	kargoServiceResumePromotionHandler := connect.NewUnaryHandler(
+		KargoServiceResumePromotionProcedure,
+		svc.ResumePromotion,
+		connect.WithSchema(kargoServiceResumePromotionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	kargoServiceDeleteProjectHandler := connect.NewUnaryHandler(
 		KargoServiceDeleteProjectProcedure,
 		svc.DeleteProject,
@@ -1848,12 +2042,24 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(kargoServiceRefreshProjectConfigMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	kargoServiceGetPromotionWavesHandler := connect.NewUnaryHandler(
+		KargoServiceGetPromotionWavesProcedure,
+		svc.GetPromotionWaves,
+		connect.WithSchema(kargoServiceGetPromotionWavesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	kargoServiceApproveFreightHandler := connect.NewUnaryHandler(
 		KargoServiceApproveFreightProcedure,
 		svc.ApproveFreight,
 		connect.WithSchema(kargoServiceApproveFreightMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	kargoServiceCreateFreightHandler := connect.NewUnaryHandler(
+		KargoServiceCreateFreightProcedure,
+		svc.CreateFreight,
+		connect.WithSchema(kargoServiceCreateFreightMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	kargoServiceDeleteFreightHandler := connect.NewUnaryHandler(
 		KargoServiceDeleteFreightProcedure,
 		svc.DeleteFreight,
@@ -1866,6 +2072,12 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(kargoServiceGetFreightMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	kargoServiceGetFreightLineageHandler := connect.NewUnaryHandler(
+		KargoServiceGetFreightLineageProcedure,
+		svc.GetFreightLineage,
+		connect.WithSchema(kargoServiceGetFreightLineageMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	kargoServiceWatchFreightHandler := connect.NewServerStreamHandler(
 		KargoServiceWatchFreightProcedure,
 		svc.WatchFreight,
@@ -2124,6 +2336,30 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(kargoServiceUpdateRoleMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	kargoServiceCreateAPITokenHandler := connect.NewUnaryHandler(
+		KargoServiceCreateAPITokenProcedure,
+		svc.CreateAPIToken,
+		connect.WithSchema(kargoServiceCreateAPITokenMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	kargoServiceDeleteAPITokenHandler := connect.NewUnaryHandler(
+		KargoServiceDeleteAPITokenProcedure,
+		svc.DeleteAPIToken,
+		connect.WithSchema(kargoServiceDeleteAPITokenMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	kargoServiceGetAPITokenHandler := connect.NewUnaryHandler(
+		KargoServiceGetAPITokenProcedure,
+		svc.GetAPIToken,
+		connect.WithSchema(kargoServiceGetAPITokenMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	kargoServiceListAPITokensHandler := connect.NewUnaryHandler(
+		KargoServiceListAPITokensProcedure,
+		svc.ListAPITokens,
+		connect.WithSchema(kargoServiceListAPITokensMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	kargoServiceListClusterSecretsHandler := connect.NewUnaryHandler(
 		KargoServiceListClusterSecretsProcedure,
 		svc.ListClusterSecrets,
@@ -2196,6 +2432,10 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 			kargoServiceWatchPromotionHandler.ServeHTTP(w, r)
 		case KargoServiceAbortPromotionProcedure:
 			kargoServiceAbortPromotionHandler.ServeHTTP(w, r)
+		case KargoServiceGetPromotionLogsProcedure:
+			kargoServiceGetPromotionLogsHandler.ServeHTTP(w, r)
+		case KargoServiceResumePromotionProcedure:
+			kargoServiceResumePromotionHandler.ServeHTTP(w, r)
 		case KargoServiceDeleteProjectProcedure:
 			kargoServiceDeleteProjectHandler.ServeHTTP(w, r)
 		case KargoServiceGetProjectProcedure:
@@ -2210,12 +2450,18 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 			kargoServiceWatchProjectConfigHandler.ServeHTTP(w, r)
 		case KargoServiceRefreshProjectConfigProcedure:
 			kargoServiceRefreshProjectConfigHandler.ServeHTTP(w, r)
+		case KargoServiceGetPromotionWavesProcedure:
+			kargoServiceGetPromotionWavesHandler.ServeHTTP(w, r)
 		case KargoServiceApproveFreightProcedure:
 			kargoServiceApproveFreightHandler.ServeHTTP(w, r)
+		case KargoServiceCreateFreightProcedure:
+			kargoServiceCreateFreightHandler.ServeHTTP(w, r)
 		case KargoServiceDeleteFreightProcedure:
 			kargoServiceDeleteFreightHandler.ServeHTTP(w, r)
 		case KargoServiceGetFreightProcedure:
 			kargoServiceGetFreightHandler.ServeHTTP(w, r)
+		case KargoServiceGetFreightLineageProcedure:
+			kargoServiceGetFreightLineageHandler.ServeHTTP(w, r)
 		case KargoServiceWatchFreightProcedure:
 			kargoServiceWatchFreightHandler.ServeHTTP(w, r)
 		case KargoServicePromoteToStageProcedure:
@@ -2302,6 +2548,14 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 			kargoServiceRevokeHandler.ServeHTTP(w, r)
 		case KargoServiceUpdateRoleProcedure:
 			kargoServiceUpdateRoleHandler.ServeHTTP(w, r)
+		case KargoServiceCreateAPITokenProcedure:
+			kargoServiceCreateAPITokenHandler.ServeHTTP(w, r)
+		case KargoServiceDeleteAPITokenProcedure:
+			kargoServiceDeleteAPITokenHandler.ServeHTTP(w, r)
+		case KargoServiceGetAPITokenProcedure:
+			kargoServiceGetAPITokenHandler.ServeHTTP(w, r)
+		case KargoServiceListAPITokensProcedure:
+			kargoServiceListAPITokensHandler.ServeHTTP(w, r)
 		case KargoServiceListClusterSecretsProcedure:
 			kargoServiceListClusterSecretsHandler.ServeHTTP(w, r)
 		case KargoServiceCreateClusterSecretProcedure:
@@ -2411,6 +2665,14 @@ func (UnimplementedKargoServiceHandler) AbortPromotion(context.Context, *connect
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.AbortPromotion is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) GetPromotionLogs(context.Context, *connect.Request[v1alpha1.GetPromotionLogsRequest], *connect.ServerStream[v1alpha1.GetPromotionLogsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.GetPromotionLogs is not implemented"))
+}
+
+func (UnimplementedKargoServiceHandler) ResumePromotion(context.Context, *connect.Request[v1alpha1.ResumePromotionRequest]) (*connect.Response[v1alpha1.ResumePromotionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.ResumePromotion is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) DeleteProject(context.Context, *connect.Request[v1alpha1.DeleteProjectRequest]) (*connect.Response[v1alpha1.DeleteProjectResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.DeleteProject is not implemented"))
 }
@@ -2439,10 +2701,18 @@ func (UnimplementedKargoServiceHandler) RefreshProjectConfig(context.Context, *c
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.RefreshProjectConfig is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) GetPromotionWaves(context.Context, *connect.Request[v1alpha1.GetPromotionWavesRequest]) (*connect.Response[v1alpha1.GetPromotionWavesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.GetPromotionWaves is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) ApproveFreight(context.Context, *connect.Request[v1alpha1.ApproveFreightRequest]) (*connect.Response[v1alpha1.ApproveFreightResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.ApproveFreight is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) CreateFreight(context.Context, *connect.Request[v1alpha1.CreateFreightRequest]) (*connect.Response[v1alpha1.CreateFreightResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.CreateFreight is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) DeleteFreight(context.Context, *connect.Request[v1alpha1.DeleteFreightRequest]) (*connect.Response[v1alpha1.DeleteFreightResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.DeleteFreight is not implemented"))
 }
@@ -2451,6 +2721,10 @@ func (UnimplementedKargoServiceHandler) GetFreight(context.Context, *connect.Req
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.GetFreight is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) GetFreightLineage(context.Context, *connect.Request[v1alpha1.GetFreightLineageRequest]) (*connect.Response[v1alpha1.GetFreightLineageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.GetFreightLineage is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) WatchFreight(context.Context, *connect.Request[v1alpha1.WatchFreightRequest], *connect.ServerStream[v1alpha1.WatchFreightResponse]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.WatchFreight is not implemented"))
 }
@@ -2623,6 +2897,22 @@ func (UnimplementedKargoServiceHandler) UpdateRole(context.Context, *connect.Req
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.UpdateRole is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) CreateAPIToken(context.Context, *connect.Request[v1alpha1.CreateAPITokenRequest]) (*connect.Response[v1alpha1.CreateAPITokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.CreateAPIToken is not implemented"))
+}
+
+func (UnimplementedKargoServiceHandler) DeleteAPIToken(context.Context, *connect.Request[v1alpha1.DeleteAPITokenRequest]) (*connect.Response[v1alpha1.DeleteAPITokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.DeleteAPIToken is not implemented"))
+}
+
+func (UnimplementedKargoServiceHandler) GetAPIToken(context.Context, *connect.Request[v1alpha1.GetAPITokenRequest]) (*connect.Response[v1alpha1.GetAPITokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.GetAPIToken is not implemented"))
+}
+
+func (UnimplementedKargoServiceHandler) ListAPITokens(context.Context, *connect.Request[v1alpha1.ListAPITokensRequest]) (*connect.Response[v1alpha1.ListAPITokensResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.ListAPITokens is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) ListClusterSecrets(context.Context, *connect.Request[v1alpha1.ListClusterSecretsRequest]) (*connect.Response[v1alpha1.ListClusterSecretsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.ListClusterSecrets is not implemented"))
 }
@@ -1,6 +1,10 @@
 package v1alpha1
 
-import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+import (
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
 
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Cluster,shortName={clusterconfig,clusterconfigs}
@@ -29,6 +33,39 @@ type ClusterConfigSpec struct {
 	// WebhookReceivers describes cluster-scoped webhook receivers used for
 	// processing events from various external platforms
 	WebhookReceivers []WebhookReceiverConfig `json:"webhookReceivers,omitempty" protobuf:"bytes,1,rep,name=webhookReceivers"`
+	// Agents describes the agents that execute the steps of Promotions
+	// out-of-process and the Projects each of them serves. A Stage may only
+	// specify an agent that serves the Stage's Project.
+	//
+	// +listType=map
+	// +listMapKey=name
+	Agents []AgentConfig `json:"agents,omitempty" protobuf:"bytes,2,rep,name=agents"`
+}
+
+// AgentConfig describes the Projects an agent serves.
+type AgentConfig struct {
+	// Name is the name of the agent.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Projects is the list of Projects the agent serves. Stages in other
+	// Projects may not specify the agent and steps of Promotions in other
+	// Projects are never dispatched to it.
+	//
+	// +kubebuilder:validation:MinItems=1
+	Projects []string `json:"projects" protobuf:"bytes,2,rep,name=projects"`
+}
+
+// ServesProject returns true if the agent with the provided name serves the
+// Project with the provided name.
+func (c *ClusterConfigSpec) ServesProject(agent, project string) bool {
+	for _, a := range c.Agents {
+		if a.Name == agent {
+			return slices.Contains(a.Projects, project)
+		}
+	}
+	return false
 }
 
 // ClusterConfigStatus describes the current status of a ClusterConfig.
@@ -0,0 +1,28 @@
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClusterConfigSpec_ServesProject(t *testing.T) {
+	spec := &ClusterConfigSpec{
+		Agents: []AgentConfig{
+			{
+				Name:     "fake-agent",
+				Projects: []string{"fake-project", "other-project"},
+			},
+			{
+				Name:     "other-agent",
+				Projects: []string{"third-project"},
+			},
+		},
+	}
+	require.True(t, spec.ServesProject("fake-agent", "fake-project"))
+	require.True(t, spec.ServesProject("fake-agent", "other-project"))
+	require.False(t, spec.ServesProject("fake-agent", "third-project"))
+	require.True(t, spec.ServesProject("other-agent", "third-project"))
+	require.False(t, spec.ServesProject("unknown-agent", "fake-project"))
+	require.False(t, (&ClusterConfigSpec{}).ServesProject("fake-agent", "fake-project"))
+}
@@ -101,4 +101,16 @@ const (
 	// the criteria have been satisfied, and the absence of the condition or
 	// a status of "False" indicates that no new Freight was created.
 	ConditionTypeFreightCreated = "FreightCreated"
+
+	// ConditionTypeAutoPromotionConditionSatisfied denotes that no Freight
+	// eligible for auto-promotion to a Stage was held back by an auto-promotion
+	// condition.
+	//
+	// This is a "normal-true" or "positive polarity" condition, meaning that
+	// the presence of the condition with a status of "True" indicates that no
+	// Freight was held back, and a status of "False" indicates that at least
+	// one Freight did not satisfy the condition (or that the condition could
+	// not be evaluated). The condition is absent if no auto-promotion condition
+	// is configured.
+	ConditionTypeAutoPromotionConditionSatisfied = "AutoPromotionConditionSatisfied"
 )
@@ -41,6 +41,7 @@ const (
 	EventActorEmailPrefix          = "email:"
 	EventActorSubjectPrefix        = "subject:"
 	EventActorKubernetesUserPrefix = "kubernetes:"
+	EventActorAPITokenPrefix       = "api-token:"
 	EventActorUnknown              = "unknown actor"
 )
 
@@ -144,6 +144,13 @@ func (f *Freight) IsApprovedFor(stage string) bool {
 	return approved
 }
 
+// IsStaleAllowedFor returns whether the Freight has been approved for the
+// specified Stage with explicit permission to promote it to that Stage even if
+// it is stale.
+func (f *Freight) IsStaleAllowedFor(stage string) bool {
+	return f.Status.ApprovedFor[stage].AllowStale
+}
+
 // GetLongestSoak returns the longest soak time for the Freight in the specified
 // Stage if it's been verified in that Stage. If it has not, zero will be
 // returned instead. If the Freight is currently in use by the specified Stage,
@@ -177,6 +184,46 @@ func (f *Freight) HasSoakedIn(stage string, dur *metav1.Duration) bool {
 	return f.GetLongestSoak(stage) >= dur.Duration
 }
 
+// FreightStalenessPolicy describes when Freight becomes stale. Stale Freight
+// is not available for promotion, unless it has been manually approved for the
+// Stage it is to be promoted to.
+type FreightStalenessPolicy struct {
+	// MaxAge specifies the maximum age of Freight. Freight created longer ago
+	// than this is stale. This is an optional field. If nil or zero, Freight
+	// does not become stale because of its age.
+	//
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(s|m|h))+$`
+	// +akuity:test-kubebuilder-pattern=Duration
+	MaxAge *metav1.Duration `json:"maxAge,omitempty" protobuf:"bytes,1,opt,name=maxAge"`
+	// SupersededBy specifies the number of newer pieces of Freight from the
+	// same origin by which Freight must be superseded to be stale. This is an
+	// optional field. If zero, Freight does not become stale because newer
+	// Freight exists.
+	//
+	// +kubebuilder:validation:Minimum=0
+	SupersededBy int32 `json:"supersededBy,omitempty" protobuf:"varint,2,opt,name=supersededBy"`
+}
+
+// StaleReason returns the reason the specified Freight is stale according to
+// the policy, or an empty string if it is not stale. The newer argument is the
+// number of pieces of Freight from the same origin that were created after the
+// specified Freight.
+func (p *FreightStalenessPolicy) StaleReason(f *Freight, newer int) string {
+	if p == nil || f == nil {
+		return ""
+	}
+	if p.MaxAge != nil && p.MaxAge.Duration > 0 {
+		if age := time.Since(f.CreationTimestamp.Time); age > p.MaxAge.Duration {
+			return fmt.Sprintf("Freight is older than %s", p.MaxAge.Duration)
+		}
+	}
+	if p.SupersededBy > 0 && newer >= int(p.SupersededBy) {
+		return fmt.Sprintf("Freight is superseded by %d newer Freight", newer)
+	}
+	return ""
+}
+
 // AddCurrentStage updates the Freight status to reflect that the Freight is
 // currently in the specified Stage.
 func (f *FreightStatus) AddCurrentStage(stage string, since time.Time) {
@@ -233,6 +280,16 @@ func (f *FreightStatus) AddApprovedStage(stage string, approvedAt time.Time) {
 	}
 }
 
+// AllowStaleFor updates the Freight status to reflect that the Freight has
+// been approved for the specified Stage and may be promoted to it even if it
+// is stale.
+func (f *FreightStatus) AllowStaleFor(stage string, approvedAt time.Time) {
+	f.AddApprovedStage(stage, approvedAt)
+	record := f.ApprovedFor[stage]
+	record.AllowStale = true
+	f.ApprovedFor[stage] = record
+}
+
 // UpsertMetadata inserts or updates the given key in Freight status Metadata
 func (f *FreightStatus) UpsertMetadata(key string, data any) error {
 	if len(f.Metadata) == 0 {
@@ -292,6 +349,11 @@ type VerifiedStage struct {
 type ApprovedStage struct {
 	// ApprovedAt is the time at which the Freight was approved for the Stage.
 	ApprovedAt *metav1.Time `json:"approvedAt,omitempty" protobuf:"bytes,1,opt,name=approvedAt"`
+	// AllowStale indicates whether the Freight may be promoted to the Stage even
+	// if it is stale according to the staleness policy that applies to it. This
+	// is only the case if it was explicitly requested when the Freight was
+	// approved.
+	AllowStale bool `json:"allowStale,omitempty" protobuf:"varint,2,opt,name=allowStale"`
 }
 
 // +kubebuilder:object:root=true
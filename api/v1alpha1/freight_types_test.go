@@ -247,6 +247,16 @@ func TestFreight_IsApprovedFor(t *testing.T) {
 	require.True(t, freight.IsApprovedFor(testStage))
 }
 
+func TestFreight_IsStaleAllowedFor(t *testing.T) {
+	const testStage = "fake-stage"
+	freight := &Freight{}
+	require.False(t, freight.IsStaleAllowedFor(testStage))
+	freight.Status.ApprovedFor = map[string]ApprovedStage{testStage: {}}
+	require.False(t, freight.IsStaleAllowedFor(testStage))
+	freight.Status.ApprovedFor[testStage] = ApprovedStage{AllowStale: true}
+	require.True(t, freight.IsStaleAllowedFor(testStage))
+}
+
 func TestFreight_GetLongestSoak(t *testing.T) {
 	testStage := "fake-stage"
 	testCases := []struct {
@@ -327,6 +337,62 @@ func TestFreight_GetLongestSoak(t *testing.T) {
 	}
 }
 
+func TestFreightStalenessPolicy_StaleReason(t *testing.T) {
+	freight := &Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-2 * time.Hour)),
+		},
+	}
+	testCases := []struct {
+		name     string
+		policy   *FreightStalenessPolicy
+		newer    int
+		expected string
+	}{
+		{
+			name: "nil policy",
+		},
+		{
+			name:   "empty policy",
+			policy: &FreightStalenessPolicy{},
+			newer:  10,
+		},
+		{
+			name: "Freight is younger than max age",
+			policy: &FreightStalenessPolicy{
+				MaxAge: &metav1.Duration{Duration: 3 * time.Hour},
+			},
+		},
+		{
+			name: "Freight is older than max age",
+			policy: &FreightStalenessPolicy{
+				MaxAge: &metav1.Duration{Duration: time.Hour},
+			},
+			expected: "Freight is older than 1h0m0s",
+		},
+		{
+			name:   "Freight is superseded by fewer newer Freight than allowed",
+			policy: &FreightStalenessPolicy{SupersededBy: 3},
+			newer:  2,
+		},
+		{
+			name:     "Freight is superseded by enough newer Freight",
+			policy:   &FreightStalenessPolicy{SupersededBy: 3},
+			newer:    3,
+			expected: "Freight is superseded by 3 newer Freight",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				testCase.policy.StaleReason(freight, testCase.newer),
+			)
+		})
+	}
+}
+
 func TestFreightStatus_AddCurrentStage(t *testing.T) {
 	const testStage = "fake-stage"
 	now := time.Now()
@@ -468,6 +534,32 @@ func TestFreightStatus_AddApprovedStage(t *testing.T) {
 	})
 }
 
+func TestFreightStatus_AllowStaleFor(t *testing.T) {
+	const testStage = "fake-stage"
+	now := time.Now()
+	t.Run("already approved", func(t *testing.T) {
+		oldTime := now.Add(-time.Hour)
+		status := FreightStatus{
+			ApprovedFor: map[string]ApprovedStage{
+				testStage: {ApprovedAt: &metav1.Time{Time: oldTime}},
+			},
+		}
+		status.AllowStaleFor(testStage, now)
+		record, approved := status.ApprovedFor[testStage]
+		require.True(t, approved)
+		require.Equal(t, oldTime, record.ApprovedAt.Time)
+		require.True(t, record.AllowStale)
+	})
+	t.Run("not already approved", func(t *testing.T) {
+		status := FreightStatus{}
+		status.AllowStaleFor(testStage, now)
+		record, approved := status.ApprovedFor[testStage]
+		require.True(t, approved)
+		require.Equal(t, now, record.ApprovedAt.Time)
+		require.True(t, record.AllowStale)
+	})
+}
+
 func TestFreightStatus_UpsertMetadata(t *testing.T) {
 	testCases := []struct {
 		name         string
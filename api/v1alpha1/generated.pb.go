@@ -30,10 +30,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+func (m *AgentConfig) Reset()      { *m = AgentConfig{} }
+func (*AgentConfig) ProtoMessage() {}
+func (*AgentConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{0}
+}
+func (m *AgentConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AgentConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AgentConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AgentConfig.Merge(m, src)
+}
+func (m *AgentConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *AgentConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_AgentConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AgentConfig proto.InternalMessageInfo
+
 func (m *AnalysisRunArgument) Reset()      { *m = AnalysisRunArgument{} }
 func (*AnalysisRunArgument) ProtoMessage() {}
 func (*AnalysisRunArgument) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{0}
+	return fileDescriptor_e26b7f7bbc391025, []int{1}
 }
 func (m *AnalysisRunArgument) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -61,7 +89,7 @@ var xxx_messageInfo_AnalysisRunArgument proto.InternalMessageInfo
 func (m *AnalysisRunMetadata) Reset()      { *m = AnalysisRunMetadata{} }
 func (*AnalysisRunMetadata) ProtoMessage() {}
 func (*AnalysisRunMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{1}
+	return fileDescriptor_e26b7f7bbc391025, []int{2}
 }
 func (m *AnalysisRunMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -89,7 +117,7 @@ var xxx_messageInfo_AnalysisRunMetadata proto.InternalMessageInfo
 func (m *AnalysisRunReference) Reset()      { *m = AnalysisRunReference{} }
 func (*AnalysisRunReference) ProtoMessage() {}
 func (*AnalysisRunReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{2}
+	return fileDescriptor_e26b7f7bbc391025, []int{3}
 }
 func (m *AnalysisRunReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -117,7 +145,7 @@ var xxx_messageInfo_AnalysisRunReference proto.InternalMessageInfo
 func (m *AnalysisTemplateReference) Reset()      { *m = AnalysisTemplateReference{} }
 func (*AnalysisTemplateReference) ProtoMessage() {}
 func (*AnalysisTemplateReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{3}
+	return fileDescriptor_e26b7f7bbc391025, []int{4}
 }
 func (m *AnalysisTemplateReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -145,7 +173,7 @@ var xxx_messageInfo_AnalysisTemplateReference proto.InternalMessageInfo
 func (m *ApprovedStage) Reset()      { *m = ApprovedStage{} }
 func (*ApprovedStage) ProtoMessage() {}
 func (*ApprovedStage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{4}
+	return fileDescriptor_e26b7f7bbc391025, []int{5}
 }
 func (m *ApprovedStage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -173,7 +201,7 @@ var xxx_messageInfo_ApprovedStage proto.InternalMessageInfo
 func (m *ArgoCDAppHealthStatus) Reset()      { *m = ArgoCDAppHealthStatus{} }
 func (*ArgoCDAppHealthStatus) ProtoMessage() {}
 func (*ArgoCDAppHealthStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{5}
+	return fileDescriptor_e26b7f7bbc391025, []int{6}
 }
 func (m *ArgoCDAppHealthStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -201,7 +229,7 @@ var xxx_messageInfo_ArgoCDAppHealthStatus proto.InternalMessageInfo
 func (m *ArgoCDAppStatus) Reset()      { *m = ArgoCDAppStatus{} }
 func (*ArgoCDAppStatus) ProtoMessage() {}
 func (*ArgoCDAppStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{6}
+	return fileDescriptor_e26b7f7bbc391025, []int{7}
 }
 func (m *ArgoCDAppStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -229,7 +257,7 @@ var xxx_messageInfo_ArgoCDAppStatus proto.InternalMessageInfo
 func (m *ArgoCDAppSyncStatus) Reset()      { *m = ArgoCDAppSyncStatus{} }
 func (*ArgoCDAppSyncStatus) ProtoMessage() {}
 func (*ArgoCDAppSyncStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{7}
+	return fileDescriptor_e26b7f7bbc391025, []int{8}
 }
 func (m *ArgoCDAppSyncStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -257,7 +285,7 @@ var xxx_messageInfo_ArgoCDAppSyncStatus proto.InternalMessageInfo
 func (m *ArtifactoryWebhookReceiverConfig) Reset()      { *m = ArtifactoryWebhookReceiverConfig{} }
 func (*ArtifactoryWebhookReceiverConfig) ProtoMessage() {}
 func (*ArtifactoryWebhookReceiverConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{8}
+	return fileDescriptor_e26b7f7bbc391025, []int{9}
 }
 func (m *ArtifactoryWebhookReceiverConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -285,7 +313,7 @@ var xxx_messageInfo_ArtifactoryWebhookReceiverConfig proto.InternalMessageInfo
 func (m *AutoPromotionOptions) Reset()      { *m = AutoPromotionOptions{} }
 func (*AutoPromotionOptions) ProtoMessage() {}
 func (*AutoPromotionOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{9}
+	return fileDescriptor_e26b7f7bbc391025, []int{10}
 }
 func (m *AutoPromotionOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -313,7 +341,7 @@ var xxx_messageInfo_AutoPromotionOptions proto.InternalMessageInfo
 func (m *AzureWebhookReceiverConfig) Reset()      { *m = AzureWebhookReceiverConfig{} }
 func (*AzureWebhookReceiverConfig) ProtoMessage() {}
 func (*AzureWebhookReceiverConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{10}
+	return fileDescriptor_e26b7f7bbc391025, []int{11}
 }
 func (m *AzureWebhookReceiverConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -341,7 +369,7 @@ var xxx_messageInfo_AzureWebhookReceiverConfig proto.InternalMessageInfo
 func (m *BitbucketWebhookReceiverConfig) Reset()      { *m = BitbucketWebhookReceiverConfig{} }
 func (*BitbucketWebhookReceiverConfig) ProtoMessage() {}
 func (*BitbucketWebhookReceiverConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{11}
+	return fileDescriptor_e26b7f7bbc391025, []int{12}
 }
 func (m *BitbucketWebhookReceiverConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -369,7 +397,7 @@ var xxx_messageInfo_BitbucketWebhookReceiverConfig proto.InternalMessageInfo
 func (m *Chart) Reset()      { *m = Chart{} }
 func (*Chart) ProtoMessage() {}
 func (*Chart) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{12}
+	return fileDescriptor_e26b7f7bbc391025, []int{13}
 }
 func (m *Chart) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -397,7 +425,7 @@ var xxx_messageInfo_Chart proto.InternalMessageInfo
 func (m *ChartDiscoveryResult) Reset()      { *m = ChartDiscoveryResult{} }
 func (*ChartDiscoveryResult) ProtoMessage() {}
 func (*ChartDiscoveryResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{13}
+	return fileDescriptor_e26b7f7bbc391025, []int{14}
 }
 func (m *ChartDiscoveryResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -425,7 +453,7 @@ var xxx_messageInfo_ChartDiscoveryResult proto.InternalMessageInfo
 func (m *ChartSubscription) Reset()      { *m = ChartSubscription{} }
 func (*ChartSubscription) ProtoMessage() {}
 func (*ChartSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{14}
+	return fileDescriptor_e26b7f7bbc391025, []int{15}
 }
 func (m *ChartSubscription) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -453,7 +481,7 @@ var xxx_messageInfo_ChartSubscription proto.InternalMessageInfo
 func (m *ClusterConfig) Reset()      { *m = ClusterConfig{} }
 func (*ClusterConfig) ProtoMessage() {}
 func (*ClusterConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{15}
+	return fileDescriptor_e26b7f7bbc391025, []int{16}
 }
 func (m *ClusterConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -481,7 +509,7 @@ var xxx_messageInfo_ClusterConfig proto.InternalMessageInfo
 func (m *ClusterConfigList) Reset()      { *m = ClusterConfigList{} }
 func (*ClusterConfigList) ProtoMessage() {}
 func (*ClusterConfigList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{16}
+	return fileDescriptor_e26b7f7bbc391025, []int{17}
 }
 func (m *ClusterConfigList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -509,7 +537,7 @@ var xxx_messageInfo_ClusterConfigList proto.InternalMessageInfo
 func (m *ClusterConfigSpec) Reset()      { *m = ClusterConfigSpec{} }
 func (*ClusterConfigSpec) ProtoMessage() {}
 func (*ClusterConfigSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{17}
+	return fileDescriptor_e26b7f7bbc391025, []int{18}
 }
 func (m *ClusterConfigSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -537,7 +565,7 @@ var xxx_messageInfo_ClusterConfigSpec proto.InternalMessageInfo
 func (m *ClusterConfigStatus) Reset()      { *m = ClusterConfigStatus{} }
 func (*ClusterConfigStatus) ProtoMessage() {}
 func (*ClusterConfigStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{18}
+	return fileDescriptor_e26b7f7bbc391025, []int{19}
 }
 func (m *ClusterConfigStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -565,7 +593,7 @@ var xxx_messageInfo_ClusterConfigStatus proto.InternalMessageInfo
 func (m *ClusterPromotionTask) Reset()      { *m = ClusterPromotionTask{} }
 func (*ClusterPromotionTask) ProtoMessage() {}
 func (*ClusterPromotionTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{19}
+	return fileDescriptor_e26b7f7bbc391025, []int{20}
 }
 func (m *ClusterPromotionTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -593,7 +621,7 @@ var xxx_messageInfo_ClusterPromotionTask proto.InternalMessageInfo
 func (m *ClusterPromotionTaskList) Reset()      { *m = ClusterPromotionTaskList{} }
 func (*ClusterPromotionTaskList) ProtoMessage() {}
 func (*ClusterPromotionTaskList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{20}
+	return fileDescriptor_e26b7f7bbc391025, []int{21}
 }
 func (m *ClusterPromotionTaskList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -618,10 +646,38 @@ func (m *ClusterPromotionTaskList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClusterPromotionTaskList proto.InternalMessageInfo
 
+func (m *ConcurrencyGroup) Reset()      { *m = ConcurrencyGroup{} }
+func (*ConcurrencyGroup) ProtoMessage() {}
+func (*ConcurrencyGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{22}
+}
+func (m *ConcurrencyGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConcurrencyGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ConcurrencyGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConcurrencyGroup.Merge(m, src)
+}
+func (m *ConcurrencyGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConcurrencyGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConcurrencyGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConcurrencyGroup proto.InternalMessageInfo
+
 func (m *CurrentStage) Reset()      { *m = CurrentStage{} }
 func (*CurrentStage) ProtoMessage() {}
 func (*CurrentStage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{21}
+	return fileDescriptor_e26b7f7bbc391025, []int{23}
 }
 func (m *CurrentStage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -649,7 +705,7 @@ var xxx_messageInfo_CurrentStage proto.InternalMessageInfo
 func (m *DiscoveredArtifacts) Reset()      { *m = DiscoveredArtifacts{} }
 func (*DiscoveredArtifacts) ProtoMessage() {}
 func (*DiscoveredArtifacts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{22}
+	return fileDescriptor_e26b7f7bbc391025, []int{24}
 }
 func (m *DiscoveredArtifacts) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -677,7 +733,7 @@ var xxx_messageInfo_DiscoveredArtifacts proto.InternalMessageInfo
 func (m *DiscoveredCommit) Reset()      { *m = DiscoveredCommit{} }
 func (*DiscoveredCommit) ProtoMessage() {}
 func (*DiscoveredCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{23}
+	return fileDescriptor_e26b7f7bbc391025, []int{25}
 }
 func (m *DiscoveredCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -705,7 +761,7 @@ var xxx_messageInfo_DiscoveredCommit proto.InternalMessageInfo
 func (m *DiscoveredImageReference) Reset()      { *m = DiscoveredImageReference{} }
 func (*DiscoveredImageReference) ProtoMessage() {}
 func (*DiscoveredImageReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{24}
+	return fileDescriptor_e26b7f7bbc391025, []int{26}
 }
 func (m *DiscoveredImageReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -733,7 +789,7 @@ var xxx_messageInfo_DiscoveredImageReference proto.InternalMessageInfo
 func (m *DockerHubWebhookReceiverConfig) Reset()      { *m = DockerHubWebhookReceiverConfig{} }
 func (*DockerHubWebhookReceiverConfig) ProtoMessage() {}
 func (*DockerHubWebhookReceiverConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{25}
+	return fileDescriptor_e26b7f7bbc391025, []int{27}
 }
 func (m *DockerHubWebhookReceiverConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -761,7 +817,7 @@ var xxx_messageInfo_DockerHubWebhookReceiverConfig proto.InternalMessageInfo
 func (m *ExpressionVariable) Reset()      { *m = ExpressionVariable{} }
 func (*ExpressionVariable) ProtoMessage() {}
 func (*ExpressionVariable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{26}
+	return fileDescriptor_e26b7f7bbc391025, []int{28}
 }
 func (m *ExpressionVariable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -789,7 +845,7 @@ var xxx_messageInfo_ExpressionVariable proto.InternalMessageInfo
 func (m *Freight) Reset()      { *m = Freight{} }
 func (*Freight) ProtoMessage() {}
 func (*Freight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{27}
+	return fileDescriptor_e26b7f7bbc391025, []int{29}
 }
 func (m *Freight) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -817,7 +873,7 @@ var xxx_messageInfo_Freight proto.InternalMessageInfo
 func (m *FreightCollection) Reset()      { *m = FreightCollection{} }
 func (*FreightCollection) ProtoMessage() {}
 func (*FreightCollection) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{28}
+	return fileDescriptor_e26b7f7bbc391025, []int{30}
 }
 func (m *FreightCollection) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -845,7 +901,7 @@ var xxx_messageInfo_FreightCollection proto.InternalMessageInfo
 func (m *FreightCreationCriteria) Reset()      { *m = FreightCreationCriteria{} }
 func (*FreightCreationCriteria) ProtoMessage() {}
 func (*FreightCreationCriteria) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{29}
+	return fileDescriptor_e26b7f7bbc391025, []int{31}
 }
 func (m *FreightCreationCriteria) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -873,7 +929,7 @@ var xxx_messageInfo_FreightCreationCriteria proto.InternalMessageInfo
 func (m *FreightList) Reset()      { *m = FreightList{} }
 func (*FreightList) ProtoMessage() {}
 func (*FreightList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{30}
+	return fileDescriptor_e26b7f7bbc391025, []int{32}
 }
 func (m *FreightList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -901,7 +957,7 @@ var xxx_messageInfo_FreightList proto.InternalMessageInfo
 func (m *FreightOrigin) Reset()      { *m = FreightOrigin{} }
 func (*FreightOrigin) ProtoMessage() {}
 func (*FreightOrigin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{31}
+	return fileDescriptor_e26b7f7bbc391025, []int{33}
 }
 func (m *FreightOrigin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -929,7 +985,7 @@ var xxx_messageInfo_FreightOrigin proto.InternalMessageInfo
 func (m *FreightReference) Reset()      { *m = FreightReference{} }
 func (*FreightReference) ProtoMessage() {}
 func (*FreightReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{32}
+	return fileDescriptor_e26b7f7bbc391025, []int{34}
 }
 func (m *FreightReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -957,7 +1013,7 @@ var xxx_messageInfo_FreightReference proto.InternalMessageInfo
 func (m *FreightRequest) Reset()      { *m = FreightRequest{} }
 func (*FreightRequest) ProtoMessage() {}
 func (*FreightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{33}
+	return fileDescriptor_e26b7f7bbc391025, []int{35}
 }
 func (m *FreightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -985,7 +1041,7 @@ var xxx_messageInfo_FreightRequest proto.InternalMessageInfo
 func (m *FreightSources) Reset()      { *m = FreightSources{} }
 func (*FreightSources) ProtoMessage() {}
 func (*FreightSources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{34}
+	return fileDescriptor_e26b7f7bbc391025, []int{36}
 }
 func (m *FreightSources) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1010,10 +1066,38 @@ func (m *FreightSources) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_FreightSources proto.InternalMessageInfo
 
+func (m *FreightStalenessPolicy) Reset()      { *m = FreightStalenessPolicy{} }
+func (*FreightStalenessPolicy) ProtoMessage() {}
+func (*FreightStalenessPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{37}
+}
+func (m *FreightStalenessPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FreightStalenessPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *FreightStalenessPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FreightStalenessPolicy.Merge(m, src)
+}
+func (m *FreightStalenessPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *FreightStalenessPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_FreightStalenessPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FreightStalenessPolicy proto.InternalMessageInfo
+
 func (m *FreightStatus) Reset()      { *m = FreightStatus{} }
 func (*FreightStatus) ProtoMessage() {}
 func (*FreightStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{35}
+	return fileDescriptor_e26b7f7bbc391025, []int{38}
 }
 func (m *FreightStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1041,7 +1125,7 @@ var xxx_messageInfo_FreightStatus proto.InternalMessageInfo
 func (m *GitCommit) Reset()      { *m = GitCommit{} }
 func (*GitCommit) ProtoMessage() {}
 func (*GitCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{36}
+	return fileDescriptor_e26b7f7bbc391025, []int{39}
 }
 func (m *GitCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1069,7 +1153,7 @@ var xxx_messageInfo_GitCommit proto.InternalMessageInfo
 func (m *GitDiscoveryResult) Reset()      { *m = GitDiscoveryResult{} }
 func (*GitDiscoveryResult) ProtoMessage() {}
 func (*GitDiscoveryResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{37}
+	return fileDescriptor_e26b7f7bbc391025, []int{40}
 }
 func (m *GitDiscoveryResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1097,7 +1181,7 @@ var xxx_messageInfo_GitDiscoveryResult proto.InternalMessageInfo
 func (m *GitHubWebhookReceiverConfig) Reset()      { *m = GitHubWebhookReceiverConfig{} }
 func (*GitHubWebhookReceiverConfig) ProtoMessage() {}
 func (*GitHubWebhookReceiverConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{38}
+	return fileDescriptor_e26b7f7bbc391025, []int{41}
 }
 func (m *GitHubWebhookReceiverConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1125,7 +1209,7 @@ var xxx_messageInfo_GitHubWebhookReceiverConfig proto.InternalMessageInfo
 func (m *GitLabWebhookReceiverConfig) Reset()      { *m = GitLabWebhookReceiverConfig{} }
 func (*GitLabWebhookReceiverConfig) ProtoMessage() {}
 func (*GitLabWebhookReceiverConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{39}
+	return fileDescriptor_e26b7f7bbc391025, []int{42}
 }
 func (m *GitLabWebhookReceiverConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1153,7 +1237,7 @@ var xxx_messageInfo_GitLabWebhookReceiverConfig proto.InternalMessageInfo
 func (m *GitSubscription) Reset()      { *m = GitSubscription{} }
 func (*GitSubscription) ProtoMessage() {}
 func (*GitSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{40}
+	return fileDescriptor_e26b7f7bbc391025, []int{43}
 }
 func (m *GitSubscription) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1181,7 +1265,7 @@ var xxx_messageInfo_GitSubscription proto.InternalMessageInfo
 func (m *GiteaWebhookReceiverConfig) Reset()      { *m = GiteaWebhookReceiverConfig{} }
 func (*GiteaWebhookReceiverConfig) ProtoMessage() {}
 func (*GiteaWebhookReceiverConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{41}
+	return fileDescriptor_e26b7f7bbc391025, []int{44}
 }
 func (m *GiteaWebhookReceiverConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1209,7 +1293,7 @@ var xxx_messageInfo_GiteaWebhookReceiverConfig proto.InternalMessageInfo
 func (m *HarborWebhookReceiverConfig) Reset()      { *m = HarborWebhookReceiverConfig{} }
 func (*HarborWebhookReceiverConfig) ProtoMessage() {}
 func (*HarborWebhookReceiverConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{42}
+	return fileDescriptor_e26b7f7bbc391025, []int{45}
 }
 func (m *HarborWebhookReceiverConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1237,7 +1321,7 @@ var xxx_messageInfo_HarborWebhookReceiverConfig proto.InternalMessageInfo
 func (m *Health) Reset()      { *m = Health{} }
 func (*Health) ProtoMessage() {}
 func (*Health) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{43}
+	return fileDescriptor_e26b7f7bbc391025, []int{46}
 }
 func (m *Health) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1265,7 +1349,7 @@ var xxx_messageInfo_Health proto.InternalMessageInfo
 func (m *HealthCheckStep) Reset()      { *m = HealthCheckStep{} }
 func (*HealthCheckStep) ProtoMessage() {}
 func (*HealthCheckStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{44}
+	return fileDescriptor_e26b7f7bbc391025, []int{47}
 }
 func (m *HealthCheckStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1293,7 +1377,7 @@ var xxx_messageInfo_HealthCheckStep proto.InternalMessageInfo
 func (m *HealthStats) Reset()      { *m = HealthStats{} }
 func (*HealthStats) ProtoMessage() {}
 func (*HealthStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{45}
+	return fileDescriptor_e26b7f7bbc391025, []int{48}
 }
 func (m *HealthStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1321,7 +1405,7 @@ var xxx_messageInfo_HealthStats proto.InternalMessageInfo
 func (m *Image) Reset()      { *m = Image{} }
 func (*Image) ProtoMessage() {}
 func (*Image) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{46}
+	return fileDescriptor_e26b7f7bbc391025, []int{49}
 }
 func (m *Image) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1349,7 +1433,7 @@ var xxx_messageInfo_Image proto.InternalMessageInfo
 func (m *ImageDiscoveryResult) Reset()      { *m = ImageDiscoveryResult{} }
 func (*ImageDiscoveryResult) ProtoMessage() {}
 func (*ImageDiscoveryResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{47}
+	return fileDescriptor_e26b7f7bbc391025, []int{50}
 }
 func (m *ImageDiscoveryResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1377,7 +1461,7 @@ var xxx_messageInfo_ImageDiscoveryResult proto.InternalMessageInfo
 func (m *ImageSubscription) Reset()      { *m = ImageSubscription{} }
 func (*ImageSubscription) ProtoMessage() {}
 func (*ImageSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{48}
+	return fileDescriptor_e26b7f7bbc391025, []int{51}
 }
 func (m *ImageSubscription) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1405,7 +1489,7 @@ var xxx_messageInfo_ImageSubscription proto.InternalMessageInfo
 func (m *Project) Reset()      { *m = Project{} }
 func (*Project) ProtoMessage() {}
 func (*Project) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{49}
+	return fileDescriptor_e26b7f7bbc391025, []int{52}
 }
 func (m *Project) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1433,7 +1517,7 @@ var xxx_messageInfo_Project proto.InternalMessageInfo
 func (m *ProjectConfig) Reset()      { *m = ProjectConfig{} }
 func (*ProjectConfig) ProtoMessage() {}
 func (*ProjectConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{50}
+	return fileDescriptor_e26b7f7bbc391025, []int{53}
 }
 func (m *ProjectConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1461,7 +1545,7 @@ var xxx_messageInfo_ProjectConfig proto.InternalMessageInfo
 func (m *ProjectConfigList) Reset()      { *m = ProjectConfigList{} }
 func (*ProjectConfigList) ProtoMessage() {}
 func (*ProjectConfigList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{51}
+	return fileDescriptor_e26b7f7bbc391025, []int{54}
 }
 func (m *ProjectConfigList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1489,7 +1573,7 @@ var xxx_messageInfo_ProjectConfigList proto.InternalMessageInfo
 func (m *ProjectConfigSpec) Reset()      { *m = ProjectConfigSpec{} }
 func (*ProjectConfigSpec) ProtoMessage() {}
 func (*ProjectConfigSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{52}
+	return fileDescriptor_e26b7f7bbc391025, []int{55}
 }
 func (m *ProjectConfigSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1517,7 +1601,7 @@ var xxx_messageInfo_ProjectConfigSpec proto.InternalMessageInfo
 func (m *ProjectConfigStatus) Reset()      { *m = ProjectConfigStatus{} }
 func (*ProjectConfigStatus) ProtoMessage() {}
 func (*ProjectConfigStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{53}
+	return fileDescriptor_e26b7f7bbc391025, []int{56}
 }
 func (m *ProjectConfigStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1545,7 +1629,7 @@ var xxx_messageInfo_ProjectConfigStatus proto.InternalMessageInfo
 func (m *ProjectList) Reset()      { *m = ProjectList{} }
 func (*ProjectList) ProtoMessage() {}
 func (*ProjectList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{54}
+	return fileDescriptor_e26b7f7bbc391025, []int{57}
 }
 func (m *ProjectList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1573,7 +1657,7 @@ var xxx_messageInfo_ProjectList proto.InternalMessageInfo
 func (m *ProjectStats) Reset()      { *m = ProjectStats{} }
 func (*ProjectStats) ProtoMessage() {}
 func (*ProjectStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{55}
+	return fileDescriptor_e26b7f7bbc391025, []int{58}
 }
 func (m *ProjectStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1601,7 +1685,7 @@ var xxx_messageInfo_ProjectStats proto.InternalMessageInfo
 func (m *ProjectStatus) Reset()      { *m = ProjectStatus{} }
 func (*ProjectStatus) ProtoMessage() {}
 func (*ProjectStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{56}
+	return fileDescriptor_e26b7f7bbc391025, []int{59}
 }
 func (m *ProjectStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1629,7 +1713,7 @@ var xxx_messageInfo_ProjectStatus proto.InternalMessageInfo
 func (m *Promotion) Reset()      { *m = Promotion{} }
 func (*Promotion) ProtoMessage() {}
 func (*Promotion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{57}
+	return fileDescriptor_e26b7f7bbc391025, []int{60}
 }
 func (m *Promotion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1654,10 +1738,38 @@ func (m *Promotion) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Promotion proto.InternalMessageInfo
 
+func (m *PromotionConcurrency) Reset()      { *m = PromotionConcurrency{} }
+func (*PromotionConcurrency) ProtoMessage() {}
+func (*PromotionConcurrency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{61}
+}
+func (m *PromotionConcurrency) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PromotionConcurrency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PromotionConcurrency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PromotionConcurrency.Merge(m, src)
+}
+func (m *PromotionConcurrency) XXX_Size() int {
+	return m.Size()
+}
+func (m *PromotionConcurrency) XXX_DiscardUnknown() {
+	xxx_messageInfo_PromotionConcurrency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PromotionConcurrency proto.InternalMessageInfo
+
 func (m *PromotionList) Reset()      { *m = PromotionList{} }
 func (*PromotionList) ProtoMessage() {}
 func (*PromotionList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{58}
+	return fileDescriptor_e26b7f7bbc391025, []int{62}
 }
 func (m *PromotionList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1685,7 +1797,7 @@ var xxx_messageInfo_PromotionList proto.InternalMessageInfo
 func (m *PromotionPolicy) Reset()      { *m = PromotionPolicy{} }
 func (*PromotionPolicy) ProtoMessage() {}
 func (*PromotionPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{59}
+	return fileDescriptor_e26b7f7bbc391025, []int{63}
 }
 func (m *PromotionPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1713,7 +1825,7 @@ var xxx_messageInfo_PromotionPolicy proto.InternalMessageInfo
 func (m *PromotionPolicySelector) Reset()      { *m = PromotionPolicySelector{} }
 func (*PromotionPolicySelector) ProtoMessage() {}
 func (*PromotionPolicySelector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{60}
+	return fileDescriptor_e26b7f7bbc391025, []int{64}
 }
 func (m *PromotionPolicySelector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1741,7 +1853,7 @@ var xxx_messageInfo_PromotionPolicySelector proto.InternalMessageInfo
 func (m *PromotionReference) Reset()      { *m = PromotionReference{} }
 func (*PromotionReference) ProtoMessage() {}
 func (*PromotionReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{61}
+	return fileDescriptor_e26b7f7bbc391025, []int{65}
 }
 func (m *PromotionReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1766,10 +1878,38 @@ func (m *PromotionReference) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PromotionReference proto.InternalMessageInfo
 
+func (m *PromotionResume) Reset()      { *m = PromotionResume{} }
+func (*PromotionResume) ProtoMessage() {}
+func (*PromotionResume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{66}
+}
+func (m *PromotionResume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PromotionResume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PromotionResume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PromotionResume.Merge(m, src)
+}
+func (m *PromotionResume) XXX_Size() int {
+	return m.Size()
+}
+func (m *PromotionResume) XXX_DiscardUnknown() {
+	xxx_messageInfo_PromotionResume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PromotionResume proto.InternalMessageInfo
+
 func (m *PromotionSpec) Reset()      { *m = PromotionSpec{} }
 func (*PromotionSpec) ProtoMessage() {}
 func (*PromotionSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{62}
+	return fileDescriptor_e26b7f7bbc391025, []int{67}
 }
 func (m *PromotionSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1797,7 +1937,7 @@ var xxx_messageInfo_PromotionSpec proto.InternalMessageInfo
 func (m *PromotionStatus) Reset()      { *m = PromotionStatus{} }
 func (*PromotionStatus) ProtoMessage() {}
 func (*PromotionStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{63}
+	return fileDescriptor_e26b7f7bbc391025, []int{68}
 }
 func (m *PromotionStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1825,7 +1965,7 @@ var xxx_messageInfo_PromotionStatus proto.InternalMessageInfo
 func (m *PromotionStep) Reset()      { *m = PromotionStep{} }
 func (*PromotionStep) ProtoMessage() {}
 func (*PromotionStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{64}
+	return fileDescriptor_e26b7f7bbc391025, []int{69}
 }
 func (m *PromotionStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1853,7 +1993,7 @@ var xxx_messageInfo_PromotionStep proto.InternalMessageInfo
 func (m *PromotionStepRetry) Reset()      { *m = PromotionStepRetry{} }
 func (*PromotionStepRetry) ProtoMessage() {}
 func (*PromotionStepRetry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{65}
+	return fileDescriptor_e26b7f7bbc391025, []int{70}
 }
 func (m *PromotionStepRetry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1881,7 +2021,7 @@ var xxx_messageInfo_PromotionStepRetry proto.InternalMessageInfo
 func (m *PromotionTask) Reset()      { *m = PromotionTask{} }
 func (*PromotionTask) ProtoMessage() {}
 func (*PromotionTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{66}
+	return fileDescriptor_e26b7f7bbc391025, []int{71}
 }
 func (m *PromotionTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1909,7 +2049,7 @@ var xxx_messageInfo_PromotionTask proto.InternalMessageInfo
 func (m *PromotionTaskList) Reset()      { *m = PromotionTaskList{} }
 func (*PromotionTaskList) ProtoMessage() {}
 func (*PromotionTaskList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{67}
+	return fileDescriptor_e26b7f7bbc391025, []int{72}
 }
 func (m *PromotionTaskList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1937,7 +2077,7 @@ var xxx_messageInfo_PromotionTaskList proto.InternalMessageInfo
 func (m *PromotionTaskReference) Reset()      { *m = PromotionTaskReference{} }
 func (*PromotionTaskReference) ProtoMessage() {}
 func (*PromotionTaskReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{68}
+	return fileDescriptor_e26b7f7bbc391025, []int{73}
 }
 func (m *PromotionTaskReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1965,7 +2105,7 @@ var xxx_messageInfo_PromotionTaskReference proto.InternalMessageInfo
 func (m *PromotionTaskSpec) Reset()      { *m = PromotionTaskSpec{} }
 func (*PromotionTaskSpec) ProtoMessage() {}
 func (*PromotionTaskSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{69}
+	return fileDescriptor_e26b7f7bbc391025, []int{74}
 }
 func (m *PromotionTaskSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1993,7 +2133,7 @@ var xxx_messageInfo_PromotionTaskSpec proto.InternalMessageInfo
 func (m *PromotionTemplate) Reset()      { *m = PromotionTemplate{} }
 func (*PromotionTemplate) ProtoMessage() {}
 func (*PromotionTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{70}
+	return fileDescriptor_e26b7f7bbc391025, []int{75}
 }
 func (m *PromotionTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2021,7 +2161,7 @@ var xxx_messageInfo_PromotionTemplate proto.InternalMessageInfo
 func (m *PromotionTemplateSpec) Reset()      { *m = PromotionTemplateSpec{} }
 func (*PromotionTemplateSpec) ProtoMessage() {}
 func (*PromotionTemplateSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{71}
+	return fileDescriptor_e26b7f7bbc391025, []int{76}
 }
 func (m *PromotionTemplateSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2046,15 +2186,15 @@ func (m *PromotionTemplateSpec) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PromotionTemplateSpec proto.InternalMessageInfo
 
-func (m *QuayWebhookReceiverConfig) Reset()      { *m = QuayWebhookReceiverConfig{} }
-func (*QuayWebhookReceiverConfig) ProtoMessage() {}
-func (*QuayWebhookReceiverConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{72}
+func (m *PromotionWave) Reset()      { *m = PromotionWave{} }
+func (*PromotionWave) ProtoMessage() {}
+func (*PromotionWave) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{77}
 }
-func (m *QuayWebhookReceiverConfig) XXX_Unmarshal(b []byte) error {
+func (m *PromotionWave) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuayWebhookReceiverConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PromotionWave) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -2062,27 +2202,27 @@ func (m *QuayWebhookReceiverConfig) XXX_Marshal(b []byte, deterministic bool) ([
 	}
 	return b[:n], nil
 }
-func (m *QuayWebhookReceiverConfig) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuayWebhookReceiverConfig.Merge(m, src)
+func (m *PromotionWave) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PromotionWave.Merge(m, src)
 }
-func (m *QuayWebhookReceiverConfig) XXX_Size() int {
+func (m *PromotionWave) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuayWebhookReceiverConfig) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuayWebhookReceiverConfig.DiscardUnknown(m)
+func (m *PromotionWave) XXX_DiscardUnknown() {
+	xxx_messageInfo_PromotionWave.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuayWebhookReceiverConfig proto.InternalMessageInfo
+var xxx_messageInfo_PromotionWave proto.InternalMessageInfo
 
-func (m *RepoSubscription) Reset()      { *m = RepoSubscription{} }
-func (*RepoSubscription) ProtoMessage() {}
-func (*RepoSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{73}
+func (m *PromotionWavePolicy) Reset()      { *m = PromotionWavePolicy{} }
+func (*PromotionWavePolicy) ProtoMessage() {}
+func (*PromotionWavePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{78}
 }
-func (m *RepoSubscription) XXX_Unmarshal(b []byte) error {
+func (m *PromotionWavePolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RepoSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PromotionWavePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -2090,22 +2230,162 @@ func (m *RepoSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 	}
 	return b[:n], nil
 }
-func (m *RepoSubscription) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RepoSubscription.Merge(m, src)
+func (m *PromotionWavePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PromotionWavePolicy.Merge(m, src)
 }
-func (m *RepoSubscription) XXX_Size() int {
+func (m *PromotionWavePolicy) XXX_Size() int {
 	return m.Size()
 }
-func (m *RepoSubscription) XXX_DiscardUnknown() {
-	xxx_messageInfo_RepoSubscription.DiscardUnknown(m)
+func (m *PromotionWavePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_PromotionWavePolicy.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RepoSubscription proto.InternalMessageInfo
+var xxx_messageInfo_PromotionWavePolicy proto.InternalMessageInfo
 
-func (m *Stage) Reset()      { *m = Stage{} }
+func (m *PromotionWaveProgress) Reset()      { *m = PromotionWaveProgress{} }
+func (*PromotionWaveProgress) ProtoMessage() {}
+func (*PromotionWaveProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{79}
+}
+func (m *PromotionWaveProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PromotionWaveProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PromotionWaveProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PromotionWaveProgress.Merge(m, src)
+}
+func (m *PromotionWaveProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *PromotionWaveProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_PromotionWaveProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PromotionWaveProgress proto.InternalMessageInfo
+
+func (m *PromotionWaveStatus) Reset()      { *m = PromotionWaveStatus{} }
+func (*PromotionWaveStatus) ProtoMessage() {}
+func (*PromotionWaveStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{80}
+}
+func (m *PromotionWaveStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PromotionWaveStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PromotionWaveStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PromotionWaveStatus.Merge(m, src)
+}
+func (m *PromotionWaveStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *PromotionWaveStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_PromotionWaveStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PromotionWaveStatus proto.InternalMessageInfo
+
+func (m *PromotionWorkspace) Reset()      { *m = PromotionWorkspace{} }
+func (*PromotionWorkspace) ProtoMessage() {}
+func (*PromotionWorkspace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{81}
+}
+func (m *PromotionWorkspace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PromotionWorkspace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PromotionWorkspace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PromotionWorkspace.Merge(m, src)
+}
+func (m *PromotionWorkspace) XXX_Size() int {
+	return m.Size()
+}
+func (m *PromotionWorkspace) XXX_DiscardUnknown() {
+	xxx_messageInfo_PromotionWorkspace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PromotionWorkspace proto.InternalMessageInfo
+
+func (m *QuayWebhookReceiverConfig) Reset()      { *m = QuayWebhookReceiverConfig{} }
+func (*QuayWebhookReceiverConfig) ProtoMessage() {}
+func (*QuayWebhookReceiverConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{82}
+}
+func (m *QuayWebhookReceiverConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuayWebhookReceiverConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *QuayWebhookReceiverConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuayWebhookReceiverConfig.Merge(m, src)
+}
+func (m *QuayWebhookReceiverConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuayWebhookReceiverConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuayWebhookReceiverConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuayWebhookReceiverConfig proto.InternalMessageInfo
+
+func (m *RepoSubscription) Reset()      { *m = RepoSubscription{} }
+func (*RepoSubscription) ProtoMessage() {}
+func (*RepoSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{83}
+}
+func (m *RepoSubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepoSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RepoSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepoSubscription.Merge(m, src)
+}
+func (m *RepoSubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepoSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepoSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepoSubscription proto.InternalMessageInfo
+
+func (m *Stage) Reset()      { *m = Stage{} }
 func (*Stage) ProtoMessage() {}
 func (*Stage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{74}
+	return fileDescriptor_e26b7f7bbc391025, []int{84}
 }
 func (m *Stage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2133,7 +2413,7 @@ var xxx_messageInfo_Stage proto.InternalMessageInfo
 func (m *StageList) Reset()      { *m = StageList{} }
 func (*StageList) ProtoMessage() {}
 func (*StageList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{75}
+	return fileDescriptor_e26b7f7bbc391025, []int{85}
 }
 func (m *StageList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2161,7 +2441,7 @@ var xxx_messageInfo_StageList proto.InternalMessageInfo
 func (m *StageSpec) Reset()      { *m = StageSpec{} }
 func (*StageSpec) ProtoMessage() {}
 func (*StageSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{76}
+	return fileDescriptor_e26b7f7bbc391025, []int{86}
 }
 func (m *StageSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2189,7 +2469,7 @@ var xxx_messageInfo_StageSpec proto.InternalMessageInfo
 func (m *StageStats) Reset()      { *m = StageStats{} }
 func (*StageStats) ProtoMessage() {}
 func (*StageStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{77}
+	return fileDescriptor_e26b7f7bbc391025, []int{87}
 }
 func (m *StageStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2217,7 +2497,7 @@ var xxx_messageInfo_StageStats proto.InternalMessageInfo
 func (m *StageStatus) Reset()      { *m = StageStatus{} }
 func (*StageStatus) ProtoMessage() {}
 func (*StageStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{78}
+	return fileDescriptor_e26b7f7bbc391025, []int{88}
 }
 func (m *StageStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2245,7 +2525,7 @@ var xxx_messageInfo_StageStatus proto.InternalMessageInfo
 func (m *StepExecutionMetadata) Reset()      { *m = StepExecutionMetadata{} }
 func (*StepExecutionMetadata) ProtoMessage() {}
 func (*StepExecutionMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{79}
+	return fileDescriptor_e26b7f7bbc391025, []int{89}
 }
 func (m *StepExecutionMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2273,7 +2553,7 @@ var xxx_messageInfo_StepExecutionMetadata proto.InternalMessageInfo
 func (m *Verification) Reset()      { *m = Verification{} }
 func (*Verification) ProtoMessage() {}
 func (*Verification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{80}
+	return fileDescriptor_e26b7f7bbc391025, []int{90}
 }
 func (m *Verification) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2301,7 +2581,7 @@ var xxx_messageInfo_Verification proto.InternalMessageInfo
 func (m *VerificationInfo) Reset()      { *m = VerificationInfo{} }
 func (*VerificationInfo) ProtoMessage() {}
 func (*VerificationInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{81}
+	return fileDescriptor_e26b7f7bbc391025, []int{91}
 }
 func (m *VerificationInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2329,7 +2609,7 @@ var xxx_messageInfo_VerificationInfo proto.InternalMessageInfo
 func (m *VerifiedStage) Reset()      { *m = VerifiedStage{} }
 func (*VerifiedStage) ProtoMessage() {}
 func (*VerifiedStage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{82}
+	return fileDescriptor_e26b7f7bbc391025, []int{92}
 }
 func (m *VerifiedStage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2357,7 +2637,7 @@ var xxx_messageInfo_VerifiedStage proto.InternalMessageInfo
 func (m *Warehouse) Reset()      { *m = Warehouse{} }
 func (*Warehouse) ProtoMessage() {}
 func (*Warehouse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{83}
+	return fileDescriptor_e26b7f7bbc391025, []int{93}
 }
 func (m *Warehouse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2385,7 +2665,7 @@ var xxx_messageInfo_Warehouse proto.InternalMessageInfo
 func (m *WarehouseList) Reset()      { *m = WarehouseList{} }
 func (*WarehouseList) ProtoMessage() {}
 func (*WarehouseList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{84}
+	return fileDescriptor_e26b7f7bbc391025, []int{94}
 }
 func (m *WarehouseList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2413,7 +2693,7 @@ var xxx_messageInfo_WarehouseList proto.InternalMessageInfo
 func (m *WarehouseSpec) Reset()      { *m = WarehouseSpec{} }
 func (*WarehouseSpec) ProtoMessage() {}
 func (*WarehouseSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{85}
+	return fileDescriptor_e26b7f7bbc391025, []int{95}
 }
 func (m *WarehouseSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2441,7 +2721,7 @@ var xxx_messageInfo_WarehouseSpec proto.InternalMessageInfo
 func (m *WarehouseStats) Reset()      { *m = WarehouseStats{} }
 func (*WarehouseStats) ProtoMessage() {}
 func (*WarehouseStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{86}
+	return fileDescriptor_e26b7f7bbc391025, []int{96}
 }
 func (m *WarehouseStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2469,7 +2749,7 @@ var xxx_messageInfo_WarehouseStats proto.InternalMessageInfo
 func (m *WarehouseStatus) Reset()      { *m = WarehouseStatus{} }
 func (*WarehouseStatus) ProtoMessage() {}
 func (*WarehouseStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{87}
+	return fileDescriptor_e26b7f7bbc391025, []int{97}
 }
 func (m *WarehouseStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2497,7 +2777,7 @@ var xxx_messageInfo_WarehouseStatus proto.InternalMessageInfo
 func (m *WebhookReceiverConfig) Reset()      { *m = WebhookReceiverConfig{} }
 func (*WebhookReceiverConfig) ProtoMessage() {}
 func (*WebhookReceiverConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{88}
+	return fileDescriptor_e26b7f7bbc391025, []int{98}
 }
 func (m *WebhookReceiverConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2525,7 +2805,7 @@ var xxx_messageInfo_WebhookReceiverConfig proto.InternalMessageInfo
 func (m *WebhookReceiverDetails) Reset()      { *m = WebhookReceiverDetails{} }
 func (*WebhookReceiverDetails) ProtoMessage() {}
 func (*WebhookReceiverDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{89}
+	return fileDescriptor_e26b7f7bbc391025, []int{99}
 }
 func (m *WebhookReceiverDetails) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2551,6 +2831,7 @@ func (m *WebhookReceiverDetails) XXX_DiscardUnknown() {
 var xxx_messageInfo_WebhookReceiverDetails proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterType((*AgentConfig)(nil), "github.com.akuity.kargo.api.v1alpha1.AgentConfig")
 	proto.RegisterType((*AnalysisRunArgument)(nil), "github.com.akuity.kargo.api.v1alpha1.AnalysisRunArgument")
 	proto.RegisterType((*AnalysisRunMetadata)(nil), "github.com.akuity.kargo.api.v1alpha1.AnalysisRunMetadata")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.akuity.kargo.api.v1alpha1.AnalysisRunMetadata.AnnotationsEntry")
@@ -2574,6 +2855,7 @@ func init() {
 	proto.RegisterType((*ClusterConfigStatus)(nil), "github.com.akuity.kargo.api.v1alpha1.ClusterConfigStatus")
 	proto.RegisterType((*ClusterPromotionTask)(nil), "github.com.akuity.kargo.api.v1alpha1.ClusterPromotionTask")
 	proto.RegisterType((*ClusterPromotionTaskList)(nil), "github.com.akuity.kargo.api.v1alpha1.ClusterPromotionTaskList")
+	proto.RegisterType((*ConcurrencyGroup)(nil), "github.com.akuity.kargo.api.v1alpha1.ConcurrencyGroup")
 	proto.RegisterType((*CurrentStage)(nil), "github.com.akuity.kargo.api.v1alpha1.CurrentStage")
 	proto.RegisterType((*DiscoveredArtifacts)(nil), "github.com.akuity.kargo.api.v1alpha1.DiscoveredArtifacts")
 	proto.RegisterType((*DiscoveredCommit)(nil), "github.com.akuity.kargo.api.v1alpha1.DiscoveredCommit")
@@ -2590,6 +2872,7 @@ func init() {
 	proto.RegisterType((*FreightReference)(nil), "github.com.akuity.kargo.api.v1alpha1.FreightReference")
 	proto.RegisterType((*FreightRequest)(nil), "github.com.akuity.kargo.api.v1alpha1.FreightRequest")
 	proto.RegisterType((*FreightSources)(nil), "github.com.akuity.kargo.api.v1alpha1.FreightSources")
+	proto.RegisterType((*FreightStalenessPolicy)(nil), "github.com.akuity.kargo.api.v1alpha1.FreightStalenessPolicy")
 	proto.RegisterType((*FreightStatus)(nil), "github.com.akuity.kargo.api.v1alpha1.FreightStatus")
 	proto.RegisterMapType((map[string]ApprovedStage)(nil), "github.com.akuity.kargo.api.v1alpha1.FreightStatus.ApprovedForEntry")
 	proto.RegisterMapType((map[string]CurrentStage)(nil), "github.com.akuity.kargo.api.v1alpha1.FreightStatus.CurrentlyInEntry")
@@ -2618,10 +2901,12 @@ func init() {
 	proto.RegisterType((*ProjectStats)(nil), "github.com.akuity.kargo.api.v1alpha1.ProjectStats")
 	proto.RegisterType((*ProjectStatus)(nil), "github.com.akuity.kargo.api.v1alpha1.ProjectStatus")
 	proto.RegisterType((*Promotion)(nil), "github.com.akuity.kargo.api.v1alpha1.Promotion")
+	proto.RegisterType((*PromotionConcurrency)(nil), "github.com.akuity.kargo.api.v1alpha1.PromotionConcurrency")
 	proto.RegisterType((*PromotionList)(nil), "github.com.akuity.kargo.api.v1alpha1.PromotionList")
 	proto.RegisterType((*PromotionPolicy)(nil), "github.com.akuity.kargo.api.v1alpha1.PromotionPolicy")
 	proto.RegisterType((*PromotionPolicySelector)(nil), "github.com.akuity.kargo.api.v1alpha1.PromotionPolicySelector")
 	proto.RegisterType((*PromotionReference)(nil), "github.com.akuity.kargo.api.v1alpha1.PromotionReference")
+	proto.RegisterType((*PromotionResume)(nil), "github.com.akuity.kargo.api.v1alpha1.PromotionResume")
 	proto.RegisterType((*PromotionSpec)(nil), "github.com.akuity.kargo.api.v1alpha1.PromotionSpec")
 	proto.RegisterType((*PromotionStatus)(nil), "github.com.akuity.kargo.api.v1alpha1.PromotionStatus")
 	proto.RegisterType((*PromotionStep)(nil), "github.com.akuity.kargo.api.v1alpha1.PromotionStep")
@@ -2632,6 +2917,11 @@ func init() {
 	proto.RegisterType((*PromotionTaskSpec)(nil), "github.com.akuity.kargo.api.v1alpha1.PromotionTaskSpec")
 	proto.RegisterType((*PromotionTemplate)(nil), "github.com.akuity.kargo.api.v1alpha1.PromotionTemplate")
 	proto.RegisterType((*PromotionTemplateSpec)(nil), "github.com.akuity.kargo.api.v1alpha1.PromotionTemplateSpec")
+	proto.RegisterType((*PromotionWave)(nil), "github.com.akuity.kargo.api.v1alpha1.PromotionWave")
+	proto.RegisterType((*PromotionWavePolicy)(nil), "github.com.akuity.kargo.api.v1alpha1.PromotionWavePolicy")
+	proto.RegisterType((*PromotionWaveProgress)(nil), "github.com.akuity.kargo.api.v1alpha1.PromotionWaveProgress")
+	proto.RegisterType((*PromotionWaveStatus)(nil), "github.com.akuity.kargo.api.v1alpha1.PromotionWaveStatus")
+	proto.RegisterType((*PromotionWorkspace)(nil), "github.com.akuity.kargo.api.v1alpha1.PromotionWorkspace")
 	proto.RegisterType((*QuayWebhookReceiverConfig)(nil), "github.com.akuity.kargo.api.v1alpha1.QuayWebhookReceiverConfig")
 	proto.RegisterType((*RepoSubscription)(nil), "github.com.akuity.kargo.api.v1alpha1.RepoSubscription")
 	proto.RegisterType((*Stage)(nil), "github.com.akuity.kargo.api.v1alpha1.Stage")
@@ -2658,330 +2948,415 @@ func init() {
 }
 
 var fileDescriptor_e26b7f7bbc391025 = []byte{
-	// 5167 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5d, 0xcb, 0x6f, 0x24, 0xc7,
-	0x79, 0xdf, 0x9e, 0xe1, 0x6b, 0xbe, 0xe1, 0xb3, 0x96, 0xab, 0xa5, 0x28, 0x8b, 0xdc, 0xb4, 0x14,
-	0x41, 0x8a, 0xa4, 0x61, 0xb4, 0x7a, 0xad, 0x5e, 0x6b, 0xcd, 0x0c, 0xf7, 0x41, 0x99, 0xd2, 0xd2,
-	0x35, 0xab, 0x95, 0xb5, 0x92, 0xa0, 0x14, 0x67, 0x8a, 0x33, 0x6d, 0xce, 0x4c, 0x8f, 0xba, 0xab,
-	0xb9, 0x4b, 0x29, 0x48, 0x14, 0x3b, 0x09, 0x7c, 0x10, 0x02, 0x1d, 0x1c, 0xc8, 0x87, 0x04, 0x08,
-	0xe2, 0x53, 0x60, 0xc0, 0xfe, 0x03, 0x02, 0xc4, 0x01, 0x72, 0x91, 0x1d, 0x39, 0x10, 0x94, 0x43,
-	0x14, 0xc0, 0x20, 0x22, 0x1a, 0xc8, 0x2d, 0x40, 0x0e, 0x39, 0x2d, 0x10, 0x20, 0xa8, 0x47, 0x77,
-	0x57, 0xf7, 0xf4, 0x2c, 0xbb, 0x67, 0x49, 0x6a, 0x83, 0xe4, 0xc6, 0xa9, 0xaf, 0xea, 0xf7, 0x75,
-	0xbd, 0xbe, 0xfa, 0x5e, 0x55, 0x84, 0xa7, 0x9a, 0x16, 0x6b, 0x79, 0x9b, 0xa5, 0xba, 0xdd, 0x59,
-	0x21, 0xdb, 0x9e, 0xc5, 0x76, 0x57, 0xb6, 0x89, 0xd3, 0xb4, 0x57, 0x48, 0xcf, 0x5a, 0xd9, 0x79,
-	0x82, 0xb4, 0x7b, 0x2d, 0xf2, 0xc4, 0x4a, 0x93, 0x76, 0xa9, 0x43, 0x18, 0x6d, 0x94, 0x7a, 0x8e,
-	0xcd, 0x6c, 0xf4, 0x60, 0xd8, 0xaa, 0x24, 0x5b, 0x95, 0x44, 0xab, 0x12, 0xe9, 0x59, 0x25, 0xbf,
-	0xd5, 0xe2, 0xe3, 0x1a, 0x76, 0xd3, 0x6e, 0xda, 0x2b, 0xa2, 0xf1, 0xa6, 0xb7, 0x25, 0x7e, 0x89,
-	0x1f, 0xe2, 0x2f, 0x09, 0xba, 0x68, 0x6e, 0x9f, 0x73, 0x4b, 0x96, 0xe4, 0x5c, 0xb7, 0x1d, 0xba,
-	0xb2, 0xd3, 0xc7, 0x78, 0xf1, 0x72, 0x58, 0x87, 0xde, 0x64, 0xb4, 0xeb, 0x5a, 0x76, 0xd7, 0x7d,
-	0x9c, 0xf4, 0x2c, 0x97, 0x3a, 0x3b, 0xd4, 0x59, 0xe9, 0x6d, 0x37, 0x39, 0xcd, 0x8d, 0x56, 0x48,
-	0x42, 0x7a, 0x2a, 0x44, 0xea, 0x90, 0x7a, 0xcb, 0xea, 0x52, 0x67, 0x37, 0x6c, 0xde, 0xa1, 0x8c,
-	0x24, 0xb5, 0x5a, 0x19, 0xd4, 0xca, 0xf1, 0xba, 0xcc, 0xea, 0xd0, 0xbe, 0x06, 0xcf, 0x1c, 0xd4,
-	0xc0, 0xad, 0xb7, 0x68, 0x87, 0xc4, 0xdb, 0x99, 0x6f, 0xc3, 0xc9, 0x72, 0x97, 0xb4, 0x77, 0x5d,
-	0xcb, 0xc5, 0x5e, 0xb7, 0xec, 0x34, 0xbd, 0x0e, 0xed, 0x32, 0x74, 0x06, 0x46, 0xba, 0xa4, 0x43,
-	0x17, 0x8c, 0x33, 0xc6, 0xc3, 0x85, 0xca, 0xe4, 0xa7, 0x7b, 0xcb, 0x27, 0xf6, 0xf7, 0x96, 0x47,
-	0x5e, 0x23, 0x1d, 0x8a, 0x05, 0x05, 0x3d, 0x00, 0xa3, 0x3b, 0xa4, 0xed, 0xd1, 0x85, 0x9c, 0xa8,
-	0x32, 0xa5, 0xaa, 0x8c, 0x5e, 0xe3, 0x85, 0x58, 0xd2, 0xcc, 0xef, 0xe7, 0x23, 0xf0, 0xaf, 0x52,
-	0x46, 0x1a, 0x84, 0x11, 0xd4, 0x81, 0xb1, 0x36, 0xd9, 0xa4, 0x6d, 0x77, 0xc1, 0x38, 0x93, 0x7f,
-	0xb8, 0x78, 0xf6, 0x42, 0x29, 0xcd, 0x44, 0x97, 0x12, 0xa0, 0x4a, 0xeb, 0x02, 0xe7, 0x42, 0x97,
-	0x39, 0xbb, 0x95, 0x69, 0xf5, 0x11, 0x63, 0xb2, 0x10, 0x2b, 0x26, 0xe8, 0x8f, 0x0c, 0x28, 0x92,
-	0x6e, 0xd7, 0x66, 0x84, 0xf1, 0x69, 0x5a, 0xc8, 0x09, 0xa6, 0xaf, 0x0c, 0xcf, 0xb4, 0x1c, 0x82,
-	0x49, 0xce, 0x27, 0x15, 0xe7, 0xa2, 0x46, 0xc1, 0x3a, 0xcf, 0xc5, 0xe7, 0xa0, 0xa8, 0x7d, 0x2a,
-	0x9a, 0x85, 0xfc, 0x36, 0xdd, 0x95, 0xe3, 0x8b, 0xf9, 0x9f, 0x68, 0x3e, 0x32, 0xa0, 0x6a, 0x04,
-	0x9f, 0xcf, 0x9d, 0x33, 0x16, 0xcf, 0xc3, 0x6c, 0x9c, 0x61, 0x96, 0xf6, 0xe6, 0x9f, 0x19, 0x30,
-	0xaf, 0xf5, 0x02, 0xd3, 0x2d, 0xea, 0xd0, 0x6e, 0x9d, 0xa2, 0x15, 0x28, 0xf0, 0xb9, 0x74, 0x7b,
-	0xa4, 0xee, 0x4f, 0xf5, 0x9c, 0xea, 0x48, 0xe1, 0x35, 0x9f, 0x80, 0xc3, 0x3a, 0xc1, 0xb2, 0xc8,
-	0xdd, 0x6e, 0x59, 0xf4, 0x5a, 0xc4, 0xa5, 0x0b, 0xf9, 0xe8, 0xb2, 0xd8, 0xe0, 0x85, 0x58, 0xd2,
-	0xcc, 0x77, 0xe1, 0x5e, 0xff, 0x7b, 0xae, 0xd2, 0x4e, 0xaf, 0x4d, 0x18, 0x0d, 0x3f, 0xea, 0xe0,
-	0xa5, 0x77, 0x06, 0x46, 0xb6, 0xad, 0x6e, 0x23, 0xfe, 0x15, 0xdf, 0xb2, 0xba, 0x0d, 0x2c, 0x28,
-	0xe6, 0x36, 0x4c, 0x95, 0x7b, 0x3d, 0xc7, 0xde, 0xa1, 0x8d, 0x1a, 0x23, 0x4d, 0x8a, 0xae, 0x03,
-	0x10, 0x55, 0x50, 0x66, 0x02, 0xba, 0x78, 0xf6, 0x77, 0x4a, 0x72, 0xcf, 0x94, 0xf4, 0x3d, 0x53,
-	0xea, 0x6d, 0x37, 0x79, 0x81, 0x5b, 0xe2, 0x5b, 0xb3, 0xb4, 0xf3, 0x44, 0xe9, 0xaa, 0xd5, 0xa1,
-	0x95, 0xe9, 0xfd, 0xbd, 0x65, 0x28, 0x07, 0x08, 0x58, 0x43, 0x33, 0xbf, 0x67, 0xc0, 0xa9, 0xb2,
-	0xd3, 0xb4, 0xab, 0xab, 0xe5, 0x5e, 0xef, 0x32, 0x25, 0x6d, 0xd6, 0xaa, 0x31, 0xc2, 0x3c, 0x17,
-	0x9d, 0x87, 0x31, 0x57, 0xfc, 0xa5, 0x3a, 0xf3, 0x90, 0xbf, 0x3e, 0x25, 0xfd, 0xd6, 0xde, 0xf2,
-	0x7c, 0x42, 0x43, 0x8a, 0x55, 0x2b, 0xf4, 0x08, 0x8c, 0x77, 0xa8, 0xeb, 0x92, 0xa6, 0x3f, 0xe2,
-	0x33, 0x0a, 0x60, 0xfc, 0x55, 0x59, 0x8c, 0x7d, 0xba, 0xf9, 0xcb, 0x1c, 0xcc, 0x04, 0x58, 0x8a,
-	0xfd, 0x11, 0x4c, 0xaf, 0x07, 0x93, 0x2d, 0xad, 0x87, 0x62, 0x96, 0x8b, 0x67, 0x5f, 0x48, 0xb9,
-	0x93, 0x92, 0x06, 0xa9, 0x32, 0xaf, 0xd8, 0x4c, 0xea, 0xa5, 0x38, 0xc2, 0x06, 0x75, 0x00, 0xdc,
-	0xdd, 0x6e, 0x5d, 0x31, 0x1d, 0x11, 0x4c, 0x9f, 0xcb, 0xc8, 0xb4, 0x16, 0x00, 0x54, 0x90, 0x62,
-	0x09, 0x61, 0x19, 0xd6, 0x18, 0x98, 0x3f, 0x35, 0xe0, 0x64, 0x42, 0x3b, 0xf4, 0x62, 0x6c, 0x3e,
-	0x1f, 0xec, 0x9b, 0x4f, 0xd4, 0xd7, 0x2c, 0x9c, 0xcd, 0xc7, 0x60, 0xc2, 0xa1, 0x3b, 0x16, 0x3f,
-	0x29, 0xd4, 0x08, 0xcf, 0xaa, 0xf6, 0x13, 0x58, 0x95, 0xe3, 0xa0, 0x06, 0x7a, 0x14, 0x0a, 0xfe,
-	0xdf, 0x7c, 0x98, 0xf3, 0x7c, 0x33, 0xf1, 0x89, 0xf3, 0xab, 0xba, 0x38, 0xa4, 0x9b, 0x7f, 0x6f,
-	0xc0, 0x99, 0xb2, 0xc3, 0xac, 0x2d, 0x52, 0x67, 0xb6, 0xb3, 0xfb, 0x06, 0xdd, 0x6c, 0xd9, 0xf6,
-	0x36, 0xa6, 0x75, 0x6a, 0xed, 0x50, 0xa7, 0x6a, 0x77, 0xb7, 0xac, 0x26, 0x7a, 0x13, 0x0a, 0x2e,
-	0xad, 0x3b, 0x94, 0x61, 0xba, 0xa5, 0xb6, 0xc0, 0xc3, 0xda, 0x16, 0x28, 0xf1, 0xb3, 0x90, 0x2f,
-	0xf8, 0x75, 0xbb, 0x4e, 0xda, 0x57, 0x36, 0xbf, 0x4b, 0xeb, 0x2c, 0xd8, 0x95, 0xe1, 0xc2, 0xa9,
-	0xf9, 0x10, 0x38, 0x44, 0x43, 0x65, 0x98, 0xd9, 0xb1, 0x1c, 0xe6, 0x91, 0x36, 0xa6, 0x3d, 0xfb,
-	0xb5, 0x70, 0x0d, 0x9d, 0x56, 0xcd, 0x66, 0xae, 0x45, 0xc9, 0x38, 0x5e, 0xdf, 0xdc, 0x85, 0xf9,
-	0xb2, 0xc7, 0xec, 0x0d, 0xc7, 0xee, 0xd8, 0x5c, 0xce, 0x5d, 0xe9, 0x09, 0x69, 0x87, 0x08, 0xcc,
-	0xb8, 0xb4, 0x4d, 0xeb, 0xfc, 0xd7, 0x86, 0xdd, 0xb6, 0xea, 0x4a, 0xe8, 0x55, 0x9e, 0xf5, 0xa1,
-	0x6b, 0x51, 0xf2, 0xad, 0xbd, 0xe5, 0x6f, 0x44, 0x90, 0x62, 0x74, 0x1c, 0xc7, 0x33, 0x6f, 0xc0,
-	0x62, 0xf9, 0x7d, 0xcf, 0xa1, 0xc7, 0x3d, 0x6c, 0xe6, 0x07, 0xb0, 0x54, 0xb1, 0xd8, 0xa6, 0x57,
-	0xdf, 0xa6, 0xec, 0xd8, 0x99, 0xff, 0x21, 0x8c, 0x56, 0x5b, 0xc4, 0x61, 0x5c, 0xca, 0x38, 0xb4,
-	0x67, 0xbf, 0x8e, 0xd7, 0xd5, 0xc8, 0x06, 0x52, 0x06, 0xcb, 0x62, 0xec, 0xd3, 0x53, 0x08, 0x88,
-	0x47, 0x60, 0x7c, 0x87, 0x3a, 0x62, 0x8d, 0xe7, 0xa3, 0x60, 0xd7, 0x64, 0x31, 0xf6, 0xe9, 0xe6,
-	0x3f, 0x1b, 0x30, 0x2f, 0xbe, 0x60, 0xd5, 0x72, 0xeb, 0xf6, 0x0e, 0x75, 0x76, 0x31, 0x75, 0xbd,
-	0xf6, 0x21, 0x7f, 0xd0, 0x2a, 0xcc, 0xba, 0xb4, 0x23, 0x47, 0xd4, 0x65, 0x0e, 0xb1, 0xba, 0x4c,
-	0x7d, 0xd9, 0x82, 0xaa, 0x3d, 0x5b, 0x8b, 0xd1, 0x71, 0x5f, 0x0b, 0xf4, 0x30, 0x4c, 0xa8, 0xcf,
-	0xe6, 0xe2, 0x87, 0x6f, 0xc6, 0x49, 0xbe, 0x6f, 0x55, 0x9f, 0x5c, 0x1c, 0x50, 0xcd, 0x7f, 0x37,
-	0x60, 0x4e, 0xf4, 0xaa, 0xe6, 0x6d, 0xba, 0x75, 0xc7, 0x12, 0xcb, 0xf8, 0x6e, 0xec, 0xd2, 0x79,
-	0x98, 0x6e, 0xf8, 0x03, 0xbf, 0x6e, 0x75, 0x2c, 0x26, 0xe4, 0xea, 0x68, 0xe5, 0x1e, 0x85, 0x31,
-	0xbd, 0x1a, 0xa1, 0xe2, 0x58, 0x6d, 0xf3, 0x67, 0x39, 0x98, 0xaa, 0xb6, 0x3d, 0x97, 0x05, 0x8b,
-	0xf5, 0xf7, 0x60, 0xa2, 0xa3, 0x34, 0x24, 0xb5, 0x56, 0x7f, 0x37, 0xdd, 0x11, 0x2b, 0x17, 0x2e,
-	0xd7, 0xae, 0x42, 0xd1, 0x1c, 0x96, 0xe1, 0x00, 0x15, 0xbd, 0x09, 0x23, 0x6e, 0x8f, 0xd6, 0xc5,
-	0xd8, 0x14, 0xcf, 0x3e, 0x9b, 0xee, 0x04, 0x88, 0x7c, 0x64, 0xad, 0x47, 0xeb, 0xe1, 0xa0, 0xf2,
-	0x5f, 0x58, 0x40, 0x22, 0x12, 0xc8, 0xf6, 0x7c, 0x96, 0xe3, 0x25, 0x0a, 0x2e, 0x8f, 0x97, 0xe9,
-	0xe8, 0xb1, 0xe0, 0x1f, 0x00, 0xe6, 0x3f, 0xf2, 0xa5, 0xa1, 0xd7, 0x5f, 0xb7, 0x5c, 0x86, 0xde,
-	0xee, 0x1b, 0xb5, 0x52, 0xba, 0x51, 0xe3, 0xad, 0xc5, 0x98, 0x05, 0xc7, 0x88, 0x5f, 0xa2, 0x8d,
-	0xd8, 0x77, 0x60, 0xd4, 0x62, 0xb4, 0xe3, 0xeb, 0xbc, 0x4f, 0x0e, 0xd1, 0xab, 0x50, 0x89, 0x5b,
-	0xe3, 0x48, 0x58, 0x02, 0x9a, 0x9f, 0xc4, 0x7b, 0xc3, 0x07, 0x93, 0xab, 0xda, 0xb3, 0x37, 0xa2,
-	0xa2, 0xcc, 0x57, 0xf2, 0x53, 0x6a, 0x09, 0x89, 0x82, 0x30, 0x5c, 0xd9, 0x31, 0xb2, 0x8b, 0xfb,
-	0xd8, 0x99, 0x9f, 0xe4, 0xe1, 0x64, 0xc2, 0xbc, 0xa0, 0x3a, 0x40, 0xdd, 0xee, 0x36, 0x2c, 0x69,
-	0x04, 0xc8, 0x8f, 0x5a, 0x49, 0x37, 0xd6, 0x55, 0xbf, 0x5d, 0xb8, 0x40, 0x83, 0x22, 0x17, 0x6b,
-	0xb0, 0xe8, 0x15, 0x40, 0xf6, 0xa6, 0xb0, 0x12, 0x1b, 0x97, 0xa4, 0xad, 0xe5, 0xcb, 0xc2, 0x7c,
-	0x65, 0x51, 0xb5, 0x45, 0x57, 0xfa, 0x6a, 0xe0, 0x84, 0x56, 0x1c, 0xab, 0x4d, 0x5c, 0x76, 0x99,
-	0x74, 0x1b, 0x6d, 0xda, 0xc0, 0x74, 0xcb, 0xa1, 0x6e, 0x4b, 0x6c, 0xd3, 0x42, 0x88, 0xb5, 0xde,
-	0x57, 0x03, 0x27, 0xb4, 0x42, 0xdf, 0x4b, 0x9a, 0x18, 0xb9, 0x28, 0x5e, 0x1c, 0x6a, 0x62, 0x56,
-	0x29, 0x23, 0x56, 0xdb, 0xcd, 0x34, 0x33, 0x42, 0xe4, 0xcb, 0x99, 0x09, 0x8e, 0xe7, 0xab, 0xc4,
-	0xdd, 0xbe, 0x5b, 0x45, 0x47, 0xe4, 0x23, 0x07, 0x89, 0x0e, 0xf3, 0x5f, 0x0d, 0x58, 0x48, 0xea,
-	0xd5, 0x31, 0x6c, 0xef, 0x77, 0xa3, 0xdb, 0xfb, 0xf9, 0x4c, 0xdb, 0x3b, 0xf2, 0xb1, 0x03, 0x76,
-	0xf9, 0x5b, 0x30, 0x59, 0xf5, 0x1c, 0x87, 0x76, 0x99, 0x34, 0xa4, 0xbe, 0x05, 0xa3, 0xae, 0xd5,
-	0x55, 0xf6, 0x44, 0x36, 0x1b, 0xaa, 0xc0, 0xc1, 0x6b, 0xbc, 0x31, 0x96, 0x18, 0xe6, 0x5f, 0xe4,
-	0xe1, 0xa4, 0x7f, 0xca, 0xd0, 0x86, 0xaf, 0xc0, 0xba, 0xa8, 0x01, 0x93, 0x8d, 0xb0, 0x98, 0x29,
-	0x85, 0x3f, 0x0b, 0xaf, 0xc0, 0xa8, 0xd0, 0xe0, 0x19, 0x8e, 0xa0, 0xa2, 0x37, 0x20, 0xdf, 0xb4,
-	0x98, 0x92, 0x03, 0xe7, 0xd2, 0x8d, 0xdc, 0x25, 0x2b, 0xae, 0xad, 0x54, 0x8a, 0x8a, 0x55, 0xfe,
-	0x92, 0xc5, 0x30, 0x47, 0x44, 0x9b, 0x30, 0x66, 0x75, 0x48, 0x93, 0x66, 0x9c, 0x95, 0x35, 0xde,
-	0x26, 0x8e, 0x1e, 0x9c, 0x25, 0x82, 0xea, 0x62, 0x85, 0xcc, 0x79, 0xd4, 0xb9, 0x96, 0x21, 0x6d,
-	0x83, 0xf4, 0x33, 0x9f, 0xa0, 0x6f, 0x85, 0x3c, 0x04, 0xd5, 0xc5, 0x0a, 0xd9, 0xfc, 0x32, 0x07,
-	0xb3, 0xe1, 0xf8, 0x55, 0xed, 0x4e, 0xc7, 0x62, 0x68, 0x11, 0x72, 0x56, 0x43, 0x29, 0x31, 0xa0,
-	0x1a, 0xe6, 0xd6, 0x56, 0x71, 0xce, 0x6a, 0xa0, 0x87, 0x60, 0x6c, 0xd3, 0x21, 0xdd, 0x7a, 0x4b,
-	0x29, 0x2f, 0x01, 0x70, 0x45, 0x94, 0x62, 0x45, 0x45, 0xf7, 0x43, 0x9e, 0x91, 0xa6, 0xd2, 0x59,
-	0x82, 0xf1, 0xbb, 0x4a, 0x9a, 0x98, 0x97, 0x73, 0x65, 0xc9, 0xf5, 0xc4, 0x1e, 0x56, 0xb2, 0x2e,
-	0x50, 0x96, 0x6a, 0xb2, 0x18, 0xfb, 0x74, 0xce, 0x91, 0x78, 0xac, 0x65, 0x3b, 0x0b, 0xa3, 0x51,
-	0x8e, 0x65, 0x51, 0x8a, 0x15, 0x95, 0x9b, 0xc2, 0x75, 0xf1, 0xfd, 0x8c, 0x3a, 0x0b, 0x63, 0x51,
-	0x53, 0xb8, 0xea, 0x13, 0x70, 0x58, 0x07, 0xbd, 0x03, 0xc5, 0xba, 0x43, 0x09, 0xb3, 0x9d, 0x55,
-	0xc2, 0xe8, 0xc2, 0x78, 0xe6, 0x15, 0x38, 0xb3, 0xbf, 0xb7, 0x5c, 0xac, 0x86, 0x10, 0x58, 0xc7,
-	0x33, 0xbf, 0x9f, 0x87, 0x85, 0x70, 0x68, 0xc5, 0xdc, 0x86, 0x1e, 0x10, 0x35, 0x3c, 0xc6, 0x80,
-	0xe1, 0x79, 0x08, 0xc6, 0x1a, 0x56, 0x93, 0xba, 0x2c, 0x3e, 0xca, 0xab, 0xa2, 0x14, 0x2b, 0x2a,
-	0xfa, 0xd3, 0x98, 0xd7, 0x6b, 0x54, 0x2c, 0x94, 0x2b, 0xe9, 0x16, 0xca, 0xa0, 0x8f, 0x1b, 0xc2,
-	0xf5, 0x85, 0xde, 0x80, 0x82, 0xe8, 0xfb, 0x90, 0x7b, 0x59, 0x98, 0xbd, 0x55, 0x1f, 0x00, 0x87,
-	0x58, 0x77, 0xec, 0x18, 0xfb, 0x00, 0x96, 0x56, 0xed, 0xfa, 0x36, 0x75, 0x2e, 0x7b, 0x9b, 0xc7,
-	0x6e, 0x7f, 0xbd, 0x05, 0xe8, 0xc2, 0xcd, 0x9e, 0x43, 0x5d, 0x6e, 0x37, 0x5c, 0x23, 0x8e, 0x45,
-	0x36, 0xdb, 0xf4, 0xb0, 0x1c, 0xaf, 0x9f, 0x8f, 0xc0, 0xf8, 0x45, 0x87, 0x5a, 0xcd, 0x16, 0x3b,
-	0x86, 0xb3, 0xf5, 0x01, 0x18, 0x25, 0x6d, 0x8b, 0xb8, 0x62, 0x9b, 0x68, 0x9f, 0x54, 0xe6, 0x85,
-	0x58, 0xd2, 0xd0, 0x5b, 0x30, 0x66, 0x3b, 0x56, 0xd3, 0xea, 0x2e, 0x14, 0xc4, 0x47, 0xa4, 0x54,
-	0x45, 0x55, 0x2f, 0xae, 0x88, 0xa6, 0xe1, 0x5a, 0x97, 0xbf, 0xb1, 0x82, 0x44, 0xd7, 0x61, 0x5c,
-	0xee, 0x5d, 0x5f, 0x1e, 0xae, 0xa4, 0x96, 0xe7, 0x72, 0xfb, 0x87, 0x32, 0x46, 0xfe, 0x76, 0xb1,
-	0x0f, 0x88, 0x6a, 0x81, 0x38, 0x1f, 0x11, 0xd0, 0x8f, 0x66, 0x10, 0xe7, 0x03, 0xe5, 0x77, 0x2d,
-	0x90, 0xdf, 0xa3, 0x59, 0x40, 0x85, 0x84, 0x1e, 0x24, 0xb0, 0xf9, 0x10, 0x2b, 0x1b, 0x66, 0x6c,
-	0x88, 0x21, 0x3e, 0xc0, 0x7a, 0xf9, 0x61, 0x1e, 0xe6, 0x54, 0xcd, 0xaa, 0xdd, 0x56, 0x1e, 0x14,
-	0x75, 0x1c, 0xe4, 0x13, 0x8f, 0x03, 0xcb, 0x57, 0x4e, 0xe4, 0x11, 0x5b, 0xc9, 0xf4, 0x35, 0x21,
-	0x8f, 0x92, 0x50, 0x48, 0xa4, 0xb0, 0x09, 0x66, 0x49, 0xd5, 0x52, 0x6a, 0x0a, 0xfa, 0x13, 0x03,
-	0x4e, 0xee, 0x50, 0xc7, 0xda, 0xb2, 0xea, 0x42, 0x18, 0x5c, 0xb6, 0x5c, 0x66, 0x3b, 0xbb, 0xea,
-	0x00, 0x7e, 0x26, 0x1d, 0xe7, 0x6b, 0x1a, 0xc0, 0x5a, 0x77, 0xcb, 0xae, 0xdc, 0xa7, 0xb8, 0x9d,
-	0xbc, 0xd6, 0x0f, 0x8d, 0x93, 0xf8, 0x2d, 0xf6, 0x00, 0xc2, 0xaf, 0x4d, 0x90, 0x45, 0xeb, 0xfa,
-	0xe6, 0x4d, 0xfd, 0x61, 0x7e, 0x67, 0x7d, 0xc9, 0xa2, 0xcb, 0xb0, 0x57, 0xe1, 0xb4, 0x3f, 0x62,
-	0x5c, 0x2e, 0x5a, 0x76, 0xb7, 0xea, 0x58, 0x8c, 0x3a, 0x16, 0x41, 0x67, 0x01, 0x68, 0x20, 0x61,
-	0x94, 0x44, 0x09, 0x36, 0x72, 0x28, 0x7b, 0xb0, 0x56, 0xcb, 0xfc, 0xb9, 0x01, 0x45, 0x85, 0x77,
-	0x0c, 0xea, 0x2b, 0x8e, 0xaa, 0xaf, 0x8f, 0x67, 0x1a, 0x8e, 0x01, 0x1a, 0xab, 0x03, 0x53, 0x11,
-	0x99, 0x81, 0x9e, 0x56, 0xe1, 0x02, 0x39, 0x00, 0xbf, 0xa5, 0x87, 0x0b, 0x6e, 0xed, 0x2d, 0xcf,
-	0x45, 0x2a, 0x87, 0x31, 0x84, 0x83, 0xfd, 0x30, 0xcf, 0x4f, 0xfc, 0xe8, 0xaf, 0x96, 0x4f, 0x7c,
-	0xf8, 0xeb, 0x33, 0x27, 0xb8, 0xc5, 0x39, 0x1b, 0x9f, 0xa4, 0x14, 0xa2, 0x3c, 0x14, 0x89, 0x13,
-	0x47, 0x2a, 0x12, 0x73, 0x47, 0x27, 0x12, 0xf3, 0x47, 0x21, 0x12, 0x47, 0x0e, 0x4d, 0x24, 0x9a,
-	0xff, 0x64, 0xc0, 0x74, 0x30, 0x33, 0xef, 0x79, 0x5c, 0x2f, 0x0a, 0x47, 0xdd, 0x38, 0xfc, 0x51,
-	0x7f, 0x17, 0xc6, 0x5d, 0xdb, 0x73, 0xea, 0x42, 0xf9, 0xe7, 0xe8, 0x4f, 0x65, 0x93, 0xc1, 0xb2,
-	0xad, 0xa6, 0xf1, 0xca, 0x02, 0xec, 0xa3, 0x9a, 0xbf, 0xcc, 0x07, 0x1d, 0x52, 0x34, 0xa9, 0x10,
-	0x3a, 0x5c, 0x5d, 0xe6, 0x1d, 0x9a, 0xd0, 0x15, 0x42, 0x5e, 0x8a, 0x15, 0x15, 0x99, 0xe2, 0x78,
-	0xf0, 0xed, 0x92, 0x42, 0x05, 0x94, 0x94, 0x17, 0x93, 0x20, 0x29, 0xa8, 0x07, 0xb3, 0x0e, 0x7d,
-	0xcf, 0xb3, 0x1c, 0xda, 0xa8, 0xd9, 0x64, 0x9b, 0x2b, 0x60, 0xca, 0x21, 0x96, 0x72, 0xdf, 0xaf,
-	0x7a, 0xd2, 0x79, 0x51, 0x99, 0xdf, 0xdf, 0x5b, 0x9e, 0xc5, 0x31, 0x2c, 0xdc, 0x87, 0x8e, 0x6c,
-	0x98, 0x27, 0x3b, 0xc4, 0x6a, 0x93, 0x4d, 0xab, 0x6d, 0xb1, 0xdd, 0x1a, 0x73, 0x08, 0xa3, 0xcd,
-	0x5d, 0xa5, 0xfa, 0xbf, 0xa0, 0xfa, 0x32, 0x5f, 0x4e, 0xa8, 0x73, 0x6b, 0x6f, 0xf9, 0x3e, 0x35,
-	0x16, 0x49, 0x64, 0x9c, 0x08, 0x8c, 0x7e, 0x60, 0xc0, 0x3c, 0x49, 0x08, 0x35, 0x08, 0x13, 0x22,
-	0xb5, 0x25, 0x95, 0x14, 0xac, 0xa8, 0x2c, 0x88, 0x2f, 0x4d, 0xa0, 0xe0, 0x44, 0x8e, 0xe6, 0xaf,
-	0xc6, 0x03, 0x61, 0xa5, 0x7c, 0x54, 0x1f, 0x40, 0xb1, 0x2e, 0xed, 0xed, 0xf6, 0xee, 0x5a, 0x57,
-	0x6d, 0xaf, 0xd5, 0x21, 0xce, 0xf1, 0x52, 0x35, 0x84, 0x89, 0x29, 0xea, 0x1a, 0x05, 0xeb, 0xdc,
-	0xd0, 0x0d, 0x00, 0x79, 0xa8, 0xd1, 0xc6, 0x5a, 0x57, 0x9d, 0xda, 0xd5, 0x61, 0x78, 0x5f, 0x0b,
-	0x50, 0x24, 0xeb, 0xe0, 0xd4, 0x09, 0x09, 0x58, 0x63, 0xc5, 0x7b, 0xed, 0x07, 0x54, 0x2f, 0xda,
-	0x8e, 0x92, 0x57, 0x43, 0xf5, 0xba, 0x1c, 0xc2, 0xc4, 0xcd, 0x93, 0x90, 0x82, 0x75, 0x6e, 0xc8,
-	0xd6, 0x8e, 0x38, 0x29, 0x79, 0xca, 0xc3, 0x70, 0xf6, 0x93, 0x03, 0x24, 0xdb, 0xe0, 0xd4, 0xf3,
-	0x8b, 0xc3, 0x53, 0x6f, 0xd1, 0x81, 0xd9, 0xf8, 0xe4, 0x24, 0xa8, 0x0a, 0x97, 0xa3, 0xaa, 0xc2,
-	0xd9, 0x94, 0xd2, 0x50, 0x73, 0xd6, 0xe8, 0x39, 0x04, 0x0e, 0xcc, 0xc4, 0x26, 0x25, 0x81, 0xe5,
-	0x5a, 0x94, 0xe5, 0x93, 0x59, 0xd4, 0x26, 0x15, 0x69, 0xd7, 0x79, 0xba, 0x30, 0x1b, 0x9f, 0x8e,
-	0x43, 0x63, 0x1a, 0x09, 0xef, 0xeb, 0x4c, 0x3f, 0x80, 0xa9, 0xc8, 0x4c, 0x24, 0x70, 0xbc, 0x1a,
-	0xe5, 0x78, 0x5e, 0x13, 0x6c, 0x61, 0x2e, 0xcf, 0xbb, 0x41, 0xb2, 0x4f, 0x28, 0xe3, 0x22, 0x15,
-	0xb8, 0xb0, 0x7b, 0xa5, 0x76, 0xe5, 0x35, 0x5d, 0x19, 0xfb, 0xcb, 0x1c, 0x14, 0x82, 0xf3, 0x33,
-	0x4b, 0xd0, 0x47, 0xaa, 0xd1, 0xb9, 0x03, 0xbc, 0x2a, 0xf9, 0x34, 0x5e, 0x95, 0x91, 0xc1, 0x5e,
-	0x15, 0x3f, 0x99, 0x60, 0xec, 0xf6, 0xc9, 0x04, 0x9a, 0x57, 0x65, 0x3c, 0xbd, 0x57, 0x65, 0xe2,
-	0x60, 0xaf, 0x8a, 0xf9, 0xd7, 0x06, 0xa0, 0x7e, 0x17, 0x5a, 0x96, 0x81, 0x22, 0x71, 0xad, 0xe6,
-	0x99, 0xac, 0xfe, 0x8c, 0x83, 0x94, 0x1b, 0xf3, 0x26, 0xdc, 0x77, 0xc9, 0x62, 0x5f, 0x87, 0x4b,
-	0x40, 0x72, 0x5e, 0x27, 0xc7, 0xcf, 0xf9, 0xa3, 0x71, 0x98, 0xb9, 0x64, 0x0d, 0x1d, 0xb3, 0x64,
-	0x70, 0x5a, 0x8e, 0x5e, 0x10, 0x6b, 0x0f, 0x8e, 0x71, 0xb9, 0xa6, 0x9f, 0x57, 0x4d, 0x4f, 0x57,
-	0x93, 0xab, 0xdd, 0x1a, 0x4c, 0xc2, 0x83, 0xa0, 0x53, 0x6f, 0x8c, 0x17, 0x60, 0xca, 0x65, 0x8e,
-	0x55, 0x67, 0x32, 0x2a, 0xea, 0x2e, 0x14, 0x85, 0x9a, 0x74, 0x4a, 0x55, 0x9f, 0xaa, 0xe9, 0x44,
-	0x1c, 0xad, 0x9b, 0x18, 0x6c, 0x1d, 0xc9, 0x1c, 0x6c, 0x5d, 0x81, 0x02, 0x69, 0xb7, 0xed, 0x1b,
-	0x57, 0x49, 0xd3, 0x55, 0xae, 0xca, 0x60, 0x42, 0xca, 0x3e, 0x01, 0x87, 0x75, 0xd0, 0xcb, 0x30,
-	0x1b, 0xfc, 0xc0, 0xb4, 0x49, 0x6f, 0x52, 0x77, 0x61, 0x4a, 0x68, 0x6d, 0x42, 0xaf, 0x2a, 0xc7,
-	0x68, 0xb8, 0xaf, 0x36, 0x2a, 0x01, 0x58, 0xcd, 0xae, 0xed, 0x50, 0xc1, 0x73, 0x4c, 0xb4, 0x15,
-	0x69, 0x4c, 0x6b, 0x41, 0x29, 0xd6, 0x6a, 0xa0, 0x2a, 0xcc, 0x85, 0xbf, 0x7c, 0x96, 0xd3, 0xa2,
-	0xd9, 0xa9, 0xfd, 0xbd, 0xe5, 0xb9, 0xb5, 0x38, 0x11, 0xf7, 0xd7, 0xe7, 0xa3, 0x15, 0x1a, 0x93,
-	0x17, 0xad, 0x36, 0x17, 0x0c, 0x93, 0xd1, 0xd1, 0xba, 0x10, 0xa3, 0xe3, 0xbe, 0x16, 0xa8, 0x06,
-	0xa7, 0xac, 0xae, 0x4b, 0xeb, 0x9e, 0x43, 0x6b, 0xdb, 0x56, 0xef, 0xea, 0x7a, 0x4d, 0x9c, 0x31,
-	0xbb, 0x42, 0x1c, 0x4d, 0x54, 0xee, 0x57, 0x50, 0xa7, 0xd6, 0x92, 0x2a, 0xe1, 0xe4, 0xb6, 0xe8,
-	0x29, 0x98, 0xb4, 0xba, 0xf5, 0xb6, 0xd7, 0xa0, 0x1b, 0x84, 0xb5, 0xdc, 0x85, 0x09, 0xd1, 0xb5,
-	0xd9, 0xfd, 0xbd, 0xe5, 0xc9, 0x35, 0xad, 0x1c, 0x47, 0x6a, 0xf1, 0x56, 0xf4, 0xa6, 0xd6, 0xaa,
-	0x10, 0xb6, 0xba, 0x70, 0x53, 0x6f, 0xa5, 0xd7, 0x4a, 0x88, 0xad, 0x43, 0xa6, 0xd8, 0xfa, 0x0d,
-	0x58, 0xbc, 0x64, 0x31, 0x4a, 0xbe, 0x0e, 0x09, 0x74, 0x99, 0x38, 0x9b, 0xb6, 0x73, 0xec, 0x9c,
-	0x7f, 0x92, 0x83, 0x31, 0x99, 0x01, 0x86, 0x9e, 0x8e, 0xa5, 0x59, 0xdd, 0xdf, 0x97, 0x66, 0x55,
-	0x4c, 0xca, 0x96, 0x33, 0x61, 0xcc, 0x72, 0x5d, 0x2f, 0x6a, 0xde, 0xac, 0x89, 0x12, 0xac, 0x28,
-	0x22, 0x6c, 0x22, 0xba, 0xa2, 0xfc, 0xd0, 0x77, 0x78, 0xf6, 0x4b, 0x1e, 0x72, 0x70, 0xb0, 0x42,
-	0xe6, 0x3c, 0x6c, 0x8f, 0xf5, 0x3c, 0xa6, 0x0c, 0x8a, 0x43, 0xe1, 0x71, 0x45, 0x20, 0x62, 0x85,
-	0x6c, 0x7e, 0x62, 0xc0, 0x8c, 0x1c, 0x83, 0x6a, 0x8b, 0xd6, 0xb7, 0x6b, 0x8c, 0xf6, 0xd0, 0x19,
-	0x18, 0xf1, 0x5c, 0xea, 0xc6, 0xfd, 0x0d, 0xaf, 0xbb, 0xd4, 0xc5, 0x82, 0xa2, 0xf5, 0x3e, 0x77,
-	0x54, 0xbd, 0x37, 0xcf, 0x81, 0x36, 0x39, 0x22, 0x85, 0x51, 0x66, 0xf2, 0x49, 0x0d, 0x2c, 0x1f,
-	0x1e, 0x22, 0xb2, 0xd6, 0x2e, 0xf6, 0xe9, 0xe6, 0x4f, 0x73, 0x30, 0x2a, 0x5c, 0x02, 0x59, 0x4e,
-	0x9e, 0x03, 0x42, 0x49, 0x61, 0xac, 0x64, 0xe4, 0xb6, 0xb1, 0x12, 0x37, 0x29, 0x54, 0xf2, 0x62,
-	0x06, 0xaf, 0xc6, 0x30, 0x29, 0xc1, 0x77, 0x1a, 0xbe, 0xf8, 0x8d, 0x01, 0xf3, 0x49, 0x41, 0xc3,
-	0x2c, 0xe3, 0xf7, 0x18, 0x4c, 0xf4, 0xda, 0x84, 0x6d, 0xd9, 0x4e, 0x27, 0x9e, 0x94, 0xb8, 0xa1,
-	0xca, 0x71, 0x50, 0x03, 0x39, 0x00, 0x8e, 0xbf, 0x9f, 0x7d, 0xdf, 0xcf, 0xf9, 0x3b, 0x0b, 0x28,
-	0x85, 0xb6, 0x61, 0x50, 0xe4, 0x62, 0x8d, 0x8b, 0xf9, 0xd9, 0x28, 0xcc, 0x89, 0x26, 0xc3, 0x2a,
-	0x27, 0x3d, 0xb8, 0x47, 0x78, 0x98, 0xfa, 0x75, 0x13, 0xb9, 0x6a, 0xce, 0xa9, 0x96, 0xf7, 0xac,
-	0x25, 0xd6, 0xba, 0x35, 0x90, 0x82, 0x07, 0xe0, 0xf6, 0x2b, 0x1c, 0x90, 0x41, 0xe1, 0x38, 0x2b,
-	0xb2, 0x54, 0x7c, 0x55, 0xa3, 0x18, 0xf5, 0xda, 0x6a, 0x4a, 0x86, 0x56, 0xeb, 0xff, 0x8c, 0x7a,
-	0xa1, 0xaf, 0xd6, 0xf1, 0x03, 0x57, 0xeb, 0x40, 0x35, 0x62, 0xe2, 0x0e, 0xd4, 0x88, 0xfe, 0xa3,
-	0xbd, 0x90, 0xe9, 0x68, 0xff, 0xd4, 0x80, 0xf1, 0x0d, 0xc7, 0x16, 0xd1, 0xeb, 0xa3, 0x8f, 0xcc,
-	0xbd, 0x15, 0xcb, 0x6a, 0x7b, 0x32, 0x75, 0xde, 0x0b, 0x07, 0x3b, 0x20, 0x22, 0xf4, 0xb3, 0x1c,
-	0x4c, 0xa9, 0x9a, 0x77, 0x77, 0x06, 0x60, 0xe4, 0x23, 0x0f, 0x3b, 0x03, 0x30, 0x0a, 0x7e, 0x70,
-	0x06, 0x60, 0xa4, 0xfe, 0x5d, 0x9b, 0x01, 0x18, 0xf9, 0xca, 0x41, 0x19, 0x80, 0xb9, 0x58, 0x6f,
-	0x44, 0x06, 0xe0, 0x1f, 0xc0, 0x5c, 0xcf, 0xf7, 0x73, 0x8a, 0x04, 0x6b, 0x8b, 0xfa, 0x11, 0xc0,
-	0xa7, 0x33, 0x66, 0x5d, 0xc9, 0xfc, 0xec, 0xca, 0xbd, 0x8a, 0xfb, 0xdc, 0x46, 0x1c, 0x17, 0xf7,
-	0xb3, 0x4a, 0xce, 0x40, 0xcc, 0x1d, 0x7f, 0x06, 0x62, 0xc2, 0xba, 0xf8, 0xff, 0x0c, 0xc4, 0xaf,
-	0x3d, 0x03, 0xf1, 0xe7, 0x06, 0x14, 0xd5, 0xcc, 0xdc, 0xb5, 0xf1, 0x4d, 0xf5, 0x7d, 0x03, 0x76,
-	0xdd, 0x17, 0x06, 0x4c, 0x6a, 0xf2, 0xd9, 0x45, 0x2d, 0x80, 0x1b, 0xc4, 0xa1, 0x2d, 0x3b, 0xd0,
-	0xfe, 0x53, 0x47, 0x9d, 0xde, 0xf0, 0xdb, 0x09, 0xa4, 0x70, 0x65, 0x05, 0xe5, 0x2e, 0xd6, 0xb0,
-	0xd1, 0x77, 0xb4, 0x00, 0x92, 0x14, 0xee, 0xa9, 0xb8, 0x08, 0x1f, 0xad, 0xe4, 0xa0, 0x0b, 0x46,
-	0x2d, 0xec, 0x64, 0xfe, 0xc2, 0x08, 0x8e, 0x92, 0xc4, 0xad, 0x92, 0x3f, 0x9a, 0xad, 0x52, 0x83,
-	0x51, 0x2e, 0x99, 0xfd, 0x2b, 0x45, 0x67, 0x33, 0x9f, 0x8e, 0xae, 0xca, 0x6a, 0xe4, 0x7f, 0x62,
-	0x89, 0x65, 0xfe, 0x38, 0x07, 0x85, 0x40, 0x52, 0x1d, 0xc3, 0x91, 0xf8, 0x7a, 0xe4, 0x48, 0x7c,
-	0x32, 0xa3, 0x8c, 0x1d, 0x78, 0x1c, 0xbe, 0x13, 0x3b, 0x0e, 0xb3, 0x0a, 0xef, 0x03, 0x8e, 0xc2,
-	0x7f, 0x90, 0x33, 0x2e, 0xeb, 0x1e, 0xc3, 0x56, 0xbc, 0x1a, 0xdd, 0x8a, 0x2b, 0x19, 0x7b, 0x33,
-	0x60, 0x33, 0x7e, 0x98, 0x83, 0x99, 0xd8, 0x71, 0x85, 0x1e, 0x10, 0x8b, 0xaa, 0xe9, 0x07, 0xfe,
-	0x83, 0x86, 0x2a, 0x54, 0x21, 0x68, 0x68, 0x87, 0x9b, 0x08, 0x81, 0xf1, 0x60, 0x3b, 0x6a, 0x90,
-	0x5f, 0x1a, 0xea, 0x84, 0xf4, 0x41, 0x2a, 0x73, 0xd2, 0xba, 0xd0, 0x70, 0x71, 0x94, 0x0d, 0xda,
-	0x88, 0xc5, 0x3e, 0x2f, 0x74, 0xc9, 0x66, 0x9b, 0xca, 0xd0, 0xc3, 0x44, 0xe5, 0x1b, 0x41, 0xb4,
-	0x35, 0xa1, 0x0e, 0x4e, 0x6c, 0x69, 0xfe, 0x8d, 0x01, 0xa7, 0x07, 0x7c, 0x4f, 0x8a, 0x14, 0x88,
-	0x36, 0x4c, 0x89, 0x4b, 0xba, 0xc1, 0x38, 0xf8, 0xab, 0x38, 0xdd, 0xcc, 0xeb, 0x4d, 0x65, 0xef,
-	0x23, 0x45, 0x38, 0x0a, 0x6e, 0x7e, 0x96, 0x03, 0x14, 0x7c, 0x6b, 0x96, 0x4c, 0x8d, 0x77, 0x60,
-	0x7c, 0x4b, 0x46, 0xfb, 0xee, 0x2c, 0x73, 0xa7, 0x52, 0xd4, 0x93, 0x97, 0x7c, 0x4c, 0xf4, 0xe6,
-	0xe1, 0xec, 0x35, 0xe8, 0xdf, 0x67, 0xe8, 0x3a, 0xc0, 0x96, 0xd5, 0xb5, 0xdc, 0xd6, 0x90, 0xd9,
-	0x97, 0xc2, 0xa6, 0xbb, 0x18, 0x20, 0x60, 0x0d, 0xcd, 0xfc, 0xf3, 0x9c, 0xb6, 0x87, 0x85, 0xf2,
-	0x97, 0x6a, 0xed, 0x3f, 0x12, 0x1d, 0xcc, 0x42, 0x7f, 0x56, 0x57, 0x30, 0x30, 0xd7, 0x61, 0x64,
-	0x87, 0x38, 0x7e, 0x46, 0x48, 0xca, 0x24, 0xed, 0xfe, 0xb4, 0xca, 0x70, 0x4e, 0xaf, 0x11, 0xc7,
-	0xc5, 0x02, 0x93, 0x2b, 0xc6, 0x2e, 0xa3, 0x3d, 0xff, 0x70, 0xc9, 0x2c, 0x38, 0x19, 0xed, 0xe9,
-	0x1d, 0xa4, 0x3d, 0x71, 0x02, 0xd0, 0x9e, 0x6b, 0xfe, 0xc7, 0xb8, 0x26, 0x15, 0xd4, 0x79, 0x76,
-	0x98, 0x9a, 0xd4, 0xd3, 0xfe, 0x25, 0x6b, 0x39, 0xca, 0xcb, 0x91, 0x4b, 0xd6, 0xb7, 0xf6, 0x96,
-	0xa7, 0xc3, 0xfd, 0xa8, 0x5d, 0xbb, 0xce, 0x70, 0x9d, 0x58, 0x5f, 0xef, 0xa3, 0x47, 0xb0, 0xde,
-	0x7f, 0x1f, 0xe6, 0xb6, 0xe2, 0x69, 0x7e, 0x2a, 0xc7, 0xfa, 0xd9, 0x21, 0xb3, 0x04, 0xa5, 0x17,
-	0xa1, 0xaf, 0x18, 0xf7, 0x33, 0x42, 0xb6, 0x7f, 0x89, 0x59, 0xf8, 0x4e, 0x65, 0x24, 0x20, 0xf5,
-	0x9e, 0x8b, 0x79, 0x5d, 0xe3, 0xd7, 0x97, 0x25, 0x24, 0x8e, 0x30, 0x40, 0x6f, 0x40, 0xc1, 0x65,
-	0xc4, 0x91, 0x09, 0xd0, 0x93, 0xc3, 0x25, 0x40, 0xd7, 0x7c, 0x00, 0x1c, 0x62, 0xc5, 0x36, 0xf7,
-	0xd8, 0x61, 0x6e, 0x6e, 0xf4, 0x74, 0x90, 0x89, 0xc2, 0xfb, 0x29, 0xbc, 0x1c, 0xf9, 0xbe, 0x1c,
-	0x12, 0x4e, 0xc2, 0x7a, 0x3d, 0xf4, 0xb1, 0x01, 0xa7, 0xf8, 0x2e, 0xb8, 0x70, 0x93, 0xd6, 0x3d,
-	0x3e, 0xdc, 0x7e, 0x34, 0x7e, 0xa1, 0x98, 0xc5, 0x06, 0xab, 0x25, 0x41, 0x84, 0x2e, 0x9b, 0x44,
-	0x32, 0x4e, 0x66, 0x8c, 0xde, 0x95, 0x5a, 0x1e, 0x15, 0x6e, 0xb8, 0x3b, 0xf7, 0x7a, 0x07, 0x1a,
-	0x9f, 0x14, 0x68, 0x8c, 0x9a, 0x3f, 0x1e, 0xd1, 0xe5, 0x60, 0x3a, 0x5f, 0xfc, 0x75, 0x18, 0x61,
-	0xc4, 0xdd, 0x56, 0xdb, 0xeb, 0xc5, 0x21, 0xee, 0x23, 0x85, 0x9b, 0x6c, 0x82, 0x63, 0x8b, 0x22,
-	0x81, 0x89, 0x16, 0x21, 0x47, 0xdc, 0x78, 0x36, 0x41, 0xd9, 0xc5, 0x39, 0xe2, 0x8a, 0x4c, 0x83,
-	0x2d, 0xe5, 0x3c, 0x0b, 0x33, 0x0d, 0xb6, 0x70, 0xce, 0x12, 0xd7, 0xb8, 0xeb, 0x76, 0x97, 0x59,
-	0x5d, 0x8f, 0x5e, 0xe9, 0x5e, 0x70, 0x1c, 0xdb, 0x51, 0xae, 0xb2, 0xe0, 0x1a, 0x77, 0x35, 0x4a,
-	0xc6, 0xf1, 0xfa, 0xe8, 0x4d, 0x18, 0x75, 0x28, 0x73, 0x76, 0xd5, 0x49, 0x73, 0x6e, 0x08, 0xa1,
-	0x8a, 0x79, 0x7b, 0x39, 0xca, 0xe2, 0x4f, 0x2c, 0x11, 0x83, 0xb3, 0x60, 0xec, 0x08, 0xce, 0x82,
-	0x30, 0x32, 0x92, 0x3f, 0xb2, 0xc8, 0xc8, 0x4f, 0x0c, 0x4d, 0xf9, 0x08, 0x3a, 0x8a, 0x5e, 0x87,
-	0x71, 0x66, 0x75, 0xa8, 0xed, 0xb1, 0x6c, 0x5a, 0x6f, 0x90, 0x68, 0x27, 0x44, 0xec, 0x55, 0x09,
-	0x81, 0x7d, 0x2c, 0x74, 0x1e, 0xa6, 0x29, 0x9f, 0x91, 0xab, 0x2d, 0x7e, 0x64, 0xd8, 0x6d, 0xa9,
-	0xe2, 0x4d, 0x85, 0x7e, 0xca, 0x0b, 0x11, 0x2a, 0x8e, 0xd5, 0x36, 0x3f, 0xd3, 0xf5, 0xf3, 0xff,
-	0xfd, 0x77, 0xf4, 0x94, 0xe7, 0xed, 0x58, 0x2f, 0xe7, 0x0d, 0xed, 0x79, 0x3b, 0xf0, 0x56, 0xde,
-	0xdb, 0x70, 0x4f, 0xb2, 0x28, 0x38, 0x94, 0xd7, 0x53, 0x7e, 0x11, 0x1f, 0x2b, 0xa1, 0xda, 0xf9,
-	0xdb, 0xcf, 0x38, 0x4a, 0x55, 0x2c, 0x77, 0xd8, 0xaa, 0x98, 0xa3, 0x77, 0x45, 0xbd, 0x35, 0x83,
-	0xde, 0x51, 0xeb, 0xcc, 0xc8, 0xf2, 0x7a, 0x49, 0x1f, 0xcc, 0xc0, 0xb5, 0xf6, 0x2b, 0x03, 0x4e,
-	0x25, 0xd6, 0x0e, 0xc6, 0x30, 0x77, 0x94, 0x63, 0x68, 0x1c, 0xf6, 0x18, 0xee, 0xc0, 0xbd, 0xdf,
-	0xf6, 0xc8, 0xb1, 0xbf, 0x2a, 0x62, 0xfe, 0x28, 0x07, 0xb3, 0x98, 0xf6, 0xec, 0x48, 0xe0, 0x6f,
-	0xc3, 0xbf, 0xb5, 0x99, 0xc1, 0x4e, 0x8a, 0x65, 0x36, 0x55, 0xc6, 0x23, 0xd7, 0x35, 0xf9, 0x36,
-	0xed, 0xf8, 0x4a, 0x71, 0x6a, 0xb1, 0xd3, 0x17, 0x92, 0x94, 0x27, 0x96, 0x0c, 0x6e, 0x4a, 0x40,
-	0x8e, 0x2c, 0xd2, 0xd0, 0xd5, 0xa1, 0xf2, 0x6c, 0x86, 0x84, 0xf6, 0x7e, 0x64, 0x51, 0x8c, 0x25,
-	0xa0, 0xf9, 0x49, 0x0e, 0xa4, 0x4d, 0x75, 0x0c, 0x52, 0xf9, 0xdb, 0x11, 0xa9, 0xbc, 0x92, 0xc5,
-	0xe7, 0x37, 0xc8, 0xb7, 0x14, 0xb7, 0x77, 0x9f, 0xc8, 0xe8, 0x48, 0xbc, 0x8d, 0x5f, 0xe9, 0x6f,
-	0x0d, 0x28, 0x88, 0x7a, 0xc7, 0x20, 0xe0, 0x37, 0xa2, 0x02, 0xfe, 0xd1, 0x0c, 0xbd, 0x18, 0x20,
-	0xd8, 0xff, 0x33, 0xaf, 0xbe, 0x3e, 0xb0, 0xa6, 0x5b, 0xc4, 0x69, 0x28, 0x33, 0x31, 0xdc, 0x9d,
-	0xbc, 0x10, 0x4b, 0x5a, 0x20, 0x53, 0xc6, 0x8f, 0x40, 0xa6, 0xbc, 0x2f, 0x6f, 0x03, 0x50, 0x97,
-	0xd1, 0xc6, 0xc5, 0xc0, 0x1e, 0xcc, 0x67, 0xbe, 0xd6, 0xa0, 0xae, 0x5e, 0x84, 0x9e, 0x7a, 0x1c,
-	0x43, 0xc5, 0x7d, 0x7c, 0xb8, 0x8d, 0xd8, 0x8b, 0x0b, 0x51, 0x65, 0xe2, 0x3c, 0x3b, 0xa4, 0xc4,
-	0x96, 0x36, 0x62, 0x5f, 0x31, 0xee, 0x67, 0x84, 0x5a, 0x30, 0xa9, 0xdf, 0xef, 0x52, 0xeb, 0xf4,
-	0x6c, 0xf6, 0x8b, 0x64, 0x32, 0x57, 0x4c, 0x2f, 0xc1, 0x11, 0x64, 0xf3, 0x23, 0x03, 0x20, 0xf4,
-	0x90, 0xf3, 0x39, 0xaf, 0xdb, 0x5e, 0x57, 0xba, 0x46, 0xf2, 0xe1, 0x9c, 0x57, 0x79, 0x21, 0x96,
-	0x34, 0xbe, 0x7f, 0xa4, 0x81, 0xa9, 0x16, 0xf5, 0x13, 0x59, 0x6c, 0xd7, 0x98, 0x27, 0x5e, 0x16,
-	0x62, 0x05, 0x68, 0xfe, 0xdd, 0x04, 0x14, 0xb5, 0x7d, 0x16, 0xf3, 0xc3, 0x4f, 0x1d, 0x59, 0xc8,
-	0x2a, 0xc1, 0x39, 0x52, 0x1c, 0xca, 0x39, 0xe2, 0xc2, 0xb4, 0x32, 0xf9, 0xfd, 0x4b, 0x80, 0xd2,
-	0x79, 0x34, 0xb4, 0x63, 0x01, 0x71, 0x6d, 0xf9, 0x62, 0x04, 0x12, 0xc7, 0x58, 0x70, 0x6d, 0x5b,
-	0x95, 0xd4, 0xbc, 0x4e, 0x87, 0x38, 0xbb, 0x2a, 0xeb, 0x31, 0xd0, 0xb6, 0x2f, 0x46, 0xa8, 0x38,
-	0x56, 0x1b, 0x6d, 0x04, 0x13, 0x2a, 0x6f, 0x82, 0x3d, 0x96, 0x65, 0x42, 0xa5, 0xb5, 0x11, 0x9d,
-	0xc7, 0x01, 0x51, 0xc0, 0xb1, 0xa1, 0xa2, 0x80, 0xef, 0xc3, 0xac, 0x32, 0xf1, 0x83, 0xbd, 0xa3,
-	0xbc, 0x35, 0x59, 0xed, 0xbb, 0xf0, 0xe8, 0x17, 0x79, 0x26, 0xd5, 0x18, 0x2a, 0xee, 0xe3, 0x83,
-	0xde, 0x83, 0x29, 0x3e, 0xc9, 0x21, 0x63, 0xb8, 0x43, 0xc6, 0xca, 0x4b, 0xac, 0x41, 0xe2, 0x28,
-	0x87, 0x81, 0x3e, 0xf2, 0xe9, 0x61, 0x7d, 0xe4, 0xa8, 0xa3, 0x1d, 0x43, 0x33, 0x62, 0x35, 0x7e,
-	0x33, 0xf3, 0x89, 0x97, 0xe1, 0x82, 0xc9, 0xd7, 0x7a, 0x07, 0xe2, 0x8b, 0x3c, 0x24, 0xbb, 0x67,
-	0xc2, 0x6b, 0xe2, 0xc6, 0x6d, 0xae, 0x89, 0x47, 0x7c, 0x65, 0xb9, 0x23, 0xf3, 0x95, 0xe5, 0x0f,
-	0xd5, 0x57, 0x76, 0x16, 0x40, 0x98, 0xcf, 0x42, 0x48, 0x8b, 0xd3, 0x7a, 0x4a, 0xbb, 0x69, 0x1b,
-	0x50, 0xb0, 0x56, 0x0b, 0xbd, 0x14, 0xe8, 0x40, 0x32, 0x61, 0xeb, 0xb7, 0xfb, 0xb2, 0x5c, 0x4f,
-	0x46, 0x94, 0xf3, 0x98, 0x5f, 0x3f, 0xc3, 0x75, 0x8e, 0x04, 0xb7, 0xce, 0x78, 0x36, 0xb7, 0x8e,
-	0xf9, 0xdf, 0x39, 0x88, 0x9c, 0x61, 0xe8, 0x07, 0x06, 0xcc, 0x91, 0xd8, 0x1b, 0x9e, 0xbe, 0xe9,
-	0xf1, 0xcd, 0x6c, 0x0f, 0xab, 0xf6, 0x3d, 0x01, 0x1a, 0x26, 0x7c, 0xc4, 0xab, 0xb8, 0xb8, 0x9f,
-	0x29, 0xfa, 0x63, 0x03, 0x4e, 0x92, 0xfe, 0x47, 0x5a, 0xd5, 0xe2, 0x79, 0x6e, 0xe8, 0x57, 0x5e,
-	0x2b, 0xa7, 0xf7, 0xf7, 0x96, 0x93, 0x9e, 0xaf, 0xc5, 0x49, 0xec, 0xd0, 0x5b, 0x30, 0x42, 0x9c,
-	0xa6, 0x1f, 0x4d, 0xc8, 0xce, 0xd6, 0x7f, 0x7b, 0x37, 0x54, 0xc4, 0xca, 0x4e, 0xd3, 0xc5, 0x02,
-	0xd4, 0xfc, 0x75, 0x1e, 0x66, 0xe3, 0xd7, 0xd3, 0xd5, 0xa5, 0xa1, 0x91, 0xc4, 0x4b, 0x43, 0x7c,
-	0xaf, 0x89, 0x78, 0x5a, 0xfc, 0x49, 0x06, 0x11, 0x16, 0x93, 0xb4, 0x60, 0xaf, 0x89, 0x5b, 0x9e,
-	0xa3, 0x77, 0xb0, 0xd7, 0xc4, 0xd5, 0xce, 0x10, 0x0b, 0x9d, 0x8b, 0x06, 0x28, 0xcc, 0x78, 0x80,
-	0x62, 0x4e, 0xef, 0xcb, 0xb0, 0x31, 0x8a, 0x0e, 0x14, 0xb5, 0x79, 0x50, 0x3b, 0xfa, 0xf9, 0xcc,
-	0xe3, 0x1e, 0x2e, 0xbb, 0x19, 0x99, 0xad, 0x1b, 0x52, 0x74, 0xfc, 0x50, 0x7e, 0x88, 0xd1, 0xba,
-	0x23, 0x5f, 0xbb, 0x18, 0x2e, 0x0d, 0xcd, 0xfc, 0x17, 0x03, 0xa6, 0x22, 0xd7, 0xe8, 0x38, 0x37,
-	0xff, 0x7e, 0xe4, 0xf0, 0x0f, 0xd6, 0x5e, 0x0b, 0x10, 0xb0, 0x86, 0x86, 0xbe, 0x0b, 0xc5, 0xb6,
-	0xdd, 0x6d, 0x52, 0x97, 0xd5, 0x6c, 0xb2, 0xad, 0xf6, 0x49, 0x56, 0xaf, 0xa3, 0xb8, 0xea, 0xba,
-	0x2e, 0x61, 0xaa, 0x76, 0xa7, 0xd7, 0xa6, 0x4c, 0x5e, 0xea, 0xc5, 0x3a, 0xb8, 0x48, 0x86, 0x08,
-	0xb2, 0x49, 0xee, 0xd6, 0x64, 0x88, 0x30, 0x0d, 0xe6, 0x90, 0x93, 0x21, 0x22, 0xf9, 0x35, 0x07,
-	0x24, 0x43, 0x04, 0x75, 0xef, 0xda, 0x64, 0x88, 0xe0, 0x0b, 0x07, 0x18, 0xaf, 0x1f, 0x8d, 0x68,
-	0xbd, 0x88, 0x1a, 0xb0, 0xb9, 0xdb, 0x18, 0xb0, 0x6f, 0xc3, 0x84, 0xd5, 0x65, 0xd4, 0xd9, 0x21,
-	0x6d, 0x15, 0x35, 0xc8, 0xba, 0x16, 0x83, 0xae, 0xae, 0x29, 0x1c, 0x1c, 0x20, 0xa2, 0x36, 0x9c,
-	0xda, 0x8a, 0xbe, 0x8f, 0xa1, 0x5e, 0x91, 0x95, 0xc9, 0xdf, 0xcf, 0xf8, 0x11, 0xa5, 0x8b, 0x49,
-	0x95, 0x6e, 0x0d, 0x22, 0xe0, 0x64, 0x50, 0xe4, 0xc2, 0x94, 0xab, 0x79, 0x6e, 0xfc, 0x13, 0x31,
-	0x65, 0xf4, 0x34, 0xee, 0xec, 0xd2, 0x32, 0xc6, 0x75, 0x50, 0x1c, 0xe5, 0x81, 0x7e, 0x68, 0xc0,
-	0xe9, 0xad, 0xe4, 0x37, 0x40, 0x94, 0x54, 0x7f, 0x29, 0x9b, 0xed, 0x13, 0x03, 0xa9, 0xdc, 0xb7,
-	0xbf, 0xb7, 0x3c, 0xe8, 0x95, 0x11, 0x3c, 0x88, 0xb5, 0xf9, 0xb1, 0x01, 0xd3, 0xd1, 0x04, 0xb3,
-	0xaf, 0xdd, 0xb8, 0xfd, 0x22, 0x0f, 0x33, 0xb1, 0x3d, 0x19, 0x33, 0x70, 0x0b, 0xc7, 0x69, 0xe0,
-	0x8e, 0x0d, 0x65, 0xe0, 0x26, 0x5b, 0x76, 0x23, 0x43, 0x59, 0x76, 0x2f, 0x48, 0xeb, 0x4a, 0xcd,
-	0xed, 0xda, 0xaa, 0xba, 0xc5, 0x1b, 0xac, 0xbb, 0x75, 0x9d, 0x88, 0xa3, 0x75, 0x85, 0xe2, 0xd5,
-	0xe8, 0x7f, 0xbe, 0x4f, 0x99, 0x86, 0xcf, 0x65, 0xbd, 0x17, 0x12, 0x00, 0x48, 0xc5, 0x2b, 0x81,
-	0x80, 0x93, 0xd8, 0x99, 0xff, 0x35, 0x0e, 0xa7, 0x92, 0x7d, 0xd3, 0x07, 0x07, 0x43, 0xde, 0x83,
-	0xc2, 0xa6, 0xff, 0x02, 0xb3, 0xda, 0x2b, 0x29, 0x9f, 0x1d, 0xb8, 0xfd, 0xc3, 0xcd, 0x52, 0x37,
-	0x0a, 0xea, 0xe0, 0x90, 0x0b, 0x67, 0xd9, 0x10, 0x8f, 0x8e, 0xb5, 0xbc, 0x4d, 0xa5, 0x46, 0xa4,
-	0x64, 0x79, 0xfb, 0xb7, 0xca, 0x24, 0xcb, 0xa0, 0x0e, 0x0e, 0xb9, 0x20, 0x0a, 0x63, 0x92, 0x81,
-	0x3a, 0x16, 0xcb, 0xa9, 0xdd, 0xe6, 0x03, 0x99, 0x09, 0x97, 0x83, 0xac, 0x80, 0x15, 0xb8, 0x62,
-	0xd3, 0x26, 0x9b, 0xea, 0x90, 0x4c, 0xcf, 0x66, 0xd0, 0x95, 0xe7, 0x80, 0xcd, 0x3a, 0x91, 0x6c,
-	0xda, 0x44, 0xb0, 0x69, 0x89, 0x3b, 0x8a, 0xca, 0x15, 0x90, 0x92, 0xcd, 0x6d, 0xee, 0x35, 0x2a,
-	0x07, 0x8a, 0xa8, 0x80, 0x15, 0x38, 0x7a, 0x07, 0x46, 0xde, 0xf3, 0x88, 0x1f, 0xc8, 0x4e, 0x69,
-	0xd3, 0x0c, 0x8c, 0x93, 0xc8, 0x18, 0x3d, 0x27, 0x63, 0x01, 0x8b, 0x76, 0xa1, 0x48, 0xc2, 0x17,
-	0xdb, 0xd5, 0x9b, 0x68, 0x17, 0xd3, 0xbe, 0x69, 0x7f, 0xfb, 0xa7, 0xde, 0x95, 0x26, 0x1b, 0xd6,
-	0xc2, 0x3a, 0x2f, 0x44, 0x60, 0x94, 0xbc, 0xef, 0x39, 0x54, 0xf9, 0x9a, 0x5e, 0x4e, 0xc9, 0x74,
-	0xe0, 0x13, 0xe9, 0x32, 0x3e, 0x21, 0xe8, 0x58, 0x22, 0x73, 0x16, 0x4d, 0x8b, 0x51, 0xa2, 0x64,
-	0xc1, 0xcb, 0xa9, 0x57, 0xc2, 0x80, 0x3b, 0xaf, 0x92, 0x85, 0xa0, 0x63, 0x89, 0x6c, 0x7e, 0x00,
-	0xf7, 0x24, 0xe7, 0x83, 0xa7, 0x8b, 0x81, 0xf6, 0x08, 0xf3, 0xef, 0x8d, 0x07, 0x35, 0x36, 0x08,
-	0x6b, 0x61, 0x41, 0x41, 0xf7, 0x43, 0xde, 0x73, 0xda, 0xf1, 0xc7, 0x14, 0x5e, 0xc7, 0xeb, 0x98,
-	0x97, 0x57, 0x5e, 0xf9, 0xf4, 0xab, 0xa5, 0x13, 0x9f, 0x7f, 0xb5, 0x74, 0xe2, 0xcb, 0xaf, 0x96,
-	0x4e, 0x7c, 0xb8, 0xbf, 0x64, 0x7c, 0xba, 0xbf, 0x64, 0x7c, 0xbe, 0xbf, 0x64, 0x7c, 0xb9, 0xbf,
-	0x64, 0xfc, 0xdb, 0xfe, 0x92, 0xf1, 0xf1, 0x6f, 0x96, 0x4e, 0x5c, 0x7f, 0x30, 0xcd, 0x3f, 0xbd,
-	0xf9, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xdb, 0xbb, 0xda, 0x16, 0x1b, 0x67, 0x00, 0x00,
+	// 5927 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5d, 0xd9, 0x6f, 0x1c, 0xc9,
+	0x79, 0x57, 0xcf, 0x45, 0xce, 0x47, 0xf1, 0x2a, 0x5d, 0x63, 0xae, 0x2d, 0x29, 0x6d, 0x67, 0xb1,
+	0x1b, 0xdb, 0x64, 0x56, 0x7b, 0x58, 0x7b, 0x78, 0xed, 0x19, 0x52, 0x94, 0xb8, 0xe6, 0xae, 0xb8,
+	0x35, 0x5a, 0xed, 0x6a, 0x8f, 0x6c, 0x8a, 0x33, 0xc5, 0x99, 0x36, 0x67, 0xa6, 0x47, 0x5d, 0x3d,
+	0x94, 0xb8, 0x0a, 0x62, 0xc7, 0xb9, 0xfc, 0xe0, 0x04, 0x0e, 0xe2, 0xc0, 0x7e, 0x48, 0xe0, 0x20,
+	0x06, 0x02, 0x24, 0x06, 0x9c, 0x3f, 0x20, 0x41, 0x12, 0x20, 0x2f, 0xeb, 0x23, 0x80, 0xe1, 0x3c,
+	0xd8, 0x01, 0x02, 0x21, 0x2b, 0x03, 0x79, 0xc9, 0x5b, 0xe0, 0x27, 0x3d, 0x05, 0x75, 0x74, 0x75,
+	0x75, 0x4f, 0x0f, 0xd9, 0x3d, 0x22, 0xb9, 0xca, 0xf1, 0x22, 0x88, 0xf5, 0x7d, 0xf5, 0xfb, 0xba,
+	0xae, 0xaf, 0xbe, 0xfa, 0xbe, 0xaf, 0x6a, 0xe0, 0xa9, 0x96, 0xe3, 0xb7, 0x07, 0x9b, 0x8b, 0x0d,
+	0xb7, 0xbb, 0x44, 0xb6, 0x07, 0x8e, 0xbf, 0xbb, 0xb4, 0x4d, 0xbc, 0x96, 0xbb, 0x44, 0xfa, 0xce,
+	0xd2, 0xce, 0x13, 0xa4, 0xd3, 0x6f, 0x93, 0x27, 0x96, 0x5a, 0xb4, 0x47, 0x3d, 0xe2, 0xd3, 0xe6,
+	0x62, 0xdf, 0x73, 0x7d, 0x17, 0x7d, 0x22, 0xac, 0xb5, 0x28, 0x6b, 0x2d, 0x8a, 0x5a, 0x8b, 0xa4,
+	0xef, 0x2c, 0x06, 0xb5, 0x16, 0x3e, 0x6d, 0x60, 0xb7, 0xdc, 0x96, 0xbb, 0x24, 0x2a, 0x6f, 0x0e,
+	0xb6, 0xc4, 0x5f, 0xe2, 0x0f, 0xf1, 0x3f, 0x09, 0xba, 0x60, 0x6f, 0x5f, 0x64, 0x8b, 0x8e, 0x94,
+	0xdc, 0x70, 0x3d, 0xba, 0xb4, 0x33, 0x24, 0x78, 0xe1, 0x4a, 0xc8, 0x43, 0x6f, 0xfb, 0xb4, 0xc7,
+	0x1c, 0xb7, 0xc7, 0x3e, 0x4d, 0xfa, 0x0e, 0xa3, 0xde, 0x0e, 0xf5, 0x96, 0xfa, 0xdb, 0x2d, 0x4e,
+	0x63, 0x51, 0x86, 0x24, 0xa4, 0xa7, 0x42, 0xa4, 0x2e, 0x69, 0xb4, 0x9d, 0x1e, 0xf5, 0x76, 0x83,
+	0xea, 0x4b, 0x1e, 0x65, 0xee, 0xc0, 0x6b, 0xd0, 0x4c, 0xb5, 0xd8, 0x52, 0x97, 0xfa, 0x24, 0x49,
+	0xd6, 0xd2, 0xa8, 0x5a, 0xde, 0xa0, 0xe7, 0x3b, 0xdd, 0x61, 0x31, 0xcf, 0xec, 0x57, 0x81, 0x35,
+	0xda, 0xb4, 0x4b, 0xe2, 0xf5, 0xec, 0x1b, 0x30, 0x55, 0x6d, 0xd1, 0x9e, 0xbf, 0xec, 0xf6, 0xb6,
+	0x9c, 0x16, 0x3a, 0x0f, 0x85, 0x1e, 0xe9, 0xd2, 0x8a, 0x75, 0xde, 0x7a, 0xac, 0x5c, 0x3b, 0xfe,
+	0xfe, 0xdd, 0x73, 0xc7, 0xee, 0xdd, 0x3d, 0x57, 0x78, 0x85, 0x74, 0x29, 0x16, 0x14, 0xf4, 0x18,
+	0x4c, 0xf6, 0x3d, 0xf7, 0x8b, 0xb4, 0xe1, 0xb3, 0x4a, 0xee, 0x7c, 0x9e, 0x73, 0xdd, 0xbb, 0x7b,
+	0x6e, 0x72, 0x43, 0x95, 0x61, 0x4d, 0xb5, 0xdf, 0x86, 0x13, 0xd5, 0x1e, 0xe9, 0xec, 0x32, 0x87,
+	0xe1, 0x41, 0xaf, 0xea, 0xb5, 0x06, 0x5d, 0xda, 0xf3, 0x53, 0x88, 0xf8, 0x38, 0x14, 0x77, 0x48,
+	0x67, 0x40, 0x2b, 0x39, 0xc1, 0x32, 0xad, 0x58, 0x8a, 0xd7, 0x79, 0x21, 0x96, 0x34, 0xfb, 0xb7,
+	0xf3, 0x11, 0xf8, 0x97, 0xa9, 0x4f, 0x9a, 0xc4, 0x27, 0xa8, 0x0b, 0xa5, 0x0e, 0xd9, 0xa4, 0x1d,
+	0x56, 0xb1, 0xce, 0xe7, 0x1f, 0x9b, 0xba, 0x70, 0x69, 0x31, 0xcd, 0xcc, 0x5b, 0x4c, 0x80, 0x5a,
+	0x5c, 0x17, 0x38, 0x97, 0x7a, 0xbe, 0xb7, 0x5b, 0x9b, 0x51, 0x1f, 0x51, 0x92, 0x85, 0x58, 0x09,
+	0x41, 0xbf, 0x65, 0xc1, 0x14, 0xe9, 0xf5, 0x5c, 0x9f, 0xf8, 0x7c, 0xde, 0x88, 0x2e, 0x99, 0xba,
+	0xf0, 0xd2, 0xf8, 0x42, 0xab, 0x21, 0x98, 0x94, 0x7c, 0x42, 0x49, 0x9e, 0x32, 0x28, 0xd8, 0x94,
+	0xb9, 0xf0, 0x2c, 0x4c, 0x19, 0x9f, 0x8a, 0xe6, 0x20, 0xbf, 0x4d, 0x77, 0x65, 0xff, 0x62, 0xfe,
+	0x5f, 0x74, 0x32, 0xd2, 0xa1, 0xaa, 0x07, 0x9f, 0xcb, 0x5d, 0xb4, 0x16, 0x5e, 0x84, 0xb9, 0xb8,
+	0xc0, 0x2c, 0xf5, 0xed, 0x3f, 0xb4, 0xe0, 0xa4, 0xd1, 0x0a, 0x4c, 0xb7, 0xa8, 0x47, 0x7b, 0x0d,
+	0x8a, 0x96, 0xa0, 0xcc, 0xc7, 0x92, 0xf5, 0x49, 0x23, 0x18, 0xea, 0x79, 0xd5, 0x90, 0xf2, 0x2b,
+	0x01, 0x01, 0x87, 0x3c, 0x7a, 0x5a, 0xe4, 0xf6, 0x9a, 0x16, 0xfd, 0x36, 0x61, 0xb4, 0x92, 0x8f,
+	0x4e, 0x8b, 0x0d, 0x5e, 0x88, 0x25, 0xcd, 0x7e, 0x17, 0x3e, 0x12, 0x7c, 0xcf, 0x35, 0xda, 0xed,
+	0x77, 0x88, 0x4f, 0xc3, 0x8f, 0xda, 0x7f, 0xea, 0x9d, 0x87, 0xc2, 0xb6, 0xd3, 0x6b, 0xc6, 0xbf,
+	0xe2, 0x0b, 0x4e, 0xaf, 0x89, 0x05, 0xc5, 0xfe, 0xb6, 0x05, 0xd3, 0xd5, 0x7e, 0xdf, 0x73, 0x77,
+	0x68, 0xb3, 0xee, 0x93, 0x16, 0x45, 0x6f, 0x02, 0x10, 0x55, 0x50, 0xf5, 0x05, 0xf6, 0xd4, 0x85,
+	0x5f, 0x59, 0x94, 0xeb, 0x71, 0xd1, 0x5c, 0x8f, 0x8b, 0xfd, 0xed, 0x16, 0x2f, 0x60, 0x8b, 0x7c,
+	0xd9, 0x2f, 0xee, 0x3c, 0xb1, 0x78, 0xcd, 0xe9, 0xd2, 0xda, 0xcc, 0xbd, 0xbb, 0xe7, 0xa0, 0xaa,
+	0x11, 0xb0, 0x81, 0x86, 0x2e, 0x00, 0x90, 0x4e, 0xc7, 0xbd, 0x55, 0xf7, 0x49, 0x47, 0xf6, 0xcd,
+	0x64, 0x0d, 0xa9, 0xaf, 0x82, 0xaa, 0xa6, 0x60, 0x83, 0xcb, 0xfe, 0x8a, 0x05, 0xa7, 0xaa, 0x5e,
+	0xcb, 0x5d, 0x5e, 0xa9, 0xf6, 0xfb, 0x57, 0x28, 0xe9, 0xf8, 0xed, 0xba, 0x4f, 0xfc, 0x01, 0x43,
+	0x2f, 0x42, 0x89, 0x89, 0xff, 0xa9, 0x1e, 0x78, 0x34, 0x98, 0xd4, 0x92, 0x7e, 0xff, 0xee, 0xb9,
+	0x93, 0x09, 0x15, 0x29, 0x56, 0xb5, 0xd0, 0xe3, 0x30, 0xd1, 0xa5, 0x8c, 0x91, 0x56, 0x30, 0x4c,
+	0xb3, 0x0a, 0x60, 0xe2, 0x65, 0x59, 0x8c, 0x03, 0xba, 0xfd, 0x83, 0x1c, 0xcc, 0x6a, 0x2c, 0x25,
+	0xfe, 0x10, 0xe6, 0xc4, 0x00, 0x8e, 0xb7, 0x8d, 0x16, 0x8a, 0xa9, 0x31, 0x75, 0xe1, 0xf9, 0x94,
+	0xcb, 0x2f, 0xa9, 0x93, 0x6a, 0x27, 0x95, 0x98, 0xe3, 0x66, 0x29, 0x8e, 0x88, 0x41, 0x5d, 0x00,
+	0xb6, 0xdb, 0x6b, 0x28, 0xa1, 0x05, 0x21, 0xf4, 0xd9, 0x8c, 0x42, 0xeb, 0x1a, 0x20, 0x1c, 0xd1,
+	0xb0, 0x0c, 0x1b, 0x02, 0xec, 0xef, 0x59, 0x70, 0x22, 0xa1, 0x1e, 0x7a, 0x21, 0x36, 0x9e, 0x9f,
+	0x18, 0x1a, 0x4f, 0x34, 0x54, 0x2d, 0x1c, 0xcd, 0x4f, 0xc1, 0xa4, 0x47, 0x77, 0x1c, 0xbe, 0xdf,
+	0xa9, 0x1e, 0x9e, 0x53, 0xf5, 0x27, 0xb1, 0x2a, 0xc7, 0x9a, 0x03, 0x7d, 0x12, 0xca, 0xc1, 0xff,
+	0x79, 0x37, 0x73, 0xc5, 0x3f, 0xcd, 0x07, 0x2e, 0x60, 0x65, 0x38, 0xa4, 0xdb, 0xff, 0x68, 0xc1,
+	0xf9, 0xaa, 0xe7, 0x3b, 0x5b, 0xa4, 0xe1, 0xbb, 0xde, 0xee, 0xeb, 0x74, 0xb3, 0xed, 0xba, 0xdb,
+	0x98, 0x36, 0xa8, 0xb3, 0x43, 0x3d, 0xb5, 0xd7, 0xdc, 0x80, 0x32, 0xa3, 0x0d, 0x8f, 0xfa, 0x98,
+	0x6e, 0xa9, 0x65, 0xf3, 0x98, 0xb1, 0x6c, 0x16, 0xf9, 0x8e, 0xce, 0x17, 0xc9, 0xba, 0xdb, 0x20,
+	0x9d, 0xab, 0x9b, 0x7c, 0x53, 0xd1, 0x4b, 0x39, 0x9c, 0x38, 0xf5, 0x00, 0x02, 0x87, 0x68, 0xa8,
+	0x0a, 0xb3, 0x3b, 0x8e, 0xe7, 0x0f, 0x48, 0x07, 0xd3, 0xbe, 0xfb, 0x4a, 0x38, 0x87, 0xce, 0xa8,
+	0x6a, 0xb3, 0xd7, 0xa3, 0x64, 0x1c, 0xe7, 0xb7, 0xff, 0x9a, 0x6b, 0xb6, 0x81, 0xef, 0x6e, 0x78,
+	0x6e, 0xd7, 0xe5, 0xda, 0xf1, 0x6a, 0x9f, 0xff, 0xcb, 0x10, 0x81, 0x59, 0x46, 0x3b, 0xb4, 0xc1,
+	0xff, 0xda, 0x70, 0x3b, 0x4e, 0x43, 0xa9, 0xca, 0xda, 0x67, 0x02, 0xec, 0x7a, 0x94, 0x7c, 0xff,
+	0xee, 0xb9, 0x8f, 0x46, 0x90, 0x62, 0x74, 0x1c, 0xc7, 0xe3, 0x0b, 0xa5, 0xe1, 0xf6, 0x9a, 0x8e,
+	0x1f, 0x0e, 0x8d, 0x6e, 0xef, 0x72, 0x40, 0xc0, 0x21, 0x8f, 0x7d, 0x0b, 0x16, 0xaa, 0xef, 0x0d,
+	0x3c, 0x7a, 0xd4, 0x1d, 0x6d, 0xdf, 0x81, 0xb3, 0x35, 0xc7, 0xdf, 0x1c, 0x34, 0xb6, 0xa9, 0x7f,
+	0xe4, 0xc2, 0xbf, 0x04, 0xc5, 0xe5, 0x36, 0xf1, 0x7c, 0xae, 0x97, 0x3c, 0xda, 0x77, 0x5f, 0xc3,
+	0xeb, 0x15, 0x2b, 0xaa, 0x97, 0xb0, 0x2c, 0xc6, 0x01, 0x3d, 0x85, 0x4a, 0x79, 0x1c, 0x26, 0x76,
+	0xa8, 0x27, 0x56, 0x45, 0x3e, 0x0a, 0x76, 0x5d, 0x16, 0xe3, 0x80, 0x6e, 0xff, 0x8b, 0x05, 0x27,
+	0xc5, 0x17, 0xac, 0x38, 0xac, 0xe1, 0xee, 0x50, 0x6f, 0x17, 0x53, 0x36, 0xe8, 0x1c, 0xf0, 0x07,
+	0xad, 0xc0, 0x1c, 0xa3, 0x5d, 0xd9, 0xa3, 0xcc, 0xf7, 0x88, 0xd3, 0xf3, 0xd5, 0x97, 0x55, 0x14,
+	0xf7, 0x5c, 0x3d, 0x46, 0xc7, 0x43, 0x35, 0xb8, 0xdd, 0xa6, 0x3e, 0x9b, 0x2b, 0x2c, 0x6d, 0xb7,
+	0xa9, 0x36, 0x31, 0xac, 0xa9, 0xf6, 0x7f, 0x58, 0x30, 0x2f, 0x5a, 0x55, 0x1f, 0x6c, 0xb2, 0x86,
+	0xe7, 0x88, 0x79, 0xff, 0x30, 0x36, 0xe9, 0x45, 0x98, 0x69, 0x06, 0x1d, 0xbf, 0xee, 0x74, 0x1d,
+	0x5f, 0x68, 0xe2, 0x62, 0xed, 0xb4, 0xc2, 0x98, 0x59, 0x89, 0x50, 0x71, 0x8c, 0xdb, 0xfe, 0x9b,
+	0x1c, 0x4c, 0x2f, 0x77, 0x06, 0xcc, 0xd7, 0x93, 0xf5, 0xd7, 0x61, 0xb2, 0xab, 0x0c, 0x31, 0x35,
+	0x57, 0x7f, 0x35, 0xdd, 0x46, 0x2e, 0x27, 0x2e, 0x37, 0xe2, 0x42, 0x65, 0x1e, 0x96, 0x61, 0x8d,
+	0x8a, 0x6e, 0x40, 0x81, 0xf5, 0x69, 0x43, 0xf4, 0xcd, 0xd4, 0x85, 0xcf, 0xa4, 0xdb, 0x33, 0x22,
+	0x1f, 0x59, 0xef, 0xd3, 0x46, 0xd8, 0xa9, 0xfc, 0x2f, 0x2c, 0x20, 0x11, 0xd1, 0xbb, 0x41, 0x3e,
+	0xcb, 0x86, 0x14, 0x05, 0x97, 0x1b, 0xd2, 0x4c, 0x74, 0x23, 0x09, 0xb6, 0x0c, 0xfb, 0x87, 0x7c,
+	0x6a, 0x98, 0xfc, 0xeb, 0x0e, 0xf3, 0xd1, 0xdb, 0x43, 0xbd, 0xb6, 0x98, 0xae, 0xd7, 0x78, 0x6d,
+	0xd1, 0x67, 0x7a, 0xe3, 0x09, 0x4a, 0x8c, 0x1e, 0x7b, 0x03, 0x8a, 0x8e, 0x4f, 0xbb, 0x81, 0x69,
+	0xfd, 0xe4, 0x18, 0xad, 0x0a, 0x6d, 0xc5, 0x35, 0x8e, 0x84, 0x25, 0xa0, 0xfd, 0x5f, 0xf1, 0xd6,
+	0xf0, 0xce, 0xe4, 0x16, 0xfd, 0xdc, 0xad, 0xa8, 0x2a, 0x0b, 0xce, 0x12, 0x29, 0xed, 0x8a, 0x44,
+	0x45, 0x18, 0xce, 0xec, 0x18, 0x99, 0xe1, 0x21, 0x71, 0xe8, 0x06, 0x94, 0x08, 0x3f, 0x95, 0x05,
+	0x8d, 0x7e, 0x22, 0xa5, 0x6d, 0x11, 0x9e, 0xe4, 0xc2, 0x21, 0x14, 0x85, 0x0c, 0x2b, 0x40, 0xfb,
+	0x9b, 0x79, 0x38, 0x91, 0x30, 0xe4, 0xa8, 0x01, 0xa0, 0xf7, 0x93, 0xa0, 0xbd, 0x4b, 0xe9, 0x86,
+	0x51, 0x6f, 0x49, 0xe1, 0xdc, 0xd7, 0x45, 0x0c, 0x1b, 0xb0, 0xe8, 0x25, 0x40, 0xee, 0xa6, 0x38,
+	0x78, 0x37, 0x2f, 0xcb, 0x83, 0x68, 0xa0, 0x66, 0xf3, 0xb5, 0x05, 0x55, 0x17, 0x5d, 0x1d, 0xe2,
+	0xc0, 0x09, 0xb5, 0x38, 0x56, 0x87, 0x30, 0xff, 0x0a, 0xe9, 0x35, 0x3b, 0xb4, 0x89, 0xe9, 0x96,
+	0x47, 0x59, 0x5b, 0x68, 0x80, 0x72, 0x88, 0xb5, 0x3e, 0xc4, 0x81, 0x13, 0x6a, 0xa1, 0xaf, 0x24,
+	0x8d, 0xb9, 0xec, 0xfa, 0x17, 0xc6, 0x1a, 0xf3, 0x15, 0xea, 0x13, 0xa7, 0xc3, 0xb2, 0x0c, 0xba,
+	0xdc, 0x4d, 0xe4, 0xc8, 0x68, 0x53, 0xe1, 0x1a, 0x61, 0xdb, 0x0f, 0xab, 0x56, 0x8a, 0x7c, 0xe4,
+	0x28, 0xad, 0x64, 0xff, 0xab, 0x05, 0x95, 0xa4, 0x56, 0x1d, 0x81, 0xe6, 0x78, 0x37, 0xaa, 0x39,
+	0x9e, 0xcb, 0xa4, 0x39, 0x22, 0x1f, 0x3b, 0x42, 0x81, 0xdc, 0x80, 0xb9, 0x65, 0xb7, 0xd7, 0x18,
+	0x78, 0xdc, 0x5a, 0xd9, 0xbd, 0xec, 0xb9, 0x83, 0x7e, 0x3a, 0xf7, 0x46, 0x47, 0xec, 0x56, 0x39,
+	0xb1, 0x5b, 0x69, 0x68, 0xb9, 0x49, 0x49, 0x9a, 0xfd, 0x16, 0x1c, 0x5f, 0x16, 0xb8, 0xbe, 0x3c,
+	0x64, 0x7e, 0x01, 0x8a, 0xcc, 0xe9, 0x35, 0xe8, 0x18, 0xe7, 0xcb, 0x32, 0x07, 0xaf, 0xf3, 0xca,
+	0x58, 0x62, 0xd8, 0x7f, 0x9a, 0x87, 0x13, 0xc1, 0xde, 0x48, 0x9b, 0x81, 0xa1, 0xce, 0x50, 0x13,
+	0x8e, 0x37, 0xc3, 0x62, 0xbf, 0x52, 0xc8, 0x2c, 0x4b, 0x1f, 0x9e, 0x0c, 0x78, 0x1f, 0x47, 0x50,
+	0xd1, 0xeb, 0x90, 0x6f, 0x39, 0xbe, 0x52, 0x31, 0x17, 0xd3, 0x0d, 0xca, 0x65, 0x27, 0x6e, 0x63,
+	0xd5, 0xa6, 0x94, 0xa8, 0xfc, 0x65, 0xc7, 0xc7, 0x1c, 0x11, 0x6d, 0x42, 0xc9, 0xe9, 0x92, 0x16,
+	0xcd, 0x38, 0xe0, 0x6b, 0xbc, 0x4e, 0x1c, 0x5d, 0xab, 0x4f, 0x41, 0x65, 0x58, 0x21, 0x73, 0x19,
+	0x0d, 0x6e, 0x1b, 0xc9, 0x33, 0x50, 0xfa, 0x49, 0x95, 0x60, 0x25, 0x86, 0x32, 0x04, 0x95, 0x61,
+	0x85, 0x6c, 0xff, 0x2c, 0x07, 0x73, 0x61, 0xff, 0x2d, 0xbb, 0xdd, 0xae, 0xe3, 0xa3, 0x05, 0xc8,
+	0x39, 0x4d, 0x35, 0xab, 0x40, 0x55, 0xcc, 0xad, 0xad, 0xe0, 0x9c, 0xd3, 0x44, 0x8f, 0x42, 0x69,
+	0xd3, 0x23, 0xbd, 0x46, 0x5b, 0x99, 0x5c, 0x1a, 0xb8, 0x26, 0x4a, 0xb1, 0xa2, 0xa2, 0x8f, 0x41,
+	0xde, 0x27, 0x2d, 0x65, 0x69, 0xe9, 0xfe, 0xbb, 0x46, 0x5a, 0x98, 0x97, 0x73, 0x13, 0x8f, 0x0d,
+	0x84, 0x7a, 0xa8, 0x14, 0xa2, 0x26, 0x5e, 0x5d, 0x16, 0xe3, 0x80, 0xce, 0x25, 0x92, 0x81, 0xdf,
+	0x76, 0xbd, 0x4a, 0x31, 0x2a, 0xb1, 0x2a, 0x4a, 0xb1, 0xa2, 0xca, 0x93, 0x0c, 0xff, 0x7e, 0x9f,
+	0x7a, 0x95, 0x52, 0xfc, 0x24, 0xa3, 0x08, 0x38, 0xe4, 0x41, 0xef, 0xc0, 0x54, 0xc3, 0xa3, 0xc4,
+	0x77, 0xbd, 0x15, 0xe2, 0xd3, 0xca, 0x44, 0xe6, 0x19, 0x38, 0xcb, 0x5d, 0x65, 0xcb, 0x21, 0x04,
+	0x36, 0xf1, 0xb8, 0xd7, 0xb0, 0x12, 0x76, 0xad, 0x18, 0xdb, 0xd0, 0x3d, 0xa4, 0xba, 0xc7, 0x1a,
+	0xd1, 0x3d, 0x8f, 0x42, 0xa9, 0xe9, 0xb4, 0x28, 0xf3, 0xe3, 0xbd, 0xbc, 0x22, 0x4a, 0xb1, 0xa2,
+	0xa2, 0xdf, 0x8b, 0xb9, 0x04, 0x8b, 0x62, 0xa2, 0x5c, 0x4d, 0x37, 0x51, 0x46, 0x7d, 0xdc, 0x18,
+	0x7e, 0x41, 0xf4, 0x3a, 0x94, 0x45, 0xdb, 0xc7, 0x5c, 0xcb, 0xe2, 0x78, 0xbf, 0x1c, 0x00, 0xe0,
+	0x10, 0xeb, 0x81, 0xbd, 0x86, 0x77, 0xe0, 0xec, 0x8a, 0xdb, 0xd8, 0xa6, 0xde, 0x95, 0xc1, 0xe6,
+	0x91, 0x9f, 0x1a, 0xdf, 0x02, 0x74, 0xe9, 0x76, 0xdf, 0xa3, 0x8c, 0x9f, 0x76, 0xae, 0x13, 0xcf,
+	0x21, 0x9b, 0x1d, 0x7a, 0x50, 0x5e, 0xe9, 0x1f, 0x17, 0x60, 0x62, 0xd5, 0xa3, 0x4e, 0xab, 0xed,
+	0x1f, 0xc1, 0xb6, 0xfd, 0x71, 0x28, 0x92, 0x8e, 0x43, 0x58, 0x65, 0x22, 0xfa, 0x49, 0x55, 0x5e,
+	0x88, 0x25, 0x0d, 0xbd, 0x05, 0x25, 0xd7, 0x73, 0x5a, 0x4e, 0xaf, 0x52, 0x3e, 0x6f, 0xa5, 0x37,
+	0xa0, 0x55, 0x2b, 0xae, 0x8a, 0xaa, 0xe1, 0x5c, 0x97, 0x7f, 0x63, 0x05, 0x89, 0xde, 0x84, 0x09,
+	0xb9, 0x76, 0x03, 0x7d, 0xb8, 0x94, 0x5a, 0x9f, 0xcb, 0xe5, 0x1f, 0xea, 0x18, 0xf9, 0x37, 0xc3,
+	0x01, 0x20, 0xaa, 0x6b, 0x75, 0x5e, 0x10, 0xd0, 0x9f, 0xcc, 0xa0, 0xce, 0x47, 0xea, 0xef, 0xba,
+	0xd6, 0xdf, 0xc5, 0x2c, 0xa0, 0x42, 0x43, 0x8f, 0x52, 0xd8, 0xbc, 0x8b, 0xd5, 0xc9, 0xab, 0x34,
+	0x46, 0x17, 0xef, 0x73, 0xe6, 0xfa, 0x46, 0x1e, 0xe6, 0x15, 0xe7, 0xb2, 0xdb, 0x51, 0x8e, 0x22,
+	0xb5, 0x1d, 0xe4, 0x13, 0xb7, 0x03, 0x27, 0xb0, 0x7b, 0xe4, 0x16, 0x5b, 0xcb, 0xf4, 0x35, 0xa1,
+	0x8c, 0x45, 0x61, 0xeb, 0x48, 0x65, 0xa3, 0x47, 0x49, 0x71, 0x29, 0x0b, 0x08, 0xfd, 0xae, 0x05,
+	0x27, 0x76, 0xa8, 0xe7, 0x6c, 0x39, 0x0d, 0xa1, 0x0c, 0xae, 0x38, 0x8c, 0x3b, 0xfc, 0xd4, 0x06,
+	0xfc, 0x4c, 0x3a, 0xc9, 0xd7, 0x0d, 0x80, 0xb5, 0xde, 0x96, 0x5b, 0x7b, 0x44, 0x49, 0x3b, 0x71,
+	0x7d, 0x18, 0x1a, 0x27, 0xc9, 0x5b, 0xe8, 0x03, 0x84, 0x5f, 0x9b, 0xa0, 0x8b, 0xd6, 0xcd, 0xc5,
+	0x9b, 0xfa, 0xc3, 0x82, 0xc6, 0x06, 0x9a, 0xc5, 0xd4, 0x61, 0x2f, 0xc3, 0x99, 0xa0, 0xc7, 0xb8,
+	0x5e, 0x74, 0xdc, 0xde, 0xb2, 0xe7, 0xf8, 0xd4, 0x73, 0x08, 0x77, 0xda, 0x53, 0xad, 0x61, 0x94,
+	0x46, 0xd1, 0x0b, 0x39, 0xd4, 0x3d, 0xd8, 0xe0, 0xb2, 0xff, 0xc1, 0x82, 0x29, 0x85, 0x77, 0x04,
+	0x96, 0x31, 0x8e, 0x5a, 0xc6, 0x9f, 0xce, 0xd4, 0x1d, 0x23, 0x8c, 0x61, 0x0f, 0xa6, 0x23, 0x3a,
+	0x03, 0x3d, 0xad, 0x62, 0x29, 0xb2, 0x03, 0x7e, 0xc9, 0x8c, 0xa5, 0xdc, 0xbf, 0x7b, 0x6e, 0x3e,
+	0xc2, 0x1c, 0x06, 0x58, 0xf6, 0xf7, 0x1e, 0x3d, 0x37, 0xf9, 0xad, 0x3f, 0x3f, 0x77, 0xec, 0xcb,
+	0xff, 0x76, 0xfe, 0x18, 0x3f, 0xcc, 0xce, 0xc5, 0x07, 0x29, 0x85, 0x2a, 0x0f, 0x55, 0xe2, 0xe4,
+	0xa1, 0xaa, 0xc4, 0xdc, 0xe1, 0xa9, 0xc4, 0xfc, 0x61, 0xa8, 0xc4, 0xc2, 0x81, 0xa9, 0x44, 0xfb,
+	0x87, 0x39, 0x98, 0xd1, 0x23, 0x73, 0x73, 0xc0, 0xed, 0xa2, 0xb0, 0xd7, 0xad, 0x83, 0xef, 0xf5,
+	0x77, 0x61, 0x42, 0x06, 0xe0, 0x99, 0x5a, 0xe2, 0x4f, 0x65, 0xd3, 0xc1, 0xb2, 0xae, 0x61, 0xf1,
+	0xca, 0x02, 0x1c, 0xa0, 0xa2, 0x3b, 0x30, 0xcb, 0x7c, 0xd2, 0xa1, 0x3d, 0xca, 0x98, 0x72, 0xfb,
+	0x4b, 0x37, 0xdb, 0x0b, 0x59, 0x95, 0xbd, 0x89, 0x51, 0x3b, 0x21, 0x02, 0x06, 0xd1, 0x42, 0x1c,
+	0x97, 0x64, 0xff, 0x20, 0xaf, 0x7b, 0x53, 0x7d, 0x98, 0xb4, 0x46, 0x3d, 0x6e, 0xab, 0x5b, 0x22,
+	0x2a, 0x68, 0x58, 0xa3, 0xbc, 0x14, 0x2b, 0x2a, 0xb2, 0xc5, 0xde, 0x14, 0x1c, 0x8a, 0xca, 0x35,
+	0x50, 0x5b, 0x8c, 0x98, 0x01, 0x92, 0x82, 0xfa, 0x30, 0xe7, 0xd1, 0x9b, 0x03, 0xc7, 0xa3, 0xcd,
+	0xba, 0x4b, 0xb6, 0xb9, 0xf5, 0x57, 0xc9, 0x67, 0x51, 0x3a, 0x2b, 0x03, 0xe9, 0x94, 0xa9, 0x9d,
+	0xe4, 0xbe, 0x0e, 0x1c, 0xc3, 0xc2, 0x43, 0xe8, 0xc8, 0x85, 0x93, 0x64, 0x87, 0x38, 0x1d, 0xb2,
+	0xe9, 0x74, 0x1c, 0x7f, 0xb7, 0xee, 0x7b, 0xc4, 0xa7, 0xad, 0x5d, 0x75, 0xee, 0x78, 0x5e, 0xb5,
+	0xe5, 0x64, 0x35, 0x81, 0xe7, 0xfe, 0xdd, 0x73, 0x8f, 0xa8, 0xbe, 0x48, 0x22, 0xe3, 0x44, 0x60,
+	0xf4, 0x55, 0x0b, 0x4e, 0x92, 0x84, 0x70, 0x8e, 0x38, 0xbf, 0xa4, 0x3e, 0xc6, 0x25, 0x05, 0x84,
+	0x6a, 0x15, 0xf1, 0xa5, 0x09, 0x14, 0x9c, 0x28, 0xd1, 0xfe, 0x4b, 0x0b, 0x4e, 0x27, 0xcf, 0x06,
+	0x84, 0xa1, 0xd4, 0x25, 0xb7, 0xab, 0x2d, 0x5a, 0xb1, 0xc6, 0xea, 0x7e, 0x31, 0xb8, 0x2f, 0x0b,
+	0x04, 0xac, 0x90, 0xd0, 0x45, 0x38, 0xce, 0x06, 0x7d, 0xea, 0x31, 0xda, 0xa4, 0xcd, 0xda, 0xae,
+	0xf2, 0x3a, 0xe8, 0x83, 0x7a, 0xdd, 0xa0, 0xe1, 0x08, 0xa7, 0xfd, 0xcf, 0x13, 0x5a, 0xa5, 0x2b,
+	0x27, 0xe1, 0x1d, 0x98, 0x92, 0xde, 0x0e, 0xbf, 0xb3, 0xbb, 0xd6, 0x53, 0x4a, 0x68, 0x65, 0x0c,
+	0x6b, 0x67, 0x71, 0x39, 0x84, 0x89, 0x1d, 0x67, 0x0c, 0x0a, 0x36, 0xa5, 0xa1, 0x5b, 0x00, 0x72,
+	0xeb, 0xa7, 0xcd, 0xb5, 0x9e, 0xb2, 0x6d, 0x96, 0xc7, 0x91, 0x7d, 0x5d, 0xa3, 0x48, 0xd1, 0x7a,
+	0x6f, 0x0e, 0x09, 0xd8, 0x10, 0xc5, 0x5b, 0x1d, 0x84, 0xe4, 0x57, 0x5d, 0xaf, 0x92, 0x1b, 0xbf,
+	0xd5, 0xd5, 0x10, 0x26, 0x7e, 0x88, 0x0b, 0x29, 0xd8, 0x94, 0x86, 0x5c, 0xc3, 0x10, 0x90, 0xfa,
+	0xb9, 0x3a, 0x8e, 0xe4, 0x20, 0xbf, 0x44, 0x8a, 0xd5, 0xb6, 0x41, 0x50, 0x1c, 0xda, 0x06, 0x0b,
+	0x1e, 0xcc, 0xc5, 0x07, 0x27, 0xc1, 0xa0, 0xba, 0x12, 0x35, 0xa8, 0x2e, 0xa4, 0xdc, 0x33, 0x0c,
+	0x97, 0x96, 0x99, 0x86, 0xe2, 0xc1, 0x6c, 0x6c, 0x50, 0x12, 0x44, 0xae, 0x45, 0x45, 0x3e, 0x99,
+	0xc5, 0xb8, 0xa4, 0xcd, 0x21, 0x99, 0x0c, 0xe6, 0xe2, 0xc3, 0x71, 0x60, 0x42, 0x23, 0x09, 0x22,
+	0xa6, 0xd0, 0x3b, 0x30, 0x1d, 0x19, 0x89, 0x04, 0x89, 0xd7, 0xa2, 0x12, 0x5f, 0x34, 0x54, 0x40,
+	0x98, 0x9f, 0xf6, 0xae, 0x4e, 0x60, 0x0b, 0xb5, 0x41, 0x84, 0x81, 0xab, 0x85, 0x97, 0xea, 0x57,
+	0x5f, 0x31, 0x4d, 0xd6, 0x3f, 0xcb, 0x41, 0x59, 0x5b, 0x19, 0x59, 0x02, 0x7a, 0xf2, 0xb0, 0x91,
+	0xdb, 0xc7, 0xf7, 0x94, 0x4f, 0xe3, 0x7b, 0x2a, 0x8c, 0xf6, 0x3d, 0x05, 0xa9, 0x25, 0xa5, 0xbd,
+	0x53, 0x4b, 0x0c, 0xdf, 0xd3, 0x44, 0x7a, 0xdf, 0xd3, 0xe4, 0xfe, 0xbe, 0x27, 0xfb, 0x2f, 0x2c,
+	0x40, 0xc3, 0x8e, 0xc6, 0x2c, 0x1d, 0x45, 0xe2, 0xb6, 0xdf, 0x33, 0x59, 0xbd, 0x3e, 0xfb, 0x99,
+	0x80, 0xf6, 0x6d, 0x78, 0xe4, 0xb2, 0xe3, 0x7f, 0x18, 0x8e, 0x13, 0x29, 0x79, 0x9d, 0x1c, 0xbd,
+	0xe4, 0xaf, 0x4d, 0xc0, 0xec, 0x65, 0x67, 0xec, 0x78, 0xb4, 0x0f, 0x67, 0x64, 0xef, 0xe9, 0xc4,
+	0x0b, 0x6d, 0x6f, 0xc8, 0x39, 0xfd, 0x9c, 0xaa, 0x7a, 0x66, 0x39, 0x99, 0xed, 0xfe, 0x68, 0x12,
+	0x1e, 0x05, 0x9d, 0x7a, 0x61, 0x3c, 0x0f, 0xd3, 0xcc, 0xf7, 0x9c, 0x86, 0x2f, 0x23, 0xde, 0xac,
+	0x32, 0x25, 0xec, 0xb9, 0x53, 0x8a, 0x7d, 0xba, 0x6e, 0x12, 0x71, 0x94, 0x37, 0x31, 0x90, 0x5e,
+	0xc8, 0x1c, 0x48, 0x5f, 0x82, 0xb2, 0xc8, 0x1f, 0xbb, 0x46, 0x5a, 0x4c, 0x39, 0x74, 0xf5, 0x80,
+	0x54, 0x03, 0x02, 0x0e, 0x79, 0xd0, 0xe7, 0x61, 0x4e, 0xff, 0x81, 0x69, 0x8b, 0xde, 0xa6, 0xac,
+	0x32, 0x2d, 0xcc, 0x4b, 0x61, 0x00, 0x56, 0x63, 0x34, 0x3c, 0xc4, 0x8d, 0x16, 0x01, 0x9c, 0x56,
+	0xcf, 0xf5, 0xa8, 0x90, 0x59, 0x12, 0x75, 0x45, 0x22, 0xdc, 0x9a, 0x2e, 0xc5, 0x06, 0x07, 0x5a,
+	0x86, 0xf9, 0xf0, 0xaf, 0x40, 0xe4, 0x8c, 0xa8, 0x76, 0xea, 0xde, 0xdd, 0x73, 0xf3, 0x6b, 0x71,
+	0x22, 0x1e, 0xe6, 0xe7, 0xbd, 0x15, 0x1e, 0xb9, 0x57, 0x9d, 0x0e, 0x57, 0x0c, 0xc7, 0xa3, 0xbd,
+	0x75, 0x29, 0x46, 0xc7, 0x43, 0x35, 0x50, 0x1d, 0x4e, 0x39, 0x3d, 0x46, 0x1b, 0x03, 0x8f, 0xd6,
+	0xb7, 0x9d, 0xfe, 0xb5, 0xf5, 0xba, 0xd8, 0x63, 0x76, 0x85, 0x3a, 0x9a, 0xac, 0x7d, 0x4c, 0x41,
+	0x9d, 0x5a, 0x4b, 0x62, 0xc2, 0xc9, 0x75, 0xd1, 0x53, 0x70, 0xdc, 0xe9, 0x35, 0x3a, 0x83, 0x26,
+	0xdd, 0x20, 0x7e, 0x9b, 0x55, 0x26, 0x45, 0xd3, 0xe6, 0xb8, 0x85, 0xb6, 0x66, 0x94, 0xe3, 0x08,
+	0x17, 0xaf, 0x45, 0x6f, 0x1b, 0xb5, 0xca, 0x61, 0xad, 0x4b, 0xb7, 0xcd, 0x5a, 0x26, 0x57, 0x42,
+	0xde, 0x04, 0x64, 0xca, 0x9b, 0xb8, 0x05, 0x0b, 0x97, 0x1d, 0x9f, 0x92, 0x0f, 0x43, 0x03, 0x5d,
+	0x21, 0xde, 0xa6, 0xeb, 0x1d, 0xb9, 0xe4, 0xef, 0xe6, 0xa0, 0x24, 0xf3, 0x01, 0xd1, 0xd3, 0xb1,
+	0xa4, 0xbb, 0x8f, 0x0d, 0x25, 0xdd, 0x4d, 0x25, 0xe5, 0x4e, 0xda, 0x50, 0x72, 0x18, 0x1b, 0x44,
+	0xcf, 0x61, 0x6b, 0xa2, 0x04, 0x2b, 0x8a, 0x08, 0x2e, 0x89, 0xa6, 0x54, 0x0a, 0x07, 0xb1, 0xf7,
+	0x4b, 0x19, 0xb2, 0x73, 0xb0, 0x42, 0xe6, 0x32, 0xdc, 0x81, 0xdf, 0x1f, 0xf8, 0x95, 0xe2, 0xc1,
+	0xc9, 0xb8, 0x2a, 0x10, 0xb1, 0x42, 0xb6, 0xbf, 0x69, 0xc1, 0xac, 0xec, 0x83, 0xe5, 0x36, 0x6d,
+	0x6c, 0xd7, 0x7d, 0x2a, 0xe2, 0xa2, 0x03, 0x46, 0x59, 0xdc, 0x2b, 0xf3, 0x1a, 0xa3, 0x0c, 0x0b,
+	0x8a, 0xd1, 0xfa, 0xdc, 0x61, 0xb5, 0xde, 0xbe, 0x08, 0xc6, 0xe0, 0x88, 0x84, 0x56, 0x99, 0xd7,
+	0x29, 0x2d, 0xb0, 0x7c, 0xb8, 0x89, 0x48, 0xae, 0x5d, 0x1c, 0xd0, 0xed, 0xef, 0xe5, 0xa0, 0x28,
+	0x1c, 0x27, 0x59, 0x76, 0x9e, 0x7d, 0x02, 0x6e, 0x61, 0x44, 0xa9, 0xb0, 0x67, 0x44, 0x89, 0x25,
+	0x05, 0x94, 0x5e, 0xc8, 0xe0, 0xfb, 0x19, 0x27, 0xab, 0xfc, 0x41, 0x83, 0x3c, 0x3f, 0xb7, 0xe0,
+	0x64, 0x52, 0x68, 0x35, 0x4b, 0xff, 0x7d, 0x0a, 0x26, 0xfb, 0x1d, 0xe2, 0x6f, 0xb9, 0x5e, 0x37,
+	0x9e, 0xa2, 0xba, 0xa1, 0xca, 0xb1, 0xe6, 0x40, 0x1e, 0x80, 0x17, 0xac, 0xe7, 0xc0, 0x43, 0xf6,
+	0xe2, 0x83, 0x85, 0xdd, 0xc2, 0xb3, 0xa1, 0x2e, 0x62, 0xd8, 0x90, 0x62, 0xff, 0xa8, 0x08, 0xf3,
+	0xa2, 0xca, 0xb8, 0xc6, 0x49, 0x1f, 0x4e, 0x0b, 0x3f, 0xdc, 0xb0, 0x6d, 0x22, 0x67, 0xcd, 0x45,
+	0x55, 0xf3, 0xf4, 0x5a, 0x22, 0xd7, 0xfd, 0x91, 0x14, 0x3c, 0x02, 0x77, 0xd8, 0xe0, 0x80, 0x0c,
+	0x06, 0xc7, 0x05, 0x91, 0x26, 0x14, 0x98, 0x1a, 0x53, 0x51, 0xdf, 0xb6, 0x61, 0x64, 0x40, 0xe3,
+	0xff, 0x9e, 0x79, 0x61, 0xce, 0xd6, 0x89, 0x7d, 0x67, 0xeb, 0x48, 0x33, 0x62, 0xf2, 0x01, 0xcc,
+	0x88, 0xe1, 0xad, 0xbd, 0x9c, 0x69, 0x6b, 0x7f, 0xdf, 0x82, 0x09, 0x75, 0x95, 0xe7, 0x08, 0xe2,
+	0x97, 0x6f, 0xc5, 0x32, 0x16, 0x9f, 0x4c, 0x9d, 0x78, 0xc4, 0xc1, 0xf6, 0x89, 0x9b, 0xf1, 0xec,
+	0x4e, 0xc5, 0xf9, 0x70, 0x67, 0x77, 0x46, 0x3e, 0xf2, 0xa0, 0xb3, 0x3b, 0xa3, 0xe0, 0xfb, 0x67,
+	0x77, 0x46, 0xf8, 0x1f, 0xda, 0xec, 0xce, 0xc8, 0x57, 0x8e, 0x88, 0x47, 0xfd, 0x71, 0x21, 0xd6,
+	0x1a, 0x91, 0xdd, 0xf9, 0x9b, 0x30, 0xdf, 0x0f, 0x1c, 0xb2, 0xc2, 0xe9, 0xea, 0xd0, 0x20, 0x4e,
+	0xfa, 0x74, 0xc6, 0xb4, 0x37, 0xe5, 0xc1, 0xff, 0x88, 0x92, 0x3e, 0xbf, 0x11, 0xc7, 0xc5, 0xc3,
+	0xa2, 0x92, 0xb3, 0x4b, 0x73, 0x47, 0x9b, 0x5d, 0xba, 0x0b, 0x33, 0xfa, 0xc3, 0x5e, 0x27, 0x3b,
+	0x7a, 0xaf, 0x7c, 0x36, 0x63, 0x07, 0xf0, 0xba, 0xaa, 0x13, 0xb4, 0x7e, 0x89, 0x10, 0x19, 0x8e,
+	0x09, 0x42, 0x5f, 0x82, 0xf9, 0x46, 0x2c, 0x63, 0x2e, 0x08, 0x3b, 0xa5, 0x74, 0x95, 0xc4, 0x13,
+	0xee, 0xc2, 0xfe, 0x8f, 0x53, 0x18, 0x1e, 0x96, 0x25, 0xd2, 0x5f, 0x13, 0xd6, 0xc4, 0xff, 0xa7,
+	0xbf, 0x7e, 0xe8, 0xe9, 0xaf, 0x3c, 0x02, 0xae, 0x46, 0xe6, 0xa1, 0x8d, 0x80, 0xab, 0xef, 0x1b,
+	0xa1, 0x71, 0x7e, 0x62, 0xc1, 0x71, 0x63, 0x6f, 0x62, 0xa8, 0x0d, 0x70, 0x8b, 0x78, 0xb4, 0xed,
+	0xea, 0x93, 0x4f, 0xea, 0xb8, 0xe4, 0xeb, 0x41, 0x3d, 0x81, 0x14, 0xce, 0x2c, 0x5d, 0xce, 0xb0,
+	0x81, 0x8d, 0xde, 0x30, 0xa2, 0x7c, 0x72, 0x63, 0x4b, 0x25, 0x45, 0xf8, 0xa7, 0xa5, 0x04, 0x73,
+	0x53, 0x30, 0x62, 0x83, 0xf6, 0xf7, 0x2d, 0xbd, 0x8d, 0x26, 0x2e, 0x95, 0xfc, 0xe1, 0x2c, 0x95,
+	0x3a, 0x14, 0xf9, 0xae, 0x14, 0x5c, 0xae, 0xbb, 0x90, 0xd9, 0x32, 0x60, 0x2a, 0xef, 0x95, 0xff,
+	0x17, 0x4b, 0x2c, 0xfb, 0x3b, 0x39, 0x28, 0x6b, 0x05, 0x75, 0x04, 0xe6, 0xc0, 0x6b, 0x11, 0x73,
+	0xe0, 0xc9, 0x8c, 0xea, 0x75, 0xa4, 0x29, 0xf0, 0x4e, 0xcc, 0x14, 0xc8, 0xba, 0x71, 0xed, 0x67,
+	0x38, 0x59, 0x70, 0x52, 0xf3, 0x1a, 0x4a, 0x95, 0xa7, 0x9b, 0xb5, 0xb8, 0x16, 0x55, 0x67, 0x1a,
+	0xbd, 0x08, 0x84, 0x6a, 0xc5, 0x92, 0x26, 0x8c, 0x60, 0xcf, 0x71, 0x3d, 0xc7, 0x0f, 0x42, 0x8d,
+	0xa1, 0x11, 0xac, 0xca, 0xb1, 0xe6, 0xe0, 0x1e, 0xb9, 0x06, 0xe9, 0x35, 0x68, 0x27, 0x0c, 0x43,
+	0x8a, 0x46, 0x4d, 0x86, 0xaa, 0x63, 0x39, 0x46, 0xc7, 0x43, 0x35, 0xec, 0x7f, 0x92, 0x73, 0x54,
+	0x7e, 0xf1, 0x11, 0x28, 0x8f, 0x6b, 0x51, 0xe5, 0xb1, 0x94, 0xb1, 0xff, 0x47, 0xa8, 0x8f, 0x2f,
+	0xe7, 0x60, 0x36, 0x66, 0x5c, 0xf0, 0x2e, 0x17, 0xeb, 0x30, 0xde, 0xe5, 0x2a, 0xb0, 0x24, 0x68,
+	0x68, 0x87, 0x1f, 0xe8, 0xf4, 0x51, 0xcf, 0xf5, 0xd4, 0xb4, 0xf8, 0xec, 0x58, 0xf6, 0x4c, 0x00,
+	0x52, 0x9b, 0x97, 0x67, 0x41, 0x03, 0x17, 0x47, 0xc5, 0xa0, 0x8d, 0x58, 0x48, 0xfd, 0x52, 0x8f,
+	0xa7, 0x52, 0x36, 0xd5, 0x35, 0xe5, 0x8f, 0xea, 0x20, 0x7e, 0x02, 0x0f, 0x4e, 0xac, 0x69, 0xff,
+	0x95, 0x05, 0x67, 0x46, 0x7c, 0x4f, 0x8a, 0xb4, 0x9e, 0x0e, 0x4c, 0x8b, 0x5b, 0xf9, 0xba, 0x1f,
+	0x82, 0x75, 0x97, 0x6e, 0xe4, 0xcd, 0xaa, 0xb2, 0xf5, 0x91, 0x22, 0x1c, 0x05, 0xb7, 0x7f, 0x94,
+	0x03, 0xa4, 0xbf, 0x35, 0x4b, 0xf6, 0xd1, 0x3b, 0x30, 0xb1, 0x25, 0x63, 0xb3, 0x0f, 0x96, 0x8d,
+	0x56, 0x9b, 0x32, 0x13, 0xf2, 0x02, 0x4c, 0x7e, 0x77, 0xe8, 0x20, 0xb4, 0x03, 0x0c, 0x6b, 0x06,
+	0x7e, 0xd3, 0x7d, 0xcb, 0xe9, 0x39, 0xac, 0x3d, 0x66, 0x46, 0xb1, 0x38, 0x81, 0xaf, 0x6a, 0x04,
+	0x6c, 0xa0, 0xd9, 0x4d, 0x63, 0xf2, 0x73, 0x47, 0x51, 0x57, 0xbc, 0x21, 0xa0, 0xcd, 0xc7, 0xf8,
+	0x7d, 0xf1, 0x90, 0x37, 0xe4, 0xe1, 0x7d, 0xcf, 0x7c, 0xda, 0x17, 0xdd, 0x9a, 0x37, 0x54, 0xa7,
+	0x4f, 0xfb, 0x58, 0x50, 0xec, 0xff, 0xcc, 0x1b, 0x9a, 0x42, 0x1c, 0x08, 0x52, 0xad, 0xb0, 0xc7,
+	0xa3, 0x43, 0x56, 0x1e, 0xce, 0x87, 0xd4, 0xdd, 0xff, 0x26, 0x14, 0x76, 0x88, 0x17, 0x18, 0xb5,
+	0x29, 0xaf, 0x37, 0x0c, 0x27, 0x24, 0x87, 0x5f, 0x7f, 0x9d, 0x78, 0x0c, 0x0b, 0x4c, 0x7e, 0x58,
+	0xe2, 0xad, 0x08, 0x36, 0xdd, 0xcc, 0x1b, 0x8a, 0x4f, 0xfb, 0x66, 0x03, 0x69, 0x5f, 0xec, 0x8c,
+	0xb4, 0xcf, 0x2f, 0xb4, 0x4f, 0x19, 0xb6, 0x72, 0xb6, 0xa4, 0x98, 0xa4, 0xbd, 0x42, 0xa5, 0xe1,
+	0x87, 0x05, 0xd8, 0xc4, 0x47, 0x94, 0x7b, 0xea, 0xf8, 0x18, 0xaf, 0x7a, 0x6e, 0xb7, 0x52, 0x1a,
+	0x6b, 0x9e, 0xca, 0x49, 0x22, 0xe7, 0x14, 0xd6, 0x60, 0xd8, 0x00, 0xb6, 0xff, 0xa8, 0x6c, 0x4c,
+	0x2a, 0x65, 0xbd, 0x1c, 0xa4, 0xdd, 0xfc, 0x74, 0xf0, 0x22, 0x85, 0x9c, 0x3b, 0xe7, 0x22, 0x2f,
+	0x52, 0xdc, 0x37, 0x4f, 0x43, 0xe6, 0x1b, 0x15, 0x19, 0x9e, 0x51, 0x30, 0x75, 0x45, 0xf1, 0x10,
+	0x74, 0xc5, 0x6f, 0xc0, 0xfc, 0x56, 0x3c, 0xed, 0xb7, 0x32, 0x91, 0xc5, 0x79, 0x31, 0x94, 0x35,
+	0x2c, 0xfd, 0x65, 0x43, 0xc5, 0x78, 0x58, 0x10, 0x72, 0x83, 0xc7, 0x1b, 0x44, 0x94, 0x40, 0xc6,
+	0xbc, 0x52, 0xcf, 0x83, 0x58, 0x7c, 0x21, 0xfe, 0x6c, 0x83, 0x84, 0xc4, 0x11, 0x01, 0xfc, 0x42,
+	0x04, 0xf3, 0x89, 0x27, 0x2f, 0x44, 0x1c, 0x1f, 0xef, 0x42, 0x44, 0x3d, 0x00, 0xc0, 0x21, 0x16,
+	0x77, 0xa9, 0xde, 0x1c, 0xd0, 0x01, 0xdd, 0x70, 0x99, 0xbc, 0xb4, 0x3f, 0x2d, 0x2c, 0x1f, 0xed,
+	0x52, 0x7d, 0xd5, 0x24, 0xe2, 0x28, 0x6f, 0x4c, 0xab, 0x96, 0x0e, 0x52, 0xab, 0xa2, 0xa7, 0x75,
+	0xc2, 0x16, 0xef, 0x24, 0xe1, 0x0c, 0xcc, 0x0f, 0xa5, 0x5a, 0x71, 0x12, 0x36, 0xf9, 0xd0, 0xd7,
+	0x2d, 0x38, 0xc5, 0x15, 0xc3, 0xa5, 0xdb, 0xb4, 0x31, 0xe0, 0x1f, 0x19, 0x24, 0xad, 0x54, 0xa6,
+	0xb2, 0xb8, 0x2a, 0xea, 0x49, 0x10, 0xa1, 0x67, 0x33, 0x91, 0x8c, 0x93, 0x05, 0xf3, 0xcb, 0x7c,
+	0x7c, 0x17, 0xa2, 0xc2, 0x5b, 0xfd, 0xe0, 0xc1, 0x21, 0x7d, 0x38, 0x90, 0x3a, 0xde, 0xa7, 0x88,
+	0x42, 0xf9, 0x96, 0xeb, 0x6d, 0xcb, 0xd7, 0x49, 0x66, 0xce, 0x5b, 0xe9, 0xb5, 0x77, 0xe8, 0xf3,
+	0x08, 0xea, 0xcb, 0xa9, 0xa2, 0xff, 0xc4, 0x21, 0xb2, 0xfd, 0x9d, 0x82, 0xb9, 0x03, 0xa5, 0x8b,
+	0x8c, 0xbd, 0x09, 0x05, 0x9f, 0xb0, 0x6d, 0xa5, 0x02, 0x5e, 0x18, 0xe3, 0x7a, 0x66, 0xa8, 0x08,
+	0x26, 0x39, 0xb6, 0x28, 0x12, 0x98, 0x3c, 0xb7, 0x87, 0xb0, 0x78, 0x6e, 0x4f, 0x95, 0xe1, 0x1c,
+	0x61, 0x9c, 0xe6, 0x6c, 0x55, 0x26, 0xa2, 0xb4, 0xb5, 0x2d, 0x9c, 0x73, 0xc4, 0x13, 0x1b, 0x0d,
+	0xb7, 0xe7, 0x3b, 0xbd, 0x01, 0xbd, 0xda, 0xbb, 0xe4, 0x79, 0xae, 0xa7, 0x1c, 0xd7, 0xfa, 0x89,
+	0x8d, 0xe5, 0x28, 0x19, 0xc7, 0xf9, 0xd1, 0x0d, 0x28, 0x7a, 0xd4, 0xf7, 0x76, 0x2b, 0x85, 0xb1,
+	0x7a, 0x5b, 0x4c, 0x5b, 0x5e, 0x5f, 0x0e, 0xa6, 0xf8, 0x2f, 0x96, 0x88, 0x7a, 0x17, 0x2e, 0x1d,
+	0xc2, 0x2e, 0x1c, 0xc6, 0x29, 0xf3, 0x87, 0x16, 0xa7, 0xfc, 0xae, 0x05, 0x68, 0xb8, 0xa1, 0xe8,
+	0x35, 0x98, 0xf0, 0x9d, 0x2e, 0x75, 0x07, 0xfe, 0x98, 0x09, 0xa2, 0x62, 0x1b, 0xb8, 0x26, 0x21,
+	0x70, 0x80, 0xc5, 0xa3, 0x06, 0x94, 0x8f, 0xc8, 0xb5, 0x36, 0xdf, 0xd6, 0xdc, 0x8e, 0x34, 0xe1,
+	0xa7, 0x43, 0xaf, 0xde, 0xa5, 0x08, 0x15, 0xc7, 0xb8, 0xed, 0x1f, 0x99, 0xe7, 0xaf, 0xff, 0xf9,
+	0x57, 0x96, 0x95, 0x1f, 0xfc, 0x48, 0xef, 0x2a, 0x8f, 0xed, 0x07, 0xdf, 0xf7, 0x92, 0xf2, 0xdb,
+	0x70, 0x3a, 0x59, 0x15, 0x1c, 0xc8, 0x73, 0x58, 0xdf, 0x8f, 0xf7, 0x95, 0x30, 0xaa, 0x83, 0xe5,
+	0x67, 0x1d, 0xa6, 0x11, 0x9c, 0x3b, 0x60, 0x23, 0xd8, 0xf6, 0xcc, 0xa6, 0xa8, 0xc7, 0xc3, 0xd0,
+	0x3b, 0x6a, 0x9e, 0x59, 0x59, 0x5e, 0x96, 0x1a, 0x82, 0x19, 0x39, 0xd7, 0xfe, 0x2e, 0x07, 0xa7,
+	0x12, 0xb9, 0x75, 0x1f, 0xe6, 0x0e, 0xb3, 0x0f, 0xad, 0x43, 0x3e, 0x48, 0xe4, 0x0f, 0xf7, 0x20,
+	0x61, 0xff, 0x22, 0x67, 0x68, 0x1e, 0x1e, 0x62, 0x48, 0x31, 0xa9, 0x87, 0xdc, 0x25, 0xb9, 0xa3,
+	0x71, 0x97, 0x3c, 0x0e, 0x13, 0x7d, 0xea, 0x35, 0xa8, 0x7a, 0xeb, 0xa6, 0x18, 0x9a, 0xfd, 0x1b,
+	0xb2, 0x18, 0x07, 0x74, 0xf4, 0x06, 0x4c, 0xb2, 0xe0, 0x22, 0x46, 0x61, 0x2c, 0x45, 0x2f, 0x1e,
+	0xf7, 0xd1, 0x17, 0x30, 0x34, 0x1a, 0x77, 0xb8, 0x6d, 0x11, 0xa7, 0x33, 0xf0, 0x68, 0xa8, 0xec,
+	0x8b, 0xe2, 0x6b, 0xb4, 0xc3, 0x6d, 0x35, 0x46, 0xc7, 0x43, 0x35, 0xec, 0x3f, 0xc8, 0xc1, 0x09,
+	0xdd, 0x11, 0x61, 0x18, 0x28, 0x45, 0xe7, 0xbf, 0x77, 0x28, 0x9d, 0x6f, 0xe4, 0x2e, 0xec, 0x31,
+	0x00, 0x6f, 0x40, 0xf1, 0x96, 0x11, 0xee, 0x7a, 0x72, 0x8c, 0x70, 0x57, 0x38, 0xeb, 0x65, 0x7c,
+	0x4b, 0x02, 0xf2, 0x97, 0x64, 0x4e, 0x45, 0xfb, 0xc3, 0x73, 0x5b, 0x7c, 0x21, 0xf2, 0x14, 0x9f,
+	0xbe, 0xf9, 0x48, 0x98, 0x76, 0xba, 0xca, 0x16, 0x60, 0x45, 0xcd, 0xe2, 0x61, 0x78, 0x14, 0x4a,
+	0x6d, 0xd2, 0xf1, 0xb5, 0xa7, 0x54, 0x43, 0x5e, 0x11, 0xa5, 0x58, 0x51, 0xd1, 0xaf, 0x05, 0xcd,
+	0x2d, 0x8c, 0x1d, 0xdd, 0x53, 0xbe, 0xa0, 0xe4, 0x46, 0x7f, 0x35, 0x3e, 0x09, 0x24, 0x77, 0x8a,
+	0x49, 0xf0, 0x6c, 0x70, 0x6e, 0x96, 0x4d, 0xfd, 0x78, 0xfc, 0xdc, 0x8c, 0xa2, 0x7d, 0x69, 0x9e,
+	0x9d, 0xc3, 0xeb, 0x4c, 0xf9, 0x91, 0xd7, 0x99, 0x9e, 0x83, 0x99, 0x1d, 0x33, 0xeb, 0x3f, 0x78,
+	0xf0, 0x0a, 0x71, 0x53, 0x26, 0x72, 0x1f, 0x80, 0xe1, 0x18, 0x27, 0xcf, 0xa8, 0xe4, 0xb3, 0x5d,
+	0xd7, 0x2c, 0x86, 0x19, 0x95, 0xab, 0x46, 0x39, 0x8e, 0x70, 0xd9, 0xdf, 0x31, 0xcd, 0x35, 0x6d,
+	0xf6, 0xa3, 0x77, 0xa1, 0xdc, 0x74, 0xd8, 0xf6, 0x6b, 0x8c, 0xa4, 0xbb, 0xd1, 0xb3, 0x18, 0xbc,
+	0x22, 0xbb, 0xf8, 0xea, 0x80, 0xf4, 0x7c, 0xc7, 0xdf, 0x0d, 0x1d, 0x5e, 0x2b, 0x01, 0x10, 0x0e,
+	0x31, 0xe5, 0x13, 0x7e, 0x3e, 0x71, 0x7a, 0xda, 0xeb, 0x6a, 0x3c, 0xe1, 0x27, 0xcb, 0xb1, 0xe6,
+	0xb0, 0x77, 0xe0, 0x23, 0xaf, 0x0e, 0xc8, 0x91, 0xbf, 0xc6, 0x67, 0x7f, 0x2b, 0x07, 0x73, 0x3c,
+	0xef, 0x29, 0x92, 0x22, 0xb5, 0x11, 0xbc, 0x02, 0x92, 0xc1, 0xf7, 0x13, 0xcb, 0x01, 0xaf, 0x4d,
+	0x44, 0x9e, 0xff, 0xe0, 0x26, 0x54, 0x37, 0x70, 0xaa, 0xa4, 0x36, 0x09, 0x87, 0x92, 0xb7, 0xe4,
+	0x69, 0x42, 0x14, 0x63, 0x09, 0xc8, 0x91, 0xc5, 0xb5, 0xc6, 0x4a, 0x3e, 0x0b, 0xf2, 0xd0, 0x1b,
+	0x6a, 0x12, 0x59, 0x14, 0x63, 0x09, 0x68, 0x7f, 0x33, 0x07, 0xd2, 0xd3, 0x78, 0x04, 0x16, 0xf3,
+	0xab, 0x11, 0x8b, 0x79, 0x29, 0x4b, 0x84, 0x70, 0x54, 0x24, 0x2a, 0xee, 0x6b, 0x7e, 0x22, 0x63,
+	0xd8, 0x71, 0x8f, 0x28, 0xd4, 0xdf, 0x5a, 0x50, 0x16, 0x7c, 0x47, 0x60, 0x7c, 0x6f, 0x44, 0x8d,
+	0xef, 0x4f, 0x66, 0x68, 0xc5, 0x08, 0xa3, 0xfb, 0x4f, 0x0a, 0xea, 0xeb, 0xb5, 0x8f, 0xb9, 0x4d,
+	0xbc, 0xa6, 0x72, 0x33, 0x86, 0x96, 0x13, 0x2f, 0xc4, 0x92, 0xc6, 0x99, 0xc4, 0x13, 0x5d, 0x95,
+	0xc9, 0x28, 0x93, 0x78, 0xbf, 0x0b, 0x4b, 0x9a, 0x36, 0x0a, 0x27, 0x0e, 0xc1, 0x28, 0x7c, 0x4f,
+	0xde, 0x02, 0xa5, 0xcc, 0xa7, 0xcd, 0x55, 0xed, 0x74, 0xcc, 0x67, 0xbe, 0x4b, 0xab, 0xee, 0xfb,
+	0x86, 0x06, 0x05, 0x8e, 0xa1, 0xe2, 0x21, 0x39, 0xdc, 0x11, 0xd9, 0x8f, 0x5b, 0xc1, 0x95, 0x52,
+	0x96, 0xd5, 0x36, 0x64, 0x44, 0x4b, 0x47, 0xe4, 0x50, 0x31, 0x1e, 0x16, 0x84, 0xda, 0x70, 0xdc,
+	0x7c, 0x54, 0xa0, 0x92, 0xcf, 0x12, 0x73, 0x36, 0xdf, 0x28, 0x90, 0x1b, 0x85, 0x59, 0x82, 0x23,
+	0xc8, 0xf6, 0xd7, 0x2c, 0x80, 0x30, 0xe8, 0xce, 0xc7, 0xbc, 0xe1, 0x0e, 0x7a, 0xbe, 0x8a, 0x58,
+	0xe8, 0x31, 0x5f, 0xe6, 0x85, 0x58, 0xd2, 0xf8, 0x22, 0x93, 0x5e, 0xcc, 0x8a, 0x95, 0x65, 0x91,
+	0x19, 0x79, 0xce, 0x86, 0x89, 0x20, 0x0a, 0xb1, 0x02, 0xb4, 0xff, 0x7e, 0x12, 0xa6, 0x8c, 0xc5,
+	0x18, 0x0b, 0xed, 0x4f, 0x1f, 0x5a, 0x16, 0x4c, 0x82, 0x07, 0x7e, 0x6a, 0x2c, 0x0f, 0x3c, 0x83,
+	0x19, 0x65, 0x16, 0x05, 0x2f, 0x4f, 0x48, 0x63, 0x67, 0x6c, 0xef, 0xb5, 0xb0, 0x11, 0x56, 0x23,
+	0x90, 0x38, 0x26, 0x82, 0xbb, 0x4b, 0x54, 0x49, 0x7d, 0xd0, 0xed, 0x12, 0x6f, 0x57, 0x5d, 0x22,
+	0xd1, 0xee, 0x92, 0xd5, 0x08, 0x15, 0xc7, 0xb8, 0xd1, 0x86, 0x1e, 0x50, 0xf9, 0xfc, 0xc0, 0xa7,
+	0xb2, 0x0c, 0xa8, 0xb4, 0x78, 0xa2, 0xe3, 0x38, 0x22, 0xb1, 0xa8, 0x34, 0x56, 0x62, 0xd1, 0x7b,
+	0x30, 0xa7, 0x5c, 0xc1, 0x7a, 0xed, 0xa8, 0x90, 0xc0, 0xc5, 0xcc, 0x11, 0x9a, 0xc0, 0x3e, 0x10,
+	0x69, 0xbb, 0xcb, 0x31, 0x54, 0x3c, 0x24, 0x07, 0xdd, 0xe4, 0x11, 0x5c, 0x66, 0x08, 0x86, 0x07,
+	0x14, 0xac, 0xc2, 0xb8, 0x06, 0x24, 0x8e, 0x4a, 0x18, 0x19, 0xc4, 0x9e, 0x19, 0x37, 0x88, 0x8d,
+	0xba, 0xc6, 0x5e, 0x35, 0x2b, 0x66, 0xe3, 0xe7, 0x32, 0x6f, 0x8b, 0x19, 0xee, 0xeb, 0x7e, 0xa8,
+	0x57, 0x4a, 0x7f, 0x92, 0x87, 0x64, 0x37, 0x7e, 0xf8, 0x36, 0x91, 0xb5, 0xc7, 0xdb, 0x44, 0x91,
+	0x80, 0x4c, 0xee, 0x00, 0x03, 0x32, 0xd1, 0x98, 0x4a, 0xfe, 0x40, 0x63, 0x2a, 0xfc, 0x79, 0x17,
+	0xee, 0xff, 0x14, 0x4a, 0x5a, 0x6c, 0xe9, 0xd3, 0xc6, 0xf3, 0x2e, 0x9a, 0x82, 0x0d, 0x2e, 0xf4,
+	0x59, 0x6d, 0x28, 0xc9, 0xfc, 0xf7, 0x5f, 0x1e, 0xba, 0x34, 0x74, 0x22, 0xe2, 0x5d, 0x89, 0x05,
+	0xde, 0x33, 0xdc, 0x8e, 0x4d, 0xf0, 0xcb, 0x4f, 0x64, 0xf3, 0xcb, 0xdb, 0x3f, 0xcd, 0x43, 0x64,
+	0x0f, 0xe3, 0x8f, 0x27, 0xcc, 0x93, 0xd8, 0xab, 0xfa, 0x81, 0xef, 0xe8, 0x73, 0xd9, 0x7e, 0xea,
+	0x60, 0xe8, 0x51, 0xfe, 0x30, 0x7f, 0x33, 0xce, 0xc2, 0xf0, 0xb0, 0x50, 0xf4, 0x3b, 0x16, 0x9c,
+	0x20, 0xc3, 0x3f, 0x9b, 0xa0, 0x26, 0xcf, 0xb3, 0x63, 0xff, 0xee, 0x42, 0xed, 0x0c, 0x7f, 0x6f,
+	0x28, 0x81, 0x80, 0x93, 0xc4, 0xa1, 0xb7, 0xa0, 0x40, 0xbc, 0x56, 0xc6, 0xc4, 0xd9, 0x84, 0x5f,
+	0xc3, 0x08, 0x0d, 0xb1, 0xaa, 0xd7, 0x62, 0x58, 0x80, 0x86, 0xde, 0xb9, 0xc2, 0x41, 0x7b, 0x38,
+	0x7f, 0xbf, 0x04, 0x73, 0xf1, 0xd7, 0x96, 0xd4, 0xed, 0xee, 0x42, 0xe2, 0xed, 0x6e, 0xbe, 0x8a,
+	0x1b, 0xbe, 0x9a, 0x43, 0xe6, 0x2a, 0xe6, 0x85, 0x58, 0xd2, 0xf4, 0x2a, 0x16, 0xee, 0xaa, 0xe2,
+	0x03, 0xac, 0x62, 0xfe, 0x27, 0x0e, 0xb1, 0xd0, 0xc5, 0x68, 0x7c, 0xdd, 0x8e, 0xfb, 0x09, 0xe6,
+	0xcd, 0xb6, 0x8c, 0x1b, 0x62, 0xef, 0xf2, 0xcb, 0x55, 0x7a, 0x60, 0xb2, 0x79, 0x2c, 0x93, 0x7e,
+	0xfa, 0x42, 0x7a, 0x2c, 0x4d, 0x8a, 0x89, 0x1f, 0x6a, 0x26, 0xd1, 0x5b, 0x0f, 0x14, 0xed, 0x15,
+	0xdd, 0x65, 0xa0, 0xc5, 0xa3, 0xbd, 0x93, 0x0f, 0x1c, 0xed, 0x2d, 0xff, 0x6f, 0x8d, 0xf6, 0xda,
+	0x3f, 0xb5, 0x60, 0x3a, 0xe2, 0x0a, 0xe2, 0x03, 0x13, 0xf8, 0x82, 0xc6, 0xff, 0x19, 0x8f, 0xeb,
+	0x1a, 0x01, 0x1b, 0x68, 0xe8, 0x8b, 0x30, 0xd5, 0x71, 0x7b, 0x2d, 0xca, 0x7c, 0xee, 0x92, 0xad,
+	0xe4, 0xb2, 0x9c, 0x60, 0xb5, 0x4b, 0x57, 0xbc, 0x33, 0xb3, 0x2e, 0x61, 0x96, 0xdd, 0x6e, 0xbf,
+	0x43, 0x7d, 0xf9, 0xa2, 0x0e, 0x36, 0xc1, 0x45, 0x92, 0xab, 0xce, 0x12, 0x7e, 0x58, 0x93, 0x5c,
+	0xc3, 0xf4, 0xe6, 0x03, 0x4e, 0x72, 0x8d, 0xe4, 0x4d, 0xef, 0xe1, 0x5e, 0xe0, 0x29, 0xa3, 0x9a,
+	0xf7, 0xa1, 0x4d, 0x19, 0xd5, 0x5f, 0x38, 0xc2, 0xcd, 0xf0, 0xed, 0xa2, 0xd1, 0x8a, 0xa8, 0xab,
+	0x21, 0xb7, 0x87, 0xab, 0xe1, 0x6d, 0x98, 0x74, 0x7a, 0x3e, 0xf5, 0x76, 0x48, 0x67, 0xcc, 0xf0,
+	0x82, 0x6e, 0xea, 0x9a, 0xc2, 0xc1, 0x1a, 0x11, 0x75, 0xe0, 0xd4, 0x56, 0xf4, 0x65, 0x3c, 0xe3,
+	0xbd, 0xac, 0x72, 0xed, 0x99, 0x40, 0x21, 0xac, 0x26, 0x31, 0xdd, 0x1f, 0x45, 0xc0, 0xc9, 0xa0,
+	0x88, 0xc1, 0x34, 0x33, 0x7c, 0x6c, 0x81, 0x59, 0x92, 0x32, 0x4f, 0x2a, 0xee, 0x96, 0x34, 0x22,
+	0x09, 0x26, 0x28, 0x8e, 0xca, 0x40, 0xdf, 0xb0, 0xe0, 0xcc, 0x56, 0xf2, 0xeb, 0x7f, 0x95, 0x62,
+	0x96, 0x80, 0xc6, 0x88, 0x27, 0x04, 0x6b, 0x8f, 0xf0, 0xd7, 0x28, 0x46, 0x10, 0xf1, 0x28, 0xd1,
+	0x5c, 0x91, 0x9f, 0xde, 0x4a, 0x7c, 0x59, 0xaa, 0x52, 0xca, 0x92, 0x3a, 0x32, 0xe2, 0xad, 0xb2,
+	0x05, 0x7e, 0x0d, 0x35, 0x99, 0x86, 0x47, 0xc8, 0xb5, 0xbf, 0x6e, 0xc1, 0x4c, 0xf4, 0x2e, 0xc3,
+	0x87, 0xee, 0xf4, 0xf8, 0x49, 0x1e, 0x66, 0x63, 0x6a, 0x22, 0xe6, 0xf8, 0x28, 0x1f, 0xa5, 0xe3,
+	0xa3, 0x34, 0x96, 0xe3, 0x23, 0xf9, 0xc4, 0x5f, 0x18, 0xeb, 0xc4, 0xff, 0xbc, 0x3c, 0x75, 0xab,
+	0x91, 0x5d, 0x5b, 0x51, 0x1e, 0x48, 0xbd, 0x14, 0xd6, 0x4d, 0x22, 0x8e, 0xf2, 0x0a, 0x83, 0xbc,
+	0x39, 0xfc, 0x96, 0xb8, 0x72, 0x19, 0x3c, 0x9b, 0xf5, 0xfa, 0xb5, 0x06, 0x90, 0x06, 0x79, 0x02,
+	0x01, 0x27, 0x89, 0xb3, 0x7f, 0x31, 0x01, 0xa7, 0x92, 0x03, 0x1b, 0xfb, 0x87, 0xa3, 0x6e, 0x42,
+	0x79, 0x33, 0xf8, 0x11, 0x1b, 0xb5, 0x7c, 0x53, 0xbe, 0xee, 0xb5, 0xf7, 0x6f, 0xdf, 0x48, 0xcb,
+	0x56, 0xf3, 0xe0, 0x50, 0x0a, 0x17, 0xd9, 0x14, 0x2f, 0x20, 0xb7, 0x07, 0x9b, 0x95, 0x52, 0x16,
+	0x91, 0x7b, 0x3f, 0x9c, 0x2c, 0x45, 0x6a, 0x1e, 0x1c, 0x4a, 0x41, 0x14, 0x4a, 0x52, 0x80, 0xda,
+	0xa9, 0xab, 0xa9, 0x63, 0x2e, 0x23, 0x85, 0x09, 0x57, 0x94, 0x64, 0xc0, 0x0a, 0x5c, 0x89, 0xe9,
+	0x90, 0xcd, 0x4a, 0x3e, 0xa3, 0x98, 0x75, 0xb2, 0x8f, 0x98, 0x75, 0x22, 0xc5, 0x74, 0x88, 0x10,
+	0xd3, 0x16, 0x4f, 0x81, 0x54, 0x20, 0x8b, 0x98, 0x3d, 0x9e, 0x0f, 0x51, 0x8e, 0x35, 0xc1, 0x80,
+	0x15, 0x38, 0xcf, 0xfe, 0xb8, 0x39, 0x20, 0x41, 0x86, 0x5a, 0xca, 0xb3, 0xee, 0xc8, 0x20, 0x9b,
+	0x4c, 0xbe, 0xe3, 0x64, 0x2c, 0x60, 0xd1, 0x2e, 0x4c, 0x91, 0xf0, 0x67, 0xb2, 0xd4, 0x03, 0xcd,
+	0xab, 0x69, 0x7f, 0x48, 0x6c, 0xef, 0xdf, 0xd7, 0x52, 0xe7, 0x90, 0x90, 0x0b, 0x9b, 0xb2, 0x10,
+	0x81, 0x22, 0xe1, 0x3f, 0x19, 0xa5, 0x7c, 0x90, 0x9f, 0x4f, 0x29, 0x74, 0xe4, 0xaf, 0x4c, 0x49,
+	0x1b, 0x5b, 0xd0, 0xb1, 0x44, 0xe6, 0x22, 0x5a, 0x8e, 0x4f, 0x49, 0x65, 0x22, 0x8b, 0x88, 0xd1,
+	0x4f, 0xcb, 0x48, 0x11, 0x82, 0x8e, 0x25, 0xb2, 0x7d, 0x07, 0x4e, 0x27, 0x5f, 0x3d, 0x4c, 0x97,
+	0xdc, 0xd4, 0x27, 0x7e, 0xf0, 0x3c, 0x93, 0xe6, 0xe0, 0x6f, 0xe4, 0x60, 0x41, 0xe1, 0xcf, 0x77,
+	0x0c, 0xbc, 0x4e, 0xfc, 0xcd, 0x32, 0xfe, 0x7c, 0x03, 0x2f, 0xaf, 0xbd, 0xf4, 0xfe, 0x07, 0x67,
+	0x8f, 0xfd, 0xf8, 0x83, 0xb3, 0xc7, 0x7e, 0xf6, 0xc1, 0xd9, 0x63, 0x5f, 0xbe, 0x77, 0xd6, 0x7a,
+	0xff, 0xde, 0x59, 0xeb, 0xc7, 0xf7, 0xce, 0x5a, 0x3f, 0xbb, 0x77, 0xd6, 0xfa, 0xf7, 0x7b, 0x67,
+	0xad, 0xaf, 0xff, 0xfc, 0xec, 0xb1, 0x37, 0x3f, 0x91, 0xe6, 0xf7, 0x52, 0xff, 0x7b, 0x00, 0x60,
+	0xa1, 0x8c, 0x7f, 0x56, 0x75, 0x00, 0x00,
+}
+
+func (m *AgentConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AgentConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AgentConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Projects) > 0 {
+		for iNdEx := len(m.Projects) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Projects[iNdEx])
+			copy(dAtA[i:], m.Projects[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Projects[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *AnalysisRunArgument) Marshal() (dAtA []byte, err error) {
@@ -3179,6 +3554,14 @@ func (m *ApprovedStage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.AllowStale {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x10
 	if m.ApprovedAt != nil {
 		{
 			size, err := m.ApprovedAt.MarshalToSizedBuffer(dAtA[:i])
@@ -3380,6 +3763,11 @@ func (m *AutoPromotionOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Condition)
+	copy(dAtA[i:], m.Condition)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Condition)))
+	i--
+	dAtA[i] = 0x12
 	i -= len(m.SelectionPolicy)
 	copy(dAtA[i:], m.SelectionPolicy)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SelectionPolicy)))
@@ -3700,6 +4088,20 @@ func (m *ClusterConfigSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Agents) > 0 {
+		for iNdEx := len(m.Agents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Agents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.WebhookReceivers) > 0 {
 		for iNdEx := len(m.WebhookReceivers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -3866,6 +4268,37 @@ func (m *ClusterPromotionTaskList) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *ConcurrencyGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConcurrencyGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConcurrencyGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Limit))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *CurrentStage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4555,6 +4988,18 @@ func (m *FreightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.StalenessPolicy != nil {
+		{
+			size, err := m.StalenessPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size, err := m.Sources.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -4647,6 +5092,44 @@ func (m *FreightSources) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FreightStalenessPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FreightStalenessPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FreightStalenessPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.SupersededBy))
+	i--
+	dAtA[i] = 0x10
+	if m.MaxAge != nil {
+		{
+			size, err := m.MaxAge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *FreightStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5628,10 +6111,10 @@ func (m *ProjectConfigSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.WebhookReceivers) > 0 {
-		for iNdEx := len(m.WebhookReceivers) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ConcurrencyGroups) > 0 {
+		for iNdEx := len(m.ConcurrencyGroups) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.WebhookReceivers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ConcurrencyGroups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5639,13 +6122,13 @@ func (m *ProjectConfigSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x22
 		}
 	}
-	if len(m.PromotionPolicies) > 0 {
-		for iNdEx := len(m.PromotionPolicies) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PromotionWaves) > 0 {
+		for iNdEx := len(m.PromotionWaves) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PromotionPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PromotionWaves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5653,7 +6136,35 @@ func (m *ProjectConfigSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.WebhookReceivers) > 0 {
+		for iNdEx := len(m.WebhookReceivers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WebhookReceivers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PromotionPolicies) > 0 {
+		for iNdEx := len(m.PromotionPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PromotionPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
 	return len(dAtA) - i, nil
@@ -5910,6 +6421,45 @@ func (m *Promotion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PromotionConcurrency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PromotionConcurrency) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PromotionConcurrency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.CancelSuperseded {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Priority))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Group)
+	copy(dAtA[i:], m.Group)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *PromotionList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6109,6 +6659,37 @@ func (m *PromotionReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PromotionResume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PromotionResume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PromotionResume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Step))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Promotion)
+	copy(dAtA[i:], m.Promotion)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Promotion)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *PromotionSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6129,6 +6710,30 @@ func (m *PromotionSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ResumeFrom != nil {
+		{
+			size, err := m.ResumeFrom.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Concurrency != nil {
+		{
+			size, err := m.Concurrency.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Vars) > 0 {
 		for iNdEx := len(m.Vars) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -6190,6 +6795,21 @@ func (m *PromotionStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Workspace != nil {
+		{
+			size, err := m.Workspace.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.QueuePosition))
+	i--
+	dAtA[i] = 0x68
 	if m.StartedAt != nil {
 		{
 			size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
@@ -6660,6 +7280,18 @@ func (m *PromotionTemplateSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Concurrency != nil {
+		{
+			size, err := m.Concurrency.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Vars) > 0 {
 		for iNdEx := len(m.Vars) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -6691,7 +7323,7 @@ func (m *PromotionTemplateSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QuayWebhookReceiverConfig) Marshal() (dAtA []byte, err error) {
+func (m *PromotionWave) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6701,52 +7333,22 @@ func (m *QuayWebhookReceiverConfig) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuayWebhookReceiverConfig) MarshalTo(dAtA []byte) (int, error) {
+func (m *PromotionWave) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuayWebhookReceiverConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PromotionWave) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.SecretRef.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.FailureThreshold))
 	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *RepoSubscription) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *RepoSubscription) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RepoSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Chart != nil {
+	dAtA[i] = 0x28
+	if m.SoakTime != nil {
 		{
-			size, err := m.Chart.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.SoakTime.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6754,11 +7356,14 @@ func (m *RepoSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x22
 	}
-	if m.Image != nil {
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Percent))
+	i--
+	dAtA[i] = 0x18
+	if m.StageSelector != nil {
 		{
-			size, err := m.Image.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.StageSelector.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6768,22 +7373,15 @@ func (m *RepoSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Git != nil {
-		{
-			size, err := m.Git.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Stage) Marshal() (dAtA []byte, err error) {
+func (m *PromotionWavePolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6793,28 +7391,32 @@ func (m *Stage) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Stage) MarshalTo(dAtA []byte) (int, error) {
+func (m *PromotionWavePolicy) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Stage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PromotionWavePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Waves) > 0 {
+		for iNdEx := len(m.Waves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Waves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
 	{
-		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.StageSelector.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -6823,20 +7425,15 @@ func (m *Stage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x12
-	{
-		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *StageList) Marshal() (dAtA []byte, err error) {
+func (m *PromotionWaveProgress) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6846,20 +7443,20 @@ func (m *StageList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StageList) MarshalTo(dAtA []byte) (int, error) {
+func (m *PromotionWaveProgress) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StageList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PromotionWaveProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Waves) > 0 {
+		for iNdEx := len(m.Waves) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Waves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -6867,23 +7464,31 @@ func (m *StageList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x22
 		}
 	}
-	{
-		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	i--
+	if m.Halted {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
 	i--
+	dAtA[i] = 0x18
+	i -= len(m.Freight)
+	copy(dAtA[i:], m.Freight)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Freight)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Policy)
+	copy(dAtA[i:], m.Policy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Policy)))
+	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *StageSpec) Marshal() (dAtA []byte, err error) {
+func (m *PromotionWaveStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6893,77 +7498,57 @@ func (m *StageSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StageSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *PromotionWaveStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StageSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PromotionWaveStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Vars) > 0 {
-		for iNdEx := len(m.Vars) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Vars[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
+	if len(m.FailedStages) > 0 {
+		for iNdEx := len(m.FailedStages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FailedStages[iNdEx])
+			copy(dAtA[i:], m.FailedStages[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.FailedStages[iNdEx])))
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0x2a
 		}
 	}
-	if m.PromotionTemplate != nil {
-		{
-			size, err := m.PromotionTemplate.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	if len(m.VerifiedStages) > 0 {
+		for iNdEx := len(m.VerifiedStages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VerifiedStages[iNdEx])
+			copy(dAtA[i:], m.VerifiedStages[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.VerifiedStages[iNdEx])))
+			i--
+			dAtA[i] = 0x22
 		}
-		i--
-		dAtA[i] = 0x32
 	}
-	if len(m.RequestedFreight) > 0 {
-		for iNdEx := len(m.RequestedFreight) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RequestedFreight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
+	if len(m.Stages) > 0 {
+		for iNdEx := len(m.Stages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Stages[iNdEx])
+			copy(dAtA[i:], m.Stages[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Stages[iNdEx])))
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x1a
 		}
 	}
-	i -= len(m.Shard)
-	copy(dAtA[i:], m.Shard)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Shard)))
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
 	i--
-	dAtA[i] = 0x22
-	if m.Verification != nil {
-		{
-			size, err := m.Verification.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *StageStats) Marshal() (dAtA []byte, err error) {
+func (m *PromotionWorkspace) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6973,21 +7558,26 @@ func (m *StageStats) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StageStats) MarshalTo(dAtA []byte) (int, error) {
+func (m *PromotionWorkspace) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StageStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PromotionWorkspace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i = encodeVarintGenerated(dAtA, i, uint64(m.Count))
+	i--
+	if m.Retained {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
 	i--
 	dAtA[i] = 0x10
 	{
-		size, err := m.Health.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.DiskUsage.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -6999,7 +7589,7 @@ func (m *StageStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *StageStatus) Marshal() (dAtA []byte, err error) {
+func (m *QuayWebhookReceiverConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7009,134 +7599,30 @@ func (m *StageStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StageStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuayWebhookReceiverConfig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StageStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuayWebhookReceiverConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Metadata) > 0 {
-		keysForMetadata := make([]string, 0, len(m.Metadata))
-		for k := range m.Metadata {
-			keysForMetadata = append(keysForMetadata, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForMetadata)
-		for iNdEx := len(keysForMetadata) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.Metadata[string(keysForMetadata[iNdEx])]
-			baseI := i
-			{
-				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-			i -= len(keysForMetadata[iNdEx])
-			copy(dAtA[i:], keysForMetadata[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForMetadata[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x7a
-		}
-	}
-	i--
-	if m.AutoPromotionEnabled {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
-	i--
-	dAtA[i] = 0x70
-	if len(m.Conditions) > 0 {
-		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x6a
-		}
-	}
-	i -= len(m.FreightSummary)
-	copy(dAtA[i:], m.FreightSummary)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FreightSummary)))
-	i--
-	dAtA[i] = 0x62
-	i -= len(m.LastHandledRefresh)
-	copy(dAtA[i:], m.LastHandledRefresh)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LastHandledRefresh)))
-	i--
-	dAtA[i] = 0x5a
-	if m.LastPromotion != nil {
-		{
-			size, err := m.LastPromotion.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x52
-	}
-	if m.Health != nil {
-		{
-			size, err := m.Health.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.CurrentPromotion != nil {
-		{
-			size, err := m.CurrentPromotion.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	{
+		size, err := m.SecretRef.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x3a
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	i = encodeVarintGenerated(dAtA, i, uint64(m.ObservedGeneration))
 	i--
-	dAtA[i] = 0x30
-	if len(m.FreightHistory) > 0 {
-		for iNdEx := len(m.FreightHistory) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FreightHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *StepExecutionMetadata) Marshal() (dAtA []byte, err error) {
+func (m *RepoSubscription) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7146,40 +7632,19 @@ func (m *StepExecutionMetadata) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StepExecutionMetadata) MarshalTo(dAtA []byte) (int, error) {
+func (m *RepoSubscription) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StepExecutionMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RepoSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i--
-	if m.ContinueOnError {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
-	i--
-	dAtA[i] = 0x38
-	i -= len(m.Message)
-	copy(dAtA[i:], m.Message)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
-	i--
-	dAtA[i] = 0x32
-	i -= len(m.Status)
-	copy(dAtA[i:], m.Status)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Status)))
-	i--
-	dAtA[i] = 0x2a
-	i = encodeVarintGenerated(dAtA, i, uint64(m.ErrorCount))
-	i--
-	dAtA[i] = 0x20
-	if m.FinishedAt != nil {
+	if m.Chart != nil {
 		{
-			size, err := m.FinishedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Chart.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7189,9 +7654,9 @@ func (m *StepExecutionMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.StartedAt != nil {
+	if m.Image != nil {
 		{
-			size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Image.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7201,15 +7666,22 @@ func (m *StepExecutionMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	i -= len(m.Alias)
-	copy(dAtA[i:], m.Alias)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Alias)))
-	i--
-	dAtA[i] = 0xa
+	if m.Git != nil {
+		{
+			size, err := m.Git.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Verification) Marshal() (dAtA []byte, err error) {
+func (m *Stage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7219,60 +7691,50 @@ func (m *Verification) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Verification) MarshalTo(dAtA []byte) (int, error) {
+func (m *Stage) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Verification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Stage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Args) > 0 {
-		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Args[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if m.AnalysisRunMetadata != nil {
-		{
-			size, err := m.AnalysisRunMetadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x12
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if len(m.AnalysisTemplates) > 0 {
-		for iNdEx := len(m.AnalysisTemplates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AnalysisTemplates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *VerificationInfo) Marshal() (dAtA []byte, err error) {
+func (m *StageList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7282,76 +7744,44 @@ func (m *VerificationInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VerificationInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *StageList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VerificationInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StageList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Actor)
-	copy(dAtA[i:], m.Actor)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Actor)))
-	i--
-	dAtA[i] = 0x3a
-	if m.FinishTime != nil {
-		{
-			size, err := m.FinishTime.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.StartTime != nil {
-		{
-			size, err := m.StartTime.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0x2a
 	}
-	i -= len(m.ID)
-	copy(dAtA[i:], m.ID)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ID)))
-	i--
-	dAtA[i] = 0x22
-	if m.AnalysisRun != nil {
-		{
-			size, err := m.AnalysisRun.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	i -= len(m.Message)
-	copy(dAtA[i:], m.Message)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.Phase)
-	copy(dAtA[i:], m.Phase)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *VerifiedStage) Marshal() (dAtA []byte, err error) {
+func (m *StageSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7361,19 +7791,38 @@ func (m *VerifiedStage) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VerifiedStage) MarshalTo(dAtA []byte) (int, error) {
+func (m *StageSpec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VerifiedStage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StageSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LongestCompletedSoak != nil {
+	i -= len(m.Agent)
+	copy(dAtA[i:], m.Agent)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Agent)))
+	i--
+	dAtA[i] = 0x42
+	if len(m.Vars) > 0 {
+		for iNdEx := len(m.Vars) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vars[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.PromotionTemplate != nil {
 		{
-			size, err := m.LongestCompletedSoak.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.PromotionTemplate.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7381,11 +7830,30 @@ func (m *VerifiedStage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x32
 	}
-	if m.VerifiedAt != nil {
+	if len(m.RequestedFreight) > 0 {
+		for iNdEx := len(m.RequestedFreight) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RequestedFreight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	i -= len(m.Shard)
+	copy(dAtA[i:], m.Shard)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Shard)))
+	i--
+	dAtA[i] = 0x22
+	if m.Verification != nil {
 		{
-			size, err := m.VerifiedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Verification.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7393,12 +7861,12 @@ func (m *VerifiedStage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1a
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Warehouse) Marshal() (dAtA []byte, err error) {
+func (m *StageStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7408,38 +7876,21 @@ func (m *Warehouse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Warehouse) MarshalTo(dAtA []byte) (int, error) {
+func (m *StageStats) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Warehouse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StageStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Count))
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x10
 	{
-		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Health.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -7451,7 +7902,7 @@ func (m *Warehouse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *WarehouseList) Marshal() (dAtA []byte, err error) {
+func (m *StageStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7461,20 +7912,27 @@ func (m *WarehouseList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WarehouseList) MarshalTo(dAtA []byte) (int, error) {
+func (m *StageStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WarehouseList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StageStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Metadata) > 0 {
+		keysForMetadata := make([]string, 0, len(m.Metadata))
+		for k := range m.Metadata {
+			keysForMetadata = append(keysForMetadata, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForMetadata)
+		for iNdEx := len(keysForMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Metadata[string(keysForMetadata[iNdEx])]
+			baseI := i
 			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -7483,44 +7941,51 @@ func (m *WarehouseList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			}
 			i--
 			dAtA[i] = 0x12
+			i -= len(keysForMetadata[iNdEx])
+			copy(dAtA[i:], keysForMetadata[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForMetadata[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x7a
 		}
 	}
-	{
-		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
 	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *WarehouseSpec) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.AutoPromotionEnabled {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	return dAtA[:n], nil
-}
-
-func (m *WarehouseSpec) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *WarehouseSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FreightCreationCriteria != nil {
+	i--
+	dAtA[i] = 0x70
+	if len(m.Conditions) > 0 {
+		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	i -= len(m.FreightSummary)
+	copy(dAtA[i:], m.FreightSummary)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FreightSummary)))
+	i--
+	dAtA[i] = 0x62
+	i -= len(m.LastHandledRefresh)
+	copy(dAtA[i:], m.LastHandledRefresh)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LastHandledRefresh)))
+	i--
+	dAtA[i] = 0x5a
+	if m.LastPromotion != nil {
 		{
-			size, err := m.FreightCreationCriteria.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.LastPromotion.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7528,32 +7993,39 @@ func (m *WarehouseSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x52
 	}
-	{
-		size, err := m.Interval.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Health != nil {
+		{
+			size, err := m.Health.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x42
 	}
+	if m.CurrentPromotion != nil {
+		{
+			size, err := m.CurrentPromotion.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ObservedGeneration))
 	i--
-	dAtA[i] = 0x22
-	i -= len(m.FreightCreationPolicy)
-	copy(dAtA[i:], m.FreightCreationPolicy)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FreightCreationPolicy)))
-	i--
-	dAtA[i] = 0x1a
-	i -= len(m.Shard)
-	copy(dAtA[i:], m.Shard)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Shard)))
-	i--
-	dAtA[i] = 0x12
-	if len(m.Subscriptions) > 0 {
-		for iNdEx := len(m.Subscriptions) - 1; iNdEx >= 0; iNdEx-- {
+	dAtA[i] = 0x30
+	if len(m.FreightHistory) > 0 {
+		for iNdEx := len(m.FreightHistory) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Subscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.FreightHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -7561,13 +8033,13 @@ func (m *WarehouseSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x22
 		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *WarehouseStats) Marshal() (dAtA []byte, err error) {
+func (m *StepExecutionMetadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7577,33 +8049,70 @@ func (m *WarehouseStats) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WarehouseStats) MarshalTo(dAtA []byte) (int, error) {
+func (m *StepExecutionMetadata) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WarehouseStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StepExecutionMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i = encodeVarintGenerated(dAtA, i, uint64(m.Count))
 	i--
-	dAtA[i] = 0x10
-	{
-		size, err := m.Health.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.ContinueOnError {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x38
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.Status)
+	copy(dAtA[i:], m.Status)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Status)))
+	i--
+	dAtA[i] = 0x2a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ErrorCount))
+	i--
+	dAtA[i] = 0x20
+	if m.FinishedAt != nil {
+		{
+			size, err := m.FinishedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.StartedAt != nil {
+		{
+			size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
+	i -= len(m.Alias)
+	copy(dAtA[i:], m.Alias)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Alias)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *WarehouseStatus) Marshal() (dAtA []byte, err error) {
+func (m *Verification) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7613,20 +8122,20 @@ func (m *WarehouseStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WarehouseStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *Verification) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WarehouseStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Verification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Conditions) > 0 {
-		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Steps) > 0 {
+		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Steps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -7634,17 +8143,26 @@ func (m *WarehouseStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4a
+			dAtA[i] = 0x22
 		}
 	}
-	i -= len(m.LastFreightID)
-	copy(dAtA[i:], m.LastFreightID)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LastFreightID)))
-	i--
-	dAtA[i] = 0x42
-	if m.DiscoveredArtifacts != nil {
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Args[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.AnalysisRunMetadata != nil {
 		{
-			size, err := m.DiscoveredArtifacts.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.AnalysisRunMetadata.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7652,20 +8170,26 @@ func (m *WarehouseStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x12
+	}
+	if len(m.AnalysisTemplates) > 0 {
+		for iNdEx := len(m.AnalysisTemplates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AnalysisTemplates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	i -= len(m.LastHandledRefresh)
-	copy(dAtA[i:], m.LastHandledRefresh)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LastHandledRefresh)))
-	i--
-	dAtA[i] = 0x32
-	i = encodeVarintGenerated(dAtA, i, uint64(m.ObservedGeneration))
-	i--
-	dAtA[i] = 0x20
 	return len(dAtA) - i, nil
 }
 
-func (m *WebhookReceiverConfig) Marshal() (dAtA []byte, err error) {
+func (m *VerificationInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7675,19 +8199,19 @@ func (m *WebhookReceiverConfig) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WebhookReceiverConfig) MarshalTo(dAtA []byte) (int, error) {
+func (m *VerificationInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WebhookReceiverConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *VerificationInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Harbor != nil {
+	if m.State != nil {
 		{
-			size, err := m.Harbor.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7697,9 +8221,31 @@ func (m *WebhookReceiverConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x52
 	}
-	if m.Artifactory != nil {
+	if len(m.StepExecutionMetadata) > 0 {
+		for iNdEx := len(m.StepExecutionMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StepExecutionMetadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.CurrentStep))
+	i--
+	dAtA[i] = 0x40
+	i -= len(m.Actor)
+	copy(dAtA[i:], m.Actor)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Actor)))
+	i--
+	dAtA[i] = 0x3a
+	if m.FinishTime != nil {
 		{
-			size, err := m.Artifactory.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.FinishTime.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7707,11 +8253,11 @@ func (m *WebhookReceiverConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0x32
 	}
-	if m.Azure != nil {
+	if m.StartTime != nil {
 		{
-			size, err := m.Azure.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.StartTime.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7719,35 +8265,16 @@ func (m *WebhookReceiverConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x2a
 	}
-	if m.Gitea != nil {
-		{
-			size, err := m.Gitea.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.DockerHub != nil {
-		{
-			size, err := m.DockerHub.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Bitbucket != nil {
+	i -= len(m.ID)
+	copy(dAtA[i:], m.ID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ID)))
+	i--
+	dAtA[i] = 0x22
+	if m.AnalysisRun != nil {
 		{
-			size, err := m.Bitbucket.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.AnalysisRun.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7755,23 +8282,44 @@ func (m *WebhookReceiverConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x1a
 	}
-	if m.Quay != nil {
-		{
-			size, err := m.Quay.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifiedStage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.GitLab != nil {
+	return dAtA[:n], nil
+}
+
+func (m *VerifiedStage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifiedStage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LongestCompletedSoak != nil {
 		{
-			size, err := m.GitLab.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.LongestCompletedSoak.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7779,11 +8327,11 @@ func (m *WebhookReceiverConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 	}
-	if m.GitHub != nil {
+	if m.VerifiedAt != nil {
 		{
-			size, err := m.GitHub.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.VerifiedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7791,17 +8339,12 @@ func (m *WebhookReceiverConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0xa
 	}
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *WebhookReceiverDetails) Marshal() (dAtA []byte, err error) {
+func (m *Warehouse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7811,561 +8354,797 @@ func (m *WebhookReceiverDetails) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WebhookReceiverDetails) MarshalTo(dAtA []byte) (int, error) {
+func (m *Warehouse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WebhookReceiverDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Warehouse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.URL)
-	copy(dAtA[i:], m.URL)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
-	i--
-	dAtA[i] = 0x22
-	i -= len(m.Path)
-	copy(dAtA[i:], m.Path)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
 	i--
 	dAtA[i] = 0x1a
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenerated(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *WarehouseList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *AnalysisRunArgument) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Value)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+
+func (m *WarehouseList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AnalysisRunMetadata) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *WarehouseList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *AnalysisRunReference) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WarehouseSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Phase)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AnalysisTemplateReference) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Kind)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+func (m *WarehouseSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApprovedStage) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *WarehouseSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ApprovedAt != nil {
-		l = m.ApprovedAt.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	return n
-}
-
-func (m *ArgoCDAppHealthStatus) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.FreightStalenessPolicy != nil {
+		{
+			size, err := m.FreightStalenessPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	var l int
-	_ = l
-	l = len(m.Status)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *ArgoCDAppStatus) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.FreightCreationCriteria != nil {
+		{
+			size, err := m.FreightCreationCriteria.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.HealthStatus.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.SyncStatus.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *ArgoCDAppSyncStatus) Size() (n int) {
-	if m == nil {
-		return 0
+	{
+		size, err := m.Interval.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.Status)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Revision)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Revisions) > 0 {
-		for _, s := range m.Revisions {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.FreightCreationPolicy)
+	copy(dAtA[i:], m.FreightCreationPolicy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FreightCreationPolicy)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Shard)
+	copy(dAtA[i:], m.Shard)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Shard)))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Subscriptions) > 0 {
+		for iNdEx := len(m.Subscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ArtifactoryWebhookReceiverConfig) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WarehouseStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.SecretRef.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.VirtualRepoName)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AutoPromotionOptions) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SelectionPolicy)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+func (m *WarehouseStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AzureWebhookReceiverConfig) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *WarehouseStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.SecretRef.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Count))
+	i--
+	dAtA[i] = 0x10
+	{
+		size, err := m.Health.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *BitbucketWebhookReceiverConfig) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WarehouseStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.SecretRef.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Chart) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RepoURL)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Version)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+func (m *WarehouseStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ChartDiscoveryResult) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *WarehouseStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RepoURL)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.SemverConstraint)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Versions) > 0 {
-		for _, s := range m.Versions {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Conditions) > 0 {
+		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
 		}
 	}
-	return n
+	i -= len(m.LastFreightID)
+	copy(dAtA[i:], m.LastFreightID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LastFreightID)))
+	i--
+	dAtA[i] = 0x42
+	if m.DiscoveredArtifacts != nil {
+		{
+			size, err := m.DiscoveredArtifacts.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	i -= len(m.LastHandledRefresh)
+	copy(dAtA[i:], m.LastHandledRefresh)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LastHandledRefresh)))
+	i--
+	dAtA[i] = 0x32
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ObservedGeneration))
+	i--
+	dAtA[i] = 0x20
+	return len(dAtA) - i, nil
 }
 
-func (m *ChartSubscription) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WebhookReceiverConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.RepoURL)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.SemverConstraint)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 1 + sovGenerated(uint64(m.DiscoveryLimit))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ClusterConfig) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WebhookReceiverConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WebhookReceiverConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Harbor != nil {
+		{
+			size, err := m.Harbor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Artifactory != nil {
+		{
+			size, err := m.Artifactory.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Azure != nil {
+		{
+			size, err := m.Azure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Gitea != nil {
+		{
+			size, err := m.Gitea.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.DockerHub != nil {
+		{
+			size, err := m.DockerHub.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Bitbucket != nil {
+		{
+			size, err := m.Bitbucket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Quay != nil {
+		{
+			size, err := m.Quay.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.GitLab != nil {
+		{
+			size, err := m.GitLab.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GitHub != nil {
+		{
+			size, err := m.GitHub.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *WebhookReceiverDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *WebhookReceiverDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WebhookReceiverDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Status.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Path)
+	copy(dAtA[i:], m.Path)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ClusterConfigList) Size() (n int) {
+func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenerated(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AgentConfig) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
+	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
+	if len(m.Projects) > 0 {
+		for _, s := range m.Projects {
+			l = len(s)
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
 	return n
 }
 
-func (m *ClusterConfigSpec) Size() (n int) {
+func (m *AnalysisRunArgument) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.WebhookReceivers) > 0 {
-		for _, e := range m.WebhookReceivers {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *ClusterConfigStatus) Size() (n int) {
+func (m *AnalysisRunMetadata) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Conditions) > 0 {
-		for _, e := range m.Conditions {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
-	if len(m.WebhookReceivers) > 0 {
-		for _, e := range m.WebhookReceivers {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
-	n += 1 + sovGenerated(uint64(m.ObservedGeneration))
-	l = len(m.LastHandledRefresh)
-	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *ClusterPromotionTask) Size() (n int) {
+func (m *AnalysisRunReference) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
+	l = len(m.Namespace)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Phase)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *ClusterPromotionTaskList) Size() (n int) {
+func (m *AnalysisTemplateReference) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
 	return n
 }
 
-func (m *CurrentStage) Size() (n int) {
+func (m *ApprovedStage) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Since != nil {
-		l = m.Since.Size()
+	if m.ApprovedAt != nil {
+		l = m.ApprovedAt.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	n += 2
 	return n
 }
 
-func (m *DiscoveredArtifacts) Size() (n int) {
+func (m *ArgoCDAppHealthStatus) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Git) > 0 {
-		for _, e := range m.Git {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	l = len(m.Status)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ArgoCDAppStatus) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if len(m.Images) > 0 {
-		for _, e := range m.Images {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.HealthStatus.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.SyncStatus.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ArgoCDAppSyncStatus) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if len(m.Charts) > 0 {
-		for _, e := range m.Charts {
-			l = e.Size()
+	var l int
+	_ = l
+	l = len(m.Status)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Revision)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Revisions) > 0 {
+		for _, s := range m.Revisions {
+			l = len(s)
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	l = m.DiscoveredAt.Size()
-	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *DiscoveredCommit) Size() (n int) {
+func (m *ArtifactoryWebhookReceiverConfig) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ID)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Branch)
+	l = m.SecretRef.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Tag)
+	l = len(m.VirtualRepoName)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Subject)
+	return n
+}
+
+func (m *AutoPromotionOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SelectionPolicy)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Author)
+	l = len(m.Condition)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Committer)
+	return n
+}
+
+func (m *AzureWebhookReceiverConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SecretRef.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.CreatorDate != nil {
-		l = m.CreatorDate.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *BitbucketWebhookReceiverConfig) Size() (n int) {
+	if m == nil {
+		return 0
 	}
+	var l int
+	_ = l
+	l = m.SecretRef.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *DiscoveredImageReference) Size() (n int) {
+func (m *Chart) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Tag)
+	l = len(m.RepoURL)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Digest)
+	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Version)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ChartDiscoveryResult) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	var l int
+	_ = l
+	l = len(m.RepoURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SemverConstraint)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Versions) > 0 {
+		for _, s := range m.Versions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
 	return n
 }
 
-func (m *DockerHubWebhookReceiverConfig) Size() (n int) {
+func (m *ChartSubscription) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.SecretRef.Size()
+	l = len(m.RepoURL)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SemverConstraint)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.DiscoveryLimit))
 	return n
 }
 
-func (m *ExpressionVariable) Size() (n int) {
+func (m *ClusterConfig) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = m.ObjectMeta.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Value)
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *Freight) Size() (n int) {
+func (m *ClusterConfigList) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
+	l = m.ListMeta.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Commits) > 0 {
-		for _, e := range m.Commits {
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if len(m.Images) > 0 {
-		for _, e := range m.Images {
+	return n
+}
+
+func (m *ClusterConfigSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.WebhookReceivers) > 0 {
+		for _, e := range m.WebhookReceivers {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if len(m.Charts) > 0 {
-		for _, e := range m.Charts {
+	if len(m.Agents) > 0 {
+		for _, e := range m.Agents {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	l = m.Status.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Alias)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Origin.Size()
-	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *FreightCollection) Size() (n int) {
+func (m *ClusterConfigStatus) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Freight) > 0 {
-		for k, v := range m.Freight {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if len(m.VerificationHistory) > 0 {
-		for _, e := range m.VerificationHistory {
+	if len(m.WebhookReceivers) > 0 {
+		for _, e := range m.WebhookReceivers {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	l = len(m.ID)
+	n += 1 + sovGenerated(uint64(m.ObservedGeneration))
+	l = len(m.LastHandledRefresh)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *FreightCreationCriteria) Size() (n int) {
+func (m *ClusterPromotionTask) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Expression)
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *FreightList) Size() (n int) {
+func (m *ClusterPromotionTaskList) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -8382,29 +9161,39 @@ func (m *FreightList) Size() (n int) {
 	return n
 }
 
-func (m *FreightOrigin) Size() (n int) {
+func (m *ConcurrencyGroup) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Kind)
-	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Limit))
 	return n
 }
 
-func (m *FreightReference) Size() (n int) {
+func (m *CurrentStage) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Commits) > 0 {
-		for _, e := range m.Commits {
+	if m.Since != nil {
+		l = m.Since.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *DiscoveredArtifacts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Git) > 0 {
+		for _, e := range m.Git {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
@@ -8421,42 +9210,253 @@ func (m *FreightReference) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	l = m.Origin.Size()
+	l = m.DiscoveredAt.Size()
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *FreightRequest) Size() (n int) {
+func (m *DiscoveredCommit) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Origin.Size()
+	l = len(m.ID)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Sources.Size()
+	l = len(m.Branch)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Tag)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Subject)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Author)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Committer)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.CreatorDate != nil {
+		l = m.CreatorDate.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
-func (m *FreightSources) Size() (n int) {
+func (m *DiscoveredImageReference) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	n += 2
-	if len(m.Stages) > 0 {
-		for _, s := range m.Stages {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if m.RequiredSoakTime != nil {
-		l = m.RequiredSoakTime.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.AvailabilityStrategy)
+	l = len(m.Tag)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Digest)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *DockerHubWebhookReceiverConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SecretRef.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ExpressionVariable) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *Freight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Commits) > 0 {
+		for _, e := range m.Commits {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Images) > 0 {
+		for _, e := range m.Images {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Charts) > 0 {
+		for _, e := range m.Charts {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Alias)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Origin.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *FreightCollection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Freight) > 0 {
+		for k, v := range m.Freight {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.VerificationHistory) > 0 {
+		for _, e := range m.VerificationHistory {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.ID)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *FreightCreationCriteria) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Expression)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *FreightList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FreightOrigin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *FreightReference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Commits) > 0 {
+		for _, e := range m.Commits {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Images) > 0 {
+		for _, e := range m.Images {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Charts) > 0 {
+		for _, e := range m.Charts {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = m.Origin.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *FreightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Origin.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Sources.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.StalenessPolicy != nil {
+		l = m.StalenessPolicy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *FreightSources) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	if len(m.Stages) > 0 {
+		for _, s := range m.Stages {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.RequiredSoakTime != nil {
+		l = m.RequiredSoakTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.AvailabilityStrategy)
 	n += 1 + l + sovGenerated(uint64(l))
 	if m.AutoPromotionOptions != nil {
 		l = m.AutoPromotionOptions.Size()
@@ -8465,6 +9465,20 @@ func (m *FreightSources) Size() (n int) {
 	return n
 }
 
+func (m *FreightStalenessPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxAge != nil {
+		l = m.MaxAge.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 1 + sovGenerated(uint64(m.SupersededBy))
+	return n
+}
+
 func (m *FreightStatus) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8843,6 +9857,18 @@ func (m *ProjectConfigSpec) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.PromotionWaves) > 0 {
+		for _, e := range m.PromotionWaves {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.ConcurrencyGroups) > 0 {
+		for _, e := range m.ConcurrencyGroups {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -8934,6 +9960,19 @@ func (m *Promotion) Size() (n int) {
 	return n
 }
 
+func (m *PromotionConcurrency) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Priority))
+	n += 2
+	return n
+}
+
 func (m *PromotionList) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9005,6 +10044,18 @@ func (m *PromotionReference) Size() (n int) {
 	return n
 }
 
+func (m *PromotionResume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Promotion)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Step))
+	return n
+}
+
 func (m *PromotionSpec) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9027,6 +10078,14 @@ func (m *PromotionSpec) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.Concurrency != nil {
+		l = m.Concurrency.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ResumeFrom != nil {
+		l = m.ResumeFrom.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -9075,6 +10134,11 @@ func (m *PromotionStatus) Size() (n int) {
 		l = m.StartedAt.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	n += 1 + sovGenerated(uint64(m.QueuePosition))
+	if m.Workspace != nil {
+		l = m.Workspace.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -9219,16 +10283,123 @@ func (m *PromotionTemplateSpec) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.Concurrency != nil {
+		l = m.Concurrency.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
-func (m *QuayWebhookReceiverConfig) Size() (n int) {
+func (m *PromotionWave) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.SecretRef.Size()
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.StageSelector != nil {
+		l = m.StageSelector.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 1 + sovGenerated(uint64(m.Percent))
+	if m.SoakTime != nil {
+		l = m.SoakTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 1 + sovGenerated(uint64(m.FailureThreshold))
+	return n
+}
+
+func (m *PromotionWavePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.StageSelector.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Waves) > 0 {
+		for _, e := range m.Waves {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PromotionWaveProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Policy)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Freight)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if len(m.Waves) > 0 {
+		for _, e := range m.Waves {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PromotionWaveStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Stages) > 0 {
+		for _, s := range m.Stages {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.VerifiedStages) > 0 {
+		for _, s := range m.VerifiedStages {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.FailedStages) > 0 {
+		for _, s := range m.FailedStages {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PromotionWorkspace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DiskUsage.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
+}
+
+func (m *QuayWebhookReceiverConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SecretRef.Size()
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
@@ -9314,6 +10485,8 @@ func (m *StageSpec) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	l = len(m.Agent)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -9424,6 +10597,12 @@ func (m *Verification) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.Steps) > 0 {
+		for _, e := range m.Steps {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -9453,6 +10632,17 @@ func (m *VerificationInfo) Size() (n int) {
 	}
 	l = len(m.Actor)
 	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.CurrentStep))
+	if len(m.StepExecutionMetadata) > 0 {
+		for _, e := range m.StepExecutionMetadata {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -9527,6 +10717,10 @@ func (m *WarehouseSpec) Size() (n int) {
 		l = m.FreightCreationCriteria.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.FreightStalenessPolicy != nil {
+		l = m.FreightStalenessPolicy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -9634,6 +10828,17 @@ func sovGenerated(x uint64) (n int) {
 func sozGenerated(x uint64) (n int) {
 	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (this *AgentConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AgentConfig{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Projects:` + fmt.Sprintf("%v", this.Projects) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *AnalysisRunArgument) String() string {
 	if this == nil {
 		return "nil"
@@ -9705,6 +10910,7 @@ func (this *ApprovedStage) String() string {
 	}
 	s := strings.Join([]string{`&ApprovedStage{`,
 		`ApprovedAt:` + strings.Replace(fmt.Sprintf("%v", this.ApprovedAt), "Time", "v1.Time", 1) + `,`,
+		`AllowStale:` + fmt.Sprintf("%v", this.AllowStale) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -9762,6 +10968,7 @@ func (this *AutoPromotionOptions) String() string {
 	}
 	s := strings.Join([]string{`&AutoPromotionOptions{`,
 		`SelectionPolicy:` + fmt.Sprintf("%v", this.SelectionPolicy) + `,`,
+		`Condition:` + fmt.Sprintf("%v", this.Condition) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -9861,8 +11068,14 @@ func (this *ClusterConfigSpec) String() string {
 		repeatedStringForWebhookReceivers += strings.Replace(strings.Replace(f.String(), "WebhookReceiverConfig", "WebhookReceiverConfig", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForWebhookReceivers += "}"
+	repeatedStringForAgents := "[]AgentConfig{"
+	for _, f := range this.Agents {
+		repeatedStringForAgents += strings.Replace(strings.Replace(f.String(), "AgentConfig", "AgentConfig", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForAgents += "}"
 	s := strings.Join([]string{`&ClusterConfigSpec{`,
 		`WebhookReceivers:` + repeatedStringForWebhookReceivers + `,`,
+		`Agents:` + repeatedStringForAgents + `,`,
 		`}`,
 	}, "")
 	return s
@@ -9917,6 +11130,17 @@ func (this *ClusterPromotionTaskList) String() string {
 	}, "")
 	return s
 }
+func (this *ConcurrencyGroup) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConcurrencyGroup{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *CurrentStage) String() string {
 	if this == nil {
 		return "nil"
@@ -10135,6 +11359,7 @@ func (this *FreightRequest) String() string {
 	s := strings.Join([]string{`&FreightRequest{`,
 		`Origin:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1), `&`, ``, 1) + `,`,
 		`Sources:` + strings.Replace(strings.Replace(this.Sources.String(), "FreightSources", "FreightSources", 1), `&`, ``, 1) + `,`,
+		`StalenessPolicy:` + strings.Replace(this.StalenessPolicy.String(), "FreightStalenessPolicy", "FreightStalenessPolicy", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10153,6 +11378,17 @@ func (this *FreightSources) String() string {
 	}, "")
 	return s
 }
+func (this *FreightStalenessPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FreightStalenessPolicy{`,
+		`MaxAge:` + strings.Replace(fmt.Sprintf("%v", this.MaxAge), "Duration", "v1.Duration", 1) + `,`,
+		`SupersededBy:` + fmt.Sprintf("%v", this.SupersededBy) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *FreightStatus) String() string {
 	if this == nil {
 		return "nil"
@@ -10448,9 +11684,21 @@ func (this *ProjectConfigSpec) String() string {
 		repeatedStringForWebhookReceivers += strings.Replace(strings.Replace(f.String(), "WebhookReceiverConfig", "WebhookReceiverConfig", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForWebhookReceivers += "}"
+	repeatedStringForPromotionWaves := "[]PromotionWavePolicy{"
+	for _, f := range this.PromotionWaves {
+		repeatedStringForPromotionWaves += strings.Replace(strings.Replace(f.String(), "PromotionWavePolicy", "PromotionWavePolicy", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPromotionWaves += "}"
+	repeatedStringForConcurrencyGroups := "[]ConcurrencyGroup{"
+	for _, f := range this.ConcurrencyGroups {
+		repeatedStringForConcurrencyGroups += strings.Replace(strings.Replace(f.String(), "ConcurrencyGroup", "ConcurrencyGroup", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForConcurrencyGroups += "}"
 	s := strings.Join([]string{`&ProjectConfigSpec{`,
 		`PromotionPolicies:` + repeatedStringForPromotionPolicies + `,`,
 		`WebhookReceivers:` + repeatedStringForWebhookReceivers + `,`,
+		`PromotionWaves:` + repeatedStringForPromotionWaves + `,`,
+		`ConcurrencyGroups:` + repeatedStringForConcurrencyGroups + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10533,6 +11781,18 @@ func (this *Promotion) String() string {
 	}, "")
 	return s
 }
+func (this *PromotionConcurrency) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PromotionConcurrency{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
+		`CancelSuperseded:` + fmt.Sprintf("%v", this.CancelSuperseded) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *PromotionList) String() string {
 	if this == nil {
 		return "nil"
@@ -10585,6 +11845,17 @@ func (this *PromotionReference) String() string {
 	}, "")
 	return s
 }
+func (this *PromotionResume) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PromotionResume{`,
+		`Promotion:` + fmt.Sprintf("%v", this.Promotion) + `,`,
+		`Step:` + fmt.Sprintf("%v", this.Step) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *PromotionSpec) String() string {
 	if this == nil {
 		return "nil"
@@ -10604,6 +11875,8 @@ func (this *PromotionSpec) String() string {
 		`Freight:` + fmt.Sprintf("%v", this.Freight) + `,`,
 		`Steps:` + repeatedStringForSteps + `,`,
 		`Vars:` + repeatedStringForVars + `,`,
+		`Concurrency:` + strings.Replace(this.Concurrency.String(), "PromotionConcurrency", "PromotionConcurrency", 1) + `,`,
+		`ResumeFrom:` + strings.Replace(this.ResumeFrom.String(), "PromotionResume", "PromotionResume", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10634,6 +11907,8 @@ func (this *PromotionStatus) String() string {
 		`State:` + strings.Replace(fmt.Sprintf("%v", this.State), "JSON", "v12.JSON", 1) + `,`,
 		`StepExecutionMetadata:` + repeatedStringForStepExecutionMetadata + `,`,
 		`StartedAt:` + strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1) + `,`,
+		`QueuePosition:` + fmt.Sprintf("%v", this.QueuePosition) + `,`,
+		`Workspace:` + strings.Replace(this.Workspace.String(), "PromotionWorkspace", "PromotionWorkspace", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10757,6 +12032,81 @@ func (this *PromotionTemplateSpec) String() string {
 	s := strings.Join([]string{`&PromotionTemplateSpec{`,
 		`Steps:` + repeatedStringForSteps + `,`,
 		`Vars:` + repeatedStringForVars + `,`,
+		`Concurrency:` + strings.Replace(this.Concurrency.String(), "PromotionConcurrency", "PromotionConcurrency", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionWave) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PromotionWave{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`StageSelector:` + strings.Replace(this.StageSelector.String(), "PromotionPolicySelector", "PromotionPolicySelector", 1) + `,`,
+		`Percent:` + fmt.Sprintf("%v", this.Percent) + `,`,
+		`SoakTime:` + strings.Replace(fmt.Sprintf("%v", this.SoakTime), "Duration", "v1.Duration", 1) + `,`,
+		`FailureThreshold:` + fmt.Sprintf("%v", this.FailureThreshold) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionWavePolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForWaves := "[]PromotionWave{"
+	for _, f := range this.Waves {
+		repeatedStringForWaves += strings.Replace(strings.Replace(f.String(), "PromotionWave", "PromotionWave", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForWaves += "}"
+	s := strings.Join([]string{`&PromotionWavePolicy{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`StageSelector:` + strings.Replace(strings.Replace(this.StageSelector.String(), "PromotionPolicySelector", "PromotionPolicySelector", 1), `&`, ``, 1) + `,`,
+		`Waves:` + repeatedStringForWaves + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionWaveProgress) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForWaves := "[]PromotionWaveStatus{"
+	for _, f := range this.Waves {
+		repeatedStringForWaves += strings.Replace(strings.Replace(f.String(), "PromotionWaveStatus", "PromotionWaveStatus", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForWaves += "}"
+	s := strings.Join([]string{`&PromotionWaveProgress{`,
+		`Policy:` + fmt.Sprintf("%v", this.Policy) + `,`,
+		`Freight:` + fmt.Sprintf("%v", this.Freight) + `,`,
+		`Halted:` + fmt.Sprintf("%v", this.Halted) + `,`,
+		`Waves:` + repeatedStringForWaves + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionWaveStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PromotionWaveStatus{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Stages:` + fmt.Sprintf("%v", this.Stages) + `,`,
+		`VerifiedStages:` + fmt.Sprintf("%v", this.VerifiedStages) + `,`,
+		`FailedStages:` + fmt.Sprintf("%v", this.FailedStages) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionWorkspace) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PromotionWorkspace{`,
+		`DiskUsage:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.DiskUsage), "Quantity", "resource.Quantity", 1), `&`, ``, 1) + `,`,
+		`Retained:` + fmt.Sprintf("%v", this.Retained) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10831,6 +12181,7 @@ func (this *StageSpec) String() string {
 		`RequestedFreight:` + repeatedStringForRequestedFreight + `,`,
 		`PromotionTemplate:` + strings.Replace(this.PromotionTemplate.String(), "PromotionTemplate", "PromotionTemplate", 1) + `,`,
 		`Vars:` + repeatedStringForVars + `,`,
+		`Agent:` + fmt.Sprintf("%v", this.Agent) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10915,10 +12266,16 @@ func (this *Verification) String() string {
 		repeatedStringForArgs += strings.Replace(strings.Replace(f.String(), "AnalysisRunArgument", "AnalysisRunArgument", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForArgs += "}"
+	repeatedStringForSteps := "[]PromotionStep{"
+	for _, f := range this.Steps {
+		repeatedStringForSteps += strings.Replace(strings.Replace(f.String(), "PromotionStep", "PromotionStep", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSteps += "}"
 	s := strings.Join([]string{`&Verification{`,
 		`AnalysisTemplates:` + repeatedStringForAnalysisTemplates + `,`,
 		`AnalysisRunMetadata:` + strings.Replace(this.AnalysisRunMetadata.String(), "AnalysisRunMetadata", "AnalysisRunMetadata", 1) + `,`,
 		`Args:` + repeatedStringForArgs + `,`,
+		`Steps:` + repeatedStringForSteps + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10927,6 +12284,11 @@ func (this *VerificationInfo) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForStepExecutionMetadata := "[]StepExecutionMetadata{"
+	for _, f := range this.StepExecutionMetadata {
+		repeatedStringForStepExecutionMetadata += strings.Replace(strings.Replace(f.String(), "StepExecutionMetadata", "StepExecutionMetadata", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForStepExecutionMetadata += "}"
 	s := strings.Join([]string{`&VerificationInfo{`,
 		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
 		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
@@ -10935,6 +12297,9 @@ func (this *VerificationInfo) String() string {
 		`StartTime:` + strings.Replace(fmt.Sprintf("%v", this.StartTime), "Time", "v1.Time", 1) + `,`,
 		`FinishTime:` + strings.Replace(fmt.Sprintf("%v", this.FinishTime), "Time", "v1.Time", 1) + `,`,
 		`Actor:` + fmt.Sprintf("%v", this.Actor) + `,`,
+		`CurrentStep:` + fmt.Sprintf("%v", this.CurrentStep) + `,`,
+		`StepExecutionMetadata:` + repeatedStringForStepExecutionMetadata + `,`,
+		`State:` + strings.Replace(fmt.Sprintf("%v", this.State), "JSON", "v12.JSON", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10993,6 +12358,7 @@ func (this *WarehouseSpec) String() string {
 		`FreightCreationPolicy:` + fmt.Sprintf("%v", this.FreightCreationPolicy) + `,`,
 		`Interval:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Interval), "Duration", "v1.Duration", 1), `&`, ``, 1) + `,`,
 		`FreightCreationCriteria:` + strings.Replace(this.FreightCreationCriteria.String(), "FreightCreationCriteria", "FreightCreationCriteria", 1) + `,`,
+		`FreightStalenessPolicy:` + strings.Replace(this.FreightStalenessPolicy.String(), "FreightStalenessPolicy", "FreightStalenessPolicy", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11050,23 +12416,1476 @@ func (this *WebhookReceiverDetails) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&WebhookReceiverDetails{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
-		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringGenerated(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	s := strings.Join([]string{`&WebhookReceiverDetails{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringGenerated(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *AgentConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AgentConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AgentConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Projects", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Projects = append(m.Projects, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnalysisRunArgument) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalysisRunArgument: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalysisRunArgument: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnalysisRunMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalysisRunMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalysisRunMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnalysisRunReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalysisRunReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalysisRunReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnalysisTemplateReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalysisTemplateReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalysisTemplateReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApprovedStage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApprovedStage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApprovedStage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ApprovedAt == nil {
+				m.ApprovedAt = &v1.Time{}
+			}
+			if err := m.ApprovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowStale", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowStale = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArgoCDAppHealthStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArgoCDAppHealthStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArgoCDAppHealthStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = ArgoCDAppHealthState(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArgoCDAppStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArgoCDAppStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArgoCDAppStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HealthStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SyncStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArgoCDAppSyncStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArgoCDAppSyncStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArgoCDAppSyncStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = ArgoCDAppSyncState(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArtifactoryWebhookReceiverConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArtifactoryWebhookReceiverConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArtifactoryWebhookReceiverConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VirtualRepoName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VirtualRepoName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *AnalysisRunArgument) Unmarshal(dAtA []byte) error {
+func (m *AutoPromotionOptions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11089,15 +13908,15 @@ func (m *AnalysisRunArgument) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AnalysisRunArgument: wiretype end group for non-group")
+			return fmt.Errorf("proto: AutoPromotionOptions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AnalysisRunArgument: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AutoPromotionOptions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SelectionPolicy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11125,11 +13944,11 @@ func (m *AnalysisRunArgument) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.SelectionPolicy = AutoPromotionSelectionPolicy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Condition", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11157,7 +13976,7 @@ func (m *AnalysisRunArgument) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Condition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11180,7 +13999,7 @@ func (m *AnalysisRunArgument) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AnalysisRunMetadata) Unmarshal(dAtA []byte) error {
+func (m *AzureWebhookReceiverConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11203,17 +14022,215 @@ func (m *AnalysisRunMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AnalysisRunMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: AzureWebhookReceiverConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AnalysisRunMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AzureWebhookReceiverConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BitbucketWebhookReceiverConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BitbucketWebhookReceiverConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BitbucketWebhookReceiverConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Chart) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Chart: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Chart: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11223,124 +14240,29 @@ func (m *AnalysisRunMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Labels[mapkey] = mapvalue
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11350,118 +14272,23 @@ func (m *AnalysisRunMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Annotations[mapkey] = mapvalue
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11484,7 +14311,7 @@ func (m *AnalysisRunMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AnalysisRunReference) Unmarshal(dAtA []byte) error {
+func (m *ChartDiscoveryResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11507,15 +14334,47 @@ func (m *AnalysisRunReference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AnalysisRunReference: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChartDiscoveryResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AnalysisRunReference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChartDiscoveryResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11543,11 +14402,11 @@ func (m *AnalysisRunReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SemverConstraint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11575,11 +14434,11 @@ func (m *AnalysisRunReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.SemverConstraint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11607,7 +14466,7 @@ func (m *AnalysisRunReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = string(dAtA[iNdEx:postIndex])
+			m.Versions = append(m.Versions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11630,7 +14489,7 @@ func (m *AnalysisRunReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AnalysisTemplateReference) Unmarshal(dAtA []byte) error {
+func (m *ChartSubscription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11653,13 +14512,45 @@ func (m *AnalysisTemplateReference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AnalysisTemplateReference: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChartSubscription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AnalysisTemplateReference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChartSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
@@ -11691,9 +14582,9 @@ func (m *AnalysisTemplateReference) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SemverConstraint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11721,8 +14612,27 @@ func (m *AnalysisTemplateReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.SemverConstraint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscoveryLimit", wireType)
+			}
+			m.DiscoveryLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiscoveryLimit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -11744,7 +14654,7 @@ func (m *AnalysisTemplateReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApprovedStage) Unmarshal(dAtA []byte) error {
+func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11767,15 +14677,15 @@ func (m *ApprovedStage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApprovedStage: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApprovedStage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11802,68 +14712,15 @@ func (m *ApprovedStage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ApprovedAt == nil {
-				m.ApprovedAt = &v1.Time{}
-			}
-			if err := m.ApprovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ArgoCDAppHealthStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ArgoCDAppHealthStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArgoCDAppHealthStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11873,29 +14730,30 @@ func (m *ArgoCDAppHealthStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = ArgoCDAppHealthState(dAtA[iNdEx:postIndex])
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11905,23 +14763,24 @@ func (m *ArgoCDAppHealthStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11944,7 +14803,7 @@ func (m *ArgoCDAppHealthStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArgoCDAppStatus) Unmarshal(dAtA []byte) error {
+func (m *ClusterConfigList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11967,17 +14826,17 @@ func (m *ArgoCDAppStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArgoCDAppStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterConfigList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArgoCDAppStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterConfigList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11987,29 +14846,30 @@ func (m *ArgoCDAppStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12019,27 +14879,79 @@ func (m *ArgoCDAppStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.Items = append(m.Items, ClusterConfig{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterConfigSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterConfigSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterConfigSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HealthStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WebhookReceivers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12066,13 +14978,14 @@ func (m *ArgoCDAppStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HealthStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WebhookReceivers = append(m.WebhookReceivers, WebhookReceiverConfig{})
+			if err := m.WebhookReceivers[len(m.WebhookReceivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Agents", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12099,7 +15012,8 @@ func (m *ArgoCDAppStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SyncStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Agents = append(m.Agents, AgentConfig{})
+			if err := m.Agents[len(m.Agents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12124,7 +15038,7 @@ func (m *ArgoCDAppStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArgoCDAppSyncStatus) Unmarshal(dAtA []byte) error {
+func (m *ClusterConfigStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12147,17 +15061,17 @@ func (m *ArgoCDAppSyncStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArgoCDAppSyncStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterConfigStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArgoCDAppSyncStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterConfigStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12167,29 +15081,31 @@ func (m *ArgoCDAppSyncStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = ArgoCDAppSyncState(dAtA[iNdEx:postIndex])
+			m.Conditions = append(m.Conditions, v1.Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WebhookReceivers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12199,27 +15115,48 @@ func (m *ArgoCDAppSyncStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			m.WebhookReceivers = append(m.WebhookReceivers, WebhookReceiverDetails{})
+			if err := m.WebhookReceivers[len(m.WebhookReceivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			}
+			m.ObservedGeneration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedGeneration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHandledRefresh", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12247,7 +15184,7 @@ func (m *ArgoCDAppSyncStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			m.LastHandledRefresh = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12270,7 +15207,7 @@ func (m *ArgoCDAppSyncStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArtifactoryWebhookReceiverConfig) Unmarshal(dAtA []byte) error {
+func (m *ClusterPromotionTask) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12293,15 +15230,15 @@ func (m *ArtifactoryWebhookReceiverConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArtifactoryWebhookReceiverConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterPromotionTask: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArtifactoryWebhookReceiverConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterPromotionTask: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12328,15 +15265,15 @@ func (m *ArtifactoryWebhookReceiverConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VirtualRepoName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12346,23 +15283,24 @@ func (m *ArtifactoryWebhookReceiverConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VirtualRepoName = string(dAtA[iNdEx:postIndex])
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12385,7 +15323,7 @@ func (m *ArtifactoryWebhookReceiverConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AutoPromotionOptions) Unmarshal(dAtA []byte) error {
+func (m *ClusterPromotionTaskList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12408,17 +15346,17 @@ func (m *AutoPromotionOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AutoPromotionOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterPromotionTaskList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AutoPromotionOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterPromotionTaskList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SelectionPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12428,23 +15366,58 @@ func (m *AutoPromotionOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SelectionPolicy = AutoPromotionSelectionPolicy(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, ClusterPromotionTask{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12467,7 +15440,7 @@ func (m *AutoPromotionOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AzureWebhookReceiverConfig) Unmarshal(dAtA []byte) error {
+func (m *ConcurrencyGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12490,17 +15463,17 @@ func (m *AzureWebhookReceiverConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AzureWebhookReceiverConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConcurrencyGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AzureWebhookReceiverConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConcurrencyGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12510,25 +15483,43 @@ func (m *AzureWebhookReceiverConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -12550,7 +15541,7 @@ func (m *AzureWebhookReceiverConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BitbucketWebhookReceiverConfig) Unmarshal(dAtA []byte) error {
+func (m *CurrentStage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12573,15 +15564,15 @@ func (m *BitbucketWebhookReceiverConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BitbucketWebhookReceiverConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: CurrentStage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BitbucketWebhookReceiverConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CurrentStage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12608,7 +15599,10 @@ func (m *BitbucketWebhookReceiverConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Since == nil {
+				m.Since = &v1.Time{}
+			}
+			if err := m.Since.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12633,7 +15627,7 @@ func (m *BitbucketWebhookReceiverConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Chart) Unmarshal(dAtA []byte) error {
+func (m *DiscoveredArtifacts) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12652,21 +15646,55 @@ func (m *Chart) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Chart: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Chart: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DiscoveredArtifacts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DiscoveredArtifacts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Git = append(m.Git, GitDiscoveryResult{})
+			if err := m.Git[len(m.Git)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12676,29 +15704,31 @@ func (m *Chart) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			m.Images = append(m.Images, ImageDiscoveryResult{})
+			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Charts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12708,29 +15738,31 @@ func (m *Chart) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Charts = append(m.Charts, ChartDiscoveryResult{})
+			if err := m.Charts[len(m.Charts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscoveredAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12740,23 +15772,24 @@ func (m *Chart) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			if err := m.DiscoveredAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12779,7 +15812,7 @@ func (m *Chart) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChartDiscoveryResult) Unmarshal(dAtA []byte) error {
+func (m *DiscoveredCommit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12802,15 +15835,15 @@ func (m *ChartDiscoveryResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChartDiscoveryResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: DiscoveredCommit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChartDiscoveryResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DiscoveredCommit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12838,11 +15871,11 @@ func (m *ChartDiscoveryResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12870,11 +15903,11 @@ func (m *ChartDiscoveryResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Branch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SemverConstraint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12902,11 +15935,11 @@ func (m *ChartDiscoveryResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SemverConstraint = string(dAtA[iNdEx:postIndex])
+			m.Tag = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12934,61 +15967,11 @@ func (m *ChartDiscoveryResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Versions = append(m.Versions, string(dAtA[iNdEx:postIndex]))
+			m.Subject = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ChartSubscription) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ChartSubscription: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChartSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13016,11 +15999,11 @@ func (m *ChartSubscription) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			m.Author = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Committer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13048,13 +16031,13 @@ func (m *ChartSubscription) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Committer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SemverConstraint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorDate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13064,43 +16047,28 @@ func (m *ChartSubscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SemverConstraint = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DiscoveryLimit", wireType)
+			if m.CreatorDate == nil {
+				m.CreatorDate = &v1.Time{}
 			}
-			m.DiscoveryLimit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DiscoveryLimit |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.CreatorDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -13122,7 +16090,7 @@ func (m *ChartSubscription) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
+func (m *DiscoveredImageReference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13145,17 +16113,17 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: DiscoveredImageReference: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DiscoveredImageReference: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13165,63 +16133,29 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13231,78 +16165,27 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Digest = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ClusterConfigList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterConfigList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterConfigList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13329,13 +16212,16 @@ func (m *ClusterConfigList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CreatedAt == nil {
+				m.CreatedAt = &v1.Time{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13362,10 +16248,103 @@ func (m *ClusterConfigList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, ClusterConfig{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13388,7 +16367,7 @@ func (m *ClusterConfigList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterConfigSpec) Unmarshal(dAtA []byte) error {
+func (m *DockerHubWebhookReceiverConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13411,15 +16390,15 @@ func (m *ClusterConfigSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterConfigSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: DockerHubWebhookReceiverConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterConfigSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DockerHubWebhookReceiverConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WebhookReceivers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13446,8 +16425,7 @@ func (m *ClusterConfigSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WebhookReceivers = append(m.WebhookReceivers, WebhookReceiverConfig{})
-			if err := m.WebhookReceivers[len(m.WebhookReceivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13472,7 +16450,7 @@ func (m *ClusterConfigSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterConfigStatus) Unmarshal(dAtA []byte) error {
+func (m *ExpressionVariable) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13495,85 +16473,17 @@ func (m *ClusterConfigStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterConfigStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExpressionVariable: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterConfigStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Conditions = append(m.Conditions, v1.Condition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WebhookReceivers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.WebhookReceivers = append(m.WebhookReceivers, WebhookReceiverDetails{})
-			if err := m.WebhookReceivers[len(m.WebhookReceivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			return fmt.Errorf("proto: ExpressionVariable: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.ObservedGeneration = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13583,14 +16493,27 @@ func (m *ClusterConfigStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ObservedGeneration |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastHandledRefresh", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13618,7 +16541,7 @@ func (m *ClusterConfigStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastHandledRefresh = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13641,7 +16564,7 @@ func (m *ClusterConfigStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterPromotionTask) Unmarshal(dAtA []byte) error {
+func (m *Freight) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13664,10 +16587,10 @@ func (m *ClusterPromotionTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterPromotionTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: Freight: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterPromotionTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Freight: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13703,9 +16626,9 @@ func (m *ClusterPromotionTask) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13732,63 +16655,48 @@ func (m *ClusterPromotionTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Commits = append(m.Commits, GitCommit{})
+			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ClusterPromotionTaskList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Images = append(m.Images, Image{})
+			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterPromotionTaskList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterPromotionTaskList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Charts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13815,13 +16723,14 @@ func (m *ClusterPromotionTaskList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Charts = append(m.Charts, Chart{})
+			if err := m.Charts[len(m.Charts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13848,64 +16757,45 @@ func (m *ClusterPromotionTaskList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, ClusterPromotionTask{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CurrentStage) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CurrentStage: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CurrentStage: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Alias = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13932,10 +16822,7 @@ func (m *CurrentStage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Since == nil {
-				m.Since = &v1.Time{}
-			}
-			if err := m.Since.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13960,7 +16847,7 @@ func (m *CurrentStage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DiscoveredArtifacts) Unmarshal(dAtA []byte) error {
+func (m *FreightCollection) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13971,61 +16858,27 @@ func (m *DiscoveredArtifacts) Unmarshal(dAtA []byte) error {
 				return ErrIntOverflowGenerated
 			}
 			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DiscoveredArtifacts: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DiscoveredArtifacts: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Git = append(m.Git, GitDiscoveryResult{})
-			if err := m.Git[len(m.Git)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FreightCollection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FreightCollection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Freight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14052,14 +16905,109 @@ func (m *DiscoveredArtifacts) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, ImageDiscoveryResult{})
-			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Freight == nil {
+				m.Freight = make(map[string]FreightReference)
+			}
+			var mapkey string
+			mapvalue := &FreightReference{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &FreightReference{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Freight[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Charts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationHistory", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14086,16 +17034,16 @@ func (m *DiscoveredArtifacts) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Charts = append(m.Charts, ChartDiscoveryResult{})
-			if err := m.Charts[len(m.Charts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.VerificationHistory = append(m.VerificationHistory, VerificationInfo{})
+			if err := m.VerificationHistory[len(m.VerificationHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DiscoveredAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14105,24 +17053,23 @@ func (m *DiscoveredArtifacts) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DiscoveredAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14145,7 +17092,7 @@ func (m *DiscoveredArtifacts) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DiscoveredCommit) Unmarshal(dAtA []byte) error {
+func (m *FreightCreationCriteria) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14168,15 +17115,15 @@ func (m *DiscoveredCommit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DiscoveredCommit: wiretype end group for non-group")
+			return fmt.Errorf("proto: FreightCreationCriteria: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DiscoveredCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FreightCreationCriteria: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14204,77 +17151,63 @@ func (m *DiscoveredCommit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Expression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FreightList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tag = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FreightList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FreightList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14284,29 +17217,30 @@ func (m *DiscoveredCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subject = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14316,27 +17250,79 @@ func (m *DiscoveredCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Author = string(dAtA[iNdEx:postIndex])
+			m.Items = append(m.Items, Freight{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FreightOrigin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FreightOrigin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FreightOrigin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Committer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14364,13 +17350,13 @@ func (m *DiscoveredCommit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Committer = string(dAtA[iNdEx:postIndex])
+			m.Kind = FreightOriginKind(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatorDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14380,27 +17366,23 @@ func (m *DiscoveredCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatorDate == nil {
-				m.CreatorDate = &v1.Time{}
-			}
-			if err := m.CreatorDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14423,7 +17405,7 @@ func (m *DiscoveredCommit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DiscoveredImageReference) Unmarshal(dAtA []byte) error {
+func (m *FreightReference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14446,15 +17428,15 @@ func (m *DiscoveredImageReference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DiscoveredImageReference: wiretype end group for non-group")
+			return fmt.Errorf("proto: FreightReference: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DiscoveredImageReference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FreightReference: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14482,13 +17464,13 @@ func (m *DiscoveredImageReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tag = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14498,27 +17480,29 @@ func (m *DiscoveredImageReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Digest = string(dAtA[iNdEx:postIndex])
+			m.Commits = append(m.Commits, GitCommit{})
+			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14545,16 +17529,14 @@ func (m *DiscoveredImageReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &v1.Time{}
-			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Images = append(m.Images, Image{})
+			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Charts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14581,103 +17563,43 @@ func (m *DiscoveredImageReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			m.Charts = append(m.Charts, Chart{})
+			if err := m.Charts[len(m.Charts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Annotations[mapkey] = mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14700,7 +17622,7 @@ func (m *DiscoveredImageReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DockerHubWebhookReceiverConfig) Unmarshal(dAtA []byte) error {
+func (m *FreightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14723,15 +17645,15 @@ func (m *DockerHubWebhookReceiverConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DockerHubWebhookReceiverConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: FreightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DockerHubWebhookReceiverConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FreightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14758,7 +17680,76 @@ func (m *DockerHubWebhookReceiverConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Sources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StalenessPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StalenessPolicy == nil {
+				m.StalenessPolicy = &FreightStalenessPolicy{}
+			}
+			if err := m.StalenessPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14783,7 +17774,7 @@ func (m *DockerHubWebhookReceiverConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExpressionVariable) Unmarshal(dAtA []byte) error {
+func (m *FreightSources) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14806,15 +17797,35 @@ func (m *ExpressionVariable) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExpressionVariable: wiretype end group for non-group")
+			return fmt.Errorf("proto: FreightSources: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExpressionVariable: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FreightSources: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direct", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Direct = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stages", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14842,11 +17853,47 @@ func (m *ExpressionVariable) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Stages = append(m.Stages, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredSoakTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RequiredSoakTime == nil {
+				m.RequiredSoakTime = &v1.Duration{}
+			}
+			if err := m.RequiredSoakTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailabilityStrategy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14874,7 +17921,43 @@ func (m *ExpressionVariable) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.AvailabilityStrategy = FreightAvailabilityStrategy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoPromotionOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AutoPromotionOptions == nil {
+				m.AutoPromotionOptions = &AutoPromotionOptions{}
+			}
+			if err := m.AutoPromotionOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14897,7 +17980,7 @@ func (m *ExpressionVariable) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Freight) Unmarshal(dAtA []byte) error {
+func (m *FreightStalenessPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14920,15 +18003,15 @@ func (m *Freight) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Freight: wiretype end group for non-group")
+			return fmt.Errorf("proto: FreightStalenessPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Freight: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FreightStalenessPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14955,49 +18038,18 @@ func (m *Freight) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.MaxAge == nil {
+				m.MaxAge = &v1.Duration{}
 			}
-			m.Commits = append(m.Commits, GitCommit{})
-			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxAge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupersededBy", wireType)
 			}
-			var msglen int
+			m.SupersededBy = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15007,29 +18059,64 @@ func (m *Freight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SupersededBy |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FreightStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, Image{})
-			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FreightStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FreightStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Charts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedIn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15056,14 +18143,109 @@ func (m *Freight) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Charts = append(m.Charts, Chart{})
-			if err := m.Charts[len(m.Charts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.VerifiedIn == nil {
+				m.VerifiedIn = make(map[string]VerifiedStage)
+			}
+			var mapkey string
+			mapvalue := &VerifiedStage{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &VerifiedStage{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.VerifiedIn[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedFor", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15090,45 +18272,109 @@ func (m *Freight) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
+			if m.ApprovedFor == nil {
+				m.ApprovedFor = make(map[string]ApprovedStage)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			mapvalue := &ApprovedStage{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &ApprovedStage{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Alias = string(dAtA[iNdEx:postIndex])
+			m.ApprovedFor[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentlyIn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15155,63 +18401,109 @@ func (m *Freight) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FreightCollection) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.CurrentlyIn == nil {
+				m.CurrentlyIn = make(map[string]CurrentStage)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var mapkey string
+			mapvalue := &CurrentStage{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &CurrentStage{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FreightCollection: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FreightCollection: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.CurrentlyIn[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Freight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15238,11 +18530,11 @@ func (m *FreightCollection) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Freight == nil {
-				m.Freight = make(map[string]FreightReference)
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]v12.JSON)
 			}
 			var mapkey string
-			mapvalue := &FreightReference{}
+			mapvalue := &v12.JSON{}
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -15316,7 +18608,7 @@ func (m *FreightCollection) Unmarshal(dAtA []byte) error {
 					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &FreightReference{}
+					mapvalue = &v12.JSON{}
 					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
 						return err
 					}
@@ -15336,13 +18628,95 @@ func (m *FreightCollection) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Freight[mapkey] = *mapvalue
+			m.Metadata[mapkey] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GitCommit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GitCommit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GitCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerificationHistory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15352,29 +18726,27 @@ func (m *FreightCollection) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VerificationHistory = append(m.VerificationHistory, VerificationInfo{})
-			if err := m.VerificationHistory[len(m.VerificationHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15402,61 +18774,107 @@ func (m *FreightCollection) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Branch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FreightCreationCriteria) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			if iNdEx >= l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FreightCreationCriteria: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FreightCreationCriteria: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Author = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Committer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15484,7 +18902,7 @@ func (m *FreightCreationCriteria) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Expression = string(dAtA[iNdEx:postIndex])
+			m.Committer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15507,7 +18925,7 @@ func (m *FreightCreationCriteria) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FreightList) Unmarshal(dAtA []byte) error {
+func (m *GitDiscoveryResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15530,17 +18948,17 @@ func (m *FreightList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FreightList: wiretype end group for non-group")
+			return fmt.Errorf("proto: GitDiscoveryResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FreightList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GitDiscoveryResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15550,28 +18968,27 @@ func (m *FreightList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15598,8 +19015,8 @@ func (m *FreightList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Freight{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Commits = append(m.Commits, DiscoveredCommit{})
+			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15624,7 +19041,7 @@ func (m *FreightList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FreightOrigin) Unmarshal(dAtA []byte) error {
+func (m *GitHubWebhookReceiverConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15647,17 +19064,17 @@ func (m *FreightOrigin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FreightOrigin: wiretype end group for non-group")
+			return fmt.Errorf("proto: GitHubWebhookReceiverConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FreightOrigin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GitHubWebhookReceiverConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretRef", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15667,29 +19084,80 @@ func (m *FreightOrigin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = FreightOriginKind(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			if err := m.SecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GitLabWebhookReceiverConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GitLabWebhookReceiverConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GitLabWebhookReceiverConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretRef", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15699,23 +19167,24 @@ func (m *FreightOrigin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.SecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15738,7 +19207,7 @@ func (m *FreightOrigin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FreightReference) Unmarshal(dAtA []byte) error {
+func (m *GitSubscription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15761,15 +19230,15 @@ func (m *FreightReference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FreightReference: wiretype end group for non-group")
+			return fmt.Errorf("proto: GitSubscription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FreightReference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GitSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15797,13 +19266,13 @@ func (m *FreightReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitSelectionStrategy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15813,31 +19282,29 @@ func (m *FreightReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commits = append(m.Commits, GitCommit{})
-			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CommitSelectionStrategy = CommitSelectionStrategy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15847,31 +19314,29 @@ func (m *FreightReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, Image{})
-			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Charts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SemverConstraint", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15881,31 +19346,29 @@ func (m *FreightReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Charts = append(m.Charts, Chart{})
-			if err := m.Charts[len(m.Charts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SemverConstraint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowTags", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15915,80 +19378,29 @@ func (m *FreightReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AllowTags = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FreightRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FreightRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FreightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreTags", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15998,30 +19410,29 @@ func (m *FreightRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.IgnoreTags = append(m.IgnoreTags, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipTLSVerify", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16031,80 +19442,17 @@ func (m *FreightRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Sources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FreightSources) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FreightSources: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FreightSources: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Direct", wireType)
-			}
-			var v int
+			m.InsecureSkipTLSVerify = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludePaths", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16114,15 +19462,27 @@ func (m *FreightSources) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Direct = bool(v != 0)
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncludePaths = append(m.IncludePaths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludePaths", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16150,13 +19510,52 @@ func (m *FreightSources) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stages = append(m.Stages, string(dAtA[iNdEx:postIndex]))
+			m.ExcludePaths = append(m.ExcludePaths, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscoveryLimit", wireType)
+			}
+			m.DiscoveryLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiscoveryLimit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrictSemvers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StrictSemvers = bool(v != 0)
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiredSoakTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpressionFilter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16166,31 +19565,27 @@ func (m *FreightSources) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RequiredSoakTime == nil {
-				m.RequiredSoakTime = &v1.Duration{}
-			}
-			if err := m.RequiredSoakTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ExpressionFilter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AvailabilityStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowTagsRegexes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16218,13 +19613,13 @@ func (m *FreightSources) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AvailabilityStrategy = FreightAvailabilityStrategy(dAtA[iNdEx:postIndex])
+			m.AllowTagsRegexes = append(m.AllowTagsRegexes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoPromotionOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreTagsRegexes", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16234,27 +19629,23 @@ func (m *FreightSources) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AutoPromotionOptions == nil {
-				m.AutoPromotionOptions = &AutoPromotionOptions{}
-			}
-			if err := m.AutoPromotionOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.IgnoreTagsRegexes = append(m.IgnoreTagsRegexes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16277,7 +19668,7 @@ func (m *FreightSources) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FreightStatus) Unmarshal(dAtA []byte) error {
+func (m *GiteaWebhookReceiverConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16296,148 +19687,19 @@ func (m *FreightStatus) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FreightStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FreightStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedIn", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.VerifiedIn == nil {
-				m.VerifiedIn = make(map[string]VerifiedStage)
-			}
-			var mapkey string
-			mapvalue := &VerifiedStage{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &VerifiedStage{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.VerifiedIn[mapkey] = *mapvalue
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GiteaWebhookReceiverConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GiteaWebhookReceiverConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedFor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16464,238 +19726,63 @@ func (m *FreightStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ApprovedFor == nil {
-				m.ApprovedFor = make(map[string]ApprovedStage)
-			}
-			var mapkey string
-			mapvalue := &ApprovedStage{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &ApprovedStage{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.SecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ApprovedFor[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentlyIn", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CurrentlyIn == nil {
-				m.CurrentlyIn = make(map[string]CurrentStage)
-			}
-			var mapkey string
-			mapvalue := &CurrentStage{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &CurrentStage{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HarborWebhookReceiverConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			m.CurrentlyIn[mapkey] = *mapvalue
-			iNdEx = postIndex
-		case 4:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HarborWebhookReceiverConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HarborWebhookReceiverConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16722,105 +19809,9 @@ func (m *FreightStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = make(map[string]v12.JSON)
-			}
-			var mapkey string
-			mapvalue := &v12.JSON{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &v12.JSON{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.SecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Metadata[mapkey] = *mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16843,7 +19834,7 @@ func (m *FreightStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitCommit) Unmarshal(dAtA []byte) error {
+func (m *Health) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16866,15 +19857,15 @@ func (m *GitCommit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitCommit: wiretype end group for non-group")
+			return fmt.Errorf("proto: Health: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Health: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16902,11 +19893,11 @@ func (m *GitCommit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			m.Status = HealthState(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issues", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16934,13 +19925,13 @@ func (m *GitCommit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Issues = append(m.Issues, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16950,29 +19941,33 @@ func (m *GitCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			if m.Config == nil {
+				m.Config = &v12.JSON{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16982,59 +19977,81 @@ func (m *GitCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tag = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			if m.Output == nil {
+				m.Output = &v12.JSON{}
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HealthCheckStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HealthCheckStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HealthCheckStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17062,13 +20079,13 @@ func (m *GitCommit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Author = string(dAtA[iNdEx:postIndex])
+			m.Uses = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Committer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17078,23 +20095,27 @@ func (m *GitCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Committer = string(dAtA[iNdEx:postIndex])
+			if m.Config == nil {
+				m.Config = &v12.JSON{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17117,7 +20138,7 @@ func (m *GitCommit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitDiscoveryResult) Unmarshal(dAtA []byte) error {
+func (m *HealthStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17140,10 +20161,79 @@ func (m *GitDiscoveryResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitDiscoveryResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: HealthStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitDiscoveryResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HealthStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Healthy", wireType)
+			}
+			m.Healthy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Healthy |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Image) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Image: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Image: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -17178,9 +20268,73 @@ func (m *GitDiscoveryResult) Unmarshal(dAtA []byte) error {
 			}
 			m.RepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Digest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17197,20 +20351,113 @@ func (m *GitDiscoveryResult) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Commits = append(m.Commits, DiscoveredCommit{})
-			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17233,7 +20480,7 @@ func (m *GitDiscoveryResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitHubWebhookReceiverConfig) Unmarshal(dAtA []byte) error {
+func (m *ImageDiscoveryResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17256,17 +20503,17 @@ func (m *GitHubWebhookReceiverConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitHubWebhookReceiverConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImageDiscoveryResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitHubWebhookReceiverConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImageDiscoveryResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17276,78 +20523,59 @@ func (m *GitHubWebhookReceiverConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GitLabWebhookReceiverConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GitLabWebhookReceiverConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitLabWebhookReceiverConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Platform = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field References", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17374,7 +20602,8 @@ func (m *GitLabWebhookReceiverConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.References = append(m.References, DiscoveredImageReference{})
+			if err := m.References[len(m.References)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17399,7 +20628,7 @@ func (m *GitLabWebhookReceiverConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitSubscription) Unmarshal(dAtA []byte) error {
+func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17422,10 +20651,10 @@ func (m *GitSubscription) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitSubscription: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImageSubscription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImageSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -17460,73 +20689,9 @@ func (m *GitSubscription) Unmarshal(dAtA []byte) error {
 			}
 			m.RepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitSelectionStrategy", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CommitSelectionStrategy = CommitSelectionStrategy(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SemverConstraint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageSelectionStrategy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17554,7 +20719,7 @@ func (m *GitSubscription) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SemverConstraint = string(dAtA[iNdEx:postIndex])
+			m.ImageSelectionStrategy = ImageSelectionStrategy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
@@ -17621,60 +20786,8 @@ func (m *GitSubscription) Unmarshal(dAtA []byte) error {
 			m.IgnoreTags = append(m.IgnoreTags, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipTLSVerify", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.InsecureSkipTLSVerify = bool(v != 0)
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludePaths", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.IncludePaths = append(m.IncludePaths, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExcludePaths", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17702,9 +20815,29 @@ func (m *GitSubscription) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExcludePaths = append(m.ExcludePaths, string(dAtA[iNdEx:postIndex]))
+			m.Platform = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipTLSVerify", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InsecureSkipTLSVerify = bool(v != 0)
+		case 9:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DiscoveryLimit", wireType)
 			}
@@ -17723,7 +20856,7 @@ func (m *GitSubscription) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 11:
+		case 10:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field StrictSemvers", wireType)
 			}
@@ -17743,9 +20876,9 @@ func (m *GitSubscription) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.StrictSemvers = bool(v != 0)
-		case 12:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpressionFilter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Constraint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17773,7 +20906,7 @@ func (m *GitSubscription) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExpressionFilter = string(dAtA[iNdEx:postIndex])
+			m.Constraint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 13:
 			if wireType != 2 {
@@ -17860,7 +20993,7 @@ func (m *GitSubscription) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GiteaWebhookReceiverConfig) Unmarshal(dAtA []byte) error {
+func (m *Project) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17883,15 +21016,15 @@ func (m *GiteaWebhookReceiverConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GiteaWebhookReceiverConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: Project: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GiteaWebhookReceiverConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Project: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17918,63 +21051,13 @@ func (m *GiteaWebhookReceiverConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HarborWebhookReceiverConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HarborWebhookReceiverConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HarborWebhookReceiverConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18001,7 +21084,7 @@ func (m *HarborWebhookReceiverConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -18026,7 +21109,7 @@ func (m *HarborWebhookReceiverConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Health) Unmarshal(dAtA []byte) error {
+func (m *ProjectConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18049,17 +21132,17 @@ func (m *Health) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Health: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Health: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18069,59 +21152,28 @@ func (m *Health) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = HealthState(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issues", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Issues = append(m.Issues, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18148,16 +21200,13 @@ func (m *Health) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Config == nil {
-				m.Config = &v12.JSON{}
-			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18184,10 +21233,7 @@ func (m *Health) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Output == nil {
-				m.Output = &v12.JSON{}
-			}
-			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -18212,7 +21258,7 @@ func (m *Health) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HealthCheckStep) Unmarshal(dAtA []byte) error {
+func (m *ProjectConfigList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18235,47 +21281,15 @@ func (m *HealthCheckStep) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HealthCheckStep: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectConfigList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HealthCheckStep: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectConfigList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uses", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Uses = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18299,71 +21313,18 @@ func (m *HealthCheckStep) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Config == nil {
-				m.Config = &v12.JSON{}
-			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HealthStats) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HealthStats: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HealthStats: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Healthy", wireType)
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Healthy = 0
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18373,11 +21334,26 @@ func (m *HealthStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Healthy |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, ProjectConfig{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -18399,7 +21375,7 @@ func (m *HealthStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Image) Unmarshal(dAtA []byte) error {
+func (m *ProjectConfigSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18422,17 +21398,17 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Image: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectConfigSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Image: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectConfigSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PromotionPolicies", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18442,29 +21418,31 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			m.PromotionPolicies = append(m.PromotionPolicies, PromotionPolicy{})
+			if err := m.PromotionPolicies[len(m.PromotionPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WebhookReceivers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18474,29 +21452,31 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tag = string(dAtA[iNdEx:postIndex])
+			m.WebhookReceivers = append(m.WebhookReceivers, WebhookReceiverConfig{})
+			if err := m.WebhookReceivers[len(m.WebhookReceivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PromotionWaves", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18506,27 +21486,29 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Digest = string(dAtA[iNdEx:postIndex])
+			m.PromotionWaves = append(m.PromotionWaves, PromotionWavePolicy{})
+			if err := m.PromotionWaves[len(m.PromotionWaves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcurrencyGroups", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18553,103 +21535,10 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.ConcurrencyGroups = append(m.ConcurrencyGroups, ConcurrencyGroup{})
+			if err := m.ConcurrencyGroups[len(m.ConcurrencyGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18672,7 +21561,7 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImageDiscoveryResult) Unmarshal(dAtA []byte) error {
+func (m *ProjectConfigStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18695,17 +21584,17 @@ func (m *ImageDiscoveryResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImageDiscoveryResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectConfigStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageDiscoveryResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectConfigStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18715,29 +21604,31 @@ func (m *ImageDiscoveryResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			m.Conditions = append(m.Conditions, v1.Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WebhookReceivers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18747,29 +21638,50 @@ func (m *ImageDiscoveryResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Platform = string(dAtA[iNdEx:postIndex])
+			m.WebhookReceivers = append(m.WebhookReceivers, WebhookReceiverDetails{})
+			if err := m.WebhookReceivers[len(m.WebhookReceivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			}
+			m.ObservedGeneration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedGeneration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field References", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHandledRefresh", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18779,25 +21691,23 @@ func (m *ImageDiscoveryResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.References = append(m.References, DiscoveredImageReference{})
-			if err := m.References[len(m.References)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LastHandledRefresh = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18820,7 +21730,7 @@ func (m *ImageDiscoveryResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
+func (m *ProjectList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18843,17 +21753,17 @@ func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImageSubscription: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18863,29 +21773,30 @@ func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageSelectionStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18895,61 +21806,81 @@ func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImageSelectionStrategy = ImageSelectionStrategy(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowTags", wireType)
+			m.Items = append(m.Items, Project{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowTags = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreTags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Warehouses", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18959,29 +21890,30 @@ func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IgnoreTags = append(m.IgnoreTags, string(dAtA[iNdEx:postIndex]))
+			if err := m.Warehouses.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stages", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18991,120 +21923,80 @@ func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Platform = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipTLSVerify", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.InsecureSkipTLSVerify = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DiscoveryLimit", wireType)
-			}
-			m.DiscoveryLimit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DiscoveryLimit |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StrictSemvers", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.StrictSemvers = bool(v != 0)
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Constraint", wireType)
+			if err := m.Stages.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Constraint = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowTagsRegexes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19114,29 +22006,31 @@ func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowTagsRegexes = append(m.AllowTagsRegexes, string(dAtA[iNdEx:postIndex]))
+			m.Conditions = append(m.Conditions, v1.Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 14:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreTagsRegexes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19146,23 +22040,27 @@ func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IgnoreTagsRegexes = append(m.IgnoreTagsRegexes, string(dAtA[iNdEx:postIndex]))
+			if m.Stats == nil {
+				m.Stats = &ProjectStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19185,7 +22083,7 @@ func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Project) Unmarshal(dAtA []byte) error {
+func (m *Promotion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19208,10 +22106,10 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Project: wiretype end group for non-group")
+			return fmt.Errorf("proto: Promotion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Project: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Promotion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -19247,6 +22145,39 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
@@ -19301,7 +22232,7 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectConfig) Unmarshal(dAtA []byte) error {
+func (m *PromotionConcurrency) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19324,17 +22255,17 @@ func (m *ProjectConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionConcurrency: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionConcurrency: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19344,28 +22275,116 @@ func (m *ProjectConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelSuperseded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CancelSuperseded = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PromotionList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PromotionList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PromotionList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19392,13 +22411,13 @@ func (m *ProjectConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19425,7 +22444,8 @@ func (m *ProjectConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, Promotion{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19450,7 +22470,7 @@ func (m *ProjectConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectConfigList) Unmarshal(dAtA []byte) error {
+func (m *PromotionPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19473,17 +22493,17 @@ func (m *ProjectConfigList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectConfigList: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectConfigList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19493,28 +22513,47 @@ func (m *ProjectConfigList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Stage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoPromotionEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoPromotionEnabled = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StageSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19541,8 +22580,10 @@ func (m *ProjectConfigList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, ProjectConfig{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.StageSelector == nil {
+				m.StageSelector = &PromotionPolicySelector{}
+			}
+			if err := m.StageSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19567,7 +22608,7 @@ func (m *ProjectConfigList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectConfigSpec) Unmarshal(dAtA []byte) error {
+func (m *PromotionPolicySelector) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19590,17 +22631,17 @@ func (m *ProjectConfigSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectConfigSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionPolicySelector: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectConfigSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionPolicySelector: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PromotionPolicies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19610,29 +22651,27 @@ func (m *ProjectConfigSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PromotionPolicies = append(m.PromotionPolicies, PromotionPolicy{})
-			if err := m.PromotionPolicies[len(m.PromotionPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WebhookReceivers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19659,8 +22698,10 @@ func (m *ProjectConfigSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WebhookReceivers = append(m.WebhookReceivers, WebhookReceiverConfig{})
-			if err := m.WebhookReceivers[len(m.WebhookReceivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LabelSelector == nil {
+				m.LabelSelector = &v1.LabelSelector{}
+			}
+			if err := m.LabelSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19685,7 +22726,7 @@ func (m *ProjectConfigSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectConfigStatus) Unmarshal(dAtA []byte) error {
+func (m *PromotionReference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19708,15 +22749,47 @@ func (m *ProjectConfigStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectConfigStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionReference: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectConfigStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionReference: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Freight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19743,14 +22816,16 @@ func (m *ProjectConfigStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, v1.Condition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Freight == nil {
+				m.Freight = &FreightReference{}
+			}
+			if err := m.Freight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WebhookReceivers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19777,16 +22852,18 @@ func (m *ProjectConfigStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WebhookReceivers = append(m.WebhookReceivers, WebhookReceiverDetails{})
-			if err := m.WebhookReceivers[len(m.WebhookReceivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Status == nil {
+				m.Status = &PromotionStatus{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
-			m.ObservedGeneration = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19796,14 +22873,81 @@ func (m *ProjectConfigStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ObservedGeneration |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinishedAt == nil {
+				m.FinishedAt = &v1.Time{}
+			}
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PromotionResume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PromotionResume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PromotionResume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastHandledRefresh", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Promotion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19831,8 +22975,27 @@ func (m *ProjectConfigStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastHandledRefresh = string(dAtA[iNdEx:postIndex])
+			m.Promotion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			}
+			m.Step = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Step |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19854,7 +23017,7 @@ func (m *ProjectConfigStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectList) Unmarshal(dAtA []byte) error {
+func (m *PromotionSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19877,15 +23040,79 @@ func (m *ProjectList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectList: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Freight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Freight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19912,13 +23139,14 @@ func (m *ProjectList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Steps = append(m.Steps, PromotionStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vars", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19945,64 +23173,14 @@ func (m *ProjectList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Project{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Vars = append(m.Vars, ExpressionVariable{})
+			if err := m.Vars[len(m.Vars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ProjectStats) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectStats: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectStats: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Warehouses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Concurrency", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20029,13 +23207,16 @@ func (m *ProjectStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Warehouses.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Concurrency == nil {
+				m.Concurrency = &PromotionConcurrency{}
+			}
+			if err := m.Concurrency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeFrom", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20062,7 +23243,10 @@ func (m *ProjectStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Stages.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ResumeFrom == nil {
+				m.ResumeFrom = &PromotionResume{}
+			}
+			if err := m.ResumeFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -20087,7 +23271,7 @@ func (m *ProjectStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
+func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20110,17 +23294,17 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 3:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20130,31 +23314,29 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, v1.Condition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Phase = PromotionPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20164,81 +23346,59 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &ProjectStats{}
-			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHandledRefresh", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Promotion) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Promotion: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Promotion: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.LastHandledRefresh = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Freight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20265,13 +23425,16 @@ func (m *Promotion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Freight == nil {
+				m.Freight = &FreightReference{}
+			}
+			if err := m.Freight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20298,13 +23461,16 @@ func (m *Promotion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.FinishedAt == nil {
+				m.FinishedAt = &v1.Time{}
+			}
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FreightCollection", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20331,63 +23497,16 @@ func (m *Promotion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PromotionList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.FreightCollection == nil {
+				m.FreightCollection = &FreightCollection{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.FreightCollection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthChecks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20414,13 +23533,33 @@ func (m *PromotionList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HealthChecks = append(m.HealthChecks, HealthCheckStep{})
+			if err := m.HealthChecks[len(m.HealthChecks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentStep", wireType)
+			}
+			m.CurrentStep = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentStep |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20447,66 +23586,52 @@ func (m *PromotionList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Promotion{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.State == nil {
+				m.State = &v12.JSON{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StepExecutionMetadata", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PromotionPolicy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.StepExecutionMetadata = append(m.StepExecutionMetadata, StepExecutionMetadata{})
+			if err := m.StepExecutionMetadata[len(m.StepExecutionMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionPolicy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20516,29 +23641,33 @@ func (m *PromotionPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stage = string(dAtA[iNdEx:postIndex])
+			if m.StartedAt == nil {
+				m.StartedAt = &v1.Time{}
+			}
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoPromotionEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuePosition", wireType)
 			}
-			var v int
+			m.QueuePosition = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20548,15 +23677,14 @@ func (m *PromotionPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.QueuePosition |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AutoPromotionEnabled = bool(v != 0)
-		case 3:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StageSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Workspace", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20583,10 +23711,10 @@ func (m *PromotionPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StageSelector == nil {
-				m.StageSelector = &PromotionPolicySelector{}
+			if m.Workspace == nil {
+				m.Workspace = &PromotionWorkspace{}
 			}
-			if err := m.StageSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Workspace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -20611,7 +23739,7 @@ func (m *PromotionPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PromotionPolicySelector) Unmarshal(dAtA []byte) error {
+func (m *PromotionStep) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20634,15 +23762,15 @@ func (m *PromotionPolicySelector) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionPolicySelector: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionStep: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionPolicySelector: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionStep: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20670,13 +23798,13 @@ func (m *PromotionPolicySelector) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Uses = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field As", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20686,83 +23814,65 @@ func (m *PromotionPolicySelector) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LabelSelector == nil {
-				m.LabelSelector = &v1.LabelSelector{}
+			m.As = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
-			if err := m.LabelSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PromotionReference) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Config == nil {
+				m.Config = &v12.JSON{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionReference: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionReference: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20772,27 +23882,31 @@ func (m *PromotionReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Retry == nil {
+				m.Retry = &PromotionStepRetry{}
+			}
+			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Freight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20819,16 +23933,16 @@ func (m *PromotionReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Freight == nil {
-				m.Freight = &FreightReference{}
+			if m.Task == nil {
+				m.Task = &PromotionTaskReference{}
 			}
-			if err := m.Freight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vars", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20855,18 +23969,16 @@ func (m *PromotionReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Status == nil {
-				m.Status = &PromotionStatus{}
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Vars = append(m.Vars, ExpressionVariable{})
+			if err := m.Vars[len(m.Vars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field If", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20876,28 +23988,44 @@ func (m *PromotionReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinishedAt == nil {
-				m.FinishedAt = &v1.Time{}
+			m.If = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinueOnError", wireType)
 			}
-			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.ContinueOnError = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -20919,7 +24047,7 @@ func (m *PromotionReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PromotionSpec) Unmarshal(dAtA []byte) error {
+func (m *PromotionStepRetry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20942,79 +24070,15 @@ func (m *PromotionSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionStepRetry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionStepRetry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Stage = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Freight", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Freight = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21041,16 +24105,18 @@ func (m *PromotionSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Steps = append(m.Steps, PromotionStep{})
-			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Timeout == nil {
+				m.Timeout = &v1.Duration{}
+			}
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vars", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorThreshold", wireType)
 			}
-			var msglen int
+			m.ErrorThreshold = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21060,26 +24126,11 @@ func (m *PromotionSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ErrorThreshold |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Vars = append(m.Vars, ExpressionVariable{})
-			if err := m.Vars[len(m.Vars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -21101,7 +24152,7 @@ func (m *PromotionSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
+func (m *PromotionTask) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21124,17 +24175,17 @@ func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionTask: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionTask: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21144,29 +24195,30 @@ func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = PromotionPhase(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21176,59 +24228,78 @@ func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastHandledRefresh", wireType)
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PromotionTaskList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastHandledRefresh = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PromotionTaskList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PromotionTaskList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Freight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21255,16 +24326,13 @@ func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Freight == nil {
-				m.Freight = &FreightReference{}
-			}
-			if err := m.Freight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21291,18 +24359,66 @@ func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinishedAt == nil {
-				m.FinishedAt = &v1.Time{}
-			}
-			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, PromotionTask{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PromotionTaskReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PromotionTaskReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PromotionTaskReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FreightCollection", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21312,33 +24428,29 @@ func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FreightCollection == nil {
-				m.FreightCollection = &FreightCollection{}
-			}
-			if err := m.FreightCollection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HealthChecks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21348,48 +24460,77 @@ func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HealthChecks = append(m.HealthChecks, HealthCheckStep{})
-			if err := m.HealthChecks[len(m.HealthChecks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentStep", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.CurrentStep = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CurrentStep |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 10:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PromotionTaskSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PromotionTaskSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PromotionTaskSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vars", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21416,16 +24557,14 @@ func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.State == nil {
-				m.State = &v12.JSON{}
-			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Vars = append(m.Vars, ExpressionVariable{})
+			if err := m.Vars[len(m.Vars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StepExecutionMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21452,14 +24591,64 @@ func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StepExecutionMetadata = append(m.StepExecutionMetadata, StepExecutionMetadata{})
-			if err := m.StepExecutionMetadata[len(m.StepExecutionMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Steps = append(m.Steps, PromotionStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 12:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PromotionTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PromotionTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PromotionTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21486,10 +24675,7 @@ func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartedAt == nil {
-				m.StartedAt = &v1.Time{}
-			}
-			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -21514,7 +24700,7 @@ func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PromotionStep) Unmarshal(dAtA []byte) error {
+func (m *PromotionTemplateSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21537,17 +24723,17 @@ func (m *PromotionStep) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionStep: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionTemplateSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionStep: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionTemplateSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21557,29 +24743,31 @@ func (m *PromotionStep) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uses = string(dAtA[iNdEx:postIndex])
+			m.Steps = append(m.Steps, PromotionStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field As", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vars", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21589,27 +24777,29 @@ func (m *PromotionStep) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.As = string(dAtA[iNdEx:postIndex])
+			m.Vars = append(m.Vars, ExpressionVariable{})
+			if err := m.Vars[len(m.Vars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Concurrency", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21636,54 +24826,68 @@ func (m *PromotionStep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Config == nil {
-				m.Config = &v12.JSON{}
+			if m.Concurrency == nil {
+				m.Concurrency = &PromotionConcurrency{}
 			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Concurrency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Retry == nil {
-				m.Retry = &PromotionStepRetry{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PromotionWave) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PromotionWave: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PromotionWave: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21693,31 +24897,27 @@ func (m *PromotionStep) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Task == nil {
-				m.Task = &PromotionTaskReference{}
-			}
-			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vars", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StageSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21744,48 +24944,18 @@ func (m *PromotionStep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vars = append(m.Vars, ExpressionVariable{})
-			if err := m.Vars[len(m.Vars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field If", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if m.StageSelector == nil {
+				m.StageSelector = &PromotionPolicySelector{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.StageSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.If = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContinueOnError", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Percent", wireType)
 			}
-			var v int
+			m.Percent = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21793,67 +24963,16 @@ func (m *PromotionStep) Unmarshal(dAtA []byte) error {
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ContinueOnError = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PromotionStepRetry) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionStepRetry: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionStepRetry: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Percent |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SoakTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21880,18 +24999,18 @@ func (m *PromotionStepRetry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Timeout == nil {
-				m.Timeout = &v1.Duration{}
+			if m.SoakTime == nil {
+				m.SoakTime = &v1.Duration{}
 			}
-			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SoakTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureThreshold", wireType)
 			}
-			m.ErrorThreshold = 0
+			m.FailureThreshold = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21901,7 +25020,7 @@ func (m *PromotionStepRetry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ErrorThreshold |= uint32(b&0x7F) << shift
+				m.FailureThreshold |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -21927,7 +25046,7 @@ func (m *PromotionStepRetry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PromotionTask) Unmarshal(dAtA []byte) error {
+func (m *PromotionWavePolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21950,15 +25069,47 @@ func (m *PromotionTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionWavePolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionWavePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StageSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21985,13 +25136,13 @@ func (m *PromotionTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StageSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Waves", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22018,7 +25169,8 @@ func (m *PromotionTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Waves = append(m.Waves, PromotionWave{})
+			if err := m.Waves[len(m.Waves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -22043,7 +25195,7 @@ func (m *PromotionTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PromotionTaskList) Unmarshal(dAtA []byte) error {
+func (m *PromotionWaveProgress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22066,17 +25218,17 @@ func (m *PromotionTaskList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionTaskList: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionWaveProgress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionTaskList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionWaveProgress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22086,28 +25238,79 @@ func (m *PromotionTaskList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Policy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Freight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Freight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Halted = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Waves", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22134,8 +25337,8 @@ func (m *PromotionTaskList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, PromotionTask{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Waves = append(m.Waves, PromotionWaveStatus{})
+			if err := m.Waves[len(m.Waves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -22160,7 +25363,7 @@ func (m *PromotionTaskList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PromotionTaskReference) Unmarshal(dAtA []byte) error {
+func (m *PromotionWaveStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22183,10 +25386,10 @@ func (m *PromotionTaskReference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionTaskReference: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionWaveStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionTaskReference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionWaveStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -22223,7 +25426,7 @@ func (m *PromotionTaskReference) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22251,63 +25454,13 @@ func (m *PromotionTaskReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.Phase = PromotionWavePhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PromotionTaskSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionTaskSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionTaskSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vars", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stages", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22317,31 +25470,29 @@ func (m *PromotionTaskSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vars = append(m.Vars, ExpressionVariable{})
-			if err := m.Vars[len(m.Vars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Stages = append(m.Stages, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedStages", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22351,81 +25502,29 @@ func (m *PromotionTaskSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Steps = append(m.Steps, PromotionStep{})
-			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PromotionTemplate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionTemplate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifiedStages = append(m.VerifiedStages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedStages", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22435,24 +25534,23 @@ func (m *PromotionTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FailedStages = append(m.FailedStages, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22475,7 +25573,7 @@ func (m *PromotionTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PromotionTemplateSpec) Unmarshal(dAtA []byte) error {
+func (m *PromotionWorkspace) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22498,15 +25596,15 @@ func (m *PromotionTemplateSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionTemplateSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionWorkspace: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionTemplateSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionWorkspace: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskUsage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22533,16 +25631,15 @@ func (m *PromotionTemplateSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Steps = append(m.Steps, PromotionStep{})
-			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DiskUsage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vars", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retained", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22552,26 +25649,12 @@ func (m *PromotionTemplateSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Vars = append(m.Vars, ExpressionVariable{})
-			if err := m.Vars[len(m.Vars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Retained = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -23301,6 +26384,38 @@ func (m *StageSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -24272,6 +27387,40 @@ func (m *Verification) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Steps = append(m.Steps, PromotionStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -24558,6 +27707,95 @@ func (m *VerificationInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.Actor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentStep", wireType)
+			}
+			m.CurrentStep = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentStep |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StepExecutionMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StepExecutionMetadata = append(m.StepExecutionMetadata, StepExecutionMetadata{})
+			if err := m.StepExecutionMetadata[len(m.StepExecutionMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.State == nil {
+				m.State = &v12.JSON{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -25163,6 +28401,42 @@ func (m *WarehouseSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreightStalenessPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FreightStalenessPolicy == nil {
+				m.FreightStalenessPolicy = &FreightStalenessPolicy{}
+			}
+			if err := m.FreightStalenessPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -33,6 +33,11 @@ const (
 	// with. For example, an AnalysisRun created for a specific Stage has this
 	// label set to the name of the Stage.
 	LabelKeyStage = "kargo.akuity.io/stage"
+	// LabelKeyPromotion is used to identify the Promotion that a resource is
+	// associated with. For example, the ConfigMap that stores the logs captured
+	// during the execution of a Promotion's steps has this label set to the
+	// name of the Promotion.
+	LabelKeyPromotion = "kargo.akuity.io/promotion"
 	// LabelKeyFreightCollection is used to identify the FreightCollection
 	// that a resource is associated with. For example, an AnalysisRun created
 	// for a specific collection of Freight has this label set to the ID of the
@@ -44,6 +49,11 @@ const (
 	LabelKeyProject = "kargo.akuity.io/project"
 	// LabelKeyShard is used to identify the shard of a resource.
 	LabelKeyShard = "kargo.akuity.io/shard"
+	// LabelKeyApplySet is used to identify the set of declaratively managed
+	// resources a resource belongs to. The value is the name of the set. Resources
+	// with this label that are no longer present in the manifests applied for the
+	// set may be pruned by `kargo apply --prune`.
+	LabelKeyApplySet = "kargo.akuity.io/apply-set"
 
 	// LabelValueTrue is used to identify a label that has a value of "true".
 	LabelValueTrue = "true"
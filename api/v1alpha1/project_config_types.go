@@ -35,6 +35,19 @@ type ProjectConfigSpec struct {
 	// WebhookReceivers describes Project-specific webhook receivers used for
 	// processing events from various external platforms
 	WebhookReceivers []WebhookReceiverConfig `json:"webhookReceivers,omitempty" protobuf:"bytes,2,rep,name=webhookReceivers"`
+	// PromotionWaves defines policies governing the automatic promotion of
+	// Freight to groups of Stages in successive waves.
+	//
+	// +listType=map
+	// +listMapKey=name
+	PromotionWaves []PromotionWavePolicy `json:"promotionWaves,omitempty" protobuf:"bytes,3,rep,name=promotionWaves"`
+	// ConcurrencyGroups defines limits on the number of Promotions that may be
+	// running at the same time across the Stages of the Project. Promotions
+	// join a group through their concurrency configuration.
+	//
+	// +listType=map
+	// +listMapKey=name
+	ConcurrencyGroups []ConcurrencyGroup `json:"concurrencyGroups,omitempty" protobuf:"bytes,4,rep,name=concurrencyGroups"`
 }
 
 // ProjectConfigStatus describes the current status of a ProjectConfig.
@@ -94,6 +107,176 @@ type PromotionPolicy struct {
 	AutoPromotionEnabled bool `json:"autoPromotionEnabled,omitempty" protobuf:"varint,2,opt,name=autoPromotionEnabled"`
 }
 
+// ConcurrencyGroup limits the number of Promotions of a group that may be
+// running at the same time.
+type ConcurrencyGroup struct {
+	// Name is the name of the group.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +akuity:test-kubebuilder-pattern=KubernetesName
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Limit is the maximum number of Promotions of the group that may be
+	// running at the same time. Other Promotions of the group are queued until
+	// a running one finishes. Defaults to 1.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=1
+	// +optional
+	Limit int32 `json:"limit,omitempty" protobuf:"varint,2,opt,name=limit"`
+}
+
+// GetLimit returns the Limit of the group, or its default if it is not set.
+func (g *ConcurrencyGroup) GetLimit() int32 {
+	if g == nil || g.Limit < 1 {
+		return 1
+	}
+	return g.Limit
+}
+
+// PromotionWavePolicy defines a group of Stages to which Freight is
+// automatically promoted in successive waves. Freight is only auto-promoted to
+// a Stage in a wave once it has been verified, and has soaked for the
+// configured amount of time, in every Stage of all preceding waves.
+//
+// Waves only govern auto-promotion. Auto-promotion must still be enabled for
+// each Stage using a PromotionPolicy, and manual promotions are never
+// restricted by waves.
+type PromotionWavePolicy struct {
+	// Name is the name of the policy.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +akuity:test-kubebuilder-pattern=KubernetesName
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// StageSelector selects all Stages to which Freight is promoted in waves
+	// according to this policy. A Stage should not be selected by more than one
+	// policy. If it is, only the first policy selecting it applies.
+	//
+	// +kubebuilder:validation:Required
+	StageSelector PromotionPolicySelector `json:"stageSelector" protobuf:"bytes,2,opt,name=stageSelector"`
+	// Waves is the ordered list of waves. Stages selected by the policy are
+	// assigned to the first wave they qualify for. Stages that do not qualify
+	// for any wave are assigned to the last wave.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +listType=map
+	// +listMapKey=name
+	Waves []PromotionWave `json:"waves" protobuf:"bytes,3,rep,name=waves"`
+}
+
+// PromotionWave describes a single wave of a PromotionWavePolicy.
+type PromotionWave struct {
+	// Name is the name of the wave.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// StageSelector optionally narrows down the Stages, among those selected by
+	// the policy and not assigned to any preceding wave, that qualify for this
+	// wave. If not specified, all such Stages qualify.
+	//
+	// +optional
+	StageSelector *PromotionPolicySelector `json:"stageSelector,omitempty" protobuf:"bytes,2,opt,name=stageSelector"`
+	// Percent optionally limits the number of Stages assigned to this wave to
+	// the given percentage (rounded up) of all Stages selected by the policy.
+	// Qualifying Stages are assigned in order of their names. If not specified,
+	// all qualifying Stages are assigned to this wave.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	Percent int32 `json:"percent,omitempty" protobuf:"varint,3,opt,name=percent"`
+	// SoakTime is the minimum amount of time Freight must have been in every
+	// Stage of this wave, after being verified there, before it can be
+	// automatically promoted to the Stages of the next wave.
+	//
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(s|m|h))+$`
+	// +akuity:test-kubebuilder-pattern=Duration
+	// +optional
+	SoakTime *metav1.Duration `json:"soakTime,omitempty" protobuf:"bytes,4,opt,name=soakTime"`
+	// FailureThreshold is the number of Stages in this wave that must fail
+	// verification of a piece of Freight for its rollout to be halted. A halted
+	// rollout does not proceed to any further Stages in this or subsequent
+	// waves. Stages that failed verification do not hold back the next wave for
+	// as long as the threshold is not reached. Defaults to 1.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=1
+	// +optional
+	FailureThreshold int32 `json:"failureThreshold,omitempty" protobuf:"varint,5,opt,name=failureThreshold"`
+}
+
+// GetFailureThreshold returns the FailureThreshold of the wave, or its default
+// if it is not set.
+func (w *PromotionWave) GetFailureThreshold() int32 {
+	if w == nil || w.FailureThreshold < 1 {
+		return 1
+	}
+	return w.FailureThreshold
+}
+
+// PromotionWavePhase describes the progress of a piece of Freight through a
+// single wave of a PromotionWavePolicy.
+type PromotionWavePhase string
+
+const (
+	// PromotionWavePhasePending denotes that the Freight has not reached any
+	// Stage of the wave yet.
+	PromotionWavePhasePending PromotionWavePhase = "Pending"
+	// PromotionWavePhaseProgressing denotes that the Freight has reached some,
+	// but not all, Stages of the wave or has not been verified in all of them
+	// yet.
+	PromotionWavePhaseProgressing PromotionWavePhase = "Progressing"
+	// PromotionWavePhaseSoaking denotes that the Freight has been verified in
+	// all Stages of the wave, but has not soaked in all of them for long enough
+	// yet.
+	PromotionWavePhaseSoaking PromotionWavePhase = "Soaking"
+	// PromotionWavePhaseCompleted denotes that the Freight has been verified
+	// and has soaked in all Stages of the wave.
+	PromotionWavePhaseCompleted PromotionWavePhase = "Completed"
+	// PromotionWavePhaseHalted denotes that the Freight failed verification in
+	// at least as many Stages of the wave as its failure threshold.
+	PromotionWavePhaseHalted PromotionWavePhase = "Halted"
+)
+
+// PromotionWaveProgress describes the progress of a piece of Freight through
+// the waves of a PromotionWavePolicy.
+type PromotionWaveProgress struct {
+	// Policy is the name of the PromotionWavePolicy.
+	Policy string `json:"policy" protobuf:"bytes,1,opt,name=policy"`
+	// Freight is the name of the Freight whose progress is described. It is
+	// empty if no Freight has reached any Stage selected by the policy yet.
+	Freight string `json:"freight,omitempty" protobuf:"bytes,2,opt,name=freight"`
+	// Halted indicates whether the rollout of the Freight was halted because
+	// it failed verification in too many Stages of a wave.
+	Halted bool `json:"halted,omitempty" protobuf:"varint,3,opt,name=halted"`
+	// Waves describes the progress of the Freight through each wave.
+	Waves []PromotionWaveStatus `json:"waves,omitempty" protobuf:"bytes,4,rep,name=waves"`
+}
+
+// PromotionWaveStatus describes the progress of a piece of Freight through a
+// single wave of a PromotionWavePolicy.
+type PromotionWaveStatus struct {
+	// Name is the name of the wave.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Phase is the phase of the wave.
+	Phase PromotionWavePhase `json:"phase" protobuf:"bytes,2,opt,name=phase"`
+	// Stages are the names of the Stages assigned to the wave.
+	Stages []string `json:"stages,omitempty" protobuf:"bytes,3,rep,name=stages"`
+	// VerifiedStages are the names of the Stages of the wave in which the
+	// Freight has been verified.
+	VerifiedStages []string `json:"verifiedStages,omitempty" protobuf:"bytes,4,rep,name=verifiedStages"`
+	// FailedStages are the names of the Stages of the wave in which the
+	// Freight failed verification.
+	FailedStages []string `json:"failedStages,omitempty" protobuf:"bytes,5,rep,name=failedStages"`
+}
+
 // WebhookReceiverConfig describes the configuration for a single webhook
 // receiver.
 type WebhookReceiverConfig struct {
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/yaml"
 )
@@ -12,7 +13,9 @@ import (
 const (
 	// PromotionPhasePending denotes a Promotion that has not been executed yet.
 	// i.e. It is currently waiting in a queue. Queues are stage-specific and
-	// prioritized by Promotion creation time.
+	// prioritized by Promotion creation time. Promotions that belong to a
+	// concurrency group are additionally queued with the other Promotions of
+	// that group.
 	PromotionPhasePending PromotionPhase = "Pending"
 	// PromotionPhaseRunning denotes a Promotion that is actively being executed.
 	//
@@ -189,6 +192,68 @@ type PromotionSpec struct {
 	// +kubebuilder:validation:MinItems=1
 	// +kubebuilder:validation:items:XValidation:message="Promotion step must have uses set and must not reference a task",rule="has(self.uses) && !has(self.task)"
 	Steps []PromotionStep `json:"steps" protobuf:"bytes,3,rep,name=steps"`
+	// Concurrency optionally describes how this Promotion is queued with
+	// Promotions to other Stages.
+	//
+	// +optional
+	Concurrency *PromotionConcurrency `json:"concurrency,omitempty" protobuf:"bytes,5,opt,name=concurrency"`
+	// ResumeFrom optionally references a Failed or Errored Promotion of the
+	// same Freight to the same Stage which this Promotion resumes. Steps
+	// preceding the step this Promotion resumes from are not executed again;
+	// instead, their outputs are restored from the referenced Promotion.
+	//
+	// +optional
+	ResumeFrom *PromotionResume `json:"resumeFrom,omitempty" protobuf:"bytes,6,opt,name=resumeFrom"`
+}
+
+// PromotionResume references a Promotion which is resumed by another
+// Promotion, and the step from which it is resumed.
+type PromotionResume struct {
+	// Promotion is the name of the Promotion which is resumed.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +akuity:test-kubebuilder-pattern=KubernetesName
+	Promotion string `json:"promotion" protobuf:"bytes,1,opt,name=promotion"`
+	// Step is the index of the step from which the Promotion is resumed. It
+	// must not be greater than the index of the step at which the resumed
+	// Promotion stopped.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Step int64 `json:"step,omitempty" protobuf:"varint,2,opt,name=step"`
+}
+
+// PromotionConcurrency describes how a Promotion is queued with other
+// Promotions.
+type PromotionConcurrency struct {
+	// Group is the name of the concurrency group the Promotion joins. The
+	// number of Promotions of the same group that may be running at the same
+	// time is limited by the limit of the group defined in the ProjectConfig.
+	// Groups that are not defined in the ProjectConfig have a limit of 1.
+	//
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +akuity:test-kubebuilder-pattern=KubernetesName
+	// +optional
+	Group string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	// Priority is the priority of the Promotion within its concurrency group.
+	// Queued Promotions with a higher priority are started before those with a
+	// lower priority. Queued Promotions with the same priority are started in
+	// the order in which they were created. Promotions to the same Stage are
+	// always started in the order in which they were created, regardless of
+	// their priority. Defaults to 0.
+	//
+	// +optional
+	Priority int32 `json:"priority,omitempty" protobuf:"varint,2,opt,name=priority"`
+	// CancelSuperseded indicates whether Promotions to the same Stage that
+	// were created before this Promotion, and have not started running yet,
+	// should be aborted when this Promotion is created.
+	//
+	// +optional
+	CancelSuperseded bool `json:"cancelSuperseded,omitempty" protobuf:"varint,3,opt,name=cancelSuperseded"`
 }
 
 // PromotionTaskReference describes a reference to a PromotionTask.
@@ -342,6 +407,11 @@ type PromotionStatus struct {
 	HealthChecks []HealthCheckStep `json:"healthChecks,omitempty" protobuf:"bytes,8,rep,name=healthChecks"`
 	// StartedAt is the time when the promotion started.
 	StartedAt *metav1.Time `json:"startedAt,omitempty" protobuf:"bytes,12,opt,name=startedAt"`
+	// QueuePosition is the position of the Promotion in the queue of its
+	// concurrency group while it is waiting for other Promotions of the group
+	// to finish. The first position in the queue is 1. It is unset while the
+	// Promotion is not queued.
+	QueuePosition int32 `json:"queuePosition,omitempty" protobuf:"varint,13,opt,name=queuePosition"`
 	// FinishedAt is the time when the promotion was completed.
 	FinishedAt *metav1.Time `json:"finishedAt,omitempty" protobuf:"bytes,6,opt,name=finishedAt"`
 	// CurrentStep is the index of the current promotion step being executed. This
@@ -354,6 +424,23 @@ type PromotionStatus struct {
 	// State stores the state of the promotion process between reconciliation
 	// attempts.
 	State *apiextensionsv1.JSON `json:"state,omitempty" protobuf:"bytes,10,opt,name=state"`
+	// Workspace describes the working directory in which the steps of the
+	// Promotion are executed, as last observed by the controller.
+	//
+	// +optional
+	Workspace *PromotionWorkspace `json:"workspace,omitempty" protobuf:"bytes,14,opt,name=workspace"`
+}
+
+// PromotionWorkspace describes the working directory in which the steps of a
+// Promotion are executed.
+type PromotionWorkspace struct {
+	// DiskUsage is the amount of disk space used by the working directory.
+	DiskUsage resource.Quantity `json:"diskUsage" protobuf:"bytes,1,opt,name=diskUsage"`
+	// Retained indicates whether the working directory was retained after the
+	// Promotion reached a terminal phase, so that a Promotion which resumes it
+	// can skip the steps that already ran. Only Promotions with a retained
+	// working directory can be resumed from a step other than the first.
+	Retained bool `json:"retained,omitempty" protobuf:"varint,2,opt,name=retained"`
 }
 
 // GetState returns the State field as unmarshalled YAML.
@@ -205,6 +205,14 @@ type StageSpec struct {
 	// kargo.akuity.io/shard label with the value of this field. When this field
 	// is empty, the webhook will ensure that label is absent.
 	Shard string `json:"shard,omitempty" protobuf:"bytes,4,opt,name=shard"`
+	// Agent is the name of the agent that executes the steps of Promotions to
+	// this Stage. This is an optional field. If not specified, the steps are
+	// executed by the controller itself. Agents run out-of-process, typically
+	// close to the environments the steps target, and dial the controller to
+	// receive the steps to execute. The agent must be mapped to the Stage's
+	// Project in the ClusterConfig. A step errors if the agent is not
+	// connected when the step is to be executed.
+	Agent string `json:"agent,omitempty" protobuf:"bytes,8,opt,name=agent"`
 	// Vars is a list of variables that can be referenced anywhere in the
 	// StageSpec that supports expressions. For example, the PromotionTemplate
 	// and arguments of the Verification.
@@ -239,6 +247,13 @@ type FreightRequest struct {
 	// Sources describes where the requested Freight may be obtained from. This is
 	// a required field.
 	Sources FreightSources `json:"sources" protobuf:"bytes,2,opt,name=sources"`
+	// StalenessPolicy describes when the requested Freight becomes stale. Stale
+	// Freight is not available for promotion to this Stage, unless it has been
+	// manually approved for this Stage with stale Freight explicitly allowed.
+	// This is an optional field. If not
+	// specified, the FreightStalenessPolicy of the Warehouse from which the
+	// Freight originated applies.
+	StalenessPolicy *FreightStalenessPolicy `json:"stalenessPolicy,omitempty" protobuf:"bytes,3,opt,name=stalenessPolicy"`
 }
 
 // FreightOrigin describes a kind of Freight in terms of where it may have
@@ -353,6 +368,26 @@ type AutoPromotionOptions struct {
 	//   from this Stage is eligible for auto-promotion. This policy may only
 	//   be applied when the Stage has exactly one upstream Stage.
 	SelectionPolicy AutoPromotionSelectionPolicy `json:"selectionPolicy,omitempty" protobuf:"bytes,1,opt,name=selectionPolicy"`
+	// Condition is an optional expr-lang expression that Freight must satisfy
+	// to be auto-promoted to this Stage. Freight that does not satisfy it is
+	// skipped and remains available for manual promotion. With the
+	// "NewestFreight" selection policy, the newest Freight that is newer than
+	// the Stage's current Freight and satisfies the condition is auto-promoted.
+	//
+	// The expression is evaluated separately for each candidate Freight. The
+	// commitFrom(), imageFrom(), and chartFrom() functions resolve artifacts
+	// from the candidate Freight, while the functions of the same names on the
+	// `current` object (e.g. current.imageFrom()) resolve artifacts from the
+	// Stage's current Freight and return nil if the Stage has none. The
+	// semverDiff() function, the `freight` object (with `name` and `alias`
+	// fields) and the `ctx` object (with `project` and `stage` fields) are also
+	// available. For example, the following condition permits only patch
+	// releases of an image to be auto-promoted:
+	//
+	//   semverDiff(current.imageFrom("example/app")?.Tag ?? "", imageFrom("example/app").Tag) == "Patch"
+	//
+	// +optional
+	Condition string `json:"condition,omitempty" protobuf:"bytes,2,opt,name=condition"`
 }
 
 // PromotionTemplate defines a template for a Promotion that can be used to
@@ -377,6 +412,11 @@ type PromotionTemplateSpec struct {
 	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step referencing a task cannot set continueOnError",rule="!has(self.task) || !has(self.continueOnError)"
 	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step referencing a task cannot set retry",rule="!has(self.task) || !has(self.retry)"
 	Steps []PromotionStep `json:"steps,omitempty" protobuf:"bytes,1,rep,name=steps"`
+	// Concurrency optionally describes how Promotions created from this
+	// template are queued with Promotions to other Stages.
+	//
+	// +optional
+	Concurrency *PromotionConcurrency `json:"concurrency,omitempty" protobuf:"bytes,3,opt,name=concurrency"`
 }
 
 // StageStatus describes a Stages's current and recent Freight, health, and
@@ -728,7 +768,9 @@ func (r *PromotionReference) GetHealthChecks() []HealthCheckStep {
 }
 
 // Verification describes how to verify that a Promotion has been successful
-// using Argo Rollouts AnalysisTemplates.
+// using either Argo Rollouts AnalysisTemplates or promotion steps.
+//
+// +kubebuilder:validation:XValidation:message="Verification must not have both analysisTemplates and steps set",rule="!(has(self.analysisTemplates) && size(self.analysisTemplates) > 0 && has(self.steps) && size(self.steps) > 0)"
 type Verification struct {
 	// AnalysisTemplates is a list of AnalysisTemplates from which AnalysisRuns
 	// should be created to verify a Stage's current Freight is fit to be promoted
@@ -739,6 +781,21 @@ type Verification struct {
 	AnalysisRunMetadata *AnalysisRunMetadata `json:"analysisRunMetadata,omitempty" protobuf:"bytes,2,opt,name=analysisRunMetadata"`
 	// Args lists arguments that should be added to all AnalysisRuns.
 	Args []AnalysisRunArgument `json:"args,omitempty" protobuf:"bytes,3,rep,name=args"`
+	// Steps is a list of promotion steps that should be executed to verify a
+	// Stage's current Freight is fit to be promoted downstream. The steps are
+	// executed by the Stage controller in the same manner as the steps of a
+	// Promotion, which means they do not require Argo Rollouts to be installed.
+	// The verification succeeds if all steps succeed. Steps may not reference
+	// PromotionTasks. This field is mutually exclusive with AnalysisTemplates.
+	//
+	// +kubebuilder:validation:items:XValidation:message="Verification step must have uses set and must not reference a task",rule="has(self.uses) && !has(self.task)"
+	Steps []PromotionStep `json:"steps,omitempty" protobuf:"bytes,4,rep,name=steps"`
+}
+
+// HasSteps returns a bool indicating whether the Verification is performed by
+// executing promotion steps rather than by creating AnalysisRuns.
+func (v *Verification) HasSteps() bool {
+	return v != nil && len(v.Steps) > 0
 }
 
 // AnalysisTemplateReference is a reference to an AnalysisTemplate.
@@ -800,6 +857,17 @@ type VerificationInfo struct {
 	AnalysisRun *AnalysisRunReference `json:"analysisRun,omitempty" protobuf:"bytes,3,opt,name=analysisRun"`
 	// FinishTime is the time at which the Verification process finished.
 	FinishTime *metav1.Time `json:"finishTime,omitempty" protobuf:"bytes,6,opt,name=finishTime"`
+	// CurrentStep is the index of the current verification step being executed.
+	// This is only set for Verification processes that execute steps.
+	CurrentStep int64 `json:"currentStep,omitempty" protobuf:"varint,8,opt,name=currentStep"`
+	// StepExecutionMetadata tracks metadata pertaining to the execution of
+	// individual verification steps. This is only set for Verification
+	// processes that execute steps.
+	StepExecutionMetadata StepExecutionMetadataList `json:"stepExecutionMetadata,omitempty" protobuf:"bytes,9,rep,name=stepExecutionMetadata"`
+	// State stores the state of the verification steps between reconciliation
+	// attempts. This is only set for Verification processes that execute
+	// steps.
+	State *apiextensionsv1.JSON `json:"state,omitempty" protobuf:"bytes,10,opt,name=state"`
 }
 
 // HasAnalysisRun returns a bool indicating whether the VerificationInfo has an
@@ -808,6 +876,25 @@ func (v *VerificationInfo) HasAnalysisRun() bool {
 	return v != nil && v.AnalysisRun != nil
 }
 
+// HasSteps returns a bool indicating whether the VerificationInfo belongs to
+// a Verification process that executes steps.
+func (v *VerificationInfo) HasSteps() bool {
+	return v != nil && len(v.StepExecutionMetadata) > 0
+}
+
+// GetState returns the State field as unmarshalled JSON.
+func (v *VerificationInfo) GetState() map[string]any {
+	if v == nil || v.State == nil {
+		return nil
+	}
+
+	var state map[string]any
+	if err := json.Unmarshal(v.State.Raw, &state); err != nil {
+		return nil
+	}
+	return state
+}
+
 type VerificationInfoStack []VerificationInfo
 
 // Current returns the VerificationInfo at the top of the stack.
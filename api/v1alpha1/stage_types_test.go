@@ -315,6 +315,37 @@ func TestVerificationInfo_HasAnalysisRun(t *testing.T) {
 	}
 }
 
+func TestVerificationInfo_HasSteps(t *testing.T) {
+	testCases := []struct {
+		name           string
+		info           *VerificationInfo
+		expectedResult bool
+	}{
+		{
+			name:           "VerificationInfo is nil",
+			info:           nil,
+			expectedResult: false,
+		},
+		{
+			name:           "StepExecutionMetadata is empty",
+			info:           &VerificationInfo{},
+			expectedResult: false,
+		},
+		{
+			name: "StepExecutionMetadata is not empty",
+			info: &VerificationInfo{
+				StepExecutionMetadata: StepExecutionMetadataList{{Alias: "step-1"}},
+			},
+			expectedResult: true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expectedResult, testCase.info.HasSteps())
+		})
+	}
+}
+
 func TestFreightCollectionIncludes(t *testing.T) {
 	const testFreight = "test-freight"
 	testCases := []struct {
@@ -115,6 +115,14 @@ type WarehouseSpec struct {
 	//
 	// +kubebuilder:validation:Optional
 	FreightCreationCriteria *FreightCreationCriteria `json:"freightCreationCriteria,omitempty" protobuf:"bytes,5,opt,name=freightCreationCriteria"`
+	// FreightStalenessPolicy describes when Freight produced by this Warehouse
+	// becomes stale. Stale Freight is not available for promotion, unless it
+	// has been manually approved for the Stage it is to be promoted to with
+	// stale Freight explicitly allowed. A Stage may override this policy for
+	// the Freight it requests from this Warehouse.
+	//
+	// +kubebuilder:validation:Optional
+	FreightStalenessPolicy *FreightStalenessPolicy `json:"freightStalenessPolicy,omitempty" protobuf:"bytes,6,opt,name=freightStalenessPolicy"`
 }
 
 // FreightCreationPolicy defines how Freight is created by a Warehouse.
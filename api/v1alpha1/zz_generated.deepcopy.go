@@ -10,6 +10,26 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentConfig) DeepCopyInto(out *AgentConfig) {
+	*out = *in
+	if in.Projects != nil {
+		in, out := &in.Projects, &out.Projects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentConfig.
+func (in *AgentConfig) DeepCopy() *AgentConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AnalysisRunArgument) DeepCopyInto(out *AnalysisRunArgument) {
 	*out = *in
@@ -337,6 +357,13 @@ func (in *ClusterConfigSpec) DeepCopyInto(out *ClusterConfigSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Agents != nil {
+		in, out := &in.Agents, &out.Agents
+		*out = make([]AgentConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterConfigSpec.
@@ -434,6 +461,21 @@ func (in *ClusterPromotionTaskList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConcurrencyGroup) DeepCopyInto(out *ConcurrencyGroup) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConcurrencyGroup.
+func (in *ConcurrencyGroup) DeepCopy() *ConcurrencyGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(ConcurrencyGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CurrentStage) DeepCopyInto(out *CurrentStage) {
 	*out = *in
@@ -764,6 +806,11 @@ func (in *FreightRequest) DeepCopyInto(out *FreightRequest) {
 	*out = *in
 	out.Origin = in.Origin
 	in.Sources.DeepCopyInto(&out.Sources)
+	if in.StalenessPolicy != nil {
+		in, out := &in.StalenessPolicy, &out.StalenessPolicy
+		*out = new(FreightStalenessPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreightRequest.
@@ -806,6 +853,26 @@ func (in *FreightSources) DeepCopy() *FreightSources {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreightStalenessPolicy) DeepCopyInto(out *FreightStalenessPolicy) {
+	*out = *in
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreightStalenessPolicy.
+func (in *FreightStalenessPolicy) DeepCopy() *FreightStalenessPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(FreightStalenessPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FreightStatus) DeepCopyInto(out *FreightStatus) {
 	*out = *in
@@ -1231,6 +1298,18 @@ func (in *ProjectConfigSpec) DeepCopyInto(out *ProjectConfigSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PromotionWaves != nil {
+		in, out := &in.PromotionWaves, &out.PromotionWaves
+		*out = make([]PromotionWavePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ConcurrencyGroups != nil {
+		in, out := &in.ConcurrencyGroups, &out.ConcurrencyGroups
+		*out = make([]ConcurrencyGroup, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectConfigSpec.
@@ -1373,6 +1452,21 @@ func (in *Promotion) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionConcurrency) DeepCopyInto(out *PromotionConcurrency) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionConcurrency.
+func (in *PromotionConcurrency) DeepCopy() *PromotionConcurrency {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionConcurrency)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PromotionList) DeepCopyInto(out *PromotionList) {
 	*out = *in
@@ -1474,6 +1568,21 @@ func (in *PromotionReference) DeepCopy() *PromotionReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionResume) DeepCopyInto(out *PromotionResume) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionResume.
+func (in *PromotionResume) DeepCopy() *PromotionResume {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionResume)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PromotionSpec) DeepCopyInto(out *PromotionSpec) {
 	*out = *in
@@ -1489,6 +1598,16 @@ func (in *PromotionSpec) DeepCopyInto(out *PromotionSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Concurrency != nil {
+		in, out := &in.Concurrency, &out.Concurrency
+		*out = new(PromotionConcurrency)
+		**out = **in
+	}
+	if in.ResumeFrom != nil {
+		in, out := &in.ResumeFrom, &out.ResumeFrom
+		*out = new(PromotionResume)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionSpec.
@@ -1541,6 +1660,11 @@ func (in *PromotionStatus) DeepCopyInto(out *PromotionStatus) {
 		*out = new(apiextensionsv1.JSON)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Workspace != nil {
+		in, out := &in.Workspace, &out.Workspace
+		*out = new(PromotionWorkspace)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionStatus.
@@ -1739,6 +1863,11 @@ func (in *PromotionTemplateSpec) DeepCopyInto(out *PromotionTemplateSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Concurrency != nil {
+		in, out := &in.Concurrency, &out.Concurrency
+		*out = new(PromotionConcurrency)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionTemplateSpec.
@@ -1751,6 +1880,122 @@ func (in *PromotionTemplateSpec) DeepCopy() *PromotionTemplateSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionWave) DeepCopyInto(out *PromotionWave) {
+	*out = *in
+	if in.StageSelector != nil {
+		in, out := &in.StageSelector, &out.StageSelector
+		*out = new(PromotionPolicySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SoakTime != nil {
+		in, out := &in.SoakTime, &out.SoakTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionWave.
+func (in *PromotionWave) DeepCopy() *PromotionWave {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionWave)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionWavePolicy) DeepCopyInto(out *PromotionWavePolicy) {
+	*out = *in
+	in.StageSelector.DeepCopyInto(&out.StageSelector)
+	if in.Waves != nil {
+		in, out := &in.Waves, &out.Waves
+		*out = make([]PromotionWave, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionWavePolicy.
+func (in *PromotionWavePolicy) DeepCopy() *PromotionWavePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionWavePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionWaveProgress) DeepCopyInto(out *PromotionWaveProgress) {
+	*out = *in
+	if in.Waves != nil {
+		in, out := &in.Waves, &out.Waves
+		*out = make([]PromotionWaveStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionWaveProgress.
+func (in *PromotionWaveProgress) DeepCopy() *PromotionWaveProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionWaveProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionWaveStatus) DeepCopyInto(out *PromotionWaveStatus) {
+	*out = *in
+	if in.Stages != nil {
+		in, out := &in.Stages, &out.Stages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VerifiedStages != nil {
+		in, out := &in.VerifiedStages, &out.VerifiedStages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FailedStages != nil {
+		in, out := &in.FailedStages, &out.FailedStages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionWaveStatus.
+func (in *PromotionWaveStatus) DeepCopy() *PromotionWaveStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionWaveStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionWorkspace) DeepCopyInto(out *PromotionWorkspace) {
+	*out = *in
+	out.DiskUsage = in.DiskUsage.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionWorkspace.
+func (in *PromotionWorkspace) DeepCopy() *PromotionWorkspace {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionWorkspace)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *QuayWebhookReceiverConfig) DeepCopyInto(out *QuayWebhookReceiverConfig) {
 	*out = *in
@@ -2026,6 +2271,13 @@ func (in *Verification) DeepCopyInto(out *Verification) {
 		*out = make([]AnalysisRunArgument, len(*in))
 		copy(*out, *in)
 	}
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]PromotionStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Verification.
@@ -2054,6 +2306,18 @@ func (in *VerificationInfo) DeepCopyInto(out *VerificationInfo) {
 		in, out := &in.FinishTime, &out.FinishTime
 		*out = (*in).DeepCopy()
 	}
+	if in.StepExecutionMetadata != nil {
+		in, out := &in.StepExecutionMetadata, &out.StepExecutionMetadata
+		*out = make(StepExecutionMetadataList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerificationInfo.
@@ -2186,6 +2450,11 @@ func (in *WarehouseSpec) DeepCopyInto(out *WarehouseSpec) {
 		*out = new(FreightCreationCriteria)
 		**out = **in
 	}
+	if in.FreightStalenessPolicy != nil {
+		in, out := &in.FreightStalenessPolicy, &out.FreightStalenessPolicy
+		*out = new(FreightStalenessPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarehouseSpec.
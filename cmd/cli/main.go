@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 
@@ -20,6 +21,10 @@ func main() {
 	}
 	cmd := NewRootCommand(cfg)
 	if err := cmd.ExecuteContext(ctx); err != nil {
+		var exitCodeErr interface{ ExitCode() int }
+		if errors.As(err, &exitCodeErr) {
+			os.Exit(exitCodeErr.ExitCode())
+		}
 		os.Exit(1)
 	}
 }
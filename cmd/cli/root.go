@@ -12,6 +12,7 @@ import (
 	"github.com/akuity/kargo/pkg/cli/cmd/create"
 	"github.com/akuity/kargo/pkg/cli/cmd/dashboard"
 	"github.com/akuity/kargo/pkg/cli/cmd/delete"
+	"github.com/akuity/kargo/pkg/cli/cmd/diff"
 	"github.com/akuity/kargo/pkg/cli/cmd/get"
 	"github.com/akuity/kargo/pkg/cli/cmd/grant"
 	"github.com/akuity/kargo/pkg/cli/cmd/login"
@@ -48,6 +49,7 @@ func NewRootCommand(cfg clicfg.CLIConfig) *cobra.Command {
 	cmd.AddCommand(cliconfigcmd.NewCommand(cfg, streams))
 	cmd.AddCommand(create.NewCommand(cfg, streams))
 	cmd.AddCommand(delete.NewCommand(cfg, streams))
+	cmd.AddCommand(diff.NewCommand(cfg, streams))
 	cmd.AddCommand(get.NewCommand(cfg, streams))
 	cmd.AddCommand(grant.NewCommand(cfg, streams))
 	cmd.AddCommand(login.NewCommand(cfg))
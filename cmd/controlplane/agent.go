@@ -0,0 +1,241 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	stdruntime "runtime"
+
+	"github.com/spf13/cobra"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	libkubernetes "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	libargocd "github.com/akuity/kargo/pkg/argocd"
+	argocd "github.com/akuity/kargo/pkg/controller/argocd/api/v1alpha1"
+	credsdb "github.com/akuity/kargo/pkg/credentials/kubernetes"
+	"github.com/akuity/kargo/pkg/logging"
+	"github.com/akuity/kargo/pkg/os"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/promotion/agent"
+	"github.com/akuity/kargo/pkg/promotion/runner/builtin"
+	"github.com/akuity/kargo/pkg/server/kubernetes"
+	"github.com/akuity/kargo/pkg/types"
+	versionpkg "github.com/akuity/kargo/pkg/x/version"
+)
+
+type agentOptions struct {
+	ControlPlaneKubeConfig string
+	QPS                    float32
+	Burst                  int
+
+	ArgoCDEnabled    bool
+	ArgoCDKubeConfig string
+
+	FluxEnabled bool
+
+	RunJobStepEnabled bool
+
+	Logger *logging.Logger
+}
+
+func newAgentCommand() *cobra.Command {
+	_, format := getLogVars()
+	cmdOpts := &agentOptions{
+		// During startup, we enforce use of an info-level logger to ensure that
+		// no important startup messages are missed.
+		Logger: logging.NewLoggerOrDie(logging.InfoLevel, format),
+	}
+
+	cmd := &cobra.Command{
+		Use:               "agent",
+		DisableAutoGenTag: true,
+		SilenceErrors:     true,
+		SilenceUsage:      true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			version := versionpkg.GetVersion()
+
+			cmdOpts.Logger.Info(
+				"Starting Kargo Agent",
+				"version", version.Version,
+				"commit", version.GitCommit,
+				"GOMAXPROCS", stdruntime.GOMAXPROCS(0),
+				"GOMEMLIMIT", os.GetEnv("GOMEMLIMIT", ""),
+			)
+
+			cmdOpts.complete()
+
+			return cmdOpts.run(cmd.Context())
+		},
+	}
+
+	return cmd
+}
+
+func (o *agentOptions) complete() {
+	o.ControlPlaneKubeConfig = os.GetEnv("KUBECONFIG", "")
+	o.QPS = types.MustParseFloat32(os.GetEnv("KUBE_API_QPS", "50.0"))
+	o.Burst = types.MustParseInt(os.GetEnv("KUBE_API_BURST", "300"))
+
+	o.ArgoCDEnabled = types.MustParseBool(os.GetEnv("ARGOCD_INTEGRATION_ENABLED", "true"))
+	o.ArgoCDKubeConfig = os.GetEnv("ARGOCD_KUBECONFIG", "")
+
+	o.FluxEnabled = types.MustParseBool(os.GetEnv("FLUX_INTEGRATION_ENABLED", "true"))
+
+	o.RunJobStepEnabled = types.MustParseBool(os.GetEnv("RUN_JOB_STEP_ENABLED", "false"))
+
+	logLevel, logFormat := getLogVars()
+
+	o.Logger = logging.NewLoggerOrDie(logLevel, logFormat)
+}
+
+func (o *agentOptions) run(ctx context.Context) error {
+	ctx = logging.ContextWithLogger(ctx, o.Logger)
+
+	kargoRestCfg, kargoClient, err := o.setupKargoClient(ctx)
+	if err != nil {
+		return fmt.Errorf("error initializing Kargo client: %w", err)
+	}
+
+	argoCDClient, err := o.setupArgoCDClient(ctx)
+	if err != nil {
+		return fmt.Errorf("error initializing Argo CD client: %w", err)
+	}
+
+	fluxClient, err := o.setupFluxClient(ctx)
+	if err != nil {
+		return fmt.Errorf("error initializing Flux client: %w", err)
+	}
+
+	if o.RunJobStepEnabled {
+		kubeClient, err := libkubernetes.NewForConfig(kargoRestCfg)
+		if err != nil {
+			return fmt.Errorf("error initializing Kubernetes client for run-job step: %w", err)
+		}
+		builtin.EnableRunJobStep(kubeClient.CoreV1())
+		o.Logger.Info("run-job promotion step is enabled")
+	}
+
+	workspaces, err := promotion.NewDirWorkspaceStore(promotion.WorkspaceStoreConfigFromEnv())
+	if err != nil {
+		return fmt.Errorf("error initializing Promotion workspace store: %w", err)
+	}
+
+	return agent.NewAgent(
+		agent.ConfigFromEnv(),
+		promotion.NewLocalStepExecutor(
+			promotion.GetStepRunnerRegistrations(),
+			kargoClient,
+			argoCDClient,
+			fluxClient,
+			credsdb.NewDatabase(ctx, kargoClient, nil, credsdb.DatabaseConfigFromEnv()),
+		),
+		workspaces,
+	).Run(ctx)
+}
+
+// setupKargoClient returns a client for the Kargo control plane along with the
+// REST config it was built from. The agent reads only a handful of resources
+// per step, so the client does not use a cache.
+func (o *agentOptions) setupKargoClient(ctx context.Context) (*rest.Config, client.Client, error) {
+	// If o.ControlPlaneKubeConfig is empty, this will resolve to kubeconfig for
+	// the cluster the agent is running in. It is typically non-empty, since
+	// agents usually run somewhere other than the Kargo control plane's
+	// cluster.
+	restCfg, err := kubernetes.GetRestConfig(ctx, o.ControlPlaneKubeConfig)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error loading REST config for Kargo client: %w", err)
+	}
+	kubernetes.ConfigureQPSBurst(ctx, restCfg, o.QPS, o.Burst)
+	restCfg.ContentType = runtime.ContentTypeJSON
+
+	scheme := runtime.NewScheme()
+	if err = corev1.AddToScheme(scheme); err != nil {
+		return nil, nil, fmt.Errorf("error adding Kubernetes core API to Kargo client scheme: %w", err)
+	}
+	if err = batchv1.AddToScheme(scheme); err != nil {
+		return nil, nil, fmt.Errorf("error adding Kubernetes batch API to Kargo client scheme: %w", err)
+	}
+	if err = kargoapi.AddToScheme(scheme); err != nil {
+		return nil, nil, fmt.Errorf("error adding Kargo API to Kargo client scheme: %w", err)
+	}
+
+	c, err := client.New(restCfg, client.Options{Scheme: scheme})
+	return restCfg, c, err
+}
+
+func (o *agentOptions) setupArgoCDClient(ctx context.Context) (client.Client, error) {
+	if !o.ArgoCDEnabled {
+		o.Logger.Info("Argo CD integration is disabled")
+		return nil, nil
+	}
+
+	// If the env var is undefined, this will resolve to kubeconfig for the
+	// cluster the agent is running in.
+	restCfg, err := kubernetes.GetRestConfig(ctx, o.ArgoCDKubeConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error loading REST config for Argo CD client: %w", err)
+	}
+	kubernetes.ConfigureQPSBurst(ctx, restCfg, o.QPS, o.Burst)
+	restCfg.ContentType = runtime.ContentTypeJSON
+
+	var exists bool
+	if exists, err = argoCDExists(ctx, restCfg, libargocd.Namespace()); !exists || err != nil {
+		if err != nil {
+			return nil, fmt.Errorf("unable to determine if Argo CD is installed: %w", err)
+		}
+		o.Logger.Info(
+			"Argo CD integration was enabled, but no Argo CD CRDs were found. " +
+				"Proceeding without Argo CD integration.",
+		)
+		return nil, nil
+	}
+
+	o.Logger.Info("Argo CD integration is enabled")
+
+	scheme := runtime.NewScheme()
+	if err = corev1.AddToScheme(scheme); err != nil {
+		return nil, fmt.Errorf("error adding Kubernetes core API to Argo CD client scheme: %w", err)
+	}
+	if err = argocd.AddToScheme(scheme); err != nil {
+		return nil, fmt.Errorf("error adding Argo CD API to Argo CD client scheme: %w", err)
+	}
+	return client.New(restCfg, client.Options{Scheme: scheme})
+}
+
+func (o *agentOptions) setupFluxClient(ctx context.Context) (client.Client, error) {
+	if !o.FluxEnabled {
+		o.Logger.Info("Flux integration is disabled")
+		return nil, nil
+	}
+
+	// Flux resources are always expected to live in the cluster the agent is
+	// running in.
+	restCfg, err := kubernetes.GetRestConfig(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("error loading REST config for Flux client: %w", err)
+	}
+	kubernetes.ConfigureQPSBurst(ctx, restCfg, o.QPS, o.Burst)
+	restCfg.ContentType = runtime.ContentTypeJSON
+
+	var exists bool
+	if exists, err = fluxExists(ctx, restCfg); !exists || err != nil {
+		if err != nil {
+			return nil, fmt.Errorf("unable to determine if Flux is installed: %w", err)
+		}
+		o.Logger.Info(
+			"Flux integration was enabled, but no Flux CRDs were found. " +
+				"Proceeding without Flux integration.",
+		)
+		return nil, nil
+	}
+
+	o.Logger.Info("Flux integration is enabled")
+
+	// Flux resources are only ever interacted with as unstructured objects, so
+	// no scheme is required.
+	return client.New(restCfg, client.Options{})
+}
@@ -8,6 +8,8 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/akuity/kargo/pkg/audit"
+	credsdb "github.com/akuity/kargo/pkg/credentials/kubernetes"
 	k8sevent "github.com/akuity/kargo/pkg/event/kubernetes"
 	"github.com/akuity/kargo/pkg/kubernetes/event"
 	"github.com/akuity/kargo/pkg/logging"
@@ -129,10 +131,22 @@ func (o *apiOptions) run(ctx context.Context) error {
 		)
 	}
 
+	auditor, err := audit.NewRecorderFromConfig(audit.ConfigFromEnv())
+	if err != nil {
+		return fmt.Errorf("error initializing audit log: %w", err)
+	}
+
 	srv := server.NewServer(
 		serverCfg,
 		kubeClient,
 		rbac.NewKubernetesRolesDatabase(kubeClient),
+		rbac.NewKubernetesAPITokensDatabase(kubeClient, serverCfg.APITokenSigningKey),
+		credsdb.NewDatabase(
+			ctx,
+			kubeClient.InternalClient(),
+			kubeClient.InternalClient(),
+			credsdb.DatabaseConfigFromEnv(),
+		),
 		k8sevent.NewEventSender(
 			event.NewRecorder(
 				ctx,
@@ -141,6 +155,7 @@ func (o *apiOptions) run(ctx context.Context) error {
 				"api",
 			),
 		),
+		auditor,
 	)
 	l, err := net.Listen("tcp", fmt.Sprintf("%s:%s", o.BindAddress, o.Port))
 	if err != nil {
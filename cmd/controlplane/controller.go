@@ -3,13 +3,16 @@ package main
 import (
 	"context"
 	"fmt"
+	"net"
 	stdruntime "runtime"
 	"sync"
 
 	"github.com/spf13/cobra"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	libkubernetes "k8s.io/client-go/kubernetes"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -20,8 +23,10 @@ import (
 	rollouts "github.com/akuity/kargo/api/stubs/rollouts/v1alpha1"
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	libargocd "github.com/akuity/kargo/pkg/argocd"
+	"github.com/akuity/kargo/pkg/audit"
 	"github.com/akuity/kargo/pkg/controller"
 	argocd "github.com/akuity/kargo/pkg/controller/argocd/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/controller/git"
 	"github.com/akuity/kargo/pkg/controller/promotions"
 	"github.com/akuity/kargo/pkg/controller/stages"
 	"github.com/akuity/kargo/pkg/controller/warehouses"
@@ -33,11 +38,11 @@ import (
 	"github.com/akuity/kargo/pkg/logging"
 	"github.com/akuity/kargo/pkg/os"
 	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/promotion/agent"
+	"github.com/akuity/kargo/pkg/promotion/runner/builtin"
 	"github.com/akuity/kargo/pkg/server/kubernetes"
 	"github.com/akuity/kargo/pkg/types"
 	versionpkg "github.com/akuity/kargo/pkg/x/version"
-
-	_ "github.com/akuity/kargo/pkg/promotion/runner/builtin"
 )
 
 type controllerOptions struct {
@@ -52,6 +57,10 @@ type controllerOptions struct {
 	ArgoCDKubeConfig    string
 	ArgoCDNamespaceOnly bool
 
+	FluxEnabled bool
+
+	RunJobStepEnabled bool
+
 	MetricsBindAddress string
 	PprofBindAddress   string
 
@@ -105,6 +114,10 @@ func (o *controllerOptions) complete() {
 	o.ArgoCDKubeConfig = os.GetEnv("ARGOCD_KUBECONFIG", "")
 	o.ArgoCDNamespaceOnly = types.MustParseBool(os.GetEnv("ARGOCD_WATCH_ARGOCD_NAMESPACE_ONLY", "false"))
 
+	o.FluxEnabled = types.MustParseBool(os.GetEnv("FLUX_INTEGRATION_ENABLED", "true"))
+
+	o.RunJobStepEnabled = types.MustParseBool(os.GetEnv("RUN_JOB_STEP_ENABLED", "false"))
+
 	o.MetricsBindAddress = os.GetEnv("METRICS_BIND_ADDRESS", "0")
 	o.PprofBindAddress = os.GetEnv("PPROF_BIND_ADDRESS", "")
 
@@ -127,6 +140,11 @@ func (o *controllerOptions) run(ctx context.Context) error {
 		return fmt.Errorf("error initializing Argo CD Application controller manager: %w", err)
 	}
 
+	fluxClient, err := o.setupFluxClient(ctx)
+	if err != nil {
+		return fmt.Errorf("error initializing Flux client: %w", err)
+	}
+
 	credentialsDB := credsdb.NewDatabase(
 		ctx,
 		kargoMgr.GetClient(),
@@ -138,6 +156,7 @@ func (o *controllerOptions) run(ctx context.Context) error {
 		ctx,
 		kargoMgr,
 		argocdMgr,
+		fluxClient,
 		credentialsDB,
 		stagesReconcilerCfg,
 	); err != nil {
@@ -172,6 +191,12 @@ func (o *controllerOptions) setupKargoManager(
 			err,
 		)
 	}
+	if err = batchv1.AddToScheme(scheme); err != nil {
+		return nil, nil, stagesReconcilerCfg, fmt.Errorf(
+			"error adding Kubernetes batch API to Kargo controller manager scheme: %w",
+			err,
+		)
+	}
 	if err = kargoapi.AddToScheme(scheme); err != nil {
 		return nil, nil, stagesReconcilerCfg, fmt.Errorf(
 			"error adding Kargo API to Kargo controller manager scheme: %w",
@@ -258,6 +283,11 @@ func (o *controllerOptions) setupKargoManager(
 						// ConfigMaps, but ConfigMaps have the potential to be quite large,
 						// so we prefer to not cache them.
 						&corev1.ConfigMap{},
+						// Jobs and Pods are only ever read by the run-job promotion
+						// step, which only needs a few of them at a time. Caching them
+						// would require watching all of them in all namespaces.
+						&batchv1.Job{},
+						&corev1.Pod{},
 					},
 				},
 			},
@@ -401,9 +431,49 @@ func (o *controllerOptions) setupArgoCDManager(ctx context.Context) (manager.Man
 	)
 }
 
+func (o *controllerOptions) setupFluxClient(ctx context.Context) (client.Client, error) {
+	if !o.FluxEnabled {
+		o.Logger.Info("Flux integration is disabled")
+		return nil, nil
+	}
+
+	// Flux resources are always expected to live in the cluster the controller
+	// is running in. In a sharded topology, this is the application cluster
+	// the shard is responsible for.
+	restCfg, err := kubernetes.GetRestConfig(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("error loading REST config for Flux client: %w", err)
+	}
+	kubernetes.ConfigureQPSBurst(ctx, restCfg, o.QPS, o.Burst)
+	restCfg.ContentType = runtime.ContentTypeJSON
+
+	var exists bool
+	if exists, err = fluxExists(ctx, restCfg); !exists || err != nil {
+		// If we are unable to determine if Flux is installed, we will return an
+		// error and fail to start the controller. Note this will only happen if
+		// we get an inconclusive response from the API server (e.g. due to
+		// network issues), and not if Flux is not installed.
+		if err != nil {
+			return nil, fmt.Errorf("unable to determine if Flux is installed: %w", err)
+		}
+		o.Logger.Info(
+			"Flux integration was enabled, but no Flux CRDs were found. " +
+				"Proceeding without Flux integration.",
+		)
+		return nil, nil
+	}
+
+	o.Logger.Info("Flux integration is enabled")
+
+	// Flux resources are only ever interacted with as unstructured objects, so
+	// no scheme or cache is required.
+	return client.New(restCfg, client.Options{})
+}
+
 func (o *controllerOptions) setupReconcilers(
 	ctx context.Context,
 	kargoMgr, argocdMgr manager.Manager,
+	fluxClient client.Client,
 	credentialsDB credentials.Database,
 	stagesReconcilerCfg stages.ReconcilerConfig,
 ) error {
@@ -412,21 +482,60 @@ func (o *controllerOptions) setupReconcilers(
 		argoCDClient = argocdMgr.GetClient()
 	}
 
-	healthCheckers.Initialize(argoCDClient)
+	healthCheckers.Initialize(argoCDClient, fluxClient)
 
 	sharedIndexer := indexer.NewSharedFieldIndexer(kargoMgr.GetFieldIndexer())
 
+	auditor, err := audit.NewRecorderFromConfig(audit.ConfigFromEnv())
+	if err != nil {
+		return fmt.Errorf("error initializing audit log: %w", err)
+	}
+
+	gitMirrorCache, err := git.NewMirrorCache(git.MirrorCacheConfigFromEnv())
+	if err != nil {
+		return fmt.Errorf("error initializing Git mirror cache: %w", err)
+	}
+	if gitMirrorCache != nil {
+		o.Logger.Info("Git mirror cache is enabled")
+	}
+	git.SetMirrorCache(gitMirrorCache)
+
+	if o.RunJobStepEnabled {
+		kubeClient, err := libkubernetes.NewForConfig(kargoMgr.GetConfig())
+		if err != nil {
+			return fmt.Errorf("error initializing Kubernetes client for run-job step: %w", err)
+		}
+		builtin.EnableRunJobStep(kubeClient.CoreV1())
+		o.Logger.Info("run-job promotion step is enabled")
+	}
+
+	// The steps of both Promotions and verifications may be dispatched to
+	// agents.
+	dispatcher, err := o.setupAgentServer(kargoMgr)
+	if err != nil {
+		return fmt.Errorf("error setting up agent server: %w", err)
+	}
+
 	if promotionsReconcilerCfg := promotions.ReconcilerConfigFromEnv(); promotionsReconcilerCfg.Enable {
-		if err := promotions.SetupReconcilerWithManager(
+		workspaces, err := promotion.NewDirWorkspaceStore(promotion.WorkspaceStoreConfigFromEnv())
+		if err != nil {
+			return fmt.Errorf("error initializing Promotion workspace store: %w", err)
+		}
+		if err = promotions.SetupReconcilerWithManager(
 			ctx,
 			kargoMgr,
 			argocdMgr,
 			promotion.NewLocalEngine(
 				kargoMgr.GetClient(),
 				argoCDClient,
+				fluxClient,
 				credentialsDB,
+				dispatcher,
+				promotion.NewKubernetesStepLogStore(kargoMgr.GetClient()),
 				promotion.DefaultExprDataCacheFn,
 			),
+			workspaces,
+			auditor,
 			promotionsReconcilerCfg,
 		); err != nil {
 			return fmt.Errorf("error setting up Promotions reconciler: %w", err)
@@ -436,6 +545,18 @@ func (o *controllerOptions) setupReconcilers(
 	if err := stages.NewRegularStageReconciler(
 		stagesReconcilerCfg,
 		health.NewAggregatingChecker(),
+		// Verification steps do not belong to a Promotion, so there is nowhere
+		// to store their logs.
+		promotion.NewLocalEngine(
+			kargoMgr.GetClient(),
+			argoCDClient,
+			fluxClient,
+			credentialsDB,
+			dispatcher,
+			nil,
+			promotion.DefaultExprDataCacheFn,
+		),
+		auditor,
 	).SetupWithManager(
 		ctx,
 		kargoMgr,
@@ -465,6 +586,29 @@ func (o *controllerOptions) setupReconcilers(
 	return nil
 }
 
+// setupAgentServer adds the agent server to the provided manager if it is
+// enabled and returns it as the promotion.StepDispatcher to dispatch the steps
+// of Promotions to agents with. If it is not enabled, nil is returned.
+func (o *controllerOptions) setupAgentServer(mgr manager.Manager) (promotion.StepDispatcher, error) {
+	cfg := agent.ServerConfigFromEnv()
+	if cfg.BindAddress == "" {
+		return nil, nil
+	}
+	srv := agent.NewServer(cfg, mgr.GetClient())
+	if err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		l, err := net.Listen("tcp", cfg.BindAddress)
+		if err != nil {
+			return fmt.Errorf("error creating listener: %w", err)
+		}
+		defer l.Close()
+		return srv.Serve(ctx, l)
+	})); err != nil {
+		return nil, err
+	}
+	o.Logger.Info("Agent server is enabled")
+	return srv, nil
+}
+
 func (o *controllerOptions) startManagers(ctx context.Context, kargoMgr, argocdMgr manager.Manager) error {
 	var (
 		errChan = make(chan error)
@@ -139,6 +139,15 @@ func (o *kubernetesWebhooksServerOptions) run(ctx context.Context) error {
 	); err != nil {
 		return fmt.Errorf("index Stages by Freight: %w", err)
 	}
+	// Index Freight by Warehouse
+	if err = mgr.GetFieldIndexer().IndexField(
+		ctx,
+		&kargoapi.Freight{},
+		indexer.FreightByWarehouseField,
+		indexer.FreightByWarehouse,
+	); err != nil {
+		return fmt.Errorf("index Freight by Warehouse: %w", err)
+	}
 
 	if err = clusterconfig.SetupWebhookWithManager(mgr); err != nil {
 		return fmt.Errorf("setup ClusterConfig webhook: %w", err)
@@ -19,6 +19,7 @@ var (
 )
 
 func Execute(ctx context.Context) error {
+	rootCmd.AddCommand(newAgentCommand())
 	rootCmd.AddCommand(newAPICommand())
 	rootCmd.AddCommand(newControllerCommand())
 	rootCmd.AddCommand(newExternalWebhooksServerCommand())
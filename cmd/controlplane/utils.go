@@ -35,6 +35,22 @@ func argoCDExists(
 	return false, client.IgnoreNotFound(err)
 }
 
+func fluxExists(ctx context.Context, restCfg *rest.Config) (bool, error) {
+	c, err := dynamic.NewForConfig(restCfg)
+	if err == nil {
+		if _, err = c.Resource(
+			schema.GroupVersionResource{
+				Group:    "kustomize.toolkit.fluxcd.io",
+				Version:  "v1",
+				Resource: "kustomizations",
+			},
+		).List(ctx, metav1.ListOptions{Limit: 1}); err == nil {
+			return true, nil
+		}
+	}
+	return false, client.IgnoreNotFound(err)
+}
+
 func argoRolloutsExists(ctx context.Context, restCfg *rest.Config) (bool, error) {
 	c, err := dynamic.NewForConfig(restCfg)
 	if err == nil {
@@ -189,6 +189,9 @@ protoc -I $temp_doc_dir \
   { msg "Generating .pb.go and .connect.go files from service.proto"; } 2> /dev/null
   buf generate . --path api/service
 
+  { msg "Generating .pb.go and .connect.go files from agent.proto"; } 2> /dev/null
+  buf generate . --path api/agent
+
   { msg "Generating TypeScript bindings for UI..."; } 2> /dev/null
   buf generate . --path api \
     --include-imports \
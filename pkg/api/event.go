@@ -17,13 +17,17 @@ func FormatEventControllerActor(name string) string {
 // that can be used as a value of AnnotationKeyEventActor.
 //
 // 1. If the user is admin, it returns EventActorAdmin since other information is not available.
-// 2. If the email is available, it returns email in "email:<email>" format.
-// 3. If the subject is available, it returns subject in "subject:<subject>" format.
-// 4. Otherwise, it returns EventActorUnknown.
+// 2. If the user authenticated with an API token, it returns the token in "api-token:<project>/<name>" format.
+// 3. If the email is available, it returns email in "email:<email>" format.
+// 4. If the subject is available, it returns subject in "subject:<subject>" format.
+// 5. Otherwise, it returns EventActorUnknown.
 func FormatEventUserActor(u user.Info) string {
 	if u.IsAdmin {
 		return kargoapi.EventActorAdmin
 	}
+	if u.APIToken != nil {
+		return kargoapi.EventActorAPITokenPrefix + u.APIToken.String()
+	}
 	if u.Username != "" {
 		return formatOIDCUsername(u)
 	}
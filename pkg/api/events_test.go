@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/pkg/server/user"
@@ -20,6 +21,16 @@ func TestFormatEventUserActor(t *testing.T) {
 			user:     user.Info{IsAdmin: true},
 			expected: kargoapi.EventActorAdmin,
 		},
+		{
+			name: "api-token",
+			user: user.Info{
+				APIToken: &types.NamespacedName{
+					Namespace: "fake-project",
+					Name:      "fake-token",
+				},
+			},
+			expected: kargoapi.EventActorAPITokenPrefix + "fake-project/fake-token",
+		},
 		{
 			name: "sub",
 			user: user.Info{
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"path"
 	"slices"
+	"sort"
 	"strings"
+	"time"
 
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -172,3 +174,133 @@ func ListFreightByCurrentStage(
 	}
 	return freightList.Items, nil
 }
+
+// GetFreightStalenessPolicy returns the staleness policy that applies to
+// Freight from the specified origin when it is to be promoted to the specified
+// Stage. This is the StalenessPolicy of the Stage's request for Freight from
+// the origin or, if the Stage is nil or its request does not specify one, the
+// FreightStalenessPolicy of the specified Warehouse, which may be nil if it no
+// longer exists. If neither specifies a policy, nil is returned.
+func GetFreightStalenessPolicy(
+	stage *kargoapi.Stage,
+	origin kargoapi.FreightOrigin,
+	warehouse *kargoapi.Warehouse,
+) *kargoapi.FreightStalenessPolicy {
+	if stage != nil {
+		for _, req := range stage.Spec.RequestedFreight {
+			if req.Origin.Equals(&origin) && req.StalenessPolicy != nil {
+				return req.StalenessPolicy
+			}
+		}
+	}
+	if warehouse != nil {
+		return warehouse.Spec.FreightStalenessPolicy
+	}
+	return nil
+}
+
+// GetStaleFreight returns the reasons the specified Freight is stale, indexed
+// by the names of the stale Freight. Freight is stale if it violates the
+// staleness policy returned by GetFreightStalenessPolicy for the Warehouse it
+// originated from and the specified Stage, which may be nil. Freight that has
+// been approved for the specified Stage with stale Freight explicitly allowed
+// is never stale.
+func GetStaleFreight(
+	ctx context.Context,
+	c client.Client,
+	stage *kargoapi.Stage,
+	freight []kargoapi.Freight,
+) (map[string]string, error) {
+	type originState struct {
+		policy *kargoapi.FreightStalenessPolicy
+		// created holds the creation times of all Freight from the origin in
+		// ascending order. It is only populated if the policy requires counting
+		// newer Freight.
+		created []time.Time
+	}
+	origins := map[string]*originState{}
+
+	stale := map[string]string{}
+	for i := range freight {
+		f := &freight[i]
+		if f.Origin.Kind != kargoapi.FreightOriginKindWarehouse {
+			continue
+		}
+		if stage != nil && f.IsStaleAllowedFor(stage.Name) {
+			continue
+		}
+
+		origin, ok := origins[f.Origin.Name]
+		if !ok {
+			warehouse, err := GetWarehouse(
+				ctx,
+				c,
+				types.NamespacedName{
+					Namespace: f.Namespace,
+					Name:      f.Origin.Name,
+				},
+			)
+			if err != nil {
+				return nil, err
+			}
+			origin = &originState{
+				policy: GetFreightStalenessPolicy(stage, f.Origin, warehouse),
+			}
+			if origin.policy != nil && origin.policy.SupersededBy > 0 {
+				if origin.created, err = listFreightCreationTimes(
+					ctx, c, f.Namespace, f.Origin.Name,
+				); err != nil {
+					return nil, err
+				}
+			}
+			origins[f.Origin.Name] = origin
+		}
+		if origin.policy == nil {
+			continue
+		}
+
+		// Count the Freight from the same origin created after this Freight
+		newer := len(origin.created) - sort.Search(
+			len(origin.created),
+			func(i int) bool {
+				return origin.created[i].After(f.CreationTimestamp.Time)
+			},
+		)
+		if reason := origin.policy.StaleReason(f, newer); reason != "" {
+			stale[f.Name] = reason
+		}
+	}
+	return stale, nil
+}
+
+// listFreightCreationTimes returns the creation times of all Freight from the
+// specified Warehouse in ascending order.
+func listFreightCreationTimes(
+	ctx context.Context,
+	c client.Client,
+	namespace string,
+	warehouse string,
+) ([]time.Time, error) {
+	freightList := kargoapi.FreightList{}
+	if err := c.List(
+		ctx,
+		&freightList,
+		client.InNamespace(namespace),
+		client.MatchingFields{"warehouse": warehouse},
+	); err != nil {
+		return nil, fmt.Errorf(
+			"error listing Freight for Warehouse %q in namespace %q: %w",
+			warehouse,
+			namespace,
+			err,
+		)
+	}
+	created := make([]time.Time, len(freightList.Items))
+	for i, f := range freightList.Items {
+		created[i] = f.CreationTimestamp.Time
+	}
+	slices.SortFunc(created, func(lhs, rhs time.Time) int {
+		return lhs.Compare(rhs)
+	})
+	return created, nil
+}
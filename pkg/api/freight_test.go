@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -255,3 +256,208 @@ func TestListFreightByCurrentStage(t *testing.T) {
 		})
 	}
 }
+
+func TestGetFreightStalenessPolicy(t *testing.T) {
+	warehousePolicy := &kargoapi.FreightStalenessPolicy{SupersededBy: 5}
+	stagePolicy := &kargoapi.FreightStalenessPolicy{SupersededBy: 2}
+	warehouse := &kargoapi.Warehouse{
+		ObjectMeta: metav1.ObjectMeta{Name: "fake-warehouse"},
+		Spec: kargoapi.WarehouseSpec{
+			FreightStalenessPolicy: warehousePolicy,
+		},
+	}
+	newStage := func(origin string, policy *kargoapi.FreightStalenessPolicy) *kargoapi.Stage {
+		return &kargoapi.Stage{
+			Spec: kargoapi.StageSpec{
+				RequestedFreight: []kargoapi.FreightRequest{{
+					Origin: kargoapi.FreightOrigin{
+						Kind: kargoapi.FreightOriginKindWarehouse,
+						Name: origin,
+					},
+					StalenessPolicy: policy,
+				}},
+			},
+		}
+	}
+
+	origin := kargoapi.FreightOrigin{
+		Kind: kargoapi.FreightOriginKindWarehouse,
+		Name: "fake-warehouse",
+	}
+
+	require.Nil(t, GetFreightStalenessPolicy(nil, origin, nil))
+	require.Same(t, warehousePolicy, GetFreightStalenessPolicy(nil, origin, warehouse))
+	require.Same(
+		t,
+		warehousePolicy,
+		GetFreightStalenessPolicy(newStage("fake-warehouse", nil), origin, warehouse),
+	)
+	require.Same(
+		t,
+		warehousePolicy,
+		GetFreightStalenessPolicy(newStage("other-warehouse", stagePolicy), origin, warehouse),
+	)
+	require.Same(
+		t,
+		stagePolicy,
+		GetFreightStalenessPolicy(newStage("fake-warehouse", stagePolicy), origin, warehouse),
+	)
+	// The Stage's policy applies even if the Warehouse no longer exists.
+	require.Same(
+		t,
+		stagePolicy,
+		GetFreightStalenessPolicy(newStage("fake-warehouse", stagePolicy), origin, nil),
+	)
+	require.Nil(t, GetFreightStalenessPolicy(newStage("fake-warehouse", nil), origin, nil))
+}
+
+func TestGetStaleFreight(t *testing.T) {
+	const testNamespace = "fake-namespace"
+	const testWarehouse = "fake-warehouse"
+
+	scheme := k8sruntime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	now := time.Now()
+	newFreight := func(name string, age time.Duration) kargoapi.Freight {
+		return kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         testNamespace,
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(now.Add(-age)),
+			},
+			Origin: kargoapi.FreightOrigin{
+				Kind: kargoapi.FreightOriginKindWarehouse,
+				Name: testWarehouse,
+			},
+		}
+	}
+	oldest := newFreight("oldest", 4*time.Hour)
+	oldest.Status.ApprovedFor = map[string]kargoapi.ApprovedStage{
+		"fake-stage": {AllowStale: true},
+	}
+	old := newFreight("old", 3*time.Hour)
+	old.Status.ApprovedFor = map[string]kargoapi.ApprovedStage{"fake-stage": {}}
+	freight := []kargoapi.Freight{
+		oldest,
+		old,
+		newFreight("new", 2*time.Hour),
+		newFreight("newest", time.Hour),
+	}
+
+	warehouse := &kargoapi.Warehouse{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      testWarehouse,
+		},
+		Spec: kargoapi.WarehouseSpec{
+			FreightStalenessPolicy: &kargoapi.FreightStalenessPolicy{
+				MaxAge: &metav1.Duration{Duration: 150 * time.Minute},
+			},
+		},
+	}
+	objects := []client.Object{warehouse}
+	for i := range freight {
+		objects = append(objects, &freight[i])
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(objects...).
+		WithIndex(&kargoapi.Freight{}, warehouseField, warehouseIndexer).
+		Build()
+
+	t.Run("Warehouse policy", func(t *testing.T) {
+		stale, err := GetStaleFreight(context.Background(), c, nil, freight)
+		require.NoError(t, err)
+		require.Equal(
+			t,
+			map[string]string{
+				"oldest": "Freight is older than 2h30m0s",
+				"old":    "Freight is older than 2h30m0s",
+			},
+			stale,
+		)
+	})
+
+	t.Run("Stage policy", func(t *testing.T) {
+		stage := &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testNamespace,
+				Name:      "fake-stage",
+			},
+			Spec: kargoapi.StageSpec{
+				RequestedFreight: []kargoapi.FreightRequest{{
+					Origin: kargoapi.FreightOrigin{
+						Kind: kargoapi.FreightOriginKindWarehouse,
+						Name: testWarehouse,
+					},
+					StalenessPolicy: &kargoapi.FreightStalenessPolicy{SupersededBy: 2},
+				}},
+			},
+		}
+		stale, err := GetStaleFreight(context.Background(), c, stage, freight)
+		require.NoError(t, err)
+		// The oldest Freight is approved for the Stage with stale Freight
+		// explicitly allowed, so it is not stale. The old Freight is merely
+		// approved for the Stage, which does not override the policy.
+		require.Equal(
+			t,
+			map[string]string{
+				"old": "Freight is superseded by 2 newer Freight",
+			},
+			stale,
+		)
+	})
+
+	t.Run("Stage policy without Warehouse", func(t *testing.T) {
+		objects := make([]client.Object, 0, len(freight))
+		for i := range freight {
+			objects = append(objects, &freight[i])
+		}
+		c := fake.NewClientBuilder().WithScheme(scheme).
+			WithObjects(objects...).
+			WithIndex(&kargoapi.Freight{}, warehouseField, warehouseIndexer).
+			Build()
+		stage := &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testNamespace,
+				Name:      "other-stage",
+			},
+			Spec: kargoapi.StageSpec{
+				RequestedFreight: []kargoapi.FreightRequest{{
+					Origin: kargoapi.FreightOrigin{
+						Kind: kargoapi.FreightOriginKindWarehouse,
+						Name: testWarehouse,
+					},
+					StalenessPolicy: &kargoapi.FreightStalenessPolicy{SupersededBy: 3},
+				}},
+			},
+		}
+		stale, err := GetStaleFreight(context.Background(), c, stage, freight)
+		require.NoError(t, err)
+		require.Equal(
+			t,
+			map[string]string{
+				"oldest": "Freight is superseded by 3 newer Freight",
+			},
+			stale,
+		)
+	})
+
+	t.Run("error getting Warehouse", func(t *testing.T) {
+		c := fake.NewClientBuilder().WithScheme(scheme).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Get: func(
+					context.Context,
+					client.WithWatch,
+					client.ObjectKey,
+					client.Object,
+					...client.GetOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			}).
+			Build()
+		_, err := GetStaleFreight(context.Background(), c, nil, freight)
+		require.ErrorContains(t, err, "something went wrong")
+	})
+}
@@ -6,10 +6,12 @@ import (
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/pattern"
 )
 
 // GetProjectConfig returns a pointer to the ProjectConfig resource specified by
@@ -62,3 +64,39 @@ func RefreshProjectConfig(
 	}
 	return config, nil
 }
+
+// StageMatchesPromotionPolicySelector returns whether the Stage with the
+// provided metadata is selected by the provided PromotionPolicySelector. A nil
+// selector matches all Stages.
+func StageMatchesPromotionPolicySelector(
+	selector *kargoapi.PromotionPolicySelector,
+	stage metav1.ObjectMeta,
+) (bool, error) {
+	if selector == nil {
+		return true, nil
+	}
+
+	// Match the Stage name with the name pattern of the selector.
+	if nameSelector := selector.Name; nameSelector != "" {
+		m, err := pattern.ParseNamePattern(nameSelector)
+		if err != nil {
+			return false, fmt.Errorf("error parsing name pattern %q: %w", nameSelector, err)
+		}
+		if !m.Matches(stage.Name) {
+			return false, nil
+		}
+	}
+
+	// Match the Stage labels with the label selector of the selector.
+	if labelSelector := selector.LabelSelector; labelSelector != nil {
+		s, err := metav1.LabelSelectorAsSelector(labelSelector)
+		if err != nil {
+			return false, fmt.Errorf("error parsing label selector %q: %w", labelSelector, err)
+		}
+		if !s.Matches(labels.Set(stage.Labels)) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
@@ -96,6 +96,41 @@ func AbortPromotion(
 	return patchAnnotation(ctx, c, promotion, kargoapi.AnnotationKeyAbort, ar.String())
 }
 
+// CanResumePromotion returns an error if the provided Promotion can not be
+// resumed from the step with the provided index. Only Failed and Errored
+// Promotions can be resumed, from any step up to and including the step at
+// which they stopped. Resuming from any step other than the first requires the
+// working directory of the Promotion to have been retained, as the steps that
+// are skipped may have produced files which subsequent steps depend on.
+func CanResumePromotion(promo *kargoapi.Promotion, step int64) error {
+	switch promo.Status.Phase {
+	case kargoapi.PromotionPhaseFailed, kargoapi.PromotionPhaseErrored:
+	default:
+		// nolint:staticcheck
+		return fmt.Errorf(
+			"Promotion %q in namespace %q is in phase %q; only %s and %s Promotions can be resumed",
+			promo.Name, promo.Namespace, promo.Status.Phase,
+			kargoapi.PromotionPhaseFailed, kargoapi.PromotionPhaseErrored,
+		)
+	}
+	if step < 0 || step > promo.Status.CurrentStep || step >= int64(len(promo.Spec.Steps)) {
+		// nolint:staticcheck
+		return fmt.Errorf(
+			"Promotion %q in namespace %q can only be resumed from steps 0 through %d",
+			promo.Name, promo.Namespace, min(promo.Status.CurrentStep, int64(len(promo.Spec.Steps))-1),
+		)
+	}
+	if step > 0 && (promo.Status.Workspace == nil || !promo.Status.Workspace.Retained) {
+		// nolint:staticcheck
+		return fmt.Errorf(
+			"working directory of Promotion %q in namespace %q no longer exists; "+
+				"it can only be resumed from step 0",
+			promo.Name, promo.Namespace,
+		)
+	}
+	return nil
+}
+
 // ComparePromotionByPhaseAndCreationTime compares two Promotions by their
 // phase and creation timestamp. It returns a negative value if Promotion `a`
 // should come before Promotion `b`, a positive value if Promotion `a` should
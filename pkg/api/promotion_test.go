@@ -136,6 +136,102 @@ func TestAbortPromotion(t *testing.T) {
 	})
 }
 
+func TestCanResumePromotion(t *testing.T) {
+	newPromotion := func(phase kargoapi.PromotionPhase, currentStep int64) *kargoapi.Promotion {
+		return &kargoapi.Promotion{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-namespace",
+				Name:      "fake-promotion",
+			},
+			Spec: kargoapi.PromotionSpec{
+				Steps: []kargoapi.PromotionStep{{Uses: "a"}, {Uses: "b"}, {Uses: "c"}},
+			},
+			Status: kargoapi.PromotionStatus{
+				Phase:       phase,
+				CurrentStep: currentStep,
+				Workspace:   &kargoapi.PromotionWorkspace{Retained: true},
+			},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		promotion *kargoapi.Promotion
+		step      int64
+		errorMsg  string
+	}{
+		{
+			name:      "Succeeded Promotion",
+			promotion: newPromotion(kargoapi.PromotionPhaseSucceeded, 2),
+			step:      2,
+			errorMsg:  "only Failed and Errored Promotions can be resumed",
+		},
+		{
+			name:      "Running Promotion",
+			promotion: newPromotion(kargoapi.PromotionPhaseRunning, 1),
+			step:      1,
+			errorMsg:  "only Failed and Errored Promotions can be resumed",
+		},
+		{
+			name:      "negative step",
+			promotion: newPromotion(kargoapi.PromotionPhaseFailed, 1),
+			step:      -1,
+			errorMsg:  "can only be resumed from steps 0 through 1",
+		},
+		{
+			name:      "step after the step at which the Promotion stopped",
+			promotion: newPromotion(kargoapi.PromotionPhaseFailed, 1),
+			step:      2,
+			errorMsg:  "can only be resumed from steps 0 through 1",
+		},
+		{
+			name:      "step after the last step",
+			promotion: newPromotion(kargoapi.PromotionPhaseErrored, 3),
+			step:      3,
+			errorMsg:  "can only be resumed from steps 0 through 2",
+		},
+		{
+			name: "working directory not retained",
+			promotion: func() *kargoapi.Promotion {
+				p := newPromotion(kargoapi.PromotionPhaseFailed, 1)
+				p.Status.Workspace = nil
+				return p
+			}(),
+			step:     1,
+			errorMsg: "it can only be resumed from step 0",
+		},
+		{
+			name: "working directory not retained resumed from the first step",
+			promotion: func() *kargoapi.Promotion {
+				p := newPromotion(kargoapi.PromotionPhaseFailed, 1)
+				p.Status.Workspace.Retained = false
+				return p
+			}(),
+			step: 0,
+		},
+		{
+			name:      "Failed Promotion resumed from the step at which it stopped",
+			promotion: newPromotion(kargoapi.PromotionPhaseFailed, 1),
+			step:      1,
+		},
+		{
+			name:      "Errored Promotion resumed from an earlier step",
+			promotion: newPromotion(kargoapi.PromotionPhaseErrored, 2),
+			step:      0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CanResumePromotion(tt.promotion, tt.step)
+			if tt.errorMsg != "" {
+				require.ErrorContains(t, err, tt.errorMsg)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func Test_ComparePromotionByPhaseAndCreationTime(t *testing.T) {
 	now := time.Date(2024, time.April, 10, 0, 0, 0, 0, time.UTC)
 	ulidEarlier := ulid.MustNew(ulid.Timestamp(now.Add(-time.Hour)), nil)
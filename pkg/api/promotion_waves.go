@@ -0,0 +1,256 @@
+package api
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// GetPromotionWavePolicyForStage returns a pointer to the first
+// PromotionWavePolicy in the provided ProjectConfig that selects the provided
+// Stage. If no such policy is found, nil is returned instead.
+func GetPromotionWavePolicyForStage(
+	projectCfg *kargoapi.ProjectConfig,
+	stage *kargoapi.Stage,
+) (*kargoapi.PromotionWavePolicy, error) {
+	if projectCfg == nil {
+		return nil, nil
+	}
+	for i := range projectCfg.Spec.PromotionWaves {
+		policy := &projectCfg.Spec.PromotionWaves[i]
+		matches, err := StageMatchesPromotionPolicySelector(
+			&policy.StageSelector,
+			stage.ObjectMeta,
+		)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error matching Stage %q with PromotionWavePolicy %q: %w",
+				stage.Name, policy.Name, err,
+			)
+		}
+		if matches {
+			return policy, nil
+		}
+	}
+	return nil, nil
+}
+
+// AssignPromotionWaves assigns the Stages selected by the provided
+// PromotionWavePolicy to its waves. The returned slice contains the names of
+// the Stages assigned to each wave, in the order of the policy's waves. Stages
+// are considered in order of their names, and are assigned to the first wave
+// they qualify for. Stages that do not qualify for any wave are assigned to
+// the last wave.
+func AssignPromotionWaves(
+	policy *kargoapi.PromotionWavePolicy,
+	stages []kargoapi.Stage,
+) ([][]string, error) {
+	selected := make([]*kargoapi.Stage, 0, len(stages))
+	for i := range stages {
+		stage := &stages[i]
+		matches, err := StageMatchesPromotionPolicySelector(
+			&policy.StageSelector,
+			stage.ObjectMeta,
+		)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error matching Stage %q with PromotionWavePolicy %q: %w",
+				stage.Name, policy.Name, err,
+			)
+		}
+		if matches {
+			selected = append(selected, stage)
+		}
+	}
+	slices.SortFunc(selected, func(a, b *kargoapi.Stage) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	assignments := make([][]string, len(policy.Waves))
+	assigned := make(map[string]struct{}, len(selected))
+	for i := range policy.Waves {
+		wave := &policy.Waves[i]
+		limit := len(selected)
+		if wave.Percent > 0 {
+			// Round up, so that a wave never ends up empty because of a small
+			// number of Stages.
+			limit = (len(selected)*int(wave.Percent) + 99) / 100
+		}
+		for _, stage := range selected {
+			if len(assignments[i]) >= limit {
+				break
+			}
+			if _, ok := assigned[stage.Name]; ok {
+				continue
+			}
+			matches, err := StageMatchesPromotionPolicySelector(
+				wave.StageSelector,
+				stage.ObjectMeta,
+			)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"error matching Stage %q with wave %q of PromotionWavePolicy %q: %w",
+					stage.Name, wave.Name, policy.Name, err,
+				)
+			}
+			if !matches {
+				continue
+			}
+			assignments[i] = append(assignments[i], stage.Name)
+			assigned[stage.Name] = struct{}{}
+		}
+	}
+
+	// Stages that did not qualify for any wave are assigned to the last one.
+	last := len(assignments) - 1
+	for _, stage := range selected {
+		if _, ok := assigned[stage.Name]; !ok && last >= 0 {
+			assignments[last] = append(assignments[last], stage.Name)
+		}
+	}
+
+	return assignments, nil
+}
+
+// GetPromotionWaveProgress returns the progress of the provided Freight through
+// the waves of the provided PromotionWavePolicy. The provided Stages should
+// include all Stages in the Project. If the provided Freight is nil, all waves
+// are reported as pending.
+func GetPromotionWaveProgress(
+	policy *kargoapi.PromotionWavePolicy,
+	stages []kargoapi.Stage,
+	freight *kargoapi.Freight,
+) (*kargoapi.PromotionWaveProgress, error) {
+	assignments, err := AssignPromotionWaves(policy, stages)
+	if err != nil {
+		return nil, err
+	}
+
+	stagesByName := make(map[string]*kargoapi.Stage, len(stages))
+	for i := range stages {
+		stagesByName[stages[i].Name] = &stages[i]
+	}
+
+	progress := &kargoapi.PromotionWaveProgress{
+		Policy: policy.Name,
+		Waves:  make([]kargoapi.PromotionWaveStatus, len(policy.Waves)),
+	}
+	if freight != nil {
+		progress.Freight = freight.Name
+	}
+
+	for i := range policy.Waves {
+		wave := &policy.Waves[i]
+		status := kargoapi.PromotionWaveStatus{
+			Name:   wave.Name,
+			Stages: assignments[i],
+		}
+
+		var reached, soaked int
+		for _, stageName := range assignments[i] {
+			if freight == nil {
+				continue
+			}
+			switch {
+			case freight.IsVerifiedIn(stageName):
+				status.VerifiedStages = append(status.VerifiedStages, stageName)
+				reached++
+				if freight.HasSoakedIn(stageName, wave.SoakTime) {
+					soaked++
+				}
+			case hasFailedVerificationIn(stagesByName[stageName], freight):
+				status.FailedStages = append(status.FailedStages, stageName)
+				reached++
+			case freight.IsCurrentlyIn(stageName):
+				reached++
+			}
+		}
+
+		failed := len(status.FailedStages)
+		verified := len(status.VerifiedStages)
+		total := len(assignments[i])
+		switch {
+		case failed >= int(wave.GetFailureThreshold()):
+			status.Phase = kargoapi.PromotionWavePhaseHalted
+			progress.Halted = true
+		case failed+soaked == total:
+			status.Phase = kargoapi.PromotionWavePhaseCompleted
+		case failed+verified == total:
+			status.Phase = kargoapi.PromotionWavePhaseSoaking
+		case reached > 0:
+			status.Phase = kargoapi.PromotionWavePhaseProgressing
+		default:
+			status.Phase = kargoapi.PromotionWavePhasePending
+		}
+
+		progress.Waves[i] = status
+	}
+
+	return progress, nil
+}
+
+// GetPromotionWaveHold returns a reason why the provided Freight may not
+// (yet) be automatically promoted to the Stage with the provided name
+// according to the provided PromotionWavePolicy. If the Freight may be
+// promoted, an empty string is returned. The provided Freight must not be nil.
+func GetPromotionWaveHold(
+	policy *kargoapi.PromotionWavePolicy,
+	stages []kargoapi.Stage,
+	stageName string,
+	freight *kargoapi.Freight,
+) (string, error) {
+	progress, err := GetPromotionWaveProgress(policy, stages, freight)
+	if err != nil {
+		return "", err
+	}
+	if !slices.ContainsFunc(progress.Waves, func(wave kargoapi.PromotionWaveStatus) bool {
+		return slices.Contains(wave.Stages, stageName)
+	}) {
+		// The Stage is not selected by the policy.
+		return "", nil
+	}
+	for _, wave := range progress.Waves {
+		if wave.Phase == kargoapi.PromotionWavePhaseHalted {
+			return fmt.Sprintf(
+				"rollout of Freight %q was halted in wave %q",
+				freight.Name, wave.Name,
+			), nil
+		}
+		if slices.Contains(wave.Stages, stageName) {
+			return "", nil
+		}
+		if wave.Phase != kargoapi.PromotionWavePhaseCompleted {
+			return fmt.Sprintf(
+				"Freight %q has not completed wave %q (%s)",
+				freight.Name, wave.Name, wave.Phase,
+			), nil
+		}
+	}
+	return "", nil
+}
+
+// hasFailedVerificationIn returns whether the most recent verification of the
+// provided Freight in the provided Stage failed.
+func hasFailedVerificationIn(stage *kargoapi.Stage, freight *kargoapi.Freight) bool {
+	if stage == nil {
+		return false
+	}
+	for _, collection := range stage.Status.FreightHistory {
+		if collection == nil {
+			continue
+		}
+		if !slices.ContainsFunc(collection.References(), func(ref kargoapi.FreightReference) bool {
+			return ref.Name == freight.Name
+		}) {
+			continue
+		}
+		if v := collection.VerificationHistory.Current(); v != nil {
+			return v.Phase == kargoapi.VerificationPhaseFailed ||
+				v.Phase == kargoapi.VerificationPhaseError
+		}
+		return false
+	}
+	return false
+}
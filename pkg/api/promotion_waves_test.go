@@ -0,0 +1,466 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestGetPromotionWavePolicyForStage(t *testing.T) {
+	stage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "prod-eu",
+			Labels: map[string]string{"env": "prod"},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		projectCfg *kargoapi.ProjectConfig
+		assertions func(*testing.T, *kargoapi.PromotionWavePolicy, error)
+	}{
+		{
+			name: "nil ProjectConfig",
+			assertions: func(t *testing.T, policy *kargoapi.PromotionWavePolicy, err error) {
+				require.NoError(t, err)
+				require.Nil(t, policy)
+			},
+		},
+		{
+			name: "no matching policy",
+			projectCfg: &kargoapi.ProjectConfig{
+				Spec: kargoapi.ProjectConfigSpec{
+					PromotionWaves: []kargoapi.PromotionWavePolicy{{
+						Name:          "test",
+						StageSelector: kargoapi.PromotionPolicySelector{Name: "test-*"},
+					}},
+				},
+			},
+			assertions: func(t *testing.T, policy *kargoapi.PromotionWavePolicy, err error) {
+				require.NoError(t, err)
+				require.Nil(t, policy)
+			},
+		},
+		{
+			name: "invalid selector",
+			projectCfg: &kargoapi.ProjectConfig{
+				Spec: kargoapi.ProjectConfigSpec{
+					PromotionWaves: []kargoapi.PromotionWavePolicy{{
+						Name:          "prod",
+						StageSelector: kargoapi.PromotionPolicySelector{Name: "regex:["},
+					}},
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.PromotionWavePolicy, err error) {
+				require.ErrorContains(t, err, "error matching Stage")
+			},
+		},
+		{
+			name: "first matching policy is returned",
+			projectCfg: &kargoapi.ProjectConfig{
+				Spec: kargoapi.ProjectConfigSpec{
+					PromotionWaves: []kargoapi.PromotionWavePolicy{
+						{
+							Name:          "test",
+							StageSelector: kargoapi.PromotionPolicySelector{Name: "test-*"},
+						},
+						{
+							Name: "prod",
+							StageSelector: kargoapi.PromotionPolicySelector{
+								LabelSelector: &metav1.LabelSelector{
+									MatchLabels: map[string]string{"env": "prod"},
+								},
+							},
+						},
+						{
+							Name:          "all",
+							StageSelector: kargoapi.PromotionPolicySelector{Name: "glob:*"},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, policy *kargoapi.PromotionWavePolicy, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, policy)
+				require.Equal(t, "prod", policy.Name)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			policy, err := GetPromotionWavePolicyForStage(testCase.projectCfg, stage)
+			testCase.assertions(t, policy, err)
+		})
+	}
+}
+
+func TestAssignPromotionWaves(t *testing.T) {
+	stages := []kargoapi.Stage{
+		{ObjectMeta: metav1.ObjectMeta{Name: "prod-e"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "prod-d"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "prod-c"}},
+		{ObjectMeta: metav1.ObjectMeta{
+			Name:   "prod-b",
+			Labels: map[string]string{"canary": "true"},
+		}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "prod-a"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "test"}},
+	}
+
+	testCases := []struct {
+		name       string
+		policy     *kargoapi.PromotionWavePolicy
+		assertions func(*testing.T, [][]string, error)
+	}{
+		{
+			name: "single wave",
+			policy: &kargoapi.PromotionWavePolicy{
+				StageSelector: kargoapi.PromotionPolicySelector{Name: "glob:prod-*"},
+				Waves:         []kargoapi.PromotionWave{{Name: "all"}},
+			},
+			assertions: func(t *testing.T, waves [][]string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[][]string{{"prod-a", "prod-b", "prod-c", "prod-d", "prod-e"}},
+					waves,
+				)
+			},
+		},
+		{
+			name: "selectors and percentages",
+			policy: &kargoapi.PromotionWavePolicy{
+				StageSelector: kargoapi.PromotionPolicySelector{Name: "glob:prod-*"},
+				Waves: []kargoapi.PromotionWave{
+					{
+						Name: "canary",
+						StageSelector: &kargoapi.PromotionPolicySelector{
+							LabelSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"canary": "true"},
+							},
+						},
+					},
+					{
+						Name:    "early",
+						Percent: 30,
+					},
+					{
+						Name:    "rest",
+						Percent: 1,
+					},
+				},
+			},
+			assertions: func(t *testing.T, waves [][]string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[][]string{
+						{"prod-b"},
+						{"prod-a", "prod-c"},
+						// One Stage qualifies, the others are left over.
+						{"prod-d", "prod-e"},
+					},
+					waves,
+				)
+			},
+		},
+		{
+			name: "invalid wave selector",
+			policy: &kargoapi.PromotionWavePolicy{
+				StageSelector: kargoapi.PromotionPolicySelector{Name: "glob:prod-*"},
+				Waves: []kargoapi.PromotionWave{{
+					Name:          "invalid",
+					StageSelector: &kargoapi.PromotionPolicySelector{Name: "regex:["},
+				}},
+			},
+			assertions: func(t *testing.T, _ [][]string, err error) {
+				require.ErrorContains(t, err, `with wave "invalid"`)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			waves, err := AssignPromotionWaves(testCase.policy, stages)
+			testCase.assertions(t, waves, err)
+		})
+	}
+}
+
+func TestGetPromotionWaveProgress(t *testing.T) {
+	policy := &kargoapi.PromotionWavePolicy{
+		Name:          "prod",
+		StageSelector: kargoapi.PromotionPolicySelector{Name: "glob:prod-*"},
+		Waves: []kargoapi.PromotionWave{
+			{
+				Name: "canary",
+				StageSelector: &kargoapi.PromotionPolicySelector{
+					Name: "prod-a",
+				},
+				SoakTime: &metav1.Duration{Duration: time.Hour},
+			},
+			{
+				Name:             "rest",
+				FailureThreshold: 2,
+			},
+		},
+	}
+	stages := []kargoapi.Stage{
+		{ObjectMeta: metav1.ObjectMeta{Name: "prod-a"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "prod-b"}},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "prod-c"},
+			Status: kargoapi.StageStatus{
+				FreightHistory: kargoapi.FreightHistory{{
+					Freight: map[string]kargoapi.FreightReference{
+						"Warehouse/test": {Name: "test-freight"},
+					},
+					VerificationHistory: kargoapi.VerificationInfoStack{{
+						Phase: kargoapi.VerificationPhaseFailed,
+					}},
+				}},
+			},
+		},
+	}
+
+	now := time.Now()
+	longAgo := metav1.NewTime(now.Add(-2 * time.Hour))
+	recently := metav1.NewTime(now.Add(-time.Minute))
+
+	testCases := []struct {
+		name       string
+		freight    *kargoapi.Freight
+		assertions func(*testing.T, *kargoapi.PromotionWaveProgress, error)
+	}{
+		{
+			name: "no Freight",
+			assertions: func(t *testing.T, progress *kargoapi.PromotionWaveProgress, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "prod", progress.Policy)
+				require.Empty(t, progress.Freight)
+				require.False(t, progress.Halted)
+				require.Len(t, progress.Waves, 2)
+				require.Equal(t, kargoapi.PromotionWavePhasePending, progress.Waves[0].Phase)
+				require.Equal(t, kargoapi.PromotionWavePhasePending, progress.Waves[1].Phase)
+				require.Equal(t, []string{"prod-b", "prod-c"}, progress.Waves[1].Stages)
+			},
+		},
+		{
+			name: "progressing",
+			freight: &kargoapi.Freight{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-freight"},
+				Status: kargoapi.FreightStatus{
+					CurrentlyIn: map[string]kargoapi.CurrentStage{
+						"prod-a": {Since: &recently},
+					},
+				},
+			},
+			assertions: func(t *testing.T, progress *kargoapi.PromotionWaveProgress, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "test-freight", progress.Freight)
+				require.Equal(t, kargoapi.PromotionWavePhaseProgressing, progress.Waves[0].Phase)
+				// One failure does not reach the threshold of two.
+				require.Equal(t, kargoapi.PromotionWavePhaseProgressing, progress.Waves[1].Phase)
+				require.Equal(t, []string{"prod-c"}, progress.Waves[1].FailedStages)
+				require.False(t, progress.Halted)
+			},
+		},
+		{
+			name: "soaking",
+			freight: &kargoapi.Freight{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-freight"},
+				Status: kargoapi.FreightStatus{
+					CurrentlyIn: map[string]kargoapi.CurrentStage{
+						"prod-a": {Since: &recently},
+					},
+					VerifiedIn: map[string]kargoapi.VerifiedStage{
+						"prod-a": {VerifiedAt: &recently},
+					},
+				},
+			},
+			assertions: func(t *testing.T, progress *kargoapi.PromotionWaveProgress, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionWavePhaseSoaking, progress.Waves[0].Phase)
+				require.Equal(t, []string{"prod-a"}, progress.Waves[0].VerifiedStages)
+			},
+		},
+		{
+			name: "completed",
+			freight: &kargoapi.Freight{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-freight"},
+				Status: kargoapi.FreightStatus{
+					CurrentlyIn: map[string]kargoapi.CurrentStage{
+						"prod-a": {Since: &longAgo},
+						"prod-b": {Since: &recently},
+					},
+					VerifiedIn: map[string]kargoapi.VerifiedStage{
+						"prod-a": {VerifiedAt: &longAgo},
+						"prod-b": {VerifiedAt: &recently},
+					},
+				},
+			},
+			assertions: func(t *testing.T, progress *kargoapi.PromotionWaveProgress, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionWavePhaseCompleted, progress.Waves[0].Phase)
+				// prod-b is verified and prod-c failed, which does not hold
+				// back the wave as long as the threshold is not reached.
+				require.Equal(t, kargoapi.PromotionWavePhaseCompleted, progress.Waves[1].Phase)
+				require.False(t, progress.Halted)
+			},
+		},
+		{
+			name: "failure below threshold",
+			freight: &kargoapi.Freight{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-freight"},
+			},
+			assertions: func(t *testing.T, progress *kargoapi.PromotionWaveProgress, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionWavePhasePending, progress.Waves[0].Phase)
+				require.Equal(t, kargoapi.PromotionWavePhaseProgressing, progress.Waves[1].Phase)
+				require.False(t, progress.Halted)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			progress, err := GetPromotionWaveProgress(policy, stages, testCase.freight)
+			testCase.assertions(t, progress, err)
+		})
+	}
+}
+
+func TestGetPromotionWaveHold(t *testing.T) {
+	policy := &kargoapi.PromotionWavePolicy{
+		Name:          "prod",
+		StageSelector: kargoapi.PromotionPolicySelector{Name: "glob:prod-*"},
+		Waves: []kargoapi.PromotionWave{
+			{
+				Name:          "canary",
+				StageSelector: &kargoapi.PromotionPolicySelector{Name: "prod-a"},
+			},
+			{Name: "rest"},
+		},
+	}
+	failedStage := func(name string) kargoapi.Stage {
+		return kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: kargoapi.StageStatus{
+				FreightHistory: kargoapi.FreightHistory{{
+					Freight: map[string]kargoapi.FreightReference{
+						"Warehouse/test": {Name: "test-freight"},
+					},
+					VerificationHistory: kargoapi.VerificationInfoStack{{
+						Phase: kargoapi.VerificationPhaseError,
+					}},
+				}},
+			},
+		}
+	}
+	verifiedIn := func(stages ...string) *kargoapi.Freight {
+		now := metav1.Now()
+		freight := &kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-freight"},
+			Status: kargoapi.FreightStatus{
+				VerifiedIn: map[string]kargoapi.VerifiedStage{},
+			},
+		}
+		for _, stage := range stages {
+			freight.Status.VerifiedIn[stage] = kargoapi.VerifiedStage{VerifiedAt: &now}
+		}
+		return freight
+	}
+
+	testCases := []struct {
+		name       string
+		stages     []kargoapi.Stage
+		stage      string
+		freight    *kargoapi.Freight
+		assertions func(*testing.T, string, error)
+	}{
+		{
+			name: "Stage in first wave",
+			stages: []kargoapi.Stage{
+				{ObjectMeta: metav1.ObjectMeta{Name: "prod-a"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "prod-b"}},
+			},
+			stage:   "prod-a",
+			freight: verifiedIn(),
+			assertions: func(t *testing.T, hold string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, hold)
+			},
+		},
+		{
+			name: "preceding wave not completed",
+			stages: []kargoapi.Stage{
+				{ObjectMeta: metav1.ObjectMeta{Name: "prod-a"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "prod-b"}},
+			},
+			stage:   "prod-b",
+			freight: verifiedIn(),
+			assertions: func(t *testing.T, hold string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, `Freight "test-freight" has not completed wave "canary" (Pending)`, hold)
+			},
+		},
+		{
+			name: "preceding wave completed",
+			stages: []kargoapi.Stage{
+				{ObjectMeta: metav1.ObjectMeta{Name: "prod-a"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "prod-b"}},
+			},
+			stage:   "prod-b",
+			freight: verifiedIn("prod-a"),
+			assertions: func(t *testing.T, hold string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, hold)
+			},
+		},
+		{
+			name:    "preceding wave halted",
+			stages:  []kargoapi.Stage{failedStage("prod-a"), {ObjectMeta: metav1.ObjectMeta{Name: "prod-b"}}},
+			stage:   "prod-b",
+			freight: verifiedIn(),
+			assertions: func(t *testing.T, hold string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, `rollout of Freight "test-freight" was halted in wave "canary"`, hold)
+			},
+		},
+		{
+			name: "own wave halted",
+			stages: []kargoapi.Stage{
+				{ObjectMeta: metav1.ObjectMeta{Name: "prod-a"}},
+				failedStage("prod-b"),
+				{ObjectMeta: metav1.ObjectMeta{Name: "prod-c"}},
+			},
+			stage:   "prod-c",
+			freight: verifiedIn("prod-a"),
+			assertions: func(t *testing.T, hold string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, `rollout of Freight "test-freight" was halted in wave "rest"`, hold)
+			},
+		},
+		{
+			name: "Stage not selected by policy",
+			stages: []kargoapi.Stage{
+				{ObjectMeta: metav1.ObjectMeta{Name: "prod-a"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "test"}},
+			},
+			stage:   "test",
+			freight: verifiedIn(),
+			assertions: func(t *testing.T, hold string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, hold)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			hold, err := GetPromotionWaveHold(policy, testCase.stages, testCase.stage, testCase.freight)
+			testCase.assertions(t, hold, err)
+		})
+	}
+}
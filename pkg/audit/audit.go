@@ -0,0 +1,177 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/akuity/kargo/pkg/api"
+	"github.com/akuity/kargo/pkg/logging"
+	"github.com/akuity/kargo/pkg/server/user"
+)
+
+// Action is the type of action recorded by an Entry.
+type Action string
+
+const (
+	// ActionPromote indicates a Promotion was created for a Stage, either by a
+	// user or automatically by a controller.
+	ActionPromote Action = "Promote"
+	// ActionPromotionCompleted indicates a Promotion reached a terminal phase.
+	ActionPromotionCompleted Action = "PromotionCompleted"
+	// ActionAbortPromotion indicates a request to abort a Promotion.
+	ActionAbortPromotion Action = "AbortPromotion"
+	// ActionApproveFreight indicates Freight was manually approved for a Stage.
+	ActionApproveFreight Action = "ApproveFreight"
+	// ActionUpdateFreightAlias indicates a piece of Freight's alias was
+	// changed.
+	ActionUpdateFreightAlias Action = "UpdateFreightAlias"
+	// ActionCreateFreight indicates Freight was manually created from a
+	// specific set of artifacts.
+	ActionCreateFreight Action = "CreateFreight"
+	// ActionGrantRole indicates a Kargo Role was granted to users or was
+	// granted additional permissions.
+	ActionGrantRole Action = "GrantRole"
+	// ActionRevokeRole indicates a Kargo Role was revoked from users or had
+	// permissions revoked.
+	ActionRevokeRole Action = "RevokeRole"
+	// ActionCreateAPIToken indicates an API token bound to a Kargo Role was
+	// created.
+	ActionCreateAPIToken Action = "CreateAPIToken"
+	// ActionDeleteAPIToken indicates an API token was revoked.
+	ActionDeleteAPIToken Action = "DeleteAPIToken"
+)
+
+// Entry is a single, immutable audit record describing who did what, to
+// which object, and when.
+type Entry struct {
+	// Time is when the action took place. If left unset, it is populated by the
+	// Recorder.
+	Time time.Time `json:"time"`
+	// Action is the type of action that took place.
+	Action Action `json:"action"`
+	// Actor describes who (or what) performed the action. If left unset, it is
+	// populated by the Recorder from the user.Info bound to the context, if
+	// any.
+	Actor Actor `json:"actor"`
+	// Project is the Project in which the action took place. This is empty for
+	// cluster-scoped actions.
+	Project string `json:"project,omitempty"`
+	// Object references the object that was acted upon.
+	Object ObjectReference `json:"object"`
+	// Details holds action-specific key/value pairs, e.g. the Freight that was
+	// promoted or the phase a Promotion concluded with.
+	Details map[string]string `json:"details,omitempty"`
+}
+
+// Actor describes who (or what) performed an audited action.
+type Actor struct {
+	// Name is the formatted name of the actor. It uses the same format as the
+	// actor recorded in Kargo's Kubernetes Events, e.g. "admin",
+	// "email:jane@example.com" or "controller:promotion-controller".
+	Name string `json:"name"`
+	// Admin is true if the actor is the Kargo API server's admin user.
+	Admin bool `json:"admin,omitempty"`
+	// UsernameClaim is the OIDC claim from which Username was extracted.
+	UsernameClaim string `json:"usernameClaim,omitempty"`
+	// Username is the username of the authenticated user, if known.
+	Username string `json:"username,omitempty"`
+	// Subject is the subject ("sub" claim) of the authenticated user, if any.
+	Subject string `json:"subject,omitempty"`
+	// Email is the email address ("email" claim) of the authenticated user, if
+	// any.
+	Email string `json:"email,omitempty"`
+	// Groups are the groups ("groups" claim) of the authenticated user, if
+	// any.
+	Groups []string `json:"groups,omitempty"`
+}
+
+// ObjectReference identifies the object an audited action was performed on.
+type ObjectReference struct {
+	// Kind is the kind of the object, e.g. "Promotion".
+	Kind string `json:"kind"`
+	// Name is the name of the object.
+	Name string `json:"name"`
+}
+
+// ActorFromUserInfo returns an Actor describing the user represented by the
+// provided user.Info. Of the user's OIDC claims, only the subject, email
+// address and groups are included. Credentials, such as the user's bearer
+// token, are never included.
+func ActorFromUserInfo(u user.Info) Actor {
+	actor := Actor{
+		Name:          api.FormatEventUserActor(u),
+		Admin:         u.IsAdmin,
+		UsernameClaim: u.UsernameClaim,
+		Username:      u.Username,
+	}
+	actor.Subject, _ = u.Claims["sub"].(string)
+	actor.Email, _ = u.Claims["email"].(string)
+	switch groups := u.Claims["groups"].(type) {
+	case []string:
+		actor.Groups = groups
+	case []any:
+		for _, group := range groups {
+			if g, ok := group.(string); ok {
+				actor.Groups = append(actor.Groups, g)
+			}
+		}
+	}
+	return actor
+}
+
+// ControllerActor returns an Actor representing the named controller.
+func ControllerActor(name string) Actor {
+	return Actor{Name: api.FormatEventControllerActor(name)}
+}
+
+// Sink is an append-only destination for audit Entries.
+type Sink interface {
+	// Write durably appends the provided Entry to the Sink, returning an error
+	// if it could not do so.
+	Write(ctx context.Context, entry Entry) error
+}
+
+// Recorder records audit Entries to zero or more Sinks. A nil *Recorder is
+// valid and discards all Entries.
+type Recorder struct {
+	sinks []Sink
+	nowFn func() time.Time
+}
+
+// NewRecorder returns a Recorder that fans Entries out to all the provided
+// Sinks.
+func NewRecorder(sinks ...Sink) *Recorder {
+	return &Recorder{
+		sinks: sinks,
+		nowFn: time.Now,
+	}
+}
+
+// Record completes the provided Entry and writes it to every Sink. Failure to
+// write to a Sink is logged, but never returned, so that the audited action is
+// not itself affected by a misbehaving Sink.
+func (r *Recorder) Record(ctx context.Context, entry Entry) {
+	if r == nil || len(r.sinks) == 0 {
+		return
+	}
+	if entry.Time.IsZero() {
+		entry.Time = r.nowFn().UTC()
+	}
+	if entry.Actor.Name == "" {
+		if u, ok := user.InfoFromContext(ctx); ok {
+			entry.Actor = ActorFromUserInfo(u)
+		}
+	}
+	logger := logging.LoggerFromContext(ctx)
+	for _, sink := range r.sinks {
+		if err := sink.Write(ctx, entry); err != nil {
+			logger.Error(
+				err, "error writing audit entry",
+				"action", entry.Action,
+				"project", entry.Project,
+				"kind", entry.Object.Kind,
+				"name", entry.Object.Name,
+			)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/server/user"
+)
+
+type mockSink struct {
+	entries []Entry
+	err     error
+}
+
+func (m *mockSink) Write(_ context.Context, entry Entry) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.entries = append(m.entries, entry)
+	return nil
+}
+
+func TestActorFromUserInfo(t *testing.T) {
+	testCases := []struct {
+		name     string
+		userInfo user.Info
+		expected Actor
+	}{
+		{
+			name:     "admin",
+			userInfo: user.Info{IsAdmin: true},
+			expected: Actor{
+				Name:  kargoapi.EventActorAdmin,
+				Admin: true,
+			},
+		},
+		{
+			name: "OIDC user",
+			userInfo: user.Info{
+				Claims: map[string]any{
+					"sub":    "fake-subject",
+					"email":  "jane@example.com",
+					"groups": []any{"devs", "ops"},
+					"phone":  "555-0100",
+				},
+				BearerToken:   "secret",
+				UsernameClaim: "email",
+				Username:      "jane@example.com",
+			},
+			expected: Actor{
+				Name:          "email:jane@example.com",
+				UsernameClaim: "email",
+				Username:      "jane@example.com",
+				Subject:       "fake-subject",
+				Email:         "jane@example.com",
+				Groups:        []string{"devs", "ops"},
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, ActorFromUserInfo(testCase.userInfo))
+		})
+	}
+}
+
+func TestControllerActor(t *testing.T) {
+	require.Equal(
+		t,
+		Actor{Name: kargoapi.EventActorControllerPrefix + "fake-controller"},
+		ControllerActor("fake-controller"),
+	)
+}
+
+func TestRecorder_Record(t *testing.T) {
+	testTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("nil recorder", func(t *testing.T) {
+		var r *Recorder
+		require.NotPanics(t, func() {
+			r.Record(context.Background(), Entry{Action: ActionPromote})
+		})
+	})
+
+	t.Run("completes entry from context", func(t *testing.T) {
+		sink := &mockSink{}
+		r := NewRecorder(sink)
+		r.nowFn = func() time.Time { return testTime }
+		ctx := user.ContextWithInfo(context.Background(), user.Info{IsAdmin: true})
+		r.Record(ctx, Entry{
+			Action:  ActionApproveFreight,
+			Project: "fake-project",
+			Object:  ObjectReference{Kind: "Freight", Name: "fake-freight"},
+		})
+		require.Equal(
+			t,
+			[]Entry{{
+				Time:    testTime,
+				Action:  ActionApproveFreight,
+				Actor:   Actor{Name: kargoapi.EventActorAdmin, Admin: true},
+				Project: "fake-project",
+				Object:  ObjectReference{Kind: "Freight", Name: "fake-freight"},
+			}},
+			sink.entries,
+		)
+	})
+
+	t.Run("explicit actor is preserved", func(t *testing.T) {
+		sink := &mockSink{}
+		r := NewRecorder(sink)
+		ctx := user.ContextWithInfo(context.Background(), user.Info{IsAdmin: true})
+		r.Record(ctx, Entry{
+			Time:   testTime,
+			Action: ActionPromote,
+			Actor:  ControllerActor("fake-controller"),
+		})
+		require.Len(t, sink.entries, 1)
+		require.Equal(t, testTime, sink.entries[0].Time)
+		require.Equal(t, ControllerActor("fake-controller"), sink.entries[0].Actor)
+	})
+
+	t.Run("failing sink does not affect others", func(t *testing.T) {
+		failingSink := &mockSink{err: errors.New("something went wrong")}
+		sink := &mockSink{}
+		r := NewRecorder(failingSink, sink)
+		r.Record(context.Background(), Entry{Action: ActionAbortPromotion})
+		require.Empty(t, failingSink.entries)
+		require.Len(t, sink.entries, 1)
+	})
+}
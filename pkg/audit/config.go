@@ -0,0 +1,62 @@
+package audit
+
+import (
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+)
+
+// Config represents configuration for audit logging.
+type Config struct {
+	// FileEnabled indicates whether audit Entries should be written as JSON
+	// lines to the file at FilePath.
+	FileEnabled bool `envconfig:"AUDIT_LOG_FILE_ENABLED"`
+	// FilePath is the path of the file audit Entries are appended to. If empty
+	// or "-", Entries are written to stdout.
+	FilePath string `envconfig:"AUDIT_LOG_FILE_PATH"`
+	// WebhookURL is the URL audit Entries are POSTed to. Webhook delivery is
+	// disabled if this is empty.
+	WebhookURL string `envconfig:"AUDIT_LOG_WEBHOOK_URL"`
+	// WebhookHeaders are HTTP headers included with every request to the
+	// webhook. These are typically used for authentication.
+	WebhookHeaders map[string]string `envconfig:"AUDIT_LOG_WEBHOOK_HEADERS"`
+	// WebhookTimeout is the maximum amount of time to wait for the webhook to
+	// respond.
+	WebhookTimeout time.Duration `envconfig:"AUDIT_LOG_WEBHOOK_TIMEOUT" default:"10s"`
+	// WebhookBufferSize is the maximum number of Entries waiting to be sent to
+	// the webhook. Entries are dropped while the buffer is full.
+	WebhookBufferSize int `envconfig:"AUDIT_LOG_WEBHOOK_BUFFER_SIZE" default:"1000"`
+}
+
+// ConfigFromEnv returns a Config populated from environment variables.
+func ConfigFromEnv() Config {
+	cfg := Config{}
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
+// NewRecorderFromConfig returns a Recorder writing to all Sinks enabled by the
+// provided Config. If no Sinks are enabled, the returned Recorder discards all
+// Entries.
+func NewRecorderFromConfig(cfg Config) (*Recorder, error) {
+	var sinks []Sink
+	if cfg.FileEnabled {
+		fileSink, err := NewFileSink(cfg.FilePath)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, fileSink)
+	}
+	if cfg.WebhookURL != "" {
+		sinks = append(
+			sinks,
+			NewWebhookSink(
+				cfg.WebhookURL,
+				cfg.WebhookHeaders,
+				cfg.WebhookTimeout,
+				cfg.WebhookBufferSize,
+			),
+		)
+	}
+	return NewRecorder(sinks...), nil
+}
@@ -0,0 +1,74 @@
+package audit
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Setenv("AUDIT_LOG_FILE_ENABLED", "true")
+	t.Setenv("AUDIT_LOG_FILE_PATH", "/var/log/kargo/audit.log")
+	t.Setenv("AUDIT_LOG_WEBHOOK_URL", "https://audit.example.com")
+	t.Setenv("AUDIT_LOG_WEBHOOK_HEADERS", "Authorization:Bearer fake-token")
+	require.Equal(
+		t,
+		Config{
+			FileEnabled:       true,
+			FilePath:          "/var/log/kargo/audit.log",
+			WebhookURL:        "https://audit.example.com",
+			WebhookHeaders:    map[string]string{"Authorization": "Bearer fake-token"},
+			WebhookTimeout:    10 * time.Second,
+			WebhookBufferSize: 1000,
+		},
+		ConfigFromEnv(),
+	)
+}
+
+func TestNewRecorderFromConfig(t *testing.T) {
+	testCases := []struct {
+		name       string
+		cfg        Config
+		assertions func(*testing.T, *Recorder, error)
+	}{
+		{
+			name: "no sinks enabled",
+			assertions: func(t *testing.T, r *Recorder, err error) {
+				require.NoError(t, err)
+				require.Empty(t, r.sinks)
+			},
+		},
+		{
+			name: "all sinks enabled",
+			cfg: Config{
+				FileEnabled: true,
+				FilePath:    filepath.Join(t.TempDir(), "audit.log"),
+				WebhookURL:  "https://audit.example.com",
+			},
+			assertions: func(t *testing.T, r *Recorder, err error) {
+				require.NoError(t, err)
+				require.Len(t, r.sinks, 2)
+				require.IsType(t, &WriterSink{}, r.sinks[0])
+				require.IsType(t, &WebhookSink{}, r.sinks[1])
+			},
+		},
+		{
+			name: "error opening file",
+			cfg: Config{
+				FileEnabled: true,
+				FilePath:    filepath.Join(t.TempDir(), "missing", "audit.log"),
+			},
+			assertions: func(t *testing.T, _ *Recorder, err error) {
+				require.ErrorContains(t, err, "error opening audit log file")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r, err := NewRecorderFromConfig(testCase.cfg)
+			testCase.assertions(t, r, err)
+		})
+	}
+}
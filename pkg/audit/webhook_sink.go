@@ -0,0 +1,121 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/akuity/kargo/pkg/logging"
+)
+
+var webhookEntriesDropped = prometheus.NewCounter(
+	prometheus.CounterOpts{
+		Name: "kargo_audit_webhook_entries_dropped_total",
+		Help: "Number of audit entries dropped because the audit webhook's buffer was full.",
+	},
+)
+
+func init() {
+	metrics.Registry.MustRegister(webhookEntriesDropped)
+}
+
+// errWebhookBufferFull is returned by WebhookSink.Write when an Entry is
+// dropped because the buffer of Entries waiting to be sent is full.
+var errWebhookBufferFull = errors.New("audit webhook buffer is full: entry dropped")
+
+// WebhookSink is a Sink that POSTs each Entry as a JSON document to a
+// configured URL. Entries are sent in the background, so the audited action is
+// never held up by a slow webhook.
+type WebhookSink struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+	entries chan Entry
+}
+
+// NewWebhookSink returns a Sink that POSTs Entries to the specified URL,
+// including the provided HTTP headers with every request. Requests are
+// abandoned if they take longer than the specified timeout. Up to bufferSize
+// Entries are held while waiting to be sent. Entries written while the buffer
+// is full are dropped.
+func NewWebhookSink(
+	url string,
+	headers map[string]string,
+	timeout time.Duration,
+	bufferSize int,
+) *WebhookSink {
+	s := &WebhookSink{
+		url:     url,
+		headers: headers,
+		client:  &http.Client{Timeout: timeout},
+		entries: make(chan Entry, bufferSize),
+	}
+	go s.run()
+	return s
+}
+
+// Write implements Sink. It queues the provided Entry to be sent and returns
+// an error if the Entry had to be dropped because the buffer is full.
+func (s *WebhookSink) Write(_ context.Context, entry Entry) error {
+	select {
+	case s.entries <- entry:
+		return nil
+	default:
+		webhookEntriesDropped.Inc()
+		return errWebhookBufferFull
+	}
+}
+
+// run sends queued Entries one at a time. Failure to send an Entry is logged.
+func (s *WebhookSink) run() {
+	logger := logging.LoggerFromContext(context.Background())
+	for entry := range s.entries {
+		if err := s.send(entry); err != nil {
+			logger.Error(
+				err, "error sending audit entry to webhook",
+				"action", entry.Action,
+				"project", entry.Project,
+				"kind", entry.Object.Kind,
+				"name", entry.Object.Name,
+			)
+		}
+	}
+}
+
+// send POSTs the provided Entry to the webhook.
+func (s *WebhookSink) send(entry Entry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("error marshaling audit entry: %w", err)
+	}
+	req, err := http.NewRequest(http.MethodPost, s.url, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("error creating audit webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range s.headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending audit entry to webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	// Drain the body so the underlying connection can be reused.
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf(
+			"audit webhook responded with unexpected status code %d",
+			resp.StatusCode,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookSink_Write(t *testing.T) {
+	t.Run("entry is sent", func(t *testing.T) {
+		type request struct {
+			req   *http.Request
+			entry Entry
+		}
+		requests := make(chan request, 1)
+		srv := httptest.NewServer(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var entry Entry
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&entry))
+				requests <- request{req: r, entry: entry}
+				w.WriteHeader(http.StatusNoContent)
+			}),
+		)
+		t.Cleanup(srv.Close)
+		sink := NewWebhookSink(
+			srv.URL,
+			map[string]string{"Authorization": "Bearer fake-token"},
+			time.Second,
+			10,
+		)
+		require.NoError(t, sink.Write(context.Background(), Entry{
+			Action:  ActionApproveFreight,
+			Project: "fake-project",
+		}))
+		select {
+		case received := <-requests:
+			require.Equal(t, http.MethodPost, received.req.Method)
+			require.Equal(t, "application/json", received.req.Header.Get("Content-Type"))
+			require.Equal(t, "Bearer fake-token", received.req.Header.Get("Authorization"))
+			require.Equal(t, ActionApproveFreight, received.entry.Action)
+			require.Equal(t, "fake-project", received.entry.Project)
+		case <-time.After(10 * time.Second):
+			t.Fatal("entry was not sent to webhook")
+		}
+	})
+
+	t.Run("entry is dropped when buffer is full", func(t *testing.T) {
+		received := make(chan struct{}, 1)
+		release := make(chan struct{})
+		srv := httptest.NewServer(
+			http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				received <- struct{}{}
+				<-release
+				w.WriteHeader(http.StatusNoContent)
+			}),
+		)
+		t.Cleanup(srv.Close)
+		t.Cleanup(func() { close(release) })
+		sink := NewWebhookSink(srv.URL, nil, 10*time.Second, 1)
+
+		// The first entry is being sent, which blocks until released
+		require.NoError(t, sink.Write(context.Background(), Entry{Action: ActionPromote}))
+		select {
+		case <-received:
+		case <-time.After(10 * time.Second):
+			t.Fatal("entry was not sent to webhook")
+		}
+		// The second entry waits in the buffer
+		require.NoError(t, sink.Write(context.Background(), Entry{Action: ActionPromote}))
+		// The third entry does not fit
+		dropped := testutil.ToFloat64(webhookEntriesDropped)
+		err := sink.Write(context.Background(), Entry{Action: ActionPromote})
+		require.ErrorIs(t, err, errWebhookBufferFull)
+		require.Equal(t, dropped+1, testutil.ToFloat64(webhookEntriesDropped))
+	})
+}
+
+func TestWebhookSink_send(t *testing.T) {
+	srv := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}),
+	)
+	t.Cleanup(srv.Close)
+	sink := &WebhookSink{url: srv.URL, client: &http.Client{Timeout: time.Second}}
+	require.ErrorContains(
+		t,
+		sink.send(Entry{Action: ActionApproveFreight}),
+		"unexpected status code 500",
+	)
+}
@@ -0,0 +1,52 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// WriterSink is a Sink that writes each Entry as a single line of JSON to an
+// underlying io.Writer.
+type WriterSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewWriterSink returns a Sink that writes Entries, one JSON document per line,
+// to the provided io.Writer.
+func NewWriterSink(w io.Writer) *WriterSink {
+	return &WriterSink{w: w}
+}
+
+// NewFileSink returns a Sink that appends Entries, one JSON document per line,
+// to the file at the specified path, creating it if it does not exist. If the
+// path is empty or "-", Entries are written to stdout instead.
+func NewFileSink(path string) (*WriterSink, error) {
+	if path == "" || path == "-" {
+		return NewWriterSink(os.Stdout), nil
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("error opening audit log file %q: %w", path, err)
+	}
+	return NewWriterSink(f), nil
+}
+
+// Write implements Sink.
+func (s *WriterSink) Write(_ context.Context, entry Entry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("error marshaling audit entry: %w", err)
+	}
+	data = append(data, '\n')
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err = s.w.Write(data); err != nil {
+		return fmt.Errorf("error writing audit entry: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriterSink_Write(t *testing.T) {
+	buf := &bytes.Buffer{}
+	sink := NewWriterSink(buf)
+	testTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, action := range []Action{ActionPromote, ActionAbortPromotion} {
+		require.NoError(t, sink.Write(context.Background(), Entry{
+			Time:    testTime,
+			Action:  action,
+			Actor:   Actor{Name: "admin", Admin: true},
+			Project: "fake-project",
+			Object:  ObjectReference{Kind: "Promotion", Name: "fake-promotion"},
+		}))
+	}
+	require.Equal(
+		t,
+		`{"time":"2024-01-01T00:00:00Z","action":"Promote","actor":{"name":"admin","admin":true},`+
+			`"project":"fake-project","object":{"kind":"Promotion","name":"fake-promotion"}}`+"\n"+
+			`{"time":"2024-01-01T00:00:00Z","action":"AbortPromotion","actor":{"name":"admin","admin":true},`+
+			`"project":"fake-project","object":{"kind":"Promotion","name":"fake-promotion"}}`+"\n",
+		buf.String(),
+	)
+}
+
+func TestNewFileSink(t *testing.T) {
+	t.Run("stdout", func(t *testing.T) {
+		sink, err := NewFileSink("-")
+		require.NoError(t, err)
+		require.Same(t, os.Stdout, sink.w)
+	})
+
+	t.Run("appends to file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "audit.log")
+		require.NoError(t, os.WriteFile(path, []byte("existing\n"), 0o600))
+		sink, err := NewFileSink(path)
+		require.NoError(t, err)
+		require.NoError(t, sink.Write(context.Background(), Entry{Action: ActionPromote}))
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.Contains(t, string(data), "existing\n")
+		require.Contains(t, string(data), `"action":"Promote"`)
+	})
+
+	t.Run("error opening file", func(t *testing.T) {
+		_, err := NewFileSink(filepath.Join(t.TempDir(), "missing", "audit.log"))
+		require.ErrorContains(t, err, "error opening audit log file")
+	})
+}
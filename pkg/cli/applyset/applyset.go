@@ -0,0 +1,152 @@
+// Package applyset implements tracking of the set of resources declaratively
+// managed within a Project by `kargo apply`, so that resources which are no
+// longer present in the applied manifests can be pruned.
+package applyset
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	"github.com/akuity/kargo/api/service/v1alpha1/svcv1alpha1connect"
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// DefaultName is the name of the apply set resources belong to when no other
+// name has been specified.
+const DefaultName = "default"
+
+// clusterScopedKinds are the kinds of resources that may appear in manifests
+// applied to a Project, but which do not belong to any namespace.
+var clusterScopedKinds = map[schema.GroupKind]struct{}{
+	{Group: kargoapi.GroupVersion.Group, Kind: "ClusterConfig"}:        {},
+	{Group: kargoapi.GroupVersion.Group, Kind: "ClusterPromotionTask"}: {},
+	{Group: kargoapi.GroupVersion.Group, Kind: "Project"}:              {},
+	{Group: "", Kind: "Namespace"}:                                     {},
+}
+
+// Label marks each of the provided objects as belonging to the apply set with
+// the provided name.
+func Label(name string, objs ...*unstructured.Unstructured) {
+	for _, obj := range objs {
+		labels := obj.GetLabels()
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[kargoapi.LabelKeyApplySet] = name
+		obj.SetLabels(labels)
+	}
+}
+
+// DefaultNamespace sets the namespace of each of the provided objects of a
+// namespaced kind which does not specify one to the namespace of the provided
+// Project.
+func DefaultNamespace(project string, objs ...*unstructured.Unstructured) {
+	for _, obj := range objs {
+		if obj.GetNamespace() != "" {
+			continue
+		}
+		if _, ok := clusterScopedKinds[obj.GroupVersionKind().GroupKind()]; ok {
+			continue
+		}
+		obj.SetNamespace(project)
+	}
+}
+
+// PruneCandidates returns the Stages, PromotionTasks and Warehouses in the
+// provided Project which belong to the apply set with the provided name, but
+// are absent from the provided desired objects. The candidates are returned in
+// an order that is safe for deletion, i.e. Stages before the PromotionTasks and
+// Warehouses they may reference. Desired objects of a namespaced kind which do
+// not specify a namespace are matched as if they specified the namespace of
+// the Project. The provided desired objects are not modified.
+func PruneCandidates(
+	ctx context.Context,
+	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
+	project string,
+	name string,
+	desired []*unstructured.Unstructured,
+) ([]*unstructured.Unstructured, error) {
+	var live []runtime.Object
+
+	stagesRes, err := kargoSvcCli.ListStages(ctx, connect.NewRequest(&svcv1alpha1.ListStagesRequest{
+		Project: project,
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("list stages: %w", err)
+	}
+	for _, stage := range stagesRes.Msg.GetStages() {
+		stage.SetGroupVersionKind(kargoapi.GroupVersion.WithKind("Stage"))
+		live = append(live, stage)
+	}
+
+	tasksRes, err := kargoSvcCli.ListPromotionTasks(ctx, connect.NewRequest(&svcv1alpha1.ListPromotionTasksRequest{
+		Project: project,
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("list promotion tasks: %w", err)
+	}
+	for _, task := range tasksRes.Msg.GetPromotionTasks() {
+		task.SetGroupVersionKind(kargoapi.GroupVersion.WithKind("PromotionTask"))
+		live = append(live, task)
+	}
+
+	warehousesRes, err := kargoSvcCli.ListWarehouses(ctx, connect.NewRequest(&svcv1alpha1.ListWarehousesRequest{
+		Project: project,
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("list warehouses: %w", err)
+	}
+	for _, warehouse := range warehousesRes.Msg.GetWarehouses() {
+		warehouse.SetGroupVersionKind(kargoapi.GroupVersion.WithKind("Warehouse"))
+		live = append(live, warehouse)
+	}
+
+	liveObjs := make([]*unstructured.Unstructured, 0, len(live))
+	for _, obj := range live {
+		u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return nil, fmt.Errorf("convert %T to unstructured: %w", obj, err)
+		}
+		liveObjs = append(liveObjs, &unstructured.Unstructured{Object: u})
+	}
+	// Namespaces are defaulted on copies, so the caller's objects are left
+	// untouched.
+	desiredCopies := make([]*unstructured.Unstructured, len(desired))
+	for i, obj := range desired {
+		desiredCopies[i] = obj.DeepCopy()
+	}
+	DefaultNamespace(project, desiredCopies...)
+	return unmatched(name, liveObjs, desiredCopies), nil
+}
+
+// unmatched returns the live objects belonging to the apply set with the
+// provided name for which there is no corresponding desired object.
+func unmatched(name string, live, desired []*unstructured.Unstructured) []*unstructured.Unstructured {
+	desiredKeys := make(map[string]struct{}, len(desired))
+	for _, obj := range desired {
+		desiredKeys[key(obj)] = struct{}{}
+	}
+	var res []*unstructured.Unstructured
+	for _, obj := range live {
+		if obj.GetLabels()[kargoapi.LabelKeyApplySet] != name {
+			continue
+		}
+		if _, ok := desiredKeys[key(obj)]; ok {
+			continue
+		}
+		res = append(res, obj)
+	}
+	return res
+}
+
+// key returns a key uniquely identifying the provided object.
+func key(obj *unstructured.Unstructured) string {
+	gvk := obj.GroupVersionKind()
+	return fmt.Sprintf("%s/%s/%s/%s", gvk.Group, gvk.Kind, obj.GetNamespace(), obj.GetName())
+}
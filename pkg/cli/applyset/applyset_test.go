@@ -0,0 +1,136 @@
+package applyset
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	"github.com/akuity/kargo/api/service/v1alpha1/svcv1alpha1connect"
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestLabel(t *testing.T) {
+	obj := newObject("Stage", "fake-stage")
+	obj.SetLabels(map[string]string{"foo": "bar"})
+	Label("fake-set", obj, newObject("Warehouse", "fake-warehouse"))
+	require.Equal(
+		t,
+		map[string]string{
+			"foo":                     "bar",
+			kargoapi.LabelKeyApplySet: "fake-set",
+		},
+		obj.GetLabels(),
+	)
+}
+
+func TestDefaultNamespace(t *testing.T) {
+	stage := newObject("Stage", "fake-stage")
+	stage.SetNamespace("")
+	otherProjectStage := newObject("Stage", "other-stage")
+	otherProjectStage.SetNamespace("other-project")
+	project := newObject("Project", "fake-project")
+	project.SetNamespace("")
+	DefaultNamespace("fake-project", stage, otherProjectStage, project)
+	require.Equal(t, "fake-project", stage.GetNamespace())
+	require.Equal(t, "other-project", otherProjectStage.GetNamespace())
+	require.Empty(t, project.GetNamespace())
+}
+
+func Test_unmatched(t *testing.T) {
+	managedStage := newObject("Stage", "managed")
+	managedWarehouse := newObject("Warehouse", "managed")
+	removedStage := newObject("Stage", "removed")
+	otherSetStage := newObject("Stage", "other-set")
+	Label("fake-set", managedStage, managedWarehouse, removedStage)
+	Label("other-set", otherSetStage)
+
+	res := unmatched(
+		"fake-set",
+		[]*unstructured.Unstructured{
+			managedStage,
+			managedWarehouse,
+			removedStage,
+			otherSetStage,
+			newObject("Stage", "unmanaged"),
+		},
+		[]*unstructured.Unstructured{
+			newObject("Stage", "managed"),
+			newObject("Warehouse", "managed"),
+		},
+	)
+	require.Equal(t, []*unstructured.Unstructured{removedStage}, res)
+}
+
+func TestPruneCandidates(t *testing.T) {
+	newStage := func(name string) *kargoapi.Stage {
+		return &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-project",
+				Name:      name,
+				Labels:    map[string]string{kargoapi.LabelKeyApplySet: "fake-set"},
+			},
+		}
+	}
+	cli := &fakeKargoServiceClient{
+		stages: []*kargoapi.Stage{newStage("managed"), newStage("removed")},
+	}
+
+	// The manifest of the managed Stage does not specify a namespace, but
+	// still refers to the live Stage in the Project.
+	desired := newObject("Stage", "managed")
+	desired.SetNamespace("")
+
+	res, err := PruneCandidates(
+		context.Background(),
+		cli,
+		"fake-project",
+		"fake-set",
+		[]*unstructured.Unstructured{desired},
+	)
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	require.Equal(t, "removed", res[0].GetName())
+	// The caller's object is not modified
+	require.Empty(t, desired.GetNamespace())
+}
+
+func newObject(kind, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(kargoapi.GroupVersion.WithKind(kind))
+	obj.SetNamespace("fake-project")
+	obj.SetName(name)
+	return obj
+}
+
+// fakeKargoServiceClient is a KargoServiceClient which lists fixed Stages and
+// no PromotionTasks or Warehouses.
+type fakeKargoServiceClient struct {
+	svcv1alpha1connect.KargoServiceClient
+	stages []*kargoapi.Stage
+}
+
+func (f *fakeKargoServiceClient) ListStages(
+	context.Context,
+	*connect.Request[svcv1alpha1.ListStagesRequest],
+) (*connect.Response[svcv1alpha1.ListStagesResponse], error) {
+	return connect.NewResponse(&svcv1alpha1.ListStagesResponse{Stages: f.stages}), nil
+}
+
+func (f *fakeKargoServiceClient) ListPromotionTasks(
+	context.Context,
+	*connect.Request[svcv1alpha1.ListPromotionTasksRequest],
+) (*connect.Response[svcv1alpha1.ListPromotionTasksResponse], error) {
+	return connect.NewResponse(&svcv1alpha1.ListPromotionTasksResponse{}), nil
+}
+
+func (f *fakeKargoServiceClient) ListWarehouses(
+	context.Context,
+	*connect.Request[svcv1alpha1.ListWarehousesRequest],
+) (*connect.Response[svcv1alpha1.ListWarehousesResponse], error) {
+	return connect.NewResponse(&svcv1alpha1.ListWarehousesResponse{}), nil
+}
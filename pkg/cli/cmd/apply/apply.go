@@ -14,6 +14,8 @@ import (
 	sigyaml "sigs.k8s.io/yaml"
 
 	kargosvcapi "github.com/akuity/kargo/api/service/v1alpha1"
+	"github.com/akuity/kargo/api/service/v1alpha1/svcv1alpha1connect"
+	"github.com/akuity/kargo/pkg/cli/applyset"
 	"github.com/akuity/kargo/pkg/cli/client"
 	"github.com/akuity/kargo/pkg/cli/config"
 	"github.com/akuity/kargo/pkg/cli/io"
@@ -31,6 +33,10 @@ type applyOptions struct {
 
 	Filenames []string
 	Recursive bool
+	Project   string
+	Prune     bool
+	ApplySet  string
+	DryRun    bool
 }
 
 func NewCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
@@ -41,7 +47,7 @@ func NewCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra
 	}
 
 	cmd := &cobra.Command{
-		Use:   "apply -f FILENAME",
+		Use:   "apply -f FILENAME [--prune [--project=project] [--apply-set=name]] [--dry-run]",
 		Short: "Apply a resource from a file or from stdin",
 		Args:  option.NoArgs,
 		Example: templates.Example(`
@@ -50,6 +56,15 @@ kargo apply -f stage.yaml
 
 # Apply the YAML resources in the stages directory
 kargo apply -f stages/
+
+# Apply the YAML resources in the my-project directory and delete any Stages,
+# Warehouses and PromotionTasks in my-project that were previously applied
+# with --prune, but have since been removed from the directory
+kargo apply -f my-project/ --prune --project=my-project
+
+# Show what applying the YAML resources in the my-project directory would do,
+# without persisting any changes
+kargo apply -f my-project/ --prune --project=my-project --dry-run
 `),
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			if err := cmdOpts.validate(); err != nil {
@@ -76,6 +91,23 @@ func (o *applyOptions) addFlags(cmd *cobra.Command) {
 
 	option.Filenames(cmd.Flags(), &o.Filenames, "Filename or directory to use to apply the resource(s)")
 	option.Recursive(cmd.Flags(), &o.Recursive)
+	option.Project(
+		cmd.Flags(), &o.Project, o.Config.Project,
+		"The project to prune resources from. If not set, the default project will be used.",
+	)
+	option.Prune(
+		cmd.Flags(), &o.Prune,
+		"Delete Stages, Warehouses and PromotionTasks in the project that belong to the apply set, "+
+			"but are not present in the applied resources.",
+	)
+	option.ApplySet(
+		cmd.Flags(), &o.ApplySet, applyset.DefaultName,
+		"The name of the apply set the applied resources are labeled as belonging to when pruning.",
+	)
+	option.DryRun(
+		cmd.Flags(), &o.DryRun,
+		"Submit the resources to the server for validation without persisting them.",
+	)
 
 	if err := cmd.MarkFlagRequired(option.FilenameFlag); err != nil {
 		panic(fmt.Errorf("could not mark filename flag as required: %w", err))
@@ -97,15 +129,33 @@ func (o *applyOptions) validate() error {
 	if len(o.Filenames) == 0 {
 		return errors.New("filename is required")
 	}
+	if o.Prune {
+		if o.Project == "" {
+			return errors.New("project is required when pruning")
+		}
+		if o.ApplySet == "" {
+			return errors.New("apply set is required when pruning")
+		}
+	}
 	return nil
 }
 
 // run performs the apply operation using the provided options.
 func (o *applyOptions) run(ctx context.Context) error {
-	manifest, err := option.ReadManifests(o.Recursive, o.Filenames...)
+	objs, err := option.ReadObjects(o.Recursive, o.Filenames...)
 	if err != nil {
 		return fmt.Errorf("read manifests: %w", err)
 	}
+	if o.Prune {
+		// Resources are pruned from the project, so resources that do not
+		// specify a namespace have to be applied to it as well.
+		applyset.DefaultNamespace(o.Project, objs...)
+		applyset.Label(o.ApplySet, objs...)
+	}
+	manifest, err := option.EncodeManifests(objs...)
+	if err != nil {
+		return fmt.Errorf("encode manifests: %w", err)
+	}
 
 	kargoSvcCli, err := client.GetClientFromConfig(ctx, o.Config, o.ClientOptions)
 	if err != nil {
@@ -118,6 +168,7 @@ func (o *applyOptions) run(ctx context.Context) error {
 	resp, err := kargoSvcCli.CreateOrUpdateResource(ctx,
 		connect.NewRequest(&kargosvcapi.CreateOrUpdateResourceRequest{
 			Manifest: manifest,
+			DryRun:   o.DryRun,
 		}))
 	if err != nil {
 		return fmt.Errorf("apply resource: %w", err)
@@ -167,10 +218,75 @@ func (o *applyOptions) run(ctx context.Context) error {
 		}
 		_ = printer.PrintObj(&obj, o.Out)
 	}
+
+	// Pruning in spite of errors could delete resources that merely failed to
+	// be applied, so we only prune if everything else succeeded.
+	if len(errs) > 0 || !o.Prune {
+		return errors.Join(errs...)
+	}
+	return o.prune(ctx, kargoSvcCli, objs)
+}
+
+// prune deletes the resources in the project that belong to the apply set, but
+// are absent from the provided applied objects.
+func (o *applyOptions) prune(
+	ctx context.Context,
+	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
+	applied []*unstructured.Unstructured,
+) error {
+	candidates, err := applyset.PruneCandidates(ctx, kargoSvcCli, o.Project, o.ApplySet, applied)
+	if err != nil {
+		return fmt.Errorf("get resources to prune: %w", err)
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	printer, err := o.toPrinter("pruned")
+	if err != nil {
+		return fmt.Errorf("new printer: %w", err)
+	}
+
+	if o.DryRun {
+		for _, obj := range candidates {
+			_ = printer.PrintObj(obj, o.Out)
+		}
+		return nil
+	}
+
+	manifest, err := option.EncodeManifests(candidates...)
+	if err != nil {
+		return fmt.Errorf("encode manifests: %w", err)
+	}
+	resp, err := kargoSvcCli.DeleteResource(ctx, connect.NewRequest(&kargosvcapi.DeleteResourceRequest{
+		Manifest: manifest,
+	}))
+	if err != nil {
+		return fmt.Errorf("prune resources: %w", err)
+	}
+
+	var errs []error
+	for _, r := range resp.Msg.GetResults() {
+		switch typedRes := r.GetResult().(type) {
+		case *kargosvcapi.DeleteResourceResult_DeletedResourceManifest:
+			var obj unstructured.Unstructured
+			if err = sigyaml.Unmarshal(typedRes.DeletedResourceManifest, &obj); err != nil {
+				_, _ = fmt.Fprintf(o.ErrOut, "Error: %s",
+					fmt.Errorf("unmarshal pruned manifest: %w", err))
+				continue
+			}
+			_ = printer.PrintObj(&obj, o.Out)
+		case *kargosvcapi.DeleteResourceResult_Error:
+			errs = append(errs, errors.New(typedRes.Error))
+		}
+	}
 	return errors.Join(errs...)
 }
 
 func (o *applyOptions) toPrinter(operation string) (printers.ResourcePrinter, error) {
+	if o.DryRun {
+		operation += " (server dry run)"
+	}
 	o.NamePrintFlags.Operation = operation
 	return o.ToPrinter()
 }
@@ -23,6 +23,7 @@ type approvalOptions struct {
 	FreightName  string
 	FreightAlias string
 	Stage        string
+	AllowStale   bool
 }
 
 func NewCommand(cfg config.CLIConfig) *cobra.Command {
@@ -31,7 +32,7 @@ func NewCommand(cfg config.CLIConfig) *cobra.Command {
 	}
 
 	cmd := &cobra.Command{
-		Use:   "approve [--project=project] (--freight=freight | --freight-alias=alias) --stage=stage",
+		Use:   "approve [--project=project] (--freight=freight | --freight-alias=alias) --stage=stage [--allow-stale]",
 		Short: "Manually approve a piece of freight for promotion to a stage",
 		Args:  option.NoArgs,
 		Example: templates.Example(`
@@ -48,6 +49,9 @@ kargo approve --freight=abc1234 --stage=qa
 # Approve a piece of freight specified by alias for the QA stage in the default project
 kargo config set-project my-project
 kargo approve --freight-alias=wonky-wombat --stage=qa
+
+# Approve a piece of freight for the QA stage even if it is stale
+kargo approve --project=my-project --freight=abc1234 --stage=qa --allow-stale
 `),
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			if err := cmdOpts.validate(); err != nil {
@@ -75,6 +79,10 @@ func (o *approvalOptions) addFlags(cmd *cobra.Command) {
 	option.Freight(cmd.Flags(), &o.FreightName, "The name of the freight to approve.")
 	option.FreightAlias(cmd.Flags(), &o.FreightAlias, "The alias of the freight to approve.")
 	option.Stage(cmd.Flags(), &o.Stage, "The stage for which to approve the freight.")
+	option.AllowStale(
+		cmd.Flags(), &o.AllowStale,
+		"Allow the freight to be promoted to the stage even if it is stale.",
+	)
 
 	if err := cmd.MarkFlagRequired(option.StageFlag); err != nil {
 		panic(fmt.Errorf("could not mark %s flag as required: %w", option.StageFlag, err))
@@ -116,10 +124,11 @@ func (o *approvalOptions) run(ctx context.Context) error {
 		ctx,
 		connect.NewRequest(
 			&v1alpha1.ApproveFreightRequest{
-				Project: o.Project,
-				Name:    o.FreightName,
-				Alias:   o.FreightAlias,
-				Stage:   o.Stage,
+				Project:    o.Project,
+				Name:       o.FreightName,
+				Alias:      o.FreightAlias,
+				Stage:      o.Stage,
+				AllowStale: o.AllowStale,
 			},
 		),
 	); err != nil {
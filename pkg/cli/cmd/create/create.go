@@ -67,8 +67,10 @@ kargo create project my-project
 
 	// Register subcommands.
 	cmd.AddCommand(newCredentialsCommand(cfg, streams))
+	cmd.AddCommand(newFreightCommand(cfg, streams))
 	cmd.AddCommand(newProjectCommand(cfg, streams))
 	cmd.AddCommand(newRoleCommand(cfg, streams))
+	cmd.AddCommand(newTokenCommand(cfg, streams))
 
 	return cmd
 }
@@ -0,0 +1,266 @@
+package create
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	kargosvcapi "github.com/akuity/kargo/api/service/v1alpha1"
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/cli/client"
+	"github.com/akuity/kargo/pkg/cli/config"
+	"github.com/akuity/kargo/pkg/cli/io"
+	"github.com/akuity/kargo/pkg/cli/kubernetes"
+	"github.com/akuity/kargo/pkg/cli/option"
+	"github.com/akuity/kargo/pkg/cli/templates"
+)
+
+// commitIDRegex matches full or abbreviated Git commit IDs.
+var commitIDRegex = regexp.MustCompile(`^[0-9a-fA-F]{7,64}$`)
+
+type createFreightOptions struct {
+	genericiooptions.IOStreams
+	*genericclioptions.PrintFlags
+
+	Config        config.CLIConfig
+	ClientOptions client.Options
+
+	Project   string
+	Warehouse string
+	Commits   []string
+	Images    []string
+	Charts    []string
+	Alias     string
+}
+
+func newFreightCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
+	cmdOpts := &createFreightOptions{
+		Config:     cfg,
+		IOStreams:  streams,
+		PrintFlags: genericclioptions.NewPrintFlags("created").WithTypeSetter(kubernetes.GetScheme()),
+	}
+
+	cmd := &cobra.Command{
+		Use: "freight [--project=project] --warehouse=warehouse " +
+			"[--commit=repo-url@ref]... [--image=repo-url:tag|repo-url@digest]... " +
+			"[--chart=repo-url[/name]:version]... [--alias=alias]",
+		Short: "Create Freight from specific artifacts",
+		Long: `Create Freight from specific artifacts.
+
+Exactly one artifact must be specified for each of the Warehouse's
+subscriptions. Artifacts are resolved using the same discovery logic the
+Warehouse itself uses, so image digests and commit metadata are filled in
+automatically, and artifacts that do not satisfy a subscription's constraints
+are rejected.`,
+		Args: option.NoArgs,
+		Example: templates.Example(`
+# Create Freight from an image and a commit
+kargo create freight --project=my-project --warehouse=my-warehouse \
+  --image=ghcr.io/example/app:v1.2.3 \
+  --commit=https://github.com/example/config.git@4e0b0a1
+
+# Create Freight from an image digest and give it an alias
+kargo create freight --project=my-project --warehouse=my-warehouse \
+  --image=ghcr.io/example/app@sha256:0123456789abcdef... --alias=release-candidate
+
+# Create Freight from a Helm chart in an HTTP(S) repository
+kargo create freight --project=my-project --warehouse=my-warehouse \
+  --chart=https://charts.example.com/my-chart:1.2.3
+
+# Create Freight from a Helm chart in an OCI repository
+kargo create freight --project=my-project --warehouse=my-warehouse \
+  --chart=oci://ghcr.io/example/charts/my-chart:1.2.3
+`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := cmdOpts.validate(); err != nil {
+				return err
+			}
+
+			return cmdOpts.run(cmd.Context())
+		},
+	}
+
+	// Register the option flags on the command.
+	cmdOpts.addFlags(cmd)
+
+	// Set the input/output streams for the command.
+	io.SetIOStreams(cmd, cmdOpts.IOStreams)
+
+	return cmd
+}
+
+// addFlags adds the flags for the create freight options to the provided
+// command.
+func (o *createFreightOptions) addFlags(cmd *cobra.Command) {
+	o.ClientOptions.AddFlags(cmd.PersistentFlags())
+	o.AddFlags(cmd)
+
+	option.Project(
+		cmd.Flags(), &o.Project, o.Config.Project,
+		"The project in which to create the Freight. If not set, the default project will be used.",
+	)
+	option.Warehouse(cmd.Flags(), &o.Warehouse, "The Warehouse the Freight will originate from.")
+	option.Commits(
+		cmd.Flags(), &o.Commits,
+		"A Git commit, specified as repo-url@ref, where ref is a commit ID (at least 7 characters) "+
+			"or a tag. May be specified multiple times.",
+	)
+	option.Images(
+		cmd.Flags(), &o.Images,
+		"A container image, specified as repo-url:tag or repo-url@digest. May be specified multiple times.",
+	)
+	option.Charts(
+		cmd.Flags(), &o.Charts,
+		"A Helm chart, specified as repo-url[/name]:version. May be specified multiple times.",
+	)
+	option.Alias(cmd.Flags(), &o.Alias, "An optional alias for the Freight.")
+
+	if err := cmd.MarkFlagRequired(option.WarehouseFlag); err != nil {
+		panic(fmt.Errorf("could not mark %s flag as required: %w", option.WarehouseFlag, err))
+	}
+}
+
+// validate performs validation of the options. If the options are invalid, an
+// error is returned.
+func (o *createFreightOptions) validate() error {
+	var errs []error
+	// While the flags are marked as required, a user could still provide an empty
+	// string. This is a check to ensure that the flags are not empty.
+	if o.Project == "" {
+		errs = append(errs, fmt.Errorf("%s is required", option.ProjectFlag))
+	}
+	if o.Warehouse == "" {
+		errs = append(errs, fmt.Errorf("%s is required", option.WarehouseFlag))
+	}
+	if len(o.Commits) == 0 && len(o.Images) == 0 && len(o.Charts) == 0 {
+		errs = append(errs, fmt.Errorf(
+			"at least one of %s, %s, or %s is required",
+			option.CommitFlag, option.ImageFlag, option.ChartFlag,
+		))
+	}
+	return errors.Join(errs...)
+}
+
+// run creates Freight using the provided options.
+func (o *createFreightOptions) run(ctx context.Context) error {
+	req := &kargosvcapi.CreateFreightRequest{
+		Project:   o.Project,
+		Warehouse: o.Warehouse,
+		Alias:     o.Alias,
+	}
+	var errs []error
+	for _, c := range o.Commits {
+		commit, err := parseCommit(c)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		req.Commits = append(req.Commits, commit)
+	}
+	for _, i := range o.Images {
+		image, err := parseImage(i)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		req.Images = append(req.Images, image)
+	}
+	for _, c := range o.Charts {
+		chart, err := parseChart(c)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		req.Charts = append(req.Charts, chart)
+	}
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+
+	kargoSvcCli, err := client.GetClientFromConfig(ctx, o.Config, o.ClientOptions)
+	if err != nil {
+		return fmt.Errorf("get client from config: %w", err)
+	}
+
+	resp, err := kargoSvcCli.CreateFreight(ctx, connect.NewRequest(req))
+	if err != nil {
+		return fmt.Errorf("create freight: %w", err)
+	}
+
+	printer, err := o.ToPrinter()
+	if err != nil {
+		return fmt.Errorf("new printer: %w", err)
+	}
+	return printer.PrintObj(resp.Msg.GetFreight(), o.Out)
+}
+
+// parseCommit parses a commit specified as repo-url@ref, where ref is either a
+// (possibly abbreviated) commit ID or a tag.
+func parseCommit(s string) (*kargoapi.GitCommit, error) {
+	i := strings.LastIndex(s, "@")
+	if i <= 0 || i == len(s)-1 || strings.ContainsAny(s[i+1:], "/:") {
+		return nil, fmt.Errorf("invalid %s %q: expected repo-url@ref", option.CommitFlag, s)
+	}
+	commit := &kargoapi.GitCommit{RepoURL: s[:i]}
+	if ref := s[i+1:]; commitIDRegex.MatchString(ref) {
+		commit.ID = strings.ToLower(ref)
+	} else {
+		commit.Tag = ref
+	}
+	return commit, nil
+}
+
+// parseImage parses an image specified as repo-url:tag, repo-url@digest, or
+// repo-url:tag@digest.
+func parseImage(s string) (*kargoapi.Image, error) {
+	image := &kargoapi.Image{RepoURL: s}
+	if i := strings.Index(s, "@"); i > 0 && i < len(s)-1 {
+		image.RepoURL, image.Digest = s[:i], s[i+1:]
+	}
+	// The tag separator is the last colon following the last slash. Any other
+	// colon belongs to the registry's host:port.
+	ref := image.RepoURL
+	if i := strings.LastIndex(ref, ":"); i > strings.LastIndex(ref, "/") && i > 0 && i < len(ref)-1 {
+		image.RepoURL, image.Tag = ref[:i], ref[i+1:]
+	}
+	if image.Tag != "" || image.Digest != "" {
+		return image, nil
+	}
+	return nil, fmt.Errorf(
+		"invalid %s %q: expected repo-url:tag or repo-url@digest",
+		option.ImageFlag, s,
+	)
+}
+
+// parseChart parses a chart specified as repo-url[/name]:version. For charts
+// in HTTP(S) repositories, the name is the last path element of the URL. For
+// charts in OCI repositories, the name is part of the repository URL itself.
+func parseChart(s string) (*kargoapi.Chart, error) {
+	i := strings.LastIndex(s, ":")
+	if i <= strings.LastIndex(s, "/") || i == len(s)-1 {
+		return nil, fmt.Errorf(
+			"invalid %s %q: expected repo-url[/name]:version",
+			option.ChartFlag, s,
+		)
+	}
+	chart := &kargoapi.Chart{RepoURL: s[:i], Version: s[i+1:]}
+	if !strings.HasPrefix(chart.RepoURL, "oci://") {
+		j := strings.LastIndex(chart.RepoURL, "/")
+		if j < strings.Index(chart.RepoURL, "://")+len("://") {
+			return nil, fmt.Errorf(
+				"invalid %s %q: expected repo-url/name:version",
+				option.ChartFlag, s,
+			)
+		}
+		chart.Name = chart.RepoURL[j+1:]
+		chart.RepoURL = chart.RepoURL[:j]
+	}
+	return chart, nil
+}
@@ -0,0 +1,161 @@
+package create
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	kargosvcapi "github.com/akuity/kargo/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/cli/client"
+	"github.com/akuity/kargo/pkg/cli/config"
+	"github.com/akuity/kargo/pkg/cli/io"
+	"github.com/akuity/kargo/pkg/cli/option"
+	"github.com/akuity/kargo/pkg/cli/templates"
+)
+
+// defaultTokenExpiresIn is the default lifetime of a newly created API token.
+const defaultTokenExpiresIn = 30 * 24 * time.Hour
+
+type createTokenOptions struct {
+	genericiooptions.IOStreams
+
+	Config        config.CLIConfig
+	ClientOptions client.Options
+
+	Project   string
+	Name      string
+	Role      string
+	ExpiresIn time.Duration
+}
+
+func newTokenCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
+	cmdOpts := &createTokenOptions{
+		Config:    cfg,
+		IOStreams: streams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "token [--project=project] NAME --role=role [--expires-in=duration]",
+		Short: "Create an API token bound to a role",
+		Long: `Create an API token bound to a role.
+
+A request authenticated using the token is granted the permissions of the
+role, within the token's project only. The token is printed exactly once and
+cannot be retrieved again.`,
+		Args: option.ExactArgs(1),
+		Example: templates.Example(`
+# Create a token for a role that expires in 30 days
+kargo create token --project=my-project my-token --role=my-role
+
+# Create a token for a role that expires in 12 hours
+kargo create token --project=my-project my-token --role=my-role --expires-in=12h
+
+# Create a token for a role in the default project
+kargo config set-project my-project
+kargo create token my-token --role=my-role
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdOpts.complete(args)
+
+			if err := cmdOpts.validate(); err != nil {
+				return err
+			}
+
+			return cmdOpts.run(cmd.Context())
+		},
+	}
+
+	// Register the option flags on the command.
+	cmdOpts.addFlags(cmd)
+
+	// Set the input/output streams for the command.
+	io.SetIOStreams(cmd, cmdOpts.IOStreams)
+
+	return cmd
+}
+
+// addFlags adds the flags for the create token options to the provided
+// command.
+func (o *createTokenOptions) addFlags(cmd *cobra.Command) {
+	o.ClientOptions.AddFlags(cmd.PersistentFlags())
+
+	option.Project(
+		cmd.Flags(), &o.Project, o.Config.Project,
+		"The project in which to create the token. If not set, the default project will be used.",
+	)
+	option.Role(cmd.Flags(), &o.Role, "The role whose permissions the token will grant.")
+	option.ExpiresIn(
+		cmd.Flags(), &o.ExpiresIn, defaultTokenExpiresIn,
+		"How long the token will remain valid for.",
+	)
+
+	if err := cmd.MarkFlagRequired(option.RoleFlag); err != nil {
+		panic(fmt.Errorf("could not mark %s flag as required: %w", option.RoleFlag, err))
+	}
+}
+
+// complete sets the options from the command arguments.
+func (o *createTokenOptions) complete(args []string) {
+	o.Name = strings.TrimSpace(args[0])
+}
+
+// validate performs validation of the options. If the options are invalid, an
+// error is returned.
+func (o *createTokenOptions) validate() error {
+	var errs []error
+	// While the flags are marked as required, a user could still provide an empty
+	// string. This is a check to ensure that the flags are not empty.
+	if o.Project == "" {
+		errs = append(errs, fmt.Errorf("%s is required", option.ProjectFlag))
+	}
+	if o.Name == "" {
+		errs = append(errs, fmt.Errorf("%s is required", option.NameFlag))
+	}
+	if o.Role == "" {
+		errs = append(errs, fmt.Errorf("%s is required", option.RoleFlag))
+	}
+	if o.ExpiresIn <= 0 {
+		errs = append(errs, fmt.Errorf("%s must be positive", option.ExpiresInFlag))
+	}
+	return errors.Join(errs...)
+}
+
+// run creates a token using the provided options.
+func (o *createTokenOptions) run(ctx context.Context) error {
+	kargoSvcCli, err := client.GetClientFromConfig(ctx, o.Config, o.ClientOptions)
+	if err != nil {
+		return fmt.Errorf("get client from config: %w", err)
+	}
+
+	resp, err := kargoSvcCli.CreateAPIToken(
+		ctx,
+		connect.NewRequest(
+			&kargosvcapi.CreateAPITokenRequest{
+				Project:   o.Project,
+				Name:      o.Name,
+				RoleName:  o.Role,
+				ExpiresAt: timestamppb.New(time.Now().Add(o.ExpiresIn)),
+			},
+		),
+	)
+	if err != nil {
+		return fmt.Errorf("create token: %w", err)
+	}
+
+	// The raw token alone goes to stdout so that it can be captured by scripts.
+	_, _ = fmt.Fprintf(
+		o.ErrOut,
+		"Token %q created; it expires at %s. Store it securely: it will not be shown again.\n",
+		resp.Msg.GetToken().Name,
+		resp.Msg.GetToken().ExpiresAt.UTC().Format(time.RFC3339),
+	)
+	_, _ = fmt.Fprintln(o.Out, resp.Msg.GetRawToken())
+	return nil
+}
@@ -81,6 +81,7 @@ kargo delete warehouse --project=my-project my-warehouse
 	cmd.AddCommand(newProjectConfigCommand(cfg, streams))
 	cmd.AddCommand(newRoleCommand(cfg, streams))
 	cmd.AddCommand(newStageCommand(cfg, streams))
+	cmd.AddCommand(newTokenCommand(cfg, streams))
 	cmd.AddCommand(newWarehouseCommand(cfg, streams))
 
 	return cmd
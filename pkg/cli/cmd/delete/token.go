@@ -0,0 +1,142 @@
+package delete
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	rbacapi "github.com/akuity/kargo/api/rbac/v1alpha1"
+	v1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/cli/client"
+	"github.com/akuity/kargo/pkg/cli/config"
+	"github.com/akuity/kargo/pkg/cli/io"
+	"github.com/akuity/kargo/pkg/cli/kubernetes"
+	"github.com/akuity/kargo/pkg/cli/option"
+	"github.com/akuity/kargo/pkg/cli/templates"
+)
+
+type deleteTokenOptions struct {
+	genericiooptions.IOStreams
+	*genericclioptions.PrintFlags
+
+	Config        config.CLIConfig
+	ClientOptions client.Options
+
+	Project string
+	Names   []string
+}
+
+func newTokenCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
+	cmdOpts := &deleteTokenOptions{
+		Config:     cfg,
+		IOStreams:  streams,
+		PrintFlags: genericclioptions.NewPrintFlags("deleted").WithTypeSetter(kubernetes.GetScheme()),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "token [--project=project] (NAME ...)",
+		Short: "Revoke API token by name",
+		Args:  option.MinimumNArgs(1),
+		Example: templates.Example(`
+# Revoke a token
+kargo delete token --project=my-project my-token
+
+# Revoke multiple tokens
+kargo delete token --project=my-project my-token1 my-token2
+
+# Revoke a token in the default project
+kargo config set-project my-project
+kargo delete token my-token
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdOpts.complete(args)
+
+			if err := cmdOpts.validate(); err != nil {
+				return err
+			}
+
+			return cmdOpts.run(cmd.Context())
+		},
+	}
+
+	// Register the option flags on the command.
+	cmdOpts.addFlags(cmd)
+
+	// Set the input/output streams for the command.
+	io.SetIOStreams(cmd, cmdOpts.IOStreams)
+
+	return cmd
+}
+
+// addFlags adds the flags for the delete token options to the provided command.
+func (o *deleteTokenOptions) addFlags(cmd *cobra.Command) {
+	o.ClientOptions.AddFlags(cmd.PersistentFlags())
+	o.AddFlags(cmd)
+
+	option.Project(cmd.Flags(), &o.Project, o.Config.Project,
+		"The Project for which to revoke tokens. If not set, the default project will be used.")
+}
+
+// complete sets the options from the command arguments.
+func (o *deleteTokenOptions) complete(args []string) {
+	o.Names = slices.Compact(args)
+}
+
+// validate performs validation of the options. If the options are invalid, an
+// error is returned.
+func (o *deleteTokenOptions) validate() error {
+	var errs []error
+	// While the flags are marked as required, a user could still provide an empty
+	// string. This is a check to ensure that the flags are not empty.
+	if o.Project == "" {
+		errs = append(errs, fmt.Errorf("%s is required", option.ProjectFlag))
+	}
+	if len(o.Names) == 0 {
+		errs = append(errs, fmt.Errorf("%s is required", option.NameFlag))
+	}
+	return errors.Join(errs...)
+}
+
+// run revokes the token(s) in the project based on the options.
+func (o *deleteTokenOptions) run(ctx context.Context) error {
+	kargoSvcCli, err := client.GetClientFromConfig(ctx, o.Config, o.ClientOptions)
+	if err != nil {
+		return fmt.Errorf("get client from config: %w", err)
+	}
+
+	printer, err := o.ToPrinter()
+	if err != nil {
+		return fmt.Errorf("create printer: %w", err)
+	}
+
+	var errs []error
+	for _, name := range o.Names {
+		if _, err := kargoSvcCli.DeleteAPIToken(
+			ctx,
+			connect.NewRequest(&v1alpha1.DeleteAPITokenRequest{
+				Project: o.Project,
+				Name:    name,
+			}),
+		); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		_ = printer.PrintObj(
+			&rbacapi.APIToken{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: o.Project,
+					Name:      name,
+				},
+			},
+			o.Out,
+		)
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,304 @@
+package diff
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	sigyaml "sigs.k8s.io/yaml"
+
+	kargosvcapi "github.com/akuity/kargo/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/cli/applyset"
+	"github.com/akuity/kargo/pkg/cli/client"
+	"github.com/akuity/kargo/pkg/cli/config"
+	"github.com/akuity/kargo/pkg/cli/io"
+	"github.com/akuity/kargo/pkg/cli/option"
+	"github.com/akuity/kargo/pkg/cli/templates"
+)
+
+// errDifferencesFound is returned when the applied resources differ from their
+// live state. It results in an exit code of 1, without an error being printed.
+var errDifferencesFound = errors.New("differences found")
+
+type diffOptions struct {
+	genericiooptions.IOStreams
+
+	Config        config.CLIConfig
+	ClientOptions client.Options
+
+	Filenames []string
+	Recursive bool
+	Project   string
+	Prune     bool
+	ApplySet  string
+}
+
+func NewCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
+	cmdOpts := &diffOptions{
+		Config:    cfg,
+		IOStreams: streams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "diff -f FILENAME [--prune [--project=project] [--apply-set=name]]",
+		Short: "Diff the live state of resources against a file or stdin",
+		Long: `Diff the live state of resources against the state they would have if the
+resources in a file or stdin were applied.
+
+The resources are submitted to the server as a dry run, so that the server's
+defaulting and validation are taken into account.
+
+Exits with status 1 if any differences were found.`,
+		Args: option.NoArgs,
+		Example: templates.Example(`
+# Diff the resources in stage.yaml against their live state
+kargo diff -f stage.yaml
+
+# Diff the YAML resources in the my-project directory against their live state,
+# including resources that would be pruned by 'kargo apply --prune'
+kargo diff -f my-project/ --prune --project=my-project
+`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := cmdOpts.validate(); err != nil {
+				return err
+			}
+
+			err := cmdOpts.run(cmd.Context())
+			if errors.Is(err, errDifferencesFound) {
+				cmd.SilenceErrors = true
+			}
+			return err
+		},
+	}
+
+	// Set the input/output streams for the command.
+	io.SetIOStreams(cmd, cmdOpts.IOStreams)
+
+	// Register the option flags on the command.
+	cmdOpts.addFlags(cmd)
+
+	return cmd
+}
+
+// addFlags adds the flags for the diff options to the provided command.
+func (o *diffOptions) addFlags(cmd *cobra.Command) {
+	o.ClientOptions.AddFlags(cmd.PersistentFlags())
+
+	option.Filenames(cmd.Flags(), &o.Filenames, "Filename or directory to use to diff the resource(s)")
+	option.Recursive(cmd.Flags(), &o.Recursive)
+	option.Project(
+		cmd.Flags(), &o.Project, o.Config.Project,
+		"The project to look for resources to prune in. If not set, the default project will be used.",
+	)
+	option.Prune(
+		cmd.Flags(), &o.Prune,
+		"Include Stages, Warehouses and PromotionTasks in the project that would be pruned by "+
+			"'kargo apply --prune'.",
+	)
+	option.ApplySet(
+		cmd.Flags(), &o.ApplySet, applyset.DefaultName,
+		"The name of the apply set the resources are labeled as belonging to when pruning.",
+	)
+
+	if err := cmd.MarkFlagRequired(option.FilenameFlag); err != nil {
+		panic(fmt.Errorf("could not mark filename flag as required: %w", err))
+	}
+	if err := cmd.MarkFlagFilename(option.FilenameFlag, ".yaml", ".yml"); err != nil {
+		panic(fmt.Errorf("could not mark filename flag as filename: %w", err))
+	}
+	if err := cmd.MarkFlagDirname(option.FilenameFlag); err != nil {
+		panic(fmt.Errorf("could not mark filename flag as dirname: %w", err))
+	}
+}
+
+// validate performs validation of the options. If the options are invalid, an
+// error is returned.
+func (o *diffOptions) validate() error {
+	// While the filename flag is marked as required, a user could still
+	// provide an empty string. This is a check to ensure that the flag is
+	// not empty.
+	if len(o.Filenames) == 0 {
+		return errors.New("filename is required")
+	}
+	if o.Prune {
+		if o.Project == "" {
+			return errors.New("project is required when pruning")
+		}
+		if o.ApplySet == "" {
+			return errors.New("apply set is required when pruning")
+		}
+	}
+	return nil
+}
+
+// run performs the diff operation using the provided options.
+func (o *diffOptions) run(ctx context.Context) error {
+	objs, err := option.ReadObjects(o.Recursive, o.Filenames...)
+	if err != nil {
+		return fmt.Errorf("read manifests: %w", err)
+	}
+	if o.Prune {
+		// Label the resources the same way 'kargo apply --prune' would, so that
+		// the label does not show up as a difference.
+		applyset.DefaultNamespace(o.Project, objs...)
+		applyset.Label(o.ApplySet, objs...)
+	}
+	manifest, err := option.EncodeManifests(objs...)
+	if err != nil {
+		return fmt.Errorf("encode manifests: %w", err)
+	}
+
+	kargoSvcCli, err := client.GetClientFromConfig(ctx, o.Config, o.ClientOptions)
+	if err != nil {
+		return fmt.Errorf("get client from config: %w", err)
+	}
+
+	resp, err := kargoSvcCli.CreateOrUpdateResource(ctx,
+		connect.NewRequest(&kargosvcapi.CreateOrUpdateResourceRequest{
+			Manifest: manifest,
+			DryRun:   true,
+		}))
+	if err != nil {
+		return fmt.Errorf("dry run apply resource: %w", err)
+	}
+
+	var differences bool
+	var errs []error
+	printDiff := func(live, merged []byte) {
+		diff, err := diffManifests(live, merged)
+		if err != nil {
+			errs = append(errs, err)
+			return
+		}
+		if diff != "" {
+			differences = true
+			_, _ = fmt.Fprint(o.Out, diff)
+		}
+	}
+	for _, r := range resp.Msg.GetResults() {
+		switch typedRes := r.GetResult().(type) {
+		case *kargosvcapi.CreateOrUpdateResourceResult_CreatedResourceManifest:
+			printDiff(nil, typedRes.CreatedResourceManifest)
+		case *kargosvcapi.CreateOrUpdateResourceResult_UpdatedResourceManifest:
+			printDiff(r.GetLiveResourceManifest(), typedRes.UpdatedResourceManifest)
+		case *kargosvcapi.CreateOrUpdateResourceResult_Error:
+			errs = append(errs, errors.New(typedRes.Error))
+		}
+	}
+
+	if o.Prune {
+		candidates, err := applyset.PruneCandidates(ctx, kargoSvcCli, o.Project, o.ApplySet, objs)
+		if err != nil {
+			return fmt.Errorf("get resources to prune: %w", err)
+		}
+		for _, obj := range candidates {
+			live, err := sigyaml.Marshal(obj.Object)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("marshal live manifest: %w", err))
+				continue
+			}
+			printDiff(live, nil)
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	if differences {
+		return errDifferencesFound
+	}
+	return nil
+}
+
+// diffManifests returns a unified diff between the provided live and merged
+// manifests of a single resource. Either manifest may be empty to indicate
+// the resource does not exist in that state. Fields which are managed by the
+// server, and would therefore only add noise, are ignored. If there are no
+// differences, an empty string is returned.
+func diffManifests(live, merged []byte) (string, error) {
+	var liveObj, mergedObj *unstructured.Unstructured
+	var liveYAML, mergedYAML string
+	var err error
+	if len(live) > 0 {
+		if liveObj, liveYAML, err = normalize(live); err != nil {
+			return "", fmt.Errorf("normalize live manifest: %w", err)
+		}
+	}
+	if len(merged) > 0 {
+		if mergedObj, mergedYAML, err = normalize(merged); err != nil {
+			return "", fmt.Errorf("normalize merged manifest: %w", err)
+		}
+	}
+
+	obj := mergedObj
+	if obj == nil {
+		obj = liveObj
+	}
+	if obj == nil {
+		return "", nil
+	}
+	name := displayName(obj)
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(liveYAML),
+		B:        splitLines(mergedYAML),
+		FromFile: "live/" + name,
+		ToFile:   "merged/" + name,
+		Context:  3,
+	})
+}
+
+// splitLines splits the provided string into lines, each retaining its
+// trailing newline.
+func splitLines(s string) []string {
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// normalize parses the provided manifest and strips it of fields which are
+// managed by the server. It returns the parsed object along with the stripped
+// manifest.
+func normalize(manifest []byte) (*unstructured.Unstructured, string, error) {
+	obj := &unstructured.Unstructured{}
+	if err := sigyaml.Unmarshal(manifest, &obj.Object); err != nil {
+		return nil, "", err
+	}
+	for _, field := range [][]string{
+		{"status"},
+		{"metadata", "creationTimestamp"},
+		{"metadata", "generation"},
+		{"metadata", "managedFields"},
+		{"metadata", "resourceVersion"},
+		{"metadata", "uid"},
+	} {
+		unstructured.RemoveNestedField(obj.Object, field...)
+	}
+	normalized, err := sigyaml.Marshal(obj.Object)
+	if err != nil {
+		return nil, "", err
+	}
+	return obj, string(normalized), nil
+}
+
+// displayName returns a name for the provided object in the form
+// [<namespace>/]<kind>.<group>/<name>.
+func displayName(obj *unstructured.Unstructured) string {
+	gvk := obj.GroupVersionKind()
+	kind := strings.ToLower(gvk.Kind)
+	if gvk.Group != "" {
+		kind += "." + gvk.Group
+	}
+	if ns := obj.GetNamespace(); ns != "" {
+		return fmt.Sprintf("%s/%s/%s", ns, kind, obj.GetName())
+	}
+	return fmt.Sprintf("%s/%s", kind, obj.GetName())
+}
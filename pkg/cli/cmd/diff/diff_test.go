@@ -0,0 +1,105 @@
+package diff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_diffManifests(t *testing.T) {
+	const live = `apiVersion: kargo.akuity.io/v1alpha1
+kind: Warehouse
+metadata:
+  name: fake-warehouse
+  namespace: fake-project
+  resourceVersion: "42"
+  uid: fake-uid
+spec:
+  interval: 5m0s
+status:
+  observedGeneration: 1
+`
+	const merged = `apiVersion: kargo.akuity.io/v1alpha1
+kind: Warehouse
+metadata:
+  name: fake-warehouse
+  namespace: fake-project
+  resourceVersion: "42"
+  uid: fake-uid
+spec:
+  interval: 10m0s
+status:
+  observedGeneration: 1
+`
+
+	testCases := []struct {
+		name       string
+		live       string
+		merged     string
+		assertions func(*testing.T, string, error)
+	}{
+		{
+			name:   "no differences",
+			live:   live,
+			merged: live,
+			assertions: func(t *testing.T, diff string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, diff)
+			},
+		},
+		{
+			name:   "updated",
+			live:   live,
+			merged: merged,
+			assertions: func(t *testing.T, diff string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					"--- live/fake-project/warehouse.kargo.akuity.io/fake-warehouse\n"+
+						"+++ merged/fake-project/warehouse.kargo.akuity.io/fake-warehouse\n"+
+						"@@ -4,4 +4,4 @@\n"+
+						"   name: fake-warehouse\n"+
+						"   namespace: fake-project\n"+
+						" spec:\n"+
+						"-  interval: 5m0s\n"+
+						"+  interval: 10m0s\n",
+					diff,
+				)
+			},
+		},
+		{
+			name:   "created",
+			merged: merged,
+			assertions: func(t *testing.T, diff string, err error) {
+				require.NoError(t, err)
+				require.Contains(t, diff, "+++ merged/fake-project/warehouse.kargo.akuity.io/fake-warehouse\n")
+				require.Contains(t, diff, "+  interval: 10m0s\n")
+				require.NotContains(t, diff, "resourceVersion")
+				require.NotContains(t, diff, "observedGeneration")
+			},
+		},
+		{
+			name: "pruned",
+			live: live,
+			assertions: func(t *testing.T, diff string, err error) {
+				require.NoError(t, err)
+				require.Contains(t, diff, "--- live/fake-project/warehouse.kargo.akuity.io/fake-warehouse\n")
+				require.Contains(t, diff, "-  interval: 5m0s\n")
+			},
+		},
+		{
+			name:   "invalid manifest",
+			live:   "{",
+			merged: merged,
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "normalize live manifest")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			diff, err := diffManifests([]byte(testCase.live), []byte(testCase.merged))
+			testCase.assertions(t, diff, err)
+		})
+	}
+}
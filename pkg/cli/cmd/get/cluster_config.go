@@ -17,7 +17,6 @@ import (
 	"github.com/akuity/kargo/pkg/cli/client"
 	"github.com/akuity/kargo/pkg/cli/config"
 	"github.com/akuity/kargo/pkg/cli/io"
-	"github.com/akuity/kargo/pkg/cli/kubernetes"
 	"github.com/akuity/kargo/pkg/cli/templates"
 )
 
@@ -40,7 +39,7 @@ func newGetClusterConfigCommand(
 		Config:     cfg,
 		IOStreams:  streams,
 		getOptions: getOptions,
-		PrintFlags: genericclioptions.NewPrintFlags("").WithTypeSetter(kubernetes.GetScheme()),
+		PrintFlags: newPrintFlags(),
 	}
 
 	cmd := &cobra.Command{
@@ -70,7 +69,7 @@ kargo get clusterconfig
 // command.
 func (o *getClusterConfigOptions) addFlags(cmd *cobra.Command) {
 	o.ClientOptions.AddFlags(cmd.PersistentFlags())
-	o.AddFlags(cmd)
+	addPrintFlags(cmd, o.PrintFlags)
 }
 
 // run gets the cluster config from the server and prints it to the console.
@@ -94,7 +93,7 @@ func (o *getClusterConfigOptions) run(ctx context.Context) error {
 		res = append(res, resp.Msg.GetClusterConfig())
 	}
 
-	if err = printObjects(res, o.PrintFlags, o.IOStreams, o.NoHeaders); err != nil {
+	if err = printObjects(res, o.PrintFlags, o.IOStreams, o.getOptions); err != nil {
 		return fmt.Errorf("print cluster configuration: %w", err)
 	}
 	return nil
@@ -20,7 +20,6 @@ import (
 	"github.com/akuity/kargo/pkg/cli/client"
 	"github.com/akuity/kargo/pkg/cli/config"
 	"github.com/akuity/kargo/pkg/cli/io"
-	"github.com/akuity/kargo/pkg/cli/kubernetes"
 	"github.com/akuity/kargo/pkg/cli/option"
 	"github.com/akuity/kargo/pkg/cli/templates"
 	libCreds "github.com/akuity/kargo/pkg/credentials"
@@ -48,7 +47,7 @@ func newGetCredentialsCommand(
 		Config:     cfg,
 		IOStreams:  streams,
 		getOptions: getOptions,
-		PrintFlags: genericclioptions.NewPrintFlags("").WithTypeSetter(kubernetes.GetScheme()),
+		PrintFlags: newPrintFlags(),
 	}
 
 	cmd := &cobra.Command{
@@ -93,7 +92,7 @@ kargo get credentials my-credentials`),
 // command.
 func (o *getCredentialsOptions) addFlags(cmd *cobra.Command) {
 	o.ClientOptions.AddFlags(cmd.PersistentFlags())
-	o.AddFlags(cmd)
+	addPrintFlags(cmd, o.PrintFlags)
 
 	option.Project(
 		cmd.Flags(), &o.Project, o.Config.Project,
@@ -136,7 +135,7 @@ func (o *getCredentialsOptions) run(ctx context.Context) error {
 		); err != nil {
 			return fmt.Errorf("list credentials: %w", err)
 		}
-		return printObjects(resp.Msg.GetCredentials(), o.PrintFlags, o.IOStreams, o.NoHeaders)
+		return printObjects(resp.Msg.GetCredentials(), o.PrintFlags, o.IOStreams, o.getOptions)
 	}
 
 	res := make([]*corev1.Secret, 0, len(o.Names))
@@ -158,7 +157,7 @@ func (o *getCredentialsOptions) run(ctx context.Context) error {
 		res = append(res, resp.Msg.GetCredentials())
 	}
 
-	if err = printObjects(res, o.PrintFlags, o.IOStreams, o.NoHeaders); err != nil {
+	if err = printObjects(res, o.PrintFlags, o.IOStreams, o.getOptions); err != nil {
 		return fmt.Errorf("print stages: %w", err)
 	}
 	return errors.Join(errs...)
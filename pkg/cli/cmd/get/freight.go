@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"connectrpc.com/connect"
@@ -14,11 +15,11 @@ import (
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 
 	v1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	"github.com/akuity/kargo/api/service/v1alpha1/svcv1alpha1connect"
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/pkg/cli/client"
 	"github.com/akuity/kargo/pkg/cli/config"
 	"github.com/akuity/kargo/pkg/cli/io"
-	"github.com/akuity/kargo/pkg/cli/kubernetes"
 	"github.com/akuity/kargo/pkg/cli/option"
 	"github.com/akuity/kargo/pkg/cli/templates"
 )
@@ -36,6 +37,8 @@ type getFreightOptions struct {
 	Names   []string
 	Aliases []string
 	Origins []string
+	Stage   string
+	Watch   bool
 }
 
 func newGetFreightCommand(
@@ -48,11 +51,11 @@ func newGetFreightCommand(
 		Config:     cfg,
 		IOStreams:  streams,
 		getOptions: getOptions,
-		PrintFlags: genericclioptions.NewPrintFlags("").WithTypeSetter(kubernetes.GetScheme()),
+		PrintFlags: newPrintFlags(),
 	}
 
 	cmd := &cobra.Command{
-		Use:   "freight [--project=project] [--name=name | --alias=alias] [--no-headers]",
+		Use:   "freight [--project=project] [--name=name | --alias=alias | --stage=stage] [--no-headers] [--watch]",
 		Short: "Display one or many pieces of freight",
 		Args:  option.NoArgs,
 		Example: templates.Example(`
@@ -62,6 +65,10 @@ kargo get freight --project=my-project
 # List all freight in my-project for a specific warehouse
 kargo get freight --project=my-project --origin=warehouse-1
 
+# List all freight available to a specific stage in my-project, including
+# whether it is stale according to the stage's staleness policies
+kargo get freight --project=my-project --stage=my-stage
+
 # List all freight in my-project in JSON output format
 kargo get freight --project=my-project -o json
 
@@ -71,6 +78,9 @@ kargo get freight --project=my-project --name=abc1234
 # Get a single piece of freight by alias
 kargo get freight --project=my-project --alias=wonky-wombat
 
+# List all freight in my-project and watch for changes
+kargo get freight --project=my-project --watch
+
 # List all freight in the default project
 kargo config set-project my-project
 kargo get freight
@@ -104,7 +114,7 @@ kargo get freight --alias=wonky-wombat
 // addFlags adds the flags for the get freight options to the provided command.
 func (o *getFreightOptions) addFlags(cmd *cobra.Command) {
 	o.ClientOptions.AddFlags(cmd.PersistentFlags())
-	o.AddFlags(cmd)
+	addPrintFlags(cmd, o.PrintFlags)
 
 	option.Project(
 		cmd.Flags(), &o.Project, o.Config.Project,
@@ -113,10 +123,25 @@ func (o *getFreightOptions) addFlags(cmd *cobra.Command) {
 	option.Names(cmd.Flags(), &o.Names, "The name of a piece of freight to get.")
 	option.Aliases(cmd.Flags(), &o.Aliases, "The alias of a piece of freight to get.")
 	option.Origins(cmd.Flags(), &o.Origins, "The origin of the freight to get.")
+	option.Stage(
+		cmd.Flags(), &o.Stage,
+		"The stage to which the freight to get must be available. Staleness is "+
+			"determined according to the staleness policies of this stage.",
+	)
+	option.Watch(
+		cmd.Flags(), &o.Watch,
+		"After listing the requested freight, watch for changes to it.",
+	)
 
 	// Origin and name/alias are mutually exclusive
 	cmd.MarkFlagsMutuallyExclusive(option.NameFlag, option.OriginFlag)
 	cmd.MarkFlagsMutuallyExclusive(option.AliasFlag, option.OriginFlag)
+	// Stage is mutually exclusive with all other filters. Which freight is
+	// available to a stage cannot be determined when watching.
+	cmd.MarkFlagsMutuallyExclusive(option.StageFlag, option.NameFlag)
+	cmd.MarkFlagsMutuallyExclusive(option.StageFlag, option.AliasFlag)
+	cmd.MarkFlagsMutuallyExclusive(option.StageFlag, option.OriginFlag)
+	cmd.MarkFlagsMutuallyExclusive(option.StageFlag, option.WatchFlag)
 }
 
 // validate performs validation of the options. If the options are invalid, an
@@ -144,6 +169,7 @@ func (o *getFreightOptions) run(ctx context.Context) error {
 			connect.NewRequest(
 				&v1alpha1.QueryFreightRequest{
 					Project: o.Project,
+					Stage:   o.Stage,
 					Origins: o.Origins,
 				},
 			),
@@ -154,7 +180,12 @@ func (o *getFreightOptions) run(ctx context.Context) error {
 		// We didn't specify any groupBy, so there should be one group with an
 		// empty key
 		freight := resp.Msg.GetGroups()[""]
-		return printObjects(freight.Freight, o.PrintFlags, o.IOStreams, o.NoHeaders)
+		o.staleFreight = freight.GetStale()
+		err = printObjects(freight.GetFreight(), o.PrintFlags, o.IOStreams, o.getOptions)
+		if err != nil || !o.Watch {
+			return err
+		}
+		return o.watch(ctx, kargoSvcCli)
 	}
 
 	res := make([]*kargoapi.Freight, 0, len(o.Names)+len(o.Aliases))
@@ -192,13 +223,62 @@ func (o *getFreightOptions) run(ctx context.Context) error {
 		res = append(res, resp.Msg.GetFreight())
 	}
 
-	if err = printObjects(res, o.PrintFlags, o.IOStreams, o.NoHeaders); err != nil {
+	if err = printObjects(res, o.PrintFlags, o.IOStreams, o.getOptions); err != nil {
 		return fmt.Errorf("print freight: %w", err)
 	}
-	return errors.Join(errs...)
+	if err = errors.Join(errs...); err != nil || !o.Watch {
+		return err
+	}
+	return o.watch(ctx, kargoSvcCli)
+}
+
+// watch streams changes to freight from the server and prints them to the
+// console until the stream ends. The server streams changes to all freight in
+// the project, so the requested names, aliases and origins are applied on the
+// client side.
+func (o *getFreightOptions) watch(
+	ctx context.Context,
+	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
+) error {
+	stream, err := kargoSvcCli.WatchFreight(
+		ctx,
+		connect.NewRequest(
+			&v1alpha1.WatchFreightRequest{
+				Project: o.Project,
+			},
+		),
+	)
+	if err != nil {
+		return fmt.Errorf("watch freight: %w", err)
+	}
+	return watchObjects(
+		stream,
+		(*v1alpha1.WatchFreightResponse).GetFreight,
+		(*v1alpha1.WatchFreightResponse).GetType,
+		o.matches,
+		o.PrintFlags,
+		o.IOStreams,
+		o.getOptions,
+	)
+}
+
+// matches returns true if the provided freight was requested by the options.
+func (o *getFreightOptions) matches(freight *kargoapi.Freight) bool {
+	if len(o.Names) > 0 || len(o.Aliases) > 0 {
+		return slices.Contains(o.Names, freight.Name) ||
+			slices.Contains(o.Aliases, freight.Alias)
+	}
+	if len(o.Origins) > 0 {
+		return freight.Origin.Kind == kargoapi.FreightOriginKindWarehouse &&
+			slices.Contains(o.Origins, freight.Origin.Name)
+	}
+	return true
 }
 
-func newFreightTable(list *metav1.List) *metav1.Table {
+// newFreightTable returns a table of the Freight in the provided list. The
+// reasons stale Freight is stale are taken from the provided map, which is
+// indexed by Freight name.
+func newFreightTable(list *metav1.List, staleFreight map[string]string) *metav1.Table {
 	rows := make([]metav1.TableRow, len(list.Items))
 	for i, item := range list.Items {
 		freight := item.Object.(*kargoapi.Freight) // nolint: forcetypeassert
@@ -212,6 +292,7 @@ func newFreightTable(list *metav1.List) *metav1.Table {
 				alias,
 				freight.Origin.String(),
 				duration.HumanDuration(time.Since(freight.CreationTimestamp.Time)),
+				staleFreight[freight.Name],
 			},
 			Object: list.Items[i],
 		}
@@ -222,6 +303,7 @@ func newFreightTable(list *metav1.List) *metav1.Table {
 			{Name: "Alias", Type: "string"},
 			{Name: "Origin", Type: "string"},
 			{Name: "Age", Type: "string"},
+			{Name: "Stale", Type: "string"},
 		},
 		Rows: rows,
 	}
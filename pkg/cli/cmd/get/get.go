@@ -2,24 +2,41 @@ package get
 
 import (
 	"fmt"
+	"reflect"
+	"slices"
+	"strings"
 
+	"connectrpc.com/connect"
 	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 	"k8s.io/cli-runtime/pkg/printers"
+	kubectlget "k8s.io/kubectl/pkg/cmd/get"
 
 	rbacapi "github.com/akuity/kargo/api/rbac/v1alpha1"
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/pkg/cli/config"
+	"github.com/akuity/kargo/pkg/cli/kubernetes"
 	"github.com/akuity/kargo/pkg/cli/option"
 	"github.com/akuity/kargo/pkg/cli/templates"
 )
 
 type getOptions struct {
 	NoHeaders bool
+	Selector  string
+	SortBy    string
+
+	// staleFreight maps the names of stale Freight to the reasons they are
+	// stale. It is used to populate the stale column of Freight tables.
+	staleFreight map[string]string
 }
 
 func NewCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
@@ -36,6 +53,13 @@ kargo get stages --project=my-project
 
 # List all promotions for the given stage
 kargo get promotions --project=my-project --stage=my-stage
+
+# List the names and phases of all promotions in the project, oldest first
+kargo get promotions --project=my-project --sort-by=.metadata.creationTimestamp \
+  -o custom-columns=NAME:.metadata.name,PHASE:.status.phase
+
+# List all stages in the project with a given label
+kargo get stages --project=my-project -l tier=prod
 `),
 	}
 
@@ -47,26 +71,134 @@ kargo get promotions --project=my-project --stage=my-stage
 	cmd.AddCommand(newGetClusterConfigCommand(cfg, streams, cmdOpts))
 	cmd.AddCommand(newGetCredentialsCommand(cfg, streams, cmdOpts))
 	cmd.AddCommand(newGetFreightCommand(cfg, streams, cmdOpts))
+	cmd.AddCommand(newGetLineageCommand(cfg, streams, cmdOpts))
 	cmd.AddCommand(newGetProjectConfigCommand(cfg, streams, cmdOpts))
 	cmd.AddCommand(newGetProjectsCommand(cfg, streams, cmdOpts))
 	cmd.AddCommand(newGetPromotionsCommand(cfg, streams, cmdOpts))
 	cmd.AddCommand(newRolesCommand(cfg, streams, cmdOpts))
 	cmd.AddCommand(newGetStagesCommand(cfg, streams, cmdOpts))
+	cmd.AddCommand(newGetTokensCommand(cfg, streams, cmdOpts))
 	cmd.AddCommand(newGetWarehousesCommand(cfg, streams, cmdOpts))
+	cmd.AddCommand(newGetWavesCommand(cfg, streams, cmdOpts))
 
 	return cmd
 }
 
 func (o *getOptions) addFlags(cmd *cobra.Command) {
 	option.NoHeaders(cmd.PersistentFlags(), &o.NoHeaders)
+	option.Selector(cmd.PersistentFlags(), &o.Selector)
+	option.SortBy(cmd.PersistentFlags(), &o.SortBy)
+}
+
+// newPrintFlags returns the print flags used by all get subcommands.
+func newPrintFlags() *genericclioptions.PrintFlags {
+	return genericclioptions.NewPrintFlags("").WithTypeSetter(kubernetes.GetScheme())
+}
+
+// addPrintFlags adds the provided print flags to the provided command. The
+// usage of the output flag is amended to include the custom columns formats,
+// which are handled by toPrinter in addition to the formats supported by the
+// print flags themselves.
+func addPrintFlags(cmd *cobra.Command, flags *genericclioptions.PrintFlags) {
+	flags.AddFlags(cmd)
+	if outputFlag := cmd.Flags().Lookup("output"); outputFlag != nil {
+		outputFlag.Usage = fmt.Sprintf(
+			"Output format. One of: (%s).",
+			strings.Join(allowedFormats(flags), ", "),
+		)
+	}
+}
+
+// allowedFormats returns all output formats supported by toPrinter.
+func allowedFormats(flags *genericclioptions.PrintFlags) []string {
+	return append(
+		flags.AllowedFormats(),
+		kubectlget.NewCustomColumnsPrintFlags().AllowedFormats()...,
+	)
+}
+
+// toPrinter returns a printer for the output format specified by the provided
+// print flags. In addition to the formats supported by the print flags, the
+// custom columns formats supported by kubectl are available.
+func toPrinter(
+	flags *genericclioptions.PrintFlags,
+	noHeaders bool,
+) (printers.ResourcePrinter, error) {
+	printer, err := flags.ToPrinter()
+	if !genericclioptions.IsNoCompatiblePrinterError(err) {
+		return printer, err
+	}
+	customColumnsFlags := kubectlget.NewCustomColumnsPrintFlags()
+	customColumnsFlags.NoHeaders = noHeaders
+	printer, ccErr := customColumnsFlags.ToPrinter(*flags.OutputFormat)
+	if !genericclioptions.IsNoCompatiblePrinterError(ccErr) {
+		return printer, ccErr
+	}
+	return nil, genericclioptions.NoCompatiblePrinterError{
+		OutputFormat:   flags.OutputFormat,
+		AllowedFormats: allowedFormats(flags),
+	}
+}
+
+// selectObjects returns the objects matching the provided label selector. If
+// the selector is empty, all objects are returned.
+func selectObjects[T runtime.Object](objects []T, selector string) ([]T, error) {
+	if selector == "" {
+		return objects, nil
+	}
+	sel, err := labels.Parse(selector)
+	if err != nil {
+		return nil, fmt.Errorf("parse selector: %w", err)
+	}
+	selected := make([]T, 0, len(objects))
+	for _, obj := range objects {
+		objMeta, err := meta.Accessor(obj)
+		if err != nil {
+			return nil, err
+		}
+		if sel.Matches(labels.Set(objMeta.GetLabels())) {
+			selected = append(selected, obj)
+		}
+	}
+	return selected, nil
+}
+
+// sortObjects returns the objects sorted by the field identified by the
+// provided JSONPath expression. If the expression is empty, the objects are
+// returned in their original order.
+func sortObjects[T runtime.Object](objects []T, sortBy string) ([]T, error) {
+	if sortBy == "" || len(objects) == 0 {
+		return objects, nil
+	}
+	objs := make([]runtime.Object, len(objects))
+	for i, obj := range objects {
+		objs[i] = obj
+	}
+	decoder := serializer.NewCodecFactory(kubernetes.GetScheme()).UniversalDecoder()
+	if _, err := kubectlget.SortObjects(decoder, objs, sortBy); err != nil {
+		return nil, fmt.Errorf("sort by %q: %w", sortBy, err)
+	}
+	sorted := make([]T, len(objs))
+	for i, obj := range objs {
+		sorted[i] = obj.(T) // nolint: forcetypeassert
+	}
+	return sorted, nil
 }
 
 func printObjects[T runtime.Object](
 	objects []T,
 	flags *genericclioptions.PrintFlags,
 	streams genericiooptions.IOStreams,
-	noHeaders bool,
+	opts *getOptions,
 ) error {
+	objects, err := selectObjects(objects, opts.Selector)
+	if err != nil {
+		return err
+	}
+	if objects, err = sortObjects(objects, opts.SortBy); err != nil {
+		return err
+	}
+
 	items := make([]runtime.RawExtension, len(objects))
 	for i, obj := range objects {
 		items[i] = runtime.RawExtension{Object: obj}
@@ -80,7 +212,7 @@ func printObjects[T runtime.Object](
 	}
 
 	if flags.OutputFlagSpecified != nil && flags.OutputFlagSpecified() {
-		printer, err := flags.ToPrinter()
+		printer, err := toPrinter(flags, opts.NoHeaders)
 		if err != nil {
 			return fmt.Errorf("new printer: %w", err)
 		}
@@ -98,13 +230,15 @@ func printObjects[T runtime.Object](
 	case *kargoapi.ClusterConfig:
 		printObj = newClusterConfigTable(list)
 	case *kargoapi.Freight:
-		printObj = newFreightTable(list)
+		printObj = newFreightTable(list, opts.staleFreight)
 	case *kargoapi.Project:
 		printObj = newProjectTable(list)
 	case *kargoapi.ProjectConfig:
 		printObj = newProjectConfigTable(list)
 	case *kargoapi.Promotion:
 		printObj = newPromotionTable(list)
+	case *rbacapi.APIToken:
+		printObj = newAPITokenTable(list)
 	case *rbacapi.Role:
 		printObj = newRoleTable(list)
 	case *rbacapi.RoleResources:
@@ -113,14 +247,118 @@ func printObjects[T runtime.Object](
 		printObj = newStageTable(list)
 	case *kargoapi.Warehouse:
 		printObj = newWarehouseTable(list)
+	case *unstructured.Unstructured:
+		printObj = newUnstructuredTable(list)
 	default:
 		printObj = list
 	}
 	return printers.
 		NewTablePrinter(
 			printers.PrintOptions{
-				NoHeaders: noHeaders,
+				NoHeaders: opts.NoHeaders,
 			},
 		).
 		PrintObj(printObj, streams.Out)
 }
+
+// newUnstructuredTable returns a table for the unstructured objects in the
+// provided list using the table builder for their kind. The list is returned
+// as is if it is empty or if there is no table builder for the kind.
+func newUnstructuredTable(list *metav1.List) runtime.Object {
+	if len(list.Items) == 0 {
+		return list
+	}
+	switch list.Items[0].Object.GetObjectKind().GroupVersionKind().Kind {
+	case "FreightLineage":
+		return newFreightLineageTable(list)
+	case "PromotionWaveProgress":
+		return newPromotionWaveProgressTable(list)
+	default:
+		return list
+	}
+}
+
+// watchObjects prints every object received from the provided stream until the
+// stream ends. The objFn and typeFn functions extract the object and the type
+// of the watch event from each message received on the stream, and objects
+// for which the optional filterFn returns false are skipped. Headers are never
+// printed because watching always follows an initial listing of the objects.
+func watchObjects[T runtime.Object, Res any](
+	stream *connect.ServerStreamForClient[Res],
+	objFn func(*Res) T,
+	typeFn func(*Res) string,
+	filterFn func(T) bool,
+	flags *genericclioptions.PrintFlags,
+	streams genericiooptions.IOStreams,
+	opts *getOptions,
+) error {
+	watchOpts := &getOptions{
+		NoHeaders: true,
+		Selector:  opts.Selector,
+	}
+	defer stream.Close()
+	for stream.Receive() {
+		msg := stream.Msg()
+		obj := objFn(msg)
+		if filterFn != nil && !filterFn(obj) {
+			continue
+		}
+		if err := printWatchEvent(typeFn(msg), obj, flags, streams, watchOpts); err != nil {
+			return err
+		}
+	}
+	if err := stream.Err(); err != nil {
+		return fmt.Errorf("watch: %w", err)
+	}
+	return nil
+}
+
+// printWatchEvent prints the object of a watch event of the provided type,
+// unless it does not match the label selector of the provided options. Deleted
+// objects are not printed as rows, as they would be indistinguishable from
+// objects that still exist. A line marking the object as deleted is printed
+// instead. When an output format was specified, that line is printed to the
+// error stream, so the output remains parseable.
+func printWatchEvent[T runtime.Object](
+	eventType string,
+	obj T,
+	flags *genericclioptions.PrintFlags,
+	streams genericiooptions.IOStreams,
+	opts *getOptions,
+) error {
+	if eventType != string(watch.Deleted) {
+		return printObjects([]T{obj}, flags, streams, opts)
+	}
+	objs, err := selectObjects([]T{obj}, opts.Selector)
+	if err != nil || len(objs) == 0 {
+		return err
+	}
+	objMeta, err := meta.Accessor(obj)
+	if err != nil {
+		return fmt.Errorf("get object metadata: %w", err)
+	}
+	out := streams.Out
+	if flags.OutputFlagSpecified != nil && flags.OutputFlagSpecified() {
+		out = streams.ErrOut
+	}
+	_, err = fmt.Fprintf(
+		out,
+		"%s/%s deleted\n",
+		strings.ToLower(reflect.TypeOf(obj).Elem().Name()),
+		objMeta.GetName(),
+	)
+	return err
+}
+
+// nameFilter returns a filter function for use with watchObjects that only
+// matches objects with one of the provided names. If no names are provided,
+// nil is returned to indicate that no filtering is required.
+func nameFilter[T runtime.Object](names []string) func(T) bool {
+	if len(names) == 0 {
+		return nil
+	}
+	return func(obj T) bool {
+		objMeta, err := meta.Accessor(obj)
+		return err == nil && slices.Contains(names, objMeta.GetName())
+	}
+}
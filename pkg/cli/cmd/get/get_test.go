@@ -0,0 +1,559 @@
+package get
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	rbacapi "github.com/akuity/kargo/api/rbac/v1alpha1"
+	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func Test_printObjects(t *testing.T) {
+	stages := []*kargoapi.Stage{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "uat",
+				Labels: map[string]string{"tier": "non-prod"},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "prod",
+				Labels: map[string]string{"tier": "prod"},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "qa",
+				Labels: map[string]string{"tier": "non-prod"},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		output     string
+		opts       *getOptions
+		assertions func(*testing.T, string, error)
+	}{
+		{
+			name:   "custom columns",
+			output: "custom-columns=NAME:.metadata.name,TIER:.metadata.labels.tier",
+			opts:   &getOptions{},
+			assertions: func(t *testing.T, out string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					"NAME   TIER\n"+
+						"uat    non-prod\n"+
+						"prod   prod\n"+
+						"qa     non-prod\n",
+					out,
+				)
+			},
+		},
+		{
+			name:   "custom columns without headers",
+			output: "custom-columns=NAME:.metadata.name",
+			opts:   &getOptions{NoHeaders: true},
+			assertions: func(t *testing.T, out string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "uat\nprod\nqa\n", out)
+			},
+		},
+		{
+			name:   "sort by",
+			output: "jsonpath={range .items[*]}{.metadata.name}{\"\\n\"}{end}",
+			opts:   &getOptions{SortBy: "{.metadata.name}"},
+			assertions: func(t *testing.T, out string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "prod\nqa\nuat\n", out)
+			},
+		},
+		{
+			name:   "selector",
+			output: "custom-columns=NAME:.metadata.name",
+			opts:   &getOptions{NoHeaders: true, Selector: "tier=non-prod"},
+			assertions: func(t *testing.T, out string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "uat\nqa\n", out)
+			},
+		},
+		{
+			name:   "invalid selector",
+			output: "json",
+			opts:   &getOptions{Selector: "tier in"},
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "parse selector")
+			},
+		},
+		{
+			name:   "unknown sort by field",
+			output: "json",
+			opts:   &getOptions{SortBy: "{.spec.unknown}"},
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "couldn't find any field")
+			},
+		},
+		{
+			name:   "unsupported output format",
+			output: "wide",
+			opts:   &getOptions{},
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "custom-columns")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			flags := newPrintFlags()
+			flags.OutputFormat = &testCase.output
+			flags.OutputFlagSpecified = func() bool { return true }
+			out := &bytes.Buffer{}
+			err := printObjects(
+				stages,
+				flags,
+				genericiooptions.IOStreams{Out: out},
+				testCase.opts,
+			)
+			testCase.assertions(t, out.String(), err)
+		})
+	}
+}
+
+func Test_printWatchEvent(t *testing.T) {
+	stage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "uat",
+			Labels: map[string]string{"tier": "non-prod"},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		eventType  string
+		output     string
+		opts       *getOptions
+		assertions func(t *testing.T, out, errOut string, err error)
+	}{
+		{
+			name:      "modified object",
+			eventType: "MODIFIED",
+			output:    "custom-columns=NAME:.metadata.name",
+			opts:      &getOptions{NoHeaders: true},
+			assertions: func(t *testing.T, out, errOut string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "uat\n", out)
+				require.Empty(t, errOut)
+			},
+		},
+		{
+			name:      "deleted object",
+			eventType: "DELETED",
+			opts:      &getOptions{NoHeaders: true},
+			assertions: func(t *testing.T, out, errOut string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "stage/uat deleted\n", out)
+				require.Empty(t, errOut)
+			},
+		},
+		{
+			name:      "deleted object with output format",
+			eventType: "DELETED",
+			output:    "json",
+			opts:      &getOptions{NoHeaders: true},
+			assertions: func(t *testing.T, out, errOut string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, out)
+				require.Equal(t, "stage/uat deleted\n", errOut)
+			},
+		},
+		{
+			name:      "deleted object not matching selector",
+			eventType: "DELETED",
+			opts:      &getOptions{NoHeaders: true, Selector: "tier=prod"},
+			assertions: func(t *testing.T, out, errOut string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, out)
+				require.Empty(t, errOut)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			flags := newPrintFlags()
+			flags.OutputFormat = &testCase.output
+			flags.OutputFlagSpecified = func() bool { return testCase.output != "" }
+			out, errOut := &bytes.Buffer{}, &bytes.Buffer{}
+			err := printWatchEvent(
+				testCase.eventType,
+				stage,
+				flags,
+				genericiooptions.IOStreams{Out: out, ErrOut: errOut},
+				testCase.opts,
+			)
+			testCase.assertions(t, out.String(), errOut.String(), err)
+		})
+	}
+}
+
+func Test_newAPITokenTable(t *testing.T) {
+	now := time.Now()
+	table := newAPITokenTable(&metav1.List{
+		Items: []runtime.RawExtension{
+			{
+				Object: &rbacapi.APIToken{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              "ci",
+						CreationTimestamp: metav1.NewTime(now.Add(-48 * time.Hour)),
+					},
+					RoleName:   "deployer",
+					ExpiresAt:  &metav1.Time{Time: now.Add(25*time.Hour + time.Minute)},
+					LastUsedAt: &metav1.Time{Time: now.Add(-time.Hour)},
+				},
+			},
+			{
+				Object: &rbacapi.APIToken{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              "old",
+						CreationTimestamp: metav1.NewTime(now.Add(-48 * time.Hour)),
+					},
+					RoleName:  "viewer",
+					ExpiresAt: &metav1.Time{Time: now.Add(-time.Hour)},
+				},
+			},
+		},
+	})
+	require.Len(t, table.Rows, 2)
+	require.Equal(t, []any{"ci", "deployer", "25h", "60m", "2d"}, table.Rows[0].Cells)
+	require.Equal(t, []any{"old", "viewer", "Expired", "Never", "2d"}, table.Rows[1].Cells)
+}
+
+func Test_newFreightTable(t *testing.T) {
+	now := time.Now()
+	origin := kargoapi.FreightOrigin{Kind: kargoapi.FreightOriginKindWarehouse, Name: "wh"}
+	table := newFreightTable(
+		&metav1.List{
+			Items: []runtime.RawExtension{
+				{
+					Object: &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:              "new",
+							Labels:            map[string]string{kargoapi.LabelKeyAlias: "fresh-fox"},
+							CreationTimestamp: metav1.NewTime(now.Add(-time.Hour)),
+						},
+						Origin: origin,
+					},
+				},
+				{
+					Object: &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:              "old",
+							CreationTimestamp: metav1.NewTime(now.Add(-48 * time.Hour)),
+						},
+						Origin: origin,
+					},
+				},
+			},
+		},
+		map[string]string{"old": "Freight is older than 24h0m0s"},
+	)
+	require.Len(t, table.Rows, 2)
+	require.Equal(t, []any{"new", "fresh-fox", "Warehouse/wh", "60m", ""}, table.Rows[0].Cells)
+	require.Equal(
+		t,
+		[]any{"old", "", "Warehouse/wh", "2d", "Freight is older than 24h0m0s"},
+		table.Rows[1].Cells,
+	)
+}
+
+func Test_nameFilter(t *testing.T) {
+	require.Nil(t, nameFilter[*kargoapi.Stage](nil))
+
+	filter := nameFilter[*kargoapi.Stage]([]string{"qa", "prod"})
+	require.True(t, filter(&kargoapi.Stage{ObjectMeta: metav1.ObjectMeta{Name: "qa"}}))
+	require.False(t, filter(&kargoapi.Stage{ObjectMeta: metav1.ObjectMeta{Name: "uat"}}))
+}
+
+func Test_getFreightOptions_matches(t *testing.T) {
+	freight := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{Name: "abc123"},
+		Alias:      "wonky-wombat",
+		Origin: kargoapi.FreightOrigin{
+			Kind: kargoapi.FreightOriginKindWarehouse,
+			Name: "fake-warehouse",
+		},
+	}
+	testCases := []struct {
+		name     string
+		opts     *getFreightOptions
+		expected bool
+	}{
+		{
+			name:     "no filters",
+			opts:     &getFreightOptions{},
+			expected: true,
+		},
+		{
+			name:     "matching name",
+			opts:     &getFreightOptions{Names: []string{"abc123"}},
+			expected: true,
+		},
+		{
+			name:     "matching alias",
+			opts:     &getFreightOptions{Aliases: []string{"wonky-wombat"}},
+			expected: true,
+		},
+		{
+			name:     "non-matching name",
+			opts:     &getFreightOptions{Names: []string{"def456"}},
+			expected: false,
+		},
+		{
+			name:     "matching origin",
+			opts:     &getFreightOptions{Origins: []string{"fake-warehouse"}},
+			expected: true,
+		},
+		{
+			name:     "non-matching origin",
+			opts:     &getFreightOptions{Origins: []string{"other-warehouse"}},
+			expected: false,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, testCase.opts.matches(freight))
+		})
+	}
+}
+
+func Test_lineageToRuntimeObject(t *testing.T) {
+	obj, err := lineageToRuntimeObject(&svcv1alpha1.FreightLineage{
+		Freight: &kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-project",
+				Name:      "fake-freight",
+				Labels:    map[string]string{kargoapi.LabelKeyAlias: "fake-alias"},
+			},
+		},
+		Stages: []*svcv1alpha1.FreightStageLineage{{
+			Stage: "fake-stage",
+			Deployments: []*svcv1alpha1.FreightDeployment{
+				{
+					Promotion: &kargoapi.Promotion{
+						ObjectMeta: metav1.ObjectMeta{Name: "failed"},
+					},
+				},
+				{
+					Promotion: &kargoapi.Promotion{
+						ObjectMeta: metav1.ObjectMeta{Name: "succeeded"},
+					},
+					Provenance: []byte(`{"_type":"https://in-toto.io/Statement/v1"}`),
+				},
+			},
+		}},
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, "FreightLineage", obj.GetKind())
+	require.Equal(t, "fake-project", obj.GetNamespace())
+	require.Equal(t, "fake-freight", obj.GetName())
+	require.Equal(t, map[string]string{kargoapi.LabelKeyAlias: "fake-alias"}, obj.GetLabels())
+	name, _, err := unstructured.NestedString(obj.Object, "freight", "metadata", "name")
+	require.NoError(t, err)
+	require.Equal(t, "fake-freight", name)
+	stages, _, err := unstructured.NestedSlice(obj.Object, "stages")
+	require.NoError(t, err)
+	require.Len(t, stages, 1)
+	deployments, _, err := unstructured.NestedSlice(stages[0].(map[string]any), "deployments")
+	require.NoError(t, err)
+	require.Len(t, deployments, 2)
+	require.NotContains(t, deployments[0], "provenance")
+	require.Equal(
+		t,
+		map[string]any{"_type": "https://in-toto.io/Statement/v1"},
+		deployments[1].(map[string]any)["provenance"],
+	)
+}
+
+func Test_newFreightLineageTable(t *testing.T) {
+	obj, err := lineageToRuntimeObject(&svcv1alpha1.FreightLineage{
+		Freight: &kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{Name: "fake-freight"},
+		},
+		Stages: []*svcv1alpha1.FreightStageLineage{
+			{
+				Stage:    "test",
+				Approved: &kargoapi.ApprovedStage{},
+				Verified: &kargoapi.VerifiedStage{},
+				Deployments: []*svcv1alpha1.FreightDeployment{{
+					Promotion: &kargoapi.Promotion{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:              "test.1",
+							CreationTimestamp: metav1.NewTime(time.Now().Add(-time.Hour)),
+						},
+						Status: kargoapi.PromotionStatus{Phase: kargoapi.PromotionPhaseSucceeded},
+					},
+					Verifications: []*kargoapi.VerificationInfo{
+						{Phase: kargoapi.VerificationPhaseSuccessful},
+					},
+				}},
+			},
+			{
+				Stage:    "prod",
+				Approved: &kargoapi.ApprovedStage{},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	table, ok := newUnstructuredTable(
+		&metav1.List{Items: []runtime.RawExtension{{Object: obj}}},
+	).(*metav1.Table)
+	require.True(t, ok)
+	require.Len(t, table.Rows, 2)
+	require.Equal(
+		t,
+		[]any{"fake-freight", "test", "Approved,Verified", "test.1", "Succeeded", "Successful", "60m"},
+		table.Rows[0].Cells,
+	)
+	require.Equal(
+		t,
+		[]any{"fake-freight", "prod", "Approved", "", "", "", ""},
+		table.Rows[1].Cells,
+	)
+}
+
+func Test_getLineageOptions_printProvenance(t *testing.T) {
+	lineage := &svcv1alpha1.FreightLineage{
+		Freight: &kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{Name: "fake-freight"},
+		},
+		Stages: []*svcv1alpha1.FreightStageLineage{
+			{
+				Stage: "test",
+				Deployments: []*svcv1alpha1.FreightDeployment{
+					{Provenance: []byte(`{"stage":"test"}`)},
+					{},
+				},
+			},
+			{
+				Stage: "prod",
+				Deployments: []*svcv1alpha1.FreightDeployment{
+					{Provenance: []byte(`{"stage":"prod"}`)},
+				},
+			},
+			{
+				Stage: "approved",
+			},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		stage      string
+		assertions func(*testing.T, string, error)
+	}{
+		{
+			name: "all stages",
+			assertions: func(t *testing.T, out string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "{\"stage\":\"test\"}\n{\"stage\":\"prod\"}\n", out)
+			},
+		},
+		{
+			name:  "single stage",
+			stage: "prod",
+			assertions: func(t *testing.T, out string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "{\"stage\":\"prod\"}\n", out)
+			},
+		},
+		{
+			name:  "stage without successful promotions",
+			stage: "approved",
+			assertions: func(t *testing.T, out string, err error) {
+				require.ErrorContains(t, err, "has not been successfully promoted to stage \"approved\"")
+				require.Empty(t, out)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			o := &getLineageOptions{
+				IOStreams: genericiooptions.IOStreams{Out: out},
+				Stage:     testCase.stage,
+			}
+			err := o.printProvenance(lineage)
+			testCase.assertions(t, out.String(), err)
+		})
+	}
+}
+
+func Test_newPromotionWaveProgressTable(t *testing.T) {
+	obj, err := waveProgressToRuntimeObject("fake-project", &kargoapi.PromotionWaveProgress{
+		Policy:  "prod",
+		Freight: "fake-freight",
+		Waves: []kargoapi.PromotionWaveStatus{
+			{
+				Name:           "canary",
+				Phase:          kargoapi.PromotionWavePhaseCompleted,
+				Stages:         []string{"prod-a"},
+				VerifiedStages: []string{"prod-a"},
+			},
+			{
+				Name:         "rest",
+				Phase:        kargoapi.PromotionWavePhaseProgressing,
+				Stages:       []string{"prod-b", "prod-c"},
+				FailedStages: []string{"prod-c"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	out := &bytes.Buffer{}
+	require.NoError(t, printObjects(
+		[]*unstructured.Unstructured{obj},
+		newPrintFlags(),
+		genericiooptions.IOStreams{Out: out},
+		&getOptions{},
+	))
+	require.Equal(
+		t,
+		"POLICY   FREIGHT        WAVE     PHASE         VERIFIED   FAILED\n"+
+			"prod     fake-freight   canary   Completed     1/1        0\n"+
+			"prod     fake-freight   rest     Progressing   0/2        1\n",
+		out.String(),
+	)
+}
+
+func Test_waveProgressToRuntimeObject(t *testing.T) {
+	obj, err := waveProgressToRuntimeObject("fake-project", &kargoapi.PromotionWaveProgress{
+		Policy:  "prod",
+		Freight: "fake-freight",
+		Waves: []kargoapi.PromotionWaveStatus{{
+			Name:  "canary",
+			Phase: kargoapi.PromotionWavePhasePending,
+		}},
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, "PromotionWaveProgress", obj.GetKind())
+	require.Equal(t, "fake-project", obj.GetNamespace())
+	require.Equal(t, "prod", obj.GetName())
+	policy, _, err := unstructured.NestedString(obj.Object, "policy")
+	require.NoError(t, err)
+	require.Equal(t, "prod", policy)
+	waves, _, err := unstructured.NestedSlice(obj.Object, "waves")
+	require.NoError(t, err)
+	require.Len(t, waves, 1)
+}
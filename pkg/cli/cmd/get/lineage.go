@@ -0,0 +1,376 @@
+package get
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	v1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/cli/client"
+	"github.com/akuity/kargo/pkg/cli/config"
+	"github.com/akuity/kargo/pkg/cli/io"
+	"github.com/akuity/kargo/pkg/cli/option"
+	"github.com/akuity/kargo/pkg/cli/templates"
+)
+
+type getLineageOptions struct {
+	genericiooptions.IOStreams
+	*genericclioptions.PrintFlags
+
+	*getOptions
+
+	Config        config.CLIConfig
+	ClientOptions client.Options
+
+	Project    string
+	Name       string
+	Alias      string
+	Stage      string
+	Provenance bool
+}
+
+func newGetLineageCommand(
+	cfg config.CLIConfig,
+	streams genericiooptions.IOStreams,
+	getOptions *getOptions,
+) *cobra.Command {
+	cmdOpts := &getLineageOptions{
+		Config:     cfg,
+		IOStreams:  streams,
+		getOptions: getOptions,
+		PrintFlags: newPrintFlags(),
+	}
+
+	cmd := &cobra.Command{
+		Use: "lineage [--project=project] (--name=name | --alias=alias) " +
+			"[--provenance [--stage=stage]]",
+		Short: "Display the lineage of a piece of freight",
+		Long: `Display the lineage of a piece of freight: the artifacts it references, the
+details its warehouse discovered about them, and every stage it has been
+approved for, promoted to, verified in or is currently in use by.
+
+By default, one row is displayed for every promotion of the freight to a stage.
+The complete lineage, including the artifacts, is displayed using an output
+format such as YAML.
+
+With --provenance, an in-toto statement carrying a SLSA provenance predicate is
+printed for every successful promotion of the freight, one JSON document per
+line.`,
+		Args: option.NoArgs,
+		Example: templates.Example(`
+# Display the lineage of a piece of freight by alias
+kargo get lineage --project=my-project --alias=wonky-wombat
+
+# Display the lineage of a piece of freight by name in YAML output format
+kargo get lineage --project=my-project --name=abc1234 -o yaml
+
+# Display the commits referenced by a piece of freight
+kargo get lineage --project=my-project --alias=wonky-wombat \
+  -o custom-columns=REPO:.commits[*].commit.repoURL,ID:.commits[*].commit.id
+
+# Export provenance documents for every deployment of a piece of freight
+kargo get lineage --project=my-project --alias=wonky-wombat --provenance > wonky-wombat.intoto.jsonl
+
+# Export provenance documents for the deployments of a piece of freight to
+# a single stage
+kargo get lineage --project=my-project --alias=wonky-wombat --provenance --stage=prod
+`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := cmdOpts.validate(); err != nil {
+				return err
+			}
+
+			return cmdOpts.run(cmd.Context())
+		},
+	}
+
+	// Register the option flags on the command.
+	cmdOpts.addFlags(cmd)
+
+	// Set the input/output streams for the command.
+	io.SetIOStreams(cmd, cmdOpts.IOStreams)
+
+	return cmd
+}
+
+// addFlags adds the flags for the get lineage options to the provided command.
+func (o *getLineageOptions) addFlags(cmd *cobra.Command) {
+	o.ClientOptions.AddFlags(cmd.PersistentFlags())
+	addPrintFlags(cmd, o.PrintFlags)
+
+	option.Project(
+		cmd.Flags(), &o.Project, o.Config.Project,
+		"The project the freight belongs to. If not set, the default project will be used.",
+	)
+	option.Name(cmd.Flags(), &o.Name, "The name of the freight to display the lineage of.")
+	option.Alias(cmd.Flags(), &o.Alias, "The alias of the freight to display the lineage of.")
+	option.Provenance(
+		cmd.Flags(), &o.Provenance,
+		"Print the provenance documents of the successful promotions of the freight instead of its lineage.",
+	)
+	option.Stage(
+		cmd.Flags(), &o.Stage,
+		"The stage to print the provenance documents of. If not set, documents for all stages are printed.",
+	)
+
+	cmd.MarkFlagsOneRequired(option.NameFlag, option.AliasFlag)
+	cmd.MarkFlagsMutuallyExclusive(option.NameFlag, option.AliasFlag)
+	cmd.MarkFlagsMutuallyExclusive(option.ProvenanceFlag, "output")
+}
+
+// validate performs validation of the options. If the options are invalid, an
+// error is returned.
+func (o *getLineageOptions) validate() error {
+	var errs []error
+	// While the flags are marked as required, a user could still provide an empty
+	// string. This is a check to ensure that the flags are not empty.
+	if o.Project == "" {
+		errs = append(errs, fmt.Errorf("%s is required", option.ProjectFlag))
+	}
+	if o.Name == "" && o.Alias == "" {
+		errs = append(errs, fmt.Errorf("either %s or %s is required", option.NameFlag, option.AliasFlag))
+	}
+	if o.Stage != "" && !o.Provenance {
+		errs = append(errs, fmt.Errorf("%s can only be used with %s", option.StageFlag, option.ProvenanceFlag))
+	}
+	return errors.Join(errs...)
+}
+
+// run gets the lineage of the freight from the server and prints it to the
+// console.
+func (o *getLineageOptions) run(ctx context.Context) error {
+	kargoSvcCli, err := client.GetClientFromConfig(ctx, o.Config, o.ClientOptions)
+	if err != nil {
+		return fmt.Errorf("get client from config: %w", err)
+	}
+
+	resp, err := kargoSvcCli.GetFreightLineage(
+		ctx,
+		connect.NewRequest(
+			&v1alpha1.GetFreightLineageRequest{
+				Project: o.Project,
+				Name:    o.Name,
+				Alias:   o.Alias,
+			},
+		),
+	)
+	if err != nil {
+		return fmt.Errorf("get freight lineage: %w", err)
+	}
+	lineage := resp.Msg.GetLineage()
+
+	if o.Provenance {
+		return o.printProvenance(lineage)
+	}
+
+	obj, err := lineageToRuntimeObject(lineage)
+	if err != nil {
+		return fmt.Errorf("map lineage to runtime object: %w", err)
+	}
+	if err = printObjects(
+		[]*unstructured.Unstructured{obj},
+		o.PrintFlags,
+		o.IOStreams,
+		o.getOptions,
+	); err != nil {
+		return fmt.Errorf("print lineage: %w", err)
+	}
+	return nil
+}
+
+// printProvenance prints the provenance documents of the deployments in the
+// provided lineage, limited to those of the requested stage if any, to the
+// console. Each document is printed as a single line of JSON, as expected of
+// in-toto bundles.
+func (o *getLineageOptions) printProvenance(lineage *v1alpha1.FreightLineage) error {
+	stage := o.Stage
+	var found bool
+	for _, stageLineage := range lineage.GetStages() {
+		if stage != "" && stageLineage.GetStage() != stage {
+			continue
+		}
+		for _, deployment := range stageLineage.GetDeployments() {
+			if len(deployment.GetProvenance()) == 0 {
+				continue
+			}
+			found = true
+			if _, err := fmt.Fprintln(o.Out, string(deployment.GetProvenance())); err != nil {
+				return err
+			}
+		}
+	}
+	if !found {
+		if stage != "" {
+			return fmt.Errorf(
+				"freight %q has not been successfully promoted to stage %q",
+				lineage.GetFreight().Name, stage,
+			)
+		}
+		return fmt.Errorf("freight %q has not been successfully promoted", lineage.GetFreight().Name)
+	}
+	return nil
+}
+
+// newFreightLineageTable returns a table with one row for every promotion of
+// the Freight in each of the provided lineages to a Stage, and one row for
+// every Stage the Freight was approved for, verified in or is currently in use
+// by without having been promoted to it.
+func newFreightLineageTable(list *metav1.List) *metav1.Table {
+	var rows []metav1.TableRow
+	for _, item := range list.Items {
+		lineage := item.Object.(*unstructured.Unstructured) // nolint: forcetypeassert
+		stages, _, _ := unstructured.NestedSlice(lineage.Object, "stages")
+		for _, s := range stages {
+			stage, ok := s.(map[string]any)
+			if !ok {
+				continue
+			}
+			stageName, _, _ := unstructured.NestedString(stage, "stage")
+			status := stageStatus(stage)
+			deployments, _, _ := unstructured.NestedSlice(stage, "deployments")
+			if len(deployments) == 0 {
+				rows = append(rows, metav1.TableRow{
+					Cells:  []any{lineage.GetName(), stageName, status, "", "", "", ""},
+					Object: item,
+				})
+				continue
+			}
+			for _, d := range deployments {
+				deployment, ok := d.(map[string]any)
+				if !ok {
+					continue
+				}
+				promoName, _, _ := unstructured.NestedString(deployment, "promotion", "metadata", "name")
+				phase, _, _ := unstructured.NestedString(deployment, "promotion", "status", "phase")
+				created, _, _ := unstructured.NestedString(
+					deployment, "promotion", "metadata", "creationTimestamp",
+				)
+				var verification string
+				if verifications, _, _ := unstructured.NestedSlice(deployment, "verifications"); len(verifications) > 0 {
+					if v, ok := verifications[0].(map[string]any); ok {
+						verification, _, _ = unstructured.NestedString(v, "phase")
+					}
+				}
+				rows = append(rows, metav1.TableRow{
+					Cells: []any{
+						lineage.GetName(),
+						stageName,
+						status,
+						promoName,
+						phase,
+						verification,
+						age(created),
+					},
+					Object: item,
+				})
+			}
+		}
+	}
+	return &metav1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "Freight", Type: "string"},
+			{Name: "Stage", Type: "string"},
+			{Name: "Status", Type: "string"},
+			{Name: "Promotion", Type: "string"},
+			{Name: "Phase", Type: "string"},
+			{Name: "Verification", Type: "string"},
+			{Name: "Age", Type: "string"},
+		},
+		Rows: rows,
+	}
+}
+
+// stageStatus returns a summary of the status of the freight in the stage
+// described by the provided unstructured stage lineage.
+func stageStatus(stageLineage map[string]any) string {
+	var status []string
+	for _, s := range []struct {
+		field  string
+		status string
+	}{
+		{field: "approved", status: "Approved"},
+		{field: "verified", status: "Verified"},
+		{field: "current", status: "Current"},
+	} {
+		if _, ok := stageLineage[s.field]; ok {
+			status = append(status, s.status)
+		}
+	}
+	return strings.Join(status, ",")
+}
+
+// age returns a human-readable representation of the time elapsed since the
+// provided RFC 3339 timestamp, or an empty string if it is empty or invalid.
+func age(timestamp string) string {
+	t, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return ""
+	}
+	return duration.HumanDuration(time.Since(t))
+}
+
+// lineageToRuntimeObject converts the provided lineage into a runtime object
+// that can be printed. The provenance documents are embedded as JSON objects
+// rather than as the encoded bytes they are transported as.
+func lineageToRuntimeObject(lineage *v1alpha1.FreightLineage) (*unstructured.Unstructured, error) {
+	data, err := json.Marshal(lineage)
+	if err != nil {
+		return nil, fmt.Errorf("marshal lineage: %w", err)
+	}
+	var content map[string]any
+	if err = json.Unmarshal(data, &content); err != nil {
+		return nil, fmt.Errorf("unmarshal lineage: %w", err)
+	}
+	stages, _, _ := unstructured.NestedSlice(content, "stages")
+	for i, stageLineage := range lineage.GetStages() {
+		stage, ok := stages[i].(map[string]any)
+		if !ok {
+			continue
+		}
+		deployments, _, _ := unstructured.NestedSlice(stage, "deployments")
+		for j, deployment := range stageLineage.GetDeployments() {
+			if len(deployment.GetProvenance()) == 0 {
+				continue
+			}
+			var statement map[string]any
+			if err = json.Unmarshal(deployment.GetProvenance(), &statement); err != nil {
+				return nil, fmt.Errorf("unmarshal provenance: %w", err)
+			}
+			if d, ok := deployments[j].(map[string]any); ok {
+				d["provenance"] = statement
+			}
+		}
+		if len(deployments) > 0 {
+			stage["deployments"] = deployments
+		}
+	}
+	if len(stages) > 0 {
+		content["stages"] = stages
+	}
+
+	u := &unstructured.Unstructured{}
+	u.SetUnstructuredContent(content)
+	u.SetAPIVersion(kargoapi.GroupVersion.String())
+	u.SetKind("FreightLineage")
+	// The lineage assumes the identity of its Freight, so that it can be
+	// selected and sorted like the Freight itself.
+	if freight := lineage.GetFreight(); freight != nil {
+		u.SetNamespace(freight.Namespace)
+		u.SetName(freight.Name)
+		u.SetLabels(freight.Labels)
+		u.SetCreationTimestamp(freight.CreationTimestamp)
+	}
+	return u, nil
+}
@@ -17,7 +17,6 @@ import (
 	"github.com/akuity/kargo/pkg/cli/client"
 	"github.com/akuity/kargo/pkg/cli/config"
 	"github.com/akuity/kargo/pkg/cli/io"
-	"github.com/akuity/kargo/pkg/cli/kubernetes"
 	"github.com/akuity/kargo/pkg/cli/option"
 	"github.com/akuity/kargo/pkg/cli/templates"
 )
@@ -43,7 +42,7 @@ func newGetProjectConfigCommand(
 		Config:     cfg,
 		IOStreams:  streams,
 		getOptions: getOptions,
-		PrintFlags: genericclioptions.NewPrintFlags("").WithTypeSetter(kubernetes.GetScheme()),
+		PrintFlags: newPrintFlags(),
 	}
 
 	cmd := &cobra.Command{
@@ -77,7 +76,7 @@ kargo get projectconfig
 // command.
 func (o *getProjectConfigOptions) addFlags(cmd *cobra.Command) {
 	o.ClientOptions.AddFlags(cmd.PersistentFlags())
-	o.AddFlags(cmd)
+	addPrintFlags(cmd, o.PrintFlags)
 
 	option.Project(
 		cmd.Flags(), &o.Project, o.Config.Project,
@@ -108,7 +107,7 @@ func (o *getProjectConfigOptions) run(ctx context.Context) error {
 		res = append(res, resp.Msg.GetProjectConfig())
 	}
 
-	if err = printObjects(res, o.PrintFlags, o.IOStreams, o.NoHeaders); err != nil {
+	if err = printObjects(res, o.PrintFlags, o.IOStreams, o.getOptions); err != nil {
 		return fmt.Errorf("print project configuration: %w", err)
 	}
 	return nil
@@ -19,7 +19,6 @@ import (
 	"github.com/akuity/kargo/pkg/cli/client"
 	"github.com/akuity/kargo/pkg/cli/config"
 	"github.com/akuity/kargo/pkg/cli/io"
-	"github.com/akuity/kargo/pkg/cli/kubernetes"
 	"github.com/akuity/kargo/pkg/cli/templates"
 	"github.com/akuity/kargo/pkg/conditions"
 )
@@ -45,7 +44,7 @@ func newGetProjectsCommand(
 		Config:     cfg,
 		IOStreams:  streams,
 		getOptions: getOptions,
-		PrintFlags: genericclioptions.NewPrintFlags("").WithTypeSetter(kubernetes.GetScheme()),
+		PrintFlags: newPrintFlags(),
 	}
 
 	cmd := &cobra.Command{
@@ -81,7 +80,7 @@ kargo get project my-project
 // addFlags adds the flags for the get projects options to the provided command.
 func (o *getProjectsOptions) addFlags(cmd *cobra.Command) {
 	o.ClientOptions.AddFlags(cmd.PersistentFlags())
-	o.AddFlags(cmd)
+	addPrintFlags(cmd, o.PrintFlags)
 }
 
 // complete sets the options from the command arguments.
@@ -104,7 +103,7 @@ func (o *getProjectsOptions) run(ctx context.Context) error {
 		); err != nil {
 			return fmt.Errorf("list projects: %w", err)
 		}
-		return printObjects(resp.Msg.GetProjects(), o.PrintFlags, o.IOStreams, o.NoHeaders)
+		return printObjects(resp.Msg.GetProjects(), o.PrintFlags, o.IOStreams, o.getOptions)
 	}
 
 	res := make([]*kargoapi.Project, 0, len(o.Names))
@@ -125,7 +124,7 @@ func (o *getProjectsOptions) run(ctx context.Context) error {
 		res = append(res, resp.Msg.GetProject())
 	}
 
-	if err = printObjects(res, o.PrintFlags, o.IOStreams, o.NoHeaders); err != nil {
+	if err = printObjects(res, o.PrintFlags, o.IOStreams, o.getOptions); err != nil {
 		return fmt.Errorf("print projects: %w", err)
 	}
 	return errors.Join(errs...)
@@ -15,11 +15,11 @@ import (
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 
 	v1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	"github.com/akuity/kargo/api/service/v1alpha1/svcv1alpha1connect"
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/pkg/cli/client"
 	"github.com/akuity/kargo/pkg/cli/config"
 	"github.com/akuity/kargo/pkg/cli/io"
-	"github.com/akuity/kargo/pkg/cli/kubernetes"
 	"github.com/akuity/kargo/pkg/cli/option"
 	"github.com/akuity/kargo/pkg/cli/templates"
 )
@@ -36,6 +36,7 @@ type getPromotionsOptions struct {
 	Project string
 	Stage   string
 	Names   []string
+	Watch   bool
 }
 
 func newGetPromotionsCommand(
@@ -47,11 +48,11 @@ func newGetPromotionsCommand(
 		Config:     cfg,
 		IOStreams:  streams,
 		getOptions: getOptions,
-		PrintFlags: genericclioptions.NewPrintFlags("").WithTypeSetter(kubernetes.GetScheme()),
+		PrintFlags: newPrintFlags(),
 	}
 
 	cmd := &cobra.Command{
-		Use:     "promotions [--project=project] [--stage=stage] [NAME ...] [--no-headers]",
+		Use:     "promotions [--project=project] [--stage=stage] [NAME ...] [--no-headers] [--watch]",
 		Aliases: []string{"promotion", "promos", "promo"},
 		Short:   "Display one or many promotions",
 		Example: templates.Example(`
@@ -64,6 +65,9 @@ kargo get promotions --project=my-project -o json
 # List all promotions for the QA stage in my-project
 kargo get promotions --project=my-project --stage=qa
 
+# List all promotions for the QA stage in my-project and watch for changes
+kargo get promotions --project=my-project --stage=qa --watch
+
 # Get a specific promotion in my-project
 kargo get promotion --project=my-project abc1234
 
@@ -102,7 +106,7 @@ kargo get promotion abc1234
 // addFlags adds the flags for the get promotions options to the provided command.
 func (o *getPromotionsOptions) addFlags(cmd *cobra.Command) {
 	o.ClientOptions.AddFlags(cmd.PersistentFlags())
-	o.AddFlags(cmd)
+	addPrintFlags(cmd, o.PrintFlags)
 
 	option.Project(
 		cmd.Flags(), &o.Project, o.Config.Project,
@@ -112,6 +116,10 @@ func (o *getPromotionsOptions) addFlags(cmd *cobra.Command) {
 		cmd.Flags(), &o.Stage,
 		"The stage for which to list promotions. If not set, all stages will be listed.",
 	)
+	option.Watch(
+		cmd.Flags(), &o.Watch,
+		"After listing the requested promotions, watch for changes to them.",
+	)
 }
 
 // complete sets the options from the command arguments.
@@ -148,7 +156,11 @@ func (o *getPromotionsOptions) run(ctx context.Context) error {
 		); err != nil {
 			return fmt.Errorf("list promotions: %w", err)
 		}
-		return printObjects(resp.Msg.GetPromotions(), o.PrintFlags, o.IOStreams, o.NoHeaders)
+		err = printObjects(resp.Msg.GetPromotions(), o.PrintFlags, o.IOStreams, o.getOptions)
+		if err != nil || !o.Watch {
+			return err
+		}
+		return o.watch(ctx, kargoSvcCli)
 	}
 
 	res := make([]*kargoapi.Promotion, 0, len(o.Names))
@@ -170,10 +182,40 @@ func (o *getPromotionsOptions) run(ctx context.Context) error {
 		res = append(res, resp.Msg.GetPromotion())
 	}
 
-	if err = printObjects(res, o.PrintFlags, o.IOStreams, o.NoHeaders); err != nil {
+	if err = printObjects(res, o.PrintFlags, o.IOStreams, o.getOptions); err != nil {
 		return fmt.Errorf("print promotions: %w", err)
 	}
-	return errors.Join(errs...)
+	if err = errors.Join(errs...); err != nil || !o.Watch {
+		return err
+	}
+	return o.watch(ctx, kargoSvcCli)
+}
+
+// watch streams changes to the promotions from the server and prints them to
+// the console until the stream ends.
+func (o *getPromotionsOptions) watch(
+	ctx context.Context,
+	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
+) error {
+	req := &v1alpha1.WatchPromotionsRequest{
+		Project: o.Project,
+	}
+	if o.Stage != "" {
+		req.Stage = &o.Stage
+	}
+	stream, err := kargoSvcCli.WatchPromotions(ctx, connect.NewRequest(req))
+	if err != nil {
+		return fmt.Errorf("watch promotions: %w", err)
+	}
+	return watchObjects(
+		stream,
+		(*v1alpha1.WatchPromotionsResponse).GetPromotion,
+		(*v1alpha1.WatchPromotionsResponse).GetType,
+		nameFilter[*kargoapi.Promotion](o.Names),
+		o.PrintFlags,
+		o.IOStreams,
+		o.getOptions,
+	)
 }
 
 func newPromotionTable(list *metav1.List) *metav1.Table {
@@ -20,7 +20,6 @@ import (
 	"github.com/akuity/kargo/pkg/cli/client"
 	"github.com/akuity/kargo/pkg/cli/config"
 	"github.com/akuity/kargo/pkg/cli/io"
-	"github.com/akuity/kargo/pkg/cli/kubernetes"
 	"github.com/akuity/kargo/pkg/cli/option"
 	"github.com/akuity/kargo/pkg/cli/templates"
 )
@@ -48,7 +47,7 @@ func newRolesCommand(
 		Config:     cfg,
 		IOStreams:  streams,
 		getOptions: getOptions,
-		PrintFlags: genericclioptions.NewPrintFlags("").WithTypeSetter(kubernetes.GetScheme()),
+		PrintFlags: newPrintFlags(),
 	}
 
 	cmd := &cobra.Command{
@@ -96,7 +95,7 @@ kargo get role dev
 // addFlags adds the flags for the get roles options to the provided command.
 func (o *getRolesOptions) addFlags(cmd *cobra.Command) {
 	o.ClientOptions.AddFlags(cmd.PersistentFlags())
-	o.AddFlags(cmd)
+	addPrintFlags(cmd, o.PrintFlags)
 
 	option.Project(
 		cmd.Flags(), &o.Project, o.Config.Project,
@@ -186,11 +185,11 @@ func (o *getRolesOptions) run(ctx context.Context) error {
 	}
 
 	if o.AsKubernetesResources {
-		if err = printObjects(resourcesRes, o.PrintFlags, o.IOStreams, o.NoHeaders); err != nil {
+		if err = printObjects(resourcesRes, o.PrintFlags, o.IOStreams, o.getOptions); err != nil {
 			return fmt.Errorf("print resources: %w", err)
 		}
 	} else {
-		if err = printObjects(kargoRoleRes, o.PrintFlags, o.IOStreams, o.NoHeaders); err != nil {
+		if err = printObjects(kargoRoleRes, o.PrintFlags, o.IOStreams, o.getOptions); err != nil {
 			return fmt.Errorf("print roles: %w", err)
 		}
 	}
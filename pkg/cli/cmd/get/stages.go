@@ -15,11 +15,11 @@ import (
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 
 	v1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	"github.com/akuity/kargo/api/service/v1alpha1/svcv1alpha1connect"
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/pkg/cli/client"
 	"github.com/akuity/kargo/pkg/cli/config"
 	"github.com/akuity/kargo/pkg/cli/io"
-	"github.com/akuity/kargo/pkg/cli/kubernetes"
 	"github.com/akuity/kargo/pkg/cli/option"
 	"github.com/akuity/kargo/pkg/cli/templates"
 	"github.com/akuity/kargo/pkg/conditions"
@@ -36,6 +36,7 @@ type getStagesOptions struct {
 
 	Project string
 	Names   []string
+	Watch   bool
 }
 
 func newGetStagesCommand(
@@ -47,11 +48,11 @@ func newGetStagesCommand(
 		Config:     cfg,
 		IOStreams:  streams,
 		getOptions: getOptions,
-		PrintFlags: genericclioptions.NewPrintFlags("").WithTypeSetter(kubernetes.GetScheme()),
+		PrintFlags: newPrintFlags(),
 	}
 
 	cmd := &cobra.Command{
-		Use:     "stages [--project=project] [NAME ...] [--no-headers]",
+		Use:     "stages [--project=project] [NAME ...] [--no-headers] [--watch]",
 		Aliases: []string{"stage"},
 		Short:   "Display one or many stages",
 		Example: templates.Example(`
@@ -64,6 +65,9 @@ kargo get stages --project=my-project -o json
 # Get the QA stage in my-project
 kargo get stage --project=my-project qa
 
+# Watch the QA stage in my-project for changes
+kargo get stage --project=my-project qa --watch
+
 # List all stages in the default project
 kargo config set-project my-project
 kargo get stages
@@ -95,12 +99,16 @@ kargo get stage qa
 // addFlags adds the flags for the get stages options to the provided command.
 func (o *getStagesOptions) addFlags(cmd *cobra.Command) {
 	o.ClientOptions.AddFlags(cmd.PersistentFlags())
-	o.AddFlags(cmd)
+	addPrintFlags(cmd, o.PrintFlags)
 
 	option.Project(
 		cmd.Flags(), &o.Project, o.Config.Project,
 		"The project for which to list stages. If not set, the default project will be used.",
 	)
+	option.Watch(
+		cmd.Flags(), &o.Watch,
+		"After listing the requested stages, watch for changes to them.",
+	)
 }
 
 // complete sets the options from the command arguments.
@@ -136,7 +144,11 @@ func (o *getStagesOptions) run(ctx context.Context) error {
 		); err != nil {
 			return fmt.Errorf("list stages: %w", err)
 		}
-		return printObjects(resp.Msg.GetStages(), o.PrintFlags, o.IOStreams, o.NoHeaders)
+		err = printObjects(resp.Msg.GetStages(), o.PrintFlags, o.IOStreams, o.getOptions)
+		if err != nil || !o.Watch {
+			return err
+		}
+		return o.watch(ctx, kargoSvcCli)
 	}
 
 	res := make([]*kargoapi.Stage, 0, len(o.Names))
@@ -158,10 +170,40 @@ func (o *getStagesOptions) run(ctx context.Context) error {
 		res = append(res, resp.Msg.GetStage())
 	}
 
-	if err = printObjects(res, o.PrintFlags, o.IOStreams, o.NoHeaders); err != nil {
+	if err = printObjects(res, o.PrintFlags, o.IOStreams, o.getOptions); err != nil {
 		return fmt.Errorf("print stages: %w", err)
 	}
-	return errors.Join(errs...)
+	if err = errors.Join(errs...); err != nil || !o.Watch {
+		return err
+	}
+	return o.watch(ctx, kargoSvcCli)
+}
+
+// watch streams changes to the stages from the server and prints them to the
+// console until the stream ends.
+func (o *getStagesOptions) watch(
+	ctx context.Context,
+	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
+) error {
+	req := &v1alpha1.WatchStagesRequest{
+		Project: o.Project,
+	}
+	if len(o.Names) == 1 {
+		req.Name = o.Names[0]
+	}
+	stream, err := kargoSvcCli.WatchStages(ctx, connect.NewRequest(req))
+	if err != nil {
+		return fmt.Errorf("watch stages: %w", err)
+	}
+	return watchObjects(
+		stream,
+		(*v1alpha1.WatchStagesResponse).GetStage,
+		(*v1alpha1.WatchStagesResponse).GetType,
+		nameFilter[*kargoapi.Stage](o.Names),
+		o.PrintFlags,
+		o.IOStreams,
+		o.getOptions,
+	)
 }
 
 func newStageTable(list *metav1.List) *metav1.Table {
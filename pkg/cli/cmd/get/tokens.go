@@ -0,0 +1,200 @@
+package get
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	rbacapi "github.com/akuity/kargo/api/rbac/v1alpha1"
+	v1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/cli/client"
+	"github.com/akuity/kargo/pkg/cli/config"
+	"github.com/akuity/kargo/pkg/cli/io"
+	"github.com/akuity/kargo/pkg/cli/option"
+	"github.com/akuity/kargo/pkg/cli/templates"
+)
+
+type getTokensOptions struct {
+	genericiooptions.IOStreams
+	*genericclioptions.PrintFlags
+
+	*getOptions
+
+	Config        config.CLIConfig
+	ClientOptions client.Options
+
+	Project string
+	Names   []string
+}
+
+func newGetTokensCommand(
+	cfg config.CLIConfig,
+	streams genericiooptions.IOStreams,
+	getOptions *getOptions,
+) *cobra.Command {
+	cmdOpts := &getTokensOptions{
+		Config:     cfg,
+		IOStreams:  streams,
+		getOptions: getOptions,
+		PrintFlags: newPrintFlags(),
+	}
+
+	cmd := &cobra.Command{
+		Use:     "tokens [--project=project] [NAME ...] [--no-headers]",
+		Aliases: []string{"token"},
+		Short:   "Display one or many API tokens",
+		Example: templates.Example(`
+# List all tokens in my-project
+kargo get tokens --project=my-project
+
+# List all tokens in my-project in JSON output format
+kargo get tokens --project=my-project -o json
+
+# Get the ci token in my-project
+kargo get token --project=my-project ci
+
+# List all tokens in the default project
+kargo config set-project my-project
+kargo get tokens
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdOpts.complete(args)
+
+			if err := cmdOpts.validate(); err != nil {
+				return err
+			}
+
+			return cmdOpts.run(cmd.Context())
+		},
+	}
+
+	// Register the option flags on the command.
+	cmdOpts.addFlags(cmd)
+
+	// Set the input/output streams for the command.
+	io.SetIOStreams(cmd, cmdOpts.IOStreams)
+
+	return cmd
+}
+
+// addFlags adds the flags for the get tokens options to the provided command.
+func (o *getTokensOptions) addFlags(cmd *cobra.Command) {
+	o.ClientOptions.AddFlags(cmd.PersistentFlags())
+	addPrintFlags(cmd, o.PrintFlags)
+
+	option.Project(
+		cmd.Flags(), &o.Project, o.Config.Project,
+		"The project for which to list tokens. If not set, the default project will be used.",
+	)
+}
+
+// complete sets the options from the command arguments.
+func (o *getTokensOptions) complete(args []string) {
+	o.Names = slices.Compact(args)
+}
+
+// validate performs validation of the options. If the options are invalid, an
+// error is returned.
+func (o *getTokensOptions) validate() error {
+	// While the flags are marked as required, a user could still provide an empty
+	// string. This is a check to ensure that the flags are not empty.
+	if o.Project == "" {
+		return fmt.Errorf("%s is required", option.ProjectFlag)
+	}
+	return nil
+}
+
+// run gets the tokens from the server and prints them to the console.
+func (o *getTokensOptions) run(ctx context.Context) error {
+	kargoSvcCli, err := client.GetClientFromConfig(ctx, o.Config, o.ClientOptions)
+	if err != nil {
+		return fmt.Errorf("get client from config: %w", err)
+	}
+
+	var tokens []*rbacapi.APIToken
+	var errs []error
+
+	if len(o.Names) == 0 {
+		var resp *connect.Response[v1alpha1.ListAPITokensResponse]
+		if resp, err = kargoSvcCli.ListAPITokens(
+			ctx,
+			connect.NewRequest(&v1alpha1.ListAPITokensRequest{
+				Project: o.Project,
+			}),
+		); err != nil {
+			return fmt.Errorf("list tokens: %w", err)
+		}
+		tokens = resp.Msg.GetTokens()
+	} else {
+		errs = make([]error, 0, len(o.Names))
+		tokens = make([]*rbacapi.APIToken, 0, len(o.Names))
+		for _, name := range o.Names {
+			var resp *connect.Response[v1alpha1.GetAPITokenResponse]
+			if resp, err = kargoSvcCli.GetAPIToken(
+				ctx,
+				connect.NewRequest(&v1alpha1.GetAPITokenRequest{
+					Project: o.Project,
+					Name:    name,
+				}),
+			); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			tokens = append(tokens, resp.Msg.GetToken())
+		}
+	}
+
+	if err = printObjects(tokens, o.PrintFlags, o.IOStreams, o.getOptions); err != nil {
+		return fmt.Errorf("print tokens: %w", err)
+	}
+	return errors.Join(errs...)
+}
+
+func newAPITokenTable(list *metav1.List) *metav1.Table {
+	now := metav1.Now()
+	rows := make([]metav1.TableRow, len(list.Items))
+	for i, item := range list.Items {
+		token := item.Object.(*rbacapi.APIToken) // nolint: forcetypeassert
+		expires := "Never"
+		if token.ExpiresAt != nil {
+			if token.IsExpired(now) {
+				expires = "Expired"
+			} else {
+				expires = duration.HumanDuration(token.ExpiresAt.Sub(now.Time))
+			}
+		}
+		lastUsed := "Never"
+		if token.LastUsedAt != nil {
+			lastUsed = duration.HumanDuration(now.Sub(token.LastUsedAt.Time))
+		}
+		rows[i] = metav1.TableRow{
+			Cells: []any{
+				token.Name,
+				token.RoleName,
+				expires,
+				lastUsed,
+				duration.HumanDuration(time.Since(token.CreationTimestamp.Time)),
+			},
+			Object: list.Items[i],
+		}
+	}
+	return &metav1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "Name", Type: "string"},
+			{Name: "Role", Type: "string"},
+			{Name: "Expires In", Type: "string"},
+			{Name: "Last Used", Type: "string"},
+			{Name: "Age", Type: "string"},
+		},
+		Rows: rows,
+	}
+}
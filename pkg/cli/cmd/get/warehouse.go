@@ -15,11 +15,11 @@ import (
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 
 	v1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	"github.com/akuity/kargo/api/service/v1alpha1/svcv1alpha1connect"
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/pkg/cli/client"
 	"github.com/akuity/kargo/pkg/cli/config"
 	"github.com/akuity/kargo/pkg/cli/io"
-	"github.com/akuity/kargo/pkg/cli/kubernetes"
 	"github.com/akuity/kargo/pkg/cli/option"
 	"github.com/akuity/kargo/pkg/cli/templates"
 )
@@ -35,6 +35,7 @@ type getWarehousesOptions struct {
 
 	Project string
 	Names   []string
+	Watch   bool
 }
 
 func newGetWarehousesCommand(
@@ -46,11 +47,11 @@ func newGetWarehousesCommand(
 		Config:     cfg,
 		IOStreams:  streams,
 		getOptions: getOptions,
-		PrintFlags: genericclioptions.NewPrintFlags("").WithTypeSetter(kubernetes.GetScheme()),
+		PrintFlags: newPrintFlags(),
 	}
 
 	cmd := &cobra.Command{
-		Use:     "warehouses [--project=project] [NAME ...] [--no-headers]",
+		Use:     "warehouses [--project=project] [NAME ...] [--no-headers] [--watch]",
 		Aliases: []string{"warehouse"},
 		Short:   "Display one or many warehouses",
 		Example: templates.Example(`
@@ -63,6 +64,9 @@ kargo get warehouses --project=my-project -o json
 # Get a specific warehouse in my-project
 kargo get warehouse --project=my-project my-warehouse
 
+# List all warehouses in my-project and watch for changes
+kargo get warehouses --project=my-project --watch
+
 # List all warehouses in the default project
 kargo config set-project my-project
 kargo get warehouses
@@ -95,12 +99,16 @@ kargo get warehouse my-warehouse
 // command.
 func (o *getWarehousesOptions) addFlags(cmd *cobra.Command) {
 	o.ClientOptions.AddFlags(cmd.PersistentFlags())
-	o.AddFlags(cmd)
+	addPrintFlags(cmd, o.PrintFlags)
 
 	option.Project(
 		cmd.Flags(), &o.Project, o.Config.Project,
 		"The project for which to list Warehouses. If not set, the default project will be used.",
 	)
+	option.Watch(
+		cmd.Flags(), &o.Watch,
+		"After listing the requested Warehouses, watch for changes to them.",
+	)
 }
 
 // complete sets the options from the command arguments.
@@ -136,7 +144,11 @@ func (o *getWarehousesOptions) run(ctx context.Context) error {
 		); err != nil {
 			return fmt.Errorf("list warehouses: %w", err)
 		}
-		return printObjects(resp.Msg.GetWarehouses(), o.PrintFlags, o.IOStreams, o.NoHeaders)
+		err = printObjects(resp.Msg.GetWarehouses(), o.PrintFlags, o.IOStreams, o.getOptions)
+		if err != nil || !o.Watch {
+			return err
+		}
+		return o.watch(ctx, kargoSvcCli)
 	}
 
 	res := make([]*kargoapi.Warehouse, 0, len(o.Names))
@@ -158,10 +170,40 @@ func (o *getWarehousesOptions) run(ctx context.Context) error {
 		res = append(res, resp.Msg.GetWarehouse())
 	}
 
-	if err = printObjects(res, o.PrintFlags, o.IOStreams, o.NoHeaders); err != nil {
+	if err = printObjects(res, o.PrintFlags, o.IOStreams, o.getOptions); err != nil {
 		return fmt.Errorf("print warehouses: %w", err)
 	}
-	return errors.Join(errs...)
+	if err = errors.Join(errs...); err != nil || !o.Watch {
+		return err
+	}
+	return o.watch(ctx, kargoSvcCli)
+}
+
+// watch streams changes to the Warehouses from the server and prints them to
+// the console until the stream ends.
+func (o *getWarehousesOptions) watch(
+	ctx context.Context,
+	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
+) error {
+	req := &v1alpha1.WatchWarehousesRequest{
+		Project: o.Project,
+	}
+	if len(o.Names) == 1 {
+		req.Name = o.Names[0]
+	}
+	stream, err := kargoSvcCli.WatchWarehouses(ctx, connect.NewRequest(req))
+	if err != nil {
+		return fmt.Errorf("watch warehouses: %w", err)
+	}
+	return watchObjects(
+		stream,
+		(*v1alpha1.WatchWarehousesResponse).GetWarehouse,
+		(*v1alpha1.WatchWarehousesResponse).GetType,
+		nameFilter[*kargoapi.Warehouse](o.Names),
+		o.PrintFlags,
+		o.IOStreams,
+		o.getOptions,
+	)
 }
 
 func newWarehouseTable(list *metav1.List) *metav1.Table {
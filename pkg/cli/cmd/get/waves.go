@@ -0,0 +1,222 @@
+package get
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	v1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/cli/client"
+	"github.com/akuity/kargo/pkg/cli/config"
+	"github.com/akuity/kargo/pkg/cli/io"
+	"github.com/akuity/kargo/pkg/cli/option"
+	"github.com/akuity/kargo/pkg/cli/templates"
+)
+
+type getWavesOptions struct {
+	genericiooptions.IOStreams
+	*genericclioptions.PrintFlags
+
+	*getOptions
+
+	Config        config.CLIConfig
+	ClientOptions client.Options
+
+	Project string
+	Policy  string
+	Freight string
+}
+
+func newGetWavesCommand(
+	cfg config.CLIConfig,
+	streams genericiooptions.IOStreams,
+	getOptions *getOptions,
+) *cobra.Command {
+	cmdOpts := &getWavesOptions{
+		Config:     cfg,
+		IOStreams:  streams,
+		getOptions: getOptions,
+		PrintFlags: newPrintFlags(),
+	}
+
+	cmd := &cobra.Command{
+		Use:     "waves [--project=project] [--policy=policy] [--freight=freight]",
+		Aliases: []string{"wave"},
+		Short:   "Display the progress of freight through promotion waves",
+		Long: `Display the progress of freight through the waves of the promotion wave
+policies of a project.
+
+Unless a piece of freight is specified, the progress of the newest freight to
+have reached any stage selected by each policy is displayed.`,
+		Args: option.NoArgs,
+		Example: templates.Example(`
+# Display the progress of freight through all promotion wave policies
+kargo get waves --project=my-project
+
+# Display the progress of a piece of freight through a single policy
+kargo get waves --project=my-project --policy=prod --freight=abc1234
+
+# Display the progress of freight in YAML output format
+kargo get waves --project=my-project -o yaml
+
+# Display the policies and the freight progressing through them, sorted by policy
+kargo get waves --project=my-project --sort-by=.policy \
+  -o custom-columns=POLICY:.policy,FREIGHT:.freight,HALTED:.halted
+`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := cmdOpts.validate(); err != nil {
+				return err
+			}
+
+			return cmdOpts.run(cmd.Context())
+		},
+	}
+
+	// Register the option flags on the command.
+	cmdOpts.addFlags(cmd)
+
+	// Set the input/output streams for the command.
+	io.SetIOStreams(cmd, cmdOpts.IOStreams)
+
+	return cmd
+}
+
+// addFlags adds the flags for the get waves options to the provided command.
+func (o *getWavesOptions) addFlags(cmd *cobra.Command) {
+	o.ClientOptions.AddFlags(cmd.PersistentFlags())
+	addPrintFlags(cmd, o.PrintFlags)
+
+	option.Project(
+		cmd.Flags(), &o.Project, o.Config.Project,
+		"The project to display the promotion waves of. If not set, the default project will be used.",
+	)
+	option.Policy(
+		cmd.Flags(), &o.Policy,
+		"The promotion wave policy to display. If not set, all policies are displayed.",
+	)
+	option.Freight(
+		cmd.Flags(), &o.Freight,
+		"The name of the freight to display the progress of. If not set, the newest freight is displayed.",
+	)
+}
+
+// validate performs validation of the options. If the options are invalid, an
+// error is returned.
+func (o *getWavesOptions) validate() error {
+	var errs []error
+	// While the flags are marked as required, a user could still provide an empty
+	// string. This is a check to ensure that the flags are not empty.
+	if o.Project == "" {
+		errs = append(errs, fmt.Errorf("%s is required", option.ProjectFlag))
+	}
+	return errors.Join(errs...)
+}
+
+// run gets the progress of freight through the promotion waves from the
+// server and prints it to the console.
+func (o *getWavesOptions) run(ctx context.Context) error {
+	kargoSvcCli, err := client.GetClientFromConfig(ctx, o.Config, o.ClientOptions)
+	if err != nil {
+		return fmt.Errorf("get client from config: %w", err)
+	}
+
+	resp, err := kargoSvcCli.GetPromotionWaves(
+		ctx,
+		connect.NewRequest(
+			&v1alpha1.GetPromotionWavesRequest{
+				Project: o.Project,
+				Policy:  o.Policy,
+				Freight: o.Freight,
+			},
+		),
+	)
+	if err != nil {
+		return fmt.Errorf("get promotion waves: %w", err)
+	}
+
+	objs := make([]*unstructured.Unstructured, 0, len(resp.Msg.GetProgress()))
+	for _, p := range resp.Msg.GetProgress() {
+		obj, err := waveProgressToRuntimeObject(o.Project, p)
+		if err != nil {
+			return fmt.Errorf("map promotion wave progress to runtime object: %w", err)
+		}
+		objs = append(objs, obj)
+	}
+	if err = printObjects(objs, o.PrintFlags, o.IOStreams, o.getOptions); err != nil {
+		return fmt.Errorf("print promotion wave progress: %w", err)
+	}
+	return nil
+}
+
+// newPromotionWaveProgressTable returns a table with one row for every wave of
+// each of the provided promotion wave progresses.
+func newPromotionWaveProgressTable(list *metav1.List) *metav1.Table {
+	var rows []metav1.TableRow
+	for _, item := range list.Items {
+		progress := &kargoapi.PromotionWaveProgress{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(
+			item.Object.(*unstructured.Unstructured).Object, // nolint: forcetypeassert
+			progress,
+		); err != nil {
+			continue
+		}
+		for _, wave := range progress.Waves {
+			rows = append(rows, metav1.TableRow{
+				Cells: []any{
+					progress.Policy,
+					progress.Freight,
+					wave.Name,
+					wave.Phase,
+					fmt.Sprintf("%d/%d", len(wave.VerifiedStages), len(wave.Stages)),
+					len(wave.FailedStages),
+				},
+				Object: item,
+			})
+		}
+	}
+	return &metav1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "Policy", Type: "string"},
+			{Name: "Freight", Type: "string"},
+			{Name: "Wave", Type: "string"},
+			{Name: "Phase", Type: "string"},
+			{Name: "Verified", Type: "string"},
+			{Name: "Failed", Type: "integer"},
+		},
+		Rows: rows,
+	}
+}
+
+// waveProgressToRuntimeObject converts the provided progress through a
+// promotion wave policy of the provided project into a runtime object that can
+// be printed. The object is named after the policy.
+func waveProgressToRuntimeObject(
+	project string,
+	progress *kargoapi.PromotionWaveProgress,
+) (*unstructured.Unstructured, error) {
+	data, err := json.Marshal(progress)
+	if err != nil {
+		return nil, fmt.Errorf("marshal promotion wave progress: %w", err)
+	}
+	var content map[string]any
+	if err = json.Unmarshal(data, &content); err != nil {
+		return nil, fmt.Errorf("unmarshal promotion wave progress: %w", err)
+	}
+	u := &unstructured.Unstructured{}
+	u.SetUnstructuredContent(content)
+	u.SetAPIVersion(kargoapi.GroupVersion.String())
+	u.SetKind("PromotionWaveProgress")
+	u.SetNamespace(project)
+	u.SetName(progress.Policy)
+	return u, nil
+}
@@ -54,6 +54,9 @@ kargo logs --project=my-project some-analysis-run --container=some-container
 
 # Show logs from a specific JobMetric and container
 kargo logs --project=my-project some-analysis-run --metric=some-metric --container=some-container
+
+# Show logs captured during the execution of a promotion's steps
+kargo logs promotion --project=my-project some-promotion
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmdOpts.complete(args)
@@ -64,6 +67,9 @@ kargo logs --project=my-project some-analysis-run --metric=some-metric --contain
 	// Register the option flags on the command.
 	cmdOpts.addFlags(cmd)
 
+	// Register subcommands.
+	cmd.AddCommand(newPromotionCommand(cfg, streams))
+
 	// Set the input/output streams for the command.
 	io.SetIOStreams(cmd, cmdOpts.IOStreams)
 
@@ -0,0 +1,146 @@
+package logs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	v1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/cli/client"
+	"github.com/akuity/kargo/pkg/cli/config"
+	"github.com/akuity/kargo/pkg/cli/io"
+	"github.com/akuity/kargo/pkg/cli/option"
+	"github.com/akuity/kargo/pkg/cli/templates"
+)
+
+type promotionLogsOptions struct {
+	genericiooptions.IOStreams
+
+	Config        config.CLIConfig
+	ClientOptions client.Options
+
+	Project string
+	Name    string
+	Step    string
+	Follow  bool
+}
+
+func newPromotionCommand(
+	cfg config.CLIConfig,
+	streams genericiooptions.IOStreams,
+) *cobra.Command {
+	cmdOpts := &promotionLogsOptions{
+		Config:    cfg,
+		IOStreams: streams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "promotion [--project=project] NAME [--step=alias] [--follow]",
+		Short: "View logs captured during the execution of a Promotion's steps",
+		Args:  option.ExactArgs(1),
+		Example: templates.Example(`
+# Show logs from all steps of a promotion
+kargo logs promotion --project=my-project some-promotion
+
+# Show logs from a specific step of a promotion
+kargo logs promotion --project=my-project some-promotion --step=render
+
+# Follow logs of a running promotion until it completes
+kargo logs promotion --project=my-project some-promotion --follow
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdOpts.complete(args)
+			return cmdOpts.run(cmd.Context())
+		},
+	}
+
+	// Register the option flags on the command.
+	cmdOpts.addFlags(cmd)
+
+	// Set the input/output streams for the command.
+	io.SetIOStreams(cmd, cmdOpts.IOStreams)
+
+	return cmd
+}
+
+// addFlags adds the flags for the promotion logs options to the provided
+// command.
+func (o *promotionLogsOptions) addFlags(cmd *cobra.Command) {
+	o.ClientOptions.AddFlags(cmd.PersistentFlags())
+
+	option.Project(
+		cmd.Flags(), &o.Project, o.Config.Project,
+		"The project for which to get logs. If not set, the default project will be used.",
+	)
+	option.Step(cmd.Flags(), &o.Step, "The alias of a specific step of the Promotion")
+	option.Follow(
+		cmd.Flags(), &o.Follow,
+		"Continue streaming logs until the Promotion has completed",
+	)
+}
+
+// complete sets the options from the command arguments.
+func (o *promotionLogsOptions) complete(args []string) {
+	o.Name = strings.TrimSpace(args[0])
+}
+
+// run retrieves logs for the specified Promotion.
+func (o *promotionLogsOptions) run(ctx context.Context) error {
+	kargoSvcCli, err := client.GetClientFromConfig(ctx, o.Config, o.ClientOptions)
+	if err != nil {
+		return fmt.Errorf("get client from config: %w", err)
+	}
+
+	stream, err := kargoSvcCli.GetPromotionLogs(
+		ctx,
+		connect.NewRequest(
+			&v1alpha1.GetPromotionLogsRequest{
+				Project: o.Project,
+				Name:    o.Name,
+				Step:    o.Step,
+				Follow:  o.Follow,
+			},
+		),
+	)
+	if err != nil {
+		return fmt.Errorf("get logs from server: %w", err)
+	}
+
+	for stream.Receive() {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		chunk := stream.Msg().Chunk
+		if o.Step == "" {
+			// Logs from multiple steps are interleaved, so prefix each line with
+			// the alias of the step that produced it.
+			chunk = prefixLines(chunk, fmt.Sprintf("[%s] ", stream.Msg().Step))
+		}
+		_, _ = fmt.Fprint(o.Out, chunk)
+	}
+	if err := stream.Err(); err != nil {
+		return fmt.Errorf("receive logs: %w", err)
+	}
+	return nil
+}
+
+// prefixLines prefixes every line of the provided string with the provided
+// prefix.
+func prefixLines(s, prefix string) string {
+	lines := strings.SplitAfter(s, "\n")
+	var sb strings.Builder
+	for _, line := range lines {
+		if line == "" {
+			continue
+		}
+		sb.WriteString(prefix)
+		sb.WriteString(line)
+	}
+	return sb.String()
+}
@@ -0,0 +1,170 @@
+package promote
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+const (
+	// ExitCodePromotionFailed is the exit code used when a Promotion that was
+	// waited on has failed.
+	ExitCodePromotionFailed = 2
+	// ExitCodePromotionErrored is the exit code used when a Promotion that was
+	// waited on has errored.
+	ExitCodePromotionErrored = 3
+	// ExitCodePromotionAborted is the exit code used when a Promotion that was
+	// waited on has been aborted.
+	ExitCodePromotionAborted = 4
+)
+
+// OutcomeError is the error returned when a Promotion that was waited on
+// reached a terminal phase other than Succeeded.
+type OutcomeError struct {
+	// Promotion is the name of the Promotion.
+	Promotion string
+	// Phase is the terminal phase of the Promotion.
+	Phase kargoapi.PromotionPhase
+	// Message is the message of the Promotion's status, if any.
+	Message string
+}
+
+// Error implements the error interface.
+func (e *OutcomeError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("promotion %q %s", e.Promotion, e.Phase)
+	}
+	return fmt.Sprintf("promotion %q %s: %s", e.Promotion, e.Phase, e.Message)
+}
+
+// ExitCode returns the exit code the CLI should exit with to reflect the
+// outcome of the Promotion.
+func (e *OutcomeError) ExitCode() int {
+	switch e.Phase {
+	case kargoapi.PromotionPhaseFailed:
+		return ExitCodePromotionFailed
+	case kargoapi.PromotionPhaseErrored:
+		return ExitCodePromotionErrored
+	case kargoapi.PromotionPhaseAborted:
+		return ExitCodePromotionAborted
+	default:
+		return 1
+	}
+}
+
+// outcomeError returns an OutcomeError for each of the provided Promotions
+// that did not succeed, joined into a single error. If all Promotions
+// succeeded, nil is returned.
+func outcomeError(promos ...*kargoapi.Promotion) error {
+	var errs []error
+	for _, promo := range promos {
+		if promo == nil || promo.Status.Phase == kargoapi.PromotionPhaseSucceeded {
+			continue
+		}
+		errs = append(errs, &OutcomeError{
+			Promotion: promo.Name,
+			Phase:     promo.Status.Phase,
+			Message:   promo.Status.Message,
+		})
+	}
+	return errors.Join(errs...)
+}
+
+// progressPrinter prints the step-by-step progress of one or more Promotions
+// as updates to them are received. It is safe for concurrent use.
+type progressPrinter struct {
+	out io.Writer
+
+	mu   sync.Mutex
+	seen map[string]promotionProgress
+}
+
+// promotionProgress is the progress of a Promotion as last printed by a
+// progressPrinter.
+type promotionProgress struct {
+	phase kargoapi.PromotionPhase
+	steps kargoapi.StepExecutionMetadataList
+}
+
+func newProgressPrinter(out io.Writer) *progressPrinter {
+	return &progressPrinter{
+		out:  out,
+		seen: map[string]promotionProgress{},
+	}
+}
+
+// update prints any changes to the phase of the provided Promotion, or to the
+// status of any of its steps, since the last update for the same Promotion.
+func (p *progressPrinter) update(promo *kargoapi.Promotion) {
+	if p == nil || promo == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	prev := p.seen[promo.Name]
+	for i, step := range promo.Status.StepExecutionMetadata {
+		if step.Status == "" {
+			continue
+		}
+		if i < len(prev.steps) && prev.steps[i].Status == step.Status &&
+			prev.steps[i].ErrorCount == step.ErrorCount &&
+			prev.steps[i].Message == step.Message {
+			continue
+		}
+		line := fmt.Sprintf(
+			"%s: step %d/%d %s %s",
+			promo.Name, i+1, max(len(promo.Spec.Steps), len(promo.Status.StepExecutionMetadata)),
+			stepDisplayName(promo, i), step.Status,
+		)
+		if step.ErrorCount > 0 && step.Status == kargoapi.PromotionStepStatusRunning {
+			line += fmt.Sprintf(" (%d failed attempts)", step.ErrorCount)
+		}
+		if step.Message != "" {
+			line += ": " + step.Message
+		}
+		_, _ = fmt.Fprintln(p.out, line)
+	}
+
+	if promo.Status.Phase != "" && promo.Status.Phase != prev.phase {
+		line := fmt.Sprintf("%s: %s", promo.Name, promo.Status.Phase)
+		if promo.Status.Phase.IsTerminal() && promo.Status.Message != "" {
+			line += ": " + promo.Status.Message
+		}
+		_, _ = fmt.Fprintln(p.out, line)
+	}
+
+	p.seen[promo.Name] = promotionProgress{
+		phase: promo.Status.Phase,
+		steps: slices.Clone(promo.Status.StepExecutionMetadata),
+	}
+}
+
+// stepDisplayName returns a human-friendly name for the step at the provided
+// index of the Promotion, composed of the step's alias and the name of the
+// step it uses, if known.
+func stepDisplayName(promo *kargoapi.Promotion, i int) string {
+	var alias, uses string
+	if i < len(promo.Status.StepExecutionMetadata) {
+		alias = promo.Status.StepExecutionMetadata[i].Alias
+	}
+	if i < len(promo.Spec.Steps) {
+		if alias == "" {
+			alias = promo.Spec.Steps[i].As
+		}
+		uses = promo.Spec.Steps[i].Uses
+	}
+	switch {
+	case alias == "":
+		return uses
+	case uses == "" || alias == uses:
+		return fmt.Sprintf("%q", alias)
+	default:
+		return fmt.Sprintf("%q (%s)", alias, uses)
+	}
+}
@@ -0,0 +1,137 @@
+package promote
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestOutcomeError(t *testing.T) {
+	testCases := []struct {
+		name       string
+		promos     []*kargoapi.Promotion
+		assertions func(*testing.T, error)
+	}{
+		{
+			name: "all promotions succeeded",
+			promos: []*kargoapi.Promotion{{
+				ObjectMeta: metav1.ObjectMeta{Name: "fake-promotion"},
+				Status:     kargoapi.PromotionStatus{Phase: kargoapi.PromotionPhaseSucceeded},
+			}},
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "promotion failed",
+			promos: []*kargoapi.Promotion{{
+				ObjectMeta: metav1.ObjectMeta{Name: "fake-promotion"},
+				Status: kargoapi.PromotionStatus{
+					Phase:   kargoapi.PromotionPhaseFailed,
+					Message: "something went wrong",
+				},
+			}},
+			assertions: func(t *testing.T, err error) {
+				require.EqualError(t, err, `promotion "fake-promotion" Failed: something went wrong`)
+				outcomeErr := &OutcomeError{}
+				require.True(t, errors.As(err, &outcomeErr))
+				require.Equal(t, ExitCodePromotionFailed, outcomeErr.ExitCode())
+			},
+		},
+		{
+			name: "one of many promotions errored",
+			promos: []*kargoapi.Promotion{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "fake-promotion-1"},
+					Status:     kargoapi.PromotionStatus{Phase: kargoapi.PromotionPhaseSucceeded},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "fake-promotion-2"},
+					Status:     kargoapi.PromotionStatus{Phase: kargoapi.PromotionPhaseErrored},
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.EqualError(t, err, `promotion "fake-promotion-2" Errored`)
+				outcomeErr := &OutcomeError{}
+				require.True(t, errors.As(err, &outcomeErr))
+				require.Equal(t, ExitCodePromotionErrored, outcomeErr.ExitCode())
+			},
+		},
+		{
+			name: "promotion aborted",
+			promos: []*kargoapi.Promotion{{
+				ObjectMeta: metav1.ObjectMeta{Name: "fake-promotion"},
+				Status:     kargoapi.PromotionStatus{Phase: kargoapi.PromotionPhaseAborted},
+			}},
+			assertions: func(t *testing.T, err error) {
+				outcomeErr := &OutcomeError{}
+				require.True(t, errors.As(err, &outcomeErr))
+				require.Equal(t, ExitCodePromotionAborted, outcomeErr.ExitCode())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(t, outcomeError(testCase.promos...))
+		})
+	}
+}
+
+func TestProgressPrinter_update(t *testing.T) {
+	out := &bytes.Buffer{}
+	p := newProgressPrinter(out)
+
+	promo := &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{Name: "fake-promotion"},
+		Spec: kargoapi.PromotionSpec{
+			Steps: []kargoapi.PromotionStep{
+				{Uses: "git-clone"},
+				{Uses: "kustomize-set-image", As: "update-image"},
+			},
+		},
+		Status: kargoapi.PromotionStatus{
+			Phase: kargoapi.PromotionPhaseRunning,
+			StepExecutionMetadata: kargoapi.StepExecutionMetadataList{
+				{Alias: "step-1", Status: kargoapi.PromotionStepStatusRunning},
+			},
+		},
+	}
+	p.update(promo)
+	require.Equal(
+		t,
+		"fake-promotion: step 1/2 \"step-1\" (git-clone) Running\n"+
+			"fake-promotion: Running\n",
+		out.String(),
+	)
+
+	// An update without any changes should print nothing
+	out.Reset()
+	p.update(promo.DeepCopy())
+	require.Empty(t, out.String())
+
+	promo = promo.DeepCopy()
+	promo.Status.StepExecutionMetadata[0].Status = kargoapi.PromotionStepStatusSucceeded
+	promo.Status.StepExecutionMetadata = append(
+		promo.Status.StepExecutionMetadata,
+		kargoapi.StepExecutionMetadata{
+			Alias:   "update-image",
+			Status:  kargoapi.PromotionStepStatusErrored,
+			Message: "something went wrong",
+		},
+	)
+	promo.Status.Phase = kargoapi.PromotionPhaseErrored
+	promo.Status.Message = "step \"update-image\" errored"
+	p.update(promo)
+	require.Equal(
+		t,
+		"fake-promotion: step 1/2 \"step-1\" (git-clone) Succeeded\n"+
+			"fake-promotion: step 2/2 \"update-image\" (kustomize-set-image) Errored: something went wrong\n"+
+			"fake-promotion: Errored: step \"update-image\" errored\n",
+		out.String(),
+	)
+}
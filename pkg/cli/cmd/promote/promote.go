@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 
 	"connectrpc.com/connect"
 	"github.com/spf13/cobra"
@@ -36,6 +37,8 @@ type promotionOptions struct {
 	Stage          string
 	DownstreamFrom string
 	Abort          bool
+	Resume         bool
+	Step           string
 	Wait           bool
 }
 
@@ -48,7 +51,7 @@ func NewCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra
 
 	cmd := &cobra.Command{
 		Use: "promote [--project=project] (--freight=freight | --freight-alias=alias | --name=name) " +
-			"[(--stage=stage | --downstream-from=stage) | --abort]",
+			"[(--stage=stage | --downstream-from=stage) [--wait] | --abort | --resume [--step=step] [--wait]]",
 		Short: "Promote a piece of freight",
 		Args:  option.NoArgs,
 		// nolint: lll
@@ -65,9 +68,21 @@ kargo promote --project=my-project --freight=abc123 --downstream-from=qa
 # Promote a piece of freight specified by alias to stages immediately downstream from the QA stage
 kargo promote --project=my-project --freight-alias=wonky-wombat --downstream-from=qa
 
+# Promote a piece of freight to the QA stage and wait for the promotion to complete
+kargo promote --project=my-project --freight=abc123 --stage=qa --wait
+
 # Abort a Promotion by name
 kargo promote --project=my-project --name=my-promotion --abort
 
+# Resume a failed Promotion by name from the step at which it stopped
+kargo promote --project=my-project --name=my-promotion --resume
+
+# Resume a failed Promotion by name from its first step and wait for it to complete
+kargo promote --project=my-project --name=my-promotion --resume --step=0 --wait
+
+# Resume a failed Promotion by name from the step with the alias "push"
+kargo promote --project=my-project --name=my-promotion --resume --step=push
+
 # Promote a piece of freight specified by name to the QA stage in the default project
 kargo config set-project my-project
 kargo promote --freight=abc123 --stage=qa
@@ -113,7 +128,10 @@ func (o *promotionOptions) addFlags(cmd *cobra.Command) {
 	)
 	option.Freight(cmd.Flags(), &o.FreightName, "The name of piece of freight to promote.")
 	option.FreightAlias(cmd.Flags(), &o.FreightAlias, "The alias of piece of freight to promote.")
-	option.Name(cmd.Flags(), &o.Promotion, "The name of a promotion. Only used when aborting a promotion.")
+	option.Name(
+		cmd.Flags(), &o.Promotion,
+		"The name of a promotion. Only used when aborting or resuming a promotion.",
+	)
 	option.Stage(
 		cmd.Flags(), &o.Stage,
 		fmt.Sprintf(
@@ -131,15 +149,27 @@ func (o *promotionOptions) addFlags(cmd *cobra.Command) {
 	option.Abort(cmd.Flags(), &o.Abort, false, fmt.Sprintf(
 		"Abort a non-terminal promotion. If set, --%s must be set.", option.NameFlag,
 	))
-	option.Wait(cmd.Flags(), &o.Wait, false, "Wait for the promotion(s) to complete.")
+	option.Resume(cmd.Flags(), &o.Resume, false, fmt.Sprintf(
+		"Create a promotion which resumes a failed or errored promotion. If set, --%s must be set.",
+		option.NameFlag,
+	))
+	option.Step(cmd.Flags(), &o.Step, fmt.Sprintf(
+		"The index or alias of the step to resume the promotion from. Defaults to the step at which "+
+			"the promotion stopped. Only used when --%s is set.",
+		option.ResumeFlag,
+	))
+	option.Wait(
+		cmd.Flags(), &o.Wait, false,
+		"Wait for the promotion(s) to complete, printing the progress of each step. "+
+			"If any promotion does not succeed, the command exits with a non-zero status "+
+			"of 2 (Failed), 3 (Errored) or 4 (Aborted).",
+	)
 
 	cmd.MarkFlagsOneRequired(option.FreightFlag, option.FreightAliasFlag, option.NameFlag)
 	cmd.MarkFlagsMutuallyExclusive(option.FreightFlag, option.FreightAliasFlag, option.NameFlag)
 
-	cmd.MarkFlagsOneRequired(option.StageFlag, option.DownstreamFromFlag, option.AbortFlag)
-	cmd.MarkFlagsMutuallyExclusive(option.StageFlag, option.DownstreamFromFlag, option.AbortFlag)
-
-	cmd.MarkFlagsRequiredTogether(option.NameFlag, option.AbortFlag)
+	cmd.MarkFlagsOneRequired(option.StageFlag, option.DownstreamFromFlag, option.AbortFlag, option.ResumeFlag)
+	cmd.MarkFlagsMutuallyExclusive(option.StageFlag, option.DownstreamFromFlag, option.AbortFlag, option.ResumeFlag)
 }
 
 // validate performs validation of the options. If the options are invalid, an
@@ -151,11 +181,25 @@ func (o *promotionOptions) validate() error {
 	if o.Project == "" {
 		errs = append(errs, fmt.Errorf("%s is required", option.ProjectFlag))
 	}
-	if o.Abort {
+	if o.Step != "" && !o.Resume {
+		errs = append(errs, fmt.Errorf("%s is only allowed when resuming a promotion", option.StepFlag))
+	}
+	switch {
+	case o.Abort:
 		if o.Promotion == "" {
 			errs = append(errs, fmt.Errorf("%s is required when aborting a promotion", option.NameFlag))
 		}
-	} else {
+	case o.Resume:
+		if o.Promotion == "" {
+			errs = append(errs, fmt.Errorf("%s is required when resuming a promotion", option.NameFlag))
+		}
+	default:
+		if o.Promotion != "" {
+			errs = append(
+				errs,
+				fmt.Errorf("%s is only allowed when aborting or resuming a promotion", option.NameFlag),
+			)
+		}
 		if o.FreightName == "" && o.FreightAlias == "" {
 			errs = append(
 				errs,
@@ -198,6 +242,36 @@ func (o *promotionOptions) run(ctx context.Context) error {
 			return fmt.Errorf("abort promotion: %w", err)
 		}
 		return nil
+	case o.Resume:
+		step, err := o.resolveStep(ctx, kargoSvcCli)
+		if err != nil {
+			return err
+		}
+		res, err := kargoSvcCli.ResumePromotion(
+			ctx,
+			connect.NewRequest(
+				&v1alpha1.ResumePromotionRequest{
+					Project: o.Project,
+					Name:    o.Promotion,
+					Step:    step,
+				},
+			),
+		)
+		if err != nil {
+			return fmt.Errorf("resume promotion: %w", err)
+		}
+		if !o.Wait {
+			_ = printer.PrintObj(res.Msg.GetPromotion(), o.Out)
+			return nil
+		}
+		promos, err := waitForPromotions(
+			ctx, kargoSvcCli, newProgressPrinter(o.ErrOut), res.Msg.GetPromotion(),
+		)
+		if err != nil {
+			return fmt.Errorf("wait for promotion: %w", err)
+		}
+		_ = printer.PrintObj(promos[0], o.Out)
+		return outcomeError(promos...)
 	case o.Stage != "":
 		res, err := kargoSvcCli.PromoteToStage(
 			ctx,
@@ -213,13 +287,18 @@ func (o *promotionOptions) run(ctx context.Context) error {
 		if err != nil {
 			return fmt.Errorf("promote stage: %w", err)
 		}
-		if o.Wait {
-			if err = waitForPromotion(ctx, kargoSvcCli, res.Msg.GetPromotion()); err != nil {
-				return fmt.Errorf("wait for promotion: %w", err)
-			}
+		if !o.Wait {
+			_ = printer.PrintObj(res.Msg.GetPromotion(), o.Out)
+			return nil
 		}
-		_ = printer.PrintObj(res.Msg.GetPromotion(), o.Out)
-		return nil
+		promos, err := waitForPromotions(
+			ctx, kargoSvcCli, newProgressPrinter(o.ErrOut), res.Msg.GetPromotion(),
+		)
+		if err != nil {
+			return fmt.Errorf("wait for promotion: %w", err)
+		}
+		_ = printer.PrintObj(promos[0], o.Out)
+		return outcomeError(promos...)
 	case o.DownstreamFrom != "":
 		res, err := kargoSvcCli.PromoteDownstream(
 			ctx,
@@ -235,41 +314,98 @@ func (o *promotionOptions) run(ctx context.Context) error {
 		if err != nil {
 			return fmt.Errorf("promote stage subscribers: %w", err)
 		}
+		promos := res.Msg.GetPromotions()
 		if o.Wait {
-			if err = waitForPromotions(ctx, kargoSvcCli, res.Msg.GetPromotions()...); err != nil {
+			if promos, err = waitForPromotions(
+				ctx, kargoSvcCli, newProgressPrinter(o.ErrOut), promos...,
+			); err != nil {
 				return fmt.Errorf("wait for promotions: %w", err)
 			}
 		}
-		for _, p := range res.Msg.GetPromotions() {
+		for _, p := range promos {
 			_ = printer.PrintObj(p, o.Out)
 		}
+		if o.Wait {
+			return outcomeError(promos...)
+		}
 		return nil
 	}
 	return nil
 }
 
+// resolveStep resolves the step to resume the promotion from to its index.
+// If no step was specified, nil is returned. A step which is not an index is
+// looked up by alias in the steps of the promotion.
+func (o *promotionOptions) resolveStep(
+	ctx context.Context,
+	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
+) (*int64, error) {
+	if o.Step == "" {
+		return nil, nil
+	}
+	if i, err := strconv.ParseInt(o.Step, 10, 64); err == nil {
+		return &i, nil
+	}
+	res, err := kargoSvcCli.GetPromotion(
+		ctx,
+		connect.NewRequest(
+			&v1alpha1.GetPromotionRequest{
+				Project: o.Project,
+				Name:    o.Promotion,
+			},
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get promotion: %w", err)
+	}
+	for i, step := range res.Msg.GetPromotion().Spec.Steps {
+		if step.GetAlias(i) == o.Step {
+			idx := int64(i)
+			return &idx, nil
+		}
+	}
+	return nil, fmt.Errorf("promotion %q has no step with alias %q", o.Promotion, o.Step)
+}
+
+// waitForPromotions waits for all the provided Promotions to reach a terminal
+// phase, reporting their progress to the provided progressPrinter, and returns
+// their final state in the same order. An error is only returned if watching
+// any of the Promotions failed; callers are responsible for inspecting the
+// outcome of each Promotion.
 func waitForPromotions(
 	ctx context.Context,
 	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
+	progress *progressPrinter,
 	p ...*kargoapi.Promotion,
-) error {
+) ([]*kargoapi.Promotion, error) {
+	res := make([]*kargoapi.Promotion, len(p))
 	g, ctx := errgroup.WithContext(ctx)
-	for _, promo := range p {
+	for i, promo := range p {
 		g.Go(func() error {
-			return waitForPromotion(ctx, kargoSvcCli, promo)
+			var err error
+			res[i], err = waitForPromotion(ctx, kargoSvcCli, progress, promo)
+			return err
 		})
 	}
-	return g.Wait()
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 
+// waitForPromotion waits for the provided Promotion to reach a terminal phase,
+// reporting its progress to the provided progressPrinter, and returns its
+// final state.
 func waitForPromotion(
 	ctx context.Context,
 	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
+	progress *progressPrinter,
 	p *kargoapi.Promotion,
-) error {
+) (*kargoapi.Promotion, error) {
+	progress.update(p)
 	if p == nil || p.Status.Phase.IsTerminal() {
 		// No need to wait for a promotion that is already terminal.
-		return nil
+		return p, nil
 	}
 
 	res, err := kargoSvcCli.WatchPromotion(ctx, connect.NewRequest(&v1alpha1.WatchPromotionRequest{
@@ -277,7 +413,7 @@ func waitForPromotion(
 		Name:    p.Name,
 	}))
 	if err != nil {
-		return fmt.Errorf("watch promotion: %w", err)
+		return nil, fmt.Errorf("watch promotion: %w", err)
 	}
 	defer func() {
 		if conn, connErr := res.Conn(); connErr == nil {
@@ -287,13 +423,14 @@ func waitForPromotion(
 	for {
 		if !res.Receive() {
 			if err = res.Err(); err != nil {
-				return fmt.Errorf("watch promotion: %w", err)
+				return nil, fmt.Errorf("watch promotion: %w", err)
 			}
-			return errors.New("unexpected end of watch stream")
+			return nil, errors.New("unexpected end of watch stream")
 		}
-		msg := res.Msg()
-		if msg.GetPromotion().Status.Phase.IsTerminal() {
-			return nil
+		promo := res.Msg().GetPromotion()
+		progress.update(promo)
+		if promo.Status.Phase.IsTerminal() {
+			return promo, nil
 		}
 	}
 }
@@ -9,8 +9,10 @@ import (
 	"github.com/spf13/cobra"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
 
+	"github.com/akuity/kargo/pkg/audit"
 	"github.com/akuity/kargo/pkg/cli/option"
 	"github.com/akuity/kargo/pkg/cli/templates"
+	credsdb "github.com/akuity/kargo/pkg/credentials/kubernetes"
 	k8sevent "github.com/akuity/kargo/pkg/event/kubernetes"
 	fakeevent "github.com/akuity/kargo/pkg/kubernetes/event/fake"
 	"github.com/akuity/kargo/pkg/server"
@@ -96,7 +98,17 @@ func (o *serverOptions) run(ctx context.Context) error {
 		},
 		client,
 		rbac.NewKubernetesRolesDatabase(client),
+		// The local server does not authenticate users, so there is no use for
+		// Kargo API tokens.
+		rbac.NewKubernetesAPITokensDatabase(client, nil),
+		credsdb.NewDatabase(
+			ctx,
+			client.InternalClient(),
+			client.InternalClient(),
+			credsdb.DatabaseConfigFromEnv(),
+		),
 		k8sevent.NewEventSender(&fakeevent.EventRecorder{}),
+		audit.NewRecorder(),
 	)
 	if err := srv.Serve(ctx, l); err != nil {
 		return fmt.Errorf("serve error: %w", err)
@@ -1,6 +1,8 @@
 package option
 
 import (
+	"time"
+
 	"github.com/spf13/pflag"
 
 	"github.com/akuity/kargo/pkg/credentials"
@@ -12,6 +14,12 @@ const (
 	// AliasShortFlag is the short flag name for the alias flag.
 	AliasShortFlag = "a"
 
+	// AllowStaleFlag is the flag name for the allow-stale flag.
+	AllowStaleFlag = "allow-stale"
+
+	// ApplySetFlag is the flag name for the apply-set flag.
+	ApplySetFlag = "apply-set"
+
 	// AsKubernetesResourcesFlag is the flag name for the as-kubernetes-resources
 	// flag.
 	AsKubernetesResourcesFlag = "as-kubernetes-resources"
@@ -19,17 +27,34 @@ const (
 	// as-kubernetes-resources flag.
 	AsKubernetesResourcesShortFlag = "k"
 
+	// ChartFlag is the flag name for the chart flag.
+	ChartFlag = "chart"
+
 	// ClaimFlag is a flag name for the claim flag.
 	ClaimFlag = "claim"
 
+	// CommitFlag is the flag name for the commit flag.
+	CommitFlag = "commit"
+
 	// ContainerFlag is the flag name for the container flag.
 	ContainerFlag = "container"
 
+	// DryRunFlag is the flag name for the dry-run flag.
+	DryRunFlag = "dry-run"
+
+	// ExpiresInFlag is the flag name for the expires-in flag.
+	ExpiresInFlag = "expires-in"
+
 	// FilenameFlag is the flag name for the filename flag.
 	FilenameFlag = "filename"
 	// FilenameShortFlag is the short flag name for the filename flag.
 	FilenameShortFlag = "f"
 
+	// FollowFlag is the flag name for the follow flag.
+	FollowFlag = "follow"
+	// FollowShortFlag is the short flag name for the follow flag.
+	FollowShortFlag = "f"
+
 	// FreightFlag is the flag name for the freight flag.
 	FreightFlag = "freight"
 
@@ -75,11 +100,20 @@ const (
 	// PasswordFlag is the flag name for the password flag.
 	PasswordFlag = "password"
 
+	// PolicyFlag is the flag name for the policy flag.
+	PolicyFlag = "policy"
+
 	// ProjectFlag is the flag name for the project flag.
 	ProjectFlag = "project"
 	// ProjectShortFlag is the short flag name for the project flag.
 	ProjectShortFlag = "p"
 
+	// ProvenanceFlag is the flag name for the provenance flag.
+	ProvenanceFlag = "provenance"
+
+	// PruneFlag is the flag name for the prune flag.
+	PruneFlag = "prune"
+
 	// RecursiveFlag is the flag name for the recursive flag.
 	RecursiveFlag = "recursive"
 	// RecursiveShortFlag is the short flag name for the recursive flag.
@@ -100,9 +134,20 @@ const (
 	// RoleFlag is the flag name for the role flag.
 	RoleFlag = "role"
 
+	// SelectorFlag is the flag name for the selector flag.
+	SelectorFlag = "selector"
+	// SelectorShortFlag is the short flag name for the selector flag.
+	SelectorShortFlag = "l"
+
+	// SortByFlag is the flag name for the sort-by flag.
+	SortByFlag = "sort-by"
+
 	// StageFlag is the flag name for the stage flag.
 	StageFlag = "stage"
 
+	// StepFlag is the flag name for the step flag.
+	StepFlag = "step"
+
 	// DownstreamFromFlag is the flag name for the downstream-from flag.
 	DownstreamFromFlag = "downstream-from"
 
@@ -118,8 +163,19 @@ const (
 	// WaitFlag is the flag name for the wait flag.
 	WaitFlag = "wait"
 
+	// WarehouseFlag is the flag name for the warehouse flag.
+	WarehouseFlag = "warehouse"
+
+	// WatchFlag is the flag name for the watch flag.
+	WatchFlag = "watch"
+	// WatchShortFlag is the short flag name for the watch flag.
+	WatchShortFlag = "w"
+
 	// AbortFlag is the flag name for the abort flag.
 	AbortFlag = "abort"
+
+	// ResumeFlag is the flag name for the resume flag.
+	ResumeFlag = "resume"
 )
 
 // Alias adds the AliasFlag to the provided flag set.
@@ -132,6 +188,16 @@ func Aliases(fs *pflag.FlagSet, stage *[]string, usage string) {
 	fs.StringArrayVar(stage, AliasFlag, nil, usage)
 }
 
+// AllowStale adds the AllowStaleFlag to the provided flag set.
+func AllowStale(fs *pflag.FlagSet, allowStale *bool, usage string) {
+	fs.BoolVar(allowStale, AllowStaleFlag, false, usage)
+}
+
+// ApplySet adds the ApplySetFlag to the provided flag set.
+func ApplySet(fs *pflag.FlagSet, applySet *string, defaultApplySet, usage string) {
+	fs.StringVar(applySet, ApplySetFlag, defaultApplySet, usage)
+}
+
 // AsKubernetesResources adds the AsKubernetesResourcesFlag and
 // AsKubernetesResourcesShortFlag to the provided flag set.
 func AsKubernetesResources(fs *pflag.FlagSet, asKubernetesResources *bool, usage string) {
@@ -144,11 +210,21 @@ func AsKubernetesResources(fs *pflag.FlagSet, asKubernetesResources *bool, usage
 	)
 }
 
+// Charts adds a multi-value ChartFlag to the provided flag set.
+func Charts(fs *pflag.FlagSet, charts *[]string, usage string) {
+	fs.StringArrayVar(charts, ChartFlag, nil, usage)
+}
+
 // Claims adds a multi-value ClaimFlag to the provided flag set.
 func Claims(fs *pflag.FlagSet, claims *[]string, usage string) {
 	fs.StringSliceVar(claims, ClaimFlag, nil, usage)
 }
 
+// Commits adds a multi-value CommitFlag to the provided flag set.
+func Commits(fs *pflag.FlagSet, commits *[]string, usage string) {
+	fs.StringArrayVar(commits, CommitFlag, nil, usage)
+}
+
 func Container(fs *pflag.FlagSet, container *string, usage string) {
 	fs.StringVar(container, ContainerFlag, "", usage)
 }
@@ -158,11 +234,26 @@ func Description(fs *pflag.FlagSet, stage *string, usage string) {
 	fs.StringVar(stage, DescriptionFlag, "", usage)
 }
 
+// DryRun adds the DryRunFlag to the provided flag set.
+func DryRun(fs *pflag.FlagSet, dryRun *bool, usage string) {
+	fs.BoolVar(dryRun, DryRunFlag, false, usage)
+}
+
+// ExpiresIn adds the ExpiresInFlag to the provided flag set.
+func ExpiresIn(fs *pflag.FlagSet, expiresIn *time.Duration, defaultValue time.Duration, usage string) {
+	fs.DurationVar(expiresIn, ExpiresInFlag, defaultValue, usage)
+}
+
 // Filenames adds the FilenameFlag and FilenameShortFlag to the provided flag set.
 func Filenames(fs *pflag.FlagSet, filenames *[]string, usage string) {
 	fs.StringSliceVarP(filenames, FilenameFlag, FilenameShortFlag, nil, usage)
 }
 
+// Follow adds the FollowFlag and FollowShortFlag to the provided flag set.
+func Follow(fs *pflag.FlagSet, follow *bool, usage string) {
+	fs.BoolVarP(follow, FollowFlag, FollowShortFlag, false, usage)
+}
+
 // Freight adds the FreightFlag to the provided flag set.
 func Freight(fs *pflag.FlagSet, freight *string, usage string) {
 	fs.StringVar(freight, FreightFlag, "", usage)
@@ -188,6 +279,11 @@ func Image(fs *pflag.FlagSet, image *bool, usage string) {
 	fs.BoolVar(image, ImageFlag, false, usage)
 }
 
+// Images adds a multi-value ImageFlag to the provided flag set.
+func Images(fs *pflag.FlagSet, images *[]string, usage string) {
+	fs.StringArrayVar(images, ImageFlag, nil, usage)
+}
+
 // InsecureTLS adds the InsecureTLSFlag to the provided flag set.
 func InsecureTLS(fs *pflag.FlagSet, insecure *bool) {
 	fs.BoolVar(insecure, InsecureTLSFlag, false, "Skip TLS certificate verification")
@@ -243,11 +339,26 @@ func Password(fs *pflag.FlagSet, password *string, usage string) {
 	fs.StringVar(password, PasswordFlag, "", usage)
 }
 
+// Policy adds the PolicyFlag to the provided flag set.
+func Policy(fs *pflag.FlagSet, policy *string, usage string) {
+	fs.StringVar(policy, PolicyFlag, "", usage)
+}
+
 // Project adds the ProjectFlag and ProjectShortFlag to the provided flag set.
 func Project(fs *pflag.FlagSet, project *string, defaultProject, usage string) {
 	fs.StringVarP(project, ProjectFlag, ProjectShortFlag, defaultProject, usage)
 }
 
+// Provenance adds the ProvenanceFlag to the provided flag set.
+func Provenance(fs *pflag.FlagSet, provenance *bool, usage string) {
+	fs.BoolVar(provenance, ProvenanceFlag, false, usage)
+}
+
+// Prune adds the PruneFlag to the provided flag set.
+func Prune(fs *pflag.FlagSet, prune *bool, usage string) {
+	fs.BoolVar(prune, PruneFlag, false, usage)
+}
+
 // Recursive adds the RecursiveFlag and RecursiveShortFlag to the provided flag
 // set.
 func Recursive(fs *pflag.FlagSet, recursive *bool) {
@@ -282,11 +393,39 @@ func Role(fs *pflag.FlagSet, role *string, usage string) {
 	fs.StringVar(role, RoleFlag, "", usage)
 }
 
+// Selector adds the SelectorFlag to the provided flag set.
+func Selector(fs *pflag.FlagSet, selector *string) {
+	fs.StringVarP(
+		selector,
+		SelectorFlag,
+		SelectorShortFlag,
+		"",
+		"Selector (label query) to filter on, supports '=', '==', '!=', 'in', 'notin' "+
+			"(e.g. -l key1=value1,key2=value2).",
+	)
+}
+
+// SortBy adds the SortByFlag to the provided flag set.
+func SortBy(fs *pflag.FlagSet, sortBy *string) {
+	fs.StringVar(
+		sortBy,
+		SortByFlag,
+		"",
+		"If non-empty, sort the list using the specified field. The field is specified as a "+
+			"JSONPath expression (e.g. '{.metadata.name}').",
+	)
+}
+
 // Stage adds the StageFlag to the provided flag set.
 func Stage(fs *pflag.FlagSet, stage *string, usage string) {
 	fs.StringVar(stage, StageFlag, "", usage)
 }
 
+// Step adds the StepFlag to the provided flag set.
+func Step(fs *pflag.FlagSet, step *string, usage string) {
+	fs.StringVar(step, StepFlag, "", usage)
+}
+
 // DownstreamFrom adds the DownstreamFromFlag to the provided flag set.
 func DownstreamFrom(fs *pflag.FlagSet, downstreamFrom *string, usage string) {
 	fs.StringVar(downstreamFrom, DownstreamFromFlag, "", usage)
@@ -312,7 +451,22 @@ func Wait(fs *pflag.FlagSet, wait *bool, defaultWait bool, usage string) {
 	fs.BoolVar(wait, WaitFlag, defaultWait, usage)
 }
 
+// Warehouse adds the WarehouseFlag to the provided flag set.
+func Warehouse(fs *pflag.FlagSet, warehouse *string, usage string) {
+	fs.StringVar(warehouse, WarehouseFlag, "", usage)
+}
+
+// Watch adds the WatchFlag to the provided flag set.
+func Watch(fs *pflag.FlagSet, watch *bool, usage string) {
+	fs.BoolVarP(watch, WatchFlag, WatchShortFlag, false, usage)
+}
+
 // Abort adds the AbortFlag to the provided flag set.
 func Abort(fs *pflag.FlagSet, abort *bool, defaultAbort bool, usage string) {
 	fs.BoolVar(abort, AbortFlag, defaultAbort, usage)
 }
+
+// Resume adds the ResumeFlag to the provided flag set.
+func Resume(fs *pflag.FlagSet, resume *bool, defaultResume bool, usage string) {
+	fs.BoolVar(resume, ResumeFlag, defaultResume, usage)
+}
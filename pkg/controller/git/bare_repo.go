@@ -3,6 +3,7 @@ package git
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -65,6 +66,12 @@ type BareCloneOptions struct {
 	// specified, the operating system's temporary directory will be used.
 	// Overriding that default is useful under certain circumstances.
 	BaseDir string
+	// Filter allows for partially cloning the repository by specifying a
+	// filter. When a filter is specified, the server will only send a subset of
+	// reachable objects according to a given object filter. Objects that are
+	// subsequently required (e.g. when adding a working tree) are downloaded
+	// on demand. See CloneOptions.Filter for more information.
+	Filter string
 	// InsecureSkipTLSVerify specifies whether certificate verification errors
 	// should be ignored when cloning the repository. The setting will be
 	// remembered for subsequent interactions with the remote repository.
@@ -77,6 +84,7 @@ type BareCloneOptions struct {
 // will also perform any setup that is required for successfully authenticating
 // to the remote repository.
 func CloneBare(
+	ctx context.Context,
 	repoURL string,
 	clientOpts *ClientOptions,
 	cloneOpts *BareCloneOptions,
@@ -107,7 +115,7 @@ func CloneBare(
 	if err = b.setupClient(homeDir, clientOpts); err != nil {
 		return nil, err
 	}
-	if err = b.clone(); err != nil {
+	if err = b.clone(ctx, cloneOpts); err != nil {
 		return nil, err
 	}
 	if err = b.saveDirs(); err != nil {
@@ -116,10 +124,13 @@ func CloneBare(
 	return b, nil
 }
 
-func (b *bareRepo) clone() error {
-	cmd := b.buildGitCommand("clone", "--bare", b.url, b.dir)
-	cmd.Dir = b.homeDir // Override the cmd.Dir that's set by r.buildGitCommand()
-	if _, err := libExec.Exec(cmd); err != nil {
+func (b *bareRepo) clone(ctx context.Context, opts *BareCloneOptions) error {
+	args := []string{"--bare"}
+	if opts.Filter != "" {
+		args = append(args, "--filter", opts.Filter)
+	}
+	args = append(args, b.url, b.dir)
+	if err := b.execClone(ctx, args...); err != nil {
 		return fmt.Errorf("error cloning repo %q into %q: %w", b.url, b.dir, err)
 	}
 	return nil
@@ -161,6 +172,12 @@ type AddWorkTreeOptions struct {
 	// Ref specifies the branch or commit to check out in the working tree. Will
 	// be ignored if Orphan is true.
 	Ref string
+	// Sparse is a list of directories to check out. When specified, the working
+	// tree is a cone mode sparse checkout containing only files in the
+	// repository's root directory and within the specified directories. The
+	// sparse checkout applies only to this working tree and not to any other
+	// working trees of the same repository. Will be ignored if Orphan is true.
+	Sparse []string
 }
 
 func (b *bareRepo) AddWorkTree(path string, opts *AddWorkTreeOptions) (WorkTree, error) {
@@ -178,7 +195,13 @@ func (b *bareRepo) AddWorkTree(path string, opts *AddWorkTreeOptions) (WorkTree,
 	if slices.Contains(workTreePaths, path) {
 		return nil, fmt.Errorf("working tree already exists at %q", path)
 	}
-	args := []string{"worktree", "add", path}
+	sparse := len(opts.Sparse) > 0 && !opts.Orphan
+	args := []string{"worktree", "add"}
+	if sparse {
+		// Nothing is checked out until the sparse checkout is configured below.
+		args = append(args, "--no-checkout")
+	}
+	args = append(args, path)
 	if opts.Orphan {
 		args = append(args, "--orphan")
 	} else {
@@ -190,7 +213,7 @@ func (b *bareRepo) AddWorkTree(path string, opts *AddWorkTreeOptions) (WorkTree,
 	if path, err = filepath.EvalSymlinks(path); err != nil {
 		return nil, fmt.Errorf("error resolving symlinks in path %s: %w", path, err)
 	}
-	return &workTree{
+	w := &workTree{
 		baseRepo: &baseRepo{
 			creds:   b.creds,
 			dir:     path,
@@ -198,7 +221,16 @@ func (b *bareRepo) AddWorkTree(path string, opts *AddWorkTreeOptions) (WorkTree,
 			url:     b.url,
 		},
 		bareRepo: b,
-	}, nil
+	}
+	if sparse {
+		if err = w.setSparseCheckout(opts.Sparse); err != nil {
+			return nil, err
+		}
+		if _, err = libExec.Exec(w.buildGitCommand("checkout")); err != nil {
+			return nil, fmt.Errorf("error checking out working tree at %q: %w", path, err)
+		}
+	}
+	return w, nil
 }
 
 func (b *bareRepo) Close() error {
@@ -1,6 +1,7 @@
 package git
 
 import (
+	"context"
 	"fmt"
 	"net/http/httptest"
 	"net/url"
@@ -47,6 +48,7 @@ func TestBareRepo(t *testing.T) {
 	testRepoURL := fmt.Sprintf("%s/test.git", server.URL)
 
 	setupRep, err := Clone(
+		context.Background(),
 		testRepoURL,
 		&ClientOptions{
 			Credentials: &testRepoCreds,
@@ -66,6 +68,7 @@ func TestBareRepo(t *testing.T) {
 	require.NoError(t, err)
 
 	rep, err := CloneBare(
+		context.Background(),
 		testRepoURL,
 		&ClientOptions{
 			Credentials: &testRepoCreds,
@@ -162,6 +165,85 @@ func TestBareRepo(t *testing.T) {
 	})
 }
 
+func TestBareRepo_sparseWorkTree(t *testing.T) {
+	service := gitkit.New(
+		gitkit.Config{
+			Dir:        t.TempDir(),
+			AutoCreate: true,
+		},
+	)
+	require.NoError(t, service.Setup())
+	server := httptest.NewServer(service)
+	defer server.Close()
+
+	testRepoURL := fmt.Sprintf("%s/test.git", server.URL)
+
+	setupRep, err := Clone(context.Background(), testRepoURL, nil, nil)
+	require.NoError(t, err)
+	defer setupRep.Close()
+	for _, file := range []string{"README.md", "envs/dev/values.yaml", "envs/prod/values.yaml"} {
+		path := filepath.Join(setupRep.Dir(), file)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+		require.NoError(t, os.WriteFile(path, []byte("foo"), 0600))
+	}
+	require.NoError(t, setupRep.AddAllAndCommit("initial commit", nil))
+	require.NoError(t, setupRep.Push(nil))
+
+	rep, err := CloneBare(context.Background(), testRepoURL, nil, &BareCloneOptions{Filter: FilterBlobless})
+	require.NoError(t, err)
+	defer rep.Close()
+
+	sparsePath := filepath.Join(rep.HomeDir(), "sparse")
+	sparseTree, err := rep.AddWorkTree(
+		sparsePath,
+		&AddWorkTreeOptions{
+			Ref:    "master",
+			Sparse: []string{"envs/prod"},
+		},
+	)
+	require.NoError(t, err)
+	defer sparseTree.Close()
+
+	t.Run("checks out only the requested directories", func(t *testing.T) {
+		require.FileExists(t, filepath.Join(sparsePath, "README.md"))
+		require.FileExists(t, filepath.Join(sparsePath, "envs", "prod", "values.yaml"))
+		require.NoDirExists(t, filepath.Join(sparsePath, "envs", "dev"))
+		hasDiffs, err := sparseTree.HasDiffs()
+		require.NoError(t, err)
+		require.False(t, hasDiffs)
+	})
+
+	t.Run("does not affect other working trees", func(t *testing.T) {
+		fullTree, err := rep.AddWorkTree(
+			filepath.Join(rep.HomeDir(), "full"),
+			&AddWorkTreeOptions{Ref: "HEAD"},
+		)
+		require.NoError(t, err)
+		defer fullTree.Close()
+		require.FileExists(t, filepath.Join(fullTree.Dir(), "envs", "dev", "values.yaml"))
+	})
+
+	t.Run("can commit and push changes", func(t *testing.T) {
+		// Promotion steps subsequent to the one that added the working tree load
+		// it from the file system.
+		loadedTree, err := LoadWorkTree(sparsePath, nil)
+		require.NoError(t, err)
+		err = os.WriteFile(filepath.Join(sparsePath, "envs", "prod", "values.yaml"), []byte("bar"), 0600)
+		require.NoError(t, err)
+		require.NoError(t, loadedTree.AddAllAndCommit("update prod", nil))
+		require.NoError(t, loadedTree.Push(&PushOptions{PullRebase: true}))
+
+		// Files outside of the sparse checkout must be unaffected
+		verifyRep, err := Clone(context.Background(), testRepoURL, nil, nil)
+		require.NoError(t, err)
+		defer verifyRep.Close()
+		require.FileExists(t, filepath.Join(verifyRep.Dir(), "envs", "dev", "values.yaml"))
+		content, err := os.ReadFile(filepath.Join(verifyRep.Dir(), "envs", "prod", "values.yaml"))
+		require.NoError(t, err)
+		require.Equal(t, "bar", string(content))
+	})
+}
+
 func Test_bareRepo_parseWorkTreeOutput(t *testing.T) {
 	tests := []struct {
 		name       string
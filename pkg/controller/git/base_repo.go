@@ -277,8 +277,29 @@ func (b *baseRepo) loadURL() error {
 	return nil
 }
 
+// setSparseCheckout configures the working tree at b.dir as a cone mode sparse
+// checkout of the specified directories. When the repository has multiple
+// working trees, the configuration applies only to this one.
+func (b *baseRepo) setSparseCheckout(dirs []string) error {
+	args := append([]string{"sparse-checkout", "set", "--cone", "--"}, dirs...)
+	if _, err := libExec.Exec(b.buildGitCommand(args...)); err != nil {
+		return fmt.Errorf("error configuring sparse checkout of %q: %w", b.dir, err)
+	}
+	return nil
+}
+
 func (b *baseRepo) buildCommand(command string, arg ...string) *exec.Cmd {
-	cmd := exec.Command(command, arg...)
+	return b.buildCommandContext(context.Background(), command, arg...)
+}
+
+// buildCommandContext is like buildCommand, but the command is killed if the
+// provided context is done before it completes.
+func (b *baseRepo) buildCommandContext(
+	ctx context.Context,
+	command string,
+	arg ...string,
+) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, command, arg...)
 	homeEnvVar := fmt.Sprintf("HOME=%s", b.homeDir)
 	if cmd.Env == nil {
 		cmd.Env = []string{homeEnvVar}
@@ -290,7 +311,13 @@ func (b *baseRepo) buildCommand(command string, arg ...string) *exec.Cmd {
 }
 
 func (b *baseRepo) buildGitCommand(arg ...string) *exec.Cmd {
-	cmd := b.buildCommand("git", arg...)
+	return b.buildGitCommandContext(context.Background(), arg...)
+}
+
+// buildGitCommandContext is like buildGitCommand, but the command is killed if
+// the provided context is done before it completes.
+func (b *baseRepo) buildGitCommandContext(ctx context.Context, arg ...string) *exec.Cmd {
+	cmd := b.buildCommandContext(ctx, "git", arg...)
 	cmd.Env = append(cmd.Env, fmt.Sprintf("GIT_SSH_COMMAND=ssh -F %s/.ssh/config", b.homeDir))
 	if b.creds != nil && b.creds.Password != "" {
 		cmd.Env = append(
@@ -1,6 +1,7 @@
 package commit
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/expr-lang/expr"
@@ -24,6 +25,7 @@ type baseSelector struct {
 	discoveryLimit        int
 
 	gitCloneFn func(
+		ctx context.Context,
 		repoURL string,
 		clientOpts *git.ClientOptions,
 		cloneOpts *git.CloneOptions,
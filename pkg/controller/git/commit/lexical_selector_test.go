@@ -65,6 +65,7 @@ func Test_lexicalSelector_Select(t *testing.T) {
 				tagBasedSelector: &tagBasedSelector{
 					baseSelector: &baseSelector{
 						gitCloneFn: func(
+							context.Context,
 							string,
 							*git.ClientOptions,
 							*git.CloneOptions,
@@ -84,6 +85,7 @@ func Test_lexicalSelector_Select(t *testing.T) {
 				tagBasedSelector: &tagBasedSelector{
 					baseSelector: &baseSelector{
 						gitCloneFn: func(
+							context.Context,
 							string,
 							*git.ClientOptions,
 							*git.CloneOptions,
@@ -107,6 +109,7 @@ func Test_lexicalSelector_Select(t *testing.T) {
 				tagBasedSelector: &tagBasedSelector{
 					baseSelector: &baseSelector{
 						gitCloneFn: func(
+							context.Context,
 							string,
 							*git.ClientOptions,
 							*git.CloneOptions,
@@ -131,6 +134,7 @@ func Test_lexicalSelector_Select(t *testing.T) {
 				tagBasedSelector: &tagBasedSelector{
 					baseSelector: &baseSelector{
 						gitCloneFn: func(
+							context.Context,
 							string,
 							*git.ClientOptions,
 							*git.CloneOptions,
@@ -160,6 +164,7 @@ func Test_lexicalSelector_Select(t *testing.T) {
 				tagBasedSelector: &tagBasedSelector{
 					baseSelector: &baseSelector{
 						gitCloneFn: func(
+							context.Context,
 							string,
 							*git.ClientOptions,
 							*git.CloneOptions,
@@ -199,6 +204,7 @@ func Test_lexicalSelector_Select(t *testing.T) {
 				tagBasedSelector: &tagBasedSelector{
 					baseSelector: &baseSelector{
 						gitCloneFn: func(
+							context.Context,
 							string,
 							*git.ClientOptions,
 							*git.CloneOptions,
@@ -238,6 +244,7 @@ func Test_lexicalSelector_Select(t *testing.T) {
 				tagBasedSelector: &tagBasedSelector{
 					baseSelector: &baseSelector{
 						gitCloneFn: func(
+							context.Context,
 							string,
 							*git.ClientOptions,
 							*git.CloneOptions,
@@ -278,6 +285,7 @@ func Test_lexicalSelector_Select(t *testing.T) {
 				tagBasedSelector: &tagBasedSelector{
 					baseSelector: &baseSelector{
 						gitCloneFn: func(
+							context.Context,
 							string,
 							*git.ClientOptions,
 							*git.CloneOptions,
@@ -323,6 +331,7 @@ func Test_lexicalSelector_Select(t *testing.T) {
 				tagBasedSelector: &tagBasedSelector{
 					baseSelector: &baseSelector{
 						gitCloneFn: func(
+							context.Context,
 							string,
 							*git.ClientOptions,
 							*git.CloneOptions,
@@ -99,6 +99,7 @@ func (n *newestFromBranchSelector) Select(ctx context.Context) (
 
 	logger.Debug("cloning repository")
 	repo, err := n.gitCloneFn(
+		ctx,
 		n.repoURL,
 		&git.ClientOptions{
 			Credentials:           n.creds,
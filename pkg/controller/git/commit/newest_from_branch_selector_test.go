@@ -130,6 +130,7 @@ func Test_newestFromBranchSelector_Select(t *testing.T) {
 			selector: &newestFromBranchSelector{
 				baseSelector: &baseSelector{
 					gitCloneFn: func(
+						context.Context,
 						string,
 						*git.ClientOptions,
 						*git.CloneOptions,
@@ -147,6 +148,7 @@ func Test_newestFromBranchSelector_Select(t *testing.T) {
 			selector: &newestFromBranchSelector{
 				baseSelector: &baseSelector{
 					gitCloneFn: func(
+						context.Context,
 						string,
 						*git.ClientOptions,
 						*git.CloneOptions,
@@ -167,6 +169,7 @@ func Test_newestFromBranchSelector_Select(t *testing.T) {
 			selector: &newestFromBranchSelector{
 				baseSelector: &baseSelector{
 					gitCloneFn: func(
+						context.Context,
 						string,
 						*git.ClientOptions,
 						*git.CloneOptions,
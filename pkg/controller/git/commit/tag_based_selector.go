@@ -156,6 +156,7 @@ func (t *tagBasedSelector) clone(ctx context.Context) (git.Repo, error) {
 		Filter:       git.FilterBlobless,
 	}
 	repo, err := t.gitCloneFn(
+		ctx,
 		t.repoURL,
 		&git.ClientOptions{
 			Credentials:           t.creds,
@@ -14,3 +14,15 @@ package git
 // paths to compute diffs, so these will trigger blob downloads the first time
 // they are run.
 const FilterBlobless = "blob:none"
+
+// FilterTreeless is a filter that excludes blobs and trees from the clone. When
+// using this filter, the initial Git clone will download all reachable commits,
+// and only download the trees and blobs for commits when you do a Git checkout
+// (including the first checkout during the clone).
+//
+// Treeless clones are well suited to checking out a single commit or, in
+// combination with a sparse checkout, a few directories of a very large
+// repository. Commands that walk history while inspecting the contents of
+// commits (e.g. `git log -- <path>`) will trigger many additional downloads and
+// should be avoided.
+const FilterTreeless = "tree:0"
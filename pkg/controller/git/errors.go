@@ -2,6 +2,8 @@ package git
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 )
 
 // ErrMergeConflict is returned when a merge conflict occurs.
@@ -13,6 +15,27 @@ func IsMergeConflict(err error) bool {
 	return errors.Is(err, ErrMergeConflict)
 }
 
+// MergeConflictError is returned when a merge conflict occurs and the paths
+// that could not be merged automatically are known. It matches
+// ErrMergeConflict when compared using errors.Is.
+type MergeConflictError struct {
+	// Paths are the paths that could not be merged automatically.
+	Paths []string
+}
+
+// Error implements the error interface.
+func (e *MergeConflictError) Error() string {
+	if len(e.Paths) == 0 {
+		return ErrMergeConflict.Error()
+	}
+	return fmt.Sprintf("%s in %s", ErrMergeConflict, strings.Join(e.Paths, ", "))
+}
+
+// Is returns true if the target is ErrMergeConflict.
+func (e *MergeConflictError) Is(target error) bool {
+	return target == ErrMergeConflict
+}
+
 // ErrNonFastForward is returned when a push is rejected because it is not a
 // fast-forward or needs to be fetched first.
 var ErrNonFastForward = errors.New("non-fast-forward")
@@ -34,6 +34,11 @@ func TestIsMergeConflict(t *testing.T) {
 			err:      fmt.Errorf("an error occurred: %w", ErrMergeConflict),
 			expected: true,
 		},
+		{
+			name:     "a wrapped merge conflict with paths",
+			err:      fmt.Errorf("an error occurred: %w", &MergeConflictError{Paths: []string{"foo.txt"}}),
+			expected: true,
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -43,6 +48,30 @@ func TestIsMergeConflict(t *testing.T) {
 	}
 }
 
+func TestMergeConflictError_Error(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      *MergeConflictError
+		expected string
+	}{
+		{
+			name:     "without paths",
+			err:      &MergeConflictError{},
+			expected: "merge conflict",
+		},
+		{
+			name:     "with paths",
+			err:      &MergeConflictError{Paths: []string{"foo.txt", "bar/baz.yaml"}},
+			expected: "merge conflict in foo.txt, bar/baz.yaml",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, testCase.err.Error())
+		})
+	}
+}
+
 func TestIsNonFastForward(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -0,0 +1,322 @@
+package git
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	libExec "github.com/akuity/kargo/pkg/exec"
+	intfs "github.com/akuity/kargo/pkg/io/fs"
+	"github.com/akuity/kargo/pkg/logging"
+	"github.com/akuity/kargo/pkg/urls"
+)
+
+// MirrorCacheConfig represents configuration for a MirrorCache.
+type MirrorCacheConfig struct {
+	// Dir is the directory in which bare mirrors of remote repositories are
+	// stored. If empty, the mirror cache is disabled.
+	Dir string `envconfig:"GIT_MIRROR_CACHE_DIR"`
+	// MaxAge is the amount of time a mirror may go unused before it is evicted.
+	// A value of zero disables age-based eviction.
+	MaxAge time.Duration `envconfig:"GIT_MIRROR_CACHE_MAX_AGE" default:"24h"`
+	// MaxSize is the total amount of disk space, expressed as a quantity (e.g.
+	// "20Gi"), that all mirrors may occupy before the least recently used ones
+	// are evicted. If empty, size-based eviction is disabled.
+	MaxSize string `envconfig:"GIT_MIRROR_CACHE_MAX_SIZE"`
+}
+
+// MirrorCacheConfigFromEnv returns a MirrorCacheConfig populated from
+// environment variables.
+func MirrorCacheConfigFromEnv() MirrorCacheConfig {
+	cfg := MirrorCacheConfig{}
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
+// mirrorCache is the MirrorCache used by Clone and CloneBare. When nil, no
+// mirrors are used.
+var mirrorCache atomic.Pointer[MirrorCache]
+
+// SetMirrorCache sets the MirrorCache used by Clone and CloneBare. A nil value
+// disables the use of mirrors.
+func SetMirrorCache(c *MirrorCache) {
+	mirrorCache.Store(c)
+}
+
+// MirrorCache is a cache of bare mirrors of remote repositories that is local
+// to the process using it. Mirrors are updated incrementally prior to every
+// clone and are used as a source of objects by the clone, which means only
+// objects not already present in a mirror are transferred over the network.
+//
+// Clones are always dissociated from the mirror they borrowed objects from, so
+// evicting a mirror never affects existing clones. Clones also continue to
+// authenticate to the remote repository using their own credentials, so a
+// mirror never grants access to content a requester could not otherwise
+// access.
+//
+// A MirrorCache is safe for concurrent use, but its directory must not be
+// shared with other processes.
+type MirrorCache struct {
+	dir     string
+	maxAge  time.Duration
+	maxSize int64
+
+	mu      sync.Mutex
+	mirrors map[string]*mirror
+
+	nowFn func() time.Time
+}
+
+// mirror is a bare mirror of a single remote repository.
+type mirror struct {
+	// mu is held for writing while the mirror is being updated or evicted and
+	// for reading while the mirror is being used as a source of objects.
+	mu  sync.RWMutex
+	dir string
+	// size is the size of the mirror on disk as of the last time it was
+	// updated. Zero means the size is not known.
+	size atomic.Int64
+}
+
+// NewMirrorCache returns a MirrorCache configured as specified. If the
+// configuration does not specify a directory, nil is returned.
+func NewMirrorCache(cfg MirrorCacheConfig) (*MirrorCache, error) {
+	if cfg.Dir == "" {
+		return nil, nil
+	}
+	var maxSize int64
+	if cfg.MaxSize != "" {
+		q, err := resource.ParseQuantity(cfg.MaxSize)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing Git mirror cache max size %q: %w", cfg.MaxSize, err)
+		}
+		maxSize = q.Value()
+	}
+	if err := os.MkdirAll(cfg.Dir, 0700); err != nil {
+		return nil, fmt.Errorf("error creating Git mirror cache directory %q: %w", cfg.Dir, err)
+	}
+	dir, err := filepath.EvalSymlinks(cfg.Dir)
+	if err != nil {
+		return nil, fmt.Errorf("error resolving symlinks in path %s: %w", cfg.Dir, err)
+	}
+	return &MirrorCache{
+		dir:     dir,
+		maxAge:  cfg.MaxAge,
+		maxSize: maxSize,
+		mirrors: map[string]*mirror{},
+		nowFn:   time.Now,
+	}, nil
+}
+
+// withMirror updates the mirror of the provided repository's remote, using the
+// repository's own credentials and client configuration, and then invokes the
+// provided function with the path to the mirror. The mirror is guaranteed not
+// to be updated or evicted until the function returns. If the mirror cannot be
+// updated, the function is invoked with an empty path so the caller can fall
+// back to cloning without a mirror.
+func (c *MirrorCache) withMirror(
+	ctx context.Context,
+	b *baseRepo,
+	fn func(mirrorDir string) error,
+) error {
+	logger := logging.LoggerFromContext(ctx).WithValues("repo", urls.NormalizeGit(b.url))
+
+	m := c.getMirror(b.url)
+	m.mu.Lock()
+	err := c.update(ctx, b, m)
+	m.mu.Unlock()
+	if err != nil {
+		logger.Error(err, "error updating Git mirror; cloning without it")
+		return fn("")
+	}
+
+	if err = c.evict(m); err != nil {
+		logger.Error(err, "error evicting Git mirrors")
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return fn(m.dir)
+}
+
+// execClone executes `git clone` with the provided arguments. If a MirrorCache
+// is in use, objects are borrowed from the mirror of the repository's remote
+// and the resulting clone is then dissociated from the mirror.
+func (b *baseRepo) execClone(ctx context.Context, args ...string) error {
+	run := func(mirrorDir string) error {
+		cloneArgs := []string{"clone"}
+		if mirrorDir != "" {
+			cloneArgs = append(cloneArgs, "--reference-if-able", mirrorDir, "--dissociate")
+		}
+		cmd := b.buildGitCommandContext(ctx, append(cloneArgs, args...)...)
+		cmd.Dir = b.homeDir // Override the cmd.Dir that's set by b.buildGitCommand()
+		_, err := libExec.Exec(cmd)
+		return err
+	}
+	if c := mirrorCache.Load(); c != nil {
+		return c.withMirror(ctx, b, run)
+	}
+	return run("")
+}
+
+// getMirror returns the mirror of the remote repository at the provided URL.
+// Repositories are identified by their normalized URL, so credentials embedded
+// in the URL do not result in distinct mirrors.
+func (c *MirrorCache) getMirror(repoURL string) *mirror {
+	sum := sha256.Sum256([]byte(urls.NormalizeGit(repoURL)))
+	return c.getMirrorByName(hex.EncodeToString(sum[:]))
+}
+
+func (c *MirrorCache) getMirrorByName(name string) *mirror {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	m, ok := c.mirrors[name]
+	if !ok {
+		m = &mirror{dir: filepath.Join(c.dir, name)}
+		c.mirrors[name] = m
+	}
+	return m
+}
+
+// update creates the provided mirror if it does not exist yet and then fetches
+// all branches and tags from the provided repository's remote into it. Git is
+// killed if the provided context is done before it completes, so the mirror's
+// lock is not held any longer than the caller is willing to wait. The caller
+// must hold the mirror's lock for writing.
+func (c *MirrorCache) update(ctx context.Context, b *baseRepo, m *mirror) error {
+	if _, err := os.Stat(filepath.Join(m.dir, "HEAD")); err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("error checking for existence of Git mirror %q: %w", m.dir, err)
+		}
+		// Discard anything left behind by an incomplete initialization.
+		if err = os.RemoveAll(m.dir); err != nil {
+			return fmt.Errorf("error removing incomplete Git mirror %q: %w", m.dir, err)
+		}
+		cmd := b.buildGitCommandContext(ctx, "init", "--bare", m.dir)
+		cmd.Dir = c.dir // Override the cmd.Dir that's set by b.buildGitCommand()
+		if _, err = libExec.Exec(cmd); err != nil {
+			return fmt.Errorf("error initializing Git mirror %q: %w", m.dir, err)
+		}
+	}
+	// The URL is passed explicitly instead of being stored as a remote because
+	// it may embed the username of whichever requester is using the mirror.
+	cmd := b.buildGitCommandContext(
+		ctx,
+		"fetch",
+		"--prune",
+		"--no-write-fetch-head",
+		b.url,
+		"+refs/heads/*:refs/heads/*",
+		"+refs/tags/*:refs/tags/*",
+	)
+	cmd.Dir = m.dir // Override the cmd.Dir that's set by b.buildGitCommand()
+	if _, err := libExec.Exec(cmd); err != nil {
+		return fmt.Errorf("error fetching into Git mirror %q: %w", m.dir, err)
+	}
+	// The modification time of the mirror's directory records when it was last
+	// used. This survives restarts of the process if the cache's directory is
+	// persistent.
+	now := c.nowFn()
+	if err := os.Chtimes(m.dir, now, now); err != nil {
+		return fmt.Errorf("error recording last use of Git mirror %q: %w", m.dir, err)
+	}
+	if c.maxSize > 0 {
+		// Only the mirror that was just updated can have changed in size, so
+		// its size is recorded here instead of walking every mirror whenever
+		// mirrors are evicted.
+		size, err := intfs.DirSize(m.dir)
+		if err != nil {
+			return fmt.Errorf("error getting size of Git mirror %q: %w", m.dir, err)
+		}
+		m.size.Store(size)
+	}
+	return nil
+}
+
+// mirrorInfo describes a mirror under consideration for eviction.
+type mirrorInfo struct {
+	mirror   *mirror
+	lastUsed time.Time
+	size     int64
+}
+
+// evict removes mirrors that have not been used within the cache's maximum
+// age and then removes least recently used mirrors until the total size of all
+// mirrors is within the cache's maximum size. The provided mirror, which is
+// about to be used, and mirrors currently in use are never evicted. Sizes
+// recorded when mirrors were updated are used, so only mirrors of unknown size
+// are walked.
+func (c *MirrorCache) evict(keep *mirror) error {
+	if c.maxAge <= 0 && c.maxSize <= 0 {
+		return nil
+	}
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return fmt.Errorf("error listing Git mirrors in %q: %w", c.dir, err)
+	}
+	infos := make([]mirrorInfo, 0, len(entries))
+	var totalSize int64
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		// Mirrors found on disk but not known to this cache (e.g. because they
+		// were created before a restart) are adopted here.
+		m := c.getMirrorByName(entry.Name())
+		fi, err := entry.Info()
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("error getting info for Git mirror %q: %w", m.dir, err)
+		}
+		var size int64
+		if c.maxSize > 0 {
+			if size = m.size.Load(); size == 0 {
+				if size, err = intfs.DirSize(m.dir); err != nil {
+					return fmt.Errorf("error getting size of Git mirror %q: %w", m.dir, err)
+				}
+				m.size.Store(size)
+			}
+		}
+		totalSize += size
+		infos = append(infos, mirrorInfo{mirror: m, lastUsed: fi.ModTime(), size: size})
+	}
+	// Least recently used first
+	slices.SortFunc(infos, func(lhs, rhs mirrorInfo) int {
+		return lhs.lastUsed.Compare(rhs.lastUsed)
+	})
+	now := c.nowFn()
+	var errs []error
+	for _, info := range infos {
+		expired := c.maxAge > 0 && now.Sub(info.lastUsed) > c.maxAge
+		oversized := c.maxSize > 0 && totalSize > c.maxSize
+		if !expired && !oversized {
+			continue
+		}
+		if info.mirror == keep || !info.mirror.mu.TryLock() {
+			continue
+		}
+		err := os.RemoveAll(info.mirror.dir)
+		info.mirror.size.Store(0)
+		info.mirror.mu.Unlock()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error removing Git mirror %q: %w", info.mirror.dir, err))
+			continue
+		}
+		totalSize -= info.size
+	}
+	return errors.Join(errs...)
+}
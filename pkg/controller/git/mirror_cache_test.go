@@ -0,0 +1,265 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sosedoff/gitkit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMirrorCacheConfigFromEnv(t *testing.T) {
+	t.Setenv("GIT_MIRROR_CACHE_DIR", "/var/cache/kargo/git")
+	t.Setenv("GIT_MIRROR_CACHE_MAX_SIZE", "20Gi")
+	require.Equal(
+		t,
+		MirrorCacheConfig{
+			Dir:     "/var/cache/kargo/git",
+			MaxAge:  24 * time.Hour,
+			MaxSize: "20Gi",
+		},
+		MirrorCacheConfigFromEnv(),
+	)
+}
+
+func TestNewMirrorCache(t *testing.T) {
+	testCases := []struct {
+		name       string
+		cfg        MirrorCacheConfig
+		assertions func(*testing.T, *MirrorCache, error)
+	}{
+		{
+			name: "disabled",
+			cfg:  MirrorCacheConfig{},
+			assertions: func(t *testing.T, c *MirrorCache, err error) {
+				require.NoError(t, err)
+				require.Nil(t, c)
+			},
+		},
+		{
+			name: "invalid max size",
+			cfg: MirrorCacheConfig{
+				Dir:     t.TempDir(),
+				MaxSize: "twenty gigs",
+			},
+			assertions: func(t *testing.T, _ *MirrorCache, err error) {
+				require.ErrorContains(t, err, "error parsing Git mirror cache max size")
+			},
+		},
+		{
+			name: "success",
+			cfg: MirrorCacheConfig{
+				Dir:     filepath.Join(t.TempDir(), "mirrors"),
+				MaxAge:  time.Hour,
+				MaxSize: "1Ki",
+			},
+			assertions: func(t *testing.T, c *MirrorCache, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, c)
+				require.DirExists(t, c.dir)
+				require.Equal(t, time.Hour, c.maxAge)
+				require.Equal(t, int64(1024), c.maxSize)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c, err := NewMirrorCache(testCase.cfg)
+			testCase.assertions(t, c, err)
+		})
+	}
+}
+
+func TestMirrorCache_clone(t *testing.T) {
+	service := gitkit.New(gitkit.Config{
+		Dir:        t.TempDir(),
+		AutoCreate: true,
+	})
+	require.NoError(t, service.Setup())
+	server := httptest.NewServer(service)
+	defer server.Close()
+
+	testRepoURL := fmt.Sprintf("%s/test.git", server.URL)
+
+	setupRep, err := Clone(context.Background(), testRepoURL, nil, nil)
+	require.NoError(t, err)
+	defer setupRep.Close()
+	err = os.WriteFile(filepath.Join(setupRep.Dir(), "test.txt"), []byte("foo"), 0600)
+	require.NoError(t, err)
+	err = setupRep.AddAllAndCommit(fmt.Sprintf("initial commit %s", uuid.NewString()), nil)
+	require.NoError(t, err)
+	err = setupRep.Push(nil)
+	require.NoError(t, err)
+	commitID, err := setupRep.LastCommitID()
+	require.NoError(t, err)
+
+	c, err := NewMirrorCache(MirrorCacheConfig{Dir: t.TempDir()})
+	require.NoError(t, err)
+	SetMirrorCache(c)
+	t.Cleanup(func() { SetMirrorCache(nil) })
+
+	m := c.getMirror(testRepoURL)
+
+	t.Run("clone populates the mirror", func(t *testing.T) {
+		rep, err := Clone(context.Background(), testRepoURL, nil, nil)
+		require.NoError(t, err)
+		defer rep.Close()
+		id, err := rep.LastCommitID()
+		require.NoError(t, err)
+		require.Equal(t, commitID, id)
+		require.FileExists(t, filepath.Join(m.dir, "refs", "heads", "master"))
+		// The clone must not depend on the mirror
+		require.NoFileExists(t, filepath.Join(rep.Dir(), ".git", "objects", "info", "alternates"))
+	})
+
+	err = os.WriteFile(filepath.Join(setupRep.Dir(), "test.txt"), []byte("bar"), 0600)
+	require.NoError(t, err)
+	err = setupRep.AddAllAndCommit(fmt.Sprintf("second commit %s", uuid.NewString()), nil)
+	require.NoError(t, err)
+	err = setupRep.Push(nil)
+	require.NoError(t, err)
+	commitID, err = setupRep.LastCommitID()
+	require.NoError(t, err)
+
+	t.Run("bare clone updates the mirror", func(t *testing.T) {
+		rep, err := CloneBare(context.Background(), testRepoURL, nil, nil)
+		require.NoError(t, err)
+		defer rep.Close()
+		require.NoFileExists(t, filepath.Join(rep.Dir(), "objects", "info", "alternates"))
+		ref, err := os.ReadFile(filepath.Join(m.dir, "refs", "heads", "master"))
+		require.NoError(t, err)
+		require.Equal(t, commitID+"\n", string(ref))
+	})
+
+	t.Run("clone survives eviction of the mirror", func(t *testing.T) {
+		rep, err := Clone(context.Background(), testRepoURL, nil, nil)
+		require.NoError(t, err)
+		defer rep.Close()
+		require.NoError(t, os.RemoveAll(m.dir))
+		id, err := rep.LastCommitID()
+		require.NoError(t, err)
+		require.Equal(t, commitID, id)
+		_, err = rep.CommitMessage(id)
+		require.NoError(t, err)
+	})
+
+	t.Run("clone is abandoned when the context is canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := Clone(ctx, testRepoURL, nil, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("clone falls back when the mirror cannot be updated", func(t *testing.T) {
+		// A file where the mirror's directory should be makes initialization fail
+		require.NoError(t, os.WriteFile(m.dir, nil, 0600))
+		defer os.Remove(m.dir)
+		rep, err := Clone(context.Background(), testRepoURL, nil, nil)
+		require.NoError(t, err)
+		defer rep.Close()
+		id, err := rep.LastCommitID()
+		require.NoError(t, err)
+		require.Equal(t, commitID, id)
+	})
+}
+
+func TestMirrorCache_evict(t *testing.T) {
+	now := time.Now()
+	newMirror := func(t *testing.T, c *MirrorCache, name string, size int, lastUsed time.Time) *mirror {
+		m := c.getMirrorByName(name)
+		require.NoError(t, os.MkdirAll(m.dir, 0700))
+		require.NoError(t, os.WriteFile(filepath.Join(m.dir, "pack"), make([]byte, size), 0600))
+		require.NoError(t, os.Chtimes(m.dir, lastUsed, lastUsed))
+		return m
+	}
+
+	testCases := []struct {
+		name       string
+		maxAge     time.Duration
+		maxSize    int64
+		assertions func(t *testing.T, c *MirrorCache)
+	}{
+		{
+			name: "eviction disabled",
+			assertions: func(t *testing.T, c *MirrorCache) {
+				old := newMirror(t, c, "old", 100, now.Add(-48*time.Hour))
+				require.NoError(t, c.evict(nil))
+				require.DirExists(t, old.dir)
+			},
+		},
+		{
+			name:   "evicts mirrors older than the max age",
+			maxAge: time.Hour,
+			assertions: func(t *testing.T, c *MirrorCache) {
+				old := newMirror(t, c, "old", 100, now.Add(-2*time.Hour))
+				recent := newMirror(t, c, "recent", 100, now.Add(-time.Minute))
+				require.NoError(t, c.evict(nil))
+				require.NoDirExists(t, old.dir)
+				require.DirExists(t, recent.dir)
+			},
+		},
+		{
+			name:    "evicts least recently used mirrors beyond the max size",
+			maxSize: 250,
+			assertions: func(t *testing.T, c *MirrorCache) {
+				oldest := newMirror(t, c, "oldest", 100, now.Add(-3*time.Hour))
+				older := newMirror(t, c, "older", 100, now.Add(-2*time.Hour))
+				newest := newMirror(t, c, "newest", 100, now.Add(-time.Hour))
+				require.NoError(t, c.evict(nil))
+				require.NoDirExists(t, oldest.dir)
+				require.DirExists(t, older.dir)
+				require.DirExists(t, newest.dir)
+			},
+		},
+		{
+			name:    "uses recorded sizes of mirrors",
+			maxSize: 250,
+			assertions: func(t *testing.T, c *MirrorCache) {
+				oldest := newMirror(t, c, "oldest", 100, now.Add(-3*time.Hour))
+				oldest.size.Store(10)
+				older := newMirror(t, c, "older", 100, now.Add(-2*time.Hour))
+				newest := newMirror(t, c, "newest", 100, now.Add(-time.Hour))
+				require.NoError(t, c.evict(nil))
+				require.DirExists(t, oldest.dir)
+				require.DirExists(t, older.dir)
+				require.DirExists(t, newest.dir)
+				// Sizes of mirrors that were not recorded are recorded now
+				require.Equal(t, int64(100), older.size.Load())
+			},
+		},
+		{
+			name:    "never evicts mirrors that are kept or in use",
+			maxAge:  time.Hour,
+			maxSize: 1,
+			assertions: func(t *testing.T, c *MirrorCache) {
+				kept := newMirror(t, c, "kept", 100, now.Add(-2*time.Hour))
+				inUse := newMirror(t, c, "in-use", 100, now.Add(-2*time.Hour))
+				inUse.mu.RLock()
+				defer inUse.mu.RUnlock()
+				unused := newMirror(t, c, "unused", 100, now.Add(-2*time.Hour))
+				require.NoError(t, c.evict(kept))
+				require.DirExists(t, kept.dir)
+				require.DirExists(t, inUse.dir)
+				require.NoDirExists(t, unused.dir)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := &MirrorCache{
+				dir:     t.TempDir(),
+				maxAge:  testCase.maxAge,
+				maxSize: testCase.maxSize,
+				mirrors: map[string]*mirror{},
+				nowFn:   func() time.Time { return now },
+			}
+			testCase.assertions(t, c)
+		})
+	}
+}
@@ -1,11 +1,10 @@
 package git
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
-
-	libExec "github.com/akuity/kargo/pkg/exec"
 )
 
 // Repo is an interface for interacting with a Git repository with a single
@@ -58,6 +57,14 @@ type CloneOptions struct {
 	// SingleBranch indicates whether the clone should be a single-branch clone.
 	// This option is ignored if Bare is true.
 	SingleBranch bool
+	// Sparse is a list of directories to check out. When specified, the working
+	// tree is a cone mode sparse checkout containing only files in the
+	// repository's root directory and within the specified directories. If not
+	// specified, the entire repository is checked out.
+	//
+	// For more information, see:
+	// - https://git-scm.com/docs/git-sparse-checkout
+	Sparse []string
 }
 
 // Clone produces a local clone of the remote git repository at the specified
@@ -66,6 +73,7 @@ type CloneOptions struct {
 // perform any setup that is required for successfully authenticating to the
 // remote repository.
 func Clone(
+	ctx context.Context,
 	repoURL string,
 	clientOpts *ClientOptions,
 	cloneOpts *CloneOptions,
@@ -100,7 +108,7 @@ func Clone(
 	if err = r.setupClient(homeDir, clientOpts); err != nil {
 		return nil, err
 	}
-	if err = r.clone(cloneOpts); err != nil {
+	if err = r.clone(ctx, cloneOpts); err != nil {
 		return nil, err
 	}
 	if err = r.saveDirs(); err != nil {
@@ -109,11 +117,11 @@ func Clone(
 	return r, nil
 }
 
-func (r *repo) clone(opts *CloneOptions) error {
+func (r *repo) clone(ctx context.Context, opts *CloneOptions) error {
 	if opts == nil {
 		opts = &CloneOptions{}
 	}
-	args := []string{"clone", "--no-tags"}
+	args := []string{"--no-tags"}
 	if opts.Branch != "" {
 		args = append(args, "--branch", opts.Branch)
 	}
@@ -123,12 +131,23 @@ func (r *repo) clone(opts *CloneOptions) error {
 	if opts.Depth > 0 {
 		args = append(args, "--depth", fmt.Sprint(opts.Depth))
 	}
+	if opts.Filter != "" {
+		args = append(args, "--filter", opts.Filter)
+	}
+	if len(opts.Sparse) > 0 {
+		// Only files in the repository's root directory are checked out until
+		// the sparse checkout is configured below.
+		args = append(args, "--sparse")
+	}
 	args = append(args, r.url, r.dir)
-	cmd := r.buildGitCommand(args...)
-	cmd.Dir = r.homeDir // Override the cmd.Dir that's set by r.buildGitCommand()
-	if _, err := libExec.Exec(cmd); err != nil {
+	if err := r.execClone(ctx, args...); err != nil {
 		return fmt.Errorf("error cloning repo %q into %q: %w", r.url, r.dir, err)
 	}
+	if len(opts.Sparse) > 0 {
+		if err := r.setSparseCheckout(opts.Sparse); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -1,10 +1,12 @@
 package git
 
 import (
+	"context"
 	"fmt"
 	"net/http/httptest"
 	"net/url"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/google/uuid"
@@ -45,6 +47,7 @@ func TestRepo(t *testing.T) {
 	testRepoURL := fmt.Sprintf("%s/test.git", server.URL)
 
 	rep, err := Clone(
+		context.Background(),
 		testRepoURL,
 		&ClientOptions{
 			Credentials: &testRepoCreds,
@@ -207,3 +210,47 @@ with a body
 	})
 
 }
+
+func TestRepo_sparseClone(t *testing.T) {
+	service := gitkit.New(
+		gitkit.Config{
+			Dir:        t.TempDir(),
+			AutoCreate: true,
+		},
+	)
+	require.NoError(t, service.Setup())
+	server := httptest.NewServer(service)
+	defer server.Close()
+
+	testRepoURL := fmt.Sprintf("%s/test.git", server.URL)
+
+	setupRep, err := Clone(context.Background(), testRepoURL, nil, nil)
+	require.NoError(t, err)
+	defer setupRep.Close()
+	for _, file := range []string{"README.md", "envs/dev/values.yaml", "envs/prod/values.yaml"} {
+		path := filepath.Join(setupRep.Dir(), file)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+		require.NoError(t, os.WriteFile(path, []byte("foo"), 0600))
+	}
+	require.NoError(t, setupRep.AddAllAndCommit("initial commit", nil))
+	require.NoError(t, setupRep.Push(nil))
+
+	rep, err := Clone(
+		context.Background(),
+		testRepoURL,
+		nil,
+		&CloneOptions{
+			Filter: FilterTreeless,
+			Sparse: []string{"envs/prod"},
+		},
+	)
+	require.NoError(t, err)
+	defer rep.Close()
+
+	require.FileExists(t, filepath.Join(rep.Dir(), "README.md"))
+	require.FileExists(t, filepath.Join(rep.Dir(), "envs", "prod", "values.yaml"))
+	require.NoDirExists(t, filepath.Join(rep.Dir(), "envs", "dev"))
+	hasDiffs, err := rep.HasDiffs()
+	require.NoError(t, err)
+	require.False(t, hasDiffs)
+}
@@ -592,7 +592,7 @@ func (w *workTree) Push(opts *PushOptions) error {
 				// will fix. If we find that a rebase is in progress, this is what
 				// has happened.
 				if isRebasing, isRebasingErr := w.IsRebasing(); isRebasingErr == nil && isRebasing {
-					return ErrMergeConflict
+					return w.abortConflictedRebase()
 				}
 				// If we get to here, the error isn't a merge conflict.
 				return fmt.Errorf("error pulling and rebasing branch: %w", err)
@@ -612,6 +612,27 @@ func (w *workTree) Push(opts *PushOptions) error {
 	return nil
 }
 
+// abortConflictedRebase collects the paths with merge conflicts from a rebase
+// that is in progress and aborts the rebase, returning the working tree to the
+// state it was in before the rebase was attempted. It returns a
+// *MergeConflictError listing the conflicted paths.
+func (w *workTree) abortConflictedRebase() error {
+	conflictErr := &MergeConflictError{}
+	res, err := libExec.Exec(w.buildGitCommand("diff", "--name-only", "--diff-filter=U"))
+	if err == nil {
+		scanner := bufio.NewScanner(bytes.NewReader(res))
+		for scanner.Scan() {
+			if path := strings.TrimSpace(scanner.Text()); path != "" {
+				conflictErr.Paths = append(conflictErr.Paths, path)
+			}
+		}
+	}
+	if _, err = libExec.Exec(w.buildGitCommand("rebase", "--abort")); err != nil {
+		return fmt.Errorf("error aborting rebase after %w: %w", conflictErr, err)
+	}
+	return conflictErr
+}
+
 func (w *workTree) RefsHaveDiffs(commit1 string, commit2 string) (bool, error) {
 	// `git diff --quiet` returns 0 if no diff, 1 if diff, and non-zero/one for any other error
 	_, err := libExec.Exec(w.buildGitCommand(
@@ -1,6 +1,7 @@
 package git
 
 import (
+	"context"
 	"fmt"
 	"net/http/httptest"
 	"os"
@@ -60,6 +61,7 @@ func TestWorkTree(t *testing.T) {
 	testRepoURL := fmt.Sprintf("%s/test.git", server.URL)
 
 	setupRep, err := Clone(
+		context.Background(),
 		testRepoURL,
 		&ClientOptions{
 			Credentials: &testRepoCreds,
@@ -79,6 +81,7 @@ func TestWorkTree(t *testing.T) {
 	require.NoError(t, err)
 
 	rep, err := CloneBare(
+		context.Background(),
 		testRepoURL,
 		&ClientOptions{
 			Credentials: &testRepoCreds,
@@ -119,6 +122,71 @@ func TestWorkTree(t *testing.T) {
 
 }
 
+func TestWorkTree_PushWithConflictingRemoteChanges(t *testing.T) {
+	service := gitkit.New(
+		gitkit.Config{
+			Dir:        t.TempDir(),
+			AutoCreate: true,
+		},
+	)
+	require.NoError(t, service.Setup())
+	server := httptest.NewServer(service)
+	defer server.Close()
+
+	testRepoURL := fmt.Sprintf("%s/test.git", server.URL)
+
+	setupRepo, err := Clone(context.Background(), testRepoURL, nil, nil)
+	require.NoError(t, err)
+	defer setupRepo.Close()
+	err = os.WriteFile(filepath.Join(setupRepo.Dir(), "test.txt"), []byte("foo"), 0600)
+	require.NoError(t, err)
+	require.NoError(t, setupRepo.AddAllAndCommit("initial commit", nil))
+	require.NoError(t, setupRepo.Push(nil))
+
+	repo, err := Clone(context.Background(), testRepoURL, nil, nil)
+	require.NoError(t, err)
+	defer repo.Close()
+
+	// Push a change to the remote that the second clone does not have.
+	err = os.WriteFile(filepath.Join(setupRepo.Dir(), "test.txt"), []byte("bar"), 0600)
+	require.NoError(t, err)
+	require.NoError(t, setupRepo.AddAllAndCommit("remote change", nil))
+	require.NoError(t, setupRepo.Push(nil))
+
+	t.Run("rebases non-conflicting changes", func(t *testing.T) {
+		err = os.WriteFile(filepath.Join(repo.Dir(), "other.txt"), []byte("baz"), 0600)
+		require.NoError(t, err)
+		require.NoError(t, repo.AddAllAndCommit("non-conflicting change", nil))
+		require.NoError(t, repo.Push(&PushOptions{PullRebase: true}))
+	})
+
+	t.Run("reports conflicting paths and aborts rebase", func(t *testing.T) {
+		err = os.WriteFile(filepath.Join(setupRepo.Dir(), "test.txt"), []byte("qux"), 0600)
+		require.NoError(t, err)
+		require.NoError(t, setupRepo.AddAllAndCommit("another remote change", nil))
+		require.NoError(t, setupRepo.Push(&PushOptions{PullRebase: true}))
+
+		err = os.WriteFile(filepath.Join(repo.Dir(), "test.txt"), []byte("quux"), 0600)
+		require.NoError(t, err)
+		require.NoError(t, repo.AddAllAndCommit("conflicting change", nil))
+		lastCommitID, err := repo.LastCommitID()
+		require.NoError(t, err)
+
+		err = repo.Push(&PushOptions{PullRebase: true})
+		require.True(t, IsMergeConflict(err))
+		conflictErr := &MergeConflictError{}
+		require.ErrorAs(t, err, &conflictErr)
+		require.Equal(t, []string{"test.txt"}, conflictErr.Paths)
+
+		isRebasing, err := repo.IsRebasing()
+		require.NoError(t, err)
+		require.False(t, isRebasing)
+		commitID, err := repo.LastCommitID()
+		require.NoError(t, err)
+		require.Equal(t, lastCommitID, commitID)
+	})
+}
+
 func Test_parseTagMetadataLine(t *testing.T) {
 	tests := []struct {
 		name    string
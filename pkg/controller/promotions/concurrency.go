@@ -0,0 +1,203 @@
+package promotions
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/api"
+	"github.com/akuity/kargo/pkg/indexer"
+)
+
+// concurrencyGroupRequeueInterval is the interval at which a Promotion that is
+// queued in its concurrency group is reconciled to check whether it can start.
+var concurrencyGroupRequeueInterval = 10 * time.Second
+
+// concurrencyGroups limits the number of Promotions of the same concurrency
+// group that are running at the same time.
+//
+// Limits are enforced by each controller separately. A sharded controller only
+// observes the Promotions of its own shard, and admissions are tracked in
+// memory, so a limit applies to the Promotions of each shard rather than to
+// all Promotions of a group.
+type concurrencyGroups struct {
+	client client.Client
+
+	mu sync.Mutex
+	// admitted holds the Promotions that were admitted to their concurrency
+	// group, but which may not have been observed as running through the
+	// cache yet. This prevents more Promotions than the limit of a group from
+	// being admitted by concurrent reconciliations. Values are the names of
+	// the groups the Promotions were admitted to.
+	admitted map[types.NamespacedName]string
+}
+
+func newConcurrencyGroups(c client.Client) *concurrencyGroups {
+	return &concurrencyGroups{
+		client:   c,
+		admitted: make(map[types.NamespacedName]string),
+	}
+}
+
+// admit determines whether the provided Promotion may start running according
+// to the limit of its concurrency group. If it may, it is admitted to the
+// group and 0 is returned. If it may not, its (1-based) position in the queue
+// of the group is returned. Promotions that do not belong to a group are
+// always admitted.
+func (g *concurrencyGroups) admit(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+) (int32, error) {
+	if promo.Spec.Concurrency == nil || promo.Spec.Concurrency.Group == "" {
+		return 0, nil
+	}
+	group := promo.Spec.Concurrency.Group
+
+	limit, err := g.getLimit(ctx, promo.Namespace, group)
+	if err != nil {
+		return 0, err
+	}
+
+	promos := &kargoapi.PromotionList{}
+	if err = g.client.List(
+		ctx,
+		promos,
+		client.InNamespace(promo.Namespace),
+		client.MatchingFields{indexer.PromotionsByConcurrencyGroupField: group},
+	); err != nil {
+		return 0, fmt.Errorf(
+			"error listing Promotions of concurrency group %q in namespace %q: %w",
+			group, promo.Namespace, err,
+		)
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	running := make(map[string]struct{}, len(promos.Items))
+	queued := make([]kargoapi.Promotion, 0, len(promos.Items))
+	for _, p := range promos.Items {
+		key := types.NamespacedName{Namespace: p.Namespace, Name: p.Name}
+		_, admitted := g.admitted[key]
+		switch {
+		case p.Status.Phase.IsTerminal():
+			delete(g.admitted, key)
+		case p.Status.Phase == kargoapi.PromotionPhaseRunning:
+			// The admission has been observed, so we no longer need to track it.
+			delete(g.admitted, key)
+			running[p.Name] = struct{}{}
+		case admitted:
+			running[p.Name] = struct{}{}
+		default:
+			queued = append(queued, p)
+		}
+	}
+	// Forget about admitted Promotions of the group which no longer exist.
+	for key, admittedTo := range g.admitted {
+		if key.Namespace != promo.Namespace || admittedTo != group {
+			continue
+		}
+		if !slices.ContainsFunc(promos.Items, func(p kargoapi.Promotion) bool {
+			return p.Name == key.Name
+		}) && key.Name != promo.Name {
+			delete(g.admitted, key)
+		}
+	}
+
+	if _, ok := running[promo.Name]; ok {
+		return 0, nil
+	}
+
+	// The provided Promotion may not be part of the list yet if the cache has
+	// not caught up with it.
+	if !slices.ContainsFunc(queued, func(p kargoapi.Promotion) bool {
+		return p.Name == promo.Name
+	}) {
+		queued = append(queued, *promo)
+	}
+
+	position := queuePosition(queued, promo.Name)
+	if len(running)+position < int(limit) {
+		g.admitted[types.NamespacedName{Namespace: promo.Namespace, Name: promo.Name}] = group
+		return 0, nil
+	}
+	return int32(position + 1), nil // nolint: gosec
+}
+
+// release forgets about the admission of the provided Promotion to its
+// concurrency group.
+func (g *concurrencyGroups) release(promo *kargoapi.Promotion) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.admitted, types.NamespacedName{Namespace: promo.Namespace, Name: promo.Name})
+}
+
+// getLimit returns the limit of the concurrency group with the provided name
+// in the provided Project. Groups that are not defined in the ProjectConfig
+// have a limit of 1.
+func (g *concurrencyGroups) getLimit(
+	ctx context.Context,
+	project string,
+	group string,
+) (int32, error) {
+	projectCfg, err := api.GetProjectConfig(ctx, g.client, project)
+	if err != nil {
+		return 0, err
+	}
+	if projectCfg != nil {
+		for i := range projectCfg.Spec.ConcurrencyGroups {
+			if cg := &projectCfg.Spec.ConcurrencyGroups[i]; cg.Name == group {
+				return cg.GetLimit(), nil
+			}
+		}
+	}
+	return 1, nil
+}
+
+// queuePosition returns the (0-based) position of the Promotion with the
+// provided name in the queue formed by the provided queued Promotions. Only the
+// oldest queued Promotion to each Stage is part of the queue, as Promotions to
+// the same Stage are run one at a time in the order in which they were
+// created. The queue is ordered by priority and then by creation time.
+func queuePosition(queued []kargoapi.Promotion, name string) int {
+	// NB: The names of Promotions to the same Stage contain a ULID, which makes
+	// them sortable by creation time when timestamps are equal.
+	slices.SortFunc(queued, func(a, b kargoapi.Promotion) int {
+		if c := a.CreationTimestamp.Compare(b.CreationTimestamp.Time); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+	heads := make([]kargoapi.Promotion, 0, len(queued))
+	stages := make(map[string]struct{}, len(queued))
+	for _, p := range queued {
+		if _, ok := stages[p.Spec.Stage]; ok && p.Name != name {
+			continue
+		}
+		stages[p.Spec.Stage] = struct{}{}
+		heads = append(heads, p)
+	}
+	slices.SortStableFunc(heads, func(a, b kargoapi.Promotion) int {
+		return cmp.Compare(getPriority(b), getPriority(a))
+	})
+	return slices.IndexFunc(heads, func(p kargoapi.Promotion) bool {
+		return p.Name == name
+	})
+}
+
+// getPriority returns the priority of the provided Promotion within its
+// concurrency group.
+func getPriority(promo kargoapi.Promotion) int32 {
+	if promo.Spec.Concurrency == nil {
+		return 0
+	}
+	return promo.Spec.Concurrency.Priority
+}
@@ -0,0 +1,212 @@
+package promotions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/indexer"
+)
+
+func Test_concurrencyGroups_admit(t *testing.T) {
+	newGroupPromo := func(
+		name string,
+		stage string,
+		phase kargoapi.PromotionPhase,
+		created time.Time,
+		priority int32,
+	) *kargoapi.Promotion {
+		promo := newPromo("fake-namespace", name, stage, phase, metav1.NewTime(created))
+		promo.Spec.Concurrency = &kargoapi.PromotionConcurrency{
+			Group:    "fake-group",
+			Priority: priority,
+		}
+		return promo
+	}
+	projectCfg := func(limit int32) *kargoapi.ProjectConfig {
+		return &kargoapi.ProjectConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-namespace",
+				Name:      "fake-namespace",
+			},
+			Spec: kargoapi.ProjectConfigSpec{
+				ConcurrencyGroups: []kargoapi.ConcurrencyGroup{{
+					Name:  "fake-group",
+					Limit: limit,
+				}},
+			},
+		}
+	}
+	t0 := time.Now().Add(-time.Hour)
+
+	testCases := []struct {
+		name             string
+		objects          []client.Object
+		promo            *kargoapi.Promotion
+		expectedPosition int32
+	}{
+		{
+			name:             "no concurrency group",
+			promo:            newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, now),
+			expectedPosition: 0,
+		},
+		{
+			name:             "only Promotion in group",
+			promo:            newGroupPromo("fake-promo", "fake-stage", kargoapi.PromotionPhasePending, t0, 0),
+			expectedPosition: 0,
+		},
+		{
+			name: "undefined group limits to one running Promotion",
+			objects: []client.Object{
+				newGroupPromo("running", "other-stage", kargoapi.PromotionPhaseRunning, t0, 0),
+			},
+			promo:            newGroupPromo("fake-promo", "fake-stage", kargoapi.PromotionPhasePending, t0, 0),
+			expectedPosition: 1,
+		},
+		{
+			name: "terminal Promotions do not count",
+			objects: []client.Object{
+				newGroupPromo("succeeded", "other-stage", kargoapi.PromotionPhaseSucceeded, t0, 0),
+			},
+			promo:            newGroupPromo("fake-promo", "fake-stage", kargoapi.PromotionPhasePending, t0, 0),
+			expectedPosition: 0,
+		},
+		{
+			name: "below limit of group",
+			objects: []client.Object{
+				projectCfg(2),
+				newGroupPromo("running", "other-stage", kargoapi.PromotionPhaseRunning, t0, 0),
+			},
+			promo:            newGroupPromo("fake-promo", "fake-stage", kargoapi.PromotionPhasePending, t0, 0),
+			expectedPosition: 0,
+		},
+		{
+			name: "older Promotion is queued first",
+			objects: []client.Object{
+				projectCfg(2),
+				newGroupPromo("running", "stage-a", kargoapi.PromotionPhaseRunning, t0, 0),
+				newGroupPromo("older", "stage-b", kargoapi.PromotionPhasePending, t0, 0),
+			},
+			promo: newGroupPromo(
+				"fake-promo", "fake-stage", kargoapi.PromotionPhasePending, t0.Add(time.Minute), 0,
+			),
+			expectedPosition: 2,
+		},
+		{
+			name: "higher priority Promotion jumps the queue",
+			objects: []client.Object{
+				newGroupPromo("running", "stage-a", kargoapi.PromotionPhaseRunning, t0, 0),
+				newGroupPromo("older", "stage-b", kargoapi.PromotionPhasePending, t0, 0),
+			},
+			promo: newGroupPromo(
+				"fake-promo", "fake-stage", kargoapi.PromotionPhasePending, t0.Add(time.Minute), 10,
+			),
+			expectedPosition: 1,
+		},
+		{
+			name: "only the oldest Promotion to a Stage is queued",
+			objects: []client.Object{
+				newGroupPromo("running", "stage-a", kargoapi.PromotionPhaseRunning, t0, 0),
+				newGroupPromo("older", "stage-b", kargoapi.PromotionPhasePending, t0, 0),
+				newGroupPromo("newer", "stage-b", kargoapi.PromotionPhasePending, t0.Add(time.Second), 0),
+			},
+			promo: newGroupPromo(
+				"fake-promo", "fake-stage", kargoapi.PromotionPhasePending, t0.Add(time.Minute), 0,
+			),
+			expectedPosition: 2,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme := k8sruntime.NewScheme()
+			require.NoError(t, kargoapi.SchemeBuilder.AddToScheme(scheme))
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithIndex(
+					&kargoapi.Promotion{},
+					indexer.PromotionsByConcurrencyGroupField,
+					indexer.PromotionsByConcurrencyGroup,
+				).
+				WithObjects(append(tt.objects, tt.promo)...).
+				Build()
+
+			position, err := newConcurrencyGroups(c).admit(context.Background(), tt.promo)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedPosition, position)
+		})
+	}
+}
+
+func Test_concurrencyGroups_admitTracksAdmissions(t *testing.T) {
+	scheme := k8sruntime.NewScheme()
+	require.NoError(t, kargoapi.SchemeBuilder.AddToScheme(scheme))
+
+	promoA := newPromo("fake-namespace", "promo-a", "stage-a", kargoapi.PromotionPhasePending, before)
+	promoA.Spec.Concurrency = &kargoapi.PromotionConcurrency{Group: "fake-group"}
+	promoB := newPromo("fake-namespace", "promo-b", "stage-b", kargoapi.PromotionPhasePending, now)
+	promoB.Spec.Concurrency = &kargoapi.PromotionConcurrency{Group: "fake-group"}
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithIndex(
+			&kargoapi.Promotion{},
+			indexer.PromotionsByConcurrencyGroupField,
+			indexer.PromotionsByConcurrencyGroup,
+		).
+		WithObjects(promoA, promoB).
+		Build()
+	groups := newConcurrencyGroups(c)
+
+	// The first Promotion is admitted, and counts as running even though it has
+	// not been observed as such yet.
+	position, err := groups.admit(context.Background(), promoA)
+	require.NoError(t, err)
+	require.Zero(t, position)
+	require.Contains(t, groups.admitted, types.NamespacedName{Namespace: "fake-namespace", Name: "promo-a"})
+
+	position, err = groups.admit(context.Background(), promoB)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), position)
+
+	// Once the first Promotion has finished and its admission has been
+	// released, the next Promotion is admitted.
+	promoA.Status.Phase = kargoapi.PromotionPhaseSucceeded
+	require.NoError(t, c.Update(context.Background(), promoA))
+	groups.release(promoA)
+	position, err = groups.admit(context.Background(), promoB)
+	require.NoError(t, err)
+	require.Zero(t, position)
+}
+
+func Test_queuePosition(t *testing.T) {
+	t0 := time.Now()
+	newQueuedPromo := func(name, stage string, created time.Time, priority int32) kargoapi.Promotion {
+		promo := newPromo("fake-namespace", name, stage, kargoapi.PromotionPhasePending, metav1.NewTime(created))
+		promo.Spec.Concurrency = &kargoapi.PromotionConcurrency{Priority: priority}
+		return *promo
+	}
+	queued := []kargoapi.Promotion{
+		newQueuedPromo("c", "stage-c", t0.Add(2*time.Second), 0),
+		newQueuedPromo("a", "stage-a", t0, 0),
+		newQueuedPromo("b2", "stage-b", t0.Add(3*time.Second), 5),
+		newQueuedPromo("b1", "stage-b", t0.Add(time.Second), 0),
+		newQueuedPromo("d", "stage-d", t0.Add(4*time.Second), 5),
+	}
+
+	require.Equal(t, 0, queuePosition(queued, "d"))
+	require.Equal(t, 1, queuePosition(queued, "a"))
+	require.Equal(t, 2, queuePosition(queued, "b1"))
+	require.Equal(t, 3, queuePosition(queued, "c"))
+	// A Promotion which is not the oldest to its Stage is still assigned a
+	// position.
+	require.Equal(t, 0, queuePosition(queued, "b2"))
+	require.Equal(t, -1, queuePosition(queued, "unknown"))
+}
@@ -3,13 +3,13 @@ package promotions
 import (
 	"context"
 	"fmt"
-	"os"
-	"path/filepath"
+	"sync"
 	"time"
 
 	"github.com/kelseyhightower/envconfig"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
@@ -21,6 +21,7 @@ import (
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/pkg/api"
+	"github.com/akuity/kargo/pkg/audit"
 	"github.com/akuity/kargo/pkg/controller"
 	argocd "github.com/akuity/kargo/pkg/controller/argocd/api/v1alpha1"
 	"github.com/akuity/kargo/pkg/event"
@@ -32,6 +33,7 @@ import (
 	"github.com/akuity/kargo/pkg/logging"
 	intpredicate "github.com/akuity/kargo/pkg/predicate"
 	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/promotion/runner/builtin"
 )
 
 // ReconcilerConfig represents configuration for the promotion reconciler.
@@ -41,6 +43,10 @@ type ReconcilerConfig struct {
 	ShardName               string `envconfig:"SHARD_NAME"`
 	APIServerBaseURL        string `envconfig:"API_SERVER_BASE_URL"`
 	MaxConcurrentReconciles int    `envconfig:"MAX_CONCURRENT_PROMOTION_RECONCILES" default:"4"`
+	// RetainFailedWorkspaces indicates whether the workspace of the most recent
+	// Failed or Errored Promotion to each Stage is retained, so that a Promotion
+	// resuming it can skip the steps which already ran.
+	RetainFailedWorkspaces bool `envconfig:"PROMOTION_WORKSPACE_RETAIN_FAILED" default:"true"`
 }
 
 func (c ReconcilerConfig) Name() string {
@@ -65,7 +71,15 @@ type reconciler struct {
 
 	cfg ReconcilerConfig
 
-	sender event.Sender
+	sender  event.Sender
+	auditor *audit.Recorder
+
+	concurrency *concurrencyGroups
+
+	workspaces promotion.WorkspaceStore
+
+	pruneMu   sync.Mutex
+	lastPrune time.Time
 
 	// The following behaviors are overridable for testing purposes:
 
@@ -97,6 +111,8 @@ func SetupReconcilerWithManager(
 	kargoMgr manager.Manager,
 	argocdMgr manager.Manager,
 	promoEngine promotion.Engine,
+	workspaces promotion.WorkspaceStore,
+	auditor *audit.Recorder,
 	cfg ReconcilerConfig,
 ) error {
 	// Index running Promotions by Argo CD Applications
@@ -114,12 +130,24 @@ func SetupReconcilerWithManager(
 		return fmt.Errorf("index running Promotions by Argo CD Applications: %w", err)
 	}
 
+	// Index non-terminal Promotions by concurrency group
+	if err := kargoMgr.GetFieldIndexer().IndexField(
+		ctx,
+		&kargoapi.Promotion{},
+		indexer.PromotionsByConcurrencyGroupField,
+		indexer.PromotionsByConcurrencyGroup,
+	); err != nil {
+		return fmt.Errorf("index Promotions by concurrency group: %w", err)
+	}
+
 	reconciler := newReconciler(
 		kargoMgr.GetClient(),
 		k8sevent.NewEventSender(
 			libEvent.NewRecorder(ctx, kargoMgr.GetScheme(), kargoMgr.GetClient(), cfg.Name()),
 		),
+		auditor,
 		promoEngine,
+		workspaces,
 		cfg,
 	)
 
@@ -189,13 +217,18 @@ func SetupReconcilerWithManager(
 func newReconciler(
 	kargoClient client.Client,
 	sender event.Sender,
+	auditor *audit.Recorder,
 	promoEngine promotion.Engine,
+	workspaces promotion.WorkspaceStore,
 	cfg ReconcilerConfig,
 ) *reconciler {
 	r := &reconciler{
 		kargoClient: kargoClient,
 		promoEngine: promoEngine,
+		workspaces:  workspaces,
 		sender:      sender,
+		auditor:     auditor,
+		concurrency: newConcurrencyGroups(kargoClient),
 		cfg:         cfg,
 		shardPredicate: controller.ResponsibleFor[kargoapi.Promotion]{
 			IsDefaultController: cfg.IsDefaultController,
@@ -268,6 +301,16 @@ func (r *reconciler) Reconcile(
 		return ctrl.Result{}, nil
 	}
 
+	// Cancel any Promotions superseded by this one. This is repeated on every
+	// reconciliation while the Promotion is pending, so that Promotions which
+	// could not be canceled before are canceled on a retry.
+	if (promo.Status.Phase == "" || promo.Status.Phase == kargoapi.PromotionPhasePending) &&
+		promo.Spec.Concurrency != nil && promo.Spec.Concurrency.CancelSuperseded {
+		if err = r.cancelSupersededPromotions(ctx, promo); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
 	// If the Promotion does not have a Phase, it must be new and (initially)
 	// pending. Mark it as such.
 	if promo.Status.Phase == "" {
@@ -310,15 +353,70 @@ func (r *reconciler) Reconcile(
 		return ctrl.Result{}, nil
 	}
 
+	// Confirm that the concurrency group of the Promotion, if any, allows it to
+	// start running. If it does not, the Promotion remains pending and is
+	// periodically requeued until it does.
+	if promo.Status.Phase != kargoapi.PromotionPhaseRunning {
+		var position int32
+		if position, err = r.concurrency.admit(ctx, promo); err != nil {
+			return ctrl.Result{}, err
+		}
+		if position > 0 {
+			logger.Debug(
+				"Promotion is queued in concurrency group",
+				"group", promo.Spec.Concurrency.Group,
+				"position", position,
+			)
+			if promo.Status.QueuePosition != position {
+				if err = kubeclient.PatchStatus(ctx, r.kargoClient, promo, func(status *kargoapi.PromotionStatus) {
+					status.QueuePosition = position
+					status.Message = fmt.Sprintf(
+						"Waiting for concurrency group %q (position %d in queue)",
+						promo.Spec.Concurrency.Group, position,
+					)
+				}); err != nil {
+					return ctrl.Result{}, err
+				}
+			}
+			return ctrl.Result{RequeueAfter: concurrencyGroupRequeueInterval}, nil
+		}
+	}
+
 	// Update promo status as Running to give visibility in UI. Also, a promo which
 	// has already entered Running status will be allowed to continue to reconcile.
 	if promo.Status.Phase != kargoapi.PromotionPhaseRunning {
+		// If the Promotion resumes another Promotion, restore the state of the
+		// steps which are not executed again.
+		var resumed *kargoapi.Promotion
+		if promo.Spec.ResumeFrom != nil {
+			if resumed, err = api.GetPromotion(ctx, r.kargoClient, types.NamespacedName{
+				Namespace: promo.Namespace,
+				Name:      promo.Spec.ResumeFrom.Promotion,
+			}); err != nil {
+				r.concurrency.release(promo)
+				return ctrl.Result{}, err
+			}
+			if resumed == nil {
+				logger.Info(
+					"resumed Promotion not found; starting from the first step",
+					"resumedPromotion", promo.Spec.ResumeFrom.Promotion,
+				)
+			}
+		}
 		if err = kubeclient.PatchStatus(ctx, r.kargoClient, promo, func(status *kargoapi.PromotionStatus) {
 			status.Phase = kargoapi.PromotionPhaseRunning
 			status.StartedAt = &metav1.Time{Time: time.Now()}
+			status.QueuePosition = 0
+			status.Message = ""
+			if resumed != nil {
+				restoreResumedStatus(status, promo, resumed)
+			}
 		}); err != nil {
+			r.concurrency.release(promo)
 			return ctrl.Result{}, err
 		}
+		r.removeRetainedWorkspaces(ctx, promo)
+		r.pruneWorkspaces(ctx)
 		logger.Info("began promotion")
 	} else {
 		logger.Debug("continuing Promotion")
@@ -366,6 +464,8 @@ func (r *reconciler) Reconcile(
 	if newStatus.Phase.IsTerminal() {
 		newStatus.FinishedAt = &metav1.Time{Time: time.Now()}
 		logger.Info("promotion", "phase", newStatus.Phase)
+		r.deleteUnfinishedJobs(ctx, promo)
+		r.concurrency.release(promo)
 	}
 
 	// Record the current refresh token as having been handled.
@@ -450,6 +550,8 @@ func (r *reconciler) Reconcile(
 		if sendErr := r.sender.Send(ctx, evt); sendErr != nil {
 			logger.Error(sendErr, "error sending promotion event")
 		}
+
+		r.recordPromotionCompletedAudit(ctx, promo, audit.ControllerActor(r.cfg.Name()), newStatus)
 	}
 
 	if err != nil {
@@ -516,6 +618,15 @@ func (r *reconciler) promote(
 		stage,
 	)
 
+	workspace, err := r.getWorkspace(ctx, &promo)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error determining workspace: %w", err)
+	}
+	workDir, created, err := r.workspaces.Open(workspace)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error opening workspace: %w", err)
+	}
+
 	// Prepare promotion steps and vars for the promotion execution engine.
 	steps := promotion.NewSteps(workingPromo)
 	promoCtx := promotion.NewContext(
@@ -524,9 +635,25 @@ func (r *reconciler) promote(
 		targetFreightRef,
 		promotion.WithActor(api.CreateActorAnnotationValue(&promo)),
 		promotion.WithUIBaseURL(r.cfg.APIServerBaseURL),
-		promotion.WithWorkDir(filepath.Join(os.TempDir(), "promotion-"+string(workingPromo.UID))),
+		promotion.WithWorkDir(workDir),
 	)
-	if err := os.Mkdir(promoCtx.WorkDir, 0o700); err == nil {
+	if created && promo.Spec.ResumeFrom != nil && promoCtx.StartFromStep > 0 {
+		// The steps which are skipped may have produced files which subsequent
+		// steps depend on. Rather than silently starting over from the first
+		// step, fail the Promotion so that it can be resumed from the first step
+		// explicitly.
+		if err = r.workspaces.Remove(workspace); err != nil {
+			logger.Error(err, "could not remove workspace")
+		}
+		workingPromo.Status.Phase = kargoapi.PromotionPhaseFailed
+		workingPromo.Status.Message = fmt.Sprintf(
+			"working directory of resumed Promotion %q no longer exists; "+
+				"the Promotion can only be resumed from step 0",
+			promo.Spec.ResumeFrom.Promotion,
+		)
+		return &workingPromo.Status, nil, nil
+	}
+	if created {
 		// If we're working with a fresh directory, we should start the promotion
 		// process again from the beginning, but we DON'T clear shared state. This
 		// allows individual steps to self-discover that they've run before and
@@ -534,18 +661,31 @@ func (r *reconciler) promote(
 		promoCtx.StartFromStep = 0
 		promoCtx.StepExecutionMetadata = nil
 		workingPromo.Status.HealthChecks = nil
-	} else if !os.IsExist(err) {
-		return nil, nil, fmt.Errorf("error creating working directory: %w", err)
 	}
 	defer func() {
-		if workingPromo.Status.Phase.IsTerminal() {
-			if err := os.RemoveAll(promoCtx.WorkDir); err != nil {
-				logger.Error(err, "could not remove working directory")
+		if !workingPromo.Status.Phase.IsTerminal() {
+			return
+		}
+		if r.retainWorkspace(workingPromo.Status.Phase) {
+			if workingPromo.Status.Workspace == nil {
+				workingPromo.Status.Workspace = &kargoapi.PromotionWorkspace{}
 			}
+			workingPromo.Status.Workspace.Retained = true
+			return
+		}
+		if err := r.workspaces.Remove(workspace); err != nil {
+			logger.Error(err, "could not remove workspace")
 		}
 	}()
 
 	res, err := r.promoEngine.Promote(ctx, promoCtx, steps)
+	if size, sizeErr := r.workspaces.Size(workspace); sizeErr != nil {
+		logger.Error(sizeErr, "could not determine disk usage of workspace")
+	} else {
+		workingPromo.Status.Workspace = &kargoapi.PromotionWorkspace{
+			DiskUsage: *resource.NewQuantity(size, resource.BinarySI),
+		}
+	}
 	workingPromo.Status.Phase = res.Status
 	workingPromo.Status.Message = res.Message
 	workingPromo.Status.CurrentStep = res.CurrentStep
@@ -599,6 +739,156 @@ func (r *reconciler) promote(
 	return &workingPromo.Status, nil, nil
 }
 
+// workspacePruneInterval is the minimum amount of time between two attempts to
+// prune workspaces which have not been used within the TTL of the workspace
+// store.
+var workspacePruneInterval = 10 * time.Minute
+
+// getWorkspace returns the ID of the workspace of the provided Promotion. A
+// Promotion which resumes another Promotion shares the workspace of the
+// Promotion it resumes, if that Promotion still exists.
+func (r *reconciler) getWorkspace(ctx context.Context, promo *kargoapi.Promotion) (string, error) {
+	root := promo
+	for root.Spec.ResumeFrom != nil {
+		resumed, err := api.GetPromotion(ctx, r.kargoClient, types.NamespacedName{
+			Namespace: root.Namespace,
+			Name:      root.Spec.ResumeFrom.Promotion,
+		})
+		if err != nil {
+			return "", err
+		}
+		if resumed == nil {
+			break
+		}
+		root = resumed
+	}
+	return string(root.UID), nil
+}
+
+// retainWorkspace returns true if the workspace of a Promotion which reached
+// the provided terminal phase is retained, so that a Promotion which resumes
+// it can skip the steps that already ran.
+func (r *reconciler) retainWorkspace(phase kargoapi.PromotionPhase) bool {
+	return r.cfg.RetainFailedWorkspaces && isResumablePhase(phase)
+}
+
+// removeRetainedWorkspaces removes the retained workspaces of Failed and
+// Errored Promotions to the Stage of the provided Promotion, except for the
+// workspace used by the provided Promotion itself. This ensures at most one
+// workspace is retained per Stage.
+func (r *reconciler) removeRetainedWorkspaces(ctx context.Context, promo *kargoapi.Promotion) {
+	logger := logging.LoggerFromContext(ctx)
+
+	workspace, err := r.getWorkspace(ctx, promo)
+	if err != nil {
+		logger.Error(err, "error determining workspace")
+		return
+	}
+
+	promos := &kargoapi.PromotionList{}
+	if err = r.kargoClient.List(ctx, promos, client.InNamespace(promo.Namespace)); err != nil {
+		logger.Error(err, "error listing Promotions to remove retained workspaces")
+		return
+	}
+	for _, p := range promos.Items {
+		if p.Name == promo.Name || p.Spec.Stage != promo.Spec.Stage || !isResumablePhase(p.Status.Phase) {
+			continue
+		}
+		if string(p.UID) == workspace {
+			continue
+		}
+		if err = r.workspaces.Remove(string(p.UID)); err != nil {
+			logger.Error(err, "could not remove retained workspace", "promotion", p.Name)
+			continue
+		}
+		if p.Status.Workspace == nil || !p.Status.Workspace.Retained {
+			continue
+		}
+		if err = kubeclient.PatchStatus(ctx, r.kargoClient, &p, func(status *kargoapi.PromotionStatus) {
+			status.Workspace.Retained = false
+		}); err != nil {
+			logger.Error(err, "could not record removal of retained workspace", "promotion", p.Name)
+		}
+	}
+}
+
+// pruneWorkspaces removes workspaces which have not been used within the TTL
+// of the workspace store, such as the retained workspaces of Promotions which
+// were never resumed, or the workspaces of Promotions which were deleted or
+// aborted while running. The workspaces of non-terminal Promotions are never
+// removed. Pruning happens at most once per workspacePruneInterval.
+func (r *reconciler) pruneWorkspaces(ctx context.Context) {
+	r.pruneMu.Lock()
+	if time.Since(r.lastPrune) < workspacePruneInterval {
+		r.pruneMu.Unlock()
+		return
+	}
+	r.lastPrune = time.Now()
+	r.pruneMu.Unlock()
+
+	logger := logging.LoggerFromContext(ctx)
+
+	promos := &kargoapi.PromotionList{}
+	if err := r.kargoClient.List(ctx, promos); err != nil {
+		logger.Error(err, "error listing Promotions to prune workspaces")
+		return
+	}
+	var keep []string
+	for _, p := range promos.Items {
+		if p.Status.Phase.IsTerminal() {
+			continue
+		}
+		workspace, err := r.getWorkspace(ctx, &p)
+		if err != nil {
+			logger.Error(err, "error determining workspace", "promotion", p.Name)
+			return
+		}
+		keep = append(keep, workspace)
+	}
+	if err := r.workspaces.Prune(keep...); err != nil {
+		logger.Error(err, "error pruning workspaces")
+	}
+}
+
+// isResumablePhase returns true if a Promotion in the provided phase can be
+// resumed.
+func isResumablePhase(phase kargoapi.PromotionPhase) bool {
+	return phase == kargoapi.PromotionPhaseFailed || phase == kargoapi.PromotionPhaseErrored
+}
+
+// restoreResumedStatus restores the shared state, health checks and execution
+// metadata of the steps preceding the step from which the provided Promotion
+// resumes the provided resumed Promotion into the provided status.
+func restoreResumedStatus(
+	status *kargoapi.PromotionStatus,
+	promo *kargoapi.Promotion,
+	resumed *kargoapi.Promotion,
+) {
+	step := promo.Spec.ResumeFrom.Step
+	if step > int64(len(promo.Spec.Steps)) {
+		step = int64(len(promo.Spec.Steps))
+	}
+	skipped := make(map[string]struct{}, step)
+	for i, s := range promo.Spec.Steps[:step] {
+		skipped[s.GetAlias(i)] = struct{}{}
+	}
+	status.StepExecutionMetadata = nil
+	for _, md := range resumed.Status.StepExecutionMetadata {
+		if _, ok := skipped[md.Alias]; ok {
+			status.StepExecutionMetadata = append(status.StepExecutionMetadata, *md.DeepCopy())
+		}
+	}
+	status.CurrentStep = step
+	status.State = resumed.Status.State.DeepCopy()
+	// NB: Health checks can not be attributed to the steps which produced them,
+	// so all of them are restored. Health checks of steps which are executed
+	// again may therefore be performed more than once, which is harmless.
+	status.HealthChecks = nil
+	for _, hc := range resumed.Status.HealthChecks {
+		status.HealthChecks = append(status.HealthChecks, *hc.DeepCopy())
+	}
+}
+
 // buildTargetFreightCollection constructs a FreightCollection that contains all
 // FreightReferences from the previous Promotion (excepting those that are no
 // longer requested), plus a FreightReference for the provided targetFreight.
@@ -657,6 +947,84 @@ func (r *reconciler) terminatePromotion(
 		actor = req.Actor
 	}
 
+	message := "Promotion terminated per user request"
+	if actor != "" {
+		message = fmt.Sprintf("Promotion terminated by %s", actor)
+	}
+
+	return r.abortPromotion(ctx, promo, freight, actor, message)
+}
+
+// cancelSupersededPromotions aborts all pending Promotions to the Stage of the
+// provided Promotion which were created before it, as they have been
+// superseded by it. It is safe to call repeatedly, as Promotions that have
+// already been aborted are skipped.
+func (r *reconciler) cancelSupersededPromotions(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+) error {
+	logger := logging.LoggerFromContext(ctx)
+
+	promos := &kargoapi.PromotionList{}
+	if err := r.kargoClient.List(ctx, promos, client.InNamespace(promo.Namespace)); err != nil {
+		return fmt.Errorf(
+			"error listing Promotions in namespace %q: %w",
+			promo.Namespace, err,
+		)
+	}
+
+	actor := api.FormatEventControllerActor(r.cfg.Name())
+	for i := range promos.Items {
+		p := &promos.Items[i]
+		if p.Name == promo.Name || p.Spec.Stage != promo.Spec.Stage {
+			continue
+		}
+		// Promotions that are already running are left to finish, as aborting
+		// them midway could leave the Stage in an inconsistent state.
+		if p.Status.Phase.IsTerminal() || p.Status.Phase == kargoapi.PromotionPhaseRunning {
+			continue
+		}
+		if !p.CreationTimestamp.Before(&promo.CreationTimestamp) {
+			continue
+		}
+
+		freight, err := api.GetFreight(ctx, r.kargoClient, types.NamespacedName{
+			Namespace: p.Namespace,
+			Name:      p.Spec.Freight,
+		})
+		if err != nil {
+			return fmt.Errorf(
+				"error finding Freight %q in namespace %q: %w",
+				p.Spec.Freight, p.Namespace, err,
+			)
+		}
+
+		logger.Info("canceling superseded Promotion", "superseded", p.Name)
+		if err = r.abortPromotion(
+			ctx,
+			p,
+			freight,
+			actor,
+			fmt.Sprintf("Superseded by Promotion %q", promo.Name),
+		); err != nil {
+			return fmt.Errorf("error canceling superseded Promotion %q: %w", p.Name, err)
+		}
+	}
+	return nil
+}
+
+// abortPromotion moves the given Promotion to the aborted phase with the
+// provided message, and emits an event and audit entry on behalf of the
+// provided actor.
+func (r *reconciler) abortPromotion(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+	freight *kargoapi.Freight,
+	actor string,
+	message string,
+) error {
+	logger := logging.LoggerFromContext(ctx)
+
 	newStatus := promo.Status.DeepCopy()
 
 	now := &metav1.Time{Time: time.Now()}
@@ -668,28 +1036,59 @@ func (r *reconciler) terminatePromotion(
 	}
 
 	newStatus.Phase = kargoapi.PromotionPhaseAborted
-	if actor != "" {
-		newStatus.Message = fmt.Sprintf("Promotion terminated by %s", actor)
-	} else {
-		newStatus.Message = "Promotion terminated per user request"
-	}
+	newStatus.Message = message
+	newStatus.QueuePosition = 0
 	newStatus.FinishedAt = now
 
+	r.deleteUnfinishedJobs(ctx, promo)
+
 	if err := kubeclient.PatchStatus(ctx, r.kargoClient, promo, func(status *kargoapi.PromotionStatus) {
 		*status = *newStatus
 	}); err != nil {
 		return err
 	}
 
+	r.concurrency.release(promo)
+
 	evt := event.NewPromotionAborted(newStatus.Message, actor, promo, freight)
 
 	if err := r.sender.Send(ctx, evt); err != nil {
 		logger.Error(err, "error sending Promotion aborted event")
 	}
 
+	r.recordPromotionCompletedAudit(ctx, promo, audit.Actor{Name: actor}, newStatus)
+
 	return nil
 }
 
+// recordPromotionCompletedAudit records an audit entry for a Promotion that
+// has reached the terminal phase found in the provided status.
+func (r *reconciler) recordPromotionCompletedAudit(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+	actor audit.Actor,
+	status *kargoapi.PromotionStatus,
+) {
+	details := map[string]string{
+		"stage":   promo.Spec.Stage,
+		"freight": promo.Spec.Freight,
+		"phase":   string(status.Phase),
+	}
+	if status.Message != "" {
+		details["message"] = status.Message
+	}
+	r.auditor.Record(ctx, audit.Entry{
+		Action:  audit.ActionPromotionCompleted,
+		Actor:   actor,
+		Project: promo.Namespace,
+		Object: audit.ObjectReference{
+			Kind: "Promotion",
+			Name: promo.Name,
+		},
+		Details: details,
+	})
+}
+
 var defaultRequeueInterval = 5 * time.Minute
 
 func calculateRequeueInterval(
@@ -727,3 +1126,13 @@ func calculateRequeueInterval(
 	}
 	return requeueInterval
 }
+
+// deleteUnfinishedJobs deletes any Jobs started by run-job steps of the given
+// Promotion that are still running, as nothing will wait for them once the
+// Promotion has finished. Failures are logged, but are otherwise ignored, as
+// the Jobs are owned by the Promotion and will be deleted along with it.
+func (r *reconciler) deleteUnfinishedJobs(ctx context.Context, promo *kargoapi.Promotion) {
+	if err := builtin.DeleteUnfinishedRunJobs(ctx, r.kargoClient, promo); err != nil {
+		logging.LoggerFromContext(ctx).Error(err, "error deleting unfinished Jobs of Promotion")
+	}
+}
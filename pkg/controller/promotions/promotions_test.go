@@ -3,10 +3,13 @@ package promotions
 import (
 	"context"
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -17,7 +20,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/audit"
 	k8sevent "github.com/akuity/kargo/pkg/event/kubernetes"
+	"github.com/akuity/kargo/pkg/indexer"
 	fakeevent "github.com/akuity/kargo/pkg/kubernetes/event/fake"
 	"github.com/akuity/kargo/pkg/promotion"
 )
@@ -32,12 +37,17 @@ func TestNewPromotionReconciler(t *testing.T) {
 	r := newReconciler(
 		kubeClient,
 		k8sevent.NewEventSender(&fakeevent.EventRecorder{}),
+		audit.NewRecorder(),
 		&promotion.MockEngine{},
+		&promotion.DirWorkspaceStore{},
 		ReconcilerConfig{},
 	)
 	require.NotNil(t, r.kargoClient)
 	require.NotNil(t, r.sender)
+	require.NotNil(t, r.auditor)
 	require.NotNil(t, r.promoEngine)
+	require.NotNil(t, r.workspaces)
+	require.NotNil(t, r.concurrency)
 	require.NotNil(t, r.getStageFn)
 	require.NotNil(t, r.promoteFn)
 }
@@ -50,11 +60,18 @@ func newFakeReconciler(
 	scheme := k8sruntime.NewScheme()
 	require.NoError(t, kargoapi.SchemeBuilder.AddToScheme(scheme))
 	kargoClient := fake.NewClientBuilder().WithScheme(scheme).
+		WithIndex(
+			&kargoapi.Promotion{},
+			indexer.PromotionsByConcurrencyGroupField,
+			indexer.PromotionsByConcurrencyGroup,
+		).
 		WithObjects(objects...).WithStatusSubresource(objects...).Build()
 	return newReconciler(
 		kargoClient,
 		k8sevent.NewEventSender(recorder),
+		audit.NewRecorder(),
 		&promotion.MockEngine{},
+		newFakeWorkspaceStore(t),
 		ReconcilerConfig{},
 	)
 }
@@ -98,6 +115,37 @@ func TestReconcile(t *testing.T) {
 			},
 			promoToReconcile: &types.NamespacedName{Namespace: "fake-namespace", Name: "fake-promo"},
 		},
+		{
+			name:                  "promo queued in concurrency group",
+			expectPromoteFnCalled: false,
+			expectedPhase:         kargoapi.PromotionPhasePending,
+			promos: []client.Object{
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-stage",
+						Namespace: "fake-namespace",
+					},
+					Status: kargoapi.StageStatus{
+						CurrentPromotion: &kargoapi.PromotionReference{
+							Name: "fake-promo",
+						},
+					},
+				},
+				func() *kargoapi.Promotion {
+					promo := newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, now)
+					promo.Spec.Concurrency = &kargoapi.PromotionConcurrency{Group: "fake-group"}
+					return promo
+				}(),
+				func() *kargoapi.Promotion {
+					promo := newPromo(
+						"fake-namespace", "other-promo", "other-stage", kargoapi.PromotionPhaseRunning, before,
+					)
+					promo.Spec.Concurrency = &kargoapi.PromotionConcurrency{Group: "fake-group"}
+					return promo
+				}(),
+			},
+			promoToReconcile: &types.NamespacedName{Namespace: "fake-namespace", Name: "fake-promo"},
+		},
 		{
 			name:                  "promo doesn't exist",
 			promoToReconcile:      &types.NamespacedName{Namespace: "fake-namespace", Name: "fake-promo"},
@@ -522,6 +570,7 @@ func Test_reconciler_terminatePromotion(t *testing.T) {
 			r := &reconciler{
 				kargoClient: c,
 				sender:      k8sevent.NewEventSender(recorder),
+				concurrency: newConcurrencyGroups(c),
 			}
 
 			req := tt.req
@@ -531,6 +580,386 @@ func Test_reconciler_terminatePromotion(t *testing.T) {
 	}
 }
 
+func Test_reconciler_cancelSupersededPromotions(t *testing.T) {
+	scheme := k8sruntime.NewScheme()
+	require.NoError(t, kargoapi.SchemeBuilder.AddToScheme(scheme))
+
+	promo := newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, now)
+	promo.Spec.Concurrency = &kargoapi.PromotionConcurrency{CancelSuperseded: true}
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			promo,
+			newPromo("fake-namespace", "older-pending", "fake-stage", kargoapi.PromotionPhasePending, before),
+			newPromo("fake-namespace", "older-running", "fake-stage", kargoapi.PromotionPhaseRunning, before),
+			newPromo("fake-namespace", "other-stage", "other-stage", kargoapi.PromotionPhasePending, before),
+			newPromo(
+				"fake-namespace", "newer-pending", "fake-stage", kargoapi.PromotionPhasePending,
+				metav1.NewTime(now.Add(time.Second)),
+			),
+		).
+		WithStatusSubresource(&kargoapi.Promotion{}).
+		Build()
+	recorder := fakeevent.NewEventRecorder(1)
+
+	r := &reconciler{
+		kargoClient: c,
+		sender:      k8sevent.NewEventSender(recorder),
+		auditor:     audit.NewRecorder(),
+		concurrency: newConcurrencyGroups(c),
+	}
+
+	require.NoError(t, r.cancelSupersededPromotions(context.Background(), promo))
+
+	expectedPhases := map[string]kargoapi.PromotionPhase{
+		"fake-promo":    kargoapi.PromotionPhasePending,
+		"older-pending": kargoapi.PromotionPhaseAborted,
+		"older-running": kargoapi.PromotionPhaseRunning,
+		"other-stage":   kargoapi.PromotionPhasePending,
+		"newer-pending": kargoapi.PromotionPhasePending,
+	}
+	for name, phase := range expectedPhases {
+		p := &kargoapi.Promotion{}
+		require.NoError(t, c.Get(
+			context.Background(),
+			types.NamespacedName{Namespace: "fake-namespace", Name: name},
+			p,
+		))
+		require.Equal(t, phase, p.Status.Phase, name)
+		if phase == kargoapi.PromotionPhaseAborted {
+			require.Equal(t, `Superseded by Promotion "fake-promo"`, p.Status.Message)
+		}
+	}
+	require.Len(t, recorder.Events, 1)
+
+	// Canceling again is a no-op, as superseded Promotions that have already
+	// been canceled are skipped.
+	require.NoError(t, r.cancelSupersededPromotions(context.Background(), promo))
+	require.Len(t, recorder.Events, 1)
+}
+
+func Test_reconciler_getWorkspace(t *testing.T) {
+	scheme := k8sruntime.NewScheme()
+	require.NoError(t, kargoapi.SchemeBuilder.AddToScheme(scheme))
+
+	first := newPromo("fake-namespace", "first", "fake-stage", kargoapi.PromotionPhaseFailed, before)
+	first.UID = "first-uid"
+	second := newPromo("fake-namespace", "second", "fake-stage", kargoapi.PromotionPhaseErrored, before)
+	second.UID = "second-uid"
+	second.Spec.ResumeFrom = &kargoapi.PromotionResume{Promotion: "first"}
+	orphan := newPromo("fake-namespace", "orphan", "fake-stage", kargoapi.PromotionPhasePending, now)
+	orphan.UID = "orphan-uid"
+	orphan.Spec.ResumeFrom = &kargoapi.PromotionResume{Promotion: "deleted"}
+	third := newPromo("fake-namespace", "third", "fake-stage", kargoapi.PromotionPhasePending, now)
+	third.UID = "third-uid"
+	third.Spec.ResumeFrom = &kargoapi.PromotionResume{Promotion: "second"}
+
+	r := &reconciler{
+		kargoClient: fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(first, second).
+			Build(),
+	}
+
+	testCases := []struct {
+		name     string
+		promo    *kargoapi.Promotion
+		expected string
+	}{
+		{
+			name:     "Promotion which does not resume another Promotion",
+			promo:    first,
+			expected: "first-uid",
+		},
+		{
+			name:     "Promotion which resumes another Promotion",
+			promo:    second,
+			expected: "first-uid",
+		},
+		{
+			name:     "Promotion which resumes a Promotion which resumed another Promotion",
+			promo:    third,
+			expected: "first-uid",
+		},
+		{
+			name:     "Promotion which resumes a Promotion which no longer exists",
+			promo:    orphan,
+			expected: "orphan-uid",
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			workspace, err := r.getWorkspace(context.Background(), tt.promo)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, workspace)
+		})
+	}
+}
+
+func Test_reconciler_retainWorkspace(t *testing.T) {
+	r := &reconciler{cfg: ReconcilerConfig{RetainFailedWorkspaces: true}}
+	require.True(t, r.retainWorkspace(kargoapi.PromotionPhaseFailed))
+	require.True(t, r.retainWorkspace(kargoapi.PromotionPhaseErrored))
+	require.False(t, r.retainWorkspace(kargoapi.PromotionPhaseSucceeded))
+	require.False(t, r.retainWorkspace(kargoapi.PromotionPhaseAborted))
+
+	r.cfg.RetainFailedWorkspaces = false
+	require.False(t, r.retainWorkspace(kargoapi.PromotionPhaseFailed))
+	require.False(t, r.retainWorkspace(kargoapi.PromotionPhaseErrored))
+}
+
+func Test_reconciler_removeRetainedWorkspaces(t *testing.T) {
+	scheme := k8sruntime.NewScheme()
+	require.NoError(t, kargoapi.SchemeBuilder.AddToScheme(scheme))
+
+	workspaces := newFakeWorkspaceStore(t)
+	newRetainedPromo := func(name, stage string, phase kargoapi.PromotionPhase) *kargoapi.Promotion {
+		promo := newPromo("fake-namespace", name, stage, phase, before)
+		promo.UID = types.UID(name)
+		promo.Status.Workspace = &kargoapi.PromotionWorkspace{Retained: true}
+		_, _, err := workspaces.Open(name)
+		require.NoError(t, err)
+		return promo
+	}
+
+	resumed := newRetainedPromo("resumed", "fake-stage", kargoapi.PromotionPhaseFailed)
+	promo := newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhaseRunning, now)
+	promo.UID = "fake-promo"
+	promo.Spec.ResumeFrom = &kargoapi.PromotionResume{Promotion: "resumed"}
+
+	objects := []client.Object{
+		resumed,
+		promo,
+		newRetainedPromo("failed", "fake-stage", kargoapi.PromotionPhaseFailed),
+		newRetainedPromo("errored", "fake-stage", kargoapi.PromotionPhaseErrored),
+		newRetainedPromo("succeeded", "fake-stage", kargoapi.PromotionPhaseSucceeded),
+		newRetainedPromo("other-stage", "other-stage", kargoapi.PromotionPhaseFailed),
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objects...).
+		WithStatusSubresource(objects...).
+		Build()
+
+	r := &reconciler{kargoClient: c, workspaces: workspaces}
+	r.removeRetainedWorkspaces(context.Background(), promo)
+
+	expectedRetained := map[string]bool{
+		"resumed":     true,
+		"failed":      false,
+		"errored":     false,
+		"succeeded":   true,
+		"other-stage": true,
+	}
+	for name, retained := range expectedRetained {
+		_, created, err := workspaces.Open(name)
+		require.NoError(t, err)
+		require.Equal(t, !retained, created, name)
+
+		p := &kargoapi.Promotion{}
+		require.NoError(t, c.Get(
+			context.Background(),
+			types.NamespacedName{Namespace: "fake-namespace", Name: name},
+			p,
+		))
+		require.Equal(t, retained, p.Status.Workspace.Retained, name)
+	}
+}
+
+func Test_reconciler_pruneWorkspaces(t *testing.T) {
+	scheme := k8sruntime.NewScheme()
+	require.NoError(t, kargoapi.SchemeBuilder.AddToScheme(scheme))
+
+	dir := t.TempDir()
+	workspaces, err := promotion.NewDirWorkspaceStore(promotion.WorkspaceStoreConfig{
+		Dir: dir,
+		TTL: time.Hour,
+	})
+	require.NoError(t, err)
+
+	newPromoWithWorkspace := func(name string, phase kargoapi.PromotionPhase) *kargoapi.Promotion {
+		promo := newPromo("fake-namespace", name, "fake-stage", phase, before)
+		promo.UID = types.UID(name)
+		_, _, err := workspaces.Open(name)
+		require.NoError(t, err)
+		// Make the workspace appear unused for longer than the TTL.
+		expired := time.Now().Add(-2 * time.Hour)
+		require.NoError(t, os.Chtimes(filepath.Join(dir, "promotion-"+name), expired, expired))
+		return promo
+	}
+
+	resuming := newPromo("fake-namespace", "resuming", "fake-stage", kargoapi.PromotionPhasePending, now)
+	resuming.Spec.ResumeFrom = &kargoapi.PromotionResume{Promotion: "resumed"}
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			newPromoWithWorkspace("running", kargoapi.PromotionPhaseRunning),
+			newPromoWithWorkspace("resumed", kargoapi.PromotionPhaseFailed),
+			newPromoWithWorkspace("failed", kargoapi.PromotionPhaseFailed),
+			resuming,
+		).
+		Build()
+
+	// The workspace of a deleted Promotion
+	newPromoWithWorkspace("deleted", kargoapi.PromotionPhaseFailed)
+
+	r := &reconciler{kargoClient: c, workspaces: workspaces}
+	r.pruneWorkspaces(context.Background())
+
+	expectedRetained := map[string]bool{
+		"running": true,
+		"resumed": true,
+		"failed":  false,
+		"deleted": false,
+	}
+	for name, retained := range expectedRetained {
+		_, err := os.Stat(filepath.Join(dir, "promotion-"+name))
+		if retained {
+			require.NoError(t, err, name)
+		} else {
+			require.True(t, os.IsNotExist(err), name)
+		}
+	}
+
+	// Pruning again right away is a no-op.
+	newPromoWithWorkspace("failed", kargoapi.PromotionPhaseFailed)
+	r.pruneWorkspaces(context.Background())
+	require.DirExists(t, filepath.Join(dir, "promotion-failed"))
+}
+
+func Test_reconciler_promote_resume(t *testing.T) {
+	stage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-stage",
+		},
+	}
+	freight := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-freight",
+		},
+		Status: kargoapi.FreightStatus{
+			ApprovedFor: map[string]kargoapi.ApprovedStage{"fake-stage": {}},
+		},
+	}
+	resumed := newPromo("fake-namespace", "resumed", "fake-stage", kargoapi.PromotionPhaseFailed, before)
+	resumed.UID = "resumed"
+	promo := newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhaseRunning, now)
+	promo.UID = "fake-promo"
+	promo.Spec.Freight = "fake-freight"
+	promo.Spec.Steps = []kargoapi.PromotionStep{{Uses: "a"}, {Uses: "b"}}
+	promo.Spec.ResumeFrom = &kargoapi.PromotionResume{Promotion: "resumed", Step: 1}
+	promo.Status.CurrentStep = 1
+
+	testCases := []struct {
+		name            string
+		workspaceExists bool
+		expectPromote   bool
+		assertions      func(*testing.T, *kargoapi.PromotionStatus)
+	}{
+		{
+			name:          "workspace of resumed Promotion no longer exists",
+			expectPromote: false,
+			assertions: func(t *testing.T, status *kargoapi.PromotionStatus) {
+				require.Equal(t, kargoapi.PromotionPhaseFailed, status.Phase)
+				require.Contains(t, status.Message, `working directory of resumed Promotion "resumed" no longer exists`)
+				require.Nil(t, status.Workspace)
+			},
+		},
+		{
+			name:            "workspace of resumed Promotion exists",
+			workspaceExists: true,
+			expectPromote:   true,
+			assertions: func(t *testing.T, status *kargoapi.PromotionStatus) {
+				require.Equal(t, kargoapi.PromotionPhaseFailed, status.Phase)
+				require.NotNil(t, status.Workspace)
+				require.True(t, status.Workspace.Retained)
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := newFakeReconciler(t, fakeevent.NewEventRecorder(1), stage, freight, resumed, promo)
+			r.cfg.RetainFailedWorkspaces = true
+			if tc.workspaceExists {
+				_, _, err := r.workspaces.Open("resumed")
+				require.NoError(t, err)
+			}
+
+			promoteWasCalled := false
+			r.promoEngine = &promotion.MockEngine{
+				PromoteFn: func(
+					_ context.Context,
+					promoCtx promotion.Context,
+					_ []promotion.Step,
+				) (promotion.Result, error) {
+					promoteWasCalled = true
+					require.Equal(t, int64(1), promoCtx.StartFromStep)
+					return promotion.Result{
+						Status:      kargoapi.PromotionPhaseFailed,
+						CurrentStep: 1,
+					}, nil
+				},
+			}
+
+			status, _, err := r.promote(context.Background(), *promo, stage, freight)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectPromote, promoteWasCalled)
+			tc.assertions(t, status)
+
+			_, created, err := r.workspaces.Open("resumed")
+			require.NoError(t, err)
+			require.Equal(t, !tc.workspaceExists, created)
+		})
+	}
+}
+
+func Test_restoreResumedStatus(t *testing.T) {
+	promo := &kargoapi.Promotion{
+		Spec: kargoapi.PromotionSpec{
+			Steps: []kargoapi.PromotionStep{
+				{Uses: "fake-step"},
+				{Uses: "fake-step", As: "second"},
+				{Uses: "fake-step", As: "third"},
+			},
+			ResumeFrom: &kargoapi.PromotionResume{
+				Promotion: "resumed",
+				Step:      2,
+			},
+		},
+	}
+	resumed := &kargoapi.Promotion{
+		Status: kargoapi.PromotionStatus{
+			Phase:       kargoapi.PromotionPhaseFailed,
+			CurrentStep: 2,
+			StepExecutionMetadata: kargoapi.StepExecutionMetadataList{
+				{Alias: "step-1", Status: kargoapi.PromotionStepStatusSucceeded},
+				{Alias: "second", Status: kargoapi.PromotionStepStatusSucceeded},
+				{Alias: "third", Status: kargoapi.PromotionStepStatusFailed, Message: "something went wrong"},
+			},
+			State: &apiextensionsv1.JSON{Raw: []byte(`{"second":{"commit":"abc123"}}`)},
+			HealthChecks: []kargoapi.HealthCheckStep{
+				{Uses: "fake-health-check"},
+			},
+		},
+	}
+
+	status := &kargoapi.PromotionStatus{Phase: kargoapi.PromotionPhaseRunning}
+	restoreResumedStatus(status, promo, resumed)
+
+	require.Equal(t, kargoapi.PromotionPhaseRunning, status.Phase)
+	require.Equal(t, int64(2), status.CurrentStep)
+	require.Equal(t, kargoapi.StepExecutionMetadataList{
+		{Alias: "step-1", Status: kargoapi.PromotionStepStatusSucceeded},
+		{Alias: "second", Status: kargoapi.PromotionStepStatusSucceeded},
+	}, status.StepExecutionMetadata)
+	require.Equal(t, resumed.Status.State, status.State)
+	require.Equal(t, resumed.Status.HealthChecks, status.HealthChecks)
+}
+
 func Test_calculateRequeueInterval(t *testing.T) {
 	testStepKindWithoutTimeout := "fake-step-without-timeout"
 	promotion.RegisterStepRunner(
@@ -766,6 +1195,12 @@ func Test_calculateRequeueInterval(t *testing.T) {
 }
 
 // nolint: unparam
+func newFakeWorkspaceStore(t *testing.T) *promotion.DirWorkspaceStore {
+	workspaces, err := promotion.NewDirWorkspaceStore(promotion.WorkspaceStoreConfig{Dir: t.TempDir()})
+	require.NoError(t, err)
+	return workspaces
+}
+
 func newPromo(namespace, name, stage string,
 	phase kargoapi.PromotionPhase,
 	creationTimestamp metav1.Time,
@@ -4,13 +4,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/vm"
 	"github.com/google/uuid"
 	"github.com/kelseyhightower/envconfig"
 	gocache "github.com/patrickmn/go-cache"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
@@ -30,6 +36,7 @@ import (
 	rolloutsapi "github.com/akuity/kargo/api/stubs/rollouts/v1alpha1"
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/pkg/api"
+	"github.com/akuity/kargo/pkg/audit"
 	"github.com/akuity/kargo/pkg/conditions"
 	"github.com/akuity/kargo/pkg/controller"
 	argocdapi "github.com/akuity/kargo/pkg/controller/argocd/api/v1alpha1"
@@ -43,8 +50,8 @@ import (
 	"github.com/akuity/kargo/pkg/kubernetes"
 	libEvent "github.com/akuity/kargo/pkg/kubernetes/event"
 	"github.com/akuity/kargo/pkg/logging"
-	"github.com/akuity/kargo/pkg/pattern"
 	intpredicate "github.com/akuity/kargo/pkg/predicate"
+	"github.com/akuity/kargo/pkg/promotion"
 	"github.com/akuity/kargo/pkg/rollouts"
 )
 
@@ -75,24 +82,36 @@ func ReconcilerConfigFromEnv() ReconcilerConfig {
 	return cfg
 }
 
+// verificationStepsRequeueInterval is the interval at which a Stage is
+// requeued while the steps of its current verification are being executed.
+const verificationStepsRequeueInterval = 10 * time.Second
+
 type RegularStageReconciler struct {
 	cfg            ReconcilerConfig
 	client         client.Client
 	eventSender    kargoEvent.Sender
+	auditor        *audit.Recorder
 	healthChecker  health.AggregatingChecker
+	promoEngine    promotion.Engine
 	shardPredicate controller.ResponsibleFor[kargoapi.Stage]
 
 	backoffCfg wait.Backoff
 }
 
-// NewRegularStageReconciler creates a new Stages reconciler.
+// NewRegularStageReconciler creates a new Stages reconciler. The provided
+// promotion.Engine is used to execute the steps of Stages that are verified
+// using promotion steps.
 func NewRegularStageReconciler(
 	cfg ReconcilerConfig,
 	healthChecker health.AggregatingChecker,
+	promoEngine promotion.Engine,
+	auditor *audit.Recorder,
 ) *RegularStageReconciler {
 	return &RegularStageReconciler{
 		cfg:           cfg,
 		healthChecker: healthChecker,
+		promoEngine:   promoEngine,
+		auditor:       auditor,
 		shardPredicate: controller.ResponsibleFor[kargoapi.Stage]{
 			IsDefaultController: cfg.IsDefaultController,
 			ShardName:           cfg.ShardName,
@@ -393,6 +412,13 @@ func (r *RegularStageReconciler) Reconcile(ctx context.Context, req ctrl.Request
 	if needsRequeue {
 		return ctrl.Result{RequeueAfter: 100 * time.Millisecond}, nil
 	}
+	// Unlike AnalysisRuns, there is nothing to watch for progress of
+	// verification steps, so we need to requeue to check on them.
+	if curFreight := newStatus.FreightHistory.Current(); curFreight != nil {
+		if vi := curFreight.VerificationHistory.Current(); vi.HasSteps() && !vi.Phase.IsTerminal() {
+			return ctrl.Result{RequeueAfter: verificationStepsRequeueInterval}, nil
+		}
+	}
 	// Otherwise, requeue after a delay.
 	// TODO: Make the requeue delay configurable.
 	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
@@ -1113,8 +1139,13 @@ func (r *RegularStageReconciler) verifyStageFreight(
 				return newStatus, err
 			}
 
-			// Get the latest result of the verification.
-			newVI, err = r.getVerificationResult(ctx, *curFreight)
+			// Get the latest result of the verification. For verification steps,
+			// this means resuming their execution.
+			if lastVerification.HasSteps() {
+				newVI, err = r.runVerificationSteps(ctx, stage, *curFreight, lastVerification)
+			} else {
+				newVI, err = r.getVerificationResult(ctx, *curFreight)
+			}
 			if newVI != nil {
 				newStatus.FreightHistory.Current().VerificationHistory.UpdateOrPush(*newVI)
 
@@ -1350,6 +1381,12 @@ func (r *RegularStageReconciler) startVerification(
 		newVI.Actor = req.Actor
 	}
 
+	// Verification steps are executed by this controller and do not depend on
+	// the Rollouts integration.
+	if stage.Spec.Verification.HasSteps() {
+		return r.runVerificationSteps(ctx, stage, freight, newVI)
+	}
+
 	// Return early, as we cannot start the verification if the Rollouts
 	// integration is disabled.
 	if !r.cfg.RolloutsIntegrationEnabled {
@@ -1581,7 +1618,7 @@ func (r *RegularStageReconciler) abortVerification(
 	if currentVI == nil {
 		return nil, fmt.Errorf("no current verification info for Freight collection %q", freight.ID)
 	}
-	if currentVI.AnalysisRun == nil {
+	if currentVI.AnalysisRun == nil && !currentVI.HasSteps() {
 		return nil, fmt.Errorf(
 			"no AnalysisRun reference in current verification info for Freight collection %q",
 			freight.ID,
@@ -1600,6 +1637,17 @@ func (r *RegularStageReconciler) abortVerification(
 		actor = req.Actor
 	}
 
+	// Verification steps are aborted simply by no longer executing them.
+	if currentVI.HasSteps() {
+		r.cleanupVerificationWorkDir(ctx, currentVI.ID)
+		newVI := currentVI.DeepCopy()
+		newVI.Actor = actor
+		newVI.FinishTime = ptr.To(metav1.Now())
+		newVI.Phase = kargoapi.VerificationPhaseAborted
+		newVI.Message = "Verification aborted by user"
+		return newVI, nil
+	}
+
 	// If the Rollouts integration is disabled, then we cannot abort the
 	// verification.
 	if !r.cfg.RolloutsIntegrationEnabled {
@@ -1658,6 +1706,126 @@ func (r *RegularStageReconciler) abortVerification(
 	}, nil
 }
 
+// runVerificationSteps starts or resumes the execution of the verification
+// steps of a Stage for the provided Freight collection and returns an updated
+// copy of the provided VerificationInfo that reflects the outcome. Errors
+// executing the steps are reflected in the returned VerificationInfo rather
+// than returned, as retrying would not change the outcome.
+func (r *RegularStageReconciler) runVerificationSteps(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	freight kargoapi.FreightCollection,
+	vi *kargoapi.VerificationInfo,
+) (*kargoapi.VerificationInfo, error) {
+	logger := logging.LoggerFromContext(ctx).WithValues("verification", vi.ID)
+	newVI := vi.DeepCopy()
+
+	if r.promoEngine == nil {
+		newVI.FinishTime = ptr.To(metav1.Now())
+		newVI.Phase = kargoapi.VerificationPhaseError
+		newVI.Message = "Promotion engine is not configured on this controller: cannot execute verification steps"
+		return newVI, nil
+	}
+	if !stage.Spec.Verification.HasSteps() {
+		// The Stage's verification configuration has changed since the
+		// verification was started.
+		r.cleanupVerificationWorkDir(ctx, vi.ID)
+		newVI.FinishTime = ptr.To(metav1.Now())
+		newVI.Phase = kargoapi.VerificationPhaseError
+		newVI.Message = "Stage no longer specifies verification steps: cannot resume verification"
+		return newVI, nil
+	}
+
+	promoCtx := promotion.Context{
+		WorkDir:               verificationWorkDir(vi.ID),
+		Project:               stage.Namespace,
+		Stage:                 stage.Name,
+		FreightRequests:       stage.Spec.RequestedFreight,
+		Freight:               *freight.DeepCopy(),
+		StartFromStep:         vi.CurrentStep,
+		StepExecutionMetadata: vi.StepExecutionMetadata,
+		State:                 promotion.State(vi.GetState()),
+		Vars:                  stage.Spec.Vars,
+		Actor:                 vi.Actor,
+		Agent:                 stage.Spec.Agent,
+		Verification:          vi.ID,
+	}
+	if lastPromo := stage.Status.LastPromotion; lastPromo != nil && lastPromo.Freight != nil {
+		promoCtx.TargetFreightRef = *lastPromo.Freight.DeepCopy()
+	}
+	if err := os.Mkdir(promoCtx.WorkDir, 0o700); err == nil {
+		// If we're working with a fresh directory, we should start executing the
+		// steps again from the beginning, but we DON'T clear shared state. This
+		// allows individual steps to self-discover that they've run before and
+		// examine the results of their own previous execution.
+		promoCtx.StartFromStep = 0
+		promoCtx.StepExecutionMetadata = nil
+	} else if !os.IsExist(err) {
+		newVI.FinishTime = ptr.To(metav1.Now())
+		newVI.Phase = kargoapi.VerificationPhaseError
+		newVI.Message = fmt.Sprintf("error creating working directory: %s", err)
+		return newVI, nil
+	}
+
+	res, err := r.promoEngine.Promote(
+		ctx,
+		promoCtx,
+		promotion.NewStepsFromPromotionSteps(stage.Spec.Verification.Steps),
+	)
+	newVI.CurrentStep = res.CurrentStep
+	newVI.StepExecutionMetadata = res.StepExecutionMetadata
+	newVI.State = &apiextensionsv1.JSON{Raw: res.State.ToJSON()}
+	newVI.Phase = verificationPhaseForPromotionPhase(res.Status)
+	newVI.Message = res.Message
+	if err != nil {
+		newVI.Phase = kargoapi.VerificationPhaseError
+		newVI.Message = err.Error()
+	}
+	logger.Debug("executed verification steps", "phase", newVI.Phase)
+
+	if newVI.Phase.IsTerminal() {
+		newVI.FinishTime = ptr.To(metav1.Now())
+		r.cleanupVerificationWorkDir(ctx, vi.ID)
+	}
+	return newVI, nil
+}
+
+// verificationPhaseForPromotionPhase maps the phase of an execution of
+// promotion steps to the corresponding VerificationPhase.
+func verificationPhaseForPromotionPhase(phase kargoapi.PromotionPhase) kargoapi.VerificationPhase {
+	switch phase {
+	case kargoapi.PromotionPhaseSucceeded:
+		return kargoapi.VerificationPhaseSuccessful
+	case kargoapi.PromotionPhaseFailed:
+		return kargoapi.VerificationPhaseFailed
+	case kargoapi.PromotionPhaseErrored:
+		return kargoapi.VerificationPhaseError
+	case kargoapi.PromotionPhaseAborted:
+		return kargoapi.VerificationPhaseAborted
+	case kargoapi.PromotionPhaseRunning:
+		return kargoapi.VerificationPhaseRunning
+	default:
+		return kargoapi.VerificationPhasePending
+	}
+}
+
+// verificationWorkDir returns the path of the working directory used for
+// executing the steps of the verification with the provided ID.
+func verificationWorkDir(id string) string {
+	return filepath.Join(os.TempDir(), "verification-"+id)
+}
+
+// cleanupVerificationWorkDir removes the working directory used for executing
+// the steps of the verification with the provided ID, if it exists.
+func (r *RegularStageReconciler) cleanupVerificationWorkDir(ctx context.Context, id string) {
+	if err := os.RemoveAll(verificationWorkDir(id)); err != nil {
+		logging.LoggerFromContext(ctx).Error(
+			err, "could not remove verification working directory",
+			"verification", id,
+		)
+	}
+}
+
 // findExistingAnalysisRun finds the most recent AnalysisRun for a Stage and
 // Freight collection in the namespace of the Stage. If no AnalysisRun is found,
 // it returns nil.
@@ -1714,8 +1882,10 @@ func (r *RegularStageReconciler) autoPromoteFreight(
 	}
 
 	// Confirm that auto-promotion is allowed for the Stage.
-	if autoPromotionAllowed, err := r.autoPromotionAllowed(ctx, stage.ObjectMeta); err != nil || !autoPromotionAllowed {
+	projectCfg, autoPromotionAllowed, err := r.autoPromotionAllowed(ctx, stage.ObjectMeta)
+	if err != nil || !autoPromotionAllowed {
 		newStatus.AutoPromotionEnabled = false
+		conditions.Delete(&newStatus, kargoapi.ConditionTypeAutoPromotionConditionSatisfied)
 		return newStatus, err
 	}
 	newStatus.AutoPromotionEnabled = true
@@ -1729,8 +1899,26 @@ func (r *RegularStageReconciler) autoPromoteFreight(
 	// If the Stage has no current Freight, then we can promote any available
 	currentFreight := newStatus.FreightHistory.Current()
 
+	// Retrieve the promotion wave policy governing the Stage, if any. The
+	// Stages in the Project are only listed once there is Freight that has to
+	// be checked against the policy.
+	wavePolicy, err := api.GetPromotionWavePolicyForStage(projectCfg, stage)
+	if err != nil {
+		return newStatus, err
+	}
+	var waveStages []kargoapi.Stage
+
+	// Keep track of Freight held back by auto-promotion conditions so that it
+	// can be reported in the Stage's status.
+	var hasAutoPromotionConditions bool
+	var heldBack, conditionErrs []string
+
 	// Check if there is any new Freight which can be auto-promoted.
 	for _, req := range stage.Spec.RequestedFreight {
+		if req.Sources.AutoPromotionOptions != nil && req.Sources.AutoPromotionOptions.Condition != "" {
+			hasAutoPromotionConditions = true
+		}
+
 		origin := req.Origin.String()
 		freight, exists := promotableFreight[origin]
 		if !exists || len(freight) == 0 {
@@ -1755,7 +1943,27 @@ func (r *RegularStageReconciler) autoPromoteFreight(
 		slices.SortFunc(freight, func(lhs, rhs kargoapi.Freight) int {
 			return rhs.CreationTimestamp.Compare(lhs.CreationTimestamp.Time)
 		})
-		latestFreight := freight[0]
+
+		// Select the newest Freight that satisfies the auto-promotion condition,
+		// if any.
+		latestFreight, skipped, err := r.selectAutoPromotionCandidate(ctx, stage, req, freight, currentFreight)
+		if err != nil {
+			// Retrying will not make an invalid condition valid, so we report the
+			// error in the Stage's status instead of returning it.
+			logger.Error(err, "error evaluating auto-promotion condition", "origin", origin)
+			conditionErrs = append(conditionErrs, fmt.Sprintf("origin %s: %s", origin, err.Error()))
+			continue
+		}
+		if len(skipped) > 0 {
+			heldBack = append(heldBack, describeHeldBackFreight(origin, skipped))
+		}
+		if latestFreight == nil {
+			logger.Debug(
+				"no Freight from origin satisfies auto-promotion condition",
+				"origin", origin,
+			)
+			continue
+		}
 
 		freightLogger := logger.WithValues("origin", origin, "freight", latestFreight.Name)
 
@@ -1768,6 +1976,30 @@ func (r *RegularStageReconciler) autoPromoteFreight(
 			}
 		}
 
+		// Hold the Freight back if it has not made its way through the
+		// preceding waves of the Stage's promotion wave policy yet. Progress
+		// through the waves (e.g. soak time elapsing) is picked up by the
+		// periodic reconciliation of the Stage.
+		if wavePolicy != nil {
+			if waveStages == nil {
+				if waveStages, err = r.listStages(ctx, stage.Namespace); err != nil {
+					return newStatus, err
+				}
+			}
+			hold, err := api.GetPromotionWaveHold(wavePolicy, waveStages, stage.Name, latestFreight)
+			if err != nil {
+				return newStatus, err
+			}
+			if hold != "" {
+				freightLogger.Debug(
+					"Freight held back by promotion wave policy",
+					"policy", wavePolicy.Name,
+					"reason", hold,
+				)
+				continue
+			}
+		}
+
 		// How we proceed depends on the auto-promotion Freight selection policy...
 		if req.Sources.AutoPromotionOptions != nil &&
 			req.Sources.AutoPromotionOptions.SelectionPolicy == kargoapi.AutoPromotionSelectionPolicyMatchUpstream {
@@ -1919,25 +2151,208 @@ func (r *RegularStageReconciler) autoPromoteFreight(
 				promotion.Spec.Stage),
 			api.FormatEventControllerActor(r.cfg.Name()),
 			promotion,
-			&latestFreight,
+			latestFreight,
 		)
 		if err := r.eventSender.Send(ctx, evt); err != nil {
 			logger.Error(err, "failed to send promotion event")
 		}
+		r.auditor.Record(ctx, audit.Entry{
+			Action:  audit.ActionPromote,
+			Actor:   audit.ControllerActor(r.cfg.Name()),
+			Project: promotion.Namespace,
+			Object: audit.ObjectReference{
+				Kind: "Promotion",
+				Name: promotion.Name,
+			},
+			Details: map[string]string{
+				"stage":   promotion.Spec.Stage,
+				"freight": promotion.Spec.Freight,
+				"origin":  origin,
+			},
+		})
 		logger.Debug(
 			"created Promotion resource",
 			"promotion", promotion.Name,
 		)
 	}
 
+	setAutoPromotionConditionSatisfied(stage, &newStatus, hasAutoPromotionConditions, heldBack, conditionErrs)
+
 	return newStatus, nil
 }
 
+// selectAutoPromotionCandidate selects the Freight to auto-promote from the
+// provided Freight, which must be sorted by creation time in descending order.
+// If the provided FreightRequest does not specify an auto-promotion condition,
+// the newest Freight is selected. Otherwise, the newest Freight that satisfies
+// the condition is selected. Freight is only considered until the Stage's
+// current Freight from the same origin is reached, as auto-promoting older
+// Freight would amount to a rollback. The names of any Freight that was
+// skipped because it did not satisfy the condition are also returned. If no
+// Freight satisfies the condition, a nil Freight is returned.
+func (r *RegularStageReconciler) selectAutoPromotionCandidate(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	req kargoapi.FreightRequest,
+	freight []kargoapi.Freight,
+	currentFreight *kargoapi.FreightCollection,
+) (*kargoapi.Freight, []string, error) {
+	opts := req.Sources.AutoPromotionOptions
+	if opts == nil || strings.TrimSpace(opts.Condition) == "" {
+		return &freight[0], nil, nil
+	}
+
+	var currentRefs []kargoapi.FreightReference
+	if currentFreight != nil {
+		currentRefs = currentFreight.References()
+	}
+	env := map[string]any{
+		"ctx": map[string]any{
+			"project": stage.Namespace,
+			"stage":   stage.Name,
+		},
+		"current": exprfn.FreightFunctions(
+			ctx,
+			r.client,
+			stage.Namespace,
+			stage.Spec.RequestedFreight,
+			currentRefs,
+		),
+	}
+
+	var skipped []string
+	for i := range freight {
+		candidate := &freight[i]
+		if currentFreight != nil {
+			if ref, ok := currentFreight.Freight[req.Origin.String()]; ok && ref.Name == candidate.Name {
+				break
+			}
+		}
+		env["freight"] = map[string]any{
+			"name":  candidate.Name,
+			"alias": candidate.Alias,
+		}
+		// The Freight functions resolve artifacts from the Freight they were
+		// created for, so the program has to be compiled for every candidate.
+		program, err := expr.Compile(
+			opts.Condition,
+			slices.Concat(
+				exprfn.FreightOperations(
+					ctx,
+					r.client,
+					stage.Namespace,
+					stage.Spec.RequestedFreight,
+					[]kargoapi.FreightReference{{
+						Name:    candidate.Name,
+						Origin:  candidate.Origin,
+						Commits: candidate.Commits,
+						Images:  candidate.Images,
+						Charts:  candidate.Charts,
+					}},
+				),
+				exprfn.UtilityOperations(),
+			)...,
+		)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error compiling auto-promotion condition: %w", err)
+		}
+		satisfied, err := runAutoPromotionCondition(program, env)
+		if err != nil {
+			return nil, nil, fmt.Errorf(
+				"error evaluating auto-promotion condition for Freight %q: %w",
+				candidate.Name, err,
+			)
+		}
+		if satisfied {
+			return candidate, skipped, nil
+		}
+		skipped = append(skipped, candidate.Name)
+	}
+	return nil, skipped, nil
+}
+
+// runAutoPromotionCondition runs the provided compiled auto-promotion
+// condition against the provided environment and interprets the result as a
+// boolean.
+func runAutoPromotionCondition(program *vm.Program, env map[string]any) (bool, error) {
+	result, err := expr.Run(program, env)
+	if err != nil {
+		return false, err
+	}
+	switch result := result.(type) {
+	case bool:
+		return result, nil
+	default:
+		parsedBool, err := strconv.ParseBool(fmt.Sprintf("%v", result))
+		if err != nil {
+			return false, fmt.Errorf(
+				"failed to parse auto-promotion condition result %q as bool: %w", result, err,
+			)
+		}
+		return parsedBool, nil
+	}
+}
+
+// describeHeldBackFreight returns a human-readable description of Freight from
+// the given origin that was held back by an auto-promotion condition.
+func describeHeldBackFreight(origin string, freight []string) string {
+	return fmt.Sprintf("%s (from origin %s)", strings.Join(freight, ", "), origin)
+}
+
+// setAutoPromotionConditionSatisfied updates the
+// AutoPromotionConditionSatisfied condition of the provided StageStatus to
+// reflect the outcome of evaluating the Stage's auto-promotion conditions. If
+// the Stage has no auto-promotion conditions, the condition is removed.
+func setAutoPromotionConditionSatisfied(
+	stage *kargoapi.Stage,
+	status *kargoapi.StageStatus,
+	hasConditions bool,
+	heldBack []string,
+	errs []string,
+) {
+	switch {
+	case !hasConditions:
+		conditions.Delete(status, kargoapi.ConditionTypeAutoPromotionConditionSatisfied)
+	case len(errs) > 0:
+		conditions.Set(status, &metav1.Condition{
+			Type:   kargoapi.ConditionTypeAutoPromotionConditionSatisfied,
+			Status: metav1.ConditionFalse,
+			Reason: "ConditionEvaluationFailed",
+			Message: fmt.Sprintf(
+				"Evaluation of auto-promotion condition failed: %s",
+				strings.Join(errs, "; "),
+			),
+			ObservedGeneration: stage.GetGeneration(),
+		})
+	case len(heldBack) > 0:
+		conditions.Set(status, &metav1.Condition{
+			Type:   kargoapi.ConditionTypeAutoPromotionConditionSatisfied,
+			Status: metav1.ConditionFalse,
+			Reason: "ConditionNotSatisfied",
+			Message: fmt.Sprintf(
+				"Freight was held back by auto-promotion condition: %s",
+				strings.Join(heldBack, "; "),
+			),
+			ObservedGeneration: stage.GetGeneration(),
+		})
+	default:
+		conditions.Set(status, &metav1.Condition{
+			Type:               kargoapi.ConditionTypeAutoPromotionConditionSatisfied,
+			Status:             metav1.ConditionTrue,
+			Reason:             "ConditionSatisfied",
+			Message:            "No Freight was held back by auto-promotion condition",
+			ObservedGeneration: stage.GetGeneration(),
+		})
+	}
+}
+
 // autoPromotionAllowed checks if auto-promotion is allowed for the given Stage.
+// It also returns the ProjectConfig it was determined from, if one exists, so
+// that callers do not need to retrieve it again.
 func (r *RegularStageReconciler) autoPromotionAllowed(
 	ctx context.Context,
 	stage metav1.ObjectMeta,
-) (bool, error) {
+) (*kargoapi.ProjectConfig, bool, error) {
 	logger := logging.LoggerFromContext(ctx)
 
 	projectCfg := &kargoapi.ProjectConfig{}
@@ -1947,14 +2362,14 @@ func (r *RegularStageReconciler) autoPromotionAllowed(
 	}, projectCfg); err != nil {
 		if apierrors.IsNotFound(err) {
 			logger.Debug("found no ProjectConfig associated with Project; auto-promotion is disabled")
-			return false, nil
+			return nil, false, nil
 		}
-		return false, fmt.Errorf("error getting ProjectConfig for Project %q: %w", stage.Namespace, err)
+		return nil, false, fmt.Errorf("error getting ProjectConfig for Project %q: %w", stage.Namespace, err)
 	}
 
 	if len(projectCfg.Spec.PromotionPolicies) == 0 {
 		logger.Debug("found no PromotionPolicy associated with Stage")
-		return false, nil
+		return projectCfg, false, nil
 	}
 
 	for _, policy := range projectCfg.Spec.PromotionPolicies {
@@ -1966,26 +2381,12 @@ func (r *RegularStageReconciler) autoPromotionAllowed(
 			}
 		}
 
-		// Match the Stage name with the exact PromotionPolicy name
-		if nameSelector := policy.StageSelector.Name; nameSelector != "" {
-			m, err := pattern.ParseNamePattern(nameSelector)
-			if err != nil {
-				return false, fmt.Errorf("error parsing PromotionPolicy name pattern %q: %w", nameSelector, err)
-			}
-			if !m.Matches(stage.Name) {
-				continue
-			}
+		matches, err := api.StageMatchesPromotionPolicySelector(policy.StageSelector, stage)
+		if err != nil {
+			return nil, false, fmt.Errorf("error matching Stage with PromotionPolicy selector: %w", err)
 		}
-
-		// Match the Stage labels with the PromotionPolicy label selector.
-		if labelSelector := policy.StageSelector.LabelSelector; labelSelector != nil {
-			s, err := metav1.LabelSelectorAsSelector(labelSelector)
-			if err != nil {
-				return false, fmt.Errorf("error parsing PromotionPolicy label selector %q: %w", labelSelector, err)
-			}
-			if !s.Matches(labels.Set(stage.Labels)) {
-				continue
-			}
+		if !matches {
+			continue
 		}
 
 		// If we reach this point, we have found a matching PromotionPolicy.
@@ -1993,15 +2394,31 @@ func (r *RegularStageReconciler) autoPromotionAllowed(
 			"found PromotionPolicy associated with Stage",
 			"autoPromotionEnabled", policy.AutoPromotionEnabled,
 		)
-		return policy.AutoPromotionEnabled, nil
+		return projectCfg, policy.AutoPromotionEnabled, nil
 	}
 
 	logger.Debug("found no PromotionPolicy associated with Stage")
-	return false, nil
+	return projectCfg, false, nil
+}
+
+// listStages returns all Stages in the given namespace.
+func (r *RegularStageReconciler) listStages(
+	ctx context.Context,
+	namespace string,
+) ([]kargoapi.Stage, error) {
+	stages := &kargoapi.StageList{}
+	if err := r.client.List(ctx, stages, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf(
+			"error listing Stages in namespace %q: %w",
+			namespace, err,
+		)
+	}
+	return stages.Items, nil
 }
 
 // getPromotableFreight retrieves a map of []Freight promotable to the specified
-// Stage, indexed by origin.
+// Stage, indexed by origin. Freight that is available to the Stage, but stale,
+// is not promotable.
 func (r *RegularStageReconciler) getPromotableFreight(
 	ctx context.Context,
 	stage *kargoapi.Stage,
@@ -2014,8 +2431,19 @@ func (r *RegularStageReconciler) getPromotableFreight(
 		)
 	}
 
+	staleFreight, err := api.GetStaleFreight(ctx, r.client, stage, availableFreight)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error determining stale Freight for Stage %q: %w",
+			stage.Name, err,
+		)
+	}
+
 	promotableFreight := make(map[string][]kargoapi.Freight)
 	for _, freight := range availableFreight {
+		if _, stale := staleFreight[freight.Name]; stale {
+			continue
+		}
 		originID := freight.Origin.String()
 		if _, ok := promotableFreight[originID]; !ok {
 			promotableFreight[originID] = []kargoapi.Freight{freight}
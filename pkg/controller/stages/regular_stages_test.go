@@ -4,12 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -28,6 +31,7 @@ import (
 	"github.com/akuity/kargo/pkg/health"
 	"github.com/akuity/kargo/pkg/indexer"
 	fakeevent "github.com/akuity/kargo/pkg/kubernetes/event/fake"
+	"github.com/akuity/kargo/pkg/promotion"
 )
 
 func TestRegularStageReconciler_Reconcile(t *testing.T) {
@@ -3621,8 +3625,62 @@ func TestRegularStageReconciler_startVerification(t *testing.T) {
 		req              *kargoapi.VerificationRequest
 		objects          []client.Object
 		rolloutsDisabled bool
+		promoEngine      promotion.Engine
 		assertions       func(*testing.T, client.Client, *kargoapi.VerificationInfo, error)
 	}{
+		{
+			name: "executes verification steps",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "test-stage",
+				},
+				Spec: kargoapi.StageSpec{
+					Verification: &kargoapi.Verification{
+						Steps: []kargoapi.PromotionStep{{Uses: "fake-step"}},
+					},
+				},
+			},
+			freightCol: kargoapi.FreightCollection{
+				ID: "test-collection",
+				Freight: map[string]kargoapi.FreightReference{
+					"warehouse": {Name: "test-freight"},
+				},
+			},
+			// Verification steps do not depend on the Rollouts integration
+			rolloutsDisabled: true,
+			promoEngine: &promotion.MockEngine{
+				PromoteFn: func(
+					_ context.Context,
+					promoCtx promotion.Context,
+					steps []promotion.Step,
+				) (promotion.Result, error) {
+					if promoCtx.Stage != "test-stage" || len(steps) != 1 || steps[0].Kind != "fake-step" {
+						return promotion.Result{}, fmt.Errorf("unexpected steps or context")
+					}
+					return promotion.Result{
+						Status:      kargoapi.PromotionPhaseRunning,
+						CurrentStep: 0,
+						StepExecutionMetadata: kargoapi.StepExecutionMetadataList{{
+							Alias:  "step-1",
+							Status: kargoapi.PromotionStepStatusRunning,
+						}},
+						State: promotion.State{"foo": "bar"},
+					}, nil
+				},
+			},
+			assertions: func(t *testing.T, _ client.Client, vi *kargoapi.VerificationInfo, err error) {
+				require.NoError(t, err)
+
+				require.NotNil(t, vi)
+				assert.NotEmpty(t, vi.ID)
+				assert.Equal(t, kargoapi.VerificationPhaseRunning, vi.Phase)
+				assert.True(t, vi.HasSteps())
+				assert.False(t, vi.HasAnalysisRun())
+				assert.Equal(t, map[string]any{"foo": "bar"}, vi.GetState())
+				assert.Nil(t, vi.FinishTime)
+			},
+		},
 		{
 			name: "rollouts integration disabled",
 			stage: &kargoapi.Stage{
@@ -4019,6 +4077,7 @@ func TestRegularStageReconciler_startVerification(t *testing.T) {
 					RolloutsIntegrationEnabled:   !tt.rolloutsDisabled,
 					RolloutsControllerInstanceID: "test-instance",
 				},
+				promoEngine: tt.promoEngine,
 			}
 
 			vi, err := r.startVerification(context.Background(), tt.stage, tt.freightCol, tt.req, now)
@@ -4408,6 +4467,42 @@ func TestRegularStageReconciler_abortVerification(t *testing.T) {
 				assert.Nil(t, vi)
 			},
 		},
+		{
+			name: "aborts verification steps",
+			freightCol: kargoapi.FreightCollection{
+				ID: "test-collection",
+				Freight: map[string]kargoapi.FreightReference{
+					"warehouse": {Name: "test-freight"},
+				},
+				VerificationHistory: []kargoapi.VerificationInfo{
+					{
+						ID:        "test-verification",
+						Actor:     "fake-actor",
+						Phase:     kargoapi.VerificationPhaseRunning,
+						StartTime: &metav1.Time{Time: now},
+						StepExecutionMetadata: kargoapi.StepExecutionMetadataList{{
+							Alias:  "step-1",
+							Status: kargoapi.PromotionStepStatusRunning,
+						}},
+					},
+				},
+			},
+			req: &kargoapi.VerificationRequest{
+				ID:    "test-verification",
+				Actor: "abort-actor",
+			},
+			assertions: func(t *testing.T, _ client.Client, vi *kargoapi.VerificationInfo, err error) {
+				require.NoError(t, err)
+
+				require.NotNil(t, vi)
+				assert.Equal(t, "test-verification", vi.ID)
+				assert.Equal(t, "abort-actor", vi.Actor)
+				assert.Equal(t, kargoapi.VerificationPhaseAborted, vi.Phase)
+				assert.Equal(t, "Verification aborted by user", vi.Message)
+				assert.NotNil(t, vi.FinishTime)
+				assert.True(t, vi.HasSteps())
+			},
+		},
 		{
 			name: "returns current verification if already terminal",
 			freightCol: kargoapi.FreightCollection{
@@ -4702,6 +4797,209 @@ func TestRegularStageReconciler_abortVerification(t *testing.T) {
 	}
 }
 
+func TestRegularStageReconciler_runVerificationSteps(t *testing.T) {
+	now := time.Now()
+	succeedingID := uuid.NewString()
+
+	stageWithSteps := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-project",
+			Name:      "test-stage",
+		},
+		Spec: kargoapi.StageSpec{
+			Agent: "fake-agent",
+			Verification: &kargoapi.Verification{
+				Steps: []kargoapi.PromotionStep{
+					{Uses: "fake-step"},
+					{Uses: "other-fake-step"},
+				},
+			},
+		},
+		Status: kargoapi.StageStatus{
+			LastPromotion: &kargoapi.PromotionReference{
+				Name:    "test-promotion",
+				Freight: &kargoapi.FreightReference{Name: "test-freight"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		stage       *kargoapi.Stage
+		vi          *kargoapi.VerificationInfo
+		promoEngine promotion.Engine
+		assertions  func(*testing.T, *kargoapi.VerificationInfo, error)
+	}{
+		{
+			name:  "promotion engine not configured",
+			stage: stageWithSteps,
+			vi:    &kargoapi.VerificationInfo{ID: uuid.NewString()},
+			assertions: func(t *testing.T, vi *kargoapi.VerificationInfo, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.VerificationPhaseError, vi.Phase)
+				assert.Contains(t, vi.Message, "Promotion engine is not configured")
+				assert.NotNil(t, vi.FinishTime)
+			},
+		},
+		{
+			name: "stage no longer specifies verification steps",
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					Verification: &kargoapi.Verification{},
+				},
+			},
+			vi:          &kargoapi.VerificationInfo{ID: uuid.NewString()},
+			promoEngine: &promotion.MockEngine{},
+			assertions: func(t *testing.T, vi *kargoapi.VerificationInfo, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.VerificationPhaseError, vi.Phase)
+				assert.Contains(t, vi.Message, "no longer specifies verification steps")
+			},
+		},
+		{
+			name:  "steps succeed",
+			stage: stageWithSteps,
+			vi: &kargoapi.VerificationInfo{
+				ID:        succeedingID,
+				Actor:     "fake-actor",
+				StartTime: &metav1.Time{Time: now},
+			},
+			promoEngine: &promotion.MockEngine{
+				PromoteFn: func(_ context.Context, promoCtx promotion.Context, _ []promotion.Step) (promotion.Result, error) {
+					// Verification steps run under their own identity rather
+					// than that of the last Promotion.
+					if promoCtx.Promotion != "" ||
+						promoCtx.Verification != succeedingID ||
+						promoCtx.TargetFreightRef.Name != "test-freight" ||
+						promoCtx.Actor != "fake-actor" ||
+						promoCtx.Agent != "fake-agent" {
+						return promotion.Result{}, fmt.Errorf("unexpected context")
+					}
+					return promotion.Result{
+						Status:      kargoapi.PromotionPhaseSucceeded,
+						CurrentStep: 1,
+						StepExecutionMetadata: kargoapi.StepExecutionMetadataList{
+							{Alias: "step-1", Status: kargoapi.PromotionStepStatusSucceeded},
+							{Alias: "step-2", Status: kargoapi.PromotionStepStatusSucceeded},
+						},
+					}, nil
+				},
+			},
+			assertions: func(t *testing.T, vi *kargoapi.VerificationInfo, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.VerificationPhaseSuccessful, vi.Phase)
+				assert.Equal(t, "fake-actor", vi.Actor)
+				assert.Equal(t, now, vi.StartTime.Time)
+				assert.NotNil(t, vi.FinishTime)
+				assert.Equal(t, int64(1), vi.CurrentStep)
+				assert.Len(t, vi.StepExecutionMetadata, 2)
+				assert.NoDirExists(t, verificationWorkDir(vi.ID))
+			},
+		},
+		{
+			name:  "steps fail",
+			stage: stageWithSteps,
+			vi:    &kargoapi.VerificationInfo{ID: uuid.NewString()},
+			promoEngine: &promotion.MockEngine{
+				PromoteFn: func(context.Context, promotion.Context, []promotion.Step) (promotion.Result, error) {
+					return promotion.Result{
+						Status:  kargoapi.PromotionPhaseFailed,
+						Message: "step-1 failed",
+						StepExecutionMetadata: kargoapi.StepExecutionMetadataList{
+							{Alias: "step-1", Status: kargoapi.PromotionStepStatusFailed},
+						},
+					}, nil
+				},
+			},
+			assertions: func(t *testing.T, vi *kargoapi.VerificationInfo, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.VerificationPhaseFailed, vi.Phase)
+				assert.Equal(t, "step-1 failed", vi.Message)
+				assert.NotNil(t, vi.FinishTime)
+			},
+		},
+		{
+			name:  "steps error",
+			stage: stageWithSteps,
+			vi:    &kargoapi.VerificationInfo{ID: uuid.NewString()},
+			promoEngine: &promotion.MockEngine{
+				PromoteFn: func(context.Context, promotion.Context, []promotion.Step) (promotion.Result, error) {
+					return promotion.Result{
+						Status: kargoapi.PromotionPhaseErrored,
+						StepExecutionMetadata: kargoapi.StepExecutionMetadataList{
+							{Alias: "step-1", Status: kargoapi.PromotionStepStatusErrored},
+						},
+					}, fmt.Errorf("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, vi *kargoapi.VerificationInfo, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.VerificationPhaseError, vi.Phase)
+				assert.Equal(t, "something went wrong", vi.Message)
+				assert.NotNil(t, vi.FinishTime)
+			},
+		},
+		{
+			name:  "resumes running steps",
+			stage: stageWithSteps,
+			vi: func() *kargoapi.VerificationInfo {
+				id := uuid.NewString()
+				// Simulate an existing working directory from a previous execution
+				require.NoError(t, os.Mkdir(verificationWorkDir(id), 0o700))
+				return &kargoapi.VerificationInfo{
+					ID:          id,
+					Phase:       kargoapi.VerificationPhaseRunning,
+					CurrentStep: 1,
+					StepExecutionMetadata: kargoapi.StepExecutionMetadataList{
+						{Alias: "step-1", Status: kargoapi.PromotionStepStatusSucceeded},
+						{Alias: "step-2", Status: kargoapi.PromotionStepStatusRunning},
+					},
+					State: &apiextensionsv1.JSON{Raw: []byte(`{"foo":"bar"}`)},
+				}
+			}(),
+			promoEngine: &promotion.MockEngine{
+				PromoteFn: func(_ context.Context, promoCtx promotion.Context, _ []promotion.Step) (promotion.Result, error) {
+					if promoCtx.StartFromStep != 1 || promoCtx.State["foo"] != "bar" {
+						return promotion.Result{}, fmt.Errorf("unexpected context")
+					}
+					return promotion.Result{
+						Status:                kargoapi.PromotionPhaseRunning,
+						CurrentStep:           promoCtx.StartFromStep,
+						StepExecutionMetadata: promoCtx.StepExecutionMetadata,
+						State:                 promoCtx.State,
+					}, nil
+				},
+			},
+			assertions: func(t *testing.T, vi *kargoapi.VerificationInfo, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.VerificationPhaseRunning, vi.Phase)
+				assert.Equal(t, int64(1), vi.CurrentStep)
+				assert.Nil(t, vi.FinishTime)
+				assert.DirExists(t, verificationWorkDir(vi.ID))
+				require.NoError(t, os.RemoveAll(verificationWorkDir(vi.ID)))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &RegularStageReconciler{promoEngine: tt.promoEngine}
+			vi, err := r.runVerificationSteps(
+				context.Background(),
+				tt.stage,
+				kargoapi.FreightCollection{
+					ID: "test-collection",
+					Freight: map[string]kargoapi.FreightReference{
+						"warehouse": {Name: "test-freight"},
+					},
+				},
+				tt.vi,
+			)
+			tt.assertions(t, vi, err)
+		})
+	}
+}
+
 func TestRegularStageReconciler_findExistingAnalysisRun(t *testing.T) {
 	scheme := runtime.NewScheme()
 	require.NoError(t, kargoapi.AddToScheme(scheme))
@@ -4971,6 +5269,160 @@ func TestRegularStageReconciler_autoPromoteFreight(t *testing.T) {
 	now := time.Now()
 	hourAgo := now.Add(-time.Hour)
 
+	// conditionalStage returns a Stage that auto-promotes Freight from a
+	// Warehouse only if it satisfies the given condition and that currently has
+	// Freight with version 1.0.0 of the example/app image.
+	conditionalStage := func(condition string) *kargoapi.Stage {
+		origin := kargoapi.FreightOrigin{
+			Kind: kargoapi.FreightOriginKindWarehouse,
+			Name: "test-warehouse",
+		}
+		return &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:  "fake-project",
+				Name:       "test-stage",
+				Generation: 2,
+			},
+			Spec: kargoapi.StageSpec{
+				RequestedFreight: []kargoapi.FreightRequest{{
+					Origin: origin,
+					Sources: kargoapi.FreightSources{
+						Direct: true,
+						AutoPromotionOptions: &kargoapi.AutoPromotionOptions{
+							Condition: condition,
+						},
+					},
+				}},
+				PromotionTemplate: &kargoapi.PromotionTemplate{
+					Spec: kargoapi.PromotionTemplateSpec{
+						Steps: []kargoapi.PromotionStep{{Uses: "fake-step"}},
+					},
+				},
+			},
+			Status: kargoapi.StageStatus{
+				FreightHistory: kargoapi.FreightHistory{{
+					Freight: map[string]kargoapi.FreightReference{
+						origin.String(): {
+							Name:   "current-freight",
+							Origin: origin,
+							Images: []kargoapi.Image{{RepoURL: "example/app", Tag: "1.0.0"}},
+						},
+					},
+				}},
+			},
+		}
+	}
+	// conditionalObjects returns the objects required by a Stage returned from
+	// conditionalStage, plus a Freight for each of the given tags of the
+	// example/app image. Freight is created from newest to oldest.
+	conditionalObjects := func(tags ...string) []client.Object {
+		objects := []client.Object{
+			&kargoapi.ProjectConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "fake-project",
+					Namespace: "fake-project",
+				},
+				Spec: kargoapi.ProjectConfigSpec{
+					PromotionPolicies: []kargoapi.PromotionPolicy{{
+						Stage:                "test-stage",
+						AutoPromotionEnabled: true,
+					}},
+				},
+			},
+			&kargoapi.Warehouse{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "test-warehouse",
+				},
+				Spec: kargoapi.WarehouseSpec{
+					Subscriptions: []kargoapi.RepoSubscription{{
+						Image: &kargoapi.ImageSubscription{RepoURL: "example/app"},
+					}},
+				},
+			},
+		}
+		for i, tag := range tags {
+			objects = append(objects, &kargoapi.Freight{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:         "fake-project",
+					Name:              "freight-" + tag,
+					CreationTimestamp: metav1.Time{Time: now.Add(-time.Duration(i) * time.Minute)},
+				},
+				Origin: kargoapi.FreightOrigin{
+					Kind: kargoapi.FreightOriginKindWarehouse,
+					Name: "test-warehouse",
+				},
+				Images: []kargoapi.Image{{RepoURL: "example/app", Tag: tag}},
+			})
+		}
+		return objects
+	}
+	const patchOnlyCondition = `semverDiff(current.imageFrom("example/app")?.Tag ?? "", ` +
+		`imageFrom("example/app").Tag) == "Patch"`
+	// waveObjects returns the objects required by a Stage returned from
+	// conditionalStage that is in the second wave of a promotion wave policy,
+	// plus version 1.0.1 of the example/app image, optionally verified in the
+	// Stage of the first wave.
+	waveObjects := func(verifiedInCanary bool) []client.Object {
+		objects := conditionalObjects("1.0.1")
+		projectCfg := objects[0].(*kargoapi.ProjectConfig) // nolint: forcetypeassert
+		projectCfg.Spec.PromotionWaves = []kargoapi.PromotionWavePolicy{{
+			Name:          "test-policy",
+			StageSelector: kargoapi.PromotionPolicySelector{Name: "glob:*"},
+			Waves: []kargoapi.PromotionWave{
+				{
+					Name:          "canary",
+					StageSelector: &kargoapi.PromotionPolicySelector{Name: "canary-stage"},
+				},
+				{Name: "rest"},
+			},
+		}}
+		freight := objects[len(objects)-1].(*kargoapi.Freight) // nolint: forcetypeassert
+		if verifiedInCanary {
+			freight.Status.VerifiedIn = map[string]kargoapi.VerifiedStage{
+				"canary-stage": {VerifiedAt: &metav1.Time{Time: hourAgo}},
+			}
+		}
+		return append(
+			objects,
+			conditionalStage(""),
+			&kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "canary-stage",
+				},
+			},
+		)
+	}
+	// failStageLists is an interceptor that fails all attempts to list Stages
+	// and counts the retrievals of ProjectConfigs in projectCfgGets.
+	var projectCfgGets int
+	failStageLists := interceptor.Funcs{
+		Get: func(
+			ctx context.Context,
+			c client.WithWatch,
+			key client.ObjectKey,
+			obj client.Object,
+			opts ...client.GetOption,
+		) error {
+			if _, ok := obj.(*kargoapi.ProjectConfig); ok {
+				projectCfgGets++
+			}
+			return c.Get(ctx, key, obj, opts...)
+		},
+		List: func(
+			ctx context.Context,
+			c client.WithWatch,
+			list client.ObjectList,
+			opts ...client.ListOption,
+		) error {
+			if _, ok := list.(*kargoapi.StageList); ok {
+				return fmt.Errorf("something went wrong")
+			}
+			return c.List(ctx, list, opts...)
+		},
+	}
+
 	tests := []struct {
 		name        string
 		stage       *kargoapi.Stage
@@ -6004,6 +6456,189 @@ func TestRegularStageReconciler_autoPromoteFreight(t *testing.T) {
 				assert.Len(t, promoList.Items, 1)
 			},
 		},
+		{
+			name:    "promotes freight that satisfies auto-promotion condition",
+			stage:   conditionalStage(patchOnlyCondition),
+			objects: conditionalObjects("1.0.1"),
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				c client.Client,
+				status kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+
+				promoList := &kargoapi.PromotionList{}
+				require.NoError(t, c.List(context.Background(), promoList, client.InNamespace("fake-project")))
+				require.Len(t, promoList.Items, 1)
+				assert.Equal(t, "freight-1.0.1", promoList.Items[0].Spec.Freight)
+
+				cond := conditions.Get(&status, kargoapi.ConditionTypeAutoPromotionConditionSatisfied)
+				require.NotNil(t, cond)
+				assert.Equal(t, metav1.ConditionTrue, cond.Status)
+				assert.Equal(t, "ConditionSatisfied", cond.Reason)
+				assert.Equal(t, int64(2), cond.ObservedGeneration)
+			},
+		},
+		{
+			name:    "holds back freight that does not satisfy auto-promotion condition",
+			stage:   conditionalStage(patchOnlyCondition),
+			objects: conditionalObjects("1.1.0"),
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				c client.Client,
+				status kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+
+				promoList := &kargoapi.PromotionList{}
+				require.NoError(t, c.List(context.Background(), promoList, client.InNamespace("fake-project")))
+				assert.Empty(t, promoList.Items)
+
+				cond := conditions.Get(&status, kargoapi.ConditionTypeAutoPromotionConditionSatisfied)
+				require.NotNil(t, cond)
+				assert.Equal(t, metav1.ConditionFalse, cond.Status)
+				assert.Equal(t, "ConditionNotSatisfied", cond.Reason)
+				assert.Contains(t, cond.Message, "freight-1.1.0")
+			},
+		},
+		{
+			name:    "promotes newest freight that satisfies auto-promotion condition",
+			stage:   conditionalStage(patchOnlyCondition),
+			objects: conditionalObjects("1.1.0", "1.0.2", "1.0.1"),
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				c client.Client,
+				status kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+
+				promoList := &kargoapi.PromotionList{}
+				require.NoError(t, c.List(context.Background(), promoList, client.InNamespace("fake-project")))
+				require.Len(t, promoList.Items, 1)
+				assert.Equal(t, "freight-1.0.2", promoList.Items[0].Spec.Freight)
+
+				cond := conditions.Get(&status, kargoapi.ConditionTypeAutoPromotionConditionSatisfied)
+				require.NotNil(t, cond)
+				assert.Equal(t, metav1.ConditionFalse, cond.Status)
+				assert.Equal(t, "ConditionNotSatisfied", cond.Reason)
+				assert.Contains(t, cond.Message, "freight-1.1.0")
+				assert.NotContains(t, cond.Message, "freight-1.0.1")
+			},
+		},
+		{
+			name:    "holds back freight that has not completed preceding waves",
+			stage:   conditionalStage(""),
+			objects: waveObjects(false),
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				c client.Client,
+				_ kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+
+				promoList := &kargoapi.PromotionList{}
+				require.NoError(t, c.List(context.Background(), promoList, client.InNamespace("fake-project")))
+				assert.Empty(t, promoList.Items)
+			},
+		},
+		{
+			name:    "promotes freight that has completed preceding waves",
+			stage:   conditionalStage(""),
+			objects: waveObjects(true),
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				c client.Client,
+				_ kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+
+				promoList := &kargoapi.PromotionList{}
+				require.NoError(t, c.List(context.Background(), promoList, client.InNamespace("fake-project")))
+				require.Len(t, promoList.Items, 1)
+				assert.Equal(t, "freight-1.0.1", promoList.Items[0].Spec.Freight)
+			},
+		},
+		{
+			name:  "does not list Stages for wave policy not selecting the Stage",
+			stage: conditionalStage(""),
+			objects: func() []client.Object {
+				objects := waveObjects(false)
+				projectCfg := objects[0].(*kargoapi.ProjectConfig) // nolint: forcetypeassert
+				projectCfg.Spec.PromotionWaves[0].StageSelector.Name = "canary-stage"
+				return objects
+			}(),
+			interceptor: failStageLists,
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				c client.Client,
+				_ kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+				// The ProjectConfig retrieved to determine whether auto-promotion
+				// is allowed is reused to find the promotion wave policy.
+				assert.Equal(t, 1, projectCfgGets)
+
+				promoList := &kargoapi.PromotionList{}
+				require.NoError(t, c.List(context.Background(), promoList, client.InNamespace("fake-project")))
+				require.Len(t, promoList.Items, 1)
+				assert.Equal(t, "freight-1.0.1", promoList.Items[0].Spec.Freight)
+			},
+		},
+		{
+			name:        "does not list Stages when no Freight is subject to wave policy",
+			stage:       conditionalStage("false"),
+			objects:     waveObjects(false),
+			interceptor: failStageLists,
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				c client.Client,
+				_ kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+
+				promoList := &kargoapi.PromotionList{}
+				require.NoError(t, c.List(context.Background(), promoList, client.InNamespace("fake-project")))
+				assert.Empty(t, promoList.Items)
+			},
+		},
+		{
+			name:    "reports auto-promotion condition evaluation error",
+			stage:   conditionalStage(`freight.name ==`),
+			objects: conditionalObjects("1.0.1"),
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				c client.Client,
+				status kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+
+				promoList := &kargoapi.PromotionList{}
+				require.NoError(t, c.List(context.Background(), promoList, client.InNamespace("fake-project")))
+				assert.Empty(t, promoList.Items)
+
+				cond := conditions.Get(&status, kargoapi.ConditionTypeAutoPromotionConditionSatisfied)
+				require.NotNil(t, cond)
+				assert.Equal(t, metav1.ConditionFalse, cond.Status)
+				assert.Equal(t, "ConditionEvaluationFailed", cond.Reason)
+				assert.Contains(t, cond.Message, "error compiling auto-promotion condition")
+			},
+		},
 		{
 			name: "handles promotion build error",
 			stage: &kargoapi.Stage{
@@ -6474,7 +7109,7 @@ func TestRegularStageReconciler_autoPromotionAllowed(t *testing.T) {
 				client: c,
 			}
 
-			allowed, err := r.autoPromotionAllowed(context.Background(), tt.stage)
+			_, allowed, err := r.autoPromotionAllowed(context.Background(), tt.stage)
 			tt.assertions(t, allowed, err)
 		})
 	}
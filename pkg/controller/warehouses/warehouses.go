@@ -22,6 +22,7 @@ import (
 	"github.com/akuity/kargo/pkg/controller"
 	"github.com/akuity/kargo/pkg/credentials"
 	"github.com/akuity/kargo/pkg/expressions/function"
+	"github.com/akuity/kargo/pkg/image"
 	"github.com/akuity/kargo/pkg/kargo"
 	"github.com/akuity/kargo/pkg/kubeclient"
 	"github.com/akuity/kargo/pkg/logging"
@@ -33,6 +34,9 @@ type ReconcilerConfig struct {
 	ShardName                 string        `envconfig:"SHARD_NAME"`
 	MaxConcurrentReconciles   int           `envconfig:"MAX_CONCURRENT_WAREHOUSE_RECONCILES" default:"4"`
 	MinReconciliationInterval time.Duration `envconfig:"MIN_WAREHOUSE_RECONCILIATION_INTERVAL"`
+	// DiscoveryCacheMaxEntries is the maximum number of entries held by the
+	// cache of image discovery results shared by all Warehouses.
+	DiscoveryCacheMaxEntries int `envconfig:"DISCOVERY_CACHE_MAX_ENTRIES" default:"10000"`
 }
 
 func ReconcilerConfigFromEnv() ReconcilerConfig {
@@ -91,9 +95,12 @@ func SetupReconcilerWithManager(
 		return fmt.Errorf("error building Warehouse reconciler: %w", err)
 	}
 
+	image.SetDiscoveryCacheMaxEntries(cfg.DiscoveryCacheMaxEntries)
+
 	logging.LoggerFromContext(ctx).Info(
 		"Initialized Warehouse reconciler",
 		"maxConcurrentReconciles", cfg.MaxConcurrentReconciles,
+		"discoveryCacheMaxEntries", cfg.DiscoveryCacheMaxEntries,
 	)
 
 	return nil
@@ -243,8 +250,16 @@ func (r *reconciler) syncWarehouse(
 			logger.Error(err, "error updating Warehouse status")
 		}
 
+		// A requested refresh (e.g. one triggered by a webhook announcing that
+		// a new image was pushed) should not be satisfied by revalidating
+		// cached information about the Warehouse's subscriptions.
+		discoveryCtx := ctx
+		if warehouse.Status.LastHandledRefresh != status.LastHandledRefresh {
+			discoveryCtx = image.ContextWithoutDiscoveryCache(ctx)
+		}
+
 		// Discover the latest artifacts.
-		discoveredArtifacts, err := r.discoverArtifactsFn(ctx, warehouse)
+		discoveredArtifacts, err := r.discoverArtifactsFn(discoveryCtx, warehouse)
 		if err != nil {
 			// Mark the Warehouse as unhealthy and not ready if we failed to
 			// discover artifacts.
@@ -506,6 +521,22 @@ func (r *reconciler) syncWarehouse(
 	return status, nil
 }
 
+// DiscoverArtifacts discovers the artifacts available for each of the provided
+// Warehouse's subscriptions using the same logic the Warehouse reconciler
+// uses. This permits components other than the reconciler (e.g. the API
+// server) to resolve artifacts exactly as the Warehouse itself would.
+func DiscoverArtifacts(
+	ctx context.Context,
+	credentialsDB credentials.Database,
+	warehouse *kargoapi.Warehouse,
+) (*kargoapi.DiscoveredArtifacts, error) {
+	r := &reconciler{credentialsDB: credentialsDB}
+	r.discoverCommitsFn = r.discoverCommits
+	r.discoverImagesFn = r.discoverImages
+	r.discoverChartsFn = r.discoverCharts
+	return r.discoverArtifacts(ctx, warehouse)
+}
+
 func (r *reconciler) discoverArtifacts(
 	ctx context.Context,
 	warehouse *kargoapi.Warehouse,
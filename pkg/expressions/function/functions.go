@@ -48,6 +48,33 @@ func FreightOperations(
 	}
 }
 
+// FreightFunctions returns a map containing `commitFrom()`, `imageFrom()`, and
+// `chartFrom()` functions that resolve artifacts from the provided Freight
+// references. Unlike the functions provided by FreightOperations, these are not
+// registered globally. Instead, the map is intended to be placed in an
+// expression's environment so that the functions can be invoked on a named
+// object (e.g. `current.imageFrom(repoURL)`) alongside the global functions of
+// the same names, which resolve artifacts from different Freight.
+func FreightFunctions(
+	ctx context.Context,
+	c client.Client,
+	project string,
+	freightRequests []kargoapi.FreightRequest,
+	freightRefs []kargoapi.FreightReference,
+) map[string]any {
+	return map[string]any{
+		"commitFrom": (func(...any) (any, error))(
+			getCommitFromFreight(ctx, c, project, freightRequests, freightRefs),
+		),
+		"imageFrom": (func(...any) (any, error))(
+			getImageFromFreight(ctx, c, project, freightRequests, freightRefs),
+		),
+		"chartFrom": (func(...any) (any, error))(
+			getChartFromFreight(ctx, c, project, freightRequests, freightRefs),
+		),
+	}
+}
+
 // DiscoveredArtifactsOperations returns a slice of expr.Option containing
 // functions for retrieving artifacts from a Warehouse's discovered artifacts.
 //
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/expr-lang/expr"
 	"github.com/patrickmn/go-cache"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -234,6 +235,64 @@ func Test_getCommitFromFreight(t *testing.T) {
 	}
 }
 
+func TestFreightFunctions(t *testing.T) {
+	const testProject = "fake-project"
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&kargoapi.Warehouse{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "fake-warehouse",
+				Namespace: testProject,
+			},
+			Spec: kargoapi.WarehouseSpec{
+				Subscriptions: []kargoapi.RepoSubscription{{
+					Image: &kargoapi.ImageSubscription{RepoURL: "example/app"},
+				}},
+			},
+		},
+	).Build()
+	freightReqs := []kargoapi.FreightRequest{{
+		Origin: kargoapi.FreightOrigin{Kind: kargoapi.FreightOriginKindWarehouse, Name: "fake-warehouse"},
+	}}
+	origin := kargoapi.FreightOrigin{Kind: kargoapi.FreightOriginKindWarehouse, Name: "fake-warehouse"}
+
+	env := map[string]any{
+		"current": FreightFunctions(
+			context.Background(), c, testProject, freightReqs,
+			[]kargoapi.FreightReference{{
+				Origin: origin,
+				Images: []kargoapi.Image{{RepoURL: "example/app", Tag: "1.0.0"}},
+			}},
+		),
+		"other": FreightFunctions(context.Background(), c, testProject, freightReqs, nil),
+	}
+	opts := ImageFromFreight(
+		context.Background(), c, testProject, freightReqs,
+		[]kargoapi.FreightReference{{
+			Origin: origin,
+			Images: []kargoapi.Image{{RepoURL: "example/app", Tag: "1.0.1"}},
+		}},
+	)
+
+	for expression, expected := range map[string]any{
+		`current.imageFrom("example/app").Tag`:                                           "1.0.0",
+		`imageFrom("example/app").Tag`:                                                   "1.0.1",
+		`other.imageFrom("example/app")?.Tag ?? "none"`:                                  "none",
+		`semverDiff(current.imageFrom("example/app").Tag, imageFrom("example/app").Tag)`: "Patch",
+	} {
+		t.Run(expression, func(t *testing.T) {
+			program, err := expr.Compile(expression, opts, SemverDiff())
+			require.NoError(t, err)
+			result, err := expr.Run(program, env)
+			require.NoError(t, err)
+			assert.Equal(t, expected, result)
+		})
+	}
+}
+
 func Test_getCommitFromDiscoveredArtifacts(t *testing.T) {
 	for _, tc := range []struct {
 		name       string
@@ -0,0 +1,11 @@
+package flux
+
+import "os"
+
+func Namespace() string {
+	value := os.Getenv("FLUX_NAMESPACE")
+	if value == "" {
+		return "flux-system"
+	}
+	return value
+}
@@ -0,0 +1,94 @@
+package flux
+
+import (
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// ReconcileRequestAnnotation is the annotation used to request that a Flux
+	// controller reconcile a resource outside of its regular interval. Flux
+	// controllers record the value of this annotation in a resource's
+	// status.lastHandledReconcileAt field once they have handled the request.
+	ReconcileRequestAnnotation = "reconcile.fluxcd.io/requestedAt"
+
+	KindGitRepository  = "GitRepository"
+	KindHelmRelease    = "HelmRelease"
+	KindHelmRepository = "HelmRepository"
+	KindKustomization  = "Kustomization"
+	KindOCIRepository  = "OCIRepository"
+
+	conditionTypeReady       = "Ready"
+	conditionTypeReconciling = "Reconciling"
+	conditionTypeStalled     = "Stalled"
+)
+
+// gvks maps each supported kind of Flux resource to the GroupVersionKind used
+// to interact with it.
+var gvks = map[string]schema.GroupVersionKind{
+	KindGitRepository: {
+		Group:   "source.toolkit.fluxcd.io",
+		Version: "v1",
+		Kind:    KindGitRepository,
+	},
+	KindHelmRelease: {
+		Group:   "helm.toolkit.fluxcd.io",
+		Version: "v2",
+		Kind:    KindHelmRelease,
+	},
+	KindHelmRepository: {
+		Group:   "source.toolkit.fluxcd.io",
+		Version: "v1",
+		Kind:    KindHelmRepository,
+	},
+	KindKustomization: {
+		Group:   "kustomize.toolkit.fluxcd.io",
+		Version: "v1",
+		Kind:    KindKustomization,
+	},
+	KindOCIRepository: {
+		Group:   "source.toolkit.fluxcd.io",
+		Version: "v1beta2",
+		Kind:    KindOCIRepository,
+	},
+}
+
+// SupportedKinds returns the kinds of Flux resources Kargo is able to interact
+// with, in alphabetical order.
+func SupportedKinds() []string {
+	kinds := make([]string, 0, len(gvks))
+	for kind := range gvks {
+		kinds = append(kinds, kind)
+	}
+	slices.Sort(kinds)
+	return kinds
+}
+
+// GroupVersionKindFor returns the GroupVersionKind used to interact with Flux
+// resources of the specified kind. It returns an error if the kind is not
+// supported.
+func GroupVersionKindFor(kind string) (schema.GroupVersionKind, error) {
+	gvk, ok := gvks[kind]
+	if !ok {
+		return schema.GroupVersionKind{}, fmt.Errorf(
+			"unsupported Flux resource kind %q; supported kinds are %v",
+			kind, SupportedKinds(),
+		)
+	}
+	return gvk, nil
+}
+
+// NewObject returns an empty unstructured.Unstructured of the specified kind
+// of Flux resource, suitable for use with a Kubernetes client.
+func NewObject(kind string) (*unstructured.Unstructured, error) {
+	gvk, err := GroupVersionKindFor(kind)
+	if err != nil {
+		return nil, err
+	}
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	return obj, nil
+}
@@ -0,0 +1,17 @@
+package flux
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewObject(t *testing.T) {
+	obj, err := NewObject(KindKustomization)
+	require.NoError(t, err)
+	require.Equal(t, "kustomize.toolkit.fluxcd.io/v1", obj.GetAPIVersion())
+	require.Equal(t, KindKustomization, obj.GetKind())
+
+	_, err = NewObject("Bogus")
+	require.ErrorContains(t, err, `unsupported Flux resource kind "Bogus"`)
+}
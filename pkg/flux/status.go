@@ -0,0 +1,104 @@
+package flux
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Status summarizes the state of a Flux resource as reported by the Flux
+// controller responsible for it.
+type Status struct {
+	// Observed is true if the Flux controller has observed the latest generation
+	// of the resource.
+	Observed bool
+	// Ready is the status of the resource's Ready condition. It is empty if the
+	// resource has no such condition.
+	Ready metav1.ConditionStatus
+	// Reconciling is true if the Flux controller is actively reconciling the
+	// resource.
+	Reconciling bool
+	// Stalled is true if the Flux controller has stopped reconciling the
+	// resource because of an error that cannot be resolved without
+	// intervention.
+	Stalled bool
+	// Suspended is true if reconciliation of the resource has been suspended.
+	Suspended bool
+	// Reason is the reason given by the resource's Stalled condition, if
+	// Stalled, or Ready condition otherwise.
+	Reason string
+	// Message is the message given by the resource's Stalled condition, if
+	// Stalled, or Ready condition otherwise.
+	Message string
+	// Revision is the revision of the source or chart the resource was most
+	// recently reconciled to, if known.
+	Revision string
+	// LastHandledReconcileAt is the value of the ReconcileRequestAnnotation most
+	// recently handled by the Flux controller.
+	LastHandledReconcileAt string
+}
+
+// GetStatus returns a summary of the status of the provided Flux resource.
+func GetStatus(obj *unstructured.Unstructured) Status {
+	status := Status{}
+
+	observedGeneration, _, _ := unstructured.NestedInt64(obj.Object, "status", "observedGeneration")
+	status.Observed = observedGeneration >= obj.GetGeneration()
+
+	status.Suspended, _, _ = unstructured.NestedBool(obj.Object, "spec", "suspend")
+
+	status.LastHandledReconcileAt, _, _ = unstructured.NestedString(
+		obj.Object, "status", "lastHandledReconcileAt",
+	)
+
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]any)
+		if !ok {
+			continue
+		}
+		conditionType, _ := condition["type"].(string)
+		conditionStatus, _ := condition["status"].(string)
+		reason, _ := condition["reason"].(string)
+		message, _ := condition["message"].(string)
+		switch conditionType {
+		case conditionTypeReady:
+			status.Ready = metav1.ConditionStatus(conditionStatus)
+			if !status.Stalled {
+				status.Reason, status.Message = reason, message
+			}
+		case conditionTypeReconciling:
+			status.Reconciling = conditionStatus == "True"
+		case conditionTypeStalled:
+			if conditionStatus == "True" {
+				status.Stalled = true
+				status.Reason, status.Message = reason, message
+			}
+		}
+	}
+
+	status.Revision = getRevision(obj)
+	return status
+}
+
+// getRevision returns the revision of the source or chart the provided Flux
+// resource was most recently reconciled to, if known.
+func getRevision(obj *unstructured.Unstructured) string {
+	// Sources
+	if rev, ok, _ := unstructured.NestedString(obj.Object, "status", "artifact", "revision"); ok {
+		return rev
+	}
+	// Kustomizations
+	if rev, ok, _ := unstructured.NestedString(obj.Object, "status", "lastAppliedRevision"); ok {
+		return rev
+	}
+	// HelmReleases
+	history, _, _ := unstructured.NestedSlice(obj.Object, "status", "history")
+	if len(history) > 0 {
+		if snapshot, ok := history[0].(map[string]any); ok {
+			if rev, ok := snapshot["chartVersion"].(string); ok {
+				return rev
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,129 @@
+package flux
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestGetStatus(t *testing.T) {
+	testCases := []struct {
+		name     string
+		obj      map[string]any
+		expected Status
+	}{
+		{
+			name: "no status",
+			obj: map[string]any{
+				"metadata": map[string]any{"generation": int64(1)},
+			},
+			expected: Status{},
+		},
+		{
+			name: "ready source",
+			obj: map[string]any{
+				"metadata": map[string]any{"generation": int64(2)},
+				"status": map[string]any{
+					"observedGeneration":     int64(2),
+					"lastHandledReconcileAt": "fake-token",
+					"artifact": map[string]any{
+						"revision": "main@sha1:abc123",
+					},
+					"conditions": []any{
+						map[string]any{
+							"type":    "Ready",
+							"status":  "True",
+							"reason":  "Succeeded",
+							"message": "stored artifact",
+						},
+					},
+				},
+			},
+			expected: Status{
+				Observed:               true,
+				Ready:                  metav1.ConditionTrue,
+				Reason:                 "Succeeded",
+				Message:                "stored artifact",
+				Revision:               "main@sha1:abc123",
+				LastHandledReconcileAt: "fake-token",
+			},
+		},
+		{
+			name: "reconciling Kustomization with outdated generation",
+			obj: map[string]any{
+				"metadata": map[string]any{"generation": int64(3)},
+				"status": map[string]any{
+					"observedGeneration":  int64(2),
+					"lastAppliedRevision": "main@sha1:abc123",
+					"conditions": []any{
+						map[string]any{
+							"type":   "Reconciling",
+							"status": "True",
+						},
+						map[string]any{
+							"type":    "Ready",
+							"status":  "Unknown",
+							"reason":  "Progressing",
+							"message": "reconciliation in progress",
+						},
+					},
+				},
+			},
+			expected: Status{
+				Ready:       metav1.ConditionUnknown,
+				Reconciling: true,
+				Reason:      "Progressing",
+				Message:     "reconciliation in progress",
+				Revision:    "main@sha1:abc123",
+			},
+		},
+		{
+			name: "stalled and suspended HelmRelease",
+			obj: map[string]any{
+				"metadata": map[string]any{"generation": int64(1)},
+				"spec":     map[string]any{"suspend": true},
+				"status": map[string]any{
+					"observedGeneration": int64(1),
+					"history": []any{
+						map[string]any{"chartVersion": "1.2.3"},
+						map[string]any{"chartVersion": "1.2.2"},
+					},
+					"conditions": []any{
+						map[string]any{
+							"type":    "Stalled",
+							"status":  "True",
+							"reason":  "RetriesExceeded",
+							"message": "install retries exhausted",
+						},
+						map[string]any{
+							"type":    "Ready",
+							"status":  "False",
+							"reason":  "InstallFailed",
+							"message": "install failed",
+						},
+					},
+				},
+			},
+			expected: Status{
+				Observed:  true,
+				Ready:     metav1.ConditionFalse,
+				Stalled:   true,
+				Suspended: true,
+				Reason:    "RetriesExceeded",
+				Message:   "install retries exhausted",
+				Revision:  "1.2.3",
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				GetStatus(&unstructured.Unstructured{Object: testCase.obj}),
+			)
+		})
+	}
+}
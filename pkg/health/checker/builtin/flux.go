@@ -0,0 +1,224 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	libflux "github.com/akuity/kargo/pkg/flux"
+	"github.com/akuity/kargo/pkg/health"
+)
+
+const resourceStatusesKey = "resourceStatuses"
+
+// FluxHealthInput is the input for a health check associated with the
+// flux-reconcile step.
+type FluxHealthInput struct {
+	// Resources is a list of health checks to perform on specific Flux
+	// resources.
+	Resources []FluxResourceHealthCheck `json:"resources"`
+}
+
+// FluxResourceHealthCheck is the configuration for a health check on a single
+// Flux resource.
+type FluxResourceHealthCheck struct {
+	// Kind is the kind of the Flux resource to check.
+	Kind string `json:"kind"`
+	// Name is the name of the Flux resource to check.
+	Name string `json:"name"`
+	// Namespace is the namespace of the Flux resource to check. If empty, the
+	// default Flux namespace is used.
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// FluxResourceStatus describes the current state of a single Flux resource.
+type FluxResourceStatus struct {
+	// Kind is the kind of the Flux resource.
+	Kind string `json:"kind"`
+	// Namespace is the namespace of the Flux resource.
+	Namespace string `json:"namespace"`
+	// Name is the name of the Flux resource.
+	Name string `json:"name"`
+	// Ready is the status of the Flux resource's Ready condition.
+	Ready metav1.ConditionStatus `json:"ready,omitempty"`
+	// Reason is the reason given by the Flux resource's Ready or Stalled
+	// condition.
+	Reason string `json:"reason,omitempty"`
+	// Message is the message given by the Flux resource's Ready or Stalled
+	// condition.
+	Message string `json:"message,omitempty"`
+	// Revision is the revision of the source or chart the Flux resource was
+	// most recently reconciled to.
+	Revision string `json:"revision,omitempty"`
+}
+
+type fluxChecker struct {
+	fluxClient client.Client
+}
+
+// newFluxChecker returns an implementation of the Checker interface that
+// monitors the readiness of Flux resources.
+func newFluxChecker(fluxClient client.Client) *fluxChecker {
+	return &fluxChecker{
+		fluxClient: fluxClient,
+	}
+}
+
+// Name implements the Checker interface.
+func (f *fluxChecker) Name() string {
+	return "flux"
+}
+
+// Check implements the Checker interface.
+func (f *fluxChecker) Check(
+	ctx context.Context,
+	_ string,
+	_ string,
+	criteria health.Criteria,
+) health.Result {
+	cfg, err := health.InputToStruct[FluxHealthInput](criteria.Input)
+	if err != nil {
+		return health.Result{
+			Status: kargoapi.HealthStateUnknown,
+			Issues: []string{
+				fmt.Sprintf(
+					"could not convert opaque input into %s health check input: %s",
+					f.Name(), err.Error(),
+				),
+			},
+		}
+	}
+	return f.check(ctx, cfg)
+}
+
+func (f *fluxChecker) check(
+	ctx context.Context,
+	input FluxHealthInput,
+) health.Result {
+	if f.fluxClient == nil {
+		return health.Result{
+			Status: kargoapi.HealthStateUnknown,
+			Issues: []string{
+				"Flux integration is disabled on this controller; cannot assess " +
+					"the health of Flux resources",
+			},
+		}
+	}
+	res := health.Result{
+		Status: kargoapi.HealthStateHealthy,
+		Issues: make([]string, 0),
+	}
+	resourceStatuses := make([]FluxResourceStatus, len(input.Resources))
+	for i, healthCheck := range input.Resources {
+		namespace := healthCheck.Namespace
+		if namespace == "" {
+			namespace = libflux.Namespace()
+		}
+		var state kargoapi.HealthState
+		var err error
+		state, resourceStatuses[i], err = f.getResourceHealth(
+			ctx,
+			healthCheck.Kind,
+			client.ObjectKey{
+				Namespace: namespace,
+				Name:      healthCheck.Name,
+			},
+		)
+		res.Status = res.Status.Merge(state)
+		if err != nil {
+			res.Issues = append(res.Issues, err.Error())
+		}
+	}
+	res.Output = map[string]any{
+		resourceStatusesKey: resourceStatuses,
+	}
+	return res
+}
+
+// getResourceHealth assesses the health of a Flux resource by looking at its
+// conditions. It returns an overall health state and a summary of the
+// resource's status. All results apart from Healthy will also include an error
+// explaining why.
+func (f *fluxChecker) getResourceHealth(
+	ctx context.Context,
+	kind string,
+	key client.ObjectKey,
+) (kargoapi.HealthState, FluxResourceStatus, error) {
+	resourceStatus := FluxResourceStatus{
+		Kind:      kind,
+		Namespace: key.Namespace,
+		Name:      key.Name,
+	}
+	obj, err := libflux.NewObject(kind)
+	if err != nil {
+		return kargoapi.HealthStateUnknown, resourceStatus, err
+	}
+	if err = f.fluxClient.Get(ctx, key, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			err = fmt.Errorf(
+				"unable to find Flux %s %q in namespace %q",
+				kind, key.Name, key.Namespace,
+			)
+		} else {
+			err = fmt.Errorf(
+				"error finding Flux %s %q in namespace %q: %w",
+				kind, key.Name, key.Namespace, err,
+			)
+		}
+		return kargoapi.HealthStateUnknown, resourceStatus, err
+	}
+
+	status := libflux.GetStatus(obj)
+	resourceStatus.Ready = status.Ready
+	resourceStatus.Reason = status.Reason
+	resourceStatus.Message = status.Message
+	resourceStatus.Revision = status.Revision
+
+	switch {
+	case status.Stalled:
+		// nolint:staticcheck
+		return kargoapi.HealthStateUnhealthy, resourceStatus, fmt.Errorf(
+			"Flux %s %q in namespace %q is stalled: %s",
+			kind, key.Name, key.Namespace, status.Message,
+		)
+	case status.Suspended:
+		// nolint:staticcheck
+		return kargoapi.HealthStateUnknown, resourceStatus, fmt.Errorf(
+			"Flux %s %q in namespace %q is suspended",
+			kind, key.Name, key.Namespace,
+		)
+	case !status.Observed || status.Reconciling:
+		// nolint:staticcheck
+		return kargoapi.HealthStateProgressing, resourceStatus, fmt.Errorf(
+			"Flux %s %q in namespace %q is being reconciled",
+			kind, key.Name, key.Namespace,
+		)
+	}
+
+	switch status.Ready {
+	case metav1.ConditionTrue:
+		return kargoapi.HealthStateHealthy, resourceStatus, nil
+	case metav1.ConditionFalse:
+		// nolint:staticcheck
+		return kargoapi.HealthStateUnhealthy, resourceStatus, fmt.Errorf(
+			"Flux %s %q in namespace %q is not ready: %s",
+			kind, key.Name, key.Namespace, status.Message,
+		)
+	case metav1.ConditionUnknown:
+		// nolint:staticcheck
+		return kargoapi.HealthStateProgressing, resourceStatus, fmt.Errorf(
+			"Flux %s %q in namespace %q is progressing",
+			kind, key.Name, key.Namespace,
+		)
+	default:
+		// nolint:staticcheck
+		return kargoapi.HealthStateUnknown, resourceStatus, fmt.Errorf(
+			"Flux %s %q in namespace %q has no Ready condition",
+			kind, key.Name, key.Namespace,
+		)
+	}
+}
@@ -0,0 +1,242 @@
+package builtin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	libflux "github.com/akuity/kargo/pkg/flux"
+	"github.com/akuity/kargo/pkg/health"
+)
+
+func newFakeFluxObject(
+	t *testing.T,
+	kind string,
+	name string,
+	spec map[string]any,
+	conditions ...map[string]any,
+) *unstructured.Unstructured {
+	t.Helper()
+	obj, err := libflux.NewObject(kind)
+	require.NoError(t, err)
+	obj.SetNamespace(libflux.Namespace())
+	obj.SetName(name)
+	obj.SetGeneration(1)
+	if spec != nil {
+		obj.Object["spec"] = spec
+	}
+	conds := make([]any, len(conditions))
+	for i, c := range conditions {
+		conds[i] = c
+	}
+	obj.Object["status"] = map[string]any{
+		"observedGeneration":  int64(1),
+		"lastAppliedRevision": "main@sha1:abc123",
+		"conditions":          conds,
+	}
+	return obj
+}
+
+func Test_fluxChecker_check(t *testing.T) {
+	readyCondition := map[string]any{
+		"type":    "Ready",
+		"status":  "True",
+		"reason":  "ReconciliationSucceeded",
+		"message": "Applied revision: main@sha1:abc123",
+	}
+
+	testCases := []struct {
+		name       string
+		client     client.Client
+		input      FluxHealthInput
+		assertions func(*testing.T, health.Result)
+	}{
+		{
+			name: "Flux integration disabled",
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnknown, res.Status)
+				require.Len(t, res.Issues, 1)
+				require.Contains(t, res.Issues[0], "Flux integration is disabled")
+			},
+		},
+		{
+			name:   "unsupported kind",
+			client: fake.NewClientBuilder().WithScheme(runtime.NewScheme()).Build(),
+			input: FluxHealthInput{
+				Resources: []FluxResourceHealthCheck{{Kind: "Bogus", Name: "fake"}},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnknown, res.Status)
+				require.Len(t, res.Issues, 1)
+				require.Contains(t, res.Issues[0], "unsupported Flux resource kind")
+			},
+		},
+		{
+			name:   "resource not found",
+			client: fake.NewClientBuilder().WithScheme(runtime.NewScheme()).Build(),
+			input: FluxHealthInput{
+				Resources: []FluxResourceHealthCheck{{
+					Kind: libflux.KindKustomization,
+					Name: "fake",
+				}},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnknown, res.Status)
+				require.Len(t, res.Issues, 1)
+				require.Contains(t, res.Issues[0], "unable to find Flux Kustomization")
+			},
+		},
+		{
+			name: "all resources ready",
+			client: fake.NewClientBuilder().
+				WithScheme(runtime.NewScheme()).
+				WithObjects(
+					newFakeFluxObject(t, libflux.KindKustomization, "fake-ks", nil, readyCondition),
+					newFakeFluxObject(t, libflux.KindHelmRelease, "fake-hr", nil, readyCondition),
+				).
+				Build(),
+			input: FluxHealthInput{
+				Resources: []FluxResourceHealthCheck{
+					{Kind: libflux.KindKustomization, Name: "fake-ks"},
+					{Kind: libflux.KindHelmRelease, Name: "fake-hr"},
+				},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateHealthy, res.Status)
+				require.Empty(t, res.Issues)
+				statuses, ok := res.Output[resourceStatusesKey].([]FluxResourceStatus)
+				require.True(t, ok)
+				require.Len(t, statuses, 2)
+				require.Equal(
+					t,
+					FluxResourceStatus{
+						Kind:      libflux.KindKustomization,
+						Namespace: libflux.Namespace(),
+						Name:      "fake-ks",
+						Ready:     metav1.ConditionTrue,
+						Reason:    "ReconciliationSucceeded",
+						Message:   "Applied revision: main@sha1:abc123",
+						Revision:  "main@sha1:abc123",
+					},
+					statuses[0],
+				)
+			},
+		},
+		{
+			name: "one resource reconciling",
+			client: fake.NewClientBuilder().
+				WithScheme(runtime.NewScheme()).
+				WithObjects(
+					newFakeFluxObject(t, libflux.KindKustomization, "fake-ks", nil, readyCondition),
+					newFakeFluxObject(
+						t, libflux.KindHelmRelease, "fake-hr", nil,
+						map[string]any{"type": "Reconciling", "status": "True"},
+						map[string]any{"type": "Ready", "status": "Unknown"},
+					),
+				).
+				Build(),
+			input: FluxHealthInput{
+				Resources: []FluxResourceHealthCheck{
+					{Kind: libflux.KindKustomization, Name: "fake-ks"},
+					{Kind: libflux.KindHelmRelease, Name: "fake-hr"},
+				},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateProgressing, res.Status)
+				require.Len(t, res.Issues, 1)
+				require.Contains(t, res.Issues[0], "is being reconciled")
+			},
+		},
+		{
+			name: "resource not ready and another stalled",
+			client: fake.NewClientBuilder().
+				WithScheme(runtime.NewScheme()).
+				WithObjects(
+					newFakeFluxObject(
+						t, libflux.KindKustomization, "fake-ks", nil,
+						map[string]any{
+							"type":    "Ready",
+							"status":  "False",
+							"message": "kustomize build failed",
+						},
+					),
+					newFakeFluxObject(
+						t, libflux.KindHelmRelease, "fake-hr", nil,
+						map[string]any{
+							"type":    "Stalled",
+							"status":  "True",
+							"message": "install retries exhausted",
+						},
+					),
+				).
+				Build(),
+			input: FluxHealthInput{
+				Resources: []FluxResourceHealthCheck{
+					{Kind: libflux.KindKustomization, Name: "fake-ks"},
+					{Kind: libflux.KindHelmRelease, Name: "fake-hr"},
+				},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnhealthy, res.Status)
+				require.Len(t, res.Issues, 2)
+				require.Contains(t, res.Issues[0], "is not ready: kustomize build failed")
+				require.Contains(t, res.Issues[1], "is stalled: install retries exhausted")
+			},
+		},
+		{
+			name: "resource suspended",
+			client: fake.NewClientBuilder().
+				WithScheme(runtime.NewScheme()).
+				WithObjects(
+					newFakeFluxObject(
+						t, libflux.KindGitRepository, "fake-repo",
+						map[string]any{"suspend": true},
+						readyCondition,
+					),
+				).
+				Build(),
+			input: FluxHealthInput{
+				Resources: []FluxResourceHealthCheck{
+					{Kind: libflux.KindGitRepository, Name: "fake-repo"},
+				},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnknown, res.Status)
+				require.Len(t, res.Issues, 1)
+				require.Contains(t, res.Issues[0], "is suspended")
+			},
+		},
+		{
+			name: "resource without Ready condition",
+			client: fake.NewClientBuilder().
+				WithScheme(runtime.NewScheme()).
+				WithObjects(
+					newFakeFluxObject(t, libflux.KindOCIRepository, "fake-repo", nil),
+				).
+				Build(),
+			input: FluxHealthInput{
+				Resources: []FluxResourceHealthCheck{
+					{Kind: libflux.KindOCIRepository, Name: "fake-repo"},
+				},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnknown, res.Status)
+				require.Len(t, res.Issues, 1)
+				require.Contains(t, res.Issues[0], "has no Ready condition")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			checker := newFluxChecker(testCase.client)
+			testCase.assertions(t, checker.check(context.Background(), testCase.input))
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package image
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// DefaultDiscoveryCacheMaxEntries is the default maximum number of entries
+// held by the discovery cache shared by all repository clients.
+const DefaultDiscoveryCacheMaxEntries = 10000
+
+// discoveryCacheTTL is how long a tag list is held by the discovery cache
+// before it expires, regardless of how recently it was used. Other entries
+// describe immutable references, so they never expire and are only evicted
+// when the cache is full.
+const discoveryCacheTTL = 30 * time.Minute
+
+const (
+	cacheKindTags  = "tags"
+	cacheKindImage = "image"
+
+	cacheResultHit         = "hit"
+	cacheResultMiss        = "miss"
+	cacheResultRevalidated = "revalidated"
+	cacheResultBypass      = "bypass"
+)
+
+var (
+	// sharedDiscoveryCache is the discovery cache shared by all repository
+	// clients, and therefore by all Warehouses.
+	sharedDiscoveryCache = newDiscoveryCache(DefaultDiscoveryCacheMaxEntries)
+
+	discoveryCacheRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kargo_image_discovery_cache_requests_total",
+			Help: "Number of image discovery cache lookups, by kind of entry and result.",
+		},
+		[]string{"kind", "result"},
+	)
+	discoveryCacheEntries = prometheus.NewGaugeFunc(
+		prometheus.GaugeOpts{
+			Name: "kargo_image_discovery_cache_entries",
+			Help: "Number of entries currently held by the image discovery cache.",
+		},
+		func() float64 { return float64(sharedDiscoveryCache.len()) },
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(discoveryCacheRequests, discoveryCacheEntries)
+}
+
+// SetDiscoveryCacheMaxEntries sets the maximum number of entries held by the
+// discovery cache shared by all repository clients. Least recently used
+// entries are evicted when the cache is full. A value less than or equal to
+// zero restores the default.
+func SetDiscoveryCacheMaxEntries(maxEntries int) {
+	if maxEntries <= 0 {
+		maxEntries = DefaultDiscoveryCacheMaxEntries
+	}
+	sharedDiscoveryCache.setMaxEntries(maxEntries)
+}
+
+type bypassDiscoveryCacheKey struct{}
+
+// ContextWithoutDiscoveryCache returns a copy of the provided context that
+// indicates that information about mutable references (e.g. tag lists) should
+// be retrieved from the registry unconditionally instead of being revalidated
+// against the discovery cache. Information about immutable references (e.g.
+// manifests addressed by digest) is always served from the cache when
+// possible.
+func ContextWithoutDiscoveryCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bypassDiscoveryCacheKey{}, true)
+}
+
+// discoveryCacheBypassed returns true if the provided context was returned by
+// ContextWithoutDiscoveryCache.
+func discoveryCacheBypassed(ctx context.Context) bool {
+	bypass, _ := ctx.Value(bypassDiscoveryCacheKey{}).(bool)
+	return bypass
+}
+
+// discoveryCache is a size-bounded, least-recently-used cache whose tag list
+// entries expire after a fixed TTL. It is safe for concurrent use.
+type discoveryCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	ttl        time.Duration
+	ll         *list.List
+	entries    map[string]*list.Element
+	nowFn      func() time.Time
+}
+
+type discoveryCacheEntry struct {
+	key   string
+	value any
+	// expiresAt is when the entry expires. The zero value means the entry
+	// never expires.
+	expiresAt time.Time
+}
+
+func newDiscoveryCache(maxEntries int) *discoveryCache {
+	return &discoveryCache{
+		maxEntries: maxEntries,
+		ttl:        discoveryCacheTTL,
+		ll:         list.New(),
+		entries:    map[string]*list.Element{},
+		nowFn:      time.Now,
+	}
+}
+
+// get returns the value cached under the provided key, if any, and records the
+// lookup as a hit or a miss. Expired entries are removed and count as a miss.
+func (c *discoveryCache) get(kind, key string) (any, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[key]; ok {
+		entry := el.Value.(*discoveryCacheEntry) // nolint: forcetypeassert
+		if entry.expiresAt.IsZero() || c.nowFn().Before(entry.expiresAt) {
+			c.ll.MoveToFront(el)
+			discoveryCacheRequests.WithLabelValues(kind, cacheResultHit).Inc()
+			return entry.value, true
+		}
+		c.remove(el)
+	}
+	discoveryCacheRequests.WithLabelValues(kind, cacheResultMiss).Inc()
+	return nil, false
+}
+
+// set caches the provided value under the provided key, evicting the least
+// recently used entries if the cache is full. Tag lists are cached for the TTL
+// of the cache and entries of any other kind are cached until evicted.
+func (c *discoveryCache) set(kind, key string, value any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var expiresAt time.Time
+	if kind == cacheKindTags {
+		expiresAt = c.nowFn().Add(c.ttl)
+	}
+	if el, ok := c.entries[key]; ok {
+		c.ll.MoveToFront(el)
+		entry := el.Value.(*discoveryCacheEntry) // nolint: forcetypeassert
+		entry.value = value
+		entry.expiresAt = expiresAt
+		return
+	}
+	c.entries[key] = c.ll.PushFront(&discoveryCacheEntry{
+		key:       key,
+		value:     value,
+		expiresAt: expiresAt,
+	})
+	c.evict()
+}
+
+func (c *discoveryCache) setMaxEntries(maxEntries int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.maxEntries = maxEntries
+	c.evict()
+}
+
+// evict removes least recently used entries until the cache is within its
+// size bound. The caller must hold the lock.
+func (c *discoveryCache) evict() {
+	for c.ll.Len() > c.maxEntries {
+		c.remove(c.ll.Back())
+	}
+}
+
+// remove removes the provided element from the cache. The caller must hold
+// the lock.
+func (c *discoveryCache) remove(el *list.Element) {
+	c.ll.Remove(el)
+	delete(c.entries, el.Value.(*discoveryCacheEntry).key) // nolint: forcetypeassert
+}
+
+func (c *discoveryCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ll.Len()
+}
+
+// credentialsCacheKey returns a string that identifies the provided
+// credentials without revealing them. Cache entries are keyed by credentials
+// so that information retrieved using one set of credentials is never served
+// to a requester using different credentials.
+func credentialsCacheKey(creds *Credentials) string {
+	if creds == nil || (creds.Username == "" && creds.Password == "") {
+		return "anonymous"
+	}
+	sum := sha256.Sum256([]byte(creds.Username + "\x00" + creds.Password))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,176 @@
+package image
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_discoveryCache(t *testing.T) {
+	c := newDiscoveryCache(2)
+
+	_, ok := c.get(cacheKindImage, "a")
+	require.False(t, ok)
+
+	c.set(cacheKindImage, "a", 1)
+	c.set(cacheKindImage, "b", 2)
+	v, ok := c.get(cacheKindImage, "a")
+	require.True(t, ok)
+	require.Equal(t, 1, v)
+
+	// "b" is now the least recently used entry and should be evicted
+	c.set(cacheKindImage, "c", 3)
+	require.Equal(t, 2, c.len())
+	_, ok = c.get(cacheKindImage, "b")
+	require.False(t, ok)
+	_, ok = c.get(cacheKindImage, "c")
+	require.True(t, ok)
+
+	// Updating an existing entry should not grow the cache
+	c.set(cacheKindImage, "c", 4)
+	require.Equal(t, 2, c.len())
+	v, ok = c.get(cacheKindImage, "c")
+	require.True(t, ok)
+	require.Equal(t, 4, v)
+
+	// Shrinking the cache should evict least recently used entries
+	c.setMaxEntries(1)
+	require.Equal(t, 1, c.len())
+	_, ok = c.get(cacheKindImage, "c")
+	require.True(t, ok)
+}
+
+func Test_discoveryCache_ttl(t *testing.T) {
+	now := time.Now()
+	c := newDiscoveryCache(2)
+	c.nowFn = func() time.Time { return now }
+
+	c.set(cacheKindTags, "a", 1)
+	now = now.Add(discoveryCacheTTL - time.Second)
+	v, ok := c.get(cacheKindTags, "a")
+	require.True(t, ok)
+	require.Equal(t, 1, v)
+
+	// Using an entry does not extend its lifetime
+	now = now.Add(time.Second)
+	_, ok = c.get(cacheKindTags, "a")
+	require.False(t, ok)
+	require.Zero(t, c.len())
+
+	// Updating an entry does
+	c.set(cacheKindTags, "b", 2)
+	now = now.Add(discoveryCacheTTL - time.Second)
+	c.set(cacheKindTags, "b", 3)
+	now = now.Add(time.Second)
+	v, ok = c.get(cacheKindTags, "b")
+	require.True(t, ok)
+	require.Equal(t, 3, v)
+
+	// Entries of other kinds never expire
+	c.set(cacheKindImage, "c", 4)
+	now = now.Add(24 * time.Hour)
+	v, ok = c.get(cacheKindImage, "c")
+	require.True(t, ok)
+	require.Equal(t, 4, v)
+}
+
+func Test_credentialsCacheKey(t *testing.T) {
+	require.Equal(t, "anonymous", credentialsCacheKey(nil))
+	require.Equal(t, "anonymous", credentialsCacheKey(&Credentials{}))
+
+	key := credentialsCacheKey(&Credentials{Username: "user", Password: "pass"})
+	require.NotContains(t, key, "user")
+	require.NotContains(t, key, "pass")
+	require.NotEqual(
+		t,
+		key,
+		credentialsCacheKey(&Credentials{Username: "user", Password: "other-pass"}),
+	)
+}
+
+func TestContextWithoutDiscoveryCache(t *testing.T) {
+	require.False(t, discoveryCacheBypassed(context.Background()))
+	require.True(t, discoveryCacheBypassed(ContextWithoutDiscoveryCache(context.Background())))
+}
+
+func Test_repositoryClient_getTags(t *testing.T) {
+	var pageRequests, conditionalRequests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/":
+			w.WriteHeader(http.StatusOK)
+		case "/v2/fake/repo/tags/list":
+			pageRequests.Add(1)
+			etag := `"page-1"`
+			body := `{"name":"fake/repo","tags":["v1.0.0","v1.1.0"]}`
+			if r.URL.Query().Get("last") == "v1.1.0" {
+				etag = `"page-2"`
+				body = `{"name":"fake/repo","tags":["v2.0.0"]}`
+			} else {
+				w.Header().Set("Link", `</v2/fake/repo/tags/list?last=v1.1.0>; rel="next"`)
+			}
+			if match := r.Header.Get("If-None-Match"); match != "" {
+				conditionalRequests.Add(1)
+				if match == etag {
+					w.WriteHeader(http.StatusNotModified)
+					return
+				}
+			}
+			w.Header().Set("ETag", etag)
+			_, _ = fmt.Fprint(w, body)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := newRepositoryClient(
+		strings.TrimPrefix(srv.URL, "http://")+"/fake/repo",
+		false,
+		nil,
+	)
+	require.NoError(t, err)
+	client.cache = newDiscoveryCache(10)
+
+	expectedTags := []string{"v1.0.0", "v1.1.0", "v2.0.0"}
+
+	// The first listing retrieves every page unconditionally
+	tags, err := client.getTags(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, expectedTags, tags)
+	require.Equal(t, int32(2), pageRequests.Load())
+	require.Equal(t, int32(0), conditionalRequests.Load())
+
+	// The second listing revalidates every page
+	tags, err = client.getTags(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, expectedTags, tags)
+	require.Equal(t, int32(4), pageRequests.Load())
+	require.Equal(t, int32(2), conditionalRequests.Load())
+
+	// Bypassing the cache retrieves every page unconditionally
+	tags, err = client.getTags(ContextWithoutDiscoveryCache(context.Background()))
+	require.NoError(t, err)
+	require.Equal(t, expectedTags, tags)
+	require.Equal(t, int32(6), pageRequests.Load())
+	require.Equal(t, int32(2), conditionalRequests.Load())
+
+	// A client using different credentials does not share cached pages
+	otherClient, err := newRepositoryClient(
+		strings.TrimPrefix(srv.URL, "http://")+"/fake/repo",
+		false,
+		&Credentials{Username: "user", Password: "pass"},
+	)
+	require.NoError(t, err)
+	otherClient.cache = client.cache
+	_, err = otherClient.getTags(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int32(2), conditionalRequests.Load())
+}
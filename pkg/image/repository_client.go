@@ -3,19 +3,22 @@ package image
 import (
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"maps"
 	"net/http"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/google/go-containerregistry/pkg/authn"
 	"github.com/google/go-containerregistry/pkg/name"
 	v1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
 	"github.com/google/go-containerregistry/pkg/v1/types"
 	"github.com/hashicorp/go-cleanhttp"
-	"github.com/patrickmn/go-cache"
 	"go.uber.org/ratelimit"
 	"golang.org/x/sync/semaphore"
 
@@ -49,7 +52,13 @@ type repositoryClient struct {
 	registry      *registry
 	repoURL       string
 	repoRef       name.Reference
+	auth          authn.Authenticator
+	transport     http.RoundTripper
 	remoteOptions []remote.Option
+	// cache is the discovery cache used by the client. Keys are scoped by
+	// cacheKeyPrefix, which identifies the repository and credentials.
+	cache          *discoveryCache
+	cacheKeyPrefix string
 
 	// The following behaviors are overridable for testing purposes:
 
@@ -84,7 +93,9 @@ type repositoryClient struct {
 		platform *platformConstraint,
 	) (*image, error)
 
-	remoteListFn func(name.Repository, ...remote.Option) ([]string, error)
+	newTransportFn func(context.Context, name.Repository) (http.RoundTripper, error)
+
+	remoteHeadFn func(name.Reference, ...remote.Option) (*v1.Descriptor, error)
 
 	remoteGetFn func(name.Reference, ...remote.Option) (*remote.Descriptor, error)
 }
@@ -118,17 +129,24 @@ func newRepositoryClient(
 		Password: creds.Password,
 	}
 
+	rt := &rateLimitedRoundTripper{
+		limiter:              reg.rateLimiter,
+		internalRoundTripper: httpTransport,
+	}
+
 	r := &repositoryClient{
-		registry: reg,
-		repoURL:  repoURL,
-		repoRef:  repoRef,
+		registry:  reg,
+		repoURL:   repoURL,
+		repoRef:   repoRef,
+		auth:      auth,
+		transport: rt,
 		remoteOptions: []remote.Option{
-			remote.WithTransport(&rateLimitedRoundTripper{
-				limiter:              reg.rateLimiter,
-				internalRoundTripper: httpTransport,
-			}),
+			remote.WithTransport(rt),
 			remote.WithAuth(auth),
 		},
+		cache: sharedDiscoveryCache,
+		cacheKeyPrefix: repoRef.Context().Name() + "|" +
+			credentialsCacheKey(creds),
 	}
 
 	r.getImageByTagFn = r.getImageByTag
@@ -136,23 +154,142 @@ func newRepositoryClient(
 	r.getImageFromRemoteDescFn = r.getImageFromRemoteDesc
 	r.getImageFromV1ImageIndexFn = r.getImageFromV1ImageIndex
 	r.getImageFromV1ImageFn = r.getImageFromV1Image
-	r.remoteListFn = remote.List
+	r.newTransportFn = r.newTransport
+	r.remoteHeadFn = remote.Head
 	r.remoteGetFn = remote.Get
 
 	return r, nil
 }
 
+// getTags lists all tags in the repository. Each page of the list is cached
+// along with its ETag, if the registry provided one, and is subsequently
+// revalidated using a conditional request. Registries respond to such requests
+// with 304 Not Modified if the page has not changed, in which case the cached
+// page is used. If the context was returned by ContextWithoutDiscoveryCache,
+// every page is retrieved unconditionally.
 func (r *repositoryClient) getTags(ctx context.Context) ([]string, error) {
-	opts := append(r.remoteOptions, remote.WithContext(ctx))
-	tags, err := r.remoteListFn(r.repoRef.Context(), opts...)
+	repo := r.repoRef.Context()
+	rt, err := r.newTransportFn(ctx, repo)
 	if err != nil {
 		return nil, fmt.Errorf("error listing tags for repo URL %s: %w", r.repoURL, err)
 	}
+	pageURL := (&url.URL{
+		Scheme: repo.Scheme(),
+		Host:   repo.RegistryStr(),
+		Path:   fmt.Sprintf("/v2/%s/tags/list", repo.RepositoryStr()),
+	}).String()
+	var tags []string
+	for pageURL != "" {
+		page, err := r.getTagsPage(ctx, rt, pageURL)
+		if err != nil {
+			return nil, fmt.Errorf("error listing tags for repo URL %s: %w", r.repoURL, err)
+		}
+		tags = append(tags, page.Tags...)
+		pageURL = page.Next
+	}
 	return tags, nil
 }
 
-// getImageByTag retrieves an Image by tag. This function uses no cache since
-// tags can be mutable.
+// tagsPage is a single page of a repository's tag list.
+type tagsPage struct {
+	ETag string
+	Tags []string `json:"tags"`
+	Next string
+}
+
+// getTagsPage retrieves the page of the tag list at the provided URL, making
+// use of the discovery cache as described for getTags.
+func (r *repositoryClient) getTagsPage(
+	ctx context.Context,
+	rt http.RoundTripper,
+	pageURL string,
+) (*tagsPage, error) {
+	logger := logging.LoggerFromContext(ctx).WithValues("url", pageURL)
+	cacheKey := cacheKindTags + "|" + r.cacheKeyPrefix + "|" + pageURL
+
+	var cached *tagsPage
+	if discoveryCacheBypassed(ctx) {
+		discoveryCacheRequests.WithLabelValues(cacheKindTags, cacheResultBypass).Inc()
+	} else if entry, ok := r.cache.get(cacheKindTags, cacheKey); ok {
+		cached = entry.(*tagsPage) // nolint: forcetypeassert
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pageURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	if cached != nil && cached.ETag != "" {
+		req.Header.Set("If-None-Match", cached.ETag)
+	}
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		logger.Trace("tag list page not modified; using cached page")
+		discoveryCacheRequests.WithLabelValues(cacheKindTags, cacheResultRevalidated).Inc()
+		return cached, nil
+	}
+	if err = transport.CheckError(resp, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	page := &tagsPage{ETag: resp.Header.Get("ETag")}
+	if err = json.NewDecoder(resp.Body).Decode(page); err != nil {
+		return nil, fmt.Errorf("error decoding tag list: %w", err)
+	}
+	if next, err := getNextTagsPageURL(resp); err != nil {
+		return nil, err
+	} else if next != nil {
+		page.Next = next.String()
+	}
+	if page.ETag != "" {
+		r.cache.set(cacheKindTags, cacheKey, page)
+		logger.Trace("cached tag list page")
+	}
+	return page, nil
+}
+
+// getNextTagsPageURL returns the URL of the next page of a tag list, as
+// indicated by the provided response's Link header, if any.
+func getNextTagsPageURL(resp *http.Response) (*url.URL, error) {
+	link := resp.Header.Get("Link")
+	if link == "" {
+		return nil, nil
+	}
+	start := strings.Index(link, "<")
+	end := strings.Index(link, ">")
+	if start != 0 || end == -1 {
+		return nil, fmt.Errorf("error parsing Link header %q", link)
+	}
+	linkURL, err := url.Parse(link[start+1 : end])
+	if err != nil {
+		return nil, fmt.Errorf("error parsing Link header %q: %w", link, err)
+	}
+	return resp.Request.URL.ResolveReference(linkURL), nil
+}
+
+// newTransport returns an http.RoundTripper that authenticates requests to
+// the provided repository using the client's credentials.
+func (r *repositoryClient) newTransport(
+	ctx context.Context,
+	repo name.Repository,
+) (http.RoundTripper, error) {
+	return transport.NewWithContext(
+		ctx,
+		repo.Registry,
+		r.auth,
+		r.transport,
+		[]string{repo.Scope(transport.PullScope)},
+	)
+}
+
+// getImageByTag retrieves an Image by tag. Since tags can be mutable, the tag
+// is first resolved to a digest using a HEAD request, which most registries do
+// not count against rate limits. Information about the digest itself is
+// immutable and is served from the discovery cache when possible.
 func (r *repositoryClient) getImageByTag(
 	ctx context.Context,
 	tag string,
@@ -160,6 +297,16 @@ func (r *repositoryClient) getImageByTag(
 ) (*image, error) {
 	repoRef := r.repoRef.Context().Tag(tag)
 	opts := append(r.remoteOptions, remote.WithContext(ctx))
+	// Not all registries support HEAD requests for manifests. If this fails,
+	// we fall back to retrieving the manifest.
+	if head, err := r.remoteHeadFn(repoRef, opts...); err == nil {
+		if img, ok := r.getCachedImage(head.Digest.String(), platform); ok {
+			if img != nil {
+				img.Tag = tag
+			}
+			return img, nil
+		}
+	}
 	desc, err := r.remoteGetFn(repoRef, opts...)
 	if err != nil {
 		return nil, fmt.Errorf(
@@ -174,14 +321,15 @@ func (r *repositoryClient) getImageByTag(
 			tag, r.repoURL, err,
 		)
 	}
+	r.cacheImage(desc.Digest.String(), platform, img)
 	if img != nil {
 		img.Tag = tag
 	}
 	return img, nil
 }
 
-// getImageByDigest retrieves an Image for a given digest. This function uses a
-// cache since information retrieved by digest will never change.
+// getImageByDigest retrieves an Image for a given digest. This function uses
+// the discovery cache since information retrieved by digest will never change.
 func (r *repositoryClient) getImageByDigest(
 	ctx context.Context,
 	digest string,
@@ -193,9 +341,8 @@ func (r *repositoryClient) getImageByDigest(
 		"digest", digest,
 	)
 
-	if entry, exists := r.registry.imageCache.Get(digest); exists {
-		img := entry.(image) // nolint: forcetypeassert
-		return &img, nil
+	if img, ok := r.getCachedImage(digest, platform); ok {
+		return img, nil
 	}
 
 	logger.Trace(
@@ -221,18 +368,68 @@ func (r *repositoryClient) getImageByDigest(
 		)
 	}
 
-	if img != nil {
-		// Cache the image
-		r.registry.imageCache.Set(digest, *img, cache.DefaultExpiration)
-		logger.Trace(
-			"cached image",
-			"digest", digest,
-		)
-	}
+	r.cacheImage(digest, platform, img)
+	logger.Trace(
+		"cached image",
+		"digest", digest,
+	)
 
 	return img, nil
 }
 
+// cachedImage is the value type of image entries in the discovery cache. A
+// nil image records that the manifest did not match the platform constraint.
+type cachedImage struct {
+	image *image
+}
+
+func (r *repositoryClient) imageCacheKey(
+	digest string,
+	platform *platformConstraint,
+) string {
+	var platformStr string
+	if platform != nil {
+		platformStr = platform.String()
+	}
+	return cacheKindImage + "|" + r.cacheKeyPrefix + "|" + digest + "|" + platformStr
+}
+
+// getCachedImage returns a copy of the image cached for the provided digest
+// and platform constraint, if any. The boolean return value indicates whether
+// the cache held an entry, since a nil image is a valid entry.
+func (r *repositoryClient) getCachedImage(
+	digest string,
+	platform *platformConstraint,
+) (*image, bool) {
+	entry, ok := r.cache.get(cacheKindImage, r.imageCacheKey(digest, platform))
+	if !ok {
+		return nil, false
+	}
+	cached := entry.(cachedImage) // nolint: forcetypeassert
+	if cached.image == nil {
+		return nil, true
+	}
+	img := *cached.image
+	img.Annotations = maps.Clone(img.Annotations)
+	return &img, true
+}
+
+// cacheImage caches a copy of the provided image for the provided digest and
+// platform constraint.
+func (r *repositoryClient) cacheImage(
+	digest string,
+	platform *platformConstraint,
+	img *image,
+) {
+	var cached cachedImage
+	if img != nil {
+		imgCopy := *img
+		imgCopy.Annotations = maps.Clone(img.Annotations)
+		cached.image = &imgCopy
+	}
+	r.cache.set(cacheKindImage, r.imageCacheKey(digest, platform), cached)
+}
+
 // getImageFromRemoteDesc gets an Image from a given remote.Descriptor.
 func (r *repositoryClient) getImageFromRemoteDesc(
 	ctx context.Context,
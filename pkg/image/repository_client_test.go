@@ -10,7 +10,6 @@ import (
 	v1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
 	"github.com/google/go-containerregistry/pkg/v1/types"
-	"github.com/patrickmn/go-cache"
 	"github.com/stretchr/testify/require"
 	"k8s.io/utils/ptr"
 )
@@ -28,13 +27,17 @@ func TestNewRepositoryClient(t *testing.T) {
 	require.NotNil(t, client.getImageFromRemoteDescFn)
 	require.NotNil(t, client.getImageFromV1ImageIndexFn)
 	require.NotNil(t, client.getImageFromV1ImageFn)
-	require.NotNil(t, client.remoteListFn)
+	require.NotNil(t, client.newTransportFn)
+	require.NotNil(t, client.remoteHeadFn)
 	require.NotNil(t, client.remoteGetFn)
+	require.Same(t, sharedDiscoveryCache, client.cache)
+	require.Equal(t, "index.docker.io/library/debian|anonymous", client.cacheKeyPrefix)
 }
 
 func Test_repositoryClient_getImageByTag(t *testing.T) {
 	const testRepoURL = "fake-url"
 	const testTag = "fake-tag"
+	testDigest := v1.Hash{Algorithm: "sha256", Hex: "fake"}
 
 	testRepoRef, err := name.ParseReference(testRepoURL)
 	require.NoError(t, err)
@@ -52,7 +55,9 @@ func Test_repositoryClient_getImageByTag(t *testing.T) {
 		{
 			name: "error getting descriptor by tag",
 			client: &repositoryClient{
-				repoRef: testRepoRef,
+				repoRef:      testRepoRef,
+				cache:        newDiscoveryCache(10),
+				remoteHeadFn: failingRemoteHead,
 				remoteGetFn: func(
 					name.Reference,
 					...remote.Option,
@@ -68,7 +73,9 @@ func Test_repositoryClient_getImageByTag(t *testing.T) {
 		{
 			name: "error getting image from descriptor",
 			client: &repositoryClient{
-				repoRef: testRepoRef,
+				repoRef:      testRepoRef,
+				cache:        newDiscoveryCache(10),
+				remoteHeadFn: failingRemoteHead,
 				remoteGetFn: func(
 					name.Reference,
 					...remote.Option,
@@ -88,10 +95,50 @@ func Test_repositoryClient_getImageByTag(t *testing.T) {
 				require.ErrorContains(t, err, "something went wrong")
 			},
 		},
+		{
+			name: "cache hit for digest resolved by HEAD request",
+			client: func() *repositoryClient {
+				c := &repositoryClient{
+					repoRef: testRepoRef,
+					cache:   newDiscoveryCache(10),
+					remoteHeadFn: func(
+						name.Reference,
+						...remote.Option,
+					) (*v1.Descriptor, error) {
+						return &v1.Descriptor{Digest: testDigest}, nil
+					},
+					remoteGetFn: func(
+						name.Reference,
+						...remote.Option,
+					) (*remote.Descriptor, error) {
+						return nil, errors.New("manifest should not have been retrieved")
+					},
+				}
+				c.cacheImage(testDigest.String(), nil, &image{
+					Digest:    testDigest.String(),
+					CreatedAt: testImage.CreatedAt,
+				})
+				return c
+			}(),
+			assertions: func(t *testing.T, img *image, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					image{
+						Tag:       testTag,
+						Digest:    testDigest.String(),
+						CreatedAt: testImage.CreatedAt,
+					},
+					*img,
+				)
+			},
+		},
 		{
 			name: "success",
 			client: &repositoryClient{
-				repoRef: testRepoRef,
+				repoRef:      testRepoRef,
+				cache:        newDiscoveryCache(10),
+				remoteHeadFn: failingRemoteHead,
 				remoteGetFn: func(
 					name.Reference,
 					...remote.Option,
@@ -136,14 +183,8 @@ func Test_repositoryClient_getImageByDigest(t *testing.T) {
 		CreatedAt: ptr.To(time.Now().UTC()),
 	}
 
-	testRegistry := &registry{
-		imageCache: cache.New(0, 0),
-	}
-	testRegistry.imageCache.Set(
-		testImage.Digest,
-		testImage,
-		cache.DefaultExpiration,
-	)
+	testCache := newDiscoveryCache(10)
+	(&repositoryClient{cache: testCache}).cacheImage(testDigest, nil, &testImage)
 
 	testCases := []struct {
 		name       string
@@ -153,7 +194,7 @@ func Test_repositoryClient_getImageByDigest(t *testing.T) {
 		{
 			name: "cache hit",
 			client: &repositoryClient{
-				registry: testRegistry,
+				cache: testCache,
 			},
 			assertions: func(t *testing.T, img *image, err error) {
 				require.NoError(t, err)
@@ -164,9 +205,7 @@ func Test_repositoryClient_getImageByDigest(t *testing.T) {
 			name: "error getting descriptor by digest",
 			client: &repositoryClient{
 				repoRef: testRepoRef,
-				registry: &registry{
-					imageCache: cache.New(30*time.Minute, time.Hour),
-				},
+				cache:   newDiscoveryCache(10),
 				remoteGetFn: func(
 					name.Reference, ...remote.Option,
 				) (*remote.Descriptor, error) {
@@ -182,9 +221,7 @@ func Test_repositoryClient_getImageByDigest(t *testing.T) {
 			name: "error getting image from descriptor",
 			client: &repositoryClient{
 				repoRef: testRepoRef,
-				registry: &registry{
-					imageCache: cache.New(30*time.Minute, time.Hour),
-				},
+				cache:   newDiscoveryCache(10),
 				remoteGetFn: func(
 					name.Reference, ...remote.Option,
 				) (*remote.Descriptor, error) {
@@ -205,9 +242,7 @@ func Test_repositoryClient_getImageByDigest(t *testing.T) {
 			name: "success",
 			client: &repositoryClient{
 				repoRef: testRepoRef,
-				registry: &registry{
-					imageCache: cache.New(30*time.Minute, time.Hour),
-				},
+				cache:   newDiscoveryCache(10),
 				remoteGetFn: func(
 					name.Reference, ...remote.Option,
 				) (*remote.Descriptor, error) {
@@ -1072,3 +1107,7 @@ func (m *mockImage) LayerByDiffID(v1.Hash) (v1.Layer, error) {
 }
 
 var errNotImplemented = errors.New("not implemented")
+
+func failingRemoteHead(name.Reference, ...remote.Option) (*v1.Descriptor, error) {
+	return nil, errors.New("HEAD not supported")
+}
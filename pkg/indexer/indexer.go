@@ -29,9 +29,10 @@ const (
 	FreightByVerifiedStagesField  = "verifiedIn"
 	FreightApprovedForStagesField = "approvedFor"
 
-	PromotionsByStageAndFreightField = "stageAndFreight"
-	PromotionsByStageField           = "stage"
-	PromotionsByTerminalField        = "terminal"
+	PromotionsByConcurrencyGroupField = "concurrencyGroup"
+	PromotionsByStageAndFreightField  = "stageAndFreight"
+	PromotionsByStageField            = "stage"
+	PromotionsByTerminalField         = "terminal"
 
 	RunningPromotionsByArgoCDApplicationsField = "applications"
 
@@ -99,6 +100,19 @@ func StagesByAnalysisRun(
 	}
 }
 
+// PromotionsByConcurrencyGroup returns a client.IndexerFunc that indexes
+// non-terminal Promotions by the concurrency group they belong to.
+func PromotionsByConcurrencyGroup(obj client.Object) []string {
+	promo, ok := obj.(*kargoapi.Promotion)
+	if !ok || promo.Status.Phase.IsTerminal() {
+		return nil
+	}
+	if promo.Spec.Concurrency == nil || promo.Spec.Concurrency.Group == "" {
+		return nil
+	}
+	return []string{promo.Spec.Concurrency.Group}
+}
+
 // PromotionsByStage returns a client.IndexerFunc that indexes Promotions
 // by the Stage they reference.
 func PromotionsByStage(obj client.Object) []string {
@@ -237,6 +237,54 @@ func TestStagesByAnalysisRun(t *testing.T) {
 	}
 }
 
+func TestPromotionsByConcurrencyGroup(t *testing.T) {
+	testCases := map[string]struct {
+		input    *kargoapi.Promotion
+		expected []string
+	}{
+		"no concurrency": {
+			input:    &kargoapi.Promotion{},
+			expected: nil,
+		},
+		"no group": {
+			input: &kargoapi.Promotion{
+				Spec: kargoapi.PromotionSpec{
+					Concurrency: &kargoapi.PromotionConcurrency{Priority: 1},
+				},
+			},
+			expected: nil,
+		},
+		"non-terminal phase": {
+			input: &kargoapi.Promotion{
+				Spec: kargoapi.PromotionSpec{
+					Concurrency: &kargoapi.PromotionConcurrency{Group: "fake-group"},
+				},
+				Status: kargoapi.PromotionStatus{
+					Phase: kargoapi.PromotionPhaseRunning,
+				},
+			},
+			expected: []string{"fake-group"},
+		},
+		"terminal phase": {
+			input: &kargoapi.Promotion{
+				Spec: kargoapi.PromotionSpec{
+					Concurrency: &kargoapi.PromotionConcurrency{Group: "fake-group"},
+				},
+				Status: kargoapi.PromotionStatus{
+					Phase: kargoapi.PromotionPhaseSucceeded,
+				},
+			},
+			expected: nil,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.expected, PromotionsByConcurrencyGroup(tc.input))
+		})
+	}
+}
+
 func TestPromotionsByStage(t *testing.T) {
 	testCases := map[string]struct {
 		input      *kargoapi.Promotion
@@ -0,0 +1,35 @@
+package fs
+
+import (
+	"errors"
+	"io/fs"
+	"path/filepath"
+)
+
+// DirSize returns the total size of all regular files within the specified
+// directory. Files removed while the directory is being walked (e.g. temporary
+// files written by a concurrent process) are ignored.
+func DirSize(dir string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(dir, func(_ string, d fs.DirEntry, err error) error {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		fi, err := d.Info()
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		size += fi.Size()
+		return nil
+	})
+	return size, err
+}
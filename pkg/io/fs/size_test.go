@@ -0,0 +1,26 @@
+package fs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDirSize(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte("12345"), 0o600))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "sub"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "sub", "b.txt"), []byte("123"), 0o600))
+	// Symlinks are not followed and do not count towards the size.
+	require.NoError(t, os.Symlink(filepath.Join(dir, "a.txt"), filepath.Join(dir, "link")))
+
+	size, err := DirSize(dir)
+	require.NoError(t, err)
+	require.Equal(t, int64(8), size)
+
+	size, err = DirSize(filepath.Join(dir, "missing"))
+	require.NoError(t, err)
+	require.Zero(t, size)
+}
@@ -83,10 +83,52 @@ func (b *PromotionBuilder) Build(
 			Annotations: annotations,
 		},
 		Spec: kargoapi.PromotionSpec{
-			Stage:   stage.Name,
-			Freight: freight,
-			Vars:    vars,
-			Steps:   stage.Spec.PromotionTemplate.Spec.Steps,
+			Stage:       stage.Name,
+			Freight:     freight,
+			Vars:        vars,
+			Steps:       stage.Spec.PromotionTemplate.Spec.Steps,
+			Concurrency: stage.Spec.PromotionTemplate.Spec.Concurrency.DeepCopy(),
+		},
+	}
+	return &promotion, nil
+}
+
+// BuildResume creates a new Promotion which resumes the given Failed or
+// Errored Promotion from the step with the given index.
+func (b *PromotionBuilder) BuildResume(
+	ctx context.Context,
+	promo kargoapi.Promotion,
+	step int64,
+) (*kargoapi.Promotion, error) {
+	if err := api.CanResumePromotion(&promo, step); err != nil {
+		return nil, err
+	}
+
+	// Build metadata
+	annotations := make(map[string]string)
+	if u, ok := user.InfoFromContext(ctx); ok {
+		annotations[kargoapi.AnnotationKeyCreateActor] = api.FormatEventUserActor(u)
+	}
+
+	// NB: The steps of the resumed Promotion were inflated when it was
+	// created, and must be copied as-is for the step indices to remain
+	// meaningful.
+	promotion := kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        generatePromotionName(promo.Spec.Stage, promo.Spec.Freight),
+			Namespace:   promo.Namespace,
+			Annotations: annotations,
+		},
+		Spec: kargoapi.PromotionSpec{
+			Stage:       promo.Spec.Stage,
+			Freight:     promo.Spec.Freight,
+			Vars:        promo.Spec.Vars,
+			Steps:       promo.Spec.Steps,
+			Concurrency: promo.Spec.Concurrency.DeepCopy(),
+			ResumeFrom: &kargoapi.PromotionResume{
+				Promotion: promo.Name,
+				Step:      step,
+			},
 		},
 	}
 	return &promotion, nil
@@ -96,6 +96,10 @@ func TestPromotionBuilder_Build(t *testing.T) {
 									Uses: "fake-step",
 								},
 							},
+							Concurrency: &kargoapi.PromotionConcurrency{
+								Group:    "fake-group",
+								Priority: 1,
+							},
 						},
 					},
 				},
@@ -127,6 +131,12 @@ func TestPromotionBuilder_Build(t *testing.T) {
 				assert.Equal(t, "step1", promotion.Spec.Steps[0].As)
 				assert.Equal(t, "fake-step", promotion.Spec.Steps[0].Uses)
 
+				// Check concurrency
+				assert.Equal(t, &kargoapi.PromotionConcurrency{
+					Group:    "fake-group",
+					Priority: 1,
+				}, promotion.Spec.Concurrency)
+
 				// Check name format
 				assert.Contains(t, promotion.Name, "test-stage")
 				assert.Contains(t, promotion.Name, "abc123"[:6])
@@ -186,6 +196,109 @@ func TestPromotionBuilder_Build(t *testing.T) {
 	}
 }
 
+func TestPromotionBuilder_BuildResume(t *testing.T) {
+	s := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(s))
+
+	resumable := kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-stage.01j2w7aknhf3j7jbx6nqmy0j8v.abc123",
+			Namespace: "test-project",
+		},
+		Spec: kargoapi.PromotionSpec{
+			Stage:   "test-stage",
+			Freight: "abc123",
+			Vars: []kargoapi.ExpressionVariable{
+				{Name: "key1", Value: "value1"},
+			},
+			Steps: []kargoapi.PromotionStep{
+				{As: "step1", Uses: "fake-step"},
+				{As: "step2", Uses: "fake-step"},
+			},
+			Concurrency: &kargoapi.PromotionConcurrency{
+				Group: "fake-group",
+			},
+		},
+		Status: kargoapi.PromotionStatus{
+			Phase:       kargoapi.PromotionPhaseFailed,
+			CurrentStep: 1,
+			Workspace:   &kargoapi.PromotionWorkspace{Retained: true},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		promo      kargoapi.Promotion
+		step       int64
+		userInfo   user.Info
+		assertions func(*testing.T, *kargoapi.Promotion, error)
+	}{
+		{
+			name: "Promotion which is not resumable returns error",
+			promo: func() kargoapi.Promotion {
+				promo := *resumable.DeepCopy()
+				promo.Status.Phase = kargoapi.PromotionPhaseSucceeded
+				return promo
+			}(),
+			step: 1,
+			assertions: func(t *testing.T, promotion *kargoapi.Promotion, err error) {
+				assert.ErrorContains(t, err, "only Failed and Errored Promotions can be resumed")
+				assert.Nil(t, promotion)
+			},
+		},
+		{
+			name:  "invalid step returns error",
+			promo: resumable,
+			step:  2,
+			assertions: func(t *testing.T, promotion *kargoapi.Promotion, err error) {
+				assert.ErrorContains(t, err, "can only be resumed from steps 0 through 1")
+				assert.Nil(t, promotion)
+			},
+		},
+		{
+			name:  "successful build",
+			promo: resumable,
+			step:  1,
+			userInfo: user.Info{
+				IsAdmin: true,
+			},
+			assertions: func(t *testing.T, promotion *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, promotion)
+
+				assert.Equal(t, "test-project", promotion.Namespace)
+				assert.NotEqual(t, resumable.Name, promotion.Name)
+				assert.True(t, strings.HasPrefix(promotion.Name, "test-stage."))
+				assert.Equal(t, kargoapi.EventActorAdmin, promotion.Annotations[kargoapi.AnnotationKeyCreateActor])
+
+				assert.Equal(t, resumable.Spec.Stage, promotion.Spec.Stage)
+				assert.Equal(t, resumable.Spec.Freight, promotion.Spec.Freight)
+				assert.Equal(t, resumable.Spec.Vars, promotion.Spec.Vars)
+				assert.Equal(t, resumable.Spec.Steps, promotion.Spec.Steps)
+				assert.Equal(t, resumable.Spec.Concurrency, promotion.Spec.Concurrency)
+				assert.Equal(t, &kargoapi.PromotionResume{
+					Promotion: resumable.Name,
+					Step:      1,
+				}, promotion.Spec.ResumeFrom)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := user.ContextWithInfo(context.Background(), tt.userInfo)
+
+			c := fake.NewClientBuilder().
+				WithScheme(s).
+				Build()
+
+			b := NewPromotionBuilder(c)
+			promotion, err := b.BuildResume(ctx, tt.promo, tt.step)
+			tt.assertions(t, promotion, err)
+		})
+	}
+}
+
 func TestPromotionBuilder_InflateSteps(t *testing.T) {
 	s := runtime.NewScheme()
 	require.NoError(t, kargoapi.AddToScheme(s))
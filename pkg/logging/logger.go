@@ -4,15 +4,18 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
 	"github.com/go-logr/zapr"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 	runtimelog "sigs.k8s.io/controller-runtime/pkg/log"
 )
 
@@ -252,3 +255,94 @@ func (l *Logger) WithValues(keysAndValues ...any) *Logger {
 		logger:          l.logger.WithValues(keysAndValues...),
 	}
 }
+
+// WithWriter returns a copy of the logger that, in addition to its existing
+// output, writes human-readable lines for messages at or above the provided
+// level to the provided io.Writer. The level applied to the io.Writer is
+// independent of the level of the logger itself. This is useful for capturing
+// a subset of log output for presentation to end users. Writes to the
+// io.Writer may occur concurrently.
+func (l *Logger) WithWriter(w io.Writer, level Level) *Logger {
+	writerLogger := funcr.New(
+		func(prefix, args string) {
+			if prefix != "" {
+				_, _ = fmt.Fprintf(w, "%s: %s\n", prefix, args)
+				return
+			}
+			_, _ = fmt.Fprintln(w, args)
+		},
+		funcr.Options{
+			LogTimestamp:    true,
+			TimestampFormat: time.RFC3339,
+			LogInfoLevel:    ptr.To(""),
+			// ErrorLevel and InfoLevel both map to logr verbosity 0
+			Verbosity: max(int(level)-InfoLevel, 0),
+		},
+	)
+	return Wrap(logr.New(&teeSink{
+		sinks: []logr.LogSink{l.logger.GetSink(), writerLogger.GetSink()},
+	}))
+}
+
+// teeSink is an implementation of logr.LogSink that forwards all messages to
+// each of multiple underlying logr.LogSinks.
+type teeSink struct {
+	sinks []logr.LogSink
+}
+
+func (t *teeSink) Init(info logr.RuntimeInfo) {
+	for _, sink := range t.sinks {
+		sink.Init(info)
+	}
+}
+
+func (t *teeSink) Enabled(level int) bool {
+	for _, sink := range t.sinks {
+		if sink.Enabled(level) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *teeSink) Info(level int, msg string, keysAndValues ...any) {
+	for _, sink := range t.sinks {
+		if sink.Enabled(level) {
+			sink.Info(level, msg, keysAndValues...)
+		}
+	}
+}
+
+func (t *teeSink) Error(err error, msg string, keysAndValues ...any) {
+	for _, sink := range t.sinks {
+		sink.Error(err, msg, keysAndValues...)
+	}
+}
+
+func (t *teeSink) WithValues(keysAndValues ...any) logr.LogSink {
+	sinks := make([]logr.LogSink, len(t.sinks))
+	for i, sink := range t.sinks {
+		sinks[i] = sink.WithValues(keysAndValues...)
+	}
+	return &teeSink{sinks: sinks}
+}
+
+func (t *teeSink) WithName(name string) logr.LogSink {
+	sinks := make([]logr.LogSink, len(t.sinks))
+	for i, sink := range t.sinks {
+		sinks[i] = sink.WithName(name)
+	}
+	return &teeSink{sinks: sinks}
+}
+
+func (t *teeSink) WithCallDepth(depth int) logr.LogSink {
+	sinks := make([]logr.LogSink, len(t.sinks))
+	for i, sink := range t.sinks {
+		if withDepth, ok := sink.(logr.CallDepthLogSink); ok {
+			sinks[i] = withDepth.WithCallDepth(depth)
+		} else {
+			sinks[i] = sink
+		}
+	}
+	return &teeSink{sinks: sinks}
+}
@@ -1,7 +1,9 @@
 package logging
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"os"
 	"testing"
 
@@ -43,6 +45,31 @@ func TestNewLogger(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestLogger_WithWriter(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := NewLoggerOrDie(DebugLevel, ConsoleFormat).
+		WithValues("step", "fake-step").
+		WithWriter(buf, InfoLevel)
+	logger.Info("hello", "key", "value")
+	logger.Debug("not for the writer")
+	logger.Error(errors.New("something went wrong"), "goodbye")
+
+	out := buf.String()
+	require.Contains(t, out, `"msg"="hello"`)
+	require.Contains(t, out, `"key"="value"`)
+	require.NotContains(t, out, "not for the writer")
+	require.Contains(t, out, `"msg"="goodbye"`)
+	require.Contains(t, out, `"error"="something went wrong"`)
+
+	// The writer's level is independent of the logger's own level
+	buf.Reset()
+	logger = NewLoggerOrDie(InfoLevel, ConsoleFormat).WithWriter(buf, DebugLevel)
+	logger.Debug("for the writer")
+	logger.Trace("not for the writer")
+	require.Contains(t, buf.String(), "for the writer")
+	require.NotContains(t, buf.String(), "not for the writer")
+}
+
 func TestParseLevel(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -0,0 +1,322 @@
+package agent
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/kelseyhightower/envconfig"
+	"golang.org/x/net/http2"
+
+	agentv1alpha1 "github.com/akuity/kargo/api/agent/v1alpha1"
+	"github.com/akuity/kargo/api/agent/v1alpha1/agentv1alpha1connect"
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/logging"
+	"github.com/akuity/kargo/pkg/promotion"
+	versionpkg "github.com/akuity/kargo/pkg/x/version"
+)
+
+const (
+	// minReconnectBackoff and maxReconnectBackoff bound the amount of time an
+	// Agent waits before reconnecting after its connection was lost.
+	minReconnectBackoff = time.Second
+	maxReconnectBackoff = time.Minute
+	// workspacePruneInterval is the interval at which an Agent removes the
+	// workspaces which have not been used within the TTL of its workspace
+	// store.
+	workspacePruneInterval = 10 * time.Minute
+)
+
+// Config represents configuration for an Agent.
+type Config struct {
+	// ServerAddress is the URL of the agent server of the controller. If the
+	// URL has the http scheme, plaintext HTTP/2 is used.
+	ServerAddress string `envconfig:"AGENT_SERVER_ADDRESS" required:"true"`
+	// Token is the bearer token the Agent presents to the agent server. It
+	// identifies the Agent.
+	Token string `envconfig:"AGENT_TOKEN" required:"true"`
+	// InsecureSkipTLSVerify indicates whether the certificate of the agent
+	// server should not be verified.
+	InsecureSkipTLSVerify bool `envconfig:"AGENT_INSECURE_SKIP_TLS_VERIFY" default:"false"`
+	// Projects are the Projects the Agent executes Steps for. Steps of any
+	// other Project are refused, even if the agent server dispatches them, as
+	// the Agent reads credentials from the control plane on their behalf.
+	Projects []string `envconfig:"AGENT_PROJECTS" required:"true"`
+}
+
+// ConfigFromEnv returns a Config populated from environment variables.
+func ConfigFromEnv() Config {
+	cfg := Config{}
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
+// Agent executes Steps dispatched to it by the agent server of the controller
+// and reports their results back. Steps are executed in workspaces that
+// mirror the workspaces of the controller.
+type Agent struct {
+	cfg        Config
+	client     agentv1alpha1connect.AgentServiceClient
+	executor   promotion.StepExecutor
+	workspaces promotion.WorkspaceStore
+}
+
+// NewAgent returns an Agent configured as specified, which executes Steps
+// using the provided StepExecutor in workspaces managed by the provided
+// WorkspaceStore.
+func NewAgent(
+	cfg Config,
+	executor promotion.StepExecutor,
+	workspaces promotion.WorkspaceStore,
+) *Agent {
+	return &Agent{
+		cfg: cfg,
+		client: agentv1alpha1connect.NewAgentServiceClient(
+			newHTTPClient(cfg),
+			cfg.ServerAddress,
+			connect.WithGRPC(),
+		),
+		executor:   executor,
+		workspaces: workspaces,
+	}
+}
+
+// Run connects the Agent to the agent server and executes the Steps it
+// receives until the provided context is canceled. Whenever the connection is
+// lost, the Agent reconnects with an exponential backoff.
+func (a *Agent) Run(ctx context.Context) error {
+	logger := logging.LoggerFromContext(ctx)
+
+	go a.pruneWorkspaces(ctx)
+
+	backoff := minReconnectBackoff
+	for {
+		start := time.Now()
+		err := a.serve(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if time.Since(start) > maxReconnectBackoff {
+			// The connection was established for a while, so this is not a
+			// repeated failure to connect.
+			backoff = minReconnectBackoff
+		}
+		logger.Error(err, "connection to agent server lost", "reconnectIn", backoff)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(backoff):
+		}
+		backoff = min(2*backoff, maxReconnectBackoff)
+	}
+}
+
+// serve opens a stream with the agent server and executes the Steps received
+// on it until the stream ends. Steps which are still executing when the
+// stream ends are canceled, as their results can no longer be reported. So
+// are Steps the agent server asks to cancel.
+func (a *Agent) serve(ctx context.Context) error {
+	logger := logging.LoggerFromContext(ctx)
+
+	ctx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	stream := a.client.Connect(ctx)
+	stream.RequestHeader().Set("Authorization", "Bearer "+a.cfg.Token)
+	// Canceling the context does not reliably interrupt a pending receive, so
+	// the stream is closed explicitly.
+	stopClose := context.AfterFunc(ctx, func() {
+		_ = stream.CloseRequest()
+		_ = stream.CloseResponse()
+	})
+	defer stopClose()
+
+	var sendMu sync.Mutex
+	send := func(msg *agentv1alpha1.ConnectRequest) error {
+		sendMu.Lock()
+		defer sendMu.Unlock()
+		return stream.Send(msg)
+	}
+
+	// The cancel functions of the contexts of the steps being executed, by the
+	// IDs of their requests.
+	var cancelsMu sync.Mutex
+	cancels := map[string]context.CancelFunc{}
+
+	if err := send(&agentv1alpha1.ConnectRequest{
+		Message: &agentv1alpha1.ConnectRequest_Hello{
+			Hello: &agentv1alpha1.Hello{Version: versionpkg.GetVersion().Version},
+		},
+	}); err != nil {
+		return fmt.Errorf("error connecting to agent server: %w", err)
+	}
+
+	for {
+		msg, err := stream.Receive()
+		if err != nil {
+			return fmt.Errorf("error receiving from agent server: %w", err)
+		}
+		if cancelStep := msg.GetCancelStep(); cancelStep != nil {
+			cancelsMu.Lock()
+			if cancelStepCtx, ok := cancels[cancelStep.GetId()]; ok {
+				logger.Debug("canceling step", "id", cancelStep.GetId())
+				cancelStepCtx()
+			}
+			cancelsMu.Unlock()
+			continue
+		}
+		req := msg.GetStepRequest()
+		if req == nil {
+			continue
+		}
+		stepCtx, cancelStepCtx := context.WithCancel(ctx)
+		cancelsMu.Lock()
+		cancels[req.GetId()] = cancelStepCtx
+		cancelsMu.Unlock()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() {
+				cancelsMu.Lock()
+				delete(cancels, req.GetId())
+				cancelsMu.Unlock()
+				cancelStepCtx()
+			}()
+			if err := send(&agentv1alpha1.ConnectRequest{
+				Message: &agentv1alpha1.ConnectRequest_StepResult{
+					StepResult: a.execute(stepCtx, req),
+				},
+			}); err != nil {
+				logger.Error(err, "error sending step result", "id", req.GetId())
+			}
+		}()
+	}
+}
+
+// execute executes the provided request and returns its result.
+func (a *Agent) execute(
+	ctx context.Context,
+	req *agentv1alpha1.StepRequest,
+) *agentv1alpha1.StepResult {
+	res := &agentv1alpha1.StepResult{Id: req.GetId()}
+
+	result, err := a.executeStep(ctx, req.GetRequest())
+	if res.Result, err = encodeResult(result, err); err != nil {
+		res.Error = err.Error()
+		res.Terminal = promotion.IsTerminal(err)
+	}
+	return res
+}
+
+// executeStep decodes the provided StepExecutionRequest and executes it in
+// the workspace mirroring the workspace it names.
+func (a *Agent) executeStep(
+	ctx context.Context,
+	payload []byte,
+) (promotion.StepResult, error) {
+	errored := promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}
+
+	req := promotion.StepExecutionRequest{}
+	if err := json.Unmarshal(payload, &req); err != nil {
+		return errored, &promotion.TerminalError{
+			Err: fmt.Errorf("error decoding step execution request: %w", err),
+		}
+	}
+
+	if !slices.Contains(a.cfg.Projects, req.Context.Project) {
+		return errored, &promotion.TerminalError{
+			Err: fmt.Errorf(
+				"agent is not permitted to execute steps of Project %q",
+				req.Context.Project,
+			),
+		}
+	}
+
+	workDir, _, err := a.workspaces.Open(promotion.WorkspaceID(req.Context.WorkDir))
+	if err != nil {
+		return errored, err
+	}
+	req.Context.WorkDir = workDir
+
+	logger := logging.LoggerFromContext(ctx).WithValues(
+		"project", req.Context.Project,
+		"promotion", req.Context.Promotion,
+		"verification", req.Context.Verification,
+	)
+	return a.executor.ExecuteStep(logging.ContextWithLogger(ctx, logger), req)
+}
+
+// encodeResult encodes the provided StepResult. The provided error, if any,
+// is returned as is, unless the StepResult cannot be encoded.
+func encodeResult(result promotion.StepResult, err error) ([]byte, error) {
+	payload, encodeErr := json.Marshal(result)
+	if encodeErr == nil {
+		return payload, err
+	}
+	// Logs and output are what may fail to encode, so we fall back to the
+	// status and message of the result.
+	payload, _ = json.Marshal(promotion.StepResult{
+		Status:  kargoapi.PromotionStepStatusErrored,
+		Message: result.Message,
+	})
+	return payload, &promotion.TerminalError{
+		Err: fmt.Errorf("error encoding step result: %w", encodeErr),
+	}
+}
+
+// pruneWorkspaces periodically removes the workspaces which have not been
+// used within the TTL of the workspace store until the provided context is
+// canceled.
+func (a *Agent) pruneWorkspaces(ctx context.Context) {
+	logger := logging.LoggerFromContext(ctx)
+	ticker := time.NewTicker(workspacePruneInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := a.workspaces.Prune(); err != nil {
+				logger.Error(err, "error pruning workspaces")
+			}
+		}
+	}
+}
+
+// newHTTPClient returns an HTTP/2 client for the agent server, as HTTP/2 is
+// required for bidirectional streaming.
+func newHTTPClient(cfg Config) *http.Client {
+	transport := &http2.Transport{
+		ReadIdleTimeout: pingInterval,
+		PingTimeout:     pingTimeout,
+	}
+	if strings.HasPrefix(cfg.ServerAddress, "http://") {
+		transport.AllowHTTP = true
+		transport.DialTLSContext = func(
+			ctx context.Context,
+			network string,
+			addr string,
+			_ *tls.Config,
+		) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, network, addr)
+		}
+	} else if cfg.InsecureSkipTLSVerify {
+		transport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: true, // nolint: gosec
+		}
+	}
+	return &http.Client{Transport: transport}
+}
@@ -0,0 +1,392 @@
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	agentv1alpha1 "github.com/akuity/kargo/api/agent/v1alpha1"
+	"github.com/akuity/kargo/api/agent/v1alpha1/agentv1alpha1connect"
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/api"
+	"github.com/akuity/kargo/pkg/promotion"
+)
+
+type fakeStepExecutor struct {
+	mu      sync.Mutex
+	workDir string
+	result  promotion.StepResult
+	err     error
+	// started, if non-nil, is closed once a step is being executed, after
+	// which the execution lasts until its context is canceled.
+	started chan struct{}
+	// canceled is closed once the context of a step that is executed until
+	// its context is canceled has been canceled.
+	canceled chan struct{}
+}
+
+func (e *fakeStepExecutor) ExecuteStep(
+	ctx context.Context,
+	req promotion.StepExecutionRequest,
+) (promotion.StepResult, error) {
+	e.mu.Lock()
+	e.workDir = req.Context.WorkDir
+	started, canceled := e.started, e.canceled
+	result, err := e.result, e.err
+	e.mu.Unlock()
+	if started != nil {
+		close(started)
+		<-ctx.Done()
+		close(canceled)
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, ctx.Err()
+	}
+	return result, err
+}
+
+func (e *fakeStepExecutor) getWorkDir() string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.workDir
+}
+
+// newFakeClient returns a client for a cluster in which the agent "fake-agent"
+// serves the Project "fake-project".
+func newFakeClient(t *testing.T) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&kargoapi.ClusterConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: api.ClusterConfigName},
+			Spec: kargoapi.ClusterConfigSpec{
+				Agents: []kargoapi.AgentConfig{{
+					Name:     "fake-agent",
+					Projects: []string{"fake-project"},
+				}},
+			},
+		},
+	).Build()
+}
+
+// startServer starts a Server which permits the agent "fake-agent" with the
+// token "fake-token" to connect and returns it along with its address.
+func startServer(t *testing.T) (*Server, string) {
+	t.Helper()
+	tokensDir := t.TempDir()
+	require.NoError(t, os.WriteFile(
+		filepath.Join(tokensDir, "fake-agent"),
+		[]byte("fake-token\n"),
+		0o600,
+	))
+	require.NoError(t, os.Mkdir(filepath.Join(tokensDir, "..data"), 0o700))
+
+	srv := NewServer(ServerConfig{TokensDir: tokensDir}, newFakeClient(t))
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go func() {
+		_ = srv.Serve(ctx, l)
+	}()
+	return srv, "http://" + l.Addr().String()
+}
+
+func TestAgent(t *testing.T) {
+	srv, addr := startServer(t)
+
+	executor := &fakeStepExecutor{}
+	workspaces, err := promotion.NewDirWorkspaceStore(
+		promotion.WorkspaceStoreConfig{Dir: t.TempDir()},
+	)
+	require.NoError(t, err)
+	a := NewAgent(
+		Config{ServerAddress: addr, Token: "fake-token", Projects: []string{"fake-project"}},
+		executor,
+		workspaces,
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go func() {
+		_ = a.Run(ctx)
+	}()
+	require.Eventually(t, func() bool {
+		return srv.getConn("fake-agent") != nil
+	}, 10*time.Second, 10*time.Millisecond)
+
+	req := promotion.StepExecutionRequest{
+		Context: promotion.StepContext{
+			Project: "fake-project",
+			WorkDir: filepath.Join("/controller", "promotion-fake-id"),
+		},
+		Step:  promotion.Step{Kind: "fake-step", Alias: "fake-alias"},
+		Agent: "fake-agent",
+	}
+
+	t.Run("step succeeds", func(t *testing.T) {
+		executor.result = promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusSucceeded,
+			Output: map[string]any{"foo": "bar"},
+		}
+		executor.err = nil
+		res, err := srv.DispatchStep(context.Background(), "fake-agent", req)
+		require.NoError(t, err)
+		require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+		require.Equal(t, map[string]any{"foo": "bar"}, res.Output)
+
+		expectedWorkDir, _, err := workspaces.Open("fake-id")
+		require.NoError(t, err)
+		require.Equal(t, expectedWorkDir, executor.getWorkDir())
+	})
+
+	t.Run("step fails with terminal error", func(t *testing.T) {
+		executor.result = promotion.StepResult{Status: kargoapi.PromotionStepStatusFailed}
+		executor.err = &promotion.TerminalError{Err: errors.New("something went wrong")}
+		res, err := srv.DispatchStep(context.Background(), "fake-agent", req)
+		require.ErrorContains(t, err, "something went wrong")
+		require.True(t, promotion.IsTerminal(err))
+		require.Equal(t, kargoapi.PromotionStepStatusFailed, res.Status)
+	})
+
+	t.Run("step fails with non-terminal error", func(t *testing.T) {
+		executor.result = promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}
+		executor.err = errors.New("something went wrong")
+		res, err := srv.DispatchStep(context.Background(), "fake-agent", req)
+		require.ErrorContains(t, err, "something went wrong")
+		require.False(t, promotion.IsTerminal(err))
+		require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+	})
+
+	t.Run("step is canceled", func(t *testing.T) {
+		started, canceled := make(chan struct{}), make(chan struct{})
+		executor.mu.Lock()
+		executor.started, executor.canceled = started, canceled
+		executor.mu.Unlock()
+		defer func() {
+			executor.mu.Lock()
+			executor.started, executor.canceled = nil, nil
+			executor.mu.Unlock()
+		}()
+
+		ctx, cancelStep := context.WithCancel(context.Background())
+		go func() {
+			<-started
+			cancelStep()
+		}()
+		res, err := srv.DispatchStep(ctx, "fake-agent", req)
+		require.ErrorIs(t, err, context.Canceled)
+		require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+
+		// The agent should have canceled the execution of the step
+		select {
+		case <-canceled:
+		case <-time.After(10 * time.Second):
+			t.Fatal("step was not canceled by the agent")
+		}
+	})
+
+	t.Run("agent does not serve Project", func(t *testing.T) {
+		otherReq := req
+		otherReq.Context.Project = "other-project"
+		res, err := srv.DispatchStep(context.Background(), "fake-agent", otherReq)
+		require.ErrorContains(t, err, `agent "fake-agent" does not serve Project "other-project"`)
+		require.True(t, promotion.IsTerminal(err))
+		require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+	})
+
+	t.Run("agent is not mapped to any Project", func(t *testing.T) {
+		res, err := srv.DispatchStep(context.Background(), "other-agent", req)
+		require.ErrorContains(t, err, `agent "other-agent" does not serve Project "fake-project"`)
+		require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+	})
+
+	t.Run("agent disconnects", func(t *testing.T) {
+		cancel()
+		require.Eventually(t, func() bool {
+			return srv.getConn("fake-agent") == nil
+		}, 10*time.Second, 10*time.Millisecond)
+		_, err := srv.DispatchStep(context.Background(), "fake-agent", req)
+		require.ErrorContains(t, err, "is not connected")
+	})
+}
+
+func TestAgent_executeStep_project(t *testing.T) {
+	executor := &fakeStepExecutor{
+		result: promotion.StepResult{Status: kargoapi.PromotionStepStatusSucceeded},
+	}
+	workspaces, err := promotion.NewDirWorkspaceStore(
+		promotion.WorkspaceStoreConfig{Dir: t.TempDir()},
+	)
+	require.NoError(t, err)
+	a := NewAgent(
+		Config{ServerAddress: "http://fake-server", Projects: []string{"fake-project"}},
+		executor,
+		workspaces,
+	)
+
+	newPayload := func(project string) []byte {
+		payload, err := json.Marshal(promotion.StepExecutionRequest{
+			Context: promotion.StepContext{
+				Project: project,
+				WorkDir: filepath.Join("/controller", "promotion-fake-id"),
+			},
+			Step: promotion.Step{Kind: "fake-step", Alias: "fake-alias"},
+		})
+		require.NoError(t, err)
+		return payload
+	}
+
+	// Steps of Projects the agent does not serve are refused, even if the agent
+	// server dispatches them.
+	res, err := a.executeStep(context.Background(), newPayload("other-project"))
+	require.ErrorContains(t, err, `agent is not permitted to execute steps of Project "other-project"`)
+	require.True(t, promotion.IsTerminal(err))
+	require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+	require.Empty(t, executor.getWorkDir())
+
+	res, err = a.executeStep(context.Background(), newPayload("fake-project"))
+	require.NoError(t, err)
+	require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+	require.NotEmpty(t, executor.getWorkDir())
+}
+
+func TestServer_Connect_authentication(t *testing.T) {
+	_, addr := startServer(t)
+
+	testCases := []struct {
+		name  string
+		token string
+	}{
+		{
+			name: "no token",
+		},
+		{
+			name:  "invalid token",
+			token: "bogus-token",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			client := agentv1alpha1connect.NewAgentServiceClient(
+				newHTTPClient(Config{ServerAddress: addr}),
+				addr,
+				connect.WithGRPC(),
+			)
+			stream := client.Connect(context.Background())
+			if testCase.token != "" {
+				stream.RequestHeader().Set("Authorization", "Bearer "+testCase.token)
+			}
+			_ = stream.Send(&agentv1alpha1.ConnectRequest{
+				Message: &agentv1alpha1.ConnectRequest_Hello{
+					Hello: &agentv1alpha1.Hello{},
+				},
+			})
+			_, err := stream.Receive()
+			require.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+		})
+	}
+}
+
+func TestServer_Connect_version(t *testing.T) {
+	srv, addr := startServer(t)
+	srv.version = "v1.9.0"
+
+	client := agentv1alpha1connect.NewAgentServiceClient(
+		newHTTPClient(Config{ServerAddress: addr}),
+		addr,
+		connect.WithGRPC(),
+	)
+	stream := client.Connect(context.Background())
+	stream.RequestHeader().Set("Authorization", "Bearer fake-token")
+	require.NoError(t, stream.Send(&agentv1alpha1.ConnectRequest{
+		Message: &agentv1alpha1.ConnectRequest_Hello{
+			Hello: &agentv1alpha1.Hello{Version: "v1.8.2"},
+		},
+	}))
+	_, err := stream.Receive()
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	require.ErrorContains(t, err, "agent version v1.8.2 is not supported")
+	require.Nil(t, srv.getConn("fake-agent"))
+}
+
+func Test_checkAgentVersion(t *testing.T) {
+	testCases := []struct {
+		name          string
+		serverVersion string
+		agentVersion  string
+		supported     bool
+	}{
+		{
+			name:          "same version",
+			serverVersion: "v1.9.0",
+			agentVersion:  "v1.9.0",
+			supported:     true,
+		},
+		{
+			name:          "different patch version",
+			serverVersion: "v1.9.3",
+			agentVersion:  "v1.9.0",
+			supported:     true,
+		},
+		{
+			name:          "different minor version",
+			serverVersion: "v1.9.0",
+			agentVersion:  "v1.8.0",
+		},
+		{
+			name:          "different major version",
+			serverVersion: "v2.9.0",
+			agentVersion:  "v1.9.0",
+		},
+		{
+			name:          "development build of the agent",
+			serverVersion: "v1.9.0",
+			agentVersion:  "devel+abcdef0",
+		},
+		{
+			name:          "no agent version",
+			serverVersion: "v1.9.0",
+		},
+		{
+			name:          "development build of the controller",
+			serverVersion: "devel+abcdef0",
+			agentVersion:  "v1.8.0",
+			supported:     true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := checkAgentVersion(testCase.serverVersion, testCase.agentVersion)
+			if testCase.supported {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, "is not supported")
+			}
+		})
+	}
+}
+
+func TestServer_authenticate(t *testing.T) {
+	srv := NewServer(
+		ServerConfig{TokensDir: filepath.Join(t.TempDir(), "missing")},
+		newFakeClient(t),
+	)
+	header := http.Header{}
+	header.Set("Authorization", "Bearer fake-token")
+	_, err := srv.authenticate(header)
+	require.ErrorContains(t, err, "error reading agent tokens")
+}
@@ -0,0 +1,431 @@
+package agent
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/Masterminds/semver/v3"
+	"github.com/google/uuid"
+	"github.com/kelseyhightower/envconfig"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	agentv1alpha1 "github.com/akuity/kargo/api/agent/v1alpha1"
+	"github.com/akuity/kargo/api/agent/v1alpha1/agentv1alpha1connect"
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/api"
+	"github.com/akuity/kargo/pkg/logging"
+	"github.com/akuity/kargo/pkg/promotion"
+	versionpkg "github.com/akuity/kargo/pkg/x/version"
+)
+
+const (
+	// pingInterval is the interval after which an idle connection is checked
+	// for liveness using an HTTP/2 ping.
+	pingInterval = 30 * time.Second
+	// pingTimeout is the amount of time after which a connection that does not
+	// respond to a ping is closed.
+	pingTimeout = 15 * time.Second
+)
+
+// ServerConfig represents configuration for a Server.
+type ServerConfig struct {
+	// BindAddress is the address the Server listens on. If empty, the Server
+	// is not enabled.
+	BindAddress string `envconfig:"AGENT_SERVER_BIND_ADDRESS"`
+	// TokensDir is a directory containing one file per agent permitted to
+	// connect. The name of each file is the name of an agent and its content
+	// is the bearer token that agent must present. This matches the layout of
+	// a mounted Secret.
+	TokensDir string `envconfig:"AGENT_SERVER_TOKENS_DIR" default:"/etc/kargo/agents"`
+	// TLSCertPath and TLSKeyPath are the paths of the certificate and private
+	// key used to serve TLS. If either is empty, the Server serves plaintext
+	// HTTP/2, which is suitable only when TLS is terminated in front of it.
+	TLSCertPath string `envconfig:"AGENT_SERVER_TLS_CERT_PATH"`
+	TLSKeyPath  string `envconfig:"AGENT_SERVER_TLS_KEY_PATH"`
+}
+
+// ServerConfigFromEnv returns a ServerConfig populated from environment
+// variables.
+func ServerConfigFromEnv() ServerConfig {
+	cfg := ServerConfig{}
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
+// Server serves the AgentService to agents and dispatches the execution of
+// Steps to them. It implements promotion.StepDispatcher.
+//
+// Each agent may have at most one connection at a time. When an agent
+// connects while it is already connected, the older connection is closed,
+// since the workspaces of an agent are local to a single agent process.
+//
+// Steps are only dispatched to an agent if the ClusterConfig maps the agent to
+// the Project of the Promotion the step belongs to.
+type Server struct {
+	cfg    ServerConfig
+	client client.Client
+	// version is the version of the controller. Agents of other versions
+	// are rejected.
+	version string
+
+	mu    sync.Mutex
+	conns map[string]*conn
+}
+
+// conn is a connection with an agent.
+type conn struct {
+	outbox chan *agentv1alpha1.ConnectResponse
+	// done is closed when the connection is closed.
+	done      chan struct{}
+	closeOnce sync.Once
+
+	mu      sync.Mutex
+	pending map[string]chan *agentv1alpha1.StepResult
+}
+
+// NewServer returns a Server configured as specified, which uses the provided
+// client to look up the Projects agents serve.
+func NewServer(cfg ServerConfig, c client.Client) *Server {
+	return &Server{
+		cfg:     cfg,
+		client:  c,
+		version: versionpkg.GetVersion().Version,
+		conns:   map[string]*conn{},
+	}
+}
+
+// Serve serves the AgentService on the provided listener until the provided
+// context is canceled.
+func (s *Server) Serve(ctx context.Context, l net.Listener) error {
+	logger := logging.LoggerFromContext(ctx)
+
+	mux := http.NewServeMux()
+	mux.Handle(agentv1alpha1connect.NewAgentServiceHandler(s))
+
+	tls := s.cfg.TLSCertPath != "" && s.cfg.TLSKeyPath != ""
+	srv := &http.Server{
+		Handler: h2c.NewHandler(mux, &http2.Server{
+			ReadIdleTimeout: pingInterval,
+			PingTimeout:     pingTimeout,
+		}),
+		ReadHeaderTimeout: time.Minute,
+	}
+	errCh := make(chan error)
+	go func() {
+		if tls {
+			errCh <- srv.ServeTLS(l, s.cfg.TLSCertPath, s.cfg.TLSKeyPath)
+		} else {
+			errCh <- srv.Serve(l)
+		}
+	}()
+
+	logger.Info(
+		"Agent server is listening",
+		"tls", tls,
+		"address", l.Addr().String(),
+	)
+
+	select {
+	case <-ctx.Done():
+		logger.Info("Stopping agent server...")
+		// Streams with agents never end on their own, so there is nothing to
+		// wait for.
+		return srv.Close()
+	case err := <-errCh:
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return err
+	}
+}
+
+// Connect implements agentv1alpha1connect.AgentServiceHandler.
+func (s *Server) Connect(
+	ctx context.Context,
+	stream *connect.BidiStream[agentv1alpha1.ConnectRequest, agentv1alpha1.ConnectResponse],
+) error {
+	name, err := s.authenticate(stream.RequestHeader())
+	if err != nil {
+		return connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	logger := logging.LoggerFromContext(ctx).WithValues("agent", name)
+
+	msg, err := stream.Receive()
+	if err != nil {
+		return err
+	}
+	hello := msg.GetHello()
+	if hello == nil {
+		return connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("first message must be a hello"),
+		)
+	}
+	if err = checkAgentVersion(s.version, hello.GetVersion()); err != nil {
+		logger.Info("rejecting agent", "version", hello.GetVersion(), "reason", err.Error())
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	c := &conn{
+		outbox:  make(chan *agentv1alpha1.ConnectResponse),
+		done:    make(chan struct{}),
+		pending: map[string]chan *agentv1alpha1.StepResult{},
+	}
+	s.register(name, c)
+	defer s.unregister(name, c)
+	logger.Info("agent connected", "version", hello.GetVersion())
+	defer logger.Info("agent disconnected")
+
+	recvErrCh := make(chan error, 1)
+	go func() {
+		recvErrCh <- c.receive(stream)
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-c.done:
+			return connect.NewError(
+				connect.CodeAborted,
+				errors.New("connection was replaced by a newer connection"),
+			)
+		case err = <-recvErrCh:
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		case msg := <-c.outbox:
+			if err = stream.Send(msg); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// DispatchStep implements promotion.StepDispatcher.
+func (s *Server) DispatchStep(
+	ctx context.Context,
+	agent string,
+	req promotion.StepExecutionRequest,
+) (promotion.StepResult, error) {
+	errored := promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}
+
+	clusterCfg, err := api.GetClusterConfig(ctx, s.client)
+	if err != nil {
+		return errored, err
+	}
+	if clusterCfg == nil || !clusterCfg.Spec.ServesProject(agent, req.Context.Project) {
+		return errored, &promotion.TerminalError{
+			Err: fmt.Errorf("agent %q does not serve Project %q", agent, req.Context.Project),
+		}
+	}
+
+	c := s.getConn(agent)
+	if c == nil {
+		return errored, fmt.Errorf("agent %q is not connected", agent)
+	}
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return errored, fmt.Errorf("error encoding request for agent %q: %w", agent, err)
+	}
+	id := uuid.NewString()
+	resCh := c.expect(id)
+	defer c.forget(id)
+
+	select {
+	case c.outbox <- &agentv1alpha1.ConnectResponse{
+		Message: &agentv1alpha1.ConnectResponse_StepRequest{
+			StepRequest: &agentv1alpha1.StepRequest{Id: id, Request: payload},
+		},
+	}:
+	case <-c.done:
+		return errored, fmt.Errorf("agent %q disconnected", agent)
+	case <-ctx.Done():
+		return errored, ctx.Err()
+	}
+
+	var res *agentv1alpha1.StepResult
+	select {
+	case res = <-resCh:
+	case <-c.done:
+		return errored, fmt.Errorf("agent %q disconnected while executing step", agent)
+	case <-ctx.Done():
+		// Nothing is waiting for the result anymore, so the agent is asked to
+		// stop executing the step.
+		select {
+		case c.outbox <- &agentv1alpha1.ConnectResponse{
+			Message: &agentv1alpha1.ConnectResponse_CancelStep{
+				CancelStep: &agentv1alpha1.CancelStep{Id: id},
+			},
+		}:
+		case <-c.done:
+		}
+		return errored, ctx.Err()
+	}
+
+	result := promotion.StepResult{}
+	if err = json.Unmarshal(res.GetResult(), &result); err != nil {
+		return errored, fmt.Errorf("error decoding result from agent %q: %w", agent, err)
+	}
+	if res.GetError() == "" {
+		return result, nil
+	}
+	err = errors.New(res.GetError())
+	if res.GetTerminal() {
+		err = &promotion.TerminalError{Err: err}
+	}
+	return result, err
+}
+
+// checkAgentVersion returns an error if an agent of the provided version may
+// not connect to a controller of the provided version. Agents must be of the
+// same major and minor version as the controller, as the requests and results
+// they exchange are only guaranteed to be compatible within a minor version.
+// Development builds of the controller accept agents of any version.
+func checkAgentVersion(serverVersion, agentVersion string) error {
+	serverSemver, err := semver.NewVersion(serverVersion)
+	if err != nil {
+		return nil
+	}
+	agentSemver, err := semver.NewVersion(agentVersion)
+	if err != nil {
+		return fmt.Errorf(
+			"agent version %q is not supported by controller version %s",
+			agentVersion, serverVersion,
+		)
+	}
+	if agentSemver.Major() != serverSemver.Major() || agentSemver.Minor() != serverSemver.Minor() {
+		return fmt.Errorf(
+			"agent version %s is not supported by controller version %s; "+
+				"agents must be of the same minor version as the controller",
+			agentVersion, serverVersion,
+		)
+	}
+	return nil
+}
+
+// authenticate returns the name of the agent the bearer token in the provided
+// headers belongs to.
+func (s *Server) authenticate(header http.Header) (string, error) {
+	token, ok := strings.CutPrefix(header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		return "", errors.New("no bearer token provided")
+	}
+	entries, err := os.ReadDir(s.cfg.TokensDir)
+	if err != nil {
+		return "", fmt.Errorf("error reading agent tokens: %w", err)
+	}
+	for _, entry := range entries {
+		// Mounted Secrets contain hidden files and directories (e.g. "..data")
+		// besides their keys.
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		expected, err := os.ReadFile(filepath.Join(s.cfg.TokensDir, entry.Name()))
+		if err != nil {
+			continue
+		}
+		expected = []byte(strings.TrimSpace(string(expected)))
+		if len(expected) > 0 && subtle.ConstantTimeCompare(expected, []byte(token)) == 1 {
+			return entry.Name(), nil
+		}
+	}
+	return "", errors.New("invalid bearer token")
+}
+
+// register registers the provided connection for the agent with the provided
+// name, closing any connection previously registered for it.
+func (s *Server) register(name string, c *conn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if old, ok := s.conns[name]; ok {
+		old.close()
+	}
+	s.conns[name] = c
+}
+
+// unregister closes the provided connection and unregisters it if it is still
+// the connection registered for the agent with the provided name.
+func (s *Server) unregister(name string, c *conn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c.close()
+	if s.conns[name] == c {
+		delete(s.conns, name)
+	}
+}
+
+// getConn returns the connection of the agent with the provided name, or nil
+// if that agent is not connected.
+func (s *Server) getConn(name string) *conn {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.conns[name]
+}
+
+// receive delivers the results received on the provided stream to the
+// requests awaiting them until the stream ends.
+func (c *conn) receive(
+	stream *connect.BidiStream[agentv1alpha1.ConnectRequest, agentv1alpha1.ConnectResponse],
+) error {
+	for {
+		msg, err := stream.Receive()
+		if err != nil {
+			return err
+		}
+		res := msg.GetStepResult()
+		if res == nil {
+			continue
+		}
+		c.mu.Lock()
+		resCh, ok := c.pending[res.GetId()]
+		c.mu.Unlock()
+		if ok {
+			select {
+			case resCh <- res:
+			default:
+				// A result was already delivered for this request.
+			}
+		}
+	}
+}
+
+// expect registers a request with the provided ID as awaiting its result and
+// returns the channel on which the result will be delivered.
+func (c *conn) expect(id string) <-chan *agentv1alpha1.StepResult {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	resCh := make(chan *agentv1alpha1.StepResult, 1)
+	c.pending[id] = resCh
+	return resCh
+}
+
+// forget unregisters the request with the provided ID.
+func (c *conn) forget(id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.pending, id)
+}
+
+// close closes the connection.
+func (c *conn) close() {
+	c.closeOnce.Do(func() {
+		close(c.done)
+	})
+}
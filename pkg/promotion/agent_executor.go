@@ -0,0 +1,61 @@
+package promotion
+
+import (
+	"context"
+	"fmt"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// StepDispatcher is an interface for components that dispatch the execution
+// of Steps to agents running out-of-process.
+type StepDispatcher interface {
+	// DispatchStep executes the provided StepExecutionRequest using the agent
+	// with the provided name and returns the result once the agent reports it.
+	DispatchStep(
+		ctx context.Context,
+		agent string,
+		req StepExecutionRequest,
+	) (StepResult, error)
+}
+
+// AgentStepExecutor is an implementation of StepExecutor that dispatches the
+// execution of Steps to the agent named by each StepExecutionRequest. Requests
+// that do not name an agent are executed by another StepExecutor.
+type AgentStepExecutor struct {
+	local      StepExecutor
+	dispatcher StepDispatcher
+}
+
+// NewAgentStepExecutor returns an AgentStepExecutor that dispatches requests
+// naming an agent using the provided StepDispatcher and executes all other
+// requests using the provided StepExecutor. If the provided StepDispatcher is
+// nil, requests naming an agent result in an error.
+func NewAgentStepExecutor(
+	local StepExecutor,
+	dispatcher StepDispatcher,
+) *AgentStepExecutor {
+	return &AgentStepExecutor{
+		local:      local,
+		dispatcher: dispatcher,
+	}
+}
+
+// ExecuteStep implements StepExecutor.
+func (e *AgentStepExecutor) ExecuteStep(
+	ctx context.Context,
+	req StepExecutionRequest,
+) (StepResult, error) {
+	if req.Agent == "" {
+		return e.local.ExecuteStep(ctx, req)
+	}
+	if e.dispatcher == nil {
+		return StepResult{Status: kargoapi.PromotionStepStatusErrored}, &TerminalError{
+			Err: fmt.Errorf(
+				"step %q must be executed by agent %q, but agents are not enabled",
+				req.Step.Alias, req.Agent,
+			),
+		}
+	}
+	return e.dispatcher.DispatchStep(ctx, req.Agent, req)
+}
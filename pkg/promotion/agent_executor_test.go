@@ -0,0 +1,77 @@
+package promotion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+type fakeStepExecutor struct {
+	result StepResult
+	err    error
+}
+
+func (e *fakeStepExecutor) ExecuteStep(context.Context, StepExecutionRequest) (StepResult, error) {
+	return e.result, e.err
+}
+
+type fakeStepDispatcher struct {
+	agent  string
+	result StepResult
+	err    error
+}
+
+func (d *fakeStepDispatcher) DispatchStep(
+	_ context.Context,
+	agent string,
+	_ StepExecutionRequest,
+) (StepResult, error) {
+	d.agent = agent
+	return d.result, d.err
+}
+
+func TestAgentStepExecutor_ExecuteStep(t *testing.T) {
+	local := &fakeStepExecutor{
+		result: StepResult{Status: kargoapi.PromotionStepStatusSucceeded, Message: "local"},
+	}
+
+	t.Run("request without agent is executed locally", func(t *testing.T) {
+		dispatcher := &fakeStepDispatcher{}
+		res, err := NewAgentStepExecutor(local, dispatcher).ExecuteStep(
+			context.Background(),
+			StepExecutionRequest{},
+		)
+		require.NoError(t, err)
+		require.Equal(t, "local", res.Message)
+		require.Empty(t, dispatcher.agent)
+	})
+
+	t.Run("request with agent is dispatched", func(t *testing.T) {
+		dispatcher := &fakeStepDispatcher{
+			result: StepResult{Status: kargoapi.PromotionStepStatusSucceeded, Message: "remote"},
+		}
+		res, err := NewAgentStepExecutor(local, dispatcher).ExecuteStep(
+			context.Background(),
+			StepExecutionRequest{Agent: "fake-agent"},
+		)
+		require.NoError(t, err)
+		require.Equal(t, "remote", res.Message)
+		require.Equal(t, "fake-agent", dispatcher.agent)
+	})
+
+	t.Run("request with agent when agents are not enabled", func(t *testing.T) {
+		res, err := NewAgentStepExecutor(local, nil).ExecuteStep(
+			context.Background(),
+			StepExecutionRequest{
+				Step:  Step{Alias: "fake-step"},
+				Agent: "fake-agent",
+			},
+		)
+		require.ErrorContains(t, err, "agents are not enabled")
+		require.True(t, IsTerminal(err))
+		require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+	})
+}
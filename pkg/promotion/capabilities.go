@@ -12,5 +12,6 @@ import (
 type StepRunnerCapabilities struct {
 	KargoClient  client.Client
 	ArgoCDClient client.Client
+	FluxClient   client.Client
 	CredsDB      credentials.Database
 }
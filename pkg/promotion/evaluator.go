@@ -99,9 +99,10 @@ func ExprEnvWithTaskOutputs(alias string, outputs State) ExprEnvOption {
 func (p *StepEvaluator) BuildExprEnv(promoCtx Context, opts ...ExprEnvOption) map[string]any {
 	env := map[string]any{
 		"ctx": map[string]any{
-			"project":   promoCtx.Project,
-			"promotion": promoCtx.Promotion,
-			"stage":     promoCtx.Stage,
+			"project":      promoCtx.Project,
+			"promotion":    promoCtx.Promotion,
+			"verification": promoCtx.Verification,
+			"stage":        promoCtx.Stage,
 			"targetFreight": map[string]any{
 				"name": promoCtx.TargetFreightRef.Name,
 				"origin": map[string]any{
@@ -318,6 +319,7 @@ func (p *StepEvaluator) BuildStepContext(
 		Project:          promoCtx.Project,
 		Stage:            promoCtx.Stage,
 		Promotion:        promoCtx.Promotion,
+		Verification:     promoCtx.Verification,
 		PromotionActor:   promoCtx.Actor,
 		FreightRequests:  freightRequests,
 		Freight:          *promoCtx.Freight.DeepCopy(),
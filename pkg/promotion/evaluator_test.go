@@ -38,9 +38,10 @@ func TestStepEvaluator_BuildExprEnv(t *testing.T) {
 			},
 			expected: map[string]any{
 				"ctx": map[string]any{
-					"project":   "test-project",
-					"promotion": "test-promotion",
-					"stage":     "test-stage",
+					"project":      "test-project",
+					"promotion":    "test-promotion",
+					"verification": "",
+					"stage":        "test-stage",
 					"targetFreight": map[string]any{
 						"name": "test-freight",
 						"origin": map[string]any{
@@ -78,9 +79,10 @@ func TestStepEvaluator_BuildExprEnv(t *testing.T) {
 			},
 			expected: map[string]any{
 				"ctx": map[string]any{
-					"project":   "test-project",
-					"promotion": "test-promotion",
-					"stage":     "test-stage",
+					"project":      "test-project",
+					"promotion":    "test-promotion",
+					"verification": "",
+					"stage":        "test-stage",
 					"targetFreight": map[string]any{
 						"name": "test-freight",
 						"origin": map[string]any{
@@ -140,9 +142,10 @@ func TestStepEvaluator_BuildExprEnv(t *testing.T) {
 			},
 			expected: map[string]any{
 				"ctx": map[string]any{
-					"project":   "test-project",
-					"promotion": "test-promotion",
-					"stage":     "test-stage",
+					"project":      "test-project",
+					"promotion":    "test-promotion",
+					"verification": "",
+					"stage":        "test-stage",
 					"targetFreight": map[string]any{
 						"name": "test-freight",
 						"origin": map[string]any{
@@ -191,9 +194,10 @@ func TestStepEvaluator_BuildExprEnv(t *testing.T) {
 			},
 			expected: map[string]any{
 				"ctx": map[string]any{
-					"project":   "test-project",
-					"promotion": "test-promotion",
-					"stage":     "test-stage",
+					"project":      "test-project",
+					"promotion":    "test-promotion",
+					"verification": "",
+					"stage":        "test-stage",
 					"targetFreight": map[string]any{
 						"name": "test-freight",
 						"origin": map[string]any{
@@ -241,9 +245,10 @@ func TestStepEvaluator_BuildExprEnv(t *testing.T) {
 			},
 			expected: map[string]any{
 				"ctx": map[string]any{
-					"project":   "test-project",
-					"promotion": "test-promotion",
-					"stage":     "test-stage",
+					"project":      "test-project",
+					"promotion":    "test-promotion",
+					"verification": "",
+					"stage":        "test-stage",
 					"targetFreight": map[string]any{
 						"name": "test-freight",
 						"origin": map[string]any{
@@ -289,9 +294,10 @@ func TestStepEvaluator_BuildExprEnv(t *testing.T) {
 			},
 			expected: map[string]any{
 				"ctx": map[string]any{
-					"project":   "test-project",
-					"promotion": "test-promotion",
-					"stage":     "test-stage",
+					"project":      "test-project",
+					"promotion":    "test-promotion",
+					"verification": "",
+					"stage":        "test-stage",
 					"targetFreight": map[string]any{
 						"name": "test-freight",
 						"origin": map[string]any{
@@ -349,9 +355,10 @@ func TestStepEvaluator_BuildExprEnv(t *testing.T) {
 			},
 			expected: map[string]any{
 				"ctx": map[string]any{
-					"project":   "test-project",
-					"promotion": "test-promotion",
-					"stage":     "test-stage",
+					"project":      "test-project",
+					"promotion":    "test-promotion",
+					"verification": "",
+					"stage":        "test-stage",
 					"targetFreight": map[string]any{
 						"name": "test-freight",
 						"origin": map[string]any{
@@ -392,9 +399,10 @@ func TestStepEvaluator_BuildExprEnv(t *testing.T) {
 			},
 			expected: map[string]any{
 				"ctx": map[string]any{
-					"project":   "",
-					"promotion": "",
-					"stage":     "",
+					"project":      "",
+					"promotion":    "",
+					"verification": "",
+					"stage":        "",
 					"targetFreight": map[string]any{
 						"name": "",
 						"origin": map[string]any{
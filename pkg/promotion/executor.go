@@ -10,6 +10,9 @@ import (
 type StepExecutionRequest struct {
 	Context StepContext `json:"context"`
 	Step    Step        `json:"step"`
+	// Agent is the name of the agent that should execute the Step. If empty,
+	// the Step is executed in-process.
+	Agent string `json:"agent,omitempty"`
 }
 
 // StepExecutor defines the interface for executing a single Step.
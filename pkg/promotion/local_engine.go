@@ -45,11 +45,16 @@ type LocalEngine struct {
 }
 
 // NewLocalEngine returns an implementation of the Engine interface that
-// uses built-in StepRunners locally.
+// uses built-in StepRunners locally. The steps of a promotion process that
+// names an agent are instead dispatched to that agent using the provided
+// StepDispatcher, which may be nil if agents are not enabled.
 func NewLocalEngine(
 	kargoClient client.Client,
 	argocdClient client.Client,
+	fluxClient client.Client,
 	credsDB credentials.Database,
+	dispatcher StepDispatcher,
+	logStore StepLogStore,
 	cacheFunc ExprDataCacheFn,
 ) *LocalEngine {
 	return &LocalEngine{
@@ -57,7 +62,10 @@ func NewLocalEngine(
 			stepRunnerRegistry,
 			kargoClient,
 			argocdClient,
+			fluxClient,
 			credsDB,
+			dispatcher,
+			logStore,
 			cacheFunc,
 		),
 	}
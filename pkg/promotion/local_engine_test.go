@@ -125,6 +125,9 @@ func TestSimpleEngine_Promote(t *testing.T) {
 					fake.NewClientBuilder().Build(),
 					nil,
 					nil,
+					nil,
+					nil,
+					nil,
 				),
 			}
 
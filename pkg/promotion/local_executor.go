@@ -8,6 +8,7 @@ import (
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/pkg/credentials"
+	"github.com/akuity/kargo/pkg/logging"
 )
 
 // LocalStepExecutor is a concrete implementation of StepExecutor that
@@ -17,6 +18,7 @@ type LocalStepExecutor struct {
 
 	kargoClient  client.Client
 	argoCDClient client.Client
+	fluxClient   client.Client
 	credsDB      credentials.Database
 }
 
@@ -25,13 +27,14 @@ type LocalStepExecutor struct {
 // execute steps in the promotion process.
 func NewLocalStepExecutor(
 	registry StepRunnerRegistry,
-	kargoClient, argoCDClient client.Client,
+	kargoClient, argoCDClient, fluxClient client.Client,
 	credsDB credentials.Database,
 ) *LocalStepExecutor {
 	return &LocalStepExecutor{
 		registry:     registry,
 		kargoClient:  kargoClient,
 		argoCDClient: argoCDClient,
+		fluxClient:   fluxClient,
 		credsDB:      credsDB,
 	}
 }
@@ -57,6 +60,8 @@ func (e *LocalStepExecutor) ExecuteStep(
 			capabilities.KargoClient = e.kargoClient
 		case StepCapabilityAccessArgoCD:
 			capabilities.ArgoCDClient = e.argoCDClient
+		case StepCapabilityAccessFlux:
+			capabilities.FluxClient = e.fluxClient
 		case StepCapabilityAccessCredentials:
 			capabilities.CredsDB = e.credsDB
 		}
@@ -64,6 +69,15 @@ func (e *LocalStepExecutor) ExecuteStep(
 
 	runner := registration.Factory(capabilities)
 
+	// Capture anything logged during the step's execution so that it can be
+	// made available to users.
+	stepLog := NewStepLog(DefaultMaxStepLogBytes)
+	logger := logging.LoggerFromContext(ctx).
+		WithValues("step", req.Step.Alias).
+		WithWriter(stepLog, logging.DebugLevel)
+	ctx = logging.ContextWithLogger(ContextWithStepLog(ctx, stepLog), logger)
+	logger.Debug("executing step", "kind", req.Step.Kind)
+
 	func() {
 		defer func() {
 			if r := recover(); r != nil {
@@ -80,8 +94,16 @@ func (e *LocalStepExecutor) ExecuteStep(
 	}()
 
 	if err != nil {
+		logger.Debug("step execution failed", "error", err.Error())
 		err = fmt.Errorf("error running step %q: %w", req.Step.Alias, err)
+	} else {
+		logger.Debug(
+			"step execution finished",
+			"status", result.Status,
+			"message", result.Message,
+		)
 	}
+	result.Logs = stepLog.Bytes()
 
 	return result, err
 }
@@ -3,6 +3,7 @@ package promotion
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -10,6 +11,7 @@ import (
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/pkg/credentials"
+	"github.com/akuity/kargo/pkg/logging"
 )
 
 func TestNewLocalStepExecutor(t *testing.T) {
@@ -23,12 +25,14 @@ func TestNewLocalStepExecutor(t *testing.T) {
 
 	kargoClient := fake.NewClientBuilder().Build()
 	argoCDClient := fake.NewClientBuilder().Build()
+	fluxClient := fake.NewClientBuilder().Build()
 	credsDB := &credentials.FakeDB{}
 
 	executor := NewLocalStepExecutor(
 		registry,
 		kargoClient,
 		argoCDClient,
+		fluxClient,
 		credsDB,
 	)
 
@@ -37,6 +41,7 @@ func TestNewLocalStepExecutor(t *testing.T) {
 	require.Equal(t, registry, executor.registry)
 	require.Equal(t, kargoClient, executor.kargoClient)
 	require.Equal(t, argoCDClient, executor.argoCDClient)
+	require.Equal(t, fluxClient, executor.fluxClient)
 	require.Equal(t, credsDB, executor.credsDB)
 }
 
@@ -83,10 +88,39 @@ func TestLocalStepExecutor_ExecuteStep(t *testing.T) {
 				},
 			},
 			assertions: func(t *testing.T, result StepResult, err error) {
-				require.Equal(t, StepResult{
-					Status: kargoapi.PromotionStepStatusSucceeded,
-				}, result)
 				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusSucceeded, result.Status)
+				require.Contains(t, string(result.Logs), `"msg"="step execution finished"`)
+			},
+		},
+		{
+			name: "step output is captured",
+			registry: StepRunnerRegistry{
+				"test-step": StepRunnerRegistration{
+					Factory: func(_ StepRunnerCapabilities) StepRunner {
+						return &MockStepRunner{
+							RunFunc: func(ctx context.Context, _ *StepContext) (StepResult, error) {
+								logging.LoggerFromContext(ctx).Debug("doing work", "file", "values.yaml")
+								_, _ = fmt.Fprintln(StepLogFromContext(ctx), "raw output")
+								return StepResult{Status: kargoapi.PromotionStepStatusSucceeded}, nil
+							},
+						}
+					},
+				},
+			},
+			request: StepExecutionRequest{
+				Context: StepContext{},
+				Step: Step{
+					Kind:  "test-step",
+					Alias: "test-alias",
+				},
+			},
+			assertions: func(t *testing.T, result StepResult, err error) {
+				require.NoError(t, err)
+				logs := string(result.Logs)
+				require.Contains(t, logs, `"msg"="executing step" "kind"="test-step"`)
+				require.Contains(t, logs, `"msg"="doing work" "file"="values.yaml"`)
+				require.Contains(t, logs, "raw output\n")
 			},
 		},
 		{
@@ -110,10 +144,9 @@ func TestLocalStepExecutor_ExecuteStep(t *testing.T) {
 				},
 			},
 			assertions: func(t *testing.T, result StepResult, err error) {
-				require.Equal(t, StepResult{
-					Status: kargoapi.PromotionStepStatusErrored,
-				}, result)
 				require.ErrorContains(t, err, "step execution failed")
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, result.Status)
+				require.Contains(t, string(result.Logs), `"error"="step execution failed"`)
 			},
 		},
 		{
@@ -136,10 +169,9 @@ func TestLocalStepExecutor_ExecuteStep(t *testing.T) {
 				},
 			},
 			assertions: func(t *testing.T, result StepResult, err error) {
-				require.Equal(t, StepResult{
-					Status: kargoapi.PromotionStepStatusErrored,
-				}, result)
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, result.Status)
 				require.ErrorContains(t, err, "step panicked: step runner panicked")
+				require.Contains(t, string(result.Logs), "step panicked: step runner panicked")
 
 				var terminalErr *TerminalError
 				require.ErrorAs(t, err, &terminalErr)
@@ -155,7 +187,7 @@ func TestLocalStepExecutor_ExecuteStep(t *testing.T) {
 				registry.Register(k, v)
 			}
 
-			executor := NewLocalStepExecutor(registry, nil, nil, nil)
+			executor := NewLocalStepExecutor(registry, nil, nil, nil, nil)
 			result, err := executor.ExecuteStep(context.Background(), tt.request)
 			tt.assertions(t, result, err)
 		})
@@ -12,6 +12,7 @@ import (
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/pkg/credentials"
 	"github.com/akuity/kargo/pkg/health"
+	"github.com/akuity/kargo/pkg/logging"
 )
 
 // LocalOrchestrator is an implementation of the Orchestrator interface that
@@ -21,21 +22,38 @@ type LocalOrchestrator struct {
 	executor  StepExecutor
 	registry  StepRunnerRegistry
 	client    client.Client
+	logStore  StepLogStore
 	cacheFunc ExprDataCacheFn
 }
 
 // NewLocalOrchestrator creates a new LocalOrchestrator instance with the
-// provided client, step runner registry, and cache function.
+// provided client, step runner registry, and cache function. If the provided
+// StepLogStore is nil, logs captured during the execution of steps are
+// discarded. Steps of a promotion process which names an agent are dispatched
+// to that agent using the provided StepDispatcher, which may be nil if agents
+// are not enabled.
 func NewLocalOrchestrator(
 	registry StepRunnerRegistry,
-	kargoClient, argoCDClient client.Client,
+	kargoClient, argoCDClient, fluxClient client.Client,
 	credsDB credentials.Database,
+	dispatcher StepDispatcher,
+	logStore StepLogStore,
 	cacheFunc ExprDataCacheFn,
 ) *LocalOrchestrator {
 	return &LocalOrchestrator{
-		executor:  NewLocalStepExecutor(registry, kargoClient, argoCDClient, credsDB),
+		executor: NewAgentStepExecutor(
+			NewLocalStepExecutor(
+				registry,
+				kargoClient,
+				argoCDClient,
+				fluxClient,
+				credsDB,
+			),
+			dispatcher,
+		),
 		registry:  registry,
 		client:    kargoClient,
+		logStore:  logStore,
 		cacheFunc: cacheFunc,
 	}
 }
@@ -139,11 +157,22 @@ func (o *LocalOrchestrator) ExecuteSteps(
 			continue
 		}
 
-		// Execute the step.
-		result, err := o.executor.ExecuteStep(ctx, StepExecutionRequest{
+		// Execute the step. The step's context expires once the step's timeout
+		// has elapsed, so that a step which is still waiting on some external
+		// process can clean up after itself.
+		execCtx, cancel := ctx, context.CancelFunc(func() {})
+		if timeout := step.Retry.GetTimeout(registration.Metadata.DefaultTimeout); timeout > 0 {
+			execCtx, cancel = context.WithDeadline(ctx, meta.StartedAt.Add(timeout))
+		}
+		result, err := o.executor.ExecuteStep(execCtx, StepExecutionRequest{
 			Context: *stepCtx,
 			Step:    step,
+			Agent:   promoCtx.Agent,
 		})
+		cancel()
+
+		// Persist any logs captured during the step's execution.
+		o.storeStepLogs(ctx, promoCtx, i, result.Logs)
 
 		// Propagate the step output to the state.
 		o.propagateStepOutput(promoCtx, step, registration.Metadata, result)
@@ -193,6 +222,33 @@ func (o *LocalOrchestrator) ExecuteSteps(
 	}, nil
 }
 
+// storeStepLogs persists the provided logs for the step at the provided index,
+// if a StepLogStore is configured. The logs of a Stage's verification steps are
+// not stored, as there is no Promotion to store them with. Failure to store
+// logs is logged, but is not permitted to affect the outcome of the Promotion.
+func (o *LocalOrchestrator) storeStepLogs(
+	ctx context.Context,
+	promoCtx Context,
+	stepIndex int64,
+	logs []byte,
+) {
+	if o.logStore == nil || len(logs) == 0 || promoCtx.Promotion == "" {
+		return
+	}
+	if err := o.logStore.Append(
+		ctx,
+		promoCtx.Project,
+		promoCtx.Promotion,
+		stepIndex,
+		logs,
+	); err != nil {
+		logging.LoggerFromContext(ctx).Error(
+			err, "error storing step logs",
+			"step", stepIndex,
+		)
+	}
+}
+
 func (o *LocalOrchestrator) propagateStepOutput(
 	promoCtx Context,
 	step Step,
@@ -311,6 +311,33 @@ func TestLocalOrchestrator_ExecuteSteps(t *testing.T) {
 				assert.Equal(t, uint32(1), result.StepExecutionMetadata[0].ErrorCount)
 			},
 		},
+		{
+			name: "step context expires once timeout elapsed",
+			promoCtx: Context{
+				StepExecutionMetadata: kargoapi.StepExecutionMetadataList{{
+					// Start time is set to an hour ago
+					StartedAt: ptr.To(metav1.NewTime(time.Now().Add(-time.Hour))),
+				}},
+			},
+			steps: []Step{
+				{
+					Kind: "deadline-step",
+					Retry: &kargoapi.PromotionStepRetry{
+						Timeout: &metav1.Duration{
+							Duration: time.Hour,
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, result Result) {
+				assert.Equal(t, kargoapi.PromotionPhaseErrored, result.Status)
+				assert.Contains(t, result.Message, context.DeadlineExceeded.Error())
+
+				require.Len(t, result.StepExecutionMetadata, 1)
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, result.StepExecutionMetadata[0].Status)
+				assert.NotNil(t, result.StepExecutionMetadata[0].FinishedAt)
+			},
+		},
 		{
 			name: "step is still running; timeout elapsed",
 			promoCtx: Context{
@@ -565,6 +592,18 @@ func TestLocalOrchestrator_ExecuteSteps(t *testing.T) {
 						}
 					},
 				},
+				"deadline-step": StepRunnerRegistration{
+					Factory: func(_ StepRunnerCapabilities) StepRunner {
+						return &MockStepRunner{
+							RunFunc: func(ctx context.Context, _ *StepContext) (StepResult, error) {
+								if err := ctx.Err(); err != nil {
+									return StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+								}
+								return StepResult{Status: kargoapi.PromotionStepStatusRunning}, nil
+							},
+						}
+					},
+				},
 				"context-waiter": StepRunnerRegistration{
 					Factory: func(_ StepRunnerCapabilities) StepRunner {
 						return &MockStepRunner{
@@ -594,6 +633,9 @@ func TestLocalOrchestrator_ExecuteSteps(t *testing.T) {
 				fake.NewClientBuilder().Build(),
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
 			)
 
 			tt.promoCtx.WorkDir = t.TempDir()
@@ -37,6 +37,10 @@ type Context struct {
 	Stage string
 	// Promotion is the name of the Promotion.
 	Promotion string
+	// Verification is the ID of the verification that the Steps are executed
+	// for. It is set instead of Promotion when the Steps are a Stage's
+	// verification steps.
+	Verification string
 	// FreightRequests is the list of Freight from various origins that is
 	// requested by the Stage targeted by the Promotion.
 	//
@@ -67,6 +71,9 @@ type Context struct {
 	Vars []kargoapi.ExpressionVariable
 	// Actor is the name of the actor triggering the Promotion.
 	Actor string
+	// Agent is the name of the agent that executes the Steps. If empty, the
+	// Steps are executed in-process.
+	Agent string
 
 	// currentStepMetadata is a pointer to the StepMetadata for the
 	// current step being executed. It is used to track the execution state of
@@ -148,6 +155,7 @@ func NewContext(
 		StepExecutionMetadata: promo.Status.StepExecutionMetadata,
 		State:                 State(promo.Status.GetState()),
 		Vars:                  promo.Spec.Vars,
+		Agent:                 stage.Spec.Agent,
 	}
 
 	for _, opt := range opts {
@@ -213,6 +221,7 @@ func (c *Context) DeepCopy() Context {
 		Project:               c.Project,
 		Stage:                 c.Stage,
 		Promotion:             c.Promotion,
+		Verification:          c.Verification,
 		Freight:               *c.Freight.DeepCopy(),
 		TargetFreightRef:      *c.TargetFreightRef.DeepCopy(),
 		StartFromStep:         c.StartFromStep,
@@ -220,6 +229,7 @@ func (c *Context) DeepCopy() Context {
 		State:                 c.State.DeepCopy(),
 		Vars:                  slices.Clone(c.Vars),
 		Actor:                 c.Actor,
+		Agent:                 c.Agent,
 	}
 
 	if c.FreightRequests != nil {
@@ -266,8 +276,16 @@ type Step struct {
 // NewSteps creates a slice of Steps from the provided Promotion. Each Step in
 // the slice corresponds to a step defined in the Promotion's spec.
 func NewSteps(promo *kargoapi.Promotion) []Step {
-	result := make([]Step, len(promo.Spec.Steps))
-	for i, step := range promo.Spec.Steps {
+	return NewStepsFromPromotionSteps(promo.Spec.Steps)
+}
+
+// NewStepsFromPromotionSteps creates a slice of Steps from the provided
+// PromotionSteps. This is useful for executing steps that are not defined in
+// a Promotion's spec, such as a Stage's verification steps. Any PromotionSteps
+// referencing a PromotionTask are expected to have been inflated already.
+func NewStepsFromPromotionSteps(steps []kargoapi.PromotionStep) []Step {
+	result := make([]Step, len(steps))
+	for i, step := range steps {
 		var rawConfig []byte
 		if step.Config != nil {
 			rawConfig = step.Config.Raw
@@ -373,6 +391,10 @@ type StepContext struct {
 	Stage string
 	// Promotion is the name of the Promotion.
 	Promotion string
+	// Verification is the ID of the verification that the Step is executed
+	// for. It is set instead of Promotion when the Step is one of a Stage's
+	// verification steps.
+	Verification string
 	// PromotionActor is the name of the actor triggering the Promotion.
 	PromotionActor string
 	// FreightRequests is the list of Freight from various origins that is
@@ -401,6 +423,16 @@ type StepContext struct {
 	TargetFreightRef kargoapi.FreightReference
 }
 
+// ExecutionID returns an identifier of the process that the Step is executed
+// as part of. This is the name of the Promotion or, for the verification steps
+// of a Stage, the ID of the verification.
+func (s *StepContext) ExecutionID() string {
+	if s.Verification != "" {
+		return s.Verification
+	}
+	return s.Promotion
+}
+
 // StepResult represents the results of a single Step of a user-defined promotion
 // process executed by a StepRunner.
 type StepResult struct {
@@ -421,4 +453,9 @@ type StepResult struct {
 	// itself to be in a Running status should be retried. Note: This is unrelated
 	// to retrying upon non-terminal failures.
 	RetryAfter *time.Duration
+	// Logs is the (bounded) log output captured during the execution of a Step.
+	// StepRunners need not set this themselves. It is populated by the
+	// StepExecutor with anything logged or written to the io.Writer returned by
+	// StepLogFromContext during the Step's execution.
+	Logs []byte
 }
@@ -653,6 +653,7 @@ func TestContext_DeepCopy(t *testing.T) {
 				Promotion:     "test-promotion",
 				StartFromStep: 2,
 				Actor:         "test-actor",
+				Agent:         "test-agent",
 				FreightRequests: []kargoapi.FreightRequest{
 					{
 						Origin: kargoapi.FreightOrigin{
@@ -719,6 +720,7 @@ func TestContext_DeepCopy(t *testing.T) {
 				assert.Equal(t, original.Promotion, deepCopy.Promotion)
 				assert.Equal(t, original.StartFromStep, deepCopy.StartFromStep)
 				assert.Equal(t, original.Actor, deepCopy.Actor)
+				assert.Equal(t, original.Agent, deepCopy.Agent)
 
 				// Verify FreightRequests is deep copied
 				assert.Equal(t, len(original.FreightRequests), len(deepCopy.FreightRequests))
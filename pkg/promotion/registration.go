@@ -67,6 +67,9 @@ const (
 	// repository credentials through a lookup by credential type and repository
 	// URL.
 	StepCapabilityAccessCredentials StepRunnerCapability = "access-credentials"
+	// StepCapabilityAccessFlux represents the capability of interacting with
+	// Flux resources via a Kubernetes client.
+	StepCapabilityAccessFlux StepRunnerCapability = "access-flux"
 	// StepCapabilityTaskOutputPropagation represents the capability of a step,
 	// when executed as part of a task, to propagate its output directly to the
 	// Promotion's shared state, in addition to the task's own state.
@@ -0,0 +1,365 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	libargocd "github.com/akuity/kargo/pkg/argocd"
+	argocd "github.com/akuity/kargo/pkg/controller/argocd/api/v1alpha1"
+	checkers "github.com/akuity/kargo/pkg/health/checker/builtin"
+	"github.com/akuity/kargo/pkg/logging"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+const applicationSetKind = "ApplicationSet"
+
+// updateApplicationSet applies the provided update to an Argo CD
+// ApplicationSet. Once the ApplicationSet controller has observably
+// regenerated all of the ApplicationSet's Applications, each of these is
+// synced. It returns the phases of the relevant operations, if known, along
+// with health checks for all generated Applications.
+func (a *argocdUpdater) updateApplicationSet(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	update *builtin.ArgoCDAppSetUpdate,
+) ([]argocd.OperationPhase, []checkers.ArgoCDAppHealthCheck, error) {
+	appSetKey := client.ObjectKey{
+		Namespace: update.Namespace,
+		Name:      update.Name,
+	}
+	if appSetKey.Namespace == "" {
+		appSetKey.Namespace = libargocd.Namespace()
+	}
+	logger := logging.LoggerFromContext(ctx).WithValues(
+		"appSet", appSetKey.Name, "namespace", appSetKey.Namespace,
+	)
+
+	appSet, err := a.getAuthorizedApplicationSetFn(ctx, stepCtx, appSetKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf(
+			"error getting Argo CD ApplicationSet %q in namespace %q: %w",
+			appSetKey.Name, appSetKey.Namespace, err,
+		)
+	}
+
+	desiredSpec, err := a.buildDesiredApplicationSetSpec(stepCtx, update, appSet)
+	if err != nil {
+		return nil, nil, fmt.Errorf(
+			"error building desired spec for Argo CD ApplicationSet %q in namespace %q: %w",
+			appSetKey.Name, appSetKey.Namespace, err,
+		)
+	}
+
+	if !equality.Semantic.DeepEqual(appSet.Object["spec"], desiredSpec) {
+		logger.Info("Argo CD ApplicationSet requires update")
+		appSet.Object["spec"] = desiredSpec
+		if err = a.argoCDAppPatchFn(ctx, appSet, func(src, dst unstructured.Unstructured) error {
+			dst.Object["spec"] = src.Object["spec"]
+			return nil
+		}); err != nil {
+			return nil, nil, fmt.Errorf(
+				"error patching Argo CD ApplicationSet %q in namespace %q: %w",
+				appSetKey.Name, appSetKey.Namespace, err,
+			)
+		}
+		// The generated Applications cannot be synced until the ApplicationSet
+		// controller has regenerated them.
+		return []argocd.OperationPhase{argocd.OperationRunning}, nil, nil
+	}
+
+	apps, err := a.getGeneratedApplicationsFn(ctx, appSet)
+	if err != nil {
+		return nil, nil, fmt.Errorf(
+			"error listing Applications generated by Argo CD ApplicationSet %q in namespace %q: %w",
+			appSetKey.Name, appSetKey.Namespace, err,
+		)
+	}
+	if len(apps) == 0 {
+		logger.Info("waiting for Argo CD ApplicationSet to generate Applications")
+		return []argocd.OperationPhase{argocd.OperationRunning}, nil, nil
+	}
+
+	// Generated Applications are synced to the revisions desired for the
+	// ApplicationSet's template sources, but are otherwise left untouched, since
+	// their sources are managed by the ApplicationSet.
+	sourceUpdates := make([]builtin.ArgoCDAppSourceUpdate, len(update.Sources))
+	for i, srcUpdate := range update.Sources {
+		sourceUpdates[i] = builtin.ArgoCDAppSourceUpdate{
+			RepoURL:         srcUpdate.RepoURL,
+			Chart:           srcUpdate.Chart,
+			DesiredRevision: srcUpdate.DesiredRevision,
+		}
+	}
+
+	phases := make([]argocd.OperationPhase, 0, len(apps))
+	healthChecks := make([]checkers.ArgoCDAppHealthCheck, 0, len(apps))
+	for i := range apps {
+		app := &apps[i]
+		appUpdate := &builtin.ArgoCDAppUpdate{
+			Name:      app.Name,
+			Namespace: app.Namespace,
+			Sources:   sourceUpdates,
+		}
+		healthChecks = append(healthChecks, checkers.ArgoCDAppHealthCheck{
+			Name:             app.Name,
+			Namespace:        app.Namespace,
+			DesiredRevisions: a.getDesiredRevisions(appUpdate, app),
+		})
+		if app.Annotations[promotionInfoKey] != stepCtx.ExecutionID() {
+			logger.Info(
+				"waiting for Argo CD ApplicationSet to regenerate Application",
+				"app", app.Name,
+			)
+			phases = append(phases, argocd.OperationRunning)
+			continue
+		}
+		phase, err := a.updateApplication(ctx, stepCtx, appUpdate, app)
+		if phase != "" {
+			phases = append(phases, phase)
+		}
+		if err != nil || phase.Failed() {
+			return phases, healthChecks, err
+		}
+	}
+	return phases, healthChecks, nil
+}
+
+// getAuthorizedApplicationSet returns an Argo CD ApplicationSet in the given
+// namespace with the given name, if it is authorized for mutation by the Kargo
+// Stage represented by stepCtx.
+func (a *argocdUpdater) getAuthorizedApplicationSet(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	appSetKey client.ObjectKey,
+) (*unstructured.Unstructured, error) {
+	appSet := &unstructured.Unstructured{}
+	appSet.SetGroupVersionKind(argocd.GroupVersion.WithKind(applicationSetKind))
+	if err := a.argocdClient.Get(ctx, appSetKey, appSet); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf(
+				"unable to find Argo CD ApplicationSet %q in namespace %q",
+				appSetKey.Name, appSetKey.Namespace,
+			)
+		}
+		return nil, fmt.Errorf(
+			"error finding Argo CD ApplicationSet %q in namespace %q: %w",
+			appSetKey.Name, appSetKey.Namespace, err,
+		)
+	}
+	if err := a.authorizeArgoCDUpdate(stepCtx, applicationSetKind, appSet); err != nil {
+		return nil, err
+	}
+	return appSet, nil
+}
+
+// getGeneratedApplications returns all Argo CD Applications owned by the
+// provided ApplicationSet.
+func (a *argocdUpdater) getGeneratedApplications(
+	ctx context.Context,
+	appSet *unstructured.Unstructured,
+) ([]argocd.Application, error) {
+	appList := &argocd.ApplicationList{}
+	if err := a.argocdClient.List(
+		ctx,
+		appList,
+		client.InNamespace(appSet.GetNamespace()),
+	); err != nil {
+		return nil, err
+	}
+	apps := make([]argocd.Application, 0, len(appList.Items))
+	for _, app := range appList.Items {
+		for _, ownerRef := range app.OwnerReferences {
+			if ownerRef.Kind == applicationSetKind && ownerRef.Name == appSet.GetName() {
+				apps = append(apps, app)
+				break
+			}
+		}
+	}
+	return apps, nil
+}
+
+// buildDesiredApplicationSetSpec returns the desired spec for an Argo CD
+// ApplicationSet, by applying the given parameter and source updates to its
+// current spec. The Application template is also annotated with the current
+// Promotion so that it can be determined when the ApplicationSet controller has
+// regenerated the ApplicationSet's Applications.
+func (a *argocdUpdater) buildDesiredApplicationSetSpec(
+	stepCtx *promotion.StepContext,
+	update *builtin.ArgoCDAppSetUpdate,
+	appSet *unstructured.Unstructured,
+) (map[string]any, error) {
+	spec, ok, err := unstructured.NestedMap(appSet.Object, "spec")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		// nolint:staticcheck
+		return nil, fmt.Errorf(
+			"Argo CD ApplicationSet %q in namespace %q has no spec",
+			appSet.GetName(), appSet.GetNamespace(),
+		)
+	}
+
+	generators, _ := spec["generators"].([]any)
+	for i := range update.Parameters {
+		paramUpdate := &update.Parameters[i]
+		updated, err := updateListGeneratorElements(generators, paramUpdate)
+		if err != nil {
+			return nil, err
+		}
+		if updated == 0 {
+			return nil, fmt.Errorf(
+				"no List generator element of Argo CD ApplicationSet %q in namespace %q "+
+					"matched update for parameter %q",
+				appSet.GetName(), appSet.GetNamespace(), paramUpdate.Key,
+			)
+		}
+	}
+
+	if len(update.Sources) > 0 {
+		if err = a.applyApplicationSetSourceUpdates(update, spec); err != nil {
+			return nil, fmt.Errorf(
+				"error updating template sources of Argo CD ApplicationSet %q in namespace %q: %w",
+				appSet.GetName(), appSet.GetNamespace(), err,
+			)
+		}
+	}
+
+	if err = unstructured.SetNestedField(
+		spec,
+		stepCtx.ExecutionID(),
+		"template", "metadata", "annotations", promotionInfoKey,
+	); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+// applyApplicationSetSourceUpdates applies the given source updates to the
+// source(s) of the Application template of the provided ApplicationSet spec.
+func (a *argocdUpdater) applyApplicationSetSourceUpdates(
+	update *builtin.ArgoCDAppSetUpdate,
+	spec map[string]any,
+) error {
+	var sources []any
+	single, isSingle, _ := unstructured.NestedMap(spec, "template", "spec", "source")
+	if isSingle {
+		sources = []any{single}
+	} else {
+		sources, _, _ = unstructured.NestedSlice(spec, "template", "spec", "sources")
+	}
+
+updateLoop:
+	for i := range update.Sources {
+		srcUpdate := &update.Sources[i]
+		for j, s := range sources {
+			srcMap, ok := s.(map[string]any)
+			if !ok {
+				continue
+			}
+			src := argocd.ApplicationSource{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(srcMap, &src); err != nil {
+				return fmt.Errorf("error converting source %d: %w", j, err)
+			}
+			desiredSrc, updateUsed := a.applyArgoCDSourceUpdateFn(
+				srcUpdate,
+				srcUpdate.DesiredRevision,
+				src,
+			)
+			if !updateUsed {
+				continue
+			}
+			desiredSrcMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&desiredSrc)
+			if err != nil {
+				return fmt.Errorf("error converting source %d: %w", j, err)
+			}
+			// Merging preserves any fields of the source that are unknown to us.
+			sources[j] = a.recursiveMerge(desiredSrcMap, srcMap)
+			continue updateLoop
+		}
+		if srcUpdate.Chart == "" {
+			return fmt.Errorf(
+				"no source matched update for source with repoURL %s",
+				srcUpdate.RepoURL,
+			)
+		}
+		return fmt.Errorf(
+			"no source matched update for source with repoURL %s and chart %q",
+			srcUpdate.RepoURL, srcUpdate.Chart,
+		)
+	}
+
+	if isSingle {
+		return unstructured.SetNestedField(spec, sources[0], "template", "spec", "source")
+	}
+	return unstructured.SetNestedSlice(spec, sources, "template", "spec", "sources")
+}
+
+// updateListGeneratorElements sets the parameter identified by the given
+// update on all matching elements of any List generators among the provided
+// ApplicationSet generators, including those nested within Matrix or Merge
+// generators. It returns the number of elements that were updated.
+func updateListGeneratorElements(
+	generators []any,
+	update *builtin.ArgoCDAppSetParameterUpdate,
+) (int, error) {
+	var updated int
+	for _, g := range generators {
+		generator, ok := g.(map[string]any)
+		if !ok {
+			continue
+		}
+		if list, ok := generator["list"].(map[string]any); ok {
+			elements, _ := list["elements"].([]any)
+			for _, e := range elements {
+				element, ok := e.(map[string]any)
+				if !ok || !listElementMatches(element, update.Match) {
+					continue
+				}
+				if err := unstructured.SetNestedField(
+					element,
+					update.Value,
+					strings.Split(update.Key, ".")...,
+				); err != nil {
+					return updated, fmt.Errorf(
+						"error setting parameter %q: %w", update.Key, err,
+					)
+				}
+				updated++
+			}
+		}
+		for _, kind := range []string{"matrix", "merge"} {
+			nested, ok := generator[kind].(map[string]any)
+			if !ok {
+				continue
+			}
+			children, _ := nested["generators"].([]any)
+			n, err := updateListGeneratorElements(children, update)
+			updated += n
+			if err != nil {
+				return updated, err
+			}
+		}
+	}
+	return updated, nil
+}
+
+// listElementMatches returns true if the provided List generator element has
+// all of the given top-level key/value pairs.
+func listElementMatches(element map[string]any, match map[string]string) bool {
+	for k, v := range match {
+		val, ok := element[k]
+		if !ok || fmt.Sprint(val) != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,660 @@
+package builtin
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	argocd "github.com/akuity/kargo/pkg/controller/argocd/api/v1alpha1"
+	checkers "github.com/akuity/kargo/pkg/health/checker/builtin"
+	"github.com/akuity/kargo/pkg/kubeclient"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+func newTestApplicationSet(annotations map[string]string, spec map[string]any) *unstructured.Unstructured {
+	appSet := &unstructured.Unstructured{Object: map[string]any{"spec": spec}}
+	appSet.SetGroupVersionKind(argocd.GroupVersion.WithKind(applicationSetKind))
+	appSet.SetNamespace("fake-namespace")
+	appSet.SetName("fake-appset")
+	appSet.SetAnnotations(annotations)
+	return appSet
+}
+
+func Test_argoCDUpdater_updateApplicationSet(t *testing.T) {
+	stepCtx := &promotion.StepContext{
+		Project:   "fake-namespace",
+		Stage:     "fake-stage",
+		Promotion: "fake-promotion",
+	}
+
+	newSpec := func(promotion string) map[string]any {
+		spec := map[string]any{
+			"generators": []any{
+				map[string]any{
+					"list": map[string]any{
+						"elements": []any{
+							map[string]any{"env": "test", "tag": "v1"},
+						},
+					},
+				},
+			},
+			"template": map[string]any{
+				"spec": map[string]any{
+					"source": map[string]any{
+						"repoURL":        "https://github.com/example/repo.git",
+						"targetRevision": "main",
+					},
+				},
+			},
+		}
+		if promotion != "" {
+			spec["template"].(map[string]any)["metadata"] = map[string]any{ // nolint: forcetypeassert
+				"annotations": map[string]any{promotionInfoKey: promotion},
+			}
+		}
+		return spec
+	}
+
+	newApp := func(promotion string) argocd.Application {
+		return argocd.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "fake-namespace",
+				Name:        "fake-app",
+				Annotations: map[string]string{promotionInfoKey: promotion},
+			},
+			Spec: argocd.ApplicationSpec{
+				Source: &argocd.ApplicationSource{
+					RepoURL:        "https://github.com/example/repo.git",
+					TargetRevision: "main",
+				},
+			},
+		}
+	}
+
+	update := &builtin.ArgoCDAppSetUpdate{
+		Name: "fake-appset",
+		Parameters: []builtin.ArgoCDAppSetParameterUpdate{{
+			Key:   "tag",
+			Value: "v1",
+		}},
+		Sources: []builtin.ArgoCDAppSourceUpdate{{
+			RepoURL:         "https://github.com/example/repo.git",
+			DesiredRevision: "fake-commit",
+		}},
+	}
+
+	testCases := []struct {
+		name       string
+		runner     *argocdUpdater
+		assertions func(*testing.T, []argocd.OperationPhase, []checkers.ArgoCDAppHealthCheck, error)
+	}{
+		{
+			name: "error getting ApplicationSet",
+			runner: &argocdUpdater{
+				getAuthorizedApplicationSetFn: func(
+					context.Context,
+					*promotion.StepContext,
+					client.ObjectKey,
+				) (*unstructured.Unstructured, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ []argocd.OperationPhase,
+				_ []checkers.ArgoCDAppHealthCheck,
+				err error,
+			) {
+				require.ErrorContains(t, err, "error getting Argo CD ApplicationSet")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "ApplicationSet requires update",
+			runner: &argocdUpdater{
+				getAuthorizedApplicationSetFn: func(
+					context.Context,
+					*promotion.StepContext,
+					client.ObjectKey,
+				) (*unstructured.Unstructured, error) {
+					return newTestApplicationSet(nil, newSpec("")), nil
+				},
+				argoCDAppPatchFn: func(
+					_ context.Context,
+					obj kubeclient.ObjectWithKind,
+					_ kubeclient.UnstructuredPatchFn,
+				) error {
+					appSet, ok := obj.(*unstructured.Unstructured)
+					if !ok {
+						return errors.New("unexpected object type")
+					}
+					annotation, _, _ := unstructured.NestedString(
+						appSet.Object,
+						"spec", "template", "metadata", "annotations", promotionInfoKey,
+					)
+					if annotation != "fake-promotion" {
+						return errors.New("template was not annotated")
+					}
+					return nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				phases []argocd.OperationPhase,
+				healthChecks []checkers.ArgoCDAppHealthCheck,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, []argocd.OperationPhase{argocd.OperationRunning}, phases)
+				require.Empty(t, healthChecks)
+			},
+		},
+		{
+			name: "Application not yet regenerated",
+			runner: &argocdUpdater{
+				getAuthorizedApplicationSetFn: func(
+					context.Context,
+					*promotion.StepContext,
+					client.ObjectKey,
+				) (*unstructured.Unstructured, error) {
+					return newTestApplicationSet(nil, newSpec("fake-promotion")), nil
+				},
+				getGeneratedApplicationsFn: func(
+					context.Context,
+					*unstructured.Unstructured,
+				) ([]argocd.Application, error) {
+					return []argocd.Application{newApp("older-promotion")}, nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				phases []argocd.OperationPhase,
+				healthChecks []checkers.ArgoCDAppHealthCheck,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, []argocd.OperationPhase{argocd.OperationRunning}, phases)
+				require.Equal(t, []checkers.ArgoCDAppHealthCheck{{
+					Name:             "fake-app",
+					Namespace:        "fake-namespace",
+					DesiredRevisions: []string{"fake-commit"},
+				}}, healthChecks)
+			},
+		},
+		{
+			name: "Application regenerated and synced",
+			runner: &argocdUpdater{
+				getAuthorizedApplicationSetFn: func(
+					context.Context,
+					*promotion.StepContext,
+					client.ObjectKey,
+				) (*unstructured.Unstructured, error) {
+					return newTestApplicationSet(nil, newSpec("fake-promotion")), nil
+				},
+				getGeneratedApplicationsFn: func(
+					context.Context,
+					*unstructured.Unstructured,
+				) ([]argocd.Application, error) {
+					return []argocd.Application{newApp("fake-promotion")}, nil
+				},
+				mustPerformUpdateFn: func(
+					context.Context,
+					*promotion.StepContext,
+					*builtin.ArgoCDAppUpdate,
+					*argocd.Application,
+				) (argocd.OperationPhase, bool, error) {
+					return "", true, nil
+				},
+				buildDesiredSourcesFn: func(
+					update *builtin.ArgoCDAppUpdate,
+					_ []string,
+					_ *argocd.Application,
+				) (argocd.ApplicationSources, error) {
+					// Generated Applications must only be pinned to revisions
+					if update.Sources[0].UpdateTargetRevision ||
+						update.Sources[0].Helm != nil ||
+						update.Sources[0].Kustomize != nil {
+						return nil, errors.New("unexpected source update")
+					}
+					return nil, nil
+				},
+				syncApplicationFn: func(
+					context.Context,
+					*promotion.StepContext,
+					*argocd.Application,
+					argocd.ApplicationSources,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				phases []argocd.OperationPhase,
+				healthChecks []checkers.ArgoCDAppHealthCheck,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, []argocd.OperationPhase{argocd.OperationRunning}, phases)
+				require.Len(t, healthChecks, 1)
+			},
+		},
+		{
+			name: "generated Application sync failed",
+			runner: &argocdUpdater{
+				getAuthorizedApplicationSetFn: func(
+					context.Context,
+					*promotion.StepContext,
+					client.ObjectKey,
+				) (*unstructured.Unstructured, error) {
+					return newTestApplicationSet(nil, newSpec("fake-promotion")), nil
+				},
+				getGeneratedApplicationsFn: func(
+					context.Context,
+					*unstructured.Unstructured,
+				) ([]argocd.Application, error) {
+					app := newApp("fake-promotion")
+					app.Status.OperationState = &argocd.OperationState{
+						Phase:   argocd.OperationFailed,
+						Message: "something went wrong",
+					}
+					return []argocd.Application{app}, nil
+				},
+				mustPerformUpdateFn: func(
+					context.Context,
+					*promotion.StepContext,
+					*builtin.ArgoCDAppUpdate,
+					*argocd.Application,
+				) (argocd.OperationPhase, bool, error) {
+					return argocd.OperationFailed, false, nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				phases []argocd.OperationPhase,
+				_ []checkers.ArgoCDAppHealthCheck,
+				err error,
+			) {
+				require.ErrorContains(t, err, "something went wrong")
+				require.Equal(t, []argocd.OperationPhase{argocd.OperationFailed}, phases)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.runner.applyArgoCDSourceUpdateFn = testCase.runner.applyArgoCDSourceUpdate
+			phases, healthChecks, err := testCase.runner.updateApplicationSet(
+				context.Background(),
+				stepCtx,
+				update,
+			)
+			testCase.assertions(t, phases, healthChecks, err)
+		})
+	}
+}
+
+func Test_argoCDUpdater_getAuthorizedApplicationSet(t *testing.T) {
+	testCases := []struct {
+		name       string
+		appSet     *unstructured.Unstructured
+		assertions func(*testing.T, *unstructured.Unstructured, error)
+	}{
+		{
+			name: "ApplicationSet not found",
+			assertions: func(t *testing.T, appSet *unstructured.Unstructured, err error) {
+				require.ErrorContains(t, err, "unable to find Argo CD ApplicationSet")
+				require.Nil(t, appSet)
+			},
+		},
+		{
+			name:   "ApplicationSet not authorized for Stage",
+			appSet: newTestApplicationSet(nil, map[string]any{}),
+			assertions: func(t *testing.T, appSet *unstructured.Unstructured, err error) {
+				require.ErrorContains(
+					t, err,
+					`Argo CD ApplicationSet "fake-appset" in namespace "fake-namespace" `+
+						"does not permit mutation by Kargo Stage",
+				)
+				require.Nil(t, appSet)
+			},
+		},
+		{
+			name: "success",
+			appSet: newTestApplicationSet(
+				map[string]string{
+					kargoapi.AnnotationKeyAuthorizedStage: "fake-namespace:fake-stage",
+				},
+				map[string]any{},
+			),
+			assertions: func(t *testing.T, appSet *unstructured.Unstructured, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, appSet)
+				require.Equal(t, "fake-appset", appSet.GetName())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().WithScheme(runtime.NewScheme())
+			if testCase.appSet != nil {
+				c.WithObjects(testCase.appSet)
+			}
+			runner := &argocdUpdater{argocdClient: c.Build()}
+			appSet, err := runner.getAuthorizedApplicationSet(
+				context.Background(),
+				&promotion.StepContext{
+					Project: "fake-namespace",
+					Stage:   "fake-stage",
+				},
+				client.ObjectKey{
+					Namespace: "fake-namespace",
+					Name:      "fake-appset",
+				},
+			)
+			testCase.assertions(t, appSet, err)
+		})
+	}
+}
+
+func Test_argoCDUpdater_getGeneratedApplications(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, argocd.AddToScheme(scheme))
+
+	newApp := func(name, owner string) *argocd.Application {
+		app := &argocd.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-namespace",
+				Name:      name,
+			},
+		}
+		if owner != "" {
+			app.OwnerReferences = []metav1.OwnerReference{{
+				APIVersion: argocd.GroupVersion.String(),
+				Kind:       applicationSetKind,
+				Name:       owner,
+			}}
+		}
+		return app
+	}
+
+	runner := &argocdUpdater{
+		argocdClient: fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(
+				newApp("generated", "fake-appset"),
+				newApp("generated-by-other", "other-appset"),
+				newApp("standalone", ""),
+			).
+			Build(),
+	}
+	apps, err := runner.getGeneratedApplications(
+		context.Background(),
+		newTestApplicationSet(nil, map[string]any{}),
+	)
+	require.NoError(t, err)
+	require.Len(t, apps, 1)
+	require.Equal(t, "generated", apps[0].Name)
+}
+
+func Test_argoCDUpdater_buildDesiredApplicationSetSpec(t *testing.T) {
+	stepCtx := &promotion.StepContext{Promotion: "fake-promotion"}
+
+	testCases := []struct {
+		name       string
+		update     *builtin.ArgoCDAppSetUpdate
+		spec       map[string]any
+		assertions func(*testing.T, map[string]any, error)
+	}{
+		{
+			name: "no List generator element matched",
+			update: &builtin.ArgoCDAppSetUpdate{
+				Parameters: []builtin.ArgoCDAppSetParameterUpdate{{
+					Key:   "tag",
+					Value: "v2",
+					Match: map[string]string{"env": "prod"},
+				}},
+			},
+			spec: map[string]any{
+				"generators": []any{
+					map[string]any{
+						"list": map[string]any{
+							"elements": []any{map[string]any{"env": "test"}},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ map[string]any, err error) {
+				require.ErrorContains(t, err, "no List generator element")
+				require.ErrorContains(t, err, `"tag"`)
+			},
+		},
+		{
+			name: "no template source matched",
+			update: &builtin.ArgoCDAppSetUpdate{
+				Sources: []builtin.ArgoCDAppSourceUpdate{{
+					RepoURL: "https://github.com/example/other.git",
+				}},
+			},
+			spec: map[string]any{
+				"template": map[string]any{
+					"spec": map[string]any{
+						"source": map[string]any{
+							"repoURL": "https://github.com/example/repo.git",
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ map[string]any, err error) {
+				require.ErrorContains(t, err, "no source matched update")
+			},
+		},
+		{
+			name: "parameters and multiple template sources updated",
+			update: &builtin.ArgoCDAppSetUpdate{
+				Parameters: []builtin.ArgoCDAppSetParameterUpdate{{
+					Key:   "image.tag",
+					Value: "v2",
+					Match: map[string]string{"env": "test"},
+				}},
+				Sources: []builtin.ArgoCDAppSourceUpdate{{
+					RepoURL:              "https://github.com/example/repo",
+					DesiredRevision:      "fake-commit",
+					UpdateTargetRevision: true,
+				}},
+			},
+			spec: map[string]any{
+				"generators": []any{
+					map[string]any{
+						"matrix": map[string]any{
+							"generators": []any{
+								map[string]any{
+									"clusters": map[string]any{},
+								},
+								map[string]any{
+									"list": map[string]any{
+										"elements": []any{
+											map[string]any{"env": "test"},
+											map[string]any{"env": "prod"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"template": map[string]any{
+					"spec": map[string]any{
+						"sources": []any{
+							map[string]any{
+								"repoURL":        "https://github.com/example/repo.git",
+								"path":           "{{env}}",
+								"targetRevision": "main",
+							},
+							map[string]any{
+								"repoURL": "https://charts.example.com",
+								"chart":   "fake-chart",
+							},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, spec map[string]any, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					map[string]any{
+						"generators": []any{
+							map[string]any{
+								"matrix": map[string]any{
+									"generators": []any{
+										map[string]any{
+											"clusters": map[string]any{},
+										},
+										map[string]any{
+											"list": map[string]any{
+												"elements": []any{
+													map[string]any{
+														"env":   "test",
+														"image": map[string]any{"tag": "v2"},
+													},
+													map[string]any{"env": "prod"},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"template": map[string]any{
+							"metadata": map[string]any{
+								"annotations": map[string]any{
+									promotionInfoKey: "fake-promotion",
+								},
+							},
+							"spec": map[string]any{
+								"sources": []any{
+									map[string]any{
+										"repoURL":        "https://github.com/example/repo.git",
+										"path":           "{{env}}",
+										"targetRevision": "fake-commit",
+									},
+									map[string]any{
+										"repoURL": "https://charts.example.com",
+										"chart":   "fake-chart",
+									},
+								},
+							},
+						},
+					},
+					spec,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			runner := &argocdUpdater{}
+			runner.applyArgoCDSourceUpdateFn = runner.applyArgoCDSourceUpdate
+			spec, err := runner.buildDesiredApplicationSetSpec(
+				stepCtx,
+				testCase.update,
+				newTestApplicationSet(nil, testCase.spec),
+			)
+			testCase.assertions(t, spec, err)
+		})
+	}
+}
+
+func Test_updateListGeneratorElements(t *testing.T) {
+	testCases := []struct {
+		name       string
+		generators []any
+		update     *builtin.ArgoCDAppSetParameterUpdate
+		assertions func(*testing.T, []any, int, error)
+	}{
+		{
+			name: "all elements updated when no match is specified",
+			generators: []any{
+				map[string]any{
+					"list": map[string]any{
+						"elements": []any{
+							map[string]any{"env": "test"},
+							map[string]any{"env": "prod"},
+						},
+					},
+				},
+			},
+			update: &builtin.ArgoCDAppSetParameterUpdate{Key: "tag", Value: "v2"},
+			assertions: func(t *testing.T, generators []any, updated int, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 2, updated)
+				require.Equal(
+					t,
+					[]any{
+						map[string]any{"env": "test", "tag": "v2"},
+						map[string]any{"env": "prod", "tag": "v2"},
+					},
+					generators[0].(map[string]any)["list"].(map[string]any)["elements"], // nolint: forcetypeassert
+				)
+			},
+		},
+		{
+			name: "non-string element values are matched",
+			generators: []any{
+				map[string]any{
+					"merge": map[string]any{
+						"generators": []any{
+							map[string]any{
+								"list": map[string]any{
+									"elements": []any{
+										map[string]any{"shard": int64(1)},
+										map[string]any{"shard": int64(2)},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			update: &builtin.ArgoCDAppSetParameterUpdate{
+				Key:   "tag",
+				Value: "v2",
+				Match: map[string]string{"shard": "2"},
+			},
+			assertions: func(t *testing.T, _ []any, updated int, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, updated)
+			},
+		},
+		{
+			name: "nested key conflicts with existing value",
+			generators: []any{
+				map[string]any{
+					"list": map[string]any{
+						"elements": []any{
+							map[string]any{"image": "nginx"},
+						},
+					},
+				},
+			},
+			update: &builtin.ArgoCDAppSetParameterUpdate{Key: "image.tag", Value: "v2"},
+			assertions: func(t *testing.T, _ []any, _ int, err error) {
+				require.ErrorContains(t, err, `error setting parameter "image.tag"`)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			updated, err := updateListGeneratorElements(testCase.generators, testCase.update)
+			testCase.assertions(t, testCase.generators, updated, err)
+		})
+	}
+}
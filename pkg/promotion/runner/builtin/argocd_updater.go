@@ -4,7 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
+	"slices"
 	"time"
 
 	"github.com/xeipuuv/gojsonschema"
@@ -49,7 +49,7 @@ func init() {
 }
 
 // argocdUpdater is an implementation of the promotion.StepRunner interface that
-// updates one or more Argo CD Application resources.
+// updates one or more Argo CD Application or ApplicationSet resources.
 type argocdUpdater struct {
 	schemaLoader gojsonschema.JSONLoader
 
@@ -63,6 +63,17 @@ type argocdUpdater struct {
 		client.ObjectKey,
 	) (*argocd.Application, error)
 
+	getAuthorizedApplicationSetFn func(
+		context.Context,
+		*promotion.StepContext,
+		client.ObjectKey,
+	) (*unstructured.Unstructured, error)
+
+	getGeneratedApplicationsFn func(
+		context.Context,
+		*unstructured.Unstructured,
+	) ([]argocd.Application, error)
+
 	buildDesiredSourcesFn func(
 		update *builtin.ArgoCDAppUpdate,
 		desiredRevisions []string,
@@ -105,11 +116,13 @@ type argocdUpdater struct {
 }
 
 // newArgocdUpdater returns a implementation of the promotion.StepRunner
-// interfaces that updates Argo CD Application resources.
+// interfaces that updates Argo CD Application and ApplicationSet resources.
 func newArgocdUpdater(caps promotion.StepRunnerCapabilities) promotion.StepRunner {
 	r := &argocdUpdater{argocdClient: caps.ArgoCDClient}
 	r.schemaLoader = getConfigSchemaLoader(stepKindArgoCDUpdate)
 	r.getAuthorizedApplicationFn = r.getAuthorizedApplication
+	r.getAuthorizedApplicationSetFn = r.getAuthorizedApplicationSet
+	r.getGeneratedApplicationsFn = r.getGeneratedApplications
 	r.buildDesiredSourcesFn = r.buildDesiredSources
 	r.mustPerformUpdateFn = r.mustPerformUpdate
 	r.syncApplicationFn = r.syncApplication
@@ -156,7 +169,7 @@ func (a *argocdUpdater) run(
 	logger.Info("executing argocd-update promotion step")
 
 	updateResults := make([]argocd.OperationPhase, 0, len(stepCfg.Apps))
-	appHealthChecks := make([]checkers.ArgoCDAppHealthCheck, len(stepCfg.Apps))
+	appHealthChecks := make([]checkers.ArgoCDAppHealthCheck, 0, len(stepCfg.Apps))
 	for i := range stepCfg.Apps {
 		update := &stepCfg.Apps[i]
 		// Retrieve the Argo CD Application.
@@ -175,106 +188,34 @@ func (a *argocdUpdater) run(
 			)
 		}
 
-		desiredRevisions := a.getDesiredRevisions(update, app)
-		appHealthChecks[i] = checkers.ArgoCDAppHealthCheck{
+		appHealthChecks = append(appHealthChecks, checkers.ArgoCDAppHealthCheck{
 			Name:             app.Name,
 			Namespace:        app.Namespace,
-			DesiredRevisions: desiredRevisions,
-		}
-
-		// Check if the update needs to be performed and retrieve its phase.
-		phase, mustUpdate, err := a.mustPerformUpdateFn(ctx, stepCtx, update, app)
-		if mustUpdate {
-			logger.Info(
-				"Argo CD Application requires update",
-				"name", app.Name,
-				"namespace", app.Namespace,
-			)
-		} else {
-			logger.Info(
-				"Argo CD Application does not require update",
-				"name", app.Name,
-				"namespace", app.Namespace,
-			)
-		}
+			DesiredRevisions: a.getDesiredRevisions(update, app),
+		})
 
-		// If we have a phase, append it to the results.
+		phase, err := a.updateApplication(ctx, stepCtx, update, app)
 		if phase != "" {
 			updateResults = append(updateResults, phase)
 		}
-
-		// If we don't need to perform an update, further processing depends on
-		// the phase and whether an error occurred.
-		if !mustUpdate {
-			if err != nil {
-				if phase == "" {
-					// If we do not have a phase, we cannot continue processing
-					// this update by waiting.
-					return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
-				}
-				// Log the error as a warning, but continue to the next update.
-				logger.Info(
-					"reason for not updating",
-					"name", app.Name,
-					"namespace", app.Namespace,
-					"reason", err.Error(),
-				)
-			}
-			if phase.Failed() {
-				// Record the reason for the failure if available.
-				if app.Status.OperationState != nil {
-					// nolint:staticcheck
-					return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, fmt.Errorf(
-						"Argo CD Application %q in namespace %q failed with: %s",
-						app.Name,
-						app.Namespace,
-						app.Status.OperationState.Message,
-					)
-				}
-				// If the update failed, we can short-circuit. This is
-				// effectively "fail fast" behavior.
-				return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, nil
-			}
-			// If we get here, we can continue to the next update.
-			continue
-		}
-
-		// Log the error, as it contains information about why we need to
-		// perform an update.
-		if err != nil {
-			logger.Info(
-				"reason for updating Argo CD Application %q in namespace %q: %s",
-				app.Name, app.Namespace, err.Error(),
-			)
-		}
-
-		// Build the desired source(s) for the Argo CD Application.
-		desiredSources, err := a.buildDesiredSourcesFn(
-			update,
-			desiredRevisions,
-			app,
-		)
-		if err != nil {
-			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, fmt.Errorf(
-				"error building desired sources for Argo CD Application %q in namespace %q: %w",
-				app.Name, app.Namespace, err,
-			)
+		if err != nil || phase.Failed() {
+			// If the update failed, we can short-circuit. This is effectively
+			// "fail fast" behavior.
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
 		}
+	}
 
-		// Perform the update.
-		if err = a.syncApplicationFn(
+	for i := range stepCfg.ApplicationSets {
+		phases, healthChecks, err := a.updateApplicationSet(
 			ctx,
 			stepCtx,
-			app,
-			desiredSources,
-		); err != nil {
-			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, fmt.Errorf(
-				"error syncing Argo CD Application %q in namespace %q: %w",
-				app.Name, app.Namespace, err,
-			)
+			&stepCfg.ApplicationSets[i],
+		)
+		updateResults = append(updateResults, phases...)
+		if err != nil || slices.ContainsFunc(phases, argocd.OperationPhase.Failed) {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
 		}
-		// As we have initiated an update, we should wait for it to complete.
-		updateResults = append(updateResults, argocd.OperationRunning)
+		appHealthChecks = append(appHealthChecks, healthChecks...)
 	}
 
 	aggregatedStatus := a.operationPhaseToPromotionStepStatus(updateResults...)
@@ -313,6 +254,102 @@ func (a *argocdUpdater) run(
 	}, nil
 }
 
+// updateApplication initiates a sync of the provided Argo CD Application with
+// the provided update applied, unless such an operation is already underway or
+// has already completed. It returns the phase of the relevant operation, if
+// known.
+func (a *argocdUpdater) updateApplication(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	update *builtin.ArgoCDAppUpdate,
+	app *argocd.Application,
+) (argocd.OperationPhase, error) {
+	logger := logging.LoggerFromContext(ctx)
+
+	// Check if the update needs to be performed and retrieve its phase.
+	phase, mustUpdate, err := a.mustPerformUpdateFn(ctx, stepCtx, update, app)
+	if mustUpdate {
+		logger.Info(
+			"Argo CD Application requires update",
+			"name", app.Name,
+			"namespace", app.Namespace,
+		)
+	} else {
+		logger.Info(
+			"Argo CD Application does not require update",
+			"name", app.Name,
+			"namespace", app.Namespace,
+		)
+	}
+
+	// If we don't need to perform an update, further processing depends on
+	// the phase and whether an error occurred.
+	if !mustUpdate {
+		if err != nil {
+			if phase == "" {
+				// If we do not have a phase, we cannot continue processing
+				// this update by waiting.
+				return "", err
+			}
+			// Log the error as a warning, but continue to the next update.
+			logger.Info(
+				"reason for not updating",
+				"name", app.Name,
+				"namespace", app.Namespace,
+				"reason", err.Error(),
+			)
+		}
+		if phase.Failed() && app.Status.OperationState != nil {
+			// Record the reason for the failure if available.
+			// nolint:staticcheck
+			return phase, fmt.Errorf(
+				"Argo CD Application %q in namespace %q failed with: %s",
+				app.Name,
+				app.Namespace,
+				app.Status.OperationState.Message,
+			)
+		}
+		return phase, nil
+	}
+
+	// Log the error, as it contains information about why we need to
+	// perform an update.
+	if err != nil {
+		logger.Info(
+			"reason for updating Argo CD Application %q in namespace %q: %s",
+			app.Name, app.Namespace, err.Error(),
+		)
+	}
+
+	// Build the desired source(s) for the Argo CD Application.
+	desiredSources, err := a.buildDesiredSourcesFn(
+		update,
+		a.getDesiredRevisions(update, app),
+		app,
+	)
+	if err != nil {
+		return "", fmt.Errorf(
+			"error building desired sources for Argo CD Application %q in namespace %q: %w",
+			app.Name, app.Namespace, err,
+		)
+	}
+
+	// Perform the update.
+	if err = a.syncApplicationFn(
+		ctx,
+		stepCtx,
+		app,
+		desiredSources,
+	); err != nil {
+		return "", fmt.Errorf(
+			"error syncing Argo CD Application %q in namespace %q: %w",
+			app.Name, app.Namespace, err,
+		)
+	}
+	// As we have initiated an update, we should wait for it to complete.
+	return argocd.OperationRunning, nil
+}
+
 // buildDesiredSources returns the desired source(s) for an Argo CD Application,
 // by updating the current source(s) with the given source updates.
 func (a *argocdUpdater) buildDesiredSources(
@@ -410,7 +447,7 @@ func (a *argocdUpdater) mustPerformUpdate(
 	var correctPromotionIDFound bool
 	for _, info := range status.Operation.Info {
 		if info.Name == promotionInfoKey {
-			correctPromotionIDFound = info.Value == stepCtx.Promotion
+			correctPromotionIDFound = info.Value == stepCtx.ExecutionID()
 			break
 		}
 	}
@@ -424,8 +461,8 @@ func (a *argocdUpdater) mustPerformUpdate(
 			// NB: We return the current phase here because we want the caller
 			//     to know that an operation is still running.
 			return status.Phase, false, fmt.Errorf(
-				"current operation was not initiated for Promotion %s: waiting for operation to complete",
-				stepCtx.Promotion,
+				"current operation was not initiated for %s: waiting for operation to complete",
+				stepCtx.ExecutionID(),
 			)
 		}
 		// Initiate our own operation.
@@ -539,7 +576,7 @@ func (a *argocdUpdater) syncApplication(
 			},
 			{
 				Name:  promotionInfoKey,
-				Value: stepCtx.Promotion,
+				Value: stepCtx.ExecutionID(),
 			},
 		},
 		Sync: &argocd.SyncOperation{
@@ -744,47 +781,18 @@ func (a *argocdUpdater) authorizeArgoCDAppUpdate(
 	stepCtx *promotion.StepContext,
 	appMeta metav1.ObjectMeta,
 ) error {
-	// nolint:staticcheck
-	permErr := fmt.Errorf(
-		"Argo CD Application %q in namespace %q does not permit mutation by "+
-			"Kargo Stage %s in namespace %s",
-		appMeta.Name,
-		appMeta.Namespace,
-		stepCtx.Stage,
-		stepCtx.Project,
-	)
-
-	allowedStage, ok := appMeta.Annotations[kargoapi.AnnotationKeyAuthorizedStage]
-	if !ok {
-		return permErr
-	}
-
-	tokens := strings.SplitN(allowedStage, ":", 2)
-	if len(tokens) != 2 {
-		return fmt.Errorf(
-			"unable to parse value of annotation %q (%q) on Argo CD Application %q in namespace %q",
-			kargoapi.AnnotationKeyAuthorizedStage,
-			allowedStage,
-			appMeta.Name,
-			appMeta.Namespace,
-		)
-	}
+	return a.authorizeArgoCDUpdate(stepCtx, "Application", &appMeta)
+}
 
-	projectName, stageName := tokens[0], tokens[1]
-	if strings.Contains(projectName, "*") || strings.Contains(stageName, "*") {
-		// nolint:staticcheck
-		return fmt.Errorf(
-			"Argo CD Application %q in namespace %q has deprecated glob expression in annotation %q (%q)",
-			appMeta.Name,
-			appMeta.Namespace,
-			kargoapi.AnnotationKeyAuthorizedStage,
-			allowedStage,
-		)
-	}
-	if projectName != stepCtx.Project || stageName != stepCtx.Stage {
-		return permErr
-	}
-	return nil
+// authorizeArgoCDUpdate returns an error if the Argo CD resource of the
+// specified kind represented by objMeta does not explicitly permit mutation by
+// the Kargo Stage represented by stageMeta.
+func (a *argocdUpdater) authorizeArgoCDUpdate(
+	stepCtx *promotion.StepContext,
+	kind string,
+	objMeta metav1.Object,
+) error {
+	return authorizeResourceUpdate(stepCtx, "Argo CD "+kind, objMeta)
 }
 
 // applyArgoCDSourceUpdate updates a single Argo CD ApplicationSource.
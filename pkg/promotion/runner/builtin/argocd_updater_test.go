@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -16,6 +17,7 @@ import (
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	argocd "github.com/akuity/kargo/pkg/controller/argocd/api/v1alpha1"
+	checkers "github.com/akuity/kargo/pkg/health/checker/builtin"
 	"github.com/akuity/kargo/pkg/kubeclient"
 	"github.com/akuity/kargo/pkg/promotion"
 	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
@@ -30,6 +32,8 @@ func Test_newArgocdUpdater(t *testing.T) {
 	require.NotNil(t, runner.argocdClient)
 	require.NotNil(t, runner.schemaLoader)
 	require.NotNil(t, runner.getAuthorizedApplicationFn)
+	require.NotNil(t, runner.getAuthorizedApplicationSetFn)
+	require.NotNil(t, runner.getGeneratedApplicationsFn)
 	require.NotNil(t, runner.buildDesiredSourcesFn)
 	require.NotNil(t, runner.mustPerformUpdateFn)
 	require.NotNil(t, runner.syncApplicationFn)
@@ -280,6 +284,72 @@ func Test_argoCDUpdater_convert(t *testing.T) {
 				"apps.0.sources.0.kustomize.images.0: Must validate one and only one schema (oneOf)",
 			},
 		},
+		{
+			name: "applicationSets is empty array",
+			config: promotion.Config{
+				"applicationSets": []promotion.Config{},
+			},
+			expectedProblems: []string{
+				"applicationSets: Array must have at least 1 items",
+			},
+		},
+		{
+			name: "applicationSet name not specified",
+			config: promotion.Config{
+				"applicationSets": []promotion.Config{{}},
+			},
+			expectedProblems: []string{
+				"applicationSets.0: name is required",
+			},
+		},
+		{
+			name: "applicationSet parameter key not specified",
+			config: promotion.Config{
+				"applicationSets": []promotion.Config{{
+					"parameters": []promotion.Config{{}},
+				}},
+			},
+			expectedProblems: []string{
+				"applicationSets.0.parameters.0: key is required",
+				"applicationSets.0.parameters.0: value is required",
+			},
+		},
+		{
+			name: "applicationSet parameter match value is not a string",
+			config: promotion.Config{
+				"applicationSets": []promotion.Config{{
+					"parameters": []promotion.Config{{
+						"match": promotion.Config{
+							"env": 42,
+						},
+					}},
+				}},
+			},
+			expectedProblems: []string{
+				"applicationSets.0.parameters.0.match.env: Invalid type. Expected: string, given: integer",
+			},
+		},
+		{
+			name: "valid applicationSets kitchen sink",
+			config: promotion.Config{
+				"applicationSets": []promotion.Config{{
+					"name":      "appset",
+					"namespace": "argocd",
+					"parameters": []promotion.Config{{
+						"key":   "image.tag",
+						"value": "fake-tag",
+						"match": promotion.Config{
+							"env": "test",
+						},
+					}},
+					"sources": []promotion.Config{{
+						"repoURL":              "fake-git-url",
+						"desiredRevision":      "fake-commit",
+						"updateTargetRevision": true,
+					}},
+				}},
+			},
+		},
 		{
 			name: "valid kitchen sink",
 			config: promotion.Config{
@@ -690,6 +760,60 @@ func Test_argoCDUpdater_run(t *testing.T) {
 				require.NoError(t, err)
 			},
 		},
+		{
+			name: "generated Applications are included in health checks",
+			runner: &argocdUpdater{
+				argocdClient: fake.NewFakeClient(),
+				getAuthorizedApplicationSetFn: func(
+					context.Context,
+					*promotion.StepContext,
+					client.ObjectKey,
+				) (*unstructured.Unstructured, error) {
+					return &unstructured.Unstructured{Object: map[string]any{
+						"spec": map[string]any{
+							"template": map[string]any{
+								"metadata": map[string]any{
+									"annotations": map[string]any{promotionInfoKey: ""},
+								},
+							},
+						},
+					}}, nil
+				},
+				getGeneratedApplicationsFn: func(
+					context.Context,
+					*unstructured.Unstructured,
+				) ([]argocd.Application, error) {
+					return []argocd.Application{
+						{ObjectMeta: metav1.ObjectMeta{Namespace: "argocd", Name: "app-1"}},
+						{ObjectMeta: metav1.ObjectMeta{Namespace: "argocd", Name: "app-2"}},
+					}, nil
+				},
+				mustPerformUpdateFn: func(
+					context.Context,
+					*promotion.StepContext,
+					*builtin.ArgoCDAppUpdate,
+					*argocd.Application,
+				) (argocd.OperationPhase, bool, error) {
+					return argocd.OperationSucceeded, false, nil
+				},
+			},
+			stepCfg: builtin.ArgoCDUpdateConfig{
+				ApplicationSets: []builtin.ArgoCDAppSetUpdate{{}},
+			},
+			assertions: func(t *testing.T, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+				require.NotNil(t, res.HealthCheck)
+				require.Equal(
+					t,
+					[]checkers.ArgoCDAppHealthCheck{
+						{Name: "app-1", Namespace: "argocd"},
+						{Name: "app-2", Namespace: "argocd"},
+					},
+					res.HealthCheck.Input["apps"],
+				)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -0,0 +1,378 @@
+package builtin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/xeipuuv/gojsonschema"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	libflux "github.com/akuity/kargo/pkg/flux"
+	"github.com/akuity/kargo/pkg/health"
+	checkers "github.com/akuity/kargo/pkg/health/checker/builtin"
+	"github.com/akuity/kargo/pkg/kubeclient"
+	"github.com/akuity/kargo/pkg/logging"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+const (
+	stepKindFluxReconcile = "flux-reconcile"
+
+	// fluxHealthCheckKind is the kind of health check associated with the
+	// flux-reconcile step.
+	fluxHealthCheckKind = "flux"
+)
+
+func init() {
+	promotion.RegisterStepRunner(
+		stepKindFluxReconcile,
+		promotion.StepRunnerRegistration{
+			Metadata: promotion.StepRunnerMetadata{
+				DefaultTimeout: 5 * time.Minute,
+				RequiredCapabilities: []promotion.StepRunnerCapability{
+					promotion.StepCapabilityAccessFlux,
+				},
+			},
+			Factory: newFluxReconciler,
+		},
+	)
+}
+
+// fluxReconciler is an implementation of the promotion.StepRunner interface
+// that optionally updates one or more Flux resources, requests their
+// reconciliation, and waits for them to become ready.
+type fluxReconciler struct {
+	schemaLoader gojsonschema.JSONLoader
+
+	fluxClient client.Client
+
+	// These behaviors are overridable for testing purposes:
+
+	getAuthorizedResourceFn func(
+		context.Context,
+		*promotion.StepContext,
+		string,
+		client.ObjectKey,
+	) (*unstructured.Unstructured, error)
+
+	patchResourceFn func(
+		context.Context,
+		kubeclient.ObjectWithKind,
+		kubeclient.UnstructuredPatchFn,
+	) error
+}
+
+// newFluxReconciler returns an implementation of the promotion.StepRunner
+// interface that reconciles Flux resources.
+func newFluxReconciler(caps promotion.StepRunnerCapabilities) promotion.StepRunner {
+	r := &fluxReconciler{fluxClient: caps.FluxClient}
+	r.schemaLoader = getConfigSchemaLoader(stepKindFluxReconcile)
+	r.getAuthorizedResourceFn = r.getAuthorizedResource
+	r.patchResourceFn = r.patchResource
+	return r
+}
+
+// Run implements the promotion.StepRunner interface.
+func (f *fluxReconciler) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	cfg, err := f.convert(stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+	return f.run(ctx, stepCtx, cfg)
+}
+
+// convert validates fluxReconciler configuration against a JSON schema and
+// converts it into a builtin.FluxReconcileConfig struct.
+func (f *fluxReconciler) convert(cfg promotion.Config) (builtin.FluxReconcileConfig, error) {
+	stepCfg, err := validateAndConvert[builtin.FluxReconcileConfig](
+		f.schemaLoader, cfg, stepKindFluxReconcile,
+	)
+	if err != nil {
+		return stepCfg, err
+	}
+	for i, res := range stepCfg.Resources {
+		if err = validateFluxResourceReconcile(res); err != nil {
+			return stepCfg, fmt.Errorf("invalid resources[%d]: %w", i, err)
+		}
+	}
+	return stepCfg, nil
+}
+
+// validateFluxResourceReconcile returns an error if the updates described by
+// the provided builtin.FluxResourceReconcile are not applicable to the kind of
+// resource it references.
+func validateFluxResourceReconcile(res builtin.FluxResourceReconcile) error {
+	if res.ChartVersion != "" && res.Kind != builtin.HelmRelease {
+		return fmt.Errorf("chartVersion is not applicable to Flux %s resources", res.Kind)
+	}
+	if res.Ref == nil {
+		return nil
+	}
+	switch res.Kind {
+	case builtin.GitRepository:
+		if res.Ref.Digest != "" {
+			return errors.New("ref.digest is not applicable to Flux GitRepository resources")
+		}
+	case builtin.OCIRepository:
+		if res.Ref.Branch != "" || res.Ref.Commit != "" || res.Ref.Name != "" {
+			return errors.New(
+				"ref.branch, ref.commit, and ref.name are not applicable to Flux " +
+					"OCIRepository resources",
+			)
+		}
+	default:
+		return fmt.Errorf("ref is not applicable to Flux %s resources", res.Kind)
+	}
+	return nil
+}
+
+func (f *fluxReconciler) run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	stepCfg builtin.FluxReconcileConfig,
+) (promotion.StepResult, error) {
+	if f.fluxClient == nil {
+		// nolint:staticcheck
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, errors.New(
+			"Flux integration is disabled on this controller; cannot reconcile " +
+				"Flux resources",
+		)
+	}
+
+	logger := logging.LoggerFromContext(ctx)
+	logger.Info("executing flux-reconcile promotion step")
+
+	healthChecks := make([]checkers.FluxResourceHealthCheck, 0, len(stepCfg.Resources))
+	for i := range stepCfg.Resources {
+		res := &stepCfg.Resources[i]
+		key := client.ObjectKey{
+			Namespace: res.Namespace,
+			Name:      res.Name,
+		}
+		if key.Namespace == "" {
+			key.Namespace = libflux.Namespace()
+		}
+		healthChecks = append(healthChecks, checkers.FluxResourceHealthCheck{
+			Kind:      string(res.Kind),
+			Name:      key.Name,
+			Namespace: key.Namespace,
+		})
+
+		// Resources are reconciled strictly in order. We do not move on to the
+		// next resource until the current one is ready, since later resources
+		// (e.g. a Kustomization) commonly depend upon earlier ones (e.g. the
+		// GitRepository it sources manifests from).
+		ready, err := f.reconcileResource(ctx, stepCtx, res, key)
+		if err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+		}
+		if !ready {
+			retryAfter := 15 * time.Second
+			logger.Info("step to be retried", "interval", retryAfter)
+			return promotion.StepResult{
+				Status:     kargoapi.PromotionStepStatusRunning,
+				RetryAfter: ptr.To(retryAfter),
+			}, nil
+		}
+	}
+
+	logger.Info("done executing flux-reconcile promotion step")
+
+	return promotion.StepResult{
+		Status: kargoapi.PromotionStepStatusSucceeded,
+		HealthCheck: &health.Criteria{
+			Kind: fluxHealthCheckKind,
+			Input: health.Input{
+				"resources": healthChecks,
+			},
+		},
+	}, nil
+}
+
+// reconcileResource applies any updates described by the provided
+// builtin.FluxResourceReconcile to the corresponding Flux resource and requests
+// its reconciliation, unless this has already been done. It returns true if
+// the Flux resource has since been reconciled and is ready. An error is
+// returned if the resource cannot be updated or if its reconciliation has
+// failed.
+func (f *fluxReconciler) reconcileResource(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	res *builtin.FluxResourceReconcile,
+	key client.ObjectKey,
+) (bool, error) {
+	logger := logging.LoggerFromContext(ctx).WithValues(
+		"kind", res.Kind, "name", key.Name, "namespace", key.Namespace,
+	)
+
+	obj, err := f.getAuthorizedResourceFn(ctx, stepCtx, string(res.Kind), key)
+	if err != nil {
+		return false, fmt.Errorf(
+			"error getting Flux %s %q in namespace %q: %w",
+			res.Kind, key.Name, key.Namespace, err,
+		)
+	}
+
+	desired, err := buildDesiredFluxResource(stepCtx, res, obj)
+	if err != nil {
+		return false, fmt.Errorf(
+			"error building desired state of Flux %s %q in namespace %q: %w",
+			res.Kind, key.Name, key.Namespace, err,
+		)
+	}
+	if !equality.Semantic.DeepEqual(obj.Object, desired.Object) {
+		logger.Info("requesting reconciliation of Flux resource")
+		if err = f.patchResourceFn(ctx, desired, func(src, dst unstructured.Unstructured) error {
+			dst.Object["spec"] = src.Object["spec"]
+			annotations := dst.GetAnnotations()
+			if annotations == nil {
+				annotations = make(map[string]string, 1)
+			}
+			annotations[libflux.ReconcileRequestAnnotation] = stepCtx.ExecutionID()
+			dst.SetAnnotations(annotations)
+			return nil
+		}); err != nil {
+			return false, fmt.Errorf(
+				"error patching Flux %s %q in namespace %q: %w",
+				res.Kind, key.Name, key.Namespace, err,
+			)
+		}
+		return false, nil
+	}
+
+	status := libflux.GetStatus(obj)
+	switch {
+	case status.Suspended:
+		return false, fmt.Errorf(
+			"reconciliation of Flux %s %q in namespace %q is suspended",
+			res.Kind, key.Name, key.Namespace,
+		)
+	case status.Stalled:
+		return false, fmt.Errorf(
+			"reconciliation of Flux %s %q in namespace %q has stalled: %s",
+			res.Kind, key.Name, key.Namespace, status.Message,
+		)
+	case status.LastHandledReconcileAt != stepCtx.ExecutionID() ||
+		!status.Observed || status.Reconciling:
+		logger.Debug("waiting for Flux resource to be reconciled")
+		return false, nil
+	case status.Ready == metav1.ConditionTrue:
+		logger.Debug("Flux resource is ready", "revision", status.Revision)
+		return true, nil
+	case status.Ready == metav1.ConditionFalse:
+		return false, fmt.Errorf(
+			"reconciliation of Flux %s %q in namespace %q failed: %s",
+			res.Kind, key.Name, key.Namespace, status.Message,
+		)
+	default:
+		logger.Debug("waiting for Flux resource to become ready")
+		return false, nil
+	}
+}
+
+// buildDesiredFluxResource returns a copy of the provided Flux resource with the
+// updates described by the provided builtin.FluxResourceReconcile applied and
+// its reconciliation requested.
+func buildDesiredFluxResource(
+	stepCtx *promotion.StepContext,
+	res *builtin.FluxResourceReconcile,
+	obj *unstructured.Unstructured,
+) (*unstructured.Unstructured, error) {
+	desired := obj.DeepCopy()
+
+	if res.Ref != nil {
+		ref := make(map[string]any, 6)
+		for field, value := range map[string]string{
+			"branch": res.Ref.Branch,
+			"commit": res.Ref.Commit,
+			"digest": res.Ref.Digest,
+			"name":   res.Ref.Name,
+			"semver": res.Ref.Semver,
+			"tag":    res.Ref.Tag,
+		} {
+			if value != "" {
+				ref[field] = value
+			}
+		}
+		if err := unstructured.SetNestedField(desired.Object, ref, "spec", "ref"); err != nil {
+			return nil, err
+		}
+	}
+
+	if res.ChartVersion != "" {
+		if _, ok, _ := unstructured.NestedMap(desired.Object, "spec", "chart"); !ok {
+			return nil, errors.New(
+				"chartVersion can only be set on a HelmRelease that defines its " +
+					"chart using spec.chart; HelmReleases that use spec.chartRef are " +
+					"not supported",
+			)
+		}
+		if err := unstructured.SetNestedField(
+			desired.Object, res.ChartVersion, "spec", "chart", "spec", "version",
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	annotations := desired.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string, 1)
+	}
+	annotations[libflux.ReconcileRequestAnnotation] = stepCtx.ExecutionID()
+	desired.SetAnnotations(annotations)
+
+	return desired, nil
+}
+
+// getAuthorizedResource returns the Flux resource of the specified kind in the
+// given namespace with the given name, if it is authorized for mutation by the
+// Kargo Stage represented by stepCtx.
+func (f *fluxReconciler) getAuthorizedResource(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	kind string,
+	key client.ObjectKey,
+) (*unstructured.Unstructured, error) {
+	obj, err := libflux.NewObject(kind)
+	if err != nil {
+		return nil, err
+	}
+	if err = f.fluxClient.Get(ctx, key, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf(
+				"unable to find Flux %s %q in namespace %q",
+				kind, key.Name, key.Namespace,
+			)
+		}
+		return nil, fmt.Errorf(
+			"error finding Flux %s %q in namespace %q: %w",
+			kind, key.Name, key.Namespace, err,
+		)
+	}
+	if err = authorizeResourceUpdate(stepCtx, "Flux "+kind, obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func (f *fluxReconciler) patchResource(
+	ctx context.Context,
+	obj kubeclient.ObjectWithKind,
+	modify kubeclient.UnstructuredPatchFn,
+) error {
+	return kubeclient.PatchUnstructured(ctx, f.fluxClient, obj, modify)
+}
@@ -0,0 +1,590 @@
+package builtin
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	libflux "github.com/akuity/kargo/pkg/flux"
+	"github.com/akuity/kargo/pkg/health"
+	checkers "github.com/akuity/kargo/pkg/health/checker/builtin"
+	"github.com/akuity/kargo/pkg/kubeclient"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+func Test_fluxReconciler_convert(t *testing.T) {
+	tests := []validationTestCase{
+		{
+			name:   "resources not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): resources is required",
+			},
+		},
+		{
+			name: "resources empty",
+			config: promotion.Config{
+				"resources": []promotion.Config{},
+			},
+			expectedProblems: []string{
+				"resources: Array must have at least 1 items",
+			},
+		},
+		{
+			name: "kind not specified",
+			config: promotion.Config{
+				"resources": []promotion.Config{{
+					"name": "fake-name",
+				}},
+			},
+			expectedProblems: []string{
+				"resources.0: kind is required",
+			},
+		},
+		{
+			name: "kind not supported",
+			config: promotion.Config{
+				"resources": []promotion.Config{{
+					"kind": "Bucket",
+					"name": "fake-name",
+				}},
+			},
+			expectedProblems: []string{
+				"resources.0.kind: resources.0.kind must be one of the following",
+			},
+		},
+		{
+			name: "name not specified",
+			config: promotion.Config{
+				"resources": []promotion.Config{{
+					"kind": "Kustomization",
+				}},
+			},
+			expectedProblems: []string{
+				"resources.0: name is required",
+			},
+		},
+		{
+			name: "ref empty",
+			config: promotion.Config{
+				"resources": []promotion.Config{{
+					"kind": "GitRepository",
+					"name": "fake-name",
+					"ref":  promotion.Config{},
+				}},
+			},
+			expectedProblems: []string{
+				"resources.0.ref: Must have at least 1 properties",
+			},
+		},
+		{
+			name: "ref not applicable to kind",
+			config: promotion.Config{
+				"resources": []promotion.Config{{
+					"kind": "Kustomization",
+					"name": "fake-name",
+					"ref":  promotion.Config{"tag": "v1.0.0"},
+				}},
+			},
+			expectedProblems: []string{
+				"invalid resources[0]: ref is not applicable to Flux Kustomization resources",
+			},
+		},
+		{
+			name: "ref.digest not applicable to GitRepository",
+			config: promotion.Config{
+				"resources": []promotion.Config{{
+					"kind": "GitRepository",
+					"name": "fake-name",
+					"ref":  promotion.Config{"digest": "sha256:abc123"},
+				}},
+			},
+			expectedProblems: []string{
+				"ref.digest is not applicable to Flux GitRepository resources",
+			},
+		},
+		{
+			name: "ref.branch not applicable to OCIRepository",
+			config: promotion.Config{
+				"resources": []promotion.Config{{
+					"kind": "OCIRepository",
+					"name": "fake-name",
+					"ref":  promotion.Config{"branch": "main"},
+				}},
+			},
+			expectedProblems: []string{
+				"are not applicable to Flux OCIRepository resources",
+			},
+		},
+		{
+			name: "chartVersion not applicable to kind",
+			config: promotion.Config{
+				"resources": []promotion.Config{{
+					"kind":         "HelmRepository",
+					"name":         "fake-name",
+					"chartVersion": "1.2.3",
+				}},
+			},
+			expectedProblems: []string{
+				"chartVersion is not applicable to Flux HelmRepository resources",
+			},
+		},
+		{
+			name: "valid config",
+			config: promotion.Config{
+				"resources": []promotion.Config{
+					{
+						"kind": "GitRepository",
+						"name": "fake-repo",
+						"ref":  promotion.Config{"commit": "abc123"},
+					},
+					{
+						"kind":      "OCIRepository",
+						"name":      "fake-oci-repo",
+						"namespace": "fake-namespace",
+						"ref":       promotion.Config{"digest": "sha256:abc123"},
+					},
+					{
+						"kind":         "HelmRelease",
+						"name":         "fake-release",
+						"chartVersion": "1.2.3",
+					},
+					{
+						"kind": "Kustomization",
+						"name": "fake-kustomization",
+					},
+				},
+			},
+		},
+	}
+
+	r := newFluxReconciler(promotion.StepRunnerCapabilities{})
+	runner, ok := r.(*fluxReconciler)
+	require.True(t, ok)
+
+	runValidationTests(t, runner.convert, tests)
+}
+
+func newTestFluxResource(
+	kind string,
+	name string,
+	annotations map[string]string,
+	spec map[string]any,
+	status map[string]any,
+) *unstructured.Unstructured {
+	obj, _ := libflux.NewObject(kind)
+	obj.SetNamespace(libflux.Namespace())
+	obj.SetName(name)
+	obj.SetGeneration(1)
+	obj.SetAnnotations(annotations)
+	if spec != nil {
+		obj.Object["spec"] = spec
+	}
+	if status != nil {
+		obj.Object["status"] = status
+	}
+	return obj
+}
+
+func newTestFluxStatus(lastHandledReconcileAt string, readyStatus string) map[string]any {
+	return map[string]any{
+		"observedGeneration":     int64(1),
+		"lastHandledReconcileAt": lastHandledReconcileAt,
+		"conditions": []any{
+			map[string]any{
+				"type":    "Ready",
+				"status":  readyStatus,
+				"message": "fake message",
+			},
+		},
+	}
+}
+
+func Test_fluxReconciler_run(t *testing.T) {
+	stepCtx := &promotion.StepContext{
+		Project:   "fake-project",
+		Stage:     "fake-stage",
+		Promotion: "fake-promotion",
+	}
+	authorized := map[string]string{
+		kargoapi.AnnotationKeyAuthorizedStage: "fake-project:fake-stage",
+	}
+	reconciled := map[string]string{
+		kargoapi.AnnotationKeyAuthorizedStage: "fake-project:fake-stage",
+		libflux.ReconcileRequestAnnotation:    "fake-promotion",
+	}
+	stepCfg := builtin.FluxReconcileConfig{
+		Resources: []builtin.FluxResourceReconcile{
+			{
+				Kind: builtin.GitRepository,
+				Name: "fake-repo",
+				Ref:  &builtin.FluxSourceRef{Commit: "abc123"},
+			},
+			{
+				Kind: builtin.Kustomization,
+				Name: "fake-kustomization",
+			},
+		},
+	}
+	gitRepoSpec := map[string]any{
+		"url": "https://github.com/example/repo.git",
+		"ref": map[string]any{"commit": "abc123"},
+	}
+
+	testCases := []struct {
+		name       string
+		client     client.Client
+		assertions func(*testing.T, client.Client, promotion.StepResult, error)
+	}{
+		{
+			name: "Flux integration disabled",
+			assertions: func(t *testing.T, _ client.Client, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "Flux integration is disabled")
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name:   "resource not found",
+			client: fake.NewClientBuilder().WithScheme(runtime.NewScheme()).Build(),
+			assertions: func(t *testing.T, _ client.Client, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "unable to find Flux GitRepository")
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name: "resource not authorized",
+			client: fake.NewClientBuilder().
+				WithScheme(runtime.NewScheme()).
+				WithObjects(
+					newTestFluxResource(libflux.KindGitRepository, "fake-repo", nil, nil, nil),
+				).
+				Build(),
+			assertions: func(t *testing.T, _ client.Client, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "does not permit mutation by Kargo Stage fake-stage")
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name: "first resource updated and reconciliation requested",
+			client: fake.NewClientBuilder().
+				WithScheme(runtime.NewScheme()).
+				WithObjects(
+					newTestFluxResource(
+						libflux.KindGitRepository, "fake-repo", authorized,
+						map[string]any{
+							"url": "https://github.com/example/repo.git",
+							"ref": map[string]any{"branch": "main"},
+						},
+						newTestFluxStatus("", "True"),
+					),
+				).
+				Build(),
+			assertions: func(t *testing.T, c client.Client, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusRunning, res.Status)
+				require.NotNil(t, res.RetryAfter)
+
+				obj, err := libflux.NewObject(libflux.KindGitRepository)
+				require.NoError(t, err)
+				require.NoError(t, c.Get(
+					context.Background(),
+					client.ObjectKey{Namespace: libflux.Namespace(), Name: "fake-repo"},
+					obj,
+				))
+				require.Equal(
+					t,
+					"fake-promotion",
+					obj.GetAnnotations()[libflux.ReconcileRequestAnnotation],
+				)
+				ref, _, err := unstructured.NestedMap(obj.Object, "spec", "ref")
+				require.NoError(t, err)
+				require.Equal(t, map[string]any{"commit": "abc123"}, ref)
+				url, _, err := unstructured.NestedString(obj.Object, "spec", "url")
+				require.NoError(t, err)
+				require.Equal(t, "https://github.com/example/repo.git", url)
+			},
+		},
+		{
+			name: "waiting for first resource to be reconciled",
+			client: fake.NewClientBuilder().
+				WithScheme(runtime.NewScheme()).
+				WithObjects(
+					newTestFluxResource(
+						libflux.KindGitRepository, "fake-repo", reconciled, gitRepoSpec,
+						newTestFluxStatus("", "True"),
+					),
+					newTestFluxResource(
+						libflux.KindKustomization, "fake-kustomization", authorized, nil, nil,
+					),
+				).
+				Build(),
+			assertions: func(t *testing.T, c client.Client, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusRunning, res.Status)
+
+				// The second resource must not have been touched yet
+				obj, err := libflux.NewObject(libflux.KindKustomization)
+				require.NoError(t, err)
+				require.NoError(t, c.Get(
+					context.Background(),
+					client.ObjectKey{Namespace: libflux.Namespace(), Name: "fake-kustomization"},
+					obj,
+				))
+				require.NotContains(t, obj.GetAnnotations(), libflux.ReconcileRequestAnnotation)
+			},
+		},
+		{
+			name: "first resource failed to reconcile",
+			client: fake.NewClientBuilder().
+				WithScheme(runtime.NewScheme()).
+				WithObjects(
+					newTestFluxResource(
+						libflux.KindGitRepository, "fake-repo", reconciled, gitRepoSpec,
+						newTestFluxStatus("fake-promotion", "False"),
+					),
+				).
+				Build(),
+			assertions: func(t *testing.T, _ client.Client, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "reconciliation of Flux GitRepository")
+				require.ErrorContains(t, err, "failed: fake message")
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name: "first resource suspended",
+			client: fake.NewClientBuilder().
+				WithScheme(runtime.NewScheme()).
+				WithObjects(
+					newTestFluxResource(
+						libflux.KindGitRepository, "fake-repo", reconciled,
+						map[string]any{
+							"url":     "https://github.com/example/repo.git",
+							"ref":     map[string]any{"commit": "abc123"},
+							"suspend": true,
+						},
+						nil,
+					),
+				).
+				Build(),
+			assertions: func(t *testing.T, _ client.Client, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "is suspended")
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name: "first resource ready; second resource reconciliation requested",
+			client: fake.NewClientBuilder().
+				WithScheme(runtime.NewScheme()).
+				WithObjects(
+					newTestFluxResource(
+						libflux.KindGitRepository, "fake-repo", reconciled, gitRepoSpec,
+						newTestFluxStatus("fake-promotion", "True"),
+					),
+					newTestFluxResource(
+						libflux.KindKustomization, "fake-kustomization", authorized, nil, nil,
+					),
+				).
+				Build(),
+			assertions: func(t *testing.T, c client.Client, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusRunning, res.Status)
+
+				obj, err := libflux.NewObject(libflux.KindKustomization)
+				require.NoError(t, err)
+				require.NoError(t, c.Get(
+					context.Background(),
+					client.ObjectKey{Namespace: libflux.Namespace(), Name: "fake-kustomization"},
+					obj,
+				))
+				require.Equal(
+					t,
+					"fake-promotion",
+					obj.GetAnnotations()[libflux.ReconcileRequestAnnotation],
+				)
+			},
+		},
+		{
+			name: "all resources ready",
+			client: fake.NewClientBuilder().
+				WithScheme(runtime.NewScheme()).
+				WithObjects(
+					newTestFluxResource(
+						libflux.KindGitRepository, "fake-repo", reconciled, gitRepoSpec,
+						newTestFluxStatus("fake-promotion", "True"),
+					),
+					newTestFluxResource(
+						libflux.KindKustomization, "fake-kustomization", reconciled, nil,
+						newTestFluxStatus("fake-promotion", "True"),
+					),
+				).
+				Build(),
+			assertions: func(t *testing.T, _ client.Client, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+				require.Equal(
+					t,
+					&health.Criteria{
+						Kind: "flux",
+						Input: health.Input{
+							"resources": []checkers.FluxResourceHealthCheck{
+								{
+									Kind:      libflux.KindGitRepository,
+									Name:      "fake-repo",
+									Namespace: libflux.Namespace(),
+								},
+								{
+									Kind:      libflux.KindKustomization,
+									Name:      "fake-kustomization",
+									Namespace: libflux.Namespace(),
+								},
+							},
+						},
+					},
+					res.HealthCheck,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			runner := newFluxReconciler(promotion.StepRunnerCapabilities{
+				FluxClient: testCase.client,
+			})
+			r, ok := runner.(*fluxReconciler)
+			require.True(t, ok)
+			res, err := r.run(context.Background(), stepCtx, stepCfg)
+			testCase.assertions(t, testCase.client, res, err)
+		})
+	}
+}
+
+func Test_fluxReconciler_run_patchError(t *testing.T) {
+	r := &fluxReconciler{
+		fluxClient: fake.NewClientBuilder().WithScheme(runtime.NewScheme()).Build(),
+		getAuthorizedResourceFn: func(
+			context.Context,
+			*promotion.StepContext,
+			string,
+			client.ObjectKey,
+		) (*unstructured.Unstructured, error) {
+			return newTestFluxResource(libflux.KindKustomization, "fake-name", nil, nil, nil), nil
+		},
+		patchResourceFn: func(context.Context, kubeclient.ObjectWithKind, kubeclient.UnstructuredPatchFn) error {
+			return errors.New("something went wrong")
+		},
+	}
+	res, err := r.run(
+		context.Background(),
+		&promotion.StepContext{Promotion: "fake-promotion"},
+		builtin.FluxReconcileConfig{
+			Resources: []builtin.FluxResourceReconcile{{
+				Kind: builtin.Kustomization,
+				Name: "fake-name",
+			}},
+		},
+	)
+	require.ErrorContains(t, err, "error patching Flux Kustomization")
+	require.ErrorContains(t, err, "something went wrong")
+	require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+}
+
+func Test_buildDesiredFluxResource(t *testing.T) {
+	stepCtx := &promotion.StepContext{Promotion: "fake-promotion"}
+
+	testCases := []struct {
+		name       string
+		res        *builtin.FluxResourceReconcile
+		obj        *unstructured.Unstructured
+		assertions func(*testing.T, *unstructured.Unstructured, error)
+	}{
+		{
+			name: "OCIRepository ref replaced",
+			res: &builtin.FluxResourceReconcile{
+				Kind: builtin.OCIRepository,
+				Ref:  &builtin.FluxSourceRef{Digest: "sha256:abc123"},
+			},
+			obj: newTestFluxResource(
+				libflux.KindOCIRepository, "fake-name", nil,
+				map[string]any{"ref": map[string]any{"tag": "latest"}},
+				nil,
+			),
+			assertions: func(t *testing.T, obj *unstructured.Unstructured, err error) {
+				require.NoError(t, err)
+				ref, _, err := unstructured.NestedMap(obj.Object, "spec", "ref")
+				require.NoError(t, err)
+				require.Equal(t, map[string]any{"digest": "sha256:abc123"}, ref)
+				require.Equal(
+					t,
+					"fake-promotion",
+					obj.GetAnnotations()[libflux.ReconcileRequestAnnotation],
+				)
+			},
+		},
+		{
+			name: "HelmRelease chart version updated",
+			res: &builtin.FluxResourceReconcile{
+				Kind:         builtin.HelmRelease,
+				ChartVersion: "1.2.3",
+			},
+			obj: newTestFluxResource(
+				libflux.KindHelmRelease, "fake-name", nil,
+				map[string]any{
+					"chart": map[string]any{
+						"spec": map[string]any{
+							"chart":   "fake-chart",
+							"version": "1.0.0",
+						},
+					},
+				},
+				nil,
+			),
+			assertions: func(t *testing.T, obj *unstructured.Unstructured, err error) {
+				require.NoError(t, err)
+				version, _, err := unstructured.NestedString(
+					obj.Object, "spec", "chart", "spec", "version",
+				)
+				require.NoError(t, err)
+				require.Equal(t, "1.2.3", version)
+				chart, _, err := unstructured.NestedString(
+					obj.Object, "spec", "chart", "spec", "chart",
+				)
+				require.NoError(t, err)
+				require.Equal(t, "fake-chart", chart)
+			},
+		},
+		{
+			name: "HelmRelease using chartRef",
+			res: &builtin.FluxResourceReconcile{
+				Kind:         builtin.HelmRelease,
+				ChartVersion: "1.2.3",
+			},
+			obj: newTestFluxResource(
+				libflux.KindHelmRelease, "fake-name", nil,
+				map[string]any{
+					"chartRef": map[string]any{
+						"kind": "OCIRepository",
+						"name": "fake-chart",
+					},
+				},
+				nil,
+			),
+			assertions: func(t *testing.T, _ *unstructured.Unstructured, err error) {
+				require.ErrorContains(t, err, "HelmReleases that use spec.chartRef are not supported")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			obj, err := buildDesiredFluxResource(stepCtx, testCase.res, testCase.obj)
+			testCase.assertions(t, obj, err)
+		})
+	}
+}
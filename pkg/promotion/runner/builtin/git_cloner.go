@@ -140,6 +140,7 @@ func (g *gitCloner) run(
 	}
 
 	repo, err := git.CloneBare(
+		ctx,
 		cfg.RepoURL,
 		&git.ClientOptions{
 			User:                  &repoUser,
@@ -148,6 +149,7 @@ func (g *gitCloner) run(
 		},
 		&git.BareCloneOptions{
 			BaseDir: stepCtx.WorkDir,
+			Filter:  partialCloneFilter(cfg.PartialClone),
 		},
 	)
 	if err != nil {
@@ -178,7 +180,10 @@ func (g *gitCloner) run(
 		}
 		worktree, err := repo.AddWorkTree(
 			path,
-			&git.AddWorkTreeOptions{Ref: ref},
+			&git.AddWorkTreeOptions{
+				Ref:    ref,
+				Sparse: checkout.Sparse,
+			},
 		)
 		if err != nil {
 			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
@@ -205,6 +210,23 @@ func (g *gitCloner) run(
 	}, nil
 }
 
+// partialCloneFilter returns the Git object filter corresponding to the
+// provided partial clone option. An empty string, indicating a full clone, is
+// returned if no partial clone option was provided.
+func partialCloneFilter(partialClone *builtin.PartialClone) string {
+	if partialClone == nil {
+		return ""
+	}
+	switch *partialClone {
+	case builtin.Blobless:
+		return git.FilterBlobless
+	case builtin.Treeless:
+		return git.FilterTreeless
+	default:
+		return ""
+	}
+}
+
 // ensureRemoteBranch checks for the existence of a remote branch. If the remote
 // branch exists, no action is taken and nil is returned. If the branch does not
 // exist and create == true, an empty orphaned branch is created and pushed to
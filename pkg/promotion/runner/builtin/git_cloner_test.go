@@ -72,6 +72,26 @@ func Test_gitCloner_convert(t *testing.T) {
 				"checkout.0.path: String length must be greater than or equal to 1",
 			},
 		},
+		{
+			name: "partialClone is invalid",
+			config: promotion.Config{
+				"partialClone": "fake-filter",
+			},
+			expectedProblems: []string{
+				"partialClone: partialClone must be one of the following:",
+			},
+		},
+		{
+			name: "sparse directory is empty string",
+			config: promotion.Config{
+				"checkout": []promotion.Config{{
+					"sparse": []string{""},
+				}},
+			},
+			expectedProblems: []string{
+				"checkout.0.sparse.0: String length must be greater than or equal to 1",
+			},
+		},
 		{
 			name: "branch and commit are both specified",
 			// These are meant to be mutually exclusive.
@@ -294,7 +314,12 @@ func Test_gitCloner_convert(t *testing.T) {
 					{
 						"path": "/fake/path/10",
 					},
+					{
+						"path":   "/fake/path/11",
+						"sparse": []string{"envs/prod", "base"},
+					},
 				},
+				"partialClone": "blobless",
 			},
 		},
 	}
@@ -322,7 +347,7 @@ func Test_gitCloner_run(t *testing.T) {
 	testRepoURL := fmt.Sprintf("%s/test.git", server.URL)
 
 	// Create some content and push it to the remote repository's default branch
-	repo, err := git.Clone(testRepoURL, nil, nil)
+	repo, err := git.Clone(context.Background(), testRepoURL, nil, nil)
 	require.NoError(t, err)
 	defer repo.Close()
 	err = os.WriteFile(filepath.Join(repo.Dir(), "test.txt"), []byte("foo"), 0600)
@@ -184,6 +184,7 @@ func Test_gitCommitter_run(t *testing.T) {
 	// gitCloner might have so we can verify gitCommitter's ability to reload the
 	// working tree from the file system.
 	repo, err := git.CloneBare(
+		context.Background(),
 		testRepoURL,
 		nil,
 		&git.BareCloneOptions{
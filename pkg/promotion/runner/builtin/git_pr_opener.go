@@ -121,6 +121,7 @@ func (g *gitPROpener) run(
 	}
 
 	repo, err := git.Clone(
+		ctx,
 		cfg.RepoURL,
 		&git.ClientOptions{
 			Credentials:           repoCreds,
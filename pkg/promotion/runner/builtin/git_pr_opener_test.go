@@ -163,7 +163,7 @@ func Test_gitPROpener_run(t *testing.T) {
 
 	workDir := t.TempDir()
 
-	repo, err := git.Clone(testRepoURL, nil, nil)
+	repo, err := git.Clone(context.Background(), testRepoURL, nil, nil)
 	require.NoError(t, err)
 	defer repo.Close()
 	err = repo.CreateOrphanedBranch(testSourceBranch)
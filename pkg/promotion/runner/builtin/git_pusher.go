@@ -140,6 +140,9 @@ func (g *gitPushPusher) run(
 		// TargetBranch and GenerateTargetBranch are mutually exclusive, so we're
 		// never overwriting a user-specified target branch here.
 		pushOpts.TargetBranch = fmt.Sprintf("kargo/promotion/%s", stepCtx.Promotion)
+		if stepCtx.Verification != "" {
+			pushOpts.TargetBranch = fmt.Sprintf("kargo/verification/%s", stepCtx.Verification)
+		}
 		pushOpts.Force = true
 	}
 	if pushOpts.TargetBranch == "" {
@@ -188,7 +191,10 @@ func (g *gitPushPusher) run(
 			// Special case: A merge conflict requires manual resolution and no amount
 			// of retries will fix that.
 			return promotion.StepResult{Status: kargoapi.PromotionStepStatusFailed},
-				&promotion.TerminalError{Err: err}
+				&promotion.TerminalError{Err: fmt.Errorf(
+					"error rebasing onto remote branch %q: %w",
+					pushOpts.TargetBranch, err,
+				)}
 		}
 		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
 			fmt.Errorf("error pushing commits to remote: %w", err)
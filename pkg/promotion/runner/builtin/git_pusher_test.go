@@ -156,6 +156,7 @@ func Test_gitPusher_run(t *testing.T) {
 	// gitCloner might have so we can verify gitPusher's ability to reload the
 	// working tree from the file system.
 	repo, err := git.CloneBare(
+		context.Background(),
 		testRepoURL,
 		nil,
 		&git.BareCloneOptions{
@@ -97,7 +97,12 @@ func (h *helmTemplateRunner) Run(
 			Status: kargoapi.PromotionStepStatusFailed,
 		}, &promotion.TerminalError{Err: err}
 	}
-	return h.run(ctx, stepCtx, cfg)
+	res, err := h.run(ctx, stepCtx, cfg)
+	if err != nil {
+		// Mirror what the helm CLI would print to stderr on failure.
+		_, _ = fmt.Fprintf(promotion.StepLogFromContext(ctx), "Error: %v\n", err)
+	}
+	return res, err
 }
 
 func (h *helmTemplateRunner) convert(cfg promotion.Config) (builtin.HelmTemplateConfig, error) {
@@ -139,7 +144,12 @@ func (h *helmTemplateRunner) run(
 			fmt.Errorf("missing chart dependencies: %w", err)
 	}
 
-	install, err := h.newInstallAction(cfg, stepCtx.Project, absOutPath)
+	stepLog := promotion.StepLogFromContext(ctx)
+	if chartRequested.Metadata.Deprecated {
+		_, _ = fmt.Fprintln(stepLog, "WARNING: This chart is deprecated")
+	}
+
+	install, err := h.newInstallAction(cfg, stepCtx.Project, absOutPath, stepLog)
 	if err != nil {
 		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
 			fmt.Errorf("failed to initialize Helm action config: %w", err)
@@ -198,11 +208,17 @@ func (h *helmTemplateRunner) composeValues(
 // newInstallAction creates a new Helm install action with the given
 // configuration. It sets the action to dry-run mode and client-only mode,
 // meaning that it will not install the chart, but only render the manifest.
+// Anything Helm logs while rendering is written to the given io.Writer.
 func (h *helmTemplateRunner) newInstallAction(
 	cfg builtin.HelmTemplateConfig,
 	project, absOutPath string,
+	logWriter io.Writer,
 ) (*action.Install, error) {
-	client := action.NewInstall(&action.Configuration{})
+	client := action.NewInstall(&action.Configuration{
+		Log: func(format string, v ...any) {
+			_, _ = fmt.Fprintf(logWriter, format+"\n", v...)
+		},
+	})
 
 	client.DryRun = true
 	client.DryRunOption = "client"
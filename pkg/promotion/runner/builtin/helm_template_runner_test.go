@@ -1,7 +1,9 @@
 package builtin
 
 import (
+	"bytes"
 	"context"
+	"io"
 	"os"
 	"path/filepath"
 	"testing"
@@ -696,6 +698,80 @@ metadata:
 	}
 }
 
+func Test_helmTemplateRunner_Run_stepLog(t *testing.T) {
+	tests := []struct {
+		name       string
+		files      map[string]string
+		assertions func(*testing.T, string, error)
+	}{
+		{
+			name: "warnings but not rendered manifests are written to the step log",
+			files: map[string]string{
+				"chart/Chart.yaml": `apiVersion: v1
+name: test-chart
+version: 0.1.0
+deprecated: true`,
+				"chart/templates/test.yaml": `---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Release.Name }}-configmap
+`,
+			},
+			assertions: func(t *testing.T, stepLog string, err error) {
+				require.NoError(t, err)
+				assert.Contains(t, stepLog, "WARNING: This chart is deprecated")
+				// Rendered manifests may contain Secrets and must never be
+				// written to the step log.
+				assert.NotContains(t, stepLog, "test-release-configmap")
+			},
+		},
+		{
+			name: "errors are written to the step log",
+			files: map[string]string{
+				"chart/Chart.yaml": `apiVersion: v1
+name: test-chart
+version: 0.1.0`,
+				"chart/templates/test.yaml": `{{ fail "intentional failure" }}`,
+			},
+			assertions: func(t *testing.T, stepLog string, err error) {
+				require.ErrorContains(t, err, "intentional failure")
+				assert.Contains(t, stepLog, "Error: failed to render chart")
+				assert.Contains(t, stepLog, "intentional failure")
+			},
+		},
+	}
+
+	runner := newHelmTemplateRunner(promotion.StepRunnerCapabilities{
+		CredsDB: &credentials.FakeDB{},
+	})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workDir := t.TempDir()
+			for p, c := range tt.files {
+				require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(workDir, p)), 0o700))
+				require.NoError(t, os.WriteFile(filepath.Join(workDir, p), []byte(c), 0o600))
+			}
+
+			stepLog := &bytes.Buffer{}
+			_, err := runner.Run(
+				promotion.ContextWithStepLog(context.Background(), stepLog),
+				&promotion.StepContext{
+					WorkDir: workDir,
+					Project: "test-project",
+					Config: promotion.Config{
+						"path":        "chart",
+						"outPath":     "output.yaml",
+						"releaseName": "test-release",
+					},
+				},
+			)
+			tt.assertions(t, stepLog.String(), err)
+		})
+	}
+}
+
 func Test_helmTemplateRunner_composeValues(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -904,7 +980,7 @@ func Test_helmTemplateRunner_newInstallAction(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			client, err := runner.newInstallAction(tt.cfg, tt.project, tt.absOutPath)
+			client, err := runner.newInstallAction(tt.cfg, tt.project, tt.absOutPath, io.Discard)
 			tt.assertions(t, client, err)
 		})
 	}
@@ -0,0 +1,238 @@
+package builtin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/xeipuuv/gojsonschema"
+	"go.yaml.in/yaml/v3"
+	"helm.sh/helm/v3/pkg/chartutil"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/credentials"
+	"github.com/akuity/kargo/pkg/helm"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+	intyaml "github.com/akuity/kargo/pkg/yaml"
+)
+
+const stepKindHelmSetValues = "helm-set-values"
+
+func init() {
+	promotion.RegisterStepRunner(
+		stepKindHelmSetValues,
+		promotion.StepRunnerRegistration{
+			Metadata: promotion.StepRunnerMetadata{
+				RequiredCapabilities: []promotion.StepRunnerCapability{
+					promotion.StepCapabilityAccessCredentials,
+				},
+			},
+			Factory: newHelmValuesSetter,
+		},
+	)
+}
+
+// helmValuesSetter is an implementation of the promotion.StepRunner interface
+// that merges values into a Helm chart's values file.
+type helmValuesSetter struct {
+	schemaLoader gojsonschema.JSONLoader
+	credsDB      credentials.Database
+}
+
+// newHelmValuesSetter returns an implementation of the promotion.StepRunner
+// interface that merges values into a Helm chart's values file.
+func newHelmValuesSetter(caps promotion.StepRunnerCapabilities) promotion.StepRunner {
+	return &helmValuesSetter{
+		credsDB:      caps.CredsDB,
+		schemaLoader: getConfigSchemaLoader(stepKindHelmSetValues),
+	}
+}
+
+// Run implements the promotion.StepRunner interface.
+func (h *helmValuesSetter) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	cfg, err := h.convert(stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+	return h.run(ctx, stepCtx, cfg)
+}
+
+// convert validates helmValuesSetter configuration against a JSON schema and
+// converts it into a builtin.HelmSetValuesConfig struct.
+func (h *helmValuesSetter) convert(cfg promotion.Config) (builtin.HelmSetValuesConfig, error) {
+	return validateAndConvert[builtin.HelmSetValuesConfig](h.schemaLoader, cfg, stepKindHelmSetValues)
+}
+
+func (h *helmValuesSetter) run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	cfg builtin.HelmSetValuesConfig,
+) (promotion.StepResult, error) {
+	absChartPath, err := securejoin.SecureJoin(stepCtx.WorkDir, cfg.Path)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error joining path %q: %w", cfg.Path, err)
+	}
+	valuesFile := cfg.ValuesFile
+	if valuesFile == "" {
+		valuesFile = chartutil.ValuesfileName
+	}
+	absValuesPath, err := securejoin.SecureJoin(absChartPath, valuesFile)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error joining path %q: %w", valuesFile, err)
+	}
+
+	node, err := readValuesFile(absValuesPath)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+	}
+	if err = intyaml.MergeValues(node, cfg.Values); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error merging values into values file %q: %w", valuesFile, err)
+	}
+
+	if err = h.validateValues(absChartPath, absValuesPath, node); err != nil {
+		// Retrying will not make invalid values valid, so there is no point in
+		// treating this as anything other than a terminal failure.
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusFailed},
+			&promotion.TerminalError{
+				Err: fmt.Errorf("values file %q is invalid: %w", valuesFile, err),
+			}
+	}
+
+	b, err := kyaml.Marshal(node)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error marshaling values file %q: %w", valuesFile, err)
+	}
+	if err = os.WriteFile(absValuesPath, b, 0o600); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error writing values file %q: %w", valuesFile, err)
+	}
+
+	var newVersions map[string]string
+	if cfg.UpdateDependencies {
+		manager, err := helm.NewEphemeralDependencyManager(h.credsDB, stepCtx.Project, stepCtx.WorkDir)
+		if err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf("failed to create Helm dependency manager: %w", err)
+		}
+		defer func() {
+			_ = manager.Teardown()
+		}()
+		if newVersions, err = manager.Update(ctx, cfg.Path); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+		}
+	}
+
+	return promotion.StepResult{
+		Status: kargoapi.PromotionStepStatusSucceeded,
+		Output: map[string]any{
+			"commitMessage": h.generateCommitMessage(
+				filepath.ToSlash(filepath.Join(cfg.Path, valuesFile)),
+				cfg.Values,
+				newVersions,
+			),
+		},
+	}, nil
+}
+
+// readValuesFile reads the values file at the specified path into a YAML
+// node. A values file that does not exist yet is treated as empty.
+func readValuesFile(path string) (*yaml.Node, error) {
+	node := &yaml.Node{}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return node, nil
+		}
+		return nil, fmt.Errorf("error reading values file: %w", err)
+	}
+	if err = yaml.Unmarshal(b, node); err != nil {
+		return nil, fmt.Errorf("error unmarshaling values file: %w", err)
+	}
+	return node, nil
+}
+
+// validateValues validates the provided values against the chart's
+// values.schema.json file, if the chart has one. If the values file being
+// updated is not the chart's own values.yaml file, the values are first
+// coalesced with the chart's default values, as Helm would do when installing
+// the chart.
+func (h *helmValuesSetter) validateValues(chartPath, valuesPath string, node *yaml.Node) error {
+	schema, err := os.ReadFile(filepath.Join(chartPath, chartutil.SchemafileName))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("error reading values schema: %w", err)
+	}
+
+	values := map[string]any{}
+	if err = node.Decode(&values); err != nil {
+		return fmt.Errorf("error decoding values: %w", err)
+	}
+
+	defaultsPath := filepath.Join(chartPath, chartutil.ValuesfileName)
+	if valuesPath != defaultsPath {
+		defaults, err := chartutil.ReadValuesFile(defaultsPath)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("error reading chart's default values: %w", err)
+		}
+		values = chartutil.CoalesceTables(values, defaults)
+	}
+
+	return chartutil.ValidateAgainstSingleSchema(values, schema)
+}
+
+func (h *helmValuesSetter) generateCommitMessage(
+	valuesPath string,
+	values map[string]any,
+	newVersions map[string]string,
+) string {
+	var commitMsg strings.Builder
+	_, _ = commitMsg.WriteString(fmt.Sprintf("Updated %s\n", valuesPath))
+	h.writeValues(&commitMsg, "", values)
+	if len(newVersions) > 0 {
+		_, _ = commitMsg.WriteString("\n\nUpdated chart dependencies\n")
+		for _, name := range slices.Sorted(maps.Keys(newVersions)) {
+			change := newVersions[name]
+			if change == "" {
+				change = "removed"
+			}
+			_, _ = commitMsg.WriteString(fmt.Sprintf("\n- %s: %s", name, change))
+		}
+	}
+	return commitMsg.String()
+}
+
+// writeValues writes a line for every leaf of the provided (nested) values to
+// the provided builder, in the same dot-separated key format as used by the
+// yaml-update step.
+func (h *helmValuesSetter) writeValues(b *strings.Builder, prefix string, values map[string]any) {
+	for _, key := range slices.Sorted(maps.Keys(values)) {
+		fullKey := strings.NewReplacer(".", `\.`, ":", `\:`).Replace(key)
+		if prefix != "" {
+			fullKey = prefix + "." + fullKey
+		}
+		if nested, ok := values[key].(map[string]any); ok && len(nested) > 0 {
+			h.writeValues(b, fullKey, nested)
+			continue
+		}
+		_, _ = b.WriteString(fmt.Sprintf("\n- %s: %v", fullKey, intyaml.QuoteIfNecessary(values[key])))
+	}
+}
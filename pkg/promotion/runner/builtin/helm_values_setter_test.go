@@ -0,0 +1,263 @@
+package builtin
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+func Test_helmValuesSetter_convert(t *testing.T) {
+	tests := []validationTestCase{
+		{
+			name:   "path and values are not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): path is required",
+				"(root): values is required",
+			},
+		},
+		{
+			name: "path is empty",
+			config: promotion.Config{
+				"path":   "",
+				"values": map[string]any{"key": "value"},
+			},
+			expectedProblems: []string{
+				"path: String length must be greater than or equal to 1",
+			},
+		},
+		{
+			name: "values are empty",
+			config: promotion.Config{
+				"path":   "fake-path",
+				"values": map[string]any{},
+			},
+			expectedProblems: []string{
+				"values: Must have at least 1 properties",
+			},
+		},
+		{
+			name: "valid kitchen sink",
+			config: promotion.Config{
+				"path":       "fake-path",
+				"valuesFile": "values-prod.yaml",
+				"values": map[string]any{
+					"image": map[string]any{"tag": "1.2.3"},
+				},
+				"updateDependencies": true,
+			},
+		},
+	}
+
+	r := newHelmValuesSetter(promotion.StepRunnerCapabilities{})
+	runner, ok := r.(*helmValuesSetter)
+	require.True(t, ok)
+
+	runValidationTests(t, runner.convert, tests)
+}
+
+func Test_helmValuesSetter_run(t *testing.T) {
+	const testSchema = `{
+  "$schema": "https://json-schema.org/draft-07/schema#",
+  "type": "object",
+  "required": ["image"],
+  "properties": {
+    "image": {
+      "type": "object",
+      "required": ["repository", "tag"],
+      "properties": {
+        "repository": {"type": "string"},
+        "tag": {"type": "string"}
+      }
+    },
+    "replicas": {"type": "integer", "minimum": 1}
+  }
+}`
+
+	tests := []struct {
+		name       string
+		files      map[string]string
+		cfg        builtin.HelmSetValuesConfig
+		assertions func(*testing.T, string, promotion.StepResult, error)
+	}{
+		{
+			name: "merges values preserving comments",
+			files: map[string]string{
+				"chart/Chart.yaml": "apiVersion: v2\nname: test-chart\nversion: 0.1.0\n",
+				"chart/values.yaml": `# Image settings
+image:
+  repository: nginx # The repository
+  tag: 1.0.0
+replicas: 1
+`,
+				"chart/values.schema.json": testSchema,
+			},
+			cfg: builtin.HelmSetValuesConfig{
+				Path: "chart",
+				Values: map[string]any{
+					"image":    map[string]any{"tag": "2.0.0"},
+					"replicas": 3,
+				},
+			},
+			assertions: func(t *testing.T, workDir string, result promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, promotion.StepResult{
+					Status: kargoapi.PromotionStepStatusSucceeded,
+					Output: map[string]any{
+						"commitMessage": "Updated chart/values.yaml\n\n- image.tag: 2.0.0\n- replicas: 3",
+					},
+				}, result)
+				b, err := os.ReadFile(filepath.Join(workDir, "chart", "values.yaml"))
+				require.NoError(t, err)
+				assert.Equal(t, `# Image settings
+image:
+  repository: nginx # The repository
+  tag: 2.0.0
+replicas: 3
+`, string(b))
+			},
+		},
+		{
+			name: "creates values file that does not exist",
+			files: map[string]string{
+				"chart/Chart.yaml":  "apiVersion: v2\nname: test-chart\nversion: 0.1.0\n",
+				"chart/values.yaml": "image:\n  repository: nginx\n  tag: 1.0.0\n",
+			},
+			cfg: builtin.HelmSetValuesConfig{
+				Path:       "chart",
+				ValuesFile: "values-prod.yaml",
+				Values: map[string]any{
+					"podAnnotations": map[string]any{"example.com/stage": "prod"},
+				},
+			},
+			assertions: func(t *testing.T, workDir string, result promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, promotion.StepResult{
+					Status: kargoapi.PromotionStepStatusSucceeded,
+					Output: map[string]any{
+						"commitMessage": "Updated chart/values-prod.yaml\n\n" +
+							`- podAnnotations.example\.com/stage: prod`,
+					},
+				}, result)
+				b, err := os.ReadFile(filepath.Join(workDir, "chart", "values-prod.yaml"))
+				require.NoError(t, err)
+				assert.Equal(t, "podAnnotations:\n  example.com/stage: prod\n", string(b))
+			},
+		},
+		{
+			name: "values violate schema",
+			files: map[string]string{
+				"chart/Chart.yaml":         "apiVersion: v2\nname: test-chart\nversion: 0.1.0\n",
+				"chart/values.yaml":        "image:\n  repository: nginx\n  tag: 1.0.0\nreplicas: 1\n",
+				"chart/values.schema.json": testSchema,
+			},
+			cfg: builtin.HelmSetValuesConfig{
+				Path:   "chart",
+				Values: map[string]any{"replicas": 0},
+			},
+			assertions: func(t *testing.T, workDir string, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, `values file "values.yaml" is invalid`)
+				assert.True(t, promotion.IsTerminal(err))
+				assert.Equal(t, promotion.StepResult{Status: kargoapi.PromotionStepStatusFailed}, result)
+				// The values file should not have been modified
+				b, err := os.ReadFile(filepath.Join(workDir, "chart", "values.yaml"))
+				require.NoError(t, err)
+				assert.Contains(t, string(b), "replicas: 1")
+			},
+		},
+		{
+			name: "override values file is validated with chart defaults",
+			files: map[string]string{
+				"chart/Chart.yaml":         "apiVersion: v2\nname: test-chart\nversion: 0.1.0\n",
+				"chart/values.yaml":        "image:\n  repository: nginx\n  tag: 1.0.0\n",
+				"chart/values.schema.json": testSchema,
+			},
+			cfg: builtin.HelmSetValuesConfig{
+				Path:       "chart",
+				ValuesFile: "values-prod.yaml",
+				Values:     map[string]any{"image": map[string]any{"tag": "2.0.0"}},
+			},
+			assertions: func(t *testing.T, workDir string, result promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, result.Status)
+				b, err := os.ReadFile(filepath.Join(workDir, "chart", "values-prod.yaml"))
+				require.NoError(t, err)
+				assert.Equal(t, "image:\n  tag: 2.0.0\n", string(b))
+			},
+		},
+		{
+			name: "updates dependencies",
+			files: map[string]string{
+				"chart/Chart.yaml": `apiVersion: v2
+name: test-chart
+version: 0.1.0
+dependencies:
+- name: subchart
+  version: 0.1.0
+  repository: file://../subchart
+`,
+				"chart/values.yaml":    "subchart:\n  enabled: false\n",
+				"subchart/Chart.yaml":  "apiVersion: v2\nname: subchart\nversion: 0.1.0\n",
+				"subchart/values.yaml": "enabled: true\n",
+			},
+			cfg: builtin.HelmSetValuesConfig{
+				Path:               "chart",
+				Values:             map[string]any{"subchart": map[string]any{"enabled": true}},
+				UpdateDependencies: true,
+			},
+			assertions: func(t *testing.T, workDir string, result promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, promotion.StepResult{
+					Status: kargoapi.PromotionStepStatusSucceeded,
+					Output: map[string]any{
+						"commitMessage": "Updated chart/values.yaml\n\n- subchart.enabled: true" +
+							"\n\nUpdated chart dependencies\n\n- subchart: 0.1.0",
+					},
+				}, result)
+				assert.FileExists(t, filepath.Join(workDir, "chart", "Chart.lock"))
+				assert.FileExists(t, filepath.Join(workDir, "chart", "charts", "subchart-0.1.0.tgz"))
+			},
+		},
+		{
+			name: "chart does not exist",
+			cfg: builtin.HelmSetValuesConfig{
+				Path:   "chart",
+				Values: map[string]any{"key": "value"},
+			},
+			assertions: func(t *testing.T, _ string, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, `error writing values file "values.yaml"`)
+				assert.Equal(t, promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, result)
+			},
+		},
+	}
+
+	runner := &helmValuesSetter{}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workDir := t.TempDir()
+			for p, content := range tt.files {
+				absPath := filepath.Join(workDir, p)
+				require.NoError(t, os.MkdirAll(filepath.Dir(absPath), 0o700))
+				require.NoError(t, os.WriteFile(absPath, []byte(content), 0o600))
+			}
+			result, err := runner.run(
+				context.Background(),
+				&promotion.StepContext{
+					Project: "test-project",
+					WorkDir: workDir,
+				},
+				tt.cfg,
+			)
+			tt.assertions(t, workDir, result, err)
+		})
+	}
+}
@@ -0,0 +1,820 @@
+package builtin
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/xeipuuv/gojsonschema"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/logging"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+const (
+	stepKindRunJob = "run-job"
+
+	// runJobWorkDirVolumeName is the name of the volume through which the
+	// contents of the promotion's working directory are mounted into the
+	// containers of a Job.
+	runJobWorkDirVolumeName = "kargo-work-dir"
+
+	// maxRunJobWorkDirSize is the maximum total size of the files that can be
+	// mounted into the containers of a Job. This is the maximum size of a
+	// ConfigMap.
+	maxRunJobWorkDirSize = 1 << 20
+
+	// labelKeyRunJob is the label that identifies the Jobs run by the step.
+	labelKeyRunJob = "kargo.akuity.io/run-job"
+
+	// annotationKeyRunJobLogOffsets is the annotation on a Job that records how
+	// many bytes of the logs of each of its containers have been written to the
+	// step's logs already. This permits logs to be written as they are produced
+	// over the course of multiple executions of the step without repeating any.
+	annotationKeyRunJobLogOffsets = "kargo.akuity.io/log-offsets"
+
+	// runJobCleanupTimeout is the maximum amount of time spent deleting a Job
+	// after the step's context was canceled.
+	runJobCleanupTimeout = 10 * time.Second
+)
+
+// runJobPodsClient is the client used by the run-job step to read the logs of
+// the Pods run by its Jobs. The controller-runtime client used for everything
+// else is unable to do this. The step is disabled for as long as this is nil.
+var runJobPodsClient atomic.Pointer[corev1client.PodsGetter]
+
+// EnableRunJobStep enables the run-job step, which will use the provided client
+// to read the logs of the Pods run by its Jobs.
+func EnableRunJobStep(podsClient corev1client.PodsGetter) {
+	runJobPodsClient.Store(&podsClient)
+}
+
+func init() {
+	promotion.RegisterStepRunner(
+		stepKindRunJob,
+		promotion.StepRunnerRegistration{
+			Metadata: promotion.StepRunnerMetadata{
+				DefaultTimeout: 30 * time.Minute,
+				RequiredCapabilities: []promotion.StepRunnerCapability{
+					promotion.StepCapabilityAccessControlPlane,
+				},
+			},
+			Factory: newJobRunner,
+		},
+	)
+}
+
+// jobRunner is an implementation of the promotion.StepRunner interface that
+// runs a Kubernetes Job in the Project namespace and waits for it to complete.
+type jobRunner struct {
+	schemaLoader gojsonschema.JSONLoader
+	kargoClient  client.Client
+	podsClient   corev1client.PodsGetter
+}
+
+// newJobRunner returns an implementation of the promotion.StepRunner interface
+// that runs a Kubernetes Job and waits for it to complete.
+func newJobRunner(caps promotion.StepRunnerCapabilities) promotion.StepRunner {
+	r := &jobRunner{
+		schemaLoader: getConfigSchemaLoader(stepKindRunJob),
+		kargoClient:  caps.KargoClient,
+	}
+	if podsClient := runJobPodsClient.Load(); podsClient != nil {
+		r.podsClient = *podsClient
+	}
+	return r
+}
+
+// Run implements the promotion.StepRunner interface.
+func (j *jobRunner) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	cfg, err := j.convert(stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+	return j.run(ctx, stepCtx, cfg)
+}
+
+// convert validates jobRunner configuration against a JSON schema and converts
+// it into a builtin.RunJobConfig struct.
+func (j *jobRunner) convert(cfg promotion.Config) (builtin.RunJobConfig, error) {
+	stepCfg, err := validateAndConvert[builtin.RunJobConfig](j.schemaLoader, cfg, stepKindRunJob)
+	if err != nil {
+		return stepCfg, err
+	}
+	if _, err = toJobSpec(stepCfg.Spec); err != nil {
+		return stepCfg, err
+	}
+	return stepCfg, nil
+}
+
+// toJobSpec converts the spec from the step's configuration into a
+// batchv1.JobSpec.
+func toJobSpec(spec map[string]any) (*batchv1.JobSpec, error) {
+	b, err := json.Marshal(spec)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling Job spec: %w", err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	jobSpec := &batchv1.JobSpec{}
+	if err = dec.Decode(jobSpec); err != nil {
+		return nil, fmt.Errorf("invalid Job spec: %w", err)
+	}
+	if len(jobSpec.Template.Spec.Containers) == 0 {
+		return nil, errors.New("invalid Job spec: template.spec.containers must not be empty")
+	}
+	if errs := validateRunJobPodSpec(
+		&jobSpec.Template.Spec,
+		field.NewPath("template", "spec"),
+	); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid Job spec: %w", errs.ToAggregate())
+	}
+	return jobSpec, nil
+}
+
+// runJobAllowedCapabilities are the only capabilities that may be added to the
+// containers of a Job. This matches the "restricted" Pod Security Standard.
+var runJobAllowedCapabilities = sets.New[corev1.Capability]("NET_BIND_SERVICE")
+
+// validateRunJobPodSpec validates that the provided PodSpec does not use any of
+// the features that would permit a Job to escape the privileges of the
+// Project namespace's default ServiceAccount, to gain access to the node it
+// runs on, or to read Secrets in the Project namespace. Jobs are created by the
+// controller, so without this, anyone who can edit a Stage could use the
+// controller to escalate their privileges.
+func validateRunJobPodSpec(spec *corev1.PodSpec, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if spec.ServiceAccountName != "" {
+		errs = append(errs, field.Forbidden(path.Child("serviceAccountName"), "must not be set"))
+	}
+	if spec.DeprecatedServiceAccount != "" {
+		errs = append(errs, field.Forbidden(path.Child("serviceAccount"), "must not be set"))
+	}
+	if ptr.Deref(spec.AutomountServiceAccountToken, false) {
+		errs = append(errs, field.Forbidden(path.Child("automountServiceAccountToken"), "must not be true"))
+	}
+	if spec.HostNetwork {
+		errs = append(errs, field.Forbidden(path.Child("hostNetwork"), "must not be true"))
+	}
+	if spec.HostPID {
+		errs = append(errs, field.Forbidden(path.Child("hostPID"), "must not be true"))
+	}
+	if spec.HostIPC {
+		errs = append(errs, field.Forbidden(path.Child("hostIPC"), "must not be true"))
+	}
+	if spec.NodeName != "" {
+		errs = append(errs, field.Forbidden(path.Child("nodeName"), "must not be set"))
+	}
+	if sc := spec.SecurityContext; sc != nil && sc.WindowsOptions != nil &&
+		ptr.Deref(sc.WindowsOptions.HostProcess, false) {
+		errs = append(errs, field.Forbidden(
+			path.Child("securityContext", "windowsOptions", "hostProcess"), "must not be true",
+		))
+	}
+	for i, vol := range spec.Volumes {
+		// Only volume types that do not expose the node or arbitrary storage
+		// are permitted. This matches the "restricted" Pod Security Standard.
+		// Secrets are not permitted either, as they would be mounted using the
+		// controller's permissions rather than those of the user who defined
+		// the step.
+		src := vol.VolumeSource
+		if src.ConfigMap == nil && src.CSI == nil && src.DownwardAPI == nil &&
+			src.EmptyDir == nil && src.Ephemeral == nil &&
+			src.PersistentVolumeClaim == nil && src.Projected == nil {
+			errs = append(errs, field.Forbidden(
+				path.Child("volumes").Index(i),
+				"only configMap, csi, downwardAPI, emptyDir, ephemeral, persistentVolumeClaim, "+
+					"and projected volumes are permitted",
+			))
+		}
+		if src.Projected != nil {
+			for j, projection := range src.Projected.Sources {
+				sourcePath := path.Child("volumes").Index(i).Child("projected", "sources").Index(j)
+				if projection.Secret != nil {
+					errs = append(errs, field.Forbidden(sourcePath.Child("secret"), "must not be set"))
+				}
+				// The ServiceAccount's token is never mounted.
+				if projection.ServiceAccountToken != nil {
+					errs = append(errs, field.Forbidden(sourcePath.Child("serviceAccountToken"), "must not be set"))
+				}
+			}
+		}
+	}
+	for i := range spec.InitContainers {
+		errs = append(errs, validateRunJobContainer(
+			&spec.InitContainers[i], path.Child("initContainers").Index(i),
+		)...)
+	}
+	for i := range spec.Containers {
+		errs = append(errs, validateRunJobContainer(
+			&spec.Containers[i], path.Child("containers").Index(i),
+		)...)
+	}
+	if len(spec.EphemeralContainers) > 0 {
+		errs = append(errs, field.Forbidden(path.Child("ephemeralContainers"), "must not be set"))
+	}
+	return errs
+}
+
+// validateRunJobContainer validates that the provided container of a Job is
+// not privileged, cannot gain any privileges, and does not reference any
+// Secrets.
+func validateRunJobContainer(c *corev1.Container, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	for i, env := range c.Env {
+		if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil {
+			errs = append(errs, field.Forbidden(
+				path.Child("env").Index(i).Child("valueFrom", "secretKeyRef"), "must not be set",
+			))
+		}
+	}
+	for i, envFrom := range c.EnvFrom {
+		if envFrom.SecretRef != nil {
+			errs = append(errs, field.Forbidden(
+				path.Child("envFrom").Index(i).Child("secretRef"), "must not be set",
+			))
+		}
+	}
+	for i, port := range c.Ports {
+		if port.HostPort != 0 {
+			errs = append(errs, field.Forbidden(
+				path.Child("ports").Index(i).Child("hostPort"), "must not be set",
+			))
+		}
+	}
+	sc := c.SecurityContext
+	if sc == nil {
+		return errs
+	}
+	path = path.Child("securityContext")
+	if ptr.Deref(sc.Privileged, false) {
+		errs = append(errs, field.Forbidden(path.Child("privileged"), "must not be true"))
+	}
+	if ptr.Deref(sc.AllowPrivilegeEscalation, false) {
+		errs = append(errs, field.Forbidden(path.Child("allowPrivilegeEscalation"), "must not be true"))
+	}
+	if sc.Capabilities != nil {
+		for i, capability := range sc.Capabilities.Add {
+			if !runJobAllowedCapabilities.Has(capability) {
+				errs = append(errs, field.Forbidden(
+					path.Child("capabilities", "add").Index(i),
+					fmt.Sprintf("capability %q must not be added", capability),
+				))
+			}
+		}
+	}
+	if sc.ProcMount != nil && *sc.ProcMount != corev1.DefaultProcMount {
+		errs = append(errs, field.Forbidden(path.Child("procMount"), "must not be set"))
+	}
+	if sc.WindowsOptions != nil && ptr.Deref(sc.WindowsOptions.HostProcess, false) {
+		errs = append(errs, field.Forbidden(path.Child("windowsOptions", "hostProcess"), "must not be true"))
+	}
+	return errs
+}
+
+func (j *jobRunner) run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	cfg builtin.RunJobConfig,
+) (promotion.StepResult, error) {
+	if j.podsClient == nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			errors.New("the run-job step is not enabled on this controller")
+	}
+
+	key := client.ObjectKey{
+		Namespace: stepCtx.Project,
+		Name:      runJobName(stepCtx),
+	}
+	result, err := j.reconcileJob(ctx, stepCtx, cfg, key)
+	if ctx.Err() != nil && (err != nil || result.Status == kargoapi.PromotionStepStatusRunning) {
+		// The step timed out or the promotion was aborted while the Job was
+		// running, so nothing is waiting for the Job anymore.
+		j.deleteJob(context.WithoutCancel(ctx), key)
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf("step timed out while Job %q was running; the Job was deleted", key.Name)
+		}
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("step was canceled while Job %q was running; the Job was deleted", key.Name)
+	}
+	return result, err
+}
+
+// reconcileJob creates the Job run by the step if it does not exist yet and
+// otherwise maps the Job's status to the step's result. Logs produced by the
+// Job since the previous execution of the step are written to the step's
+// logs.
+func (j *jobRunner) reconcileJob(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	cfg builtin.RunJobConfig,
+	key client.ObjectKey,
+) (promotion.StepResult, error) {
+	logger := logging.LoggerFromContext(ctx).WithValues("job", key.Name)
+
+	job := &batchv1.Job{}
+	if err := j.kargoClient.Get(ctx, key, job); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf("error getting Job %q in namespace %q: %w", key.Name, key.Namespace, err)
+		}
+		if err = j.createJob(ctx, stepCtx, cfg, key); err != nil {
+			if promotion.IsTerminal(err) {
+				return promotion.StepResult{Status: kargoapi.PromotionStepStatusFailed}, err
+			}
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+		}
+		logger.Debug("created Job")
+		return j.runningResult(), nil
+	}
+
+	switch {
+	case job.DeletionTimestamp != nil:
+		// This is the Job from a previous, failed attempt that has not been
+		// deleted yet. A new Job will be created once it is gone.
+		return j.runningResult(), nil
+	case hasJobCondition(job, batchv1.JobComplete):
+		logger.Debug("Job succeeded")
+		j.writePodLogs(ctx, job)
+		j.deleteWorkDirConfigMap(ctx, key)
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusSucceeded,
+			Output: map[string]any{"jobName": job.Name},
+		}, nil
+	case hasJobCondition(job, batchv1.JobFailed):
+		logger.Debug("Job failed")
+		exitCode := j.writePodLogs(ctx, job)
+		// Delete the failed Job so that a new one is created if the step is
+		// retried.
+		if err := j.kargoClient.Delete(
+			ctx,
+			job,
+			client.PropagationPolicy(metav1.DeletePropagationBackground),
+		); client.IgnoreNotFound(err) != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf("error deleting failed Job %q in namespace %q: %w", job.Name, job.Namespace, err)
+		}
+		j.deleteWorkDirConfigMap(ctx, key)
+		err := fmt.Errorf("job %q failed", job.Name)
+		if exitCode != nil {
+			err = fmt.Errorf("job %q failed with exit code %d", job.Name, *exitCode)
+		}
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusFailed}, err
+	default:
+		// Write the logs produced so far, so they can be followed while the Job
+		// is running.
+		j.writePodLogs(ctx, job)
+		return j.runningResult(), nil
+	}
+}
+
+// deleteJob deletes the specified Job, its Pods, and the ConfigMap containing
+// the contents of the promotion's working directory, if any. Failures are
+// logged, but are otherwise ignored, as the Job is owned by the Promotion and
+// will be deleted along with it regardless.
+func (j *jobRunner) deleteJob(ctx context.Context, key client.ObjectKey) {
+	ctx, cancel := context.WithTimeout(ctx, runJobCleanupTimeout)
+	defer cancel()
+	logger := logging.LoggerFromContext(ctx).WithValues("job", key.Name)
+	if err := j.kargoClient.Delete(
+		ctx,
+		&batchv1.Job{ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name}},
+		client.PropagationPolicy(metav1.DeletePropagationBackground),
+	); client.IgnoreNotFound(err) != nil {
+		logger.Error(err, "error deleting Job")
+	}
+	j.deleteWorkDirConfigMap(ctx, key)
+}
+
+// deleteWorkDirConfigMap deletes the ConfigMap containing the contents of the
+// promotion's working directory that was mounted into the specified Job, if
+// any. It is no longer needed once the Job has finished. Failures are logged,
+// but are otherwise ignored.
+func (j *jobRunner) deleteWorkDirConfigMap(ctx context.Context, key client.ObjectKey) {
+	if err := j.kargoClient.Delete(
+		ctx,
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name}},
+	); client.IgnoreNotFound(err) != nil {
+		logging.LoggerFromContext(ctx).Error(err, "error deleting ConfigMap", "job", key.Name)
+	}
+}
+
+// DeleteUnfinishedRunJobs deletes the Jobs run by run-job steps of the provided
+// Promotion which have not finished, along with their Pods and ConfigMaps. It
+// is meant to be called when the Promotion is aborted or has otherwise
+// finished, as nothing is waiting for these Jobs anymore. It does nothing if
+// the run-job step is not enabled.
+func DeleteUnfinishedRunJobs(
+	ctx context.Context,
+	c client.Client,
+	promo *kargoapi.Promotion,
+) error {
+	if runJobPodsClient.Load() == nil {
+		return nil
+	}
+	jobs := &batchv1.JobList{}
+	if err := c.List(
+		ctx,
+		jobs,
+		client.InNamespace(promo.Namespace),
+		client.MatchingLabels{labelKeyRunJob: kargoapi.LabelValueTrue},
+	); err != nil {
+		return fmt.Errorf("error listing Jobs in namespace %q: %w", promo.Namespace, err)
+	}
+	r := &jobRunner{kargoClient: c}
+	for _, job := range jobs.Items {
+		if !isOwnedBy(&job, promo) ||
+			hasJobCondition(&job, batchv1.JobComplete) ||
+			hasJobCondition(&job, batchv1.JobFailed) {
+			continue
+		}
+		r.deleteJob(ctx, client.ObjectKeyFromObject(&job))
+	}
+	return nil
+}
+
+// isOwnedBy returns true if the provided object has an owner reference to the
+// provided owner.
+func isOwnedBy(obj metav1.Object, owner metav1.Object) bool {
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.UID == owner.GetUID() {
+			return true
+		}
+	}
+	return false
+}
+
+func (j *jobRunner) runningResult() promotion.StepResult {
+	return promotion.StepResult{
+		Status:     kargoapi.PromotionStepStatusRunning,
+		RetryAfter: ptr.To(10 * time.Second),
+	}
+}
+
+// runJobName returns the name of the Job run by the step with the provided
+// context. The name is stable across reconciliations of the same Promotion or
+// verification, so a Job that has already been created is found again.
+func runJobName(stepCtx *promotion.StepContext) string {
+	sum := sha256.Sum256([]byte(stepCtx.ExecutionID() + "/" + stepCtx.Alias))
+	return "run-job-" + hex.EncodeToString(sum[:])[:16]
+}
+
+// buildJob builds the Job described by the provided configuration.
+func buildJob(
+	stepCtx *promotion.StepContext,
+	cfg builtin.RunJobConfig,
+	key client.ObjectKey,
+) (*batchv1.Job, error) {
+	spec, err := toJobSpec(cfg.Spec)
+	if err != nil {
+		return nil, err
+	}
+	if spec.BackoffLimit == nil {
+		spec.BackoffLimit = ptr.To[int32](0)
+	}
+	// The Job runs as the Project namespace's default ServiceAccount. Its token
+	// is never mounted, so the Job cannot act on the cluster with it.
+	spec.Template.Spec.AutomountServiceAccountToken = ptr.To(false)
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: key.Namespace,
+			Name:      key.Name,
+			Labels: map[string]string{
+				labelKeyRunJob: kargoapi.LabelValueTrue,
+			},
+		},
+		Spec: *spec,
+	}
+	for k, v := range map[string]string{
+		kargoapi.LabelKeyStage:     stepCtx.Stage,
+		kargoapi.LabelKeyPromotion: stepCtx.Promotion,
+	} {
+		if v != "" && len(validation.IsValidLabelValue(v)) == 0 {
+			job.Labels[k] = v
+		}
+	}
+
+	if cfg.WorkDir != nil {
+		podSpec := &job.Spec.Template.Spec
+		podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+			Name: runJobWorkDirVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: job.Name},
+				},
+			},
+		})
+		mount := corev1.VolumeMount{
+			Name:      runJobWorkDirVolumeName,
+			MountPath: cfg.WorkDir.MountPath,
+			ReadOnly:  true,
+		}
+		for i := range podSpec.InitContainers {
+			podSpec.InitContainers[i].VolumeMounts = append(podSpec.InitContainers[i].VolumeMounts, mount)
+		}
+		for i := range podSpec.Containers {
+			podSpec.Containers[i].VolumeMounts = append(podSpec.Containers[i].VolumeMounts, mount)
+		}
+	}
+
+	return job, nil
+}
+
+// setOwner makes the Promotion the step is running for the owner of the
+// provided object, so that the object is garbage collected along with the
+// Promotion. For the verification steps of a Stage, the Stage is made the
+// owner instead. This is skipped if the owner cannot be found.
+func (j *jobRunner) setOwner(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	obj client.Object,
+) error {
+	var owner client.Object = &kargoapi.Promotion{}
+	kind, name := "Promotion", stepCtx.Promotion
+	if stepCtx.Verification != "" {
+		owner = &kargoapi.Stage{}
+		kind, name = "Stage", stepCtx.Stage
+	}
+	if err := j.kargoClient.Get(
+		ctx,
+		client.ObjectKey{Namespace: stepCtx.Project, Name: name},
+		owner,
+	); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("error getting %s %q in namespace %q: %w", kind, name, stepCtx.Project, err)
+	}
+	if err := controllerutil.SetOwnerReference(owner, obj, j.kargoClient.Scheme()); err != nil {
+		return fmt.Errorf("error setting owner reference: %w", err)
+	}
+	return nil
+}
+
+// createJob creates the Job described by the provided configuration, along
+// with the ConfigMap containing the contents of the promotion's working
+// directory if they are to be mounted. Errors that retrying cannot resolve are
+// returned as a promotion.TerminalError.
+func (j *jobRunner) createJob(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	cfg builtin.RunJobConfig,
+	key client.ObjectKey,
+) error {
+	job, err := buildJob(stepCtx, cfg, key)
+	if err != nil {
+		return &promotion.TerminalError{Err: err}
+	}
+	if err = j.setOwner(ctx, stepCtx, job); err != nil {
+		return err
+	}
+	if cfg.WorkDir != nil {
+		var cm *corev1.ConfigMap
+		var items []corev1.KeyToPath
+		if cm, items, err = buildWorkDirConfigMap(stepCtx.WorkDir, cfg.WorkDir.Path); err != nil {
+			return &promotion.TerminalError{Err: err}
+		}
+		cm.Namespace = job.Namespace
+		cm.Name = job.Name
+		cm.Labels = job.Labels
+		cm.OwnerReferences = job.OwnerReferences
+		existing := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: cm.Namespace, Name: cm.Name}}
+		if _, err = controllerutil.CreateOrUpdate(ctx, j.kargoClient, existing, func() error {
+			existing.Labels = cm.Labels
+			existing.OwnerReferences = cm.OwnerReferences
+			existing.Data = cm.Data
+			existing.BinaryData = cm.BinaryData
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error creating ConfigMap %q in namespace %q: %w", cm.Name, cm.Namespace, err)
+		}
+		for i := range job.Spec.Template.Spec.Volumes {
+			if vol := &job.Spec.Template.Spec.Volumes[i]; vol.Name == runJobWorkDirVolumeName {
+				vol.ConfigMap.Items = items
+			}
+		}
+	}
+	if err = j.kargoClient.Create(ctx, job); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("error creating Job %q in namespace %q: %w", job.Name, job.Namespace, err)
+	}
+	return nil
+}
+
+// buildWorkDirConfigMap returns a ConfigMap containing the files in the
+// specified directory of the working directory, along with the items that
+// project each of them into a volume at their original relative path. Keys
+// of a ConfigMap cannot contain path separators, so files are keyed by their
+// index instead.
+func buildWorkDirConfigMap(
+	workDir string,
+	path string,
+) (*corev1.ConfigMap, []corev1.KeyToPath, error) {
+	absPath, err := securejoin.SecureJoin(workDir, path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error joining path %q: %w", path, err)
+	}
+	cm := &corev1.ConfigMap{
+		Data:       map[string]string{},
+		BinaryData: map[string][]byte{},
+	}
+	var items []corev1.KeyToPath
+	var size int
+	if err = filepath.WalkDir(absPath, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if d.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		b, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		if size += len(b); size > maxRunJobWorkDirSize {
+			return fmt.Errorf("contents of %q exceed the maximum size of %d bytes", path, maxRunJobWorkDirSize)
+		}
+		relPath, err := filepath.Rel(absPath, p)
+		if err != nil {
+			return err
+		}
+		key := fmt.Sprintf("f%d", len(items))
+		if utf8.Valid(b) {
+			cm.Data[key] = string(b)
+		} else {
+			cm.BinaryData[key] = b
+		}
+		items = append(items, corev1.KeyToPath{Key: key, Path: filepath.ToSlash(relPath)})
+		return nil
+	}); err != nil {
+		return nil, nil, fmt.Errorf("error reading directory %q: %w", path, err)
+	}
+	return cm, items, nil
+}
+
+// hasJobCondition returns true if the provided Job has a condition of the
+// specified type with a status of True.
+func hasJobCondition(job *batchv1.Job, condType batchv1.JobConditionType) bool {
+	for _, cond := range job.Status.Conditions {
+		if cond.Type == condType && cond.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// writePodLogs writes the logs of all containers of all Pods run by the
+// provided Job to the step's logs. Only the logs produced since the previous
+// call for the same Job are written, as recorded in the Job's log offsets
+// annotation. It returns the exit code of the first container of the most
+// recent Pod that terminated with a non-zero exit code, if any. Failures to
+// retrieve logs are not fatal to the step.
+func (j *jobRunner) writePodLogs(ctx context.Context, job *batchv1.Job) *int32 {
+	logger := logging.LoggerFromContext(ctx)
+	w := promotion.StepLogFromContext(ctx)
+
+	offsets := map[string]int64{}
+	if v, ok := job.Annotations[annotationKeyRunJobLogOffsets]; ok {
+		if err := json.Unmarshal([]byte(v), &offsets); err != nil {
+			logger.Error(err, "error parsing log offsets of Job")
+		}
+	}
+	var offsetsChanged bool
+
+	pods := &corev1.PodList{}
+	if err := j.kargoClient.List(
+		ctx,
+		pods,
+		client.InNamespace(job.Namespace),
+		client.MatchingLabels{batchv1.JobNameLabel: job.Name},
+	); err != nil {
+		logger.Error(err, "error listing Pods of Job")
+		return nil
+	}
+	slices.SortFunc(pods.Items, func(a, b corev1.Pod) int {
+		return a.CreationTimestamp.Compare(b.CreationTimestamp.Time)
+	})
+
+	var exitCode *int32
+	for _, pod := range pods.Items {
+		// Only the exit code of the most recent Pod is of interest.
+		exitCode = nil
+		statuses := slices.Concat(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses)
+		for _, status := range statuses {
+			if term := status.State.Terminated; term != nil && term.ExitCode != 0 && exitCode == nil {
+				exitCode = ptr.To(term.ExitCode)
+			}
+			if status.State.Running == nil && status.State.Terminated == nil {
+				// The container has not started yet, so there are no logs.
+				continue
+			}
+			key := pod.Name + "/" + status.Name
+			offset, seen := offsets[key]
+			if !seen {
+				_, _ = fmt.Fprintf(w, "==> %s <==\n", key)
+				offsets[key] = 0
+				offsetsChanged = true
+			}
+			n, err := j.copyContainerLogs(ctx, w, pod, status.Name, offset)
+			if n > 0 {
+				offsets[key] += n
+				offsetsChanged = true
+			}
+			if err != nil {
+				logger.Error(err, "error getting container logs", "pod", pod.Name, "container", status.Name)
+			}
+		}
+	}
+
+	if offsetsChanged {
+		if err := j.patchLogOffsets(ctx, job, offsets); err != nil {
+			logger.Error(err, "error recording log offsets of Job")
+		}
+	}
+	return exitCode
+}
+
+// patchLogOffsets records the provided log offsets in the log offsets
+// annotation of the provided Job.
+func (j *jobRunner) patchLogOffsets(
+	ctx context.Context,
+	job *batchv1.Job,
+	offsets map[string]int64,
+) error {
+	b, err := json.Marshal(offsets)
+	if err != nil {
+		return err
+	}
+	patch := client.MergeFrom(job.DeepCopy())
+	if job.Annotations == nil {
+		job.Annotations = map[string]string{}
+	}
+	job.Annotations[annotationKeyRunJobLogOffsets] = string(b)
+	return j.kargoClient.Patch(ctx, job, patch)
+}
+
+// copyContainerLogs copies the logs of the specified container to the
+// provided io.Writer, skipping the number of bytes specified by offset, which
+// have been copied previously. It returns the number of bytes copied.
+func (j *jobRunner) copyContainerLogs(
+	ctx context.Context,
+	w io.Writer,
+	pod corev1.Pod,
+	container string,
+	offset int64,
+) (int64, error) {
+	stream, err := j.podsClient.Pods(pod.Namespace).GetLogs(
+		pod.Name,
+		&corev1.PodLogOptions{Container: container},
+	).Stream(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer stream.Close()
+	if _, err = io.CopyN(io.Discard, stream, offset); err != nil {
+		if errors.Is(err, io.EOF) {
+			// Nothing new has been logged.
+			return 0, nil
+		}
+		return 0, err
+	}
+	return io.Copy(w, stream)
+}
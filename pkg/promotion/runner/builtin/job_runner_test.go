@@ -0,0 +1,1025 @@
+package builtin
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+func Test_jobRunner_convert(t *testing.T) {
+	tests := []validationTestCase{
+		{
+			name:   "spec not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): spec is required",
+			},
+		},
+		{
+			name: "spec is empty",
+			config: promotion.Config{
+				"spec": map[string]any{},
+			},
+			expectedProblems: []string{
+				"spec: Must have at least 1 properties",
+			},
+		},
+		{
+			name: "spec has unknown fields",
+			config: promotion.Config{
+				"spec": map[string]any{
+					"bogus": true,
+				},
+			},
+			expectedProblems: []string{
+				`invalid Job spec: json: unknown field "bogus"`,
+			},
+		},
+		{
+			name: "spec has no containers",
+			config: promotion.Config{
+				"spec": map[string]any{
+					"template": map[string]any{
+						"spec": map[string]any{},
+					},
+				},
+			},
+			expectedProblems: []string{
+				"template.spec.containers must not be empty",
+			},
+		},
+		{
+			name: "serviceAccountName is set",
+			config: promotion.Config{
+				"spec": testJobSpecWith(func(podSpec map[string]any) {
+					podSpec["serviceAccountName"] = "kargo-controller"
+				}),
+			},
+			expectedProblems: []string{
+				"template.spec.serviceAccountName: Forbidden",
+			},
+		},
+		{
+			name: "serviceAccount is set",
+			config: promotion.Config{
+				"spec": testJobSpecWith(func(podSpec map[string]any) {
+					podSpec["serviceAccount"] = "kargo-controller"
+				}),
+			},
+			expectedProblems: []string{
+				"template.spec.serviceAccount: Forbidden",
+			},
+		},
+		{
+			name: "automountServiceAccountToken is true",
+			config: promotion.Config{
+				"spec": testJobSpecWith(func(podSpec map[string]any) {
+					podSpec["automountServiceAccountToken"] = true
+				}),
+			},
+			expectedProblems: []string{
+				"template.spec.automountServiceAccountToken: Forbidden",
+			},
+		},
+		{
+			name: "hostNetwork is true",
+			config: promotion.Config{
+				"spec": testJobSpecWith(func(podSpec map[string]any) {
+					podSpec["hostNetwork"] = true
+				}),
+			},
+			expectedProblems: []string{
+				"template.spec.hostNetwork: Forbidden",
+			},
+		},
+		{
+			name: "hostPID is true",
+			config: promotion.Config{
+				"spec": testJobSpecWith(func(podSpec map[string]any) {
+					podSpec["hostPID"] = true
+				}),
+			},
+			expectedProblems: []string{
+				"template.spec.hostPID: Forbidden",
+			},
+		},
+		{
+			name: "hostIPC is true",
+			config: promotion.Config{
+				"spec": testJobSpecWith(func(podSpec map[string]any) {
+					podSpec["hostIPC"] = true
+				}),
+			},
+			expectedProblems: []string{
+				"template.spec.hostIPC: Forbidden",
+			},
+		},
+		{
+			name: "nodeName is set",
+			config: promotion.Config{
+				"spec": testJobSpecWith(func(podSpec map[string]any) {
+					podSpec["nodeName"] = "fake-node"
+				}),
+			},
+			expectedProblems: []string{
+				"template.spec.nodeName: Forbidden",
+			},
+		},
+		{
+			name: "hostPath volume",
+			config: promotion.Config{
+				"spec": testJobSpecWith(func(podSpec map[string]any) {
+					podSpec["volumes"] = []any{
+						map[string]any{
+							"name":     "host",
+							"hostPath": map[string]any{"path": "/"},
+						},
+					}
+				}),
+			},
+			expectedProblems: []string{
+				"template.spec.volumes[0]: Forbidden",
+			},
+		},
+		{
+			name: "secret volume",
+			config: promotion.Config{
+				"spec": testJobSpecWith(func(podSpec map[string]any) {
+					podSpec["volumes"] = []any{
+						map[string]any{
+							"name":   "creds",
+							"secret": map[string]any{"secretName": "repo-creds"},
+						},
+					}
+				}),
+			},
+			expectedProblems: []string{
+				"template.spec.volumes[0]: Forbidden",
+			},
+		},
+		{
+			name: "projected secret and service account token",
+			config: promotion.Config{
+				"spec": testJobSpecWith(func(podSpec map[string]any) {
+					podSpec["volumes"] = []any{
+						map[string]any{
+							"name": "creds",
+							"projected": map[string]any{
+								"sources": []any{
+									map[string]any{
+										"configMap": map[string]any{"name": "config"},
+									},
+									map[string]any{
+										"secret": map[string]any{"name": "repo-creds"},
+									},
+									map[string]any{
+										"serviceAccountToken": map[string]any{"path": "token"},
+									},
+								},
+							},
+						},
+					}
+				}),
+			},
+			expectedProblems: []string{
+				"template.spec.volumes[0].projected.sources[1].secret: Forbidden",
+				"template.spec.volumes[0].projected.sources[2].serviceAccountToken: Forbidden",
+			},
+		},
+		{
+			name: "secret env var",
+			config: promotion.Config{
+				"spec": testJobSpecWith(func(podSpec map[string]any) {
+					podSpec["containers"] = []any{
+						map[string]any{
+							"name":  "test",
+							"image": "alpine",
+							"env": []any{
+								map[string]any{
+									"name": "PASSWORD",
+									"valueFrom": map[string]any{
+										"secretKeyRef": map[string]any{
+											"name": "repo-creds",
+											"key":  "password",
+										},
+									},
+								},
+							},
+						},
+					}
+				}),
+			},
+			expectedProblems: []string{
+				"template.spec.containers[0].env[0].valueFrom.secretKeyRef: Forbidden",
+			},
+		},
+		{
+			name: "secret env source",
+			config: promotion.Config{
+				"spec": testJobSpecWith(func(podSpec map[string]any) {
+					podSpec["initContainers"] = []any{
+						map[string]any{
+							"name":  "init",
+							"image": "alpine",
+							"envFrom": []any{
+								map[string]any{
+									"secretRef": map[string]any{"name": "repo-creds"},
+								},
+							},
+						},
+					}
+				}),
+			},
+			expectedProblems: []string{
+				"template.spec.initContainers[0].envFrom[0].secretRef: Forbidden",
+			},
+		},
+		{
+			name: "privileged container",
+			config: promotion.Config{
+				"spec": testJobSpecWith(func(podSpec map[string]any) {
+					container(podSpec)["securityContext"] = map[string]any{
+						"privileged": true,
+					}
+				}),
+			},
+			expectedProblems: []string{
+				"template.spec.containers[0].securityContext.privileged: Forbidden",
+			},
+		},
+		{
+			name: "privilege escalation allowed",
+			config: promotion.Config{
+				"spec": testJobSpecWith(func(podSpec map[string]any) {
+					container(podSpec)["securityContext"] = map[string]any{
+						"allowPrivilegeEscalation": true,
+					}
+				}),
+			},
+			expectedProblems: []string{
+				"template.spec.containers[0].securityContext.allowPrivilegeEscalation: Forbidden",
+			},
+		},
+		{
+			name: "capability added",
+			config: promotion.Config{
+				"spec": testJobSpecWith(func(podSpec map[string]any) {
+					container(podSpec)["securityContext"] = map[string]any{
+						"capabilities": map[string]any{
+							"add": []any{"NET_BIND_SERVICE", "SYS_ADMIN"},
+						},
+					}
+				}),
+			},
+			expectedProblems: []string{
+				"template.spec.containers[0].securityContext.capabilities.add[1]: Forbidden: capability \"SYS_ADMIN\"",
+			},
+		},
+		{
+			name: "unmasked procMount",
+			config: promotion.Config{
+				"spec": testJobSpecWith(func(podSpec map[string]any) {
+					container(podSpec)["securityContext"] = map[string]any{
+						"procMount": "Unmasked",
+					}
+				}),
+			},
+			expectedProblems: []string{
+				"template.spec.containers[0].securityContext.procMount: Forbidden",
+			},
+		},
+		{
+			name: "host process",
+			config: promotion.Config{
+				"spec": testJobSpecWith(func(podSpec map[string]any) {
+					container(podSpec)["securityContext"] = map[string]any{
+						"windowsOptions": map[string]any{"hostProcess": true},
+					}
+				}),
+			},
+			expectedProblems: []string{
+				"template.spec.containers[0].securityContext.windowsOptions.hostProcess: Forbidden",
+			},
+		},
+		{
+			name: "host port",
+			config: promotion.Config{
+				"spec": testJobSpecWith(func(podSpec map[string]any) {
+					container(podSpec)["ports"] = []any{
+						map[string]any{"containerPort": 80, "hostPort": 80},
+					}
+				}),
+			},
+			expectedProblems: []string{
+				"template.spec.containers[0].ports[0].hostPort: Forbidden",
+			},
+		},
+		{
+			name: "privileged init container",
+			config: promotion.Config{
+				"spec": testJobSpecWith(func(podSpec map[string]any) {
+					podSpec["initContainers"] = []any{
+						map[string]any{
+							"name":            "init",
+							"image":           "busybox",
+							"securityContext": map[string]any{"privileged": true},
+						},
+					}
+				}),
+			},
+			expectedProblems: []string{
+				"template.spec.initContainers[0].securityContext.privileged: Forbidden",
+			},
+		},
+		{
+			name: "ephemeral containers",
+			config: promotion.Config{
+				"spec": testJobSpecWith(func(podSpec map[string]any) {
+					podSpec["ephemeralContainers"] = []any{
+						map[string]any{"name": "debug", "image": "busybox"},
+					}
+				}),
+			},
+			expectedProblems: []string{
+				"template.spec.ephemeralContainers: Forbidden",
+			},
+		},
+		{
+			name: "workDir mountPath not specified",
+			config: promotion.Config{
+				"spec":    testJobSpec(),
+				"workDir": map[string]any{},
+			},
+			expectedProblems: []string{
+				"workDir: mountPath is required",
+			},
+		},
+		{
+			name: "workDir mountPath is relative",
+			config: promotion.Config{
+				"spec": testJobSpec(),
+				"workDir": map[string]any{
+					"mountPath": "workspace",
+				},
+			},
+			expectedProblems: []string{
+				"workDir.mountPath: Does not match pattern",
+			},
+		},
+		{
+			name: "valid kitchen sink",
+			config: promotion.Config{
+				"spec": testJobSpec(),
+				"workDir": map[string]any{
+					"path":      "src",
+					"mountPath": "/workspace",
+				},
+			},
+		},
+	}
+
+	r := newJobRunner(promotion.StepRunnerCapabilities{})
+	runner, ok := r.(*jobRunner)
+	require.True(t, ok)
+
+	runValidationTests(t, runner.convert, tests)
+}
+
+func Test_jobRunner_run(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, batchv1.AddToScheme(scheme))
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	testPromo := &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-project",
+			Name:      "fake-promotion",
+			UID:       "fake-uid",
+		},
+	}
+
+	newStepCtx := func(t *testing.T) *promotion.StepContext {
+		return &promotion.StepContext{
+			Project:   testPromo.Namespace,
+			Stage:     "fake-stage",
+			Promotion: testPromo.Name,
+			Alias:     "run-tests",
+			WorkDir:   t.TempDir(),
+		}
+	}
+
+	newJob := func(stepCtx *promotion.StepContext, status batchv1.JobStatus) *batchv1.Job {
+		return &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: stepCtx.Project,
+				Name:      runJobName(stepCtx),
+			},
+			Status: status,
+		}
+	}
+
+	newPod := func(stepCtx *promotion.StepContext, exitCode int32) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: stepCtx.Project,
+				Name:      runJobName(stepCtx) + "-abcde",
+				Labels:    map[string]string{batchv1.JobNameLabel: runJobName(stepCtx)},
+			},
+			Status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{{
+					Name: "main",
+					State: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{ExitCode: exitCode},
+					},
+				}},
+			},
+		}
+	}
+
+	newRunningPod := func(stepCtx *promotion.StepContext) *corev1.Pod {
+		pod := newPod(stepCtx, 0)
+		pod.Status.ContainerStatuses[0].State = corev1.ContainerState{
+			Running: &corev1.ContainerStateRunning{},
+		}
+		return pod
+	}
+
+	tests := []struct {
+		name       string
+		files      map[string]string
+		cfg        builtin.RunJobConfig
+		disabled   bool
+		canceled   bool
+		objects    func(*promotion.StepContext) []client.Object
+		assertions func(*testing.T, *promotion.StepContext, client.Client, string, promotion.StepResult, error)
+	}{
+		{
+			name:     "step is not enabled",
+			cfg:      builtin.RunJobConfig{Spec: testJobSpec()},
+			disabled: true,
+			assertions: func(
+				t *testing.T,
+				_ *promotion.StepContext,
+				_ client.Client,
+				_ string,
+				result promotion.StepResult,
+				err error,
+			) {
+				require.ErrorContains(t, err, "not enabled on this controller")
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, result.Status)
+			},
+		},
+		{
+			name: "creates Job",
+			cfg:  builtin.RunJobConfig{Spec: testJobSpec()},
+			objects: func(*promotion.StepContext) []client.Object {
+				return []client.Object{testPromo.DeepCopy()}
+			},
+			assertions: func(
+				t *testing.T,
+				stepCtx *promotion.StepContext,
+				c client.Client,
+				_ string,
+				result promotion.StepResult,
+				err error,
+			) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionStepStatusRunning, result.Status)
+				assert.NotNil(t, result.RetryAfter)
+
+				job := &batchv1.Job{}
+				require.NoError(t, c.Get(
+					context.Background(),
+					client.ObjectKey{Namespace: stepCtx.Project, Name: runJobName(stepCtx)},
+					job,
+				))
+				assert.Equal(t, ptr.To[int32](0), job.Spec.BackoffLimit)
+				assert.Equal(t, map[string]string{
+					labelKeyRunJob:             kargoapi.LabelValueTrue,
+					kargoapi.LabelKeyStage:     "fake-stage",
+					kargoapi.LabelKeyPromotion: "fake-promotion",
+				}, job.Labels)
+				require.Len(t, job.OwnerReferences, 1)
+				assert.Equal(t, testPromo.UID, job.OwnerReferences[0].UID)
+				assert.Empty(t, job.Spec.Template.Spec.Volumes)
+				assert.Equal(t, ptr.To(false), job.Spec.Template.Spec.AutomountServiceAccountToken)
+			},
+		},
+		{
+			name: "creates Job with working directory mounted",
+			files: map[string]string{
+				"src/values.yaml":     "replicas: 1\n",
+				"src/nested/app.yaml": "kind: Deployment\n",
+				"src/.git/config":     "[core]\n",
+				"other.txt":           "not mounted\n",
+			},
+			cfg: builtin.RunJobConfig{
+				Spec: testJobSpec(),
+				WorkDir: &builtin.WorkDir{
+					Path:      "src",
+					MountPath: "/workspace",
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				stepCtx *promotion.StepContext,
+				c client.Client,
+				_ string,
+				result promotion.StepResult,
+				err error,
+			) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionStepStatusRunning, result.Status)
+
+				key := client.ObjectKey{Namespace: stepCtx.Project, Name: runJobName(stepCtx)}
+				cm := &corev1.ConfigMap{}
+				require.NoError(t, c.Get(context.Background(), key, cm))
+				assert.Equal(t, map[string]string{
+					"f0": "kind: Deployment\n",
+					"f1": "replicas: 1\n",
+				}, cm.Data)
+
+				job := &batchv1.Job{}
+				require.NoError(t, c.Get(context.Background(), key, job))
+				podSpec := job.Spec.Template.Spec
+				require.Len(t, podSpec.Volumes, 1)
+				assert.Equal(t, cm.Name, podSpec.Volumes[0].ConfigMap.Name)
+				assert.Equal(t, []corev1.KeyToPath{
+					{Key: "f0", Path: "nested/app.yaml"},
+					{Key: "f1", Path: "values.yaml"},
+				}, podSpec.Volumes[0].ConfigMap.Items)
+				assert.Equal(t, []corev1.VolumeMount{{
+					Name:      runJobWorkDirVolumeName,
+					MountPath: "/workspace",
+					ReadOnly:  true,
+				}}, podSpec.Containers[0].VolumeMounts)
+			},
+		},
+		{
+			name: "working directory is too large",
+			files: map[string]string{
+				"big.txt": strings.Repeat("a", maxRunJobWorkDirSize+1),
+			},
+			cfg: builtin.RunJobConfig{
+				Spec:    testJobSpec(),
+				WorkDir: &builtin.WorkDir{MountPath: "/workspace"},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *promotion.StepContext,
+				_ client.Client,
+				_ string,
+				result promotion.StepResult,
+				err error,
+			) {
+				require.ErrorContains(t, err, "exceed the maximum size")
+				assert.True(t, promotion.IsTerminal(err))
+				assert.Equal(t, kargoapi.PromotionStepStatusFailed, result.Status)
+			},
+		},
+		{
+			name: "Job is still running",
+			cfg:  builtin.RunJobConfig{Spec: testJobSpec()},
+			objects: func(stepCtx *promotion.StepContext) []client.Object {
+				return []client.Object{newJob(stepCtx, batchv1.JobStatus{Active: 1})}
+			},
+			assertions: func(
+				t *testing.T,
+				_ *promotion.StepContext,
+				_ client.Client,
+				_ string,
+				result promotion.StepResult,
+				err error,
+			) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionStepStatusRunning, result.Status)
+			},
+		},
+		{
+			name: "Job is still running and has produced logs",
+			cfg:  builtin.RunJobConfig{Spec: testJobSpec()},
+			objects: func(stepCtx *promotion.StepContext) []client.Object {
+				return []client.Object{
+					newJob(stepCtx, batchv1.JobStatus{Active: 1}),
+					newRunningPod(stepCtx),
+				}
+			},
+			assertions: func(
+				t *testing.T,
+				stepCtx *promotion.StepContext,
+				c client.Client,
+				logs string,
+				result promotion.StepResult,
+				err error,
+			) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionStepStatusRunning, result.Status)
+				assert.Equal(t, "==> "+runJobName(stepCtx)+"-abcde/main <==\nfake logs", logs)
+
+				// The amount of logs written should have been recorded
+				job := &batchv1.Job{}
+				require.NoError(t, c.Get(
+					context.Background(),
+					client.ObjectKey{Namespace: stepCtx.Project, Name: runJobName(stepCtx)},
+					job,
+				))
+				assert.JSONEq(
+					t,
+					`{"`+runJobName(stepCtx)+`-abcde/main":9}`,
+					job.Annotations[annotationKeyRunJobLogOffsets],
+				)
+			},
+		},
+		{
+			name: "Job is still running and logs were already written",
+			cfg:  builtin.RunJobConfig{Spec: testJobSpec()},
+			objects: func(stepCtx *promotion.StepContext) []client.Object {
+				job := newJob(stepCtx, batchv1.JobStatus{Active: 1})
+				job.Annotations = map[string]string{
+					annotationKeyRunJobLogOffsets: `{"` + runJobName(stepCtx) + `-abcde/main":9}`,
+				}
+				return []client.Object{job, newRunningPod(stepCtx)}
+			},
+			assertions: func(
+				t *testing.T,
+				_ *promotion.StepContext,
+				_ client.Client,
+				logs string,
+				result promotion.StepResult,
+				err error,
+			) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionStepStatusRunning, result.Status)
+				assert.Empty(t, logs)
+			},
+		},
+		{
+			name:     "step canceled while Job is running",
+			cfg:      builtin.RunJobConfig{Spec: testJobSpec()},
+			canceled: true,
+			objects: func(stepCtx *promotion.StepContext) []client.Object {
+				return []client.Object{
+					newJob(stepCtx, batchv1.JobStatus{Active: 1}),
+					&corev1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: stepCtx.Project,
+							Name:      runJobName(stepCtx),
+						},
+					},
+				}
+			},
+			assertions: func(
+				t *testing.T,
+				stepCtx *promotion.StepContext,
+				c client.Client,
+				_ string,
+				result promotion.StepResult,
+				err error,
+			) {
+				require.ErrorContains(t, err, "step was canceled")
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, result.Status)
+
+				key := client.ObjectKey{Namespace: stepCtx.Project, Name: runJobName(stepCtx)}
+				err = c.Get(context.Background(), key, &batchv1.Job{})
+				assert.True(t, apierrors.IsNotFound(err))
+				err = c.Get(context.Background(), key, &corev1.ConfigMap{})
+				assert.True(t, apierrors.IsNotFound(err))
+			},
+		},
+		{
+			name: "Job from a previous attempt is being deleted",
+			cfg:  builtin.RunJobConfig{Spec: testJobSpec()},
+			objects: func(stepCtx *promotion.StepContext) []client.Object {
+				job := newJob(stepCtx, batchv1.JobStatus{})
+				job.Finalizers = []string{"fake-finalizer"}
+				job.DeletionTimestamp = &metav1.Time{}
+				return []client.Object{job}
+			},
+			assertions: func(
+				t *testing.T,
+				_ *promotion.StepContext,
+				_ client.Client,
+				_ string,
+				result promotion.StepResult,
+				err error,
+			) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionStepStatusRunning, result.Status)
+			},
+		},
+		{
+			name: "Job succeeded",
+			cfg:  builtin.RunJobConfig{Spec: testJobSpec()},
+			objects: func(stepCtx *promotion.StepContext) []client.Object {
+				return []client.Object{
+					newJob(stepCtx, batchv1.JobStatus{
+						Conditions: []batchv1.JobCondition{{
+							Type:   batchv1.JobComplete,
+							Status: corev1.ConditionTrue,
+						}},
+					}),
+					newPod(stepCtx, 0),
+				}
+			},
+			assertions: func(
+				t *testing.T,
+				stepCtx *promotion.StepContext,
+				_ client.Client,
+				logs string,
+				result promotion.StepResult,
+				err error,
+			) {
+				require.NoError(t, err)
+				assert.Equal(t, promotion.StepResult{
+					Status: kargoapi.PromotionStepStatusSucceeded,
+					Output: map[string]any{"jobName": runJobName(stepCtx)},
+				}, result)
+				assert.Contains(t, logs, "/main <==\nfake logs")
+			},
+		},
+		{
+			name: "Job failed",
+			cfg:  builtin.RunJobConfig{Spec: testJobSpec()},
+			objects: func(stepCtx *promotion.StepContext) []client.Object {
+				return []client.Object{
+					newJob(stepCtx, batchv1.JobStatus{
+						Conditions: []batchv1.JobCondition{{
+							Type:   batchv1.JobFailed,
+							Status: corev1.ConditionTrue,
+						}},
+					}),
+					newPod(stepCtx, 2),
+				}
+			},
+			assertions: func(
+				t *testing.T,
+				stepCtx *promotion.StepContext,
+				c client.Client,
+				logs string,
+				result promotion.StepResult,
+				err error,
+			) {
+				require.ErrorContains(t, err, "failed with exit code 2")
+				assert.False(t, promotion.IsTerminal(err))
+				assert.Equal(t, kargoapi.PromotionStepStatusFailed, result.Status)
+				assert.Contains(t, logs, "fake logs")
+
+				// The Job should have been deleted so that a retry creates a new one
+				err = c.Get(
+					context.Background(),
+					client.ObjectKey{Namespace: stepCtx.Project, Name: runJobName(stepCtx)},
+					&batchv1.Job{},
+				)
+				assert.True(t, apierrors.IsNotFound(err))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stepCtx := newStepCtx(t)
+			for p, content := range tt.files {
+				absPath := filepath.Join(stepCtx.WorkDir, p)
+				require.NoError(t, os.MkdirAll(filepath.Dir(absPath), 0o700))
+				require.NoError(t, os.WriteFile(absPath, []byte(content), 0o600))
+			}
+
+			c := fake.NewClientBuilder().WithScheme(scheme)
+			if tt.objects != nil {
+				c.WithObjects(tt.objects(stepCtx)...)
+			}
+			runner := &jobRunner{kargoClient: c.Build()}
+			if !tt.disabled {
+				runner.podsClient = k8sfake.NewClientset().CoreV1()
+			}
+
+			logs := &bytes.Buffer{}
+			ctx, cancel := context.WithCancel(
+				promotion.ContextWithStepLog(context.Background(), logs),
+			)
+			defer cancel()
+			if tt.canceled {
+				cancel()
+			}
+			result, err := runner.run(ctx, stepCtx, tt.cfg)
+			tt.assertions(t, stepCtx, runner.kargoClient, logs.String(), result, err)
+		})
+	}
+}
+
+func Test_jobRunner_run_transitions(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, batchv1.AddToScheme(scheme))
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	ctx := context.Background()
+	c := fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&batchv1.Job{}).Build()
+	runner := &jobRunner{
+		kargoClient: c,
+		podsClient:  k8sfake.NewClientset().CoreV1(),
+	}
+	stepCtx := &promotion.StepContext{
+		Project:   "fake-project",
+		Promotion: "fake-promotion",
+		Alias:     "run-tests",
+		WorkDir:   t.TempDir(),
+	}
+	cfg := builtin.RunJobConfig{Spec: testJobSpec()}
+	key := client.ObjectKey{Namespace: stepCtx.Project, Name: runJobName(stepCtx)}
+
+	setStatus := func(status batchv1.JobStatus) {
+		t.Helper()
+		job := &batchv1.Job{}
+		require.NoError(t, c.Get(ctx, key, job))
+		job.Status = status
+		require.NoError(t, c.Status().Update(ctx, job))
+	}
+
+	// The first run creates the Job
+	result, err := runner.run(ctx, stepCtx, cfg)
+	require.NoError(t, err)
+	require.Equal(t, kargoapi.PromotionStepStatusRunning, result.Status)
+	// The Job is running
+	setStatus(batchv1.JobStatus{Active: 1})
+	result, err = runner.run(ctx, stepCtx, cfg)
+	require.NoError(t, err)
+	require.Equal(t, kargoapi.PromotionStepStatusRunning, result.Status)
+
+	// The Job fails
+	setStatus(batchv1.JobStatus{
+		Failed: 1,
+		Conditions: []batchv1.JobCondition{{
+			Type:   batchv1.JobFailed,
+			Status: corev1.ConditionTrue,
+		}},
+	})
+	result, err = runner.run(ctx, stepCtx, cfg)
+	require.ErrorContains(t, err, "failed")
+	require.Equal(t, kargoapi.PromotionStepStatusFailed, result.Status)
+
+	// The step is retried, which creates a new Job
+	result, err = runner.run(ctx, stepCtx, cfg)
+	require.NoError(t, err)
+	require.Equal(t, kargoapi.PromotionStepStatusRunning, result.Status)
+	job := &batchv1.Job{}
+	require.NoError(t, c.Get(ctx, key, job))
+	require.Empty(t, job.Status.Conditions)
+
+	// The new Job succeeds
+	setStatus(batchv1.JobStatus{
+		Succeeded: 1,
+		Conditions: []batchv1.JobCondition{{
+			Type:   batchv1.JobComplete,
+			Status: corev1.ConditionTrue,
+		}},
+	})
+	result, err = runner.run(ctx, stepCtx, cfg)
+	require.NoError(t, err)
+	require.Equal(t, kargoapi.PromotionStepStatusSucceeded, result.Status)
+}
+
+func Test_DeleteUnfinishedRunJobs(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, batchv1.AddToScheme(scheme))
+
+	promo := &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-project",
+			Name:      "fake-promotion",
+			UID:       "fake-uid",
+		},
+	}
+
+	newJob := func(name string, owner types.UID, conditions ...batchv1.JobConditionType) *batchv1.Job {
+		job := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       promo.Namespace,
+				Name:            name,
+				Labels:          map[string]string{labelKeyRunJob: kargoapi.LabelValueTrue},
+				OwnerReferences: []metav1.OwnerReference{{UID: owner}},
+			},
+		}
+		for _, cond := range conditions {
+			job.Status.Conditions = append(job.Status.Conditions, batchv1.JobCondition{
+				Type:   cond,
+				Status: corev1.ConditionTrue,
+			})
+		}
+		return job
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		newJob("running", promo.UID),
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: promo.Namespace, Name: "running"},
+		},
+		newJob("complete", promo.UID, batchv1.JobComplete),
+		newJob("failed", promo.UID, batchv1.JobFailed),
+		newJob("other-promotion", "other-uid"),
+	).Build()
+
+	t.Run("step not enabled", func(t *testing.T) {
+		require.NoError(t, DeleteUnfinishedRunJobs(context.Background(), c, promo))
+		require.NoError(t, c.Get(
+			context.Background(),
+			client.ObjectKey{Namespace: promo.Namespace, Name: "running"},
+			&batchv1.Job{},
+		))
+	})
+
+	t.Run("step enabled", func(t *testing.T) {
+		EnableRunJobStep(k8sfake.NewClientset().CoreV1())
+		t.Cleanup(func() { runJobPodsClient.Store(nil) })
+
+		require.NoError(t, DeleteUnfinishedRunJobs(context.Background(), c, promo))
+
+		for name, deleted := range map[string]bool{
+			"running":         true,
+			"complete":        false,
+			"failed":          false,
+			"other-promotion": false,
+		} {
+			err := c.Get(
+				context.Background(),
+				client.ObjectKey{Namespace: promo.Namespace, Name: name},
+				&batchv1.Job{},
+			)
+			assert.Equal(t, deleted, apierrors.IsNotFound(err), name)
+		}
+		err := c.Get(
+			context.Background(),
+			client.ObjectKey{Namespace: promo.Namespace, Name: "running"},
+			&corev1.ConfigMap{},
+		)
+		assert.True(t, apierrors.IsNotFound(err))
+	})
+}
+
+func Test_runJobName(t *testing.T) {
+	stepCtx := &promotion.StepContext{
+		Promotion: "fake-promotion",
+		Alias:     "run-tests",
+	}
+	name := runJobName(stepCtx)
+	assert.Len(t, name, len("run-job-")+16)
+	// The name is stable
+	assert.Equal(t, name, runJobName(stepCtx))
+	// A verification running the same step uses a different Job
+	assert.NotEqual(t, name, runJobName(&promotion.StepContext{
+		Verification: "fake-verification-id",
+		Alias:        stepCtx.Alias,
+	}))
+}
+
+// testJobSpecWith returns the spec returned by testJobSpec after applying the
+// provided function to its Pod spec.
+func testJobSpecWith(fn func(podSpec map[string]any)) map[string]any {
+	spec := testJobSpec()
+	fn(spec["template"].(map[string]any)["spec"].(map[string]any)) // nolint: forcetypeassert
+	return spec
+}
+
+// container returns the first container of the provided Pod spec.
+func container(podSpec map[string]any) map[string]any {
+	return podSpec["containers"].([]any)[0].(map[string]any) // nolint: forcetypeassert
+}
+
+func testJobSpec() map[string]any {
+	return map[string]any{
+		"template": map[string]any{
+			"spec": map[string]any{
+				"restartPolicy": "Never",
+				"containers": []any{
+					map[string]any{
+						"name":    "main",
+						"image":   "busybox",
+						"command": []any{"sh", "-c", "echo hello"},
+					},
+				},
+			},
+		},
+	}
+}
@@ -54,7 +54,7 @@ func newKustomizeBuilder(promotion.StepRunnerCapabilities) promotion.StepRunner
 
 // Run implements the promotion.StepRunner interface.
 func (k *kustomizeBuilder) Run(
-	_ context.Context,
+	ctx context.Context,
 	stepCtx *promotion.StepContext,
 ) (promotion.StepResult, error) {
 	cfg, err := k.convert(stepCtx.Config)
@@ -63,7 +63,12 @@ func (k *kustomizeBuilder) Run(
 			Status: kargoapi.PromotionStepStatusFailed,
 		}, &promotion.TerminalError{Err: err}
 	}
-	return k.run(stepCtx, cfg)
+	res, err := k.run(ctx, stepCtx, cfg)
+	if err != nil {
+		// Mirror what the kustomize CLI would print to stderr on failure.
+		_, _ = fmt.Fprintf(promotion.StepLogFromContext(ctx), "Error: %v\n", err)
+	}
+	return res, err
 }
 
 // convert validates kustomizeBuilder configuration against a JSON schema and
@@ -73,6 +78,7 @@ func (k *kustomizeBuilder) convert(cfg promotion.Config) (builtin.KustomizeBuild
 }
 
 func (k *kustomizeBuilder) run(
+	ctx context.Context,
 	stepCtx *promotion.StepContext,
 	cfg builtin.KustomizeBuildConfig,
 ) (promotion.StepResult, error) {
@@ -1,6 +1,8 @@
 package builtin
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -345,8 +347,73 @@ metadata:
 				WorkDir: tempDir,
 			}
 
-			result, err := runner.run(stepCtx, tt.config)
+			result, err := runner.run(context.Background(), stepCtx, tt.config)
 			tt.assertions(t, tempDir, result, err)
 		})
 	}
 }
+
+func Test_kustomizeBuilder_Run_stepLog(t *testing.T) {
+	tests := []struct {
+		name       string
+		setupFiles func(*testing.T, string)
+		assertions func(*testing.T, string, error)
+	}{
+		{
+			name: "built manifests are not written to the step log",
+			setupFiles: func(t *testing.T, dir string) {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "kustomization.yaml"), []byte(`
+apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+- deployment.yaml
+`), 0o600))
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "deployment.yaml"), []byte(`---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: test-deployment
+`), 0o600))
+			},
+			assertions: func(t *testing.T, stepLog string, err error) {
+				require.NoError(t, err)
+				// Built manifests may contain Secrets and must never be written
+				// to the step log.
+				assert.Empty(t, stepLog)
+			},
+		},
+		{
+			name: "errors are written to the step log",
+			setupFiles: func(t *testing.T, dir string) {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "kustomization.yaml"), []byte(`invalid`), 0o600))
+			},
+			assertions: func(t *testing.T, stepLog string, err error) {
+				require.ErrorContains(t, err, "invalid Kustomization")
+				assert.Contains(t, stepLog, "Error: ")
+				assert.Contains(t, stepLog, "invalid Kustomization")
+			},
+		},
+	}
+
+	runner := newKustomizeBuilder(promotion.StepRunnerCapabilities{})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tempDir := t.TempDir()
+			tt.setupFiles(t, tempDir)
+
+			stepLog := &bytes.Buffer{}
+			_, err := runner.Run(
+				promotion.ContextWithStepLog(context.Background(), stepLog),
+				&promotion.StepContext{
+					WorkDir: tempDir,
+					Config: promotion.Config{
+						"path":    ".",
+						"outPath": "output.yaml",
+					},
+				},
+			)
+			tt.assertions(t, stepLog.String(), err)
+		})
+	}
+}
@@ -0,0 +1,506 @@
+package builtin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/xeipuuv/gojsonschema"
+	"go.yaml.in/yaml/v3"
+	kustypes "sigs.k8s.io/kustomize/api/types"
+	"sigs.k8s.io/kustomize/kyaml/resid"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+	intyaml "github.com/akuity/kargo/pkg/yaml"
+)
+
+const stepKindKustomizeEdit = "kustomize-edit"
+
+func init() {
+	promotion.RegisterStepRunner(
+		stepKindKustomizeEdit,
+		promotion.StepRunnerRegistration{Factory: newKustomizeEditor},
+	)
+}
+
+// kustomizeEditor is an implementation of the promotion.StepRunner interface
+// that edits a Kustomization file.
+type kustomizeEditor struct {
+	schemaLoader gojsonschema.JSONLoader
+}
+
+// newKustomizeEditor returns an implementation of the promotion.StepRunner
+// interface that edits a Kustomization file.
+func newKustomizeEditor(promotion.StepRunnerCapabilities) promotion.StepRunner {
+	return &kustomizeEditor{schemaLoader: getConfigSchemaLoader(stepKindKustomizeEdit)}
+}
+
+// Run implements the promotion.StepRunner interface.
+func (k *kustomizeEditor) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	cfg, err := k.convert(stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+	return k.run(ctx, stepCtx, cfg)
+}
+
+// convert validates kustomizeEditor configuration against a JSON schema and
+// converts it into a builtin.KustomizeEditConfig struct.
+func (k *kustomizeEditor) convert(cfg promotion.Config) (builtin.KustomizeEditConfig, error) {
+	return validateAndConvert[builtin.KustomizeEditConfig](k.schemaLoader, cfg, stepKindKustomizeEdit)
+}
+
+func (k *kustomizeEditor) run(
+	_ context.Context,
+	stepCtx *promotion.StepContext,
+	cfg builtin.KustomizeEditConfig,
+) (promotion.StepResult, error) {
+	kusPath, err := findKustomization(stepCtx.WorkDir, cfg.Path)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("could not discover kustomization file: %w", err)
+	}
+
+	node, err := readKustomizationFile(kusPath)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+	}
+
+	changes, err := editKustomization(node, cfg)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("could not edit Kustomization file: %w", err)
+	}
+
+	result := promotion.StepResult{Status: kargoapi.PromotionStepStatusSucceeded}
+	if len(changes) == 0 {
+		return result, nil
+	}
+
+	if err = writeKustomizationFile(kusPath, node); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+	}
+
+	result.Output = map[string]any{
+		"commitMessage": k.generateCommitMessage(cfg.Path, changes),
+	}
+	return result, nil
+}
+
+func (k *kustomizeEditor) generateCommitMessage(path string, changes []string) string {
+	var commitMsg strings.Builder
+	_, _ = commitMsg.WriteString(fmt.Sprintf("Updated Kustomization in %s\n", path))
+	for _, change := range changes {
+		_, _ = commitMsg.WriteString(fmt.Sprintf("\n- %s", change))
+	}
+	return commitMsg.String()
+}
+
+// editKustomization applies all edits specified by the provided configuration
+// to the provided Kustomization document. It returns a human-readable
+// description of every change that was made. Edits that would not change the
+// Kustomization (e.g. adding a resource that is already present) are not
+// reported.
+func editKustomization(node *yaml.Node, cfg builtin.KustomizeEditConfig) ([]string, error) {
+	if node.Kind != yaml.DocumentNode || len(node.Content) == 0 ||
+		node.Content[0].Kind != yaml.MappingNode {
+		return nil, errors.New("Kustomization is not a YAML mapping")
+	}
+	kus := node.Content[0]
+
+	var changes []string
+	for _, edit := range []func() ([]string, error){
+		func() ([]string, error) {
+			return editPathList(kus, "resources", "resource", cfg.AddResources, cfg.RemoveResources)
+		},
+		func() ([]string, error) {
+			return editPathList(kus, "components", "component", cfg.AddComponents, cfg.RemoveComponents)
+		},
+		func() ([]string, error) { return setScalarField(kus, "namespace", "namespace", cfg.Namespace) },
+		func() ([]string, error) { return setScalarField(kus, "namePrefix", "name prefix", cfg.NamePrefix) },
+		func() ([]string, error) { return setScalarField(kus, "nameSuffix", "name suffix", cfg.NameSuffix) },
+		func() ([]string, error) { return setMapField(kus, "commonLabels", "common label", cfg.CommonLabels) },
+		func() ([]string, error) {
+			return setMapField(kus, "commonAnnotations", "common annotation", cfg.CommonAnnotations)
+		},
+		func() ([]string, error) { return addPatches(kus, cfg.Patches) },
+		func() ([]string, error) { return setReplicas(kus, cfg.Replicas) },
+		func() ([]string, error) { return setHelmChartVersions(kus, cfg.HelmCharts) },
+	} {
+		c, err := edit()
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, c...)
+	}
+	return changes, nil
+}
+
+// getFieldNode returns the value node of the specified field of the provided
+// mapping node. If the field does not exist, nil is returned.
+func getFieldNode(mapping *yaml.Node, field string) *yaml.Node {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == field {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// removeField removes the specified field from the provided mapping node, if
+// it exists.
+func removeField(mapping *yaml.Node, field string) {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == field {
+			mapping.Content = slices.Delete(mapping.Content, i, i+2)
+			return
+		}
+	}
+}
+
+// getSequenceField returns the sequence node that is the value of the
+// specified field of the provided mapping node. If the field does not exist or
+// is null, nil is returned.
+func getSequenceField(mapping *yaml.Node, field string) (*yaml.Node, error) {
+	seq := getFieldNode(mapping, field)
+	if seq == nil || seq.Tag == "!!null" {
+		return nil, nil
+	}
+	if seq.Kind != yaml.SequenceNode {
+		return nil, fmt.Errorf("field %q is not a list", field)
+	}
+	return seq, nil
+}
+
+// appendToSequenceField appends the provided value to the sequence that is the
+// value of the specified field of the provided mapping node. If the field does
+// not exist, it is created.
+func appendToSequenceField(mapping *yaml.Node, field string, value any) error {
+	seq, err := getSequenceField(mapping, field)
+	if err != nil {
+		return err
+	}
+	if seq == nil {
+		return intyaml.UpdateField(mapping, field, []any{value})
+	}
+	item := &yaml.Node{}
+	if err = item.Encode(value); err != nil {
+		return fmt.Errorf("error encoding new item of field %q: %w", field, err)
+	}
+	seq.Content = append(seq.Content, item)
+	return nil
+}
+
+// normalizeKustomizationPath returns a representation of a path or URL
+// referenced by a Kustomization that is suitable for comparison. For example,
+// "./deployment.yaml" and "deployment.yaml" are equivalent.
+func normalizeKustomizationPath(p string) string {
+	if strings.Contains(p, "://") {
+		return p
+	}
+	return path.Clean(p)
+}
+
+// editPathList adds and removes paths to and from the list of paths that is
+// the value of the specified field of the provided Kustomization.
+func editPathList(kus *yaml.Node, field, noun string, add, remove []string) ([]string, error) {
+	if len(add) == 0 && len(remove) == 0 {
+		return nil, nil
+	}
+	seq, err := getSequenceField(kus, field)
+	if err != nil {
+		return nil, err
+	}
+	var changes []string
+	if seq != nil && len(remove) > 0 {
+		toRemove := make(map[string]struct{}, len(remove))
+		for _, p := range remove {
+			toRemove[normalizeKustomizationPath(p)] = struct{}{}
+		}
+		seq.Content = slices.DeleteFunc(seq.Content, func(item *yaml.Node) bool {
+			if _, ok := toRemove[normalizeKustomizationPath(item.Value)]; ok {
+				changes = append(changes, fmt.Sprintf("Removed %s %s", noun, item.Value))
+				return true
+			}
+			return false
+		})
+		if len(seq.Content) == 0 {
+			removeField(kus, field)
+			seq = nil
+		}
+	}
+	for _, p := range add {
+		var exists bool
+		if seq != nil {
+			exists = slices.ContainsFunc(seq.Content, func(item *yaml.Node) bool {
+				return normalizeKustomizationPath(item.Value) == normalizeKustomizationPath(p)
+			})
+		}
+		if exists {
+			continue
+		}
+		if err = appendToSequenceField(kus, field, p); err != nil {
+			return nil, err
+		}
+		if seq, err = getSequenceField(kus, field); err != nil {
+			return nil, err
+		}
+		changes = append(changes, fmt.Sprintf("Added %s %s", noun, p))
+	}
+	return changes, nil
+}
+
+// setScalarField sets the value of the specified field of the provided
+// Kustomization. An empty value leaves the field unchanged.
+func setScalarField(kus *yaml.Node, field, noun, value string) ([]string, error) {
+	if value == "" {
+		return nil, nil
+	}
+	if current := getFieldNode(kus, field); current != nil && current.Value == value {
+		return nil, nil
+	}
+	if err := intyaml.UpdateField(kus, field, value); err != nil {
+		return nil, fmt.Errorf("error setting field %q: %w", field, err)
+	}
+	return []string{fmt.Sprintf("Set %s to %s", noun, value)}, nil
+}
+
+// setMapField sets keys in the map that is the value of the specified field of
+// the provided Kustomization, preserving all other keys.
+func setMapField(kus *yaml.Node, field, noun string, values map[string]string) ([]string, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	mapping := getFieldNode(kus, field)
+	if mapping == nil || mapping.Tag == "!!null" {
+		if err := intyaml.UpdateField(kus, field, map[string]string{}); err != nil {
+			return nil, fmt.Errorf("error creating field %q: %w", field, err)
+		}
+		mapping = getFieldNode(kus, field)
+		mapping.Style = 0 // An empty map would otherwise be encoded in flow style
+	}
+	if mapping.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("field %q is not a map", field)
+	}
+	var changes []string
+	for _, key := range slices.Sorted(maps.Keys(values)) {
+		value := values[key]
+		if current := getFieldNode(mapping, key); current != nil {
+			if current.Value == value {
+				continue
+			}
+			if err := current.Encode(value); err != nil {
+				return nil, fmt.Errorf("error encoding value of %s %q: %w", noun, key, err)
+			}
+		} else {
+			keyNode, valueNode := &yaml.Node{}, &yaml.Node{}
+			if err := keyNode.Encode(key); err != nil {
+				return nil, fmt.Errorf("error encoding %s %q: %w", noun, key, err)
+			}
+			if err := valueNode.Encode(value); err != nil {
+				return nil, fmt.Errorf("error encoding value of %s %q: %w", noun, key, err)
+			}
+			mapping.Content = append(mapping.Content, keyNode, valueNode)
+		}
+		changes = append(changes, fmt.Sprintf("Set %s %s=%s", noun, key, value))
+	}
+	return changes, nil
+}
+
+// addPatches adds the provided patches to the provided Kustomization unless
+// identical patches are already present.
+func addPatches(kus *yaml.Node, patches []builtin.KustomizePatch) ([]string, error) {
+	if len(patches) == 0 {
+		return nil, nil
+	}
+	var current []kustypes.Patch
+	if err := intyaml.DecodeField(kus, "patches", &current); err != nil {
+		var fieldErr intyaml.FieldNotFoundErr
+		if !errors.As(err, &fieldErr) {
+			return nil, fmt.Errorf("could not decode patches field in Kustomization file: %w", err)
+		}
+	}
+	var changes []string
+	for _, p := range patches {
+		patch := toKustomizePatch(p)
+		if slices.ContainsFunc(current, patch.Equals) {
+			continue
+		}
+		if err := appendToSequenceField(kus, "patches", patch); err != nil {
+			return nil, err
+		}
+		current = append(current, patch)
+		changes = append(changes, fmt.Sprintf("Added patch %s", describePatch(patch)))
+	}
+	return changes, nil
+}
+
+func toKustomizePatch(p builtin.KustomizePatch) kustypes.Patch {
+	patch := kustypes.Patch{
+		Path:  p.Path,
+		Patch: p.Patch,
+	}
+	if p.Target != nil {
+		patch.Target = &kustypes.Selector{
+			ResId: resid.ResId{
+				Gvk: resid.Gvk{
+					Group:   p.Target.Group,
+					Version: p.Target.Version,
+					Kind:    p.Target.Kind,
+				},
+				Name:      p.Target.Name,
+				Namespace: p.Target.Namespace,
+			},
+			LabelSelector:      p.Target.LabelSelector,
+			AnnotationSelector: p.Target.AnnotationSelector,
+		}
+	}
+	if p.Options != nil {
+		options := map[string]bool{}
+		if p.Options.AllowNameChange {
+			options["allowNameChange"] = true
+		}
+		if p.Options.AllowKindChange {
+			options["allowKindChange"] = true
+		}
+		if len(options) > 0 {
+			patch.Options = options
+		}
+	}
+	return patch
+}
+
+func describePatch(patch kustypes.Patch) string {
+	desc := "(inline)"
+	if patch.Path != "" {
+		desc = patch.Path
+	}
+	if patch.Target == nil {
+		return desc
+	}
+	var target []string
+	for _, part := range []string{
+		patch.Target.Kind,
+		patch.Target.Name,
+		patch.Target.LabelSelector,
+		patch.Target.AnnotationSelector,
+	} {
+		if part != "" {
+			target = append(target, part)
+		}
+	}
+	if len(target) > 0 {
+		desc = fmt.Sprintf("%s targeting %s", desc, strings.Join(target, " "))
+	}
+	return desc
+}
+
+// setReplicas sets replica counts in the provided Kustomization, overwriting
+// existing replica counts for resources with the same names.
+func setReplicas(kus *yaml.Node, replicas []builtin.KustomizeReplica) ([]string, error) {
+	if len(replicas) == 0 {
+		return nil, nil
+	}
+	var changes []string
+	for _, r := range replicas {
+		seq, err := getSequenceField(kus, "replicas")
+		if err != nil {
+			return nil, err
+		}
+		var found bool
+		if seq != nil {
+			for _, item := range seq.Content {
+				var current kustypes.Replica
+				if err = item.Decode(&current); err != nil {
+					return nil, fmt.Errorf("could not decode replicas field in Kustomization file: %w", err)
+				}
+				if current.Name != r.Name {
+					continue
+				}
+				found = true
+				if current.Count == r.Count {
+					break
+				}
+				if err = intyaml.UpdateField(item, "count", r.Count); err != nil {
+					return nil, fmt.Errorf("error setting replicas of %q: %w", r.Name, err)
+				}
+				changes = append(changes, fmt.Sprintf("Set replicas of %s to %d", r.Name, r.Count))
+				break
+			}
+		}
+		if found {
+			continue
+		}
+		if err = appendToSequenceField(
+			kus,
+			"replicas",
+			kustypes.Replica{Name: r.Name, Count: r.Count},
+		); err != nil {
+			return nil, err
+		}
+		changes = append(changes, fmt.Sprintf("Set replicas of %s to %d", r.Name, r.Count))
+	}
+	return changes, nil
+}
+
+// setHelmChartVersions sets the versions of charts inflated by the provided
+// Kustomization. It is an error for a chart not to be present in the
+// Kustomization.
+func setHelmChartVersions(kus *yaml.Node, charts []builtin.KustomizeHelmChart) ([]string, error) {
+	if len(charts) == 0 {
+		return nil, nil
+	}
+	seq, err := getSequenceField(kus, "helmCharts")
+	if err != nil {
+		return nil, err
+	}
+	var changes []string
+	for _, chart := range charts {
+		var found bool
+		if seq != nil {
+			for _, item := range seq.Content {
+				var current kustypes.HelmChart
+				if err = item.Decode(&current); err != nil {
+					return nil, fmt.Errorf("could not decode helmCharts field in Kustomization file: %w", err)
+				}
+				if current.Name != chart.Name ||
+					(chart.ReleaseName != "" && current.ReleaseName != chart.ReleaseName) {
+					continue
+				}
+				found = true
+				if current.Version == chart.Version {
+					continue
+				}
+				if err = intyaml.UpdateField(item, "version", chart.Version); err != nil {
+					return nil, fmt.Errorf("error setting version of chart %q: %w", chart.Name, err)
+				}
+				name := current.Name
+				if current.ReleaseName != "" {
+					name = fmt.Sprintf("%s (release %s)", name, current.ReleaseName)
+				}
+				changes = append(changes, fmt.Sprintf("Set version of chart %s to %s", name, chart.Version))
+			}
+		}
+		if !found {
+			if chart.ReleaseName != "" {
+				return nil, fmt.Errorf(
+					"no chart %q with release name %q found in helmCharts field",
+					chart.Name, chart.ReleaseName,
+				)
+			}
+			return nil, fmt.Errorf("no chart %q found in helmCharts field", chart.Name)
+		}
+	}
+	return changes, nil
+}
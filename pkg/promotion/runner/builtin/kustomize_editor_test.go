@@ -0,0 +1,464 @@
+package builtin
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+func Test_kustomizeEditor_convert(t *testing.T) {
+	tests := []validationTestCase{
+		{
+			name:   "path is not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): path is required",
+			},
+		},
+		{
+			name: "path is empty",
+			config: promotion.Config{
+				"path": "",
+			},
+			expectedProblems: []string{
+				"path: String length must be greater than or equal to 1",
+			},
+		},
+		{
+			name: "resource to add is empty",
+			config: promotion.Config{
+				"path":         "fake-path",
+				"addResources": []string{""},
+			},
+			expectedProblems: []string{
+				"addResources.0: String length must be greater than or equal to 1",
+			},
+		},
+		{
+			name: "patch has neither path nor patch",
+			config: promotion.Config{
+				"path":    "fake-path",
+				"patches": []promotion.Config{{}},
+			},
+			expectedProblems: []string{
+				"patches.0: Must validate one and only one schema (oneOf)",
+			},
+		},
+		{
+			name: "patch has both path and patch",
+			config: promotion.Config{
+				"path": "fake-path",
+				"patches": []promotion.Config{{
+					"path":  "patch.yaml",
+					"patch": "fake-patch",
+				}},
+			},
+			expectedProblems: []string{
+				"patches.0: Must validate one and only one schema (oneOf)",
+			},
+		},
+		{
+			name: "replica count is negative",
+			config: promotion.Config{
+				"path": "fake-path",
+				"replicas": []promotion.Config{{
+					"name":  "fake-name",
+					"count": -1,
+				}},
+			},
+			expectedProblems: []string{
+				"replicas.0.count: Must be greater than or equal to 0",
+			},
+		},
+		{
+			name: "helm chart version not specified",
+			config: promotion.Config{
+				"path": "fake-path",
+				"helmCharts": []promotion.Config{{
+					"name": "fake-chart",
+				}},
+			},
+			expectedProblems: []string{
+				"helmCharts.0: version is required",
+			},
+		},
+		{
+			name: "valid kitchen sink",
+			config: promotion.Config{
+				"path":             "fake-path",
+				"addResources":     []string{"deployment.yaml"},
+				"removeResources":  []string{"service.yaml"},
+				"addComponents":    []string{"../components/foo"},
+				"removeComponents": []string{"../components/bar"},
+				"namespace":        "fake-namespace",
+				"namePrefix":       "fake-",
+				"nameSuffix":       "-fake",
+				"commonLabels":     map[string]string{"app": "fake"},
+				"commonAnnotations": map[string]string{
+					"example.com/owner": "fake",
+				},
+				"patches": []promotion.Config{
+					{"path": "patch.yaml"},
+					{
+						"patch": "fake-patch",
+						"target": promotion.Config{
+							"kind": "Deployment",
+							"name": "fake-name",
+						},
+						"options": promotion.Config{
+							"allowNameChange": true,
+						},
+					},
+				},
+				"replicas": []promotion.Config{{
+					"name":  "fake-name",
+					"count": 3,
+				}},
+				"helmCharts": []promotion.Config{{
+					"name":        "fake-chart",
+					"releaseName": "fake-release",
+					"version":     "1.2.3",
+				}},
+			},
+		},
+	}
+
+	r := newKustomizeEditor(promotion.StepRunnerCapabilities{})
+	runner, ok := r.(*kustomizeEditor)
+	require.True(t, ok)
+
+	runValidationTests(t, runner.convert, tests)
+}
+
+func Test_kustomizeEditor_run(t *testing.T) {
+	tests := []struct {
+		name        string
+		kustomize   string
+		cfg         builtin.KustomizeEditConfig
+		assertions  func(*testing.T, string, promotion.StepResult, error)
+		noKustomize bool
+	}{
+		{
+			name:        "Kustomization file not found",
+			noKustomize: true,
+			cfg:         builtin.KustomizeEditConfig{Path: "."},
+			assertions: func(t *testing.T, _ string, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "could not discover kustomization file:")
+				assert.Equal(t, promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, result)
+			},
+		},
+		{
+			name: "no changes",
+			kustomize: `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+namespace: fake-namespace
+resources:
+- ./deployment.yaml
+`,
+			cfg: builtin.KustomizeEditConfig{
+				Path:            ".",
+				AddResources:    []string{"deployment.yaml"},
+				RemoveResources: []string{"service.yaml"},
+				Namespace:       "fake-namespace",
+			},
+			assertions: func(t *testing.T, _ string, result promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, promotion.StepResult{Status: kargoapi.PromotionStepStatusSucceeded}, result)
+			},
+		},
+		{
+			name: "edits resources and components",
+			kustomize: `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+# Resources managed by Kargo
+resources:
+- deployment.yaml
+- service.yaml
+components:
+- ../components/foo
+`,
+			cfg: builtin.KustomizeEditConfig{
+				Path:             ".",
+				AddResources:     []string{"configmap.yaml"},
+				RemoveResources:  []string{"./service.yaml"},
+				AddComponents:    []string{"../components/bar"},
+				RemoveComponents: []string{"../components/foo"},
+			},
+			assertions: func(t *testing.T, workDir string, result promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, promotion.StepResult{
+					Status: kargoapi.PromotionStepStatusSucceeded,
+					Output: map[string]any{
+						"commitMessage": "Updated Kustomization in .\n\n" +
+							"- Removed resource service.yaml\n" +
+							"- Added resource configmap.yaml\n" +
+							"- Removed component ../components/foo\n" +
+							"- Added component ../components/bar",
+					},
+				}, result)
+				b, err := os.ReadFile(filepath.Join(workDir, "kustomization.yaml"))
+				require.NoError(t, err)
+				assert.Equal(t, `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+# Resources managed by Kargo
+resources:
+- deployment.yaml
+- configmap.yaml
+components:
+- ../components/bar
+`, string(b))
+			},
+		},
+		{
+			name: "creates missing fields",
+			kustomize: `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+`,
+			cfg: builtin.KustomizeEditConfig{
+				Path:         ".",
+				AddResources: []string{"deployment.yaml"},
+				Replicas:     []builtin.KustomizeReplica{{Name: "api", Count: 2}},
+			},
+			assertions: func(t *testing.T, workDir string, result promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, promotion.StepResult{
+					Status: kargoapi.PromotionStepStatusSucceeded,
+					Output: map[string]any{
+						"commitMessage": "Updated Kustomization in .\n\n" +
+							"- Added resource deployment.yaml\n" +
+							"- Set replicas of api to 2",
+					},
+				}, result)
+				b, err := os.ReadFile(filepath.Join(workDir, "kustomization.yaml"))
+				require.NoError(t, err)
+				assert.Equal(t, `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+- deployment.yaml
+replicas:
+- name: api
+  count: 2
+`, string(b))
+			},
+		},
+		{
+			name: "sets metadata fields",
+			kustomize: `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+namespace: old-namespace
+commonLabels:
+  app: fake # the app
+  team: old-team
+`,
+			cfg: builtin.KustomizeEditConfig{
+				Path:       ".",
+				Namespace:  "new-namespace",
+				NamePrefix: "prod-",
+				CommonLabels: map[string]string{
+					"team": "new-team",
+					"tier": "backend",
+				},
+				CommonAnnotations: map[string]string{
+					"example.com/owner": "platform",
+				},
+			},
+			assertions: func(t *testing.T, workDir string, result promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, promotion.StepResult{
+					Status: kargoapi.PromotionStepStatusSucceeded,
+					Output: map[string]any{
+						"commitMessage": "Updated Kustomization in .\n\n" +
+							"- Set namespace to new-namespace\n" +
+							"- Set name prefix to prod-\n" +
+							"- Set common label team=new-team\n" +
+							"- Set common label tier=backend\n" +
+							"- Set common annotation example.com/owner=platform",
+					},
+				}, result)
+				b, err := os.ReadFile(filepath.Join(workDir, "kustomization.yaml"))
+				require.NoError(t, err)
+				assert.Equal(t, `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+namespace: new-namespace
+commonLabels:
+  app: fake # the app
+  team: new-team
+  tier: backend
+namePrefix: prod-
+commonAnnotations:
+  example.com/owner: platform
+`, string(b))
+			},
+		},
+		{
+			name: "adds patches and sets replicas",
+			kustomize: `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+patches:
+- path: patch.yaml
+replicas:
+- name: api
+  count: 1
+`,
+			cfg: builtin.KustomizeEditConfig{
+				Path: ".",
+				Patches: []builtin.KustomizePatch{
+					{Path: "patch.yaml"},
+					{
+						Patch: "- op: replace\n  path: /spec/replicas\n  value: 2\n",
+						Target: &builtin.KustomizePatchTarget{
+							Kind: "Deployment",
+							Name: "worker",
+						},
+						Options: &builtin.KustomizePatchOptions{AllowNameChange: true},
+					},
+				},
+				Replicas: []builtin.KustomizeReplica{
+					{Name: "api", Count: 3},
+					{Name: "worker", Count: 0},
+				},
+			},
+			assertions: func(t *testing.T, workDir string, result promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, promotion.StepResult{
+					Status: kargoapi.PromotionStepStatusSucceeded,
+					Output: map[string]any{
+						"commitMessage": "Updated Kustomization in .\n\n" +
+							"- Added patch (inline) targeting Deployment worker\n" +
+							"- Set replicas of api to 3\n" +
+							"- Set replicas of worker to 0",
+					},
+				}, result)
+				b, err := os.ReadFile(filepath.Join(workDir, "kustomization.yaml"))
+				require.NoError(t, err)
+				assert.Equal(t, `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+patches:
+- path: patch.yaml
+- patch: |
+    - op: replace
+      path: /spec/replicas
+      value: 2
+  target:
+    kind: Deployment
+    name: worker
+  options:
+    allowNameChange: true
+replicas:
+- name: api
+  count: 3
+- name: worker
+  count: 0
+`, string(b))
+			},
+		},
+		{
+			name: "sets Helm chart versions",
+			kustomize: `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+helmCharts:
+- name: redis
+  repo: https://charts.example.com
+  releaseName: cache
+  version: 1.0.0
+- name: redis
+  repo: https://charts.example.com
+  releaseName: queue
+  version: 1.0.0
+`,
+			cfg: builtin.KustomizeEditConfig{
+				Path: ".",
+				HelmCharts: []builtin.KustomizeHelmChart{{
+					Name:        "redis",
+					ReleaseName: "queue",
+					Version:     "2.0.0",
+				}},
+			},
+			assertions: func(t *testing.T, workDir string, result promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, promotion.StepResult{
+					Status: kargoapi.PromotionStepStatusSucceeded,
+					Output: map[string]any{
+						"commitMessage": "Updated Kustomization in .\n\n" +
+							"- Set version of chart redis (release queue) to 2.0.0",
+					},
+				}, result)
+				b, err := os.ReadFile(filepath.Join(workDir, "kustomization.yaml"))
+				require.NoError(t, err)
+				assert.Equal(t, `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+helmCharts:
+- name: redis
+  repo: https://charts.example.com
+  releaseName: cache
+  version: 1.0.0
+- name: redis
+  repo: https://charts.example.com
+  releaseName: queue
+  version: 2.0.0
+`, string(b))
+			},
+		},
+		{
+			name: "Helm chart not found",
+			kustomize: `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+`,
+			cfg: builtin.KustomizeEditConfig{
+				Path: ".",
+				HelmCharts: []builtin.KustomizeHelmChart{{
+					Name:    "redis",
+					Version: "2.0.0",
+				}},
+			},
+			assertions: func(t *testing.T, _ string, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, `no chart "redis" found in helmCharts field`)
+				assert.Equal(t, promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, result)
+			},
+		},
+		{
+			name: "resources field is not a list",
+			kustomize: `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources: deployment.yaml
+`,
+			cfg: builtin.KustomizeEditConfig{
+				Path:         ".",
+				AddResources: []string{"service.yaml"},
+			},
+			assertions: func(t *testing.T, _ string, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, `field "resources" is not a list`)
+				assert.Equal(t, promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, result)
+			},
+		},
+	}
+
+	runner := &kustomizeEditor{}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workDir := t.TempDir()
+			if !tt.noKustomize {
+				require.NoError(t, os.WriteFile(
+					filepath.Join(workDir, "kustomization.yaml"),
+					[]byte(tt.kustomize),
+					0o600,
+				))
+			}
+			result, err := runner.run(
+				context.Background(),
+				&promotion.StepContext{WorkDir: workDir},
+				tt.cfg,
+			)
+			tt.assertions(t, workDir, result, err)
+		})
+	}
+}
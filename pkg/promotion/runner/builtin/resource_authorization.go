@@ -0,0 +1,65 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion"
+)
+
+// authorizeResourceUpdate returns an error if the resource represented by
+// objMeta does not explicitly permit mutation by the Kargo Stage represented by
+// stepCtx. Permission is granted using the kargoapi.AnnotationKeyAuthorizedStage
+// annotation. The description (e.g. "Argo CD Application") is used to identify
+// the kind of resource in error messages.
+func authorizeResourceUpdate(
+	stepCtx *promotion.StepContext,
+	description string,
+	objMeta metav1.Object,
+) error {
+	permErr := fmt.Errorf(
+		"%s %q in namespace %q does not permit mutation by "+
+			"Kargo Stage %s in namespace %s",
+		description,
+		objMeta.GetName(),
+		objMeta.GetNamespace(),
+		stepCtx.Stage,
+		stepCtx.Project,
+	)
+
+	allowedStage, ok := objMeta.GetAnnotations()[kargoapi.AnnotationKeyAuthorizedStage]
+	if !ok {
+		return permErr
+	}
+
+	tokens := strings.SplitN(allowedStage, ":", 2)
+	if len(tokens) != 2 {
+		return fmt.Errorf(
+			"unable to parse value of annotation %q (%q) on %s %q in namespace %q",
+			kargoapi.AnnotationKeyAuthorizedStage,
+			allowedStage,
+			description,
+			objMeta.GetName(),
+			objMeta.GetNamespace(),
+		)
+	}
+
+	projectName, stageName := tokens[0], tokens[1]
+	if strings.Contains(projectName, "*") || strings.Contains(stageName, "*") {
+		return fmt.Errorf(
+			"%s %q in namespace %q has deprecated glob expression in annotation %q (%q)",
+			description,
+			objMeta.GetName(),
+			objMeta.GetNamespace(),
+			kargoapi.AnnotationKeyAuthorizedStage,
+			allowedStage,
+		)
+	}
+	if projectName != stepCtx.Project || stageName != stepCtx.Stage {
+		return permErr
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package promotion
+
+import (
+	"context"
+	"io"
+	"sync"
+	"unicode/utf8"
+)
+
+// DefaultMaxStepLogBytes is the default maximum number of bytes of log output
+// retained for a single execution of a Step.
+const DefaultMaxStepLogBytes = 64 << 10 // 64 KiB
+
+// stepLogTruncatedMarker is prepended to the content of a StepLog whose
+// earliest output was discarded to keep it within its size limit.
+const stepLogTruncatedMarker = "[... earlier output truncated ...]\n"
+
+type stepLogContextKey struct{}
+
+// StepLog is a bounded, concurrency-safe io.Writer that captures log output
+// produced during the execution of a single Step. When the amount of output
+// written exceeds the configured limit, the earliest output is discarded,
+// since the most recent output is generally the most useful in understanding
+// the outcome of a Step.
+type StepLog struct {
+	mu        sync.Mutex
+	maxBytes  int
+	buf       []byte
+	truncated bool
+}
+
+// NewStepLog returns a new StepLog that retains, at most, the specified number
+// of bytes of output. If maxBytes is not positive, DefaultMaxStepLogBytes is
+// used.
+func NewStepLog(maxBytes int) *StepLog {
+	if maxBytes <= 0 {
+		maxBytes = DefaultMaxStepLogBytes
+	}
+	return &StepLog{maxBytes: maxBytes}
+}
+
+// Write implements io.Writer.
+func (l *StepLog) Write(p []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.buf = append(l.buf, p...)
+	if overflow := len(l.buf) - l.maxBytes; overflow > 0 {
+		// Avoid splitting a multi-byte character
+		for overflow < len(l.buf) && !utf8.RuneStart(l.buf[overflow]) {
+			overflow++
+		}
+		l.buf = append(l.buf[:0], l.buf[overflow:]...)
+		l.truncated = true
+	}
+	return len(p), nil
+}
+
+// Bytes returns the captured output. If any output was discarded, the result
+// is prefixed with a marker indicating as much.
+func (l *StepLog) Bytes() []byte {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if len(l.buf) == 0 {
+		return nil
+	}
+	var out []byte
+	if l.truncated {
+		out = append(out, stepLogTruncatedMarker...)
+	}
+	return append(out, l.buf...)
+}
+
+// ContextWithStepLog returns a context.Context that has been augmented with
+// the provided io.Writer, to which StepRunners may write any output they wish
+// to make available to users as part of the Step's logs.
+func ContextWithStepLog(ctx context.Context, w io.Writer) context.Context {
+	return context.WithValue(ctx, stepLogContextKey{}, w)
+}
+
+// StepLogFromContext extracts the io.Writer for the current Step's logs from
+// the provided context.Context. If none is found, io.Discard is returned.
+func StepLogFromContext(ctx context.Context) io.Writer {
+	if w, ok := ctx.Value(stepLogContextKey{}).(io.Writer); ok {
+		return w
+	}
+	return io.Discard
+}
+
+// StepLogStore is an interface for components that persist logs captured
+// during the execution of a Promotion's Steps so they can be retrieved later.
+type StepLogStore interface {
+	// Append appends the provided logs, captured during an execution of the
+	// Step at the specified index, to any logs previously stored for that Step
+	// of the specified Promotion.
+	Append(
+		ctx context.Context,
+		project string,
+		promotion string,
+		stepIndex int64,
+		logs []byte,
+	) error
+	// Get returns all logs stored for the specified Promotion, keyed by Step
+	// index.
+	Get(ctx context.Context, project, promotion string) (map[int64][]byte, error)
+}
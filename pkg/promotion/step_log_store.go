@@ -0,0 +1,213 @@
+package promotion
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// maxStepLogStoreBytes is the maximum number of bytes of log output stored
+// for all Steps of a single Promotion combined. This keeps the underlying
+// ConfigMap comfortably below the 1 MiB size limit imposed on all Kubernetes
+// resources.
+const maxStepLogStoreBytes = 768 << 10 // 768 KiB
+
+// KubernetesStepLogStore is an implementation of StepLogStore that stores the
+// logs for each Promotion in a ConfigMap in the Promotion's namespace. The
+// ConfigMap is owned by the Promotion and is therefore garbage collected along
+// with it.
+type KubernetesStepLogStore struct {
+	client client.Client
+}
+
+// NewKubernetesStepLogStore returns a StepLogStore that stores logs in
+// ConfigMaps using the provided Kubernetes client.
+func NewKubernetesStepLogStore(c client.Client) *KubernetesStepLogStore {
+	return &KubernetesStepLogStore{client: c}
+}
+
+// Append implements StepLogStore.
+func (s *KubernetesStepLogStore) Append(
+	ctx context.Context,
+	project string,
+	promotion string,
+	stepIndex int64,
+	logs []byte,
+) error {
+	if len(logs) == 0 {
+		return nil
+	}
+	key := strconv.FormatInt(stepIndex, 10)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm := &corev1.ConfigMap{}
+		err := s.client.Get(
+			ctx,
+			client.ObjectKey{Namespace: project, Name: stepLogConfigMapName(promotion)},
+			cm,
+		)
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				return fmt.Errorf(
+					"error getting logs for Promotion %q in namespace %q: %w",
+					promotion, project, err,
+				)
+			}
+			return s.create(ctx, project, promotion, key, logs)
+		}
+		if err = checkStepLogConfigMap(cm, promotion); err != nil {
+			return err
+		}
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+		var otherBytes int
+		for k, v := range cm.Data {
+			if k != key {
+				otherBytes += len(v)
+			}
+		}
+		cm.Data[key] = tailStepLog(
+			append([]byte(cm.Data[key]), logs...),
+			min(DefaultMaxStepLogBytes, maxStepLogStoreBytes-otherBytes),
+		)
+		if err = s.client.Update(ctx, cm); err != nil {
+			return fmt.Errorf(
+				"error updating logs for Promotion %q in namespace %q: %w",
+				promotion, project, err,
+			)
+		}
+		return nil
+	})
+}
+
+func (s *KubernetesStepLogStore) create(
+	ctx context.Context,
+	project string,
+	promotion string,
+	key string,
+	logs []byte,
+) error {
+	promo := &kargoapi.Promotion{}
+	if err := s.client.Get(
+		ctx,
+		client.ObjectKey{Namespace: project, Name: promotion},
+		promo,
+	); err != nil {
+		return fmt.Errorf(
+			"error getting Promotion %q in namespace %q: %w",
+			promotion, project, err,
+		)
+	}
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: project,
+			Name:      stepLogConfigMapName(promotion),
+			Labels: map[string]string{
+				kargoapi.LabelKeyPromotion: promotion,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(promo, kargoapi.GroupVersion.WithKind("Promotion")),
+			},
+		},
+		Data: map[string]string{
+			key: tailStepLog(logs, DefaultMaxStepLogBytes),
+		},
+	}
+	if err := s.client.Create(ctx, cm); err != nil {
+		return fmt.Errorf(
+			"error creating logs for Promotion %q in namespace %q: %w",
+			promotion, project, err,
+		)
+	}
+	return nil
+}
+
+// Get implements StepLogStore.
+func (s *KubernetesStepLogStore) Get(
+	ctx context.Context,
+	project string,
+	promotion string,
+) (map[int64][]byte, error) {
+	cm := &corev1.ConfigMap{}
+	if err := s.client.Get(
+		ctx,
+		client.ObjectKey{Namespace: project, Name: stepLogConfigMapName(promotion)},
+		cm,
+	); err != nil {
+		if apierrors.IsNotFound(err) {
+			return map[int64][]byte{}, nil
+		}
+		return nil, fmt.Errorf(
+			"error getting logs for Promotion %q in namespace %q: %w",
+			promotion, project, err,
+		)
+	}
+	if err := checkStepLogConfigMap(cm, promotion); err != nil {
+		return nil, err
+	}
+	logs := make(map[int64][]byte, len(cm.Data))
+	for key, val := range cm.Data {
+		stepIndex, err := strconv.ParseInt(key, 10, 64)
+		if err != nil {
+			// Ignore anything we did not put there ourselves
+			continue
+		}
+		logs[stepIndex] = []byte(val)
+	}
+	return logs, nil
+}
+
+// checkStepLogConfigMap returns an error if the provided ConfigMap was not
+// created by a KubernetesStepLogStore to store the logs of the Promotion with
+// the provided name, i.e. if it is not labeled with the Promotion's name or is
+// not controlled by the Promotion. This prevents the store from overwriting, or
+// serving the contents of, a ConfigMap that merely happens to have the same
+// name.
+func checkStepLogConfigMap(cm *corev1.ConfigMap, promotion string) error {
+	if cm.Labels[kargoapi.LabelKeyPromotion] == promotion {
+		if owner := metav1.GetControllerOf(cm); owner != nil &&
+			owner.APIVersion == kargoapi.GroupVersion.String() &&
+			owner.Kind == "Promotion" &&
+			owner.Name == promotion {
+			return nil
+		}
+	}
+	return fmt.Errorf(
+		"ConfigMap %q in namespace %q exists, but does not hold the logs of Promotion %q",
+		cm.Name, cm.Namespace, promotion,
+	)
+}
+
+// stepLogConfigMapName returns the name of the ConfigMap used to store the
+// logs of the Promotion with the provided name.
+func stepLogConfigMapName(promotion string) string {
+	return promotion + "-logs"
+}
+
+// tailStepLog returns, at most, the last maxBytes bytes of the provided logs.
+// If any bytes are discarded, the result is prefixed with a marker indicating
+// as much.
+func tailStepLog(logs []byte, maxBytes int) string {
+	if len(logs) <= maxBytes {
+		return string(logs)
+	}
+	keep := maxBytes - len(stepLogTruncatedMarker)
+	if keep <= 0 {
+		return stepLogTruncatedMarker
+	}
+	start := len(logs) - keep
+	// Avoid splitting a multi-byte character
+	for start < len(logs) && !utf8.RuneStart(logs[start]) {
+		start++
+	}
+	return stepLogTruncatedMarker + string(logs[start:])
+}
@@ -0,0 +1,134 @@
+package promotion
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestKubernetesStepLogStore(t *testing.T) {
+	const (
+		testProject   = "fake-project"
+		testPromotion = "fake-promotion"
+	)
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	newStore := func(objects ...client.Object) (*KubernetesStepLogStore, client.Client) {
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+		return NewKubernetesStepLogStore(c), c
+	}
+	promo := &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testProject,
+			Name:      testPromotion,
+			UID:       "fake-uid",
+		},
+	}
+
+	t.Run("no logs stored", func(t *testing.T) {
+		store, _ := newStore(promo)
+		logs, err := store.Get(context.Background(), testProject, testPromotion)
+		require.NoError(t, err)
+		require.Empty(t, logs)
+	})
+
+	t.Run("Promotion does not exist", func(t *testing.T) {
+		store, _ := newStore()
+		err := store.Append(context.Background(), testProject, testPromotion, 0, []byte("hello"))
+		require.ErrorContains(t, err, "error getting Promotion")
+	})
+
+	t.Run("append and get", func(t *testing.T) {
+		store, c := newStore(promo)
+		ctx := context.Background()
+		require.NoError(t, store.Append(ctx, testProject, testPromotion, 0, []byte("first\n")))
+		require.NoError(t, store.Append(ctx, testProject, testPromotion, 1, []byte("second\n")))
+		require.NoError(t, store.Append(ctx, testProject, testPromotion, 0, []byte("again\n")))
+		// Empty logs are a no-op
+		require.NoError(t, store.Append(ctx, testProject, testPromotion, 2, nil))
+
+		logs, err := store.Get(ctx, testProject, testPromotion)
+		require.NoError(t, err)
+		require.Equal(t, map[int64][]byte{
+			0: []byte("first\nagain\n"),
+			1: []byte("second\n"),
+		}, logs)
+
+		cm := &corev1.ConfigMap{}
+		require.NoError(t, c.Get(
+			ctx,
+			client.ObjectKey{Namespace: testProject, Name: testPromotion + "-logs"},
+			cm,
+		))
+		require.Equal(t, testPromotion, cm.Labels[kargoapi.LabelKeyPromotion])
+		require.Len(t, cm.OwnerReferences, 1)
+		require.Equal(t, promo.UID, cm.OwnerReferences[0].UID)
+	})
+
+	t.Run("ConfigMap not owned by Promotion", func(t *testing.T) {
+		ctx := context.Background()
+		for _, cm := range []*corev1.ConfigMap{
+			{
+				// Neither labeled nor owned
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: testProject,
+					Name:      testPromotion + "-logs",
+				},
+				Data: map[string]string{"0": "unrelated"},
+			},
+			{
+				// Labeled, but not owned
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: testProject,
+					Name:      testPromotion + "-logs",
+					Labels:    map[string]string{kargoapi.LabelKeyPromotion: testPromotion},
+				},
+				Data: map[string]string{"0": "unrelated"},
+			},
+		} {
+			store, c := newStore(promo, cm)
+			err := store.Append(ctx, testProject, testPromotion, 0, []byte("hello"))
+			require.ErrorContains(t, err, "does not hold the logs of Promotion")
+			_, err = store.Get(ctx, testProject, testPromotion)
+			require.ErrorContains(t, err, "does not hold the logs of Promotion")
+
+			// The ConfigMap must not have been modified
+			got := &corev1.ConfigMap{}
+			require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(cm), got))
+			require.Equal(t, map[string]string{"0": "unrelated"}, got.Data)
+		}
+	})
+
+	t.Run("logs are bounded", func(t *testing.T) {
+		store, _ := newStore(promo)
+		ctx := context.Background()
+		big := []byte(strings.Repeat("a", DefaultMaxStepLogBytes) + "end")
+		require.NoError(t, store.Append(ctx, testProject, testPromotion, 0, big))
+		logs, err := store.Get(ctx, testProject, testPromotion)
+		require.NoError(t, err)
+		require.Len(t, logs[0], DefaultMaxStepLogBytes)
+		require.True(t, strings.HasPrefix(string(logs[0]), stepLogTruncatedMarker))
+		require.True(t, strings.HasSuffix(string(logs[0]), "end"))
+
+		// Fill the store up to its overall limit
+		for i := int64(1); i < maxStepLogStoreBytes/DefaultMaxStepLogBytes; i++ {
+			require.NoError(t, store.Append(ctx, testProject, testPromotion, i, big))
+		}
+		require.NoError(t, store.Append(ctx, testProject, testPromotion, 100, []byte("no room")))
+		logs, err = store.Get(ctx, testProject, testPromotion)
+		require.NoError(t, err)
+		require.Equal(t, stepLogTruncatedMarker, string(logs[100]))
+	})
+}
@@ -0,0 +1,58 @@
+package promotion
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStepLog(t *testing.T) {
+	t.Run("within limit", func(t *testing.T) {
+		l := NewStepLog(16)
+		_, err := l.Write([]byte("hello "))
+		require.NoError(t, err)
+		_, err = l.Write([]byte("world"))
+		require.NoError(t, err)
+		require.Equal(t, "hello world", string(l.Bytes()))
+	})
+
+	t.Run("exceeds limit", func(t *testing.T) {
+		l := NewStepLog(8)
+		n, err := l.Write([]byte("0123456789"))
+		require.NoError(t, err)
+		require.Equal(t, 10, n)
+		require.Equal(t, stepLogTruncatedMarker+"23456789", string(l.Bytes()))
+	})
+
+	t.Run("does not split multi-byte characters", func(t *testing.T) {
+		l := NewStepLog(4)
+		_, err := l.Write([]byte("abcé€"))
+		require.NoError(t, err)
+		require.Equal(t, stepLogTruncatedMarker+"€", string(l.Bytes()))
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		require.Nil(t, NewStepLog(0).Bytes())
+	})
+}
+
+func TestStepLogFromContext(t *testing.T) {
+	require.Equal(t, io.Discard, StepLogFromContext(context.Background()))
+
+	l := NewStepLog(0)
+	ctx := ContextWithStepLog(context.Background(), l)
+	_, err := StepLogFromContext(ctx).Write([]byte("hello"))
+	require.NoError(t, err)
+	require.Equal(t, "hello", string(l.Bytes()))
+}
+
+func Test_tailStepLog(t *testing.T) {
+	require.Equal(t, "short", tailStepLog([]byte("short"), 10))
+	long := strings.Repeat("a", 100) + "end"
+	tail := tailStepLog([]byte(long), len(stepLogTruncatedMarker)+3)
+	require.Equal(t, stepLogTruncatedMarker+"end", tail)
+	require.Equal(t, stepLogTruncatedMarker, tailStepLog([]byte(long), 1))
+}
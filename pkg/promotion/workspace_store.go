@@ -0,0 +1,174 @@
+package promotion
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+
+	intfs "github.com/akuity/kargo/pkg/io/fs"
+)
+
+// workspaceDirPrefix is the prefix of the name of every directory holding a
+// workspace. It distinguishes workspaces from anything else that may reside
+// in the same directory, such as when workspaces are stored in the temporary
+// directory of the process.
+const workspaceDirPrefix = "promotion-"
+
+// WorkspaceStoreConfig represents configuration for a DirWorkspaceStore.
+type WorkspaceStoreConfig struct {
+	// Dir is the directory in which workspaces are stored. If empty, workspaces
+	// are stored in the temporary directory of the process. When the directory
+	// is backed by a persistent volume, such as a PersistentVolumeClaim or a
+	// hostPath volume, Promotions can continue where they left off after a
+	// restart of the process.
+	Dir string `envconfig:"PROMOTION_WORKSPACE_DIR"`
+	// TTL is the amount of time a workspace may go unused before it is removed.
+	// A value of zero disables TTL-based removal.
+	TTL time.Duration `envconfig:"PROMOTION_WORKSPACE_TTL" default:"24h"`
+}
+
+// WorkspaceStoreConfigFromEnv returns a WorkspaceStoreConfig populated from
+// environment variables.
+func WorkspaceStoreConfigFromEnv() WorkspaceStoreConfig {
+	cfg := WorkspaceStoreConfig{}
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
+// WorkspaceStore is an interface for components that manage the working
+// directories (workspaces) in which the steps of Promotions are executed.
+// Workspaces are identified by an ID, which is typically the UID of the
+// Promotion which created it.
+type WorkspaceStore interface {
+	// Open returns the path of the workspace with the provided ID, creating it
+	// if it does not exist yet, and records its use. The returned boolean is
+	// true if the workspace was created, in which case any files produced by
+	// previously executed steps are not available.
+	Open(id string) (string, bool, error)
+	// Size returns the amount of disk space, in bytes, used by the workspace
+	// with the provided ID.
+	Size(id string) (int64, error)
+	// Remove removes the workspace with the provided ID, if it exists.
+	Remove(id string) error
+	// Prune removes all workspaces which have not been used within the TTL of
+	// the store, except for the workspaces with the provided IDs.
+	Prune(keep ...string) error
+}
+
+// DirWorkspaceStore is an implementation of WorkspaceStore that stores each
+// workspace as a subdirectory of a single directory on the local filesystem.
+// That directory may reside on ephemeral storage or on a mounted persistent
+// volume. It must not be shared with other processes managing workspaces.
+type DirWorkspaceStore struct {
+	dir string
+	ttl time.Duration
+
+	nowFn func() time.Time
+}
+
+// NewDirWorkspaceStore returns a DirWorkspaceStore configured as specified.
+func NewDirWorkspaceStore(cfg WorkspaceStoreConfig) (*DirWorkspaceStore, error) {
+	dir := cfg.Dir
+	if dir == "" {
+		dir = os.TempDir()
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("error creating workspace directory %q: %w", dir, err)
+	}
+	return &DirWorkspaceStore{
+		dir:   dir,
+		ttl:   cfg.TTL,
+		nowFn: time.Now,
+	}, nil
+}
+
+// Open implements WorkspaceStore.
+func (s *DirWorkspaceStore) Open(id string) (string, bool, error) {
+	path := s.path(id)
+	err := os.Mkdir(path, 0o700)
+	if err == nil {
+		return path, true, nil
+	}
+	if !os.IsExist(err) {
+		return "", false, fmt.Errorf("error creating workspace %q: %w", path, err)
+	}
+	// The modification time of the workspace's directory records when it was
+	// last used. This survives restarts of the process if the store's
+	// directory is persistent.
+	now := s.nowFn()
+	if err = os.Chtimes(path, now, now); err != nil {
+		return "", false, fmt.Errorf("error recording use of workspace %q: %w", path, err)
+	}
+	return path, false, nil
+}
+
+// Size implements WorkspaceStore.
+func (s *DirWorkspaceStore) Size(id string) (int64, error) {
+	size, err := intfs.DirSize(s.path(id))
+	if err != nil {
+		return 0, fmt.Errorf("error getting size of workspace %q: %w", s.path(id), err)
+	}
+	return size, nil
+}
+
+// Remove implements WorkspaceStore.
+func (s *DirWorkspaceStore) Remove(id string) error {
+	if err := os.RemoveAll(s.path(id)); err != nil {
+		return fmt.Errorf("error removing workspace %q: %w", s.path(id), err)
+	}
+	return nil
+}
+
+// Prune implements WorkspaceStore.
+func (s *DirWorkspaceStore) Prune(keep ...string) error {
+	if s.ttl <= 0 {
+		return nil
+	}
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return fmt.Errorf("error listing workspaces in %q: %w", s.dir, err)
+	}
+	now := s.nowFn()
+	var errs []error
+	for _, entry := range entries {
+		id, ok := strings.CutPrefix(entry.Name(), workspaceDirPrefix)
+		if !ok || !entry.IsDir() || slices.Contains(keep, id) {
+			continue
+		}
+		fi, err := entry.Info()
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error getting info for workspace %q: %w", s.path(id), err))
+			continue
+		}
+		if now.Sub(fi.ModTime()) <= s.ttl {
+			continue
+		}
+		if err = s.Remove(id); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// path returns the path of the workspace with the provided ID.
+func (s *DirWorkspaceStore) path(id string) string {
+	return filepath.Join(s.dir, workspaceDirPrefix+id)
+}
+
+// WorkspaceID returns the ID of the workspace a DirWorkspaceStore keeps at the
+// provided path. This permits a workspace to be mirrored by another store,
+// such as the one of an agent executing Steps out-of-process. If the path is
+// not that of a workspace, its base name is returned.
+func WorkspaceID(path string) string {
+	return strings.TrimPrefix(filepath.Base(path), workspaceDirPrefix)
+}
@@ -0,0 +1,128 @@
+package promotion
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDirWorkspaceStore(t *testing.T) {
+	t.Run("default directory", func(t *testing.T) {
+		s, err := NewDirWorkspaceStore(WorkspaceStoreConfig{})
+		require.NoError(t, err)
+		require.Equal(t, os.TempDir(), s.dir)
+	})
+
+	t.Run("configured directory is created", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "workspaces")
+		s, err := NewDirWorkspaceStore(WorkspaceStoreConfig{Dir: dir, TTL: time.Hour})
+		require.NoError(t, err)
+		require.Equal(t, dir, s.dir)
+		require.Equal(t, time.Hour, s.ttl)
+		require.DirExists(t, dir)
+	})
+}
+
+func TestDirWorkspaceStore_Open(t *testing.T) {
+	s, err := NewDirWorkspaceStore(WorkspaceStoreConfig{Dir: t.TempDir()})
+	require.NoError(t, err)
+	now := time.Now().Add(time.Hour).Truncate(time.Second)
+	s.nowFn = func() time.Time { return now }
+
+	path, created, err := s.Open("fake-id")
+	require.NoError(t, err)
+	require.True(t, created)
+	require.Equal(t, filepath.Join(s.dir, "promotion-fake-id"), path)
+	require.DirExists(t, path)
+
+	require.NoError(t, os.WriteFile(filepath.Join(path, "file.txt"), []byte("content"), 0o600))
+
+	// Opening the workspace again retains its content and records its use.
+	path, created, err = s.Open("fake-id")
+	require.NoError(t, err)
+	require.False(t, created)
+	require.FileExists(t, filepath.Join(path, "file.txt"))
+	fi, err := os.Stat(path)
+	require.NoError(t, err)
+	require.True(t, now.Equal(fi.ModTime()))
+}
+
+func TestDirWorkspaceStore_Size(t *testing.T) {
+	s, err := NewDirWorkspaceStore(WorkspaceStoreConfig{Dir: t.TempDir()})
+	require.NoError(t, err)
+
+	path, _, err := s.Open("fake-id")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(path, "file.txt"), []byte("content"), 0o600))
+
+	size, err := s.Size("fake-id")
+	require.NoError(t, err)
+	require.Equal(t, int64(len("content")), size)
+}
+
+func TestDirWorkspaceStore_Remove(t *testing.T) {
+	s, err := NewDirWorkspaceStore(WorkspaceStoreConfig{Dir: t.TempDir()})
+	require.NoError(t, err)
+
+	path, _, err := s.Open("fake-id")
+	require.NoError(t, err)
+	require.NoError(t, s.Remove("fake-id"))
+	require.NoDirExists(t, path)
+
+	// Removing a workspace which does not exist is not an error.
+	require.NoError(t, s.Remove("fake-id"))
+}
+
+func TestDirWorkspaceStore_Prune(t *testing.T) {
+	newStore := func(t *testing.T, ttl time.Duration) *DirWorkspaceStore {
+		s, err := NewDirWorkspaceStore(WorkspaceStoreConfig{Dir: t.TempDir(), TTL: ttl})
+		require.NoError(t, err)
+		return s
+	}
+	age := func(t *testing.T, s *DirWorkspaceStore, name string, d time.Duration) {
+		ts := time.Now().Add(-d)
+		require.NoError(t, os.Chtimes(filepath.Join(s.dir, name), ts, ts))
+	}
+
+	t.Run("TTL disabled", func(t *testing.T) {
+		s := newStore(t, 0)
+		path, _, err := s.Open("expired")
+		require.NoError(t, err)
+		age(t, s, "promotion-expired", 48*time.Hour)
+
+		require.NoError(t, s.Prune())
+		require.DirExists(t, path)
+	})
+
+	t.Run("removes expired workspaces only", func(t *testing.T) {
+		s := newStore(t, time.Hour)
+		for _, id := range []string{"expired", "recent", "kept"} {
+			_, _, err := s.Open(id)
+			require.NoError(t, err)
+		}
+		age(t, s, "promotion-expired", 2*time.Hour)
+		age(t, s, "promotion-kept", 2*time.Hour)
+		// Directories which are not workspaces are never removed.
+		require.NoError(t, os.Mkdir(filepath.Join(s.dir, "verification-other"), 0o700))
+		age(t, s, "verification-other", 2*time.Hour)
+
+		require.NoError(t, s.Prune("kept"))
+		require.NoDirExists(t, filepath.Join(s.dir, "promotion-expired"))
+		require.DirExists(t, filepath.Join(s.dir, "promotion-recent"))
+		require.DirExists(t, filepath.Join(s.dir, "promotion-kept"))
+		require.DirExists(t, filepath.Join(s.dir, "verification-other"))
+	})
+}
+
+func TestWorkspaceID(t *testing.T) {
+	s, err := NewDirWorkspaceStore(WorkspaceStoreConfig{Dir: t.TempDir()})
+	require.NoError(t, err)
+	path, _, err := s.Open("fake-id")
+	require.NoError(t, err)
+	require.Equal(t, "fake-id", WorkspaceID(path))
+
+	require.Equal(t, "run-123", WorkspaceID(filepath.Join(os.TempDir(), "run-123")))
+}
@@ -0,0 +1,296 @@
+// Package provenance produces exportable attestations describing the
+// deployment of Freight to a Stage. Attestations take the form of an in-toto
+// Statement (https://in-toto.io/Statement/v1) carrying a SLSA Provenance
+// (https://slsa.dev/provenance/v1) predicate.
+package provenance
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/x/version"
+)
+
+const (
+	// StatementType is the type of the in-toto Statement produced by
+	// NewStatement.
+	StatementType = "https://in-toto.io/Statement/v1"
+	// PredicateType is the type of the predicate carried by the in-toto
+	// Statement produced by NewStatement.
+	PredicateType = "https://slsa.dev/provenance/v1"
+	// BuildType identifies a Promotion as the process that produced the
+	// attested deployment.
+	BuildType = "https://kargo.akuity.io/promotion/v1"
+	// BuilderID identifies Kargo as the builder of the attested deployment.
+	BuilderID = "https://kargo.akuity.io"
+
+	// digestAlgorithmGitCommit is the digest algorithm used for Git commits,
+	// as defined by the in-toto digest set specification.
+	digestAlgorithmGitCommit = "gitCommit"
+)
+
+// Statement is an in-toto Statement attesting to the deployment of Freight to
+// a Stage.
+type Statement struct {
+	// Type is always StatementType.
+	Type string `json:"_type"`
+	// Subject contains the artifacts referenced by the deployed Freight.
+	Subject []ResourceDescriptor `json:"subject"`
+	// PredicateType is always PredicateType.
+	PredicateType string `json:"predicateType"`
+	// Predicate describes how the artifacts were deployed.
+	Predicate Provenance `json:"predicate"`
+}
+
+// ResourceDescriptor describes an artifact.
+type ResourceDescriptor struct {
+	// Name is a human-readable identifier of the artifact.
+	Name string `json:"name,omitempty"`
+	// URI uniquely identifies the artifact.
+	URI string `json:"uri,omitempty"`
+	// Digest is a set of cryptographic digests of the artifact, keyed by
+	// algorithm.
+	Digest map[string]string `json:"digest,omitempty"`
+	// Annotations contains additional information about the artifact.
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Provenance is a SLSA Provenance predicate.
+type Provenance struct {
+	// BuildDefinition describes the inputs of the deployment.
+	BuildDefinition BuildDefinition `json:"buildDefinition"`
+	// RunDetails describes the Promotion that performed the deployment.
+	RunDetails RunDetails `json:"runDetails"`
+}
+
+// BuildDefinition describes the inputs of a deployment.
+type BuildDefinition struct {
+	// BuildType is always BuildType.
+	BuildType string `json:"buildType"`
+	// ExternalParameters contains the parameters of the deployment which were
+	// under the control of the user, i.e. the Freight and the Stage it was
+	// promoted to.
+	ExternalParameters ExternalParameters `json:"externalParameters"`
+	// InternalParameters contains the parameters of the deployment which were
+	// defined by the Stage's promotion template.
+	InternalParameters InternalParameters `json:"internalParameters"`
+	// ResolvedDependencies contains the artifacts referenced by the deployed
+	// Freight.
+	ResolvedDependencies []ResourceDescriptor `json:"resolvedDependencies,omitempty"`
+}
+
+// ExternalParameters contains the user-controlled parameters of a deployment.
+type ExternalParameters struct {
+	// Project is the Project in which the deployment took place.
+	Project string `json:"project"`
+	// Stage is the Stage the Freight was deployed to.
+	Stage string `json:"stage"`
+	// Freight is the name of the deployed Freight.
+	Freight string `json:"freight"`
+	// FreightAlias is the alias of the deployed Freight at the time the
+	// attestation was produced.
+	FreightAlias string `json:"freightAlias,omitempty"`
+	// Origin is the origin of the deployed Freight, e.g. "Warehouse/my-wh".
+	Origin string `json:"origin,omitempty"`
+}
+
+// InternalParameters contains the parameters of a deployment that were
+// defined by the Stage's promotion template.
+type InternalParameters struct {
+	// Promotion is the name of the Promotion that performed the deployment.
+	Promotion string `json:"promotion"`
+	// Steps contains the steps executed by the Promotion.
+	Steps []Step `json:"steps,omitempty"`
+}
+
+// Step describes a single step executed by a Promotion.
+type Step struct {
+	// Uses is the name of the step runner or PromotionTask used by the step.
+	Uses string `json:"uses,omitempty"`
+	// As is the alias of the step.
+	As string `json:"as,omitempty"`
+}
+
+// RunDetails describes the Promotion that performed a deployment.
+type RunDetails struct {
+	// Builder identifies the Kargo instance that performed the deployment.
+	Builder Builder `json:"builder"`
+	// Metadata contains the identity and timing of the Promotion.
+	Metadata Metadata `json:"metadata"`
+	// Byproducts contains the verifications of the deployment.
+	Byproducts []ResourceDescriptor `json:"byproducts,omitempty"`
+}
+
+// Builder identifies the Kargo instance that performed a deployment.
+type Builder struct {
+	// ID is always BuilderID.
+	ID string `json:"id"`
+	// Version contains the version of Kargo, keyed by component.
+	Version map[string]string `json:"version,omitempty"`
+}
+
+// Metadata contains the identity and timing of a Promotion.
+type Metadata struct {
+	// InvocationID is the UID of the Promotion.
+	InvocationID string `json:"invocationId"`
+	// StartedOn is the time at which the Promotion started.
+	StartedOn *time.Time `json:"startedOn,omitempty"`
+	// FinishedOn is the time at which the Promotion finished.
+	FinishedOn *time.Time `json:"finishedOn,omitempty"`
+}
+
+// NewStatement returns a Statement attesting to the deployment of the provided
+// Freight by the provided Promotion. The provided verifications, if any, are
+// recorded as byproducts of the deployment.
+func NewStatement(
+	freight *kargoapi.Freight,
+	promo *kargoapi.Promotion,
+	verifications []kargoapi.VerificationInfo,
+) Statement {
+	artifacts := artifactsOf(freight)
+
+	steps := make([]Step, 0, len(promo.Spec.Steps))
+	for _, step := range promo.Spec.Steps {
+		uses := step.Uses
+		if step.Task != nil {
+			kind := step.Task.Kind
+			if kind == "" {
+				kind = "PromotionTask"
+			}
+			uses = fmt.Sprintf("%s/%s", kind, step.Task.Name)
+		}
+		steps = append(steps, Step{Uses: uses, As: step.As})
+	}
+
+	byproducts := make([]ResourceDescriptor, 0, len(verifications))
+	for _, v := range verifications {
+		d := ResourceDescriptor{
+			Name: v.ID,
+			Annotations: map[string]string{
+				"phase": string(v.Phase),
+			},
+		}
+		if v.AnalysisRun != nil {
+			d.Name = v.AnalysisRun.Name
+			d.URI = fmt.Sprintf("kargo://analysisrun/%s/%s", v.AnalysisRun.Namespace, v.AnalysisRun.Name)
+		}
+		if v.Message != "" {
+			d.Annotations["message"] = v.Message
+		}
+		byproducts = append(byproducts, d)
+	}
+
+	return Statement{
+		Type:          StatementType,
+		Subject:       artifacts,
+		PredicateType: PredicateType,
+		Predicate: Provenance{
+			BuildDefinition: BuildDefinition{
+				BuildType: BuildType,
+				ExternalParameters: ExternalParameters{
+					Project:      promo.Namespace,
+					Stage:        promo.Spec.Stage,
+					Freight:      freight.Name,
+					FreightAlias: freight.Alias,
+					Origin:       freight.Origin.String(),
+				},
+				InternalParameters: InternalParameters{
+					Promotion: promo.Name,
+					Steps:     steps,
+				},
+				ResolvedDependencies: artifacts,
+			},
+			RunDetails: RunDetails{
+				Builder: Builder{
+					ID: BuilderID,
+					Version: map[string]string{
+						"kargo": version.GetVersion().Version,
+					},
+				},
+				Metadata: Metadata{
+					InvocationID: string(promo.UID),
+					StartedOn:    timeOf(promo.Status.StartedAt),
+					FinishedOn:   timeOf(promo.Status.FinishedAt),
+				},
+				Byproducts: byproducts,
+			},
+		},
+	}
+}
+
+// Marshal returns the JSON encoding of a Statement attesting to the
+// deployment of the provided Freight by the provided Promotion.
+func Marshal(
+	freight *kargoapi.Freight,
+	promo *kargoapi.Promotion,
+	verifications []kargoapi.VerificationInfo,
+) ([]byte, error) {
+	return json.Marshal(NewStatement(freight, promo, verifications))
+}
+
+// artifactsOf returns descriptors for all artifacts referenced by the
+// provided Freight.
+func artifactsOf(freight *kargoapi.Freight) []ResourceDescriptor {
+	artifacts := make(
+		[]ResourceDescriptor,
+		0,
+		len(freight.Commits)+len(freight.Images)+len(freight.Charts),
+	)
+	for _, commit := range freight.Commits {
+		d := ResourceDescriptor{
+			Name: commit.RepoURL,
+			URI:  "git+" + commit.RepoURL,
+			Digest: map[string]string{
+				digestAlgorithmGitCommit: commit.ID,
+			},
+		}
+		if commit.Tag != "" {
+			d.Annotations = map[string]string{"tag": commit.Tag}
+		} else if commit.Branch != "" {
+			d.Annotations = map[string]string{"branch": commit.Branch}
+		}
+		artifacts = append(artifacts, d)
+	}
+	for _, image := range freight.Images {
+		d := ResourceDescriptor{
+			Name: image.RepoURL,
+			URI:  image.RepoURL,
+		}
+		if algorithm, digest, ok := strings.Cut(image.Digest, ":"); ok {
+			d.URI = fmt.Sprintf("%s@%s", image.RepoURL, image.Digest)
+			d.Digest = map[string]string{algorithm: digest}
+		}
+		if image.Tag != "" {
+			d.Annotations = map[string]string{"tag": image.Tag}
+		}
+		artifacts = append(artifacts, d)
+	}
+	for _, chart := range freight.Charts {
+		uri := chart.RepoURL
+		if chart.Name != "" {
+			uri = fmt.Sprintf("%s/%s", strings.TrimSuffix(chart.RepoURL, "/"), chart.Name)
+		}
+		artifacts = append(artifacts, ResourceDescriptor{
+			Name: uri,
+			URI:  uri,
+			Annotations: map[string]string{
+				"version": chart.Version,
+			},
+		})
+	}
+	return artifacts
+}
+
+// timeOf returns the time wrapped by the provided metav1.Time, or nil if it
+// is nil.
+func timeOf(t *metav1.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	return &t.Time
+}
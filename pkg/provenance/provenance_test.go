@@ -0,0 +1,184 @@
+package provenance
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestNewStatement(t *testing.T) {
+	startedAt := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	finishedAt := metav1.NewTime(startedAt.Add(time.Minute))
+
+	freight := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-project",
+			Name:      "fake-freight",
+		},
+		Alias: "fake-alias",
+		Origin: kargoapi.FreightOrigin{
+			Kind: kargoapi.FreightOriginKindWarehouse,
+			Name: "fake-warehouse",
+		},
+		Commits: []kargoapi.GitCommit{{
+			RepoURL: "https://github.com/example/repo",
+			ID:      "abc123",
+			Branch:  "main",
+		}},
+		Images: []kargoapi.Image{{
+			RepoURL: "example/image",
+			Tag:     "v1.0.0",
+			Digest:  "sha256:def456",
+		}},
+		Charts: []kargoapi.Chart{{
+			RepoURL: "oci://example.com/charts/",
+			Name:    "fake-chart",
+			Version: "1.2.3",
+		}},
+	}
+	promo := &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-project",
+			Name:      "fake-promotion",
+			UID:       "fake-uid",
+		},
+		Spec: kargoapi.PromotionSpec{
+			Stage:   "fake-stage",
+			Freight: "fake-freight",
+			Steps: []kargoapi.PromotionStep{
+				{Uses: "git-clone"},
+				{
+					As:   "update",
+					Task: &kargoapi.PromotionTaskReference{Name: "fake-task"},
+				},
+			},
+		},
+		Status: kargoapi.PromotionStatus{
+			StartedAt:  &startedAt,
+			FinishedAt: &finishedAt,
+		},
+	}
+	verifications := []kargoapi.VerificationInfo{{
+		ID:    "fake-verification",
+		Phase: kargoapi.VerificationPhaseSuccessful,
+		AnalysisRun: &kargoapi.AnalysisRunReference{
+			Namespace: "fake-project",
+			Name:      "fake-run",
+		},
+	}}
+
+	statement := NewStatement(freight, promo, verifications)
+
+	require.Equal(t, StatementType, statement.Type)
+	require.Equal(t, PredicateType, statement.PredicateType)
+	require.Equal(
+		t,
+		[]ResourceDescriptor{
+			{
+				Name:        "https://github.com/example/repo",
+				URI:         "git+https://github.com/example/repo",
+				Digest:      map[string]string{"gitCommit": "abc123"},
+				Annotations: map[string]string{"branch": "main"},
+			},
+			{
+				Name:        "example/image",
+				URI:         "example/image@sha256:def456",
+				Digest:      map[string]string{"sha256": "def456"},
+				Annotations: map[string]string{"tag": "v1.0.0"},
+			},
+			{
+				Name:        "oci://example.com/charts/fake-chart",
+				URI:         "oci://example.com/charts/fake-chart",
+				Annotations: map[string]string{"version": "1.2.3"},
+			},
+		},
+		statement.Subject,
+	)
+
+	buildDef := statement.Predicate.BuildDefinition
+	require.Equal(t, BuildType, buildDef.BuildType)
+	require.Equal(
+		t,
+		ExternalParameters{
+			Project:      "fake-project",
+			Stage:        "fake-stage",
+			Freight:      "fake-freight",
+			FreightAlias: "fake-alias",
+			Origin:       "Warehouse/fake-warehouse",
+		},
+		buildDef.ExternalParameters,
+	)
+	require.Equal(
+		t,
+		InternalParameters{
+			Promotion: "fake-promotion",
+			Steps: []Step{
+				{Uses: "git-clone"},
+				{Uses: "PromotionTask/fake-task", As: "update"},
+			},
+		},
+		buildDef.InternalParameters,
+	)
+	require.Equal(t, statement.Subject, buildDef.ResolvedDependencies)
+
+	runDetails := statement.Predicate.RunDetails
+	require.Equal(t, BuilderID, runDetails.Builder.ID)
+	require.NotEmpty(t, runDetails.Builder.Version["kargo"])
+	require.Equal(
+		t,
+		Metadata{
+			InvocationID: "fake-uid",
+			StartedOn:    &startedAt.Time,
+			FinishedOn:   &finishedAt.Time,
+		},
+		runDetails.Metadata,
+	)
+	require.Equal(
+		t,
+		[]ResourceDescriptor{{
+			Name:        "fake-run",
+			URI:         "kargo://analysisrun/fake-project/fake-run",
+			Annotations: map[string]string{"phase": "Successful"},
+		}},
+		runDetails.Byproducts,
+	)
+}
+
+func TestMarshal(t *testing.T) {
+	b, err := Marshal(
+		&kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{Name: "fake-freight"},
+			Images: []kargoapi.Image{{
+				RepoURL: "example/image",
+				Tag:     "v1.0.0",
+			}},
+		},
+		&kargoapi.Promotion{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-project",
+				Name:      "fake-promotion",
+			},
+		},
+		nil,
+	)
+	require.NoError(t, err)
+
+	statement := map[string]any{}
+	require.NoError(t, json.Unmarshal(b, &statement))
+	require.Equal(t, StatementType, statement["_type"])
+	require.Equal(t, PredicateType, statement["predicateType"])
+	require.Equal(
+		t,
+		[]any{map[string]any{
+			"name":        "example/image",
+			"uri":         "example/image",
+			"annotations": map[string]any{"tag": "v1.0.0"},
+		}},
+		statement["subject"],
+	)
+}
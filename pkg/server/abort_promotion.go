@@ -9,6 +9,7 @@ import (
 	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/pkg/api"
+	"github.com/akuity/kargo/pkg/audit"
 )
 
 func (s *server) AbortPromotion(
@@ -35,5 +36,13 @@ func (s *server) AbortPromotion(
 	if err := api.AbortPromotion(ctx, s.client, objKey, kargoapi.AbortActionTerminate); err != nil {
 		return nil, err
 	}
+	s.auditor.Record(ctx, audit.Entry{
+		Action:  audit.ActionAbortPromotion,
+		Project: project,
+		Object: audit.ObjectReference{
+			Kind: "Promotion",
+			Name: name,
+		},
+	})
 	return connect.NewResponse(&svcv1alpha1.AbortPromotionResponse{}), nil
 }
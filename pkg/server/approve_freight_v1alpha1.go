@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	"connectrpc.com/connect"
@@ -14,6 +15,7 @@ import (
 	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/pkg/api"
+	"github.com/akuity/kargo/pkg/audit"
 	"github.com/akuity/kargo/pkg/event"
 	"github.com/akuity/kargo/pkg/kubeclient"
 	"github.com/akuity/kargo/pkg/logging"
@@ -103,7 +105,8 @@ func (s *server) ApproveFreight(
 		return nil, err
 	}
 
-	if freight.IsApprovedFor(stageName) {
+	allowStale := req.Msg.GetAllowStale()
+	if freight.IsApprovedFor(stageName) && (!allowStale || freight.IsStaleAllowedFor(stageName)) {
 		return &connect.Response[svcv1alpha1.ApproveFreightResponse]{}, nil
 	}
 
@@ -111,7 +114,11 @@ func (s *server) ApproveFreight(
 	if newStatus.ApprovedFor == nil {
 		newStatus.ApprovedFor = make(map[string]kargoapi.ApprovedStage)
 	}
-	newStatus.AddApprovedStage(stageName, time.Now())
+	if allowStale {
+		newStatus.AllowStaleFor(stageName, time.Now())
+	} else {
+		newStatus.AddApprovedStage(stageName, time.Now())
+	}
 
 	if err := s.patchFreightStatusFn(ctx, freight, newStatus); err != nil {
 		return nil, fmt.Errorf("patch status: %w", err)
@@ -129,6 +136,21 @@ func (s *server) ApproveFreight(
 		logging.LoggerFromContext(ctx).Error(err,
 			"error sending Freight approved event")
 	}
+	details := map[string]string{
+		"stage": stageName,
+	}
+	if allowStale {
+		details["allowStale"] = strconv.FormatBool(allowStale)
+	}
+	s.auditor.Record(ctx, audit.Entry{
+		Action:  audit.ActionApproveFreight,
+		Project: project,
+		Object: audit.ObjectReference{
+			Kind: "Freight",
+			Name: freight.Name,
+		},
+		Details: details,
+	})
 	return &connect.Response[svcv1alpha1.ApproveFreightResponse]{}, nil
 }
 
@@ -1,7 +1,9 @@
 package server
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
 
@@ -14,11 +16,13 @@ import (
 
 	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/audit"
 	k8sevent "github.com/akuity/kargo/pkg/event/kubernetes"
 	fakeevent "github.com/akuity/kargo/pkg/kubernetes/event/fake"
 )
 
 func TestApproveFreight(t *testing.T) {
+	auditLog := &bytes.Buffer{}
 	testCases := []struct {
 		name       string
 		req        *svcv1alpha1.ApproveFreightRequest
@@ -358,6 +362,7 @@ func TestApproveFreight(t *testing.T) {
 				) error {
 					return nil
 				},
+				auditor: audit.NewRecorder(audit.NewWriterSink(auditLog)),
 			},
 			assertions: func(
 				t *testing.T,
@@ -370,6 +375,74 @@ func TestApproveFreight(t *testing.T) {
 				event := <-recorder.Events
 				require.Equal(t, corev1.EventTypeNormal, event.EventType)
 				require.Equal(t, string(kargoapi.EventTypeFreightApproved), event.Reason)
+				entry := audit.Entry{}
+				require.NoError(t, json.Unmarshal(auditLog.Bytes(), &entry))
+				require.Equal(t, audit.ActionApproveFreight, entry.Action)
+				require.Equal(t, "fake-project", entry.Project)
+				require.Equal(t, map[string]string{"stage": "fake-stage"}, entry.Details)
+			},
+		},
+		{
+			name: "success allowing stale Freight that was already approved",
+			req: &svcv1alpha1.ApproveFreightRequest{
+				Project:    "fake-project",
+				Name:       "fake-freight",
+				Stage:      "fake-stage",
+				AllowStale: true,
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getFreightByNameOrAliasFn: func(
+					context.Context,
+					client.Client,
+					string,
+					string,
+					string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						Status: kargoapi.FreightStatus{
+							ApprovedFor: map[string]kargoapi.ApprovedStage{"fake-stage": {}},
+						},
+					}, nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{}, nil
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return nil
+				},
+				patchFreightStatusFn: func(
+					_ context.Context,
+					_ *kargoapi.Freight,
+					status kargoapi.FreightStatus,
+				) error {
+					if !status.ApprovedFor["fake-stage"].AllowStale {
+						return errors.New("stale Freight was not allowed")
+					}
+					return nil
+				},
+				auditor: audit.NewRecorder(audit.NewWriterSink(&bytes.Buffer{})),
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.ApproveFreightResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Len(t, recorder.Events, 1)
 			},
 		},
 	}
@@ -32,6 +32,9 @@ type ServerConfig struct {
 	AnalysisRunLogToken         string
 	AnalysisRunLogHTTPHeaders   map[string]string
 	ClusterSecretNamespace      string
+	// APITokenSigningKey is the key used to sign Kargo API tokens. If empty,
+	// Kargo API tokens can neither be created nor used.
+	APITokenSigningKey []byte
 }
 
 func ServerConfigFromEnv() ServerConfig {
@@ -77,6 +80,7 @@ func ServerConfigFromEnv() ServerConfig {
 	if cfg.SecretManagementEnabled {
 		cfg.ClusterSecretNamespace = os.GetEnv("CLUSTER_SECRETS_NAMESPACE", "")
 	}
+	cfg.APITokenSigningKey = []byte(os.GetEnv("API_TOKEN_SIGNING_KEY", ""))
 	return cfg
 }
 
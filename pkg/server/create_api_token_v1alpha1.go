@@ -0,0 +1,111 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/audit"
+	"github.com/akuity/kargo/pkg/server/rbac"
+)
+
+func (s *server) CreateAPIToken(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.CreateAPITokenRequest],
+) (*connect.Response[svcv1alpha1.CreateAPITokenResponse], error) {
+	project := req.Msg.GetProject()
+	if err := validateFieldNotEmpty("project", project); err != nil {
+		return nil, err
+	}
+
+	name := req.Msg.GetName()
+	if err := validateFieldNotEmpty("name", name); err != nil {
+		return nil, err
+	}
+
+	roleName := req.Msg.GetRoleName()
+	if err := validateFieldNotEmpty("role_name", roleName); err != nil {
+		return nil, err
+	}
+
+	if req.Msg.GetExpiresAt() == nil {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("expires_at should not be empty"),
+		)
+	}
+	expiresAt := req.Msg.GetExpiresAt().AsTime()
+	if !expiresAt.After(time.Now()) {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("expires_at must be in the future"),
+		)
+	}
+
+	if err := s.validateProjectExistsFn(ctx, project); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.rolesDB.Get(ctx, project, roleName); err != nil {
+		return nil, fmt.Errorf(
+			"error getting Kargo Role %q in project %q: %w", roleName, project, err,
+		)
+	}
+
+	// A token grants the permissions of its Role to anyone who holds it. To
+	// prevent privilege escalation, only users who could themselves bind the
+	// Role may create a token for it.
+	if err := s.authorizeFn(
+		ctx,
+		"bind",
+		schema.GroupVersionResource{
+			Group:    rbacv1.SchemeGroupVersion.Group,
+			Version:  rbacv1.SchemeGroupVersion.Version,
+			Resource: "roles",
+		},
+		"",
+		types.NamespacedName{
+			Namespace: project,
+			Name:      roleName,
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	token, rawToken, err := s.tokensDB.Create(ctx, project, name, roleName, expiresAt)
+	if errors.Is(err, rbac.ErrAPITokensNotEnabled) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error creating API token %q in project %q: %w", name, project, err,
+		)
+	}
+
+	s.auditor.Record(ctx, audit.Entry{
+		Action:  audit.ActionCreateAPIToken,
+		Project: project,
+		Object: audit.ObjectReference{
+			Kind: "APIToken",
+			Name: name,
+		},
+		Details: map[string]string{
+			"role":      roleName,
+			"expiresAt": expiresAt.UTC().Format(time.RFC3339),
+		},
+	})
+
+	return connect.NewResponse(
+		&svcv1alpha1.CreateAPITokenResponse{
+			Token:    token,
+			RawToken: rawToken,
+		},
+	), nil
+}
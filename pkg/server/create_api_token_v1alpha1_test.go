@@ -0,0 +1,186 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/audit"
+	"github.com/akuity/kargo/pkg/server/rbac"
+)
+
+func TestCreateAPIToken(t *testing.T) {
+	validReq := func() *svcv1alpha1.CreateAPITokenRequest {
+		return &svcv1alpha1.CreateAPITokenRequest{
+			Project:   "fake-project",
+			Name:      "fake-token",
+			RoleName:  "fake-role",
+			ExpiresAt: timestamppb.New(time.Now().Add(time.Hour)),
+		}
+	}
+	newServer := func(
+		signingKey []byte,
+		authorizeErr error,
+		objects ...client.Object,
+	) (*server, *bytes.Buffer) {
+		c := fake.NewClientBuilder().WithObjects(objects...).Build()
+		auditLog := &bytes.Buffer{}
+		return &server{
+			rolesDB:  rbac.NewKubernetesRolesDatabase(c),
+			tokensDB: rbac.NewKubernetesAPITokensDatabase(c, signingKey),
+			auditor:  audit.NewRecorder(audit.NewWriterSink(auditLog)),
+			validateProjectExistsFn: func(context.Context, string) error {
+				return nil
+			},
+			authorizeFn: func(
+				_ context.Context,
+				verb string,
+				gvr schema.GroupVersionResource,
+				_ string,
+				key client.ObjectKey,
+			) error {
+				require.Equal(t, "bind", verb)
+				require.Equal(t, "roles", gvr.Resource)
+				require.Equal(t, "fake-role", key.Name)
+				return authorizeErr
+			},
+		}, auditLog
+	}
+	role := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-project",
+			Name:      "fake-role",
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		req        *svcv1alpha1.CreateAPITokenRequest
+		objects    []client.Object
+		signingKey []byte
+		authorize  error
+		assertions func(*testing.T, *bytes.Buffer, *connect.Response[svcv1alpha1.CreateAPITokenResponse], error)
+	}{
+		{
+			name: "missing role name",
+			req: func() *svcv1alpha1.CreateAPITokenRequest {
+				req := validReq()
+				req.RoleName = ""
+				return req
+			}(),
+			assertions: func(
+				t *testing.T,
+				_ *bytes.Buffer,
+				_ *connect.Response[svcv1alpha1.CreateAPITokenResponse],
+				err error,
+			) {
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "expiry in the past",
+			req: func() *svcv1alpha1.CreateAPITokenRequest {
+				req := validReq()
+				req.ExpiresAt = timestamppb.New(time.Now().Add(-time.Hour))
+				return req
+			}(),
+			assertions: func(
+				t *testing.T,
+				_ *bytes.Buffer,
+				_ *connect.Response[svcv1alpha1.CreateAPITokenResponse],
+				err error,
+			) {
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+				require.ErrorContains(t, err, "must be in the future")
+			},
+		},
+		{
+			name: "role does not exist",
+			req:  validReq(),
+			assertions: func(
+				t *testing.T,
+				_ *bytes.Buffer,
+				_ *connect.Response[svcv1alpha1.CreateAPITokenResponse],
+				err error,
+			) {
+				require.True(t, apierrors.IsNotFound(err))
+			},
+		},
+		{
+			name:       "API tokens not enabled",
+			req:        validReq(),
+			objects:    []client.Object{role},
+			signingKey: []byte{},
+			assertions: func(
+				t *testing.T,
+				auditLog *bytes.Buffer,
+				_ *connect.Response[svcv1alpha1.CreateAPITokenResponse],
+				err error,
+			) {
+				require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+				require.ErrorIs(t, err, rbac.ErrAPITokensNotEnabled)
+				require.Zero(t, auditLog.Len())
+			},
+		},
+		{
+			name:      "not allowed to bind role",
+			req:       validReq(),
+			objects:   []client.Object{role},
+			authorize: errors.New("forbidden"),
+			assertions: func(
+				t *testing.T,
+				auditLog *bytes.Buffer,
+				_ *connect.Response[svcv1alpha1.CreateAPITokenResponse],
+				err error,
+			) {
+				require.ErrorContains(t, err, "forbidden")
+				require.Zero(t, auditLog.Len())
+			},
+		},
+		{
+			name:    "success",
+			req:     validReq(),
+			objects: []client.Object{role},
+			assertions: func(
+				t *testing.T,
+				auditLog *bytes.Buffer,
+				res *connect.Response[svcv1alpha1.CreateAPITokenResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, "fake-token", res.Msg.GetToken().Name)
+				require.Equal(t, "fake-role", res.Msg.GetToken().RoleName)
+				require.True(t, rbac.IsAPIToken(res.Msg.GetRawToken()))
+				entry := audit.Entry{}
+				require.NoError(t, json.Unmarshal(auditLog.Bytes(), &entry))
+				require.Equal(t, audit.ActionCreateAPIToken, entry.Action)
+				require.Equal(t, "fake-role", entry.Details["role"])
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			signingKey := testCase.signingKey
+			if signingKey == nil {
+				signingKey = []byte("fake-signing-key")
+			}
+			svr, auditLog := newServer(signingKey, testCase.authorize, testCase.objects...)
+			res, err := svr.CreateAPIToken(context.Background(), connect.NewRequest(testCase.req))
+			testCase.assertions(t, auditLog, res, err)
+		})
+	}
+}
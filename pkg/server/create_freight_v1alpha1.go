@@ -0,0 +1,445 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/api"
+	"github.com/akuity/kargo/pkg/audit"
+	"github.com/akuity/kargo/pkg/controller/warehouses"
+	"github.com/akuity/kargo/pkg/logging"
+	"github.com/akuity/kargo/pkg/urls"
+)
+
+// minCommitIDPrefixLength is the minimum number of characters a (possibly
+// abbreviated) commit ID must have for it to be matched against the IDs of
+// discovered commits.
+const minCommitIDPrefixLength = 7
+
+// CreateFreight creates Freight originating from a Warehouse from a specific
+// set of artifacts. Every artifact must correspond to one of the Warehouse's
+// subscriptions, and every subscription must be accounted for. Artifact details
+// (e.g. image digests and commit metadata) are resolved using the same
+// discovery logic the Warehouse itself uses, which also ensures that only
+// artifacts satisfying the subscriptions' constraints are accepted.
+func (s *server) CreateFreight(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.CreateFreightRequest],
+) (*connect.Response[svcv1alpha1.CreateFreightResponse], error) {
+	project := req.Msg.GetProject()
+	if err := validateFieldNotEmpty("project", project); err != nil {
+		return nil, err
+	}
+
+	warehouseName := req.Msg.GetWarehouse()
+	if err := validateFieldNotEmpty("warehouse", warehouseName); err != nil {
+		return nil, err
+	}
+
+	commits := req.Msg.GetCommits()
+	images := req.Msg.GetImages()
+	charts := req.Msg.GetCharts()
+	if len(commits) == 0 && len(images) == 0 && len(charts) == 0 {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("at least one commit, image, or chart must be specified"),
+		)
+	}
+
+	if err := s.validateProjectExistsFn(ctx, project); err != nil {
+		return nil, err
+	}
+
+	warehouse, err := s.getWarehouseFn(
+		ctx,
+		s.client,
+		types.NamespacedName{
+			Namespace: project,
+			Name:      warehouseName,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get warehouse: %w", err)
+	}
+	if warehouse == nil {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			fmt.Errorf(
+				"warehouse %q not found in namespace %q",
+				warehouseName, project,
+			),
+		)
+	}
+
+	// Validate the requested artifacts against the Warehouse's subscriptions
+	// before performing any (comparatively expensive) discovery.
+	if err = validateRequestedArtifacts(warehouse, commits, images, charts); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	discovered, err := s.discoverArtifactsFn(ctx, warehouse)
+	if err != nil {
+		return nil, connect.NewError(
+			connect.CodeUnavailable,
+			fmt.Errorf("error discovering artifacts: %w", err),
+		)
+	}
+
+	freight, err := buildFreightFromRequestedArtifacts(
+		discovered,
+		commits,
+		images,
+		charts,
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	freight.Namespace = project
+	freight.Origin = kargoapi.FreightOrigin{
+		Kind: kargoapi.FreightOriginKindWarehouse,
+		Name: warehouse.Name,
+	}
+	if alias := req.Msg.GetAlias(); alias != "" {
+		freight.Alias = alias
+		freight.Labels = map[string]string{kargoapi.LabelKeyAlias: alias}
+	}
+	freight.Name = api.GenerateFreightID(freight)
+
+	if err = s.createFreightFn(ctx, freight); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return nil, connect.NewError(
+				connect.CodeAlreadyExists,
+				fmt.Errorf(
+					"freight %q referencing the same artifacts already exists in namespace %q",
+					freight.Name, project,
+				),
+			)
+		}
+		return nil, fmt.Errorf("create freight: %w", err)
+	}
+
+	s.auditor.Record(ctx, audit.Entry{
+		Action:  audit.ActionCreateFreight,
+		Project: project,
+		Object: audit.ObjectReference{
+			Kind: "Freight",
+			Name: freight.Name,
+		},
+		Details: map[string]string{
+			"warehouse": warehouse.Name,
+			"alias":     freight.Alias,
+		},
+	})
+
+	return connect.NewResponse(&svcv1alpha1.CreateFreightResponse{
+		Freight: freight,
+	}), nil
+}
+
+func (s *server) discoverArtifacts(
+	ctx context.Context,
+	warehouse *kargoapi.Warehouse,
+) (*kargoapi.DiscoveredArtifacts, error) {
+	logging.LoggerFromContext(ctx).Debug(
+		"discovering artifacts for Freight creation",
+		"namespace", warehouse.Namespace,
+		"warehouse", warehouse.Name,
+	)
+	return warehouses.DiscoverArtifacts(ctx, s.credsDB, warehouse)
+}
+
+// chartKey returns a key that uniquely identifies a chart from the provided
+// repository URL and chart name.
+func chartKey(repoURL, name string) string {
+	repoURL = urls.NormalizeChart(repoURL)
+	if name == "" {
+		return repoURL
+	}
+	return strings.TrimSuffix(repoURL, "/") + "/" + name
+}
+
+// validateRequestedArtifacts returns an error if any of the requested
+// artifacts are malformed or do not correspond to a subscription of the
+// provided Warehouse, if more than one artifact corresponds to the same
+// subscription, or if any subscription is not accounted for.
+func validateRequestedArtifacts(
+	warehouse *kargoapi.Warehouse,
+	commits []*kargoapi.GitCommit,
+	images []*kargoapi.Image,
+	charts []*kargoapi.Chart,
+) error {
+	// Map each subscription to the number of requested artifacts that
+	// correspond to it.
+	gitSubs := map[string]int{}
+	imageSubs := map[string]int{}
+	chartSubs := map[string]int{}
+	for _, sub := range warehouse.Spec.Subscriptions {
+		switch {
+		case sub.Git != nil:
+			gitSubs[urls.NormalizeGit(sub.Git.RepoURL)] = 0
+		case sub.Image != nil:
+			imageSubs[sub.Image.RepoURL] = 0
+		case sub.Chart != nil:
+			chartSubs[chartKey(sub.Chart.RepoURL, sub.Chart.Name)] = 0
+		}
+	}
+
+	var errs []error
+	for _, commit := range commits {
+		if commit.RepoURL == "" || (commit.ID == "" && commit.Tag == "") {
+			errs = append(errs, errors.New(
+				"each commit must specify a repoURL and either an id or a tag",
+			))
+			continue
+		}
+		if commit.ID != "" && len(commit.ID) < minCommitIDPrefixLength {
+			errs = append(errs, fmt.Errorf(
+				"commit id %q for Git repository %q must be at least %d characters long",
+				commit.ID, commit.RepoURL, minCommitIDPrefixLength,
+			))
+			continue
+		}
+		key := urls.NormalizeGit(commit.RepoURL)
+		if _, ok := gitSubs[key]; !ok {
+			errs = append(errs, fmt.Errorf(
+				"warehouse %q does not subscribe to Git repository %q",
+				warehouse.Name, commit.RepoURL,
+			))
+			continue
+		}
+		gitSubs[key]++
+	}
+	for _, image := range images {
+		if image.RepoURL == "" || (image.Tag == "" && image.Digest == "") {
+			errs = append(errs, errors.New(
+				"each image must specify a repoURL and either a tag or a digest",
+			))
+			continue
+		}
+		if _, ok := imageSubs[image.RepoURL]; !ok {
+			errs = append(errs, fmt.Errorf(
+				"warehouse %q does not subscribe to image repository %q",
+				warehouse.Name, image.RepoURL,
+			))
+			continue
+		}
+		imageSubs[image.RepoURL]++
+	}
+	for _, chart := range charts {
+		if chart.RepoURL == "" || chart.Version == "" {
+			errs = append(errs, errors.New(
+				"each chart must specify a repoURL and a version",
+			))
+			continue
+		}
+		key := chartKey(chart.RepoURL, chart.Name)
+		if _, ok := chartSubs[key]; !ok {
+			errs = append(errs, fmt.Errorf(
+				"warehouse %q does not subscribe to Helm chart %q",
+				warehouse.Name, key,
+			))
+			continue
+		}
+		chartSubs[key]++
+	}
+
+	for _, kindSubs := range []struct {
+		kind string
+		subs map[string]int
+	}{
+		{kind: "Git repository", subs: gitSubs},
+		{kind: "image repository", subs: imageSubs},
+		{kind: "Helm chart", subs: chartSubs},
+	} {
+		kind, subs := kindSubs.kind, kindSubs.subs
+		for _, key := range sortedKeys(subs) {
+			switch count := subs[key]; {
+			case count == 0:
+				errs = append(errs, fmt.Errorf(
+					"no artifact specified for %s %q subscribed to by warehouse %q",
+					kind, key, warehouse.Name,
+				))
+			case count > 1:
+				errs = append(errs, fmt.Errorf(
+					"multiple artifacts specified for %s %q subscribed to by warehouse %q",
+					kind, key, warehouse.Name,
+				))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// buildFreightFromRequestedArtifacts builds Freight from the requested
+// artifacts, with the details of each resolved from the provided discovered
+// artifacts. It returns an error if any requested artifact cannot be found
+// among the discovered artifacts.
+func buildFreightFromRequestedArtifacts(
+	discovered *kargoapi.DiscoveredArtifacts,
+	commits []*kargoapi.GitCommit,
+	images []*kargoapi.Image,
+	charts []*kargoapi.Chart,
+) (*kargoapi.Freight, error) {
+	if discovered == nil {
+		discovered = &kargoapi.DiscoveredArtifacts{}
+	}
+	freight := &kargoapi.Freight{}
+
+	var errs []error
+	for _, requested := range commits {
+		commit, err := resolveRequestedCommit(discovered.Git, requested)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		freight.Commits = append(freight.Commits, kargoapi.GitCommit{
+			// Use the URL exactly as it appears in the subscription
+			RepoURL:   commit.RepoURL,
+			ID:        commit.ID,
+			Branch:    commit.Branch,
+			Tag:       commit.Tag,
+			Message:   commit.Subject,
+			Author:    commit.Author,
+			Committer: commit.Committer,
+		})
+	}
+	for _, requested := range images {
+		image, err := resolveRequestedImage(discovered.Images, requested)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		freight.Images = append(freight.Images, *image)
+	}
+	for _, requested := range charts {
+		chart, err := resolveRequestedChart(discovered.Charts, requested)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		freight.Charts = append(freight.Charts, *chart)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return freight, nil
+}
+
+// resolvedCommit is a kargoapi.DiscoveredCommit paired with the URL of the
+// repository it was discovered in.
+type resolvedCommit struct {
+	kargoapi.DiscoveredCommit
+	RepoURL string
+}
+
+func resolveRequestedCommit(
+	results []kargoapi.GitDiscoveryResult,
+	requested *kargoapi.GitCommit,
+) (*resolvedCommit, error) {
+	repoURL := urls.NormalizeGit(requested.RepoURL)
+	for _, result := range results {
+		if urls.NormalizeGit(result.RepoURL) != repoURL {
+			continue
+		}
+		for _, commit := range result.Commits {
+			if requested.ID != "" && !strings.HasPrefix(commit.ID, requested.ID) {
+				continue
+			}
+			if requested.Tag != "" && commit.Tag != requested.Tag {
+				continue
+			}
+			return &resolvedCommit{
+				DiscoveredCommit: commit,
+				RepoURL:          result.RepoURL,
+			}, nil
+		}
+	}
+	ref := requested.ID
+	if ref == "" {
+		ref = requested.Tag
+	}
+	return nil, fmt.Errorf(
+		"commit %q was not found among the commits discovered from Git repository "+
+			"%q; it may not exist or may not satisfy the subscription's constraints",
+		ref, requested.RepoURL,
+	)
+}
+
+func resolveRequestedImage(
+	results []kargoapi.ImageDiscoveryResult,
+	requested *kargoapi.Image,
+) (*kargoapi.Image, error) {
+	for _, result := range results {
+		if result.RepoURL != requested.RepoURL {
+			continue
+		}
+		for _, ref := range result.References {
+			if requested.Tag != "" && ref.Tag != requested.Tag {
+				continue
+			}
+			if requested.Digest != "" && ref.Digest != requested.Digest {
+				continue
+			}
+			return &kargoapi.Image{
+				RepoURL:     result.RepoURL,
+				Tag:         ref.Tag,
+				Digest:      ref.Digest,
+				Annotations: ref.Annotations,
+			}, nil
+		}
+	}
+	ref := requested.Tag
+	if requested.Digest != "" {
+		ref = requested.Digest
+	}
+	return nil, fmt.Errorf(
+		"image %q was not found among the images discovered from image "+
+			"repository %q; it may not exist or may not satisfy the subscription's "+
+			"constraints",
+		ref, requested.RepoURL,
+	)
+}
+
+func resolveRequestedChart(
+	results []kargoapi.ChartDiscoveryResult,
+	requested *kargoapi.Chart,
+) (*kargoapi.Chart, error) {
+	key := chartKey(requested.RepoURL, requested.Name)
+	for _, result := range results {
+		if chartKey(result.RepoURL, result.Name) != key {
+			continue
+		}
+		if slices.Contains(result.Versions, requested.Version) {
+			return &kargoapi.Chart{
+				RepoURL: result.RepoURL,
+				Name:    result.Name,
+				Version: requested.Version,
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf(
+		"version %q of Helm chart %q was not found among the versions discovered; "+
+			"it may not exist or may not satisfy the subscription's constraints",
+		requested.Version, key,
+	)
+}
@@ -0,0 +1,518 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/api"
+	"github.com/akuity/kargo/pkg/audit"
+)
+
+func TestCreateFreight(t *testing.T) {
+	testWarehouse := &kargoapi.Warehouse{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-project",
+			Name:      "fake-warehouse",
+		},
+		Spec: kargoapi.WarehouseSpec{
+			Subscriptions: []kargoapi.RepoSubscription{
+				{Git: &kargoapi.GitSubscription{RepoURL: "https://github.com/example/repo"}},
+				{Image: &kargoapi.ImageSubscription{RepoURL: "example/image"}},
+				{Chart: &kargoapi.ChartSubscription{
+					RepoURL: "https://charts.example.com",
+					Name:    "chart",
+				}},
+			},
+		},
+	}
+	testDiscovered := &kargoapi.DiscoveredArtifacts{
+		Git: []kargoapi.GitDiscoveryResult{{
+			RepoURL: "https://github.com/example/repo",
+			Commits: []kargoapi.DiscoveredCommit{
+				{
+					ID:      "1234567890abcdef1234567890abcdef12345678",
+					Branch:  "main",
+					Subject: "fake commit",
+					Author:  "Fake Author",
+				},
+				{
+					ID:  "abcdef1234567890abcdef1234567890abcdef12",
+					Tag: "v1.0.0",
+				},
+			},
+		}},
+		Images: []kargoapi.ImageDiscoveryResult{{
+			RepoURL: "example/image",
+			References: []kargoapi.DiscoveredImageReference{
+				{Tag: "v2.0.0", Digest: "sha256:feed"},
+				{Tag: "v1.0.0", Digest: "sha256:beef"},
+			},
+		}},
+		Charts: []kargoapi.ChartDiscoveryResult{{
+			RepoURL:  "https://charts.example.com",
+			Name:     "chart",
+			Versions: []string{"2.0.0", "1.0.0"},
+		}},
+	}
+	testReq := func() *svcv1alpha1.CreateFreightRequest {
+		return &svcv1alpha1.CreateFreightRequest{
+			Project:   "fake-project",
+			Warehouse: "fake-warehouse",
+			Commits: []*kargoapi.GitCommit{{
+				RepoURL: "https://github.com/example/repo.git",
+				ID:      "1234567",
+			}},
+			Images: []*kargoapi.Image{{
+				RepoURL: "example/image",
+				Tag:     "v1.0.0",
+			}},
+			Charts: []*kargoapi.Chart{{
+				RepoURL: "https://charts.example.com",
+				Name:    "chart",
+				Version: "1.0.0",
+			}},
+			Alias: "fake-alias",
+		}
+	}
+	testServer := func() *server {
+		return &server{
+			validateProjectExistsFn: func(context.Context, string) error {
+				return nil
+			},
+			getWarehouseFn: func(
+				context.Context,
+				client.Client,
+				types.NamespacedName,
+			) (*kargoapi.Warehouse, error) {
+				return testWarehouse.DeepCopy(), nil
+			},
+			discoverArtifactsFn: func(
+				context.Context,
+				*kargoapi.Warehouse,
+			) (*kargoapi.DiscoveredArtifacts, error) {
+				return testDiscovered.DeepCopy(), nil
+			},
+			createFreightFn: func(
+				context.Context,
+				client.Object,
+				...client.CreateOption,
+			) error {
+				return nil
+			},
+		}
+	}
+	requireConnectCode := func(t *testing.T, err error, code connect.Code) {
+		require.Error(t, err)
+		var connErr *connect.Error
+		require.True(t, errors.As(err, &connErr))
+		require.Equal(t, code, connErr.Code())
+	}
+
+	testCases := []struct {
+		name       string
+		req        func() *svcv1alpha1.CreateFreightRequest
+		server     func() *server
+		assertions func(*testing.T, *connect.Response[svcv1alpha1.CreateFreightResponse], error)
+	}{
+		{
+			name: "project not specified",
+			req: func() *svcv1alpha1.CreateFreightRequest {
+				return &svcv1alpha1.CreateFreightRequest{}
+			},
+			server: testServer,
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.CreateFreightResponse], err error) {
+				requireConnectCode(t, err, connect.CodeInvalidArgument)
+			},
+		},
+		{
+			name: "warehouse not specified",
+			req: func() *svcv1alpha1.CreateFreightRequest {
+				return &svcv1alpha1.CreateFreightRequest{Project: "fake-project"}
+			},
+			server: testServer,
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.CreateFreightResponse], err error) {
+				requireConnectCode(t, err, connect.CodeInvalidArgument)
+			},
+		},
+		{
+			name: "no artifacts specified",
+			req: func() *svcv1alpha1.CreateFreightRequest {
+				return &svcv1alpha1.CreateFreightRequest{
+					Project:   "fake-project",
+					Warehouse: "fake-warehouse",
+				}
+			},
+			server: testServer,
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.CreateFreightResponse], err error) {
+				requireConnectCode(t, err, connect.CodeInvalidArgument)
+				require.ErrorContains(t, err, "at least one commit, image, or chart")
+			},
+		},
+		{
+			name: "error validating project",
+			req:  testReq,
+			server: func() *server {
+				s := testServer()
+				s.validateProjectExistsFn = func(context.Context, string) error {
+					return errors.New("something went wrong")
+				}
+				return s
+			},
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.CreateFreightResponse], err error) {
+				require.EqualError(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "error getting Warehouse",
+			req:  testReq,
+			server: func() *server {
+				s := testServer()
+				s.getWarehouseFn = func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Warehouse, error) {
+					return nil, errors.New("something went wrong")
+				}
+				return s
+			},
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.CreateFreightResponse], err error) {
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "Warehouse not found",
+			req:  testReq,
+			server: func() *server {
+				s := testServer()
+				s.getWarehouseFn = func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Warehouse, error) {
+					return nil, nil
+				}
+				return s
+			},
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.CreateFreightResponse], err error) {
+				requireConnectCode(t, err, connect.CodeNotFound)
+			},
+		},
+		{
+			name: "malformed artifacts",
+			req: func() *svcv1alpha1.CreateFreightRequest {
+				req := testReq()
+				req.Commits[0].ID = "123"
+				req.Images[0].Tag = ""
+				req.Charts[0].Version = ""
+				return req
+			},
+			server: testServer,
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.CreateFreightResponse], err error) {
+				requireConnectCode(t, err, connect.CodeInvalidArgument)
+				require.ErrorContains(t, err, "must be at least 7 characters long")
+				require.ErrorContains(t, err, "either a tag or a digest")
+				require.ErrorContains(t, err, "a repoURL and a version")
+			},
+		},
+		{
+			name: "artifact not subscribed to",
+			req: func() *svcv1alpha1.CreateFreightRequest {
+				req := testReq()
+				req.Images = append(req.Images, &kargoapi.Image{
+					RepoURL: "example/other-image",
+					Tag:     "v1.0.0",
+				})
+				return req
+			},
+			server: testServer,
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.CreateFreightResponse], err error) {
+				requireConnectCode(t, err, connect.CodeInvalidArgument)
+				require.ErrorContains(
+					t, err,
+					`does not subscribe to image repository "example/other-image"`,
+				)
+			},
+		},
+		{
+			name: "multiple artifacts for one subscription",
+			req: func() *svcv1alpha1.CreateFreightRequest {
+				req := testReq()
+				req.Images = append(req.Images, &kargoapi.Image{
+					RepoURL: "example/image",
+					Tag:     "v2.0.0",
+				})
+				return req
+			},
+			server: testServer,
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.CreateFreightResponse], err error) {
+				requireConnectCode(t, err, connect.CodeInvalidArgument)
+				require.ErrorContains(t, err, "multiple artifacts specified")
+			},
+		},
+		{
+			name: "subscription not accounted for",
+			req: func() *svcv1alpha1.CreateFreightRequest {
+				req := testReq()
+				req.Charts = nil
+				return req
+			},
+			server: testServer,
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.CreateFreightResponse], err error) {
+				requireConnectCode(t, err, connect.CodeInvalidArgument)
+				require.ErrorContains(
+					t, err,
+					`no artifact specified for Helm chart "https://charts.example.com/chart"`,
+				)
+			},
+		},
+		{
+			name: "error discovering artifacts",
+			req:  testReq,
+			server: func() *server {
+				s := testServer()
+				s.discoverArtifactsFn = func(
+					context.Context,
+					*kargoapi.Warehouse,
+				) (*kargoapi.DiscoveredArtifacts, error) {
+					return nil, errors.New("something went wrong")
+				}
+				return s
+			},
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.CreateFreightResponse], err error) {
+				requireConnectCode(t, err, connect.CodeUnavailable)
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "artifacts not discovered",
+			req: func() *svcv1alpha1.CreateFreightRequest {
+				req := testReq()
+				req.Commits[0].ID = "fedcba9"
+				req.Images[0].Tag = "v3.0.0"
+				req.Charts[0].Version = "3.0.0"
+				return req
+			},
+			server: testServer,
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.CreateFreightResponse], err error) {
+				requireConnectCode(t, err, connect.CodeFailedPrecondition)
+				require.ErrorContains(t, err, `commit "fedcba9" was not found`)
+				require.ErrorContains(t, err, `image "v3.0.0" was not found`)
+				require.ErrorContains(t, err, `version "3.0.0" of Helm chart`)
+			},
+		},
+		{
+			name: "Freight already exists",
+			req:  testReq,
+			server: func() *server {
+				s := testServer()
+				s.createFreightFn = func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return apierrors.NewAlreadyExists(
+						schema.GroupResource{
+							Group:    kargoapi.GroupVersion.Group,
+							Resource: "freights",
+						},
+						"fake-freight",
+					)
+				}
+				return s
+			},
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.CreateFreightResponse], err error) {
+				requireConnectCode(t, err, connect.CodeAlreadyExists)
+			},
+		},
+		{
+			name: "error creating Freight",
+			req:  testReq,
+			server: func() *server {
+				s := testServer()
+				s.createFreightFn = func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return errors.New("something went wrong")
+				}
+				return s
+			},
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.CreateFreightResponse], err error) {
+				require.ErrorContains(t, err, "create freight: something went wrong")
+			},
+		},
+		{
+			name: "success",
+			req:  testReq,
+			server: func() *server {
+				s := testServer()
+				s.createFreightFn = func(
+					_ context.Context,
+					obj client.Object,
+					_ ...client.CreateOption,
+				) error {
+					freight, ok := obj.(*kargoapi.Freight)
+					require.True(t, ok)
+					require.Equal(t, "fake-project", freight.Namespace)
+					return nil
+				}
+				return s
+			},
+			assertions: func(
+				t *testing.T,
+				resp *connect.Response[svcv1alpha1.CreateFreightResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				freight := resp.Msg.GetFreight()
+				require.NotNil(t, freight)
+				require.Equal(t, "fake-project", freight.Namespace)
+				require.Equal(t, api.GenerateFreightID(freight), freight.Name)
+				require.Equal(t, "fake-alias", freight.Alias)
+				require.Equal(t, "fake-alias", freight.Labels[kargoapi.LabelKeyAlias])
+				require.Equal(
+					t,
+					kargoapi.FreightOrigin{
+						Kind: kargoapi.FreightOriginKindWarehouse,
+						Name: "fake-warehouse",
+					},
+					freight.Origin,
+				)
+				require.Equal(
+					t,
+					[]kargoapi.GitCommit{{
+						RepoURL: "https://github.com/example/repo",
+						ID:      "1234567890abcdef1234567890abcdef12345678",
+						Branch:  "main",
+						Message: "fake commit",
+						Author:  "Fake Author",
+					}},
+					freight.Commits,
+				)
+				require.Equal(
+					t,
+					[]kargoapi.Image{{
+						RepoURL: "example/image",
+						Tag:     "v1.0.0",
+						Digest:  "sha256:beef",
+					}},
+					freight.Images,
+				)
+				require.Equal(
+					t,
+					[]kargoapi.Chart{{
+						RepoURL: "https://charts.example.com",
+						Name:    "chart",
+						Version: "1.0.0",
+					}},
+					freight.Charts,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			resp, err := testCase.server().CreateFreight(
+				context.Background(),
+				connect.NewRequest(testCase.req()),
+			)
+			testCase.assertions(t, resp, err)
+		})
+	}
+
+	t.Run("records audit entry", func(t *testing.T) {
+		auditLog := &bytes.Buffer{}
+		s := testServer()
+		s.auditor = audit.NewRecorder(audit.NewWriterSink(auditLog))
+		resp, err := s.CreateFreight(
+			context.Background(),
+			connect.NewRequest(testReq()),
+		)
+		require.NoError(t, err)
+		entry := audit.Entry{}
+		require.NoError(t, json.Unmarshal(auditLog.Bytes(), &entry))
+		require.Equal(t, audit.ActionCreateFreight, entry.Action)
+		require.Equal(t, "fake-project", entry.Project)
+		require.Equal(t, resp.Msg.GetFreight().Name, entry.Object.Name)
+		require.Equal(
+			t,
+			map[string]string{
+				"warehouse": "fake-warehouse",
+				"alias":     "fake-alias",
+			},
+			entry.Details,
+		)
+	})
+}
+
+func Test_resolveRequestedImage(t *testing.T) {
+	results := []kargoapi.ImageDiscoveryResult{{
+		RepoURL: "example/image",
+		References: []kargoapi.DiscoveredImageReference{
+			{Tag: "v2.0.0", Digest: "sha256:feed"},
+			{Tag: "v1.0.0", Digest: "sha256:beef"},
+		},
+	}}
+
+	t.Run("by digest", func(t *testing.T) {
+		image, err := resolveRequestedImage(
+			results,
+			&kargoapi.Image{RepoURL: "example/image", Digest: "sha256:feed"},
+		)
+		require.NoError(t, err)
+		require.Equal(t, "v2.0.0", image.Tag)
+	})
+
+	t.Run("tag and digest mismatch", func(t *testing.T) {
+		_, err := resolveRequestedImage(
+			results,
+			&kargoapi.Image{
+				RepoURL: "example/image",
+				Tag:     "v1.0.0",
+				Digest:  "sha256:feed",
+			},
+		)
+		require.ErrorContains(t, err, "was not found")
+	})
+}
+
+func Test_resolveRequestedCommit(t *testing.T) {
+	results := []kargoapi.GitDiscoveryResult{{
+		RepoURL: "https://github.com/example/repo",
+		Commits: []kargoapi.DiscoveredCommit{
+			{ID: "1234567890abcdef", Tag: "v1.0.0"},
+		},
+	}}
+
+	t.Run("by tag", func(t *testing.T) {
+		commit, err := resolveRequestedCommit(
+			results,
+			&kargoapi.GitCommit{RepoURL: "https://github.com/example/repo", Tag: "v1.0.0"},
+		)
+		require.NoError(t, err)
+		require.Equal(t, "1234567890abcdef", commit.ID)
+	})
+
+	t.Run("unknown tag", func(t *testing.T) {
+		_, err := resolveRequestedCommit(
+			results,
+			&kargoapi.GitCommit{RepoURL: "https://github.com/example/repo", Tag: "v2.0.0"},
+		)
+		require.ErrorContains(t, err, `commit "v2.0.0" was not found`)
+	})
+}
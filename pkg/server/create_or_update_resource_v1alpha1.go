@@ -24,12 +24,22 @@ func (s *server) CreateOrUpdateResource(
 	resources := append(projects, otherResources...)
 
 	createdProjects := map[string]struct{}{}
+	dryRun := req.Msg.GetDryRun()
 
 	results := make([]*svcv1alpha1.CreateOrUpdateResourceResult, 0, len(resources))
 	for _, r := range resources {
 		resource := r // Avoid implicit memory aliasing
 		var cl client.Client = s.client
 		if _, ok := createdProjects[resource.GetNamespace()]; ok {
+			if dryRun {
+				// This resource belongs to a Project that would have been created
+				// previously in this API call, had this not been a dry run. Since the
+				// Project's namespace does not actually exist, the API server would
+				// reject even a dry run creation of this resource. Every resource in a
+				// new Project is necessarily new itself, so we report it as such.
+				results = append(results, dryRunCreatedResult(&resource))
+				continue
+			}
 			// This resource belongs to a Project we created previously in this API
 			// call. The user had sufficient permissions to accomplish that and having
 			// done so makes them automatically the "owner" of the Project and an
@@ -40,7 +50,7 @@ func (s *server) CreateOrUpdateResource(
 			// s.client's internal client for creation of this resource.
 			cl = s.client.InternalClient()
 		}
-		result, err := s.createOrUpdateResource(ctx, cl, &resource)
+		result, err := s.createOrUpdateResource(ctx, cl, &resource, dryRun)
 		if err != nil && len(resources) == 1 {
 			return nil, err
 		}
@@ -63,6 +73,7 @@ func (s *server) createOrUpdateResource(
 	ctx context.Context,
 	cl client.Client,
 	obj *unstructured.Unstructured,
+	dryRun bool,
 ) (*svcv1alpha1.CreateOrUpdateResourceResult, error) {
 	if obj.GroupVersionKind() == secretGVK && !s.cfg.SecretManagementEnabled {
 		return &svcv1alpha1.CreateOrUpdateResourceResult{
@@ -97,7 +108,11 @@ func (s *server) createOrUpdateResource(
 		// TODO(krancour): Do we want to do this for a broader variety of resource
 		// types in the future?
 		annotateProjectWithCreator(ctx, obj)
-		if err := cl.Create(ctx, obj); err != nil {
+		var createOpts []client.CreateOption
+		if dryRun {
+			createOpts = append(createOpts, client.DryRunAll)
+		}
+		if err := cl.Create(ctx, obj, createOpts...); err != nil {
 			return &svcv1alpha1.CreateOrUpdateResourceResult{
 				Result: &svcv1alpha1.CreateOrUpdateResourceResult_Error{
 					Error: fmt.Errorf("create resource: %w", err).Error(),
@@ -122,7 +137,11 @@ func (s *server) createOrUpdateResource(
 	// If we get to here, the resource already exists, so we can update it.
 
 	obj.SetResourceVersion(existingObj.GetResourceVersion())
-	if err := cl.Update(ctx, obj); err != nil {
+	var updateOpts []client.UpdateOption
+	if dryRun {
+		updateOpts = append(updateOpts, client.DryRunAll)
+	}
+	if err := cl.Update(ctx, obj, updateOpts...); err != nil {
 		return &svcv1alpha1.CreateOrUpdateResourceResult{
 			Result: &svcv1alpha1.CreateOrUpdateResourceResult_Error{
 				Error: fmt.Errorf("update resource: %w", err).Error(),
@@ -137,9 +156,41 @@ func (s *server) createOrUpdateResource(
 			},
 		}, err
 	}
-	return &svcv1alpha1.CreateOrUpdateResourceResult{
+	result := &svcv1alpha1.CreateOrUpdateResourceResult{
 		Result: &svcv1alpha1.CreateOrUpdateResourceResult_UpdatedResourceManifest{
 			UpdatedResourceManifest: updatedManifest,
 		},
-	}, nil
+	}
+	if dryRun {
+		// Include the live state of the resource so that clients can compare it
+		// to what the resource would have looked like after the update.
+		if result.LiveResourceManifest, err = sigyaml.Marshal(existingObj); err != nil {
+			return &svcv1alpha1.CreateOrUpdateResourceResult{
+				Result: &svcv1alpha1.CreateOrUpdateResourceResult_Error{
+					Error: fmt.Errorf("marshal live manifest: %w", err).Error(),
+				},
+			}, err
+		}
+	}
+	return result, nil
+}
+
+// dryRunCreatedResult returns a result reporting the provided resource as
+// created without having submitted it to the API server.
+func dryRunCreatedResult(
+	obj *unstructured.Unstructured,
+) *svcv1alpha1.CreateOrUpdateResourceResult {
+	manifest, err := sigyaml.Marshal(obj)
+	if err != nil {
+		return &svcv1alpha1.CreateOrUpdateResourceResult{
+			Result: &svcv1alpha1.CreateOrUpdateResourceResult_Error{
+				Error: fmt.Errorf("marshal created manifest: %w", err).Error(),
+			},
+		}
+	}
+	return &svcv1alpha1.CreateOrUpdateResourceResult{
+		Result: &svcv1alpha1.CreateOrUpdateResourceResult_CreatedResourceManifest{
+			CreatedResourceManifest: manifest,
+		},
+	}
 }
@@ -0,0 +1,207 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	sigyaml "sigs.k8s.io/yaml"
+
+	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/server/kubernetes"
+)
+
+func TestCreateOrUpdateResource(t *testing.T) {
+	const manifest = `apiVersion: kargo.akuity.io/v1alpha1
+kind: Warehouse
+metadata:
+  name: fake-warehouse
+  namespace: kargo-demo
+spec:
+  interval: 10m
+---
+apiVersion: kargo.akuity.io/v1alpha1
+kind: Stage
+metadata:
+  name: fake-stage
+  namespace: kargo-demo
+  labels:
+    foo: bar
+`
+	const newProjectManifest = `apiVersion: kargo.akuity.io/v1alpha1
+kind: Project
+metadata:
+  name: new-project
+---
+apiVersion: kargo.akuity.io/v1alpha1
+kind: Stage
+metadata:
+  name: fake-stage
+  namespace: new-project
+`
+
+	testCases := []struct {
+		name       string
+		req        *svcv1alpha1.CreateOrUpdateResourceRequest
+		assertions func(*testing.T, client.Client, *connect.Response[svcv1alpha1.CreateOrUpdateResourceResponse], error)
+	}{
+		{
+			name: "invalid manifest",
+			req: &svcv1alpha1.CreateOrUpdateResourceRequest{
+				Manifest: []byte("{"),
+			},
+			assertions: func(
+				t *testing.T,
+				_ client.Client,
+				_ *connect.Response[svcv1alpha1.CreateOrUpdateResourceResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "create and update",
+			req: &svcv1alpha1.CreateOrUpdateResourceRequest{
+				Manifest: []byte(manifest),
+			},
+			assertions: func(
+				t *testing.T,
+				c client.Client,
+				resp *connect.Response[svcv1alpha1.CreateOrUpdateResourceResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				results := resp.Msg.GetResults()
+				require.Len(t, results, 2)
+				require.NotNil(t, results[0].GetCreatedResourceManifest())
+				require.NotNil(t, results[1].GetUpdatedResourceManifest())
+				require.Nil(t, results[1].GetLiveResourceManifest())
+
+				require.NoError(t, c.Get(
+					context.Background(),
+					types.NamespacedName{Namespace: "kargo-demo", Name: "fake-warehouse"},
+					&kargoapi.Warehouse{},
+				))
+				stage := &kargoapi.Stage{}
+				require.NoError(t, c.Get(
+					context.Background(),
+					types.NamespacedName{Namespace: "kargo-demo", Name: "fake-stage"},
+					stage,
+				))
+				require.Equal(t, "bar", stage.Labels["foo"])
+			},
+		},
+		{
+			name: "dry run",
+			req: &svcv1alpha1.CreateOrUpdateResourceRequest{
+				Manifest: []byte(manifest),
+				DryRun:   true,
+			},
+			assertions: func(
+				t *testing.T,
+				c client.Client,
+				resp *connect.Response[svcv1alpha1.CreateOrUpdateResourceResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				results := resp.Msg.GetResults()
+				require.Len(t, results, 2)
+				require.NotNil(t, results[0].GetCreatedResourceManifest())
+				require.Nil(t, results[0].GetLiveResourceManifest())
+
+				updated := &kargoapi.Stage{}
+				require.NoError(t, sigyaml.Unmarshal(results[1].GetUpdatedResourceManifest(), updated))
+				require.Equal(t, "bar", updated.Labels["foo"])
+				live := &kargoapi.Stage{}
+				require.NoError(t, sigyaml.Unmarshal(results[1].GetLiveResourceManifest(), live))
+				require.Empty(t, live.Labels)
+
+				// Nothing should have been persisted
+				err = c.Get(
+					context.Background(),
+					types.NamespacedName{Namespace: "kargo-demo", Name: "fake-warehouse"},
+					&kargoapi.Warehouse{},
+				)
+				require.True(t, apierrors.IsNotFound(err))
+				stage := &kargoapi.Stage{}
+				require.NoError(t, c.Get(
+					context.Background(),
+					types.NamespacedName{Namespace: "kargo-demo", Name: "fake-stage"},
+					stage,
+				))
+				require.Empty(t, stage.Labels)
+			},
+		},
+		{
+			name: "dry run with new project",
+			req: &svcv1alpha1.CreateOrUpdateResourceRequest{
+				Manifest: []byte(newProjectManifest),
+				DryRun:   true,
+			},
+			assertions: func(
+				t *testing.T,
+				c client.Client,
+				resp *connect.Response[svcv1alpha1.CreateOrUpdateResourceResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				results := resp.Msg.GetResults()
+				require.Len(t, results, 2)
+				require.NotNil(t, results[0].GetCreatedResourceManifest())
+				require.NotNil(t, results[1].GetCreatedResourceManifest())
+
+				err = c.Get(
+					context.Background(),
+					types.NamespacedName{Name: "new-project"},
+					&kargoapi.Project{},
+				)
+				require.True(t, apierrors.IsNotFound(err))
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			internalClient := fake.NewClientBuilder().
+				WithScheme(mustNewScheme()).
+				WithObjects(
+					mustNewObject[corev1.Namespace]("testdata/namespace.yaml"),
+					&kargoapi.Stage{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "kargo-demo",
+							Name:      "fake-stage",
+						},
+					},
+				).
+				Build()
+
+			cl, err := kubernetes.NewClient(
+				ctx,
+				&rest.Config{},
+				kubernetes.ClientOptions{
+					SkipAuthorization: true,
+					NewInternalClient: func(context.Context, *rest.Config, *runtime.Scheme) (client.Client, error) {
+						return internalClient, nil
+					},
+				},
+			)
+			require.NoError(t, err)
+
+			s := &server{client: cl}
+			resp, err := s.CreateOrUpdateResource(ctx, connect.NewRequest(testCase.req))
+			testCase.assertions(t, internalClient, resp, err)
+		})
+	}
+}
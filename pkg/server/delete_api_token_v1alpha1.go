@@ -0,0 +1,47 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+
+	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/audit"
+)
+
+func (s *server) DeleteAPIToken(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.DeleteAPITokenRequest],
+) (*connect.Response[svcv1alpha1.DeleteAPITokenResponse], error) {
+	project := req.Msg.GetProject()
+	if err := validateFieldNotEmpty("project", project); err != nil {
+		return nil, err
+	}
+
+	name := req.Msg.GetName()
+	if err := validateFieldNotEmpty("name", name); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateProjectExistsFn(ctx, project); err != nil {
+		return nil, err
+	}
+
+	if err := s.tokensDB.Delete(ctx, project, name); err != nil {
+		return nil, fmt.Errorf(
+			"error deleting API token %q in project %q: %w", name, project, err,
+		)
+	}
+
+	s.auditor.Record(ctx, audit.Entry{
+		Action:  audit.ActionDeleteAPIToken,
+		Project: project,
+		Object: audit.ObjectReference{
+			Kind: "APIToken",
+			Name: name,
+		},
+	})
+
+	return connect.NewResponse(&svcv1alpha1.DeleteAPITokenResponse{}), nil
+}
@@ -0,0 +1,40 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+
+	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+)
+
+func (s *server) GetAPIToken(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.GetAPITokenRequest],
+) (*connect.Response[svcv1alpha1.GetAPITokenResponse], error) {
+	project := req.Msg.GetProject()
+	if err := validateFieldNotEmpty("project", project); err != nil {
+		return nil, err
+	}
+
+	name := req.Msg.GetName()
+	if err := validateFieldNotEmpty("name", name); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateProjectExistsFn(ctx, project); err != nil {
+		return nil, err
+	}
+
+	token, err := s.tokensDB.Get(ctx, project, name)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error getting API token %q in project %q: %w", name, project, err,
+		)
+	}
+
+	return connect.NewResponse(&svcv1alpha1.GetAPITokenResponse{
+		Token: token,
+	}), nil
+}
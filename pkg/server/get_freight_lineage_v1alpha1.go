@@ -0,0 +1,264 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/api"
+	"github.com/akuity/kargo/pkg/provenance"
+)
+
+func (s *server) GetFreightLineage(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.GetFreightLineageRequest],
+) (*connect.Response[svcv1alpha1.GetFreightLineageResponse], error) {
+	project := req.Msg.GetProject()
+	if err := validateFieldNotEmpty("project", project); err != nil {
+		return nil, err
+	}
+
+	name := req.Msg.GetName()
+	alias := req.Msg.GetAlias()
+	if (name == "" && alias == "") || (name != "" && alias != "") {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("exactly one of name or alias should not be empty"),
+		)
+	}
+
+	if err := s.validateProjectExistsFn(ctx, project); err != nil {
+		return nil, err
+	}
+
+	freight, err := s.getFreightByNameOrAliasFn(ctx, s.client, project, name, alias)
+	if err != nil {
+		return nil, fmt.Errorf("get freight: %w", err)
+	}
+	if freight == nil {
+		if name != "" {
+			err = fmt.Errorf("freight %q not found in namespace %q", name, project)
+		} else {
+			err = fmt.Errorf("freight with alias %q not found in namespace %q", alias, project)
+		}
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+
+	lineage := &svcv1alpha1.FreightLineage{
+		Freight: freight,
+	}
+
+	// Join the artifacts referenced by the Freight with the details the
+	// Warehouse discovered about them. The Warehouse only retains the details
+	// of its most recent discovery, so these may no longer be available.
+	var discovered *kargoapi.DiscoveredArtifacts
+	if freight.Origin.Kind == kargoapi.FreightOriginKindWarehouse {
+		warehouse, err := api.GetWarehouse(
+			ctx,
+			s.client,
+			client.ObjectKey{Namespace: project, Name: freight.Origin.Name},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("get warehouse: %w", err)
+		}
+		if warehouse != nil {
+			discovered = warehouse.Status.DiscoveredArtifacts
+		}
+	}
+	for i := range freight.Commits {
+		lineage.Commits = append(lineage.Commits, &svcv1alpha1.FreightCommitLineage{
+			Commit:     &freight.Commits[i],
+			Discovered: findDiscoveredCommit(discovered, freight.Commits[i]),
+		})
+	}
+	for i := range freight.Images {
+		lineage.Images = append(lineage.Images, &svcv1alpha1.FreightImageLineage{
+			Image:      &freight.Images[i],
+			Discovered: findDiscoveredImage(discovered, freight.Images[i]),
+		})
+	}
+
+	promoList := &kargoapi.PromotionList{}
+	if err = s.client.List(ctx, promoList, client.InNamespace(project)); err != nil {
+		return nil, fmt.Errorf("list promotions: %w", err)
+	}
+	promosByStage := map[string][]*kargoapi.Promotion{}
+	for i := range promoList.Items {
+		promo := &promoList.Items[i]
+		if promo.Spec.Freight != freight.Name {
+			continue
+		}
+		promosByStage[promo.Spec.Stage] = append(promosByStage[promo.Spec.Stage], promo)
+	}
+	for _, promos := range promosByStage {
+		slices.SortFunc(promos, func(lhs, rhs *kargoapi.Promotion) int {
+			if c := lhs.CreationTimestamp.Compare(rhs.CreationTimestamp.Time); c != 0 {
+				return c
+			}
+			return strings.Compare(lhs.Name, rhs.Name)
+		})
+	}
+
+	stageNames := make([]string, 0, len(promosByStage))
+	for stageName := range promosByStage {
+		stageNames = append(stageNames, stageName)
+	}
+	addStage := func(stageName string) {
+		if !slices.Contains(stageNames, stageName) {
+			stageNames = append(stageNames, stageName)
+		}
+	}
+	for stageName := range freight.Status.ApprovedFor {
+		addStage(stageName)
+	}
+	for stageName := range freight.Status.VerifiedIn {
+		addStage(stageName)
+	}
+	for stageName := range freight.Status.CurrentlyIn {
+		addStage(stageName)
+	}
+	// Order the Stages by when the Freight was first promoted to them, which
+	// approximates the order in which the Freight progressed through the
+	// pipeline. Stages the Freight was never promoted to come last.
+	slices.SortFunc(stageNames, func(lhs, rhs string) int {
+		lhsPromos, rhsPromos := promosByStage[lhs], promosByStage[rhs]
+		switch {
+		case len(lhsPromos) > 0 && len(rhsPromos) > 0:
+			if c := lhsPromos[0].CreationTimestamp.Compare(rhsPromos[0].CreationTimestamp.Time); c != 0 {
+				return c
+			}
+		case len(lhsPromos) > 0:
+			return -1
+		case len(rhsPromos) > 0:
+			return 1
+		}
+		return strings.Compare(lhs, rhs)
+	})
+
+	for _, stageName := range stageNames {
+		stageLineage := &svcv1alpha1.FreightStageLineage{
+			Stage: stageName,
+		}
+		if approved, ok := freight.Status.ApprovedFor[stageName]; ok {
+			stageLineage.Approved = &approved
+		}
+		if verified, ok := freight.Status.VerifiedIn[stageName]; ok {
+			stageLineage.Verified = &verified
+		}
+		if current, ok := freight.Status.CurrentlyIn[stageName]; ok {
+			stageLineage.Current = &current
+		}
+
+		promos := promosByStage[stageName]
+		var stage *kargoapi.Stage
+		if len(promos) > 0 {
+			if stage, err = s.getStageFn(
+				ctx,
+				s.client,
+				client.ObjectKey{Namespace: project, Name: stageName},
+			); err != nil {
+				return nil, fmt.Errorf("get stage: %w", err)
+			}
+		}
+		for _, promo := range promos {
+			deployment := &svcv1alpha1.FreightDeployment{
+				Promotion:     promo,
+				Verifications: findVerifications(stage, promo),
+			}
+			if promo.Status.Phase == kargoapi.PromotionPhaseSucceeded {
+				verifications := make([]kargoapi.VerificationInfo, 0, len(deployment.Verifications))
+				for _, v := range deployment.Verifications {
+					verifications = append(verifications, *v)
+				}
+				if deployment.Provenance, err = provenance.Marshal(freight, promo, verifications); err != nil {
+					return nil, fmt.Errorf("marshal provenance of promotion %q: %w", promo.Name, err)
+				}
+			}
+			stageLineage.Deployments = append(stageLineage.Deployments, deployment)
+		}
+
+		lineage.Stages = append(lineage.Stages, stageLineage)
+	}
+
+	return connect.NewResponse(&svcv1alpha1.GetFreightLineageResponse{
+		Lineage: lineage,
+	}), nil
+}
+
+// findDiscoveredCommit returns the details discovered about the provided
+// commit, or nil if they are not available.
+func findDiscoveredCommit(
+	discovered *kargoapi.DiscoveredArtifacts,
+	commit kargoapi.GitCommit,
+) *kargoapi.DiscoveredCommit {
+	if discovered == nil {
+		return nil
+	}
+	for _, result := range discovered.Git {
+		if result.RepoURL != commit.RepoURL {
+			continue
+		}
+		for i := range result.Commits {
+			if result.Commits[i].ID == commit.ID {
+				return &result.Commits[i]
+			}
+		}
+	}
+	return nil
+}
+
+// findDiscoveredImage returns the details discovered about the provided
+// image, or nil if they are not available. The image is matched by digest if
+// it has one, and by tag otherwise.
+func findDiscoveredImage(
+	discovered *kargoapi.DiscoveredArtifacts,
+	image kargoapi.Image,
+) *kargoapi.DiscoveredImageReference {
+	if discovered == nil {
+		return nil
+	}
+	for _, result := range discovered.Images {
+		if result.RepoURL != image.RepoURL {
+			continue
+		}
+		for i := range result.References {
+			ref := &result.References[i]
+			if (image.Digest != "" && ref.Digest == image.Digest) ||
+				(image.Digest == "" && ref.Tag == image.Tag) {
+				return ref
+			}
+		}
+	}
+	return nil
+}
+
+// findVerifications returns the verifications of the freight collection that
+// resulted from the provided Promotion, as recorded in the Stage's freight
+// history. Nil is returned if the Stage is nil or the collection is no longer
+// part of its history.
+func findVerifications(
+	stage *kargoapi.Stage,
+	promo *kargoapi.Promotion,
+) []*kargoapi.VerificationInfo {
+	if stage == nil || promo.Status.FreightCollection == nil {
+		return nil
+	}
+	for _, collection := range stage.Status.FreightHistory {
+		if collection == nil || collection.ID != promo.Status.FreightCollection.ID {
+			continue
+		}
+		verifications := make([]*kargoapi.VerificationInfo, 0, len(collection.VerificationHistory))
+		for i := range collection.VerificationHistory {
+			verifications = append(verifications, &collection.VerificationHistory[i])
+		}
+		return verifications
+	}
+	return nil
+}
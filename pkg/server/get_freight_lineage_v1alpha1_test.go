@@ -0,0 +1,334 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/api"
+	"github.com/akuity/kargo/pkg/provenance"
+	"github.com/akuity/kargo/pkg/server/kubernetes"
+	"github.com/akuity/kargo/pkg/server/validation"
+)
+
+func TestGetFreightLineage(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+
+	freight := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "kargo-demo",
+			Name:      "fake-freight",
+			Labels: map[string]string{
+				kargoapi.LabelKeyAlias: "fake-alias",
+			},
+		},
+		Alias: "fake-alias",
+		Origin: kargoapi.FreightOrigin{
+			Kind: kargoapi.FreightOriginKindWarehouse,
+			Name: "fake-warehouse",
+		},
+		Commits: []kargoapi.GitCommit{{
+			RepoURL: "https://github.com/example/repo",
+			ID:      "abc123",
+		}},
+		Images: []kargoapi.Image{
+			{
+				RepoURL: "example/image",
+				Tag:     "v1.0.0",
+				Digest:  "sha256:def456",
+			},
+			{
+				RepoURL: "example/forgotten",
+				Tag:     "v1.0.0",
+			},
+		},
+		Status: kargoapi.FreightStatus{
+			VerifiedIn: map[string]kargoapi.VerifiedStage{
+				"test": {},
+			},
+			ApprovedFor: map[string]kargoapi.ApprovedStage{
+				"prod": {},
+			},
+			CurrentlyIn: map[string]kargoapi.CurrentStage{
+				"test": {},
+			},
+		},
+	}
+
+	newPromotion := func(
+		name string,
+		stage string,
+		created time.Time,
+		phase kargoapi.PromotionPhase,
+	) *kargoapi.Promotion {
+		return &kargoapi.Promotion{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "kargo-demo",
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(created),
+			},
+			Spec: kargoapi.PromotionSpec{
+				Stage:   stage,
+				Freight: "fake-freight",
+				Steps: []kargoapi.PromotionStep{{
+					Uses: "fake-step",
+				}},
+			},
+			Status: kargoapi.PromotionStatus{
+				Phase: phase,
+				FreightCollection: &kargoapi.FreightCollection{
+					ID: name + "-collection",
+				},
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		req        *svcv1alpha1.GetFreightLineageRequest
+		objects    []client.Object
+		assertions func(*testing.T, *connect.Response[svcv1alpha1.GetFreightLineageResponse], error)
+	}{
+		"empty project": {
+			req: &svcv1alpha1.GetFreightLineageRequest{
+				Name: "fake-freight",
+			},
+			assertions: func(t *testing.T, res *connect.Response[svcv1alpha1.GetFreightLineageResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+				require.Nil(t, res)
+			},
+		},
+		"non-empty name and alias": {
+			req: &svcv1alpha1.GetFreightLineageRequest{
+				Project: "kargo-demo",
+				Name:    "fake-freight",
+				Alias:   "fake-alias",
+			},
+			assertions: func(t *testing.T, res *connect.Response[svcv1alpha1.GetFreightLineageResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+				require.Nil(t, res)
+			},
+		},
+		"non-existing project": {
+			req: &svcv1alpha1.GetFreightLineageRequest{
+				Project: "kargo-x",
+				Name:    "fake-freight",
+			},
+			assertions: func(t *testing.T, res *connect.Response[svcv1alpha1.GetFreightLineageResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+				require.Nil(t, res)
+			},
+		},
+		"non-existing Freight": {
+			req: &svcv1alpha1.GetFreightLineageRequest{
+				Project: "kargo-demo",
+				Name:    "non-existing",
+			},
+			objects: []client.Object{
+				mustNewObject[corev1.Namespace]("testdata/namespace.yaml"),
+			},
+			assertions: func(t *testing.T, res *connect.Response[svcv1alpha1.GetFreightLineageResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+				require.Nil(t, res)
+			},
+		},
+		"Freight without history": {
+			req: &svcv1alpha1.GetFreightLineageRequest{
+				Project: "kargo-demo",
+				Name:    "fake-freight",
+			},
+			objects: []client.Object{
+				mustNewObject[corev1.Namespace]("testdata/namespace.yaml"),
+				&kargoapi.Freight{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "kargo-demo",
+						Name:      "fake-freight",
+					},
+					Origin: kargoapi.FreightOrigin{
+						Kind: kargoapi.FreightOriginKindWarehouse,
+						Name: "deleted-warehouse",
+					},
+				},
+			},
+			assertions: func(t *testing.T, res *connect.Response[svcv1alpha1.GetFreightLineageResponse], err error) {
+				require.NoError(t, err)
+				lineage := res.Msg.GetLineage()
+				require.Equal(t, "fake-freight", lineage.GetFreight().Name)
+				require.Empty(t, lineage.GetCommits())
+				require.Empty(t, lineage.GetImages())
+				require.Empty(t, lineage.GetStages())
+			},
+		},
+		"Freight with history by alias": {
+			req: &svcv1alpha1.GetFreightLineageRequest{
+				Project: "kargo-demo",
+				Alias:   "fake-alias",
+			},
+			objects: []client.Object{
+				mustNewObject[corev1.Namespace]("testdata/namespace.yaml"),
+				freight,
+				&kargoapi.Warehouse{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "kargo-demo",
+						Name:      "fake-warehouse",
+					},
+					Status: kargoapi.WarehouseStatus{
+						DiscoveredArtifacts: &kargoapi.DiscoveredArtifacts{
+							Git: []kargoapi.GitDiscoveryResult{{
+								RepoURL: "https://github.com/example/repo",
+								Commits: []kargoapi.DiscoveredCommit{
+									{ID: "other"},
+									{ID: "abc123", Subject: "Fix everything"},
+								},
+							}},
+							Images: []kargoapi.ImageDiscoveryResult{{
+								RepoURL: "example/image",
+								References: []kargoapi.DiscoveredImageReference{{
+									Tag:    "v1.0.0",
+									Digest: "sha256:def456",
+								}},
+							}},
+						},
+					},
+				},
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "kargo-demo",
+						Name:      "test",
+					},
+					Status: kargoapi.StageStatus{
+						FreightHistory: kargoapi.FreightHistory{
+							{
+								ID: "promo-2-collection",
+								VerificationHistory: []kargoapi.VerificationInfo{{
+									ID:    "fake-verification",
+									Phase: kargoapi.VerificationPhaseSuccessful,
+								}},
+							},
+						},
+					},
+				},
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "kargo-demo",
+						Name:      "uat",
+					},
+				},
+				newPromotion("promo-2", "test", now.Add(-time.Hour), kargoapi.PromotionPhaseSucceeded),
+				newPromotion("promo-1", "test", now.Add(-2*time.Hour), kargoapi.PromotionPhaseFailed),
+				newPromotion("promo-3", "uat", now, kargoapi.PromotionPhaseRunning),
+				&kargoapi.Promotion{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "kargo-demo",
+						Name:      "other-freight",
+					},
+					Spec: kargoapi.PromotionSpec{
+						Stage:   "test",
+						Freight: "other-freight",
+					},
+				},
+			},
+			assertions: func(t *testing.T, res *connect.Response[svcv1alpha1.GetFreightLineageResponse], err error) {
+				require.NoError(t, err)
+				lineage := res.Msg.GetLineage()
+				require.Equal(t, "fake-freight", lineage.GetFreight().Name)
+
+				commits := lineage.GetCommits()
+				require.Len(t, commits, 1)
+				require.Equal(t, "abc123", commits[0].GetCommit().ID)
+				require.NotNil(t, commits[0].GetDiscovered())
+				require.Equal(t, "Fix everything", commits[0].GetDiscovered().Subject)
+
+				images := lineage.GetImages()
+				require.Len(t, images, 2)
+				require.NotNil(t, images[0].GetDiscovered())
+				require.Equal(t, "sha256:def456", images[0].GetDiscovered().Digest)
+				require.Nil(t, images[1].GetDiscovered())
+
+				stages := lineage.GetStages()
+				require.Len(t, stages, 3)
+
+				require.Equal(t, "test", stages[0].GetStage())
+				require.NotNil(t, stages[0].GetVerified())
+				require.NotNil(t, stages[0].GetCurrent())
+				require.Nil(t, stages[0].GetApproved())
+				deployments := stages[0].GetDeployments()
+				require.Len(t, deployments, 2)
+				require.Equal(t, "promo-1", deployments[0].GetPromotion().Name)
+				require.Empty(t, deployments[0].GetVerifications())
+				require.Empty(t, deployments[0].GetProvenance())
+				require.Equal(t, "promo-2", deployments[1].GetPromotion().Name)
+				require.Len(t, deployments[1].GetVerifications(), 1)
+				require.Equal(t, "fake-verification", deployments[1].GetVerifications()[0].ID)
+				statement := provenance.Statement{}
+				require.NoError(t, json.Unmarshal(deployments[1].GetProvenance(), &statement))
+				require.Equal(t, provenance.StatementType, statement.Type)
+				require.Equal(t, "test", statement.Predicate.BuildDefinition.ExternalParameters.Stage)
+				require.Equal(t, "promo-2", statement.Predicate.BuildDefinition.InternalParameters.Promotion)
+				require.Len(t, statement.Subject, 3)
+				require.Len(t, statement.Predicate.RunDetails.Byproducts, 1)
+
+				require.Equal(t, "uat", stages[1].GetStage())
+				require.Len(t, stages[1].GetDeployments(), 1)
+				require.Equal(t, "promo-3", stages[1].GetDeployments()[0].GetPromotion().Name)
+				require.Empty(t, stages[1].GetDeployments()[0].GetProvenance())
+
+				require.Equal(t, "prod", stages[2].GetStage())
+				require.NotNil(t, stages[2].GetApproved())
+				require.Empty(t, stages[2].GetDeployments())
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			client, err := kubernetes.NewClient(
+				ctx,
+				&rest.Config{},
+				kubernetes.ClientOptions{
+					SkipAuthorization: true,
+					NewInternalClient: func(
+						_ context.Context,
+						_ *rest.Config,
+						scheme *runtime.Scheme,
+					) (client.Client, error) {
+						c := fake.NewClientBuilder().WithScheme(scheme)
+						if len(testCase.objects) > 0 {
+							c.WithObjects(testCase.objects...)
+						}
+						return c.Build(), nil
+					},
+				},
+			)
+			require.NoError(t, err)
+
+			svr := &server{
+				client:                    client,
+				externalValidateProjectFn: validation.ValidateProject,
+				getFreightByNameOrAliasFn: api.GetFreightByNameOrAlias,
+				getStageFn:                api.GetStage,
+			}
+			svr.validateProjectExistsFn = svr.validateProjectExists
+			res, err := svr.GetFreightLineage(ctx, connect.NewRequest(testCase.req))
+			testCase.assertions(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/logging"
+)
+
+// promotionLogsPollInterval is the interval at which stored logs are checked
+// for new output when following the logs of a Promotion.
+var promotionLogsPollInterval = 2 * time.Second
+
+func (s *server) GetPromotionLogs(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.GetPromotionLogsRequest],
+	stream *connect.ServerStream[svcv1alpha1.GetPromotionLogsResponse],
+) error {
+	project := req.Msg.GetProject()
+	if err := validateFieldNotEmpty("project", project); err != nil {
+		return err
+	}
+
+	name := req.Msg.GetName()
+	if err := validateFieldNotEmpty("name", name); err != nil {
+		return err
+	}
+
+	if err := s.validateProjectExistsFn(ctx, project); err != nil {
+		return err
+	}
+
+	step := req.Msg.GetStep()
+	follow := req.Msg.GetFollow()
+
+	// Tracks the logs already sent for each step, so that only new output is
+	// sent when following.
+	sent := map[int64]string{}
+
+	for {
+		// The Promotion is retrieved using the user's own permissions, which
+		// effectively authorizes access to its logs. It must also be retrieved
+		// BEFORE the logs, since logs are always stored prior to the Promotion's
+		// status being updated. Having observed a terminal phase, we can be
+		// certain the logs we retrieve afterward are complete.
+		promo := &kargoapi.Promotion{}
+		if err := s.client.Get(
+			ctx, client.ObjectKey{Namespace: project, Name: name}, promo,
+		); err != nil {
+			if client.IgnoreNotFound(err) == nil {
+				// nolint:staticcheck
+				err = fmt.Errorf("Promotion %q not found in project %q", name, project)
+				return connect.NewError(connect.CodeNotFound, err)
+			}
+			return err
+		}
+		done := !follow || promo.Status.Phase.IsTerminal()
+
+		if step != "" && done && !slices.ContainsFunc(
+			promo.Status.StepExecutionMetadata,
+			func(m kargoapi.StepExecutionMetadata) bool { return m.Alias == step },
+		) {
+			// nolint:staticcheck
+			return connect.NewError(
+				connect.CodeNotFound,
+				fmt.Errorf(
+					"Promotion %q in project %q has not executed a step with alias %q",
+					name, project, step,
+				),
+			)
+		}
+
+		logs, err := s.getPromotionLogsFn(ctx, project, name)
+		if err != nil {
+			return fmt.Errorf("error getting logs: %w", err)
+		}
+
+		stepIndices := make([]int64, 0, len(logs))
+		for stepIndex := range logs {
+			stepIndices = append(stepIndices, stepIndex)
+		}
+		slices.Sort(stepIndices)
+
+		for _, stepIndex := range stepIndices {
+			alias := promotionStepAlias(promo, stepIndex)
+			if step != "" && alias != step {
+				continue
+			}
+			current := string(logs[stepIndex])
+			chunk := current
+			if previous := sent[stepIndex]; strings.HasPrefix(current, previous) {
+				chunk = current[len(previous):]
+			}
+			// If the stored logs were truncated since they were last sent, we
+			// cannot reliably determine what is new, so the whole of what is
+			// stored is sent again.
+			sent[stepIndex] = current
+			if chunk == "" {
+				continue
+			}
+			if err = stream.Send(&svcv1alpha1.GetPromotionLogsResponse{
+				Step:  alias,
+				Chunk: chunk,
+			}); err != nil {
+				return fmt.Errorf("error sending log chunk: %w", err)
+			}
+		}
+
+		if done {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			logger := logging.LoggerFromContext(ctx)
+			logger.Debug(ctx.Err().Error())
+			return nil
+		case <-time.After(promotionLogsPollInterval):
+		}
+	}
+}
+
+// promotionStepAlias returns the alias of the step at the provided index of
+// the provided Promotion's (expanded) steps.
+func promotionStepAlias(promo *kargoapi.Promotion, stepIndex int64) string {
+	if stepIndex >= 0 && stepIndex < int64(len(promo.Status.StepExecutionMetadata)) {
+		return promo.Status.StepExecutionMetadata[stepIndex].Alias
+	}
+	return fmt.Sprintf("step-%d", stepIndex)
+}
@@ -0,0 +1,235 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	"github.com/akuity/kargo/api/service/v1alpha1/svcv1alpha1connect"
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/server/kubernetes"
+)
+
+func TestGetPromotionLogs(t *testing.T) {
+	const (
+		testProject   = "fake-project"
+		testPromotion = "fake-promotion"
+	)
+
+	defaultPollInterval := promotionLogsPollInterval
+	promotionLogsPollInterval = 10 * time.Millisecond
+	t.Cleanup(func() { promotionLogsPollInterval = defaultPollInterval })
+
+	newPromotion := func(phase kargoapi.PromotionPhase) *kargoapi.Promotion {
+		return &kargoapi.Promotion{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testProject,
+				Name:      testPromotion,
+			},
+			Status: kargoapi.PromotionStatus{
+				Phase: phase,
+				StepExecutionMetadata: kargoapi.StepExecutionMetadataList{
+					{Alias: "clone"},
+					{Alias: "render"},
+				},
+			},
+		}
+	}
+
+	type chunk struct {
+		step string
+		data string
+	}
+
+	testCases := []struct {
+		name          string
+		req           *svcv1alpha1.GetPromotionLogsRequest
+		objects       []client.Object
+		getLogsFn     func(client.Client) func(context.Context, string, string) (map[int64][]byte, error)
+		expectedCode  connect.Code
+		expectedLogs  []chunk
+		expectedError bool
+	}{
+		{
+			name: "missing name",
+			req: &svcv1alpha1.GetPromotionLogsRequest{
+				Project: testProject,
+			},
+			expectedError: true,
+			expectedCode:  connect.CodeInvalidArgument,
+		},
+		{
+			name: "Promotion not found",
+			req: &svcv1alpha1.GetPromotionLogsRequest{
+				Project: testProject,
+				Name:    testPromotion,
+			},
+			expectedError: true,
+			expectedCode:  connect.CodeNotFound,
+		},
+		{
+			name: "unknown step",
+			req: &svcv1alpha1.GetPromotionLogsRequest{
+				Project: testProject,
+				Name:    testPromotion,
+				Step:    "deploy",
+			},
+			objects:       []client.Object{newPromotion(kargoapi.PromotionPhaseSucceeded)},
+			expectedError: true,
+			expectedCode:  connect.CodeNotFound,
+		},
+		{
+			name: "all steps",
+			req: &svcv1alpha1.GetPromotionLogsRequest{
+				Project: testProject,
+				Name:    testPromotion,
+			},
+			objects: []client.Object{newPromotion(kargoapi.PromotionPhaseSucceeded)},
+			getLogsFn: func(client.Client) func(context.Context, string, string) (map[int64][]byte, error) {
+				return func(context.Context, string, string) (map[int64][]byte, error) {
+					return map[int64][]byte{
+						1: []byte("rendering\n"),
+						0: []byte("cloning\n"),
+					}, nil
+				}
+			},
+			expectedLogs: []chunk{
+				{step: "clone", data: "cloning\n"},
+				{step: "render", data: "rendering\n"},
+			},
+		},
+		{
+			name: "single step",
+			req: &svcv1alpha1.GetPromotionLogsRequest{
+				Project: testProject,
+				Name:    testPromotion,
+				Step:    "render",
+			},
+			objects: []client.Object{newPromotion(kargoapi.PromotionPhaseFailed)},
+			getLogsFn: func(client.Client) func(context.Context, string, string) (map[int64][]byte, error) {
+				return func(context.Context, string, string) (map[int64][]byte, error) {
+					return map[int64][]byte{
+						0: []byte("cloning\n"),
+						1: []byte("rendering\n"),
+					}, nil
+				}
+			},
+			expectedLogs: []chunk{
+				{step: "render", data: "rendering\n"},
+			},
+		},
+		{
+			name: "follow until terminal",
+			req: &svcv1alpha1.GetPromotionLogsRequest{
+				Project: testProject,
+				Name:    testPromotion,
+				Follow:  true,
+			},
+			objects: []client.Object{newPromotion(kargoapi.PromotionPhaseRunning)},
+			getLogsFn: func(c client.Client) func(context.Context, string, string) (map[int64][]byte, error) {
+				var calls int
+				return func(ctx context.Context, _ string, _ string) (map[int64][]byte, error) {
+					calls++
+					switch calls {
+					case 1:
+						return map[int64][]byte{0: []byte("cloning\n")}, nil
+					case 2:
+						return map[int64][]byte{
+							0: []byte("cloning\ncloned\n"),
+							1: []byte("rendering\n"),
+						}, nil
+					default:
+						promo := newPromotion(kargoapi.PromotionPhaseRunning)
+						if err := c.Get(ctx, client.ObjectKeyFromObject(promo), promo); err != nil {
+							return nil, err
+						}
+						promo.Status.Phase = kargoapi.PromotionPhaseSucceeded
+						if err := c.Status().Update(ctx, promo); err != nil {
+							return nil, err
+						}
+						return map[int64][]byte{
+							0: []byte("cloning\ncloned\n"),
+							1: []byte("rendering\nrendered\n"),
+						}, nil
+					}
+				}
+			},
+			expectedLogs: []chunk{
+				{step: "clone", data: "cloning\n"},
+				{step: "clone", data: "cloned\n"},
+				{step: "render", data: "rendering\n"},
+				{step: "render", data: "rendered\n"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			var internalClient client.Client
+			kubeClient, err := kubernetes.NewClient(
+				ctx,
+				&rest.Config{},
+				kubernetes.ClientOptions{
+					SkipAuthorization: true,
+					NewInternalClient: func(
+						_ context.Context,
+						_ *rest.Config,
+						scheme *runtime.Scheme,
+					) (client.Client, error) {
+						internalClient = fake.NewClientBuilder().
+							WithScheme(scheme).
+							WithObjects(testCase.objects...).
+							WithStatusSubresource(&kargoapi.Promotion{}).
+							Build()
+						return internalClient, nil
+					},
+				},
+			)
+			require.NoError(t, err)
+
+			svr := &server{
+				client: kubeClient,
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+			}
+			if testCase.getLogsFn != nil {
+				svr.getPromotionLogsFn = testCase.getLogsFn(internalClient)
+			}
+
+			mux := http.NewServeMux()
+			mux.Handle(svcv1alpha1connect.NewKargoServiceHandler(svr))
+			srv := httptest.NewServer(mux)
+			t.Cleanup(srv.Close)
+
+			stream, err := svcv1alpha1connect.NewKargoServiceClient(srv.Client(), srv.URL).
+				GetPromotionLogs(ctx, connect.NewRequest(testCase.req))
+			require.NoError(t, err)
+
+			var logs []chunk
+			for stream.Receive() {
+				logs = append(logs, chunk{step: stream.Msg().Step, data: stream.Msg().Chunk})
+			}
+			if testCase.expectedError {
+				require.Error(t, stream.Err())
+				require.Equal(t, testCase.expectedCode, connect.CodeOf(stream.Err()))
+				return
+			}
+			require.NoError(t, stream.Err())
+			require.Equal(t, testCase.expectedLogs, logs)
+		})
+	}
+}
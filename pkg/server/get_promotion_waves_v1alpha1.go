@@ -0,0 +1,135 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/api"
+)
+
+func (s *server) GetPromotionWaves(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.GetPromotionWavesRequest],
+) (*connect.Response[svcv1alpha1.GetPromotionWavesResponse], error) {
+	project := req.Msg.GetProject()
+	if err := validateFieldNotEmpty("project", project); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateProjectExistsFn(ctx, project); err != nil {
+		return nil, err
+	}
+
+	projectCfg, err := api.GetProjectConfig(ctx, s.client, project)
+	if err != nil {
+		return nil, fmt.Errorf("get project config: %w", err)
+	}
+	var policies []kargoapi.PromotionWavePolicy
+	if projectCfg != nil {
+		policies = projectCfg.Spec.PromotionWaves
+	}
+	if policyName := req.Msg.GetPolicy(); policyName != "" {
+		var found bool
+		for _, policy := range policies {
+			if policy.Name == policyName {
+				policies = []kargoapi.PromotionWavePolicy{policy}
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, connect.NewError(
+				connect.CodeNotFound,
+				fmt.Errorf(
+					"promotion wave policy %q not found in project %q",
+					policyName, project,
+				),
+			)
+		}
+	}
+	if len(policies) == 0 {
+		return connect.NewResponse(&svcv1alpha1.GetPromotionWavesResponse{}), nil
+	}
+
+	stages := &kargoapi.StageList{}
+	if err = s.client.List(ctx, stages, client.InNamespace(project)); err != nil {
+		return nil, fmt.Errorf("list stages: %w", err)
+	}
+
+	var freight *kargoapi.Freight
+	var freightList *kargoapi.FreightList
+	if freightName := req.Msg.GetFreight(); freightName != "" {
+		if freight, err = api.GetFreight(
+			ctx,
+			s.client,
+			client.ObjectKey{Namespace: project, Name: freightName},
+		); err != nil {
+			return nil, fmt.Errorf("get freight: %w", err)
+		}
+		if freight == nil {
+			return nil, connect.NewError(
+				connect.CodeNotFound,
+				fmt.Errorf("freight %q not found in namespace %q", freightName, project),
+			)
+		}
+	} else {
+		freightList = &kargoapi.FreightList{}
+		if err = s.client.List(ctx, freightList, client.InNamespace(project)); err != nil {
+			return nil, fmt.Errorf("list freight: %w", err)
+		}
+	}
+
+	progress := make([]*kargoapi.PromotionWaveProgress, 0, len(policies))
+	for i := range policies {
+		policy := &policies[i]
+		policyFreight := freight
+		if freightList != nil {
+			if policyFreight, err = newestFreightInWaves(policy, stages.Items, freightList.Items); err != nil {
+				return nil, err
+			}
+		}
+		p, err := api.GetPromotionWaveProgress(policy, stages.Items, policyFreight)
+		if err != nil {
+			return nil, fmt.Errorf("get promotion wave progress: %w", err)
+		}
+		progress = append(progress, p)
+	}
+
+	return connect.NewResponse(&svcv1alpha1.GetPromotionWavesResponse{
+		Progress: progress,
+	}), nil
+}
+
+// newestFreightInWaves returns the newest Freight that is currently in, or
+// has been verified in, any Stage assigned to the waves of the provided
+// PromotionWavePolicy. If no such Freight exists, nil is returned.
+func newestFreightInWaves(
+	policy *kargoapi.PromotionWavePolicy,
+	stages []kargoapi.Stage,
+	freight []kargoapi.Freight,
+) (*kargoapi.Freight, error) {
+	waves, err := api.AssignPromotionWaves(policy, stages)
+	if err != nil {
+		return nil, fmt.Errorf("assign promotion waves: %w", err)
+	}
+	var newest *kargoapi.Freight
+	for i := range freight {
+		f := &freight[i]
+		if newest != nil && !f.CreationTimestamp.After(newest.CreationTimestamp.Time) {
+			continue
+		}
+		for _, wave := range waves {
+			for _, stage := range wave {
+				if f.IsCurrentlyIn(stage) || f.IsVerifiedIn(stage) {
+					newest = f
+				}
+			}
+		}
+	}
+	return newest, nil
+}
@@ -0,0 +1,220 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/server/kubernetes"
+	"github.com/akuity/kargo/pkg/server/validation"
+)
+
+func TestGetPromotionWaves(t *testing.T) {
+	now := time.Now()
+
+	projectCfg := &kargoapi.ProjectConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "kargo-demo",
+			Name:      "kargo-demo",
+		},
+		Spec: kargoapi.ProjectConfigSpec{
+			PromotionWaves: []kargoapi.PromotionWavePolicy{
+				{
+					Name:          "prod",
+					StageSelector: kargoapi.PromotionPolicySelector{Name: "glob:prod-*"},
+					Waves: []kargoapi.PromotionWave{
+						{
+							Name:          "canary",
+							StageSelector: &kargoapi.PromotionPolicySelector{Name: "prod-a"},
+						},
+						{Name: "rest"},
+					},
+				},
+				{
+					Name:          "test",
+					StageSelector: kargoapi.PromotionPolicySelector{Name: "test"},
+					Waves:         []kargoapi.PromotionWave{{Name: "all"}},
+				},
+			},
+		},
+	}
+	newStage := func(name string) *kargoapi.Stage {
+		return &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "kargo-demo",
+				Name:      name,
+			},
+		}
+	}
+	newFreight := func(name string, created time.Time, verifiedIn ...string) *kargoapi.Freight {
+		freight := &kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "kargo-demo",
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(created),
+			},
+		}
+		for _, stage := range verifiedIn {
+			if freight.Status.VerifiedIn == nil {
+				freight.Status.VerifiedIn = map[string]kargoapi.VerifiedStage{}
+			}
+			freight.Status.VerifiedIn[stage] = kargoapi.VerifiedStage{}
+		}
+		return freight
+	}
+	objects := []client.Object{
+		mustNewObject[corev1.Namespace]("testdata/namespace.yaml"),
+		projectCfg,
+		newStage("prod-a"),
+		newStage("prod-b"),
+		newStage("test"),
+		newFreight("old-freight", now.Add(-time.Hour), "test", "prod-a", "prod-b"),
+		newFreight("new-freight", now, "test"),
+	}
+
+	testCases := map[string]struct {
+		req        *svcv1alpha1.GetPromotionWavesRequest
+		objects    []client.Object
+		assertions func(*testing.T, *connect.Response[svcv1alpha1.GetPromotionWavesResponse], error)
+	}{
+		"empty project": {
+			req: &svcv1alpha1.GetPromotionWavesRequest{},
+			assertions: func(t *testing.T, res *connect.Response[svcv1alpha1.GetPromotionWavesResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+				require.Nil(t, res)
+			},
+		},
+		"non-existing project": {
+			req: &svcv1alpha1.GetPromotionWavesRequest{
+				Project: "kargo-x",
+			},
+			assertions: func(t *testing.T, res *connect.Response[svcv1alpha1.GetPromotionWavesResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+				require.Nil(t, res)
+			},
+		},
+		"no ProjectConfig": {
+			req: &svcv1alpha1.GetPromotionWavesRequest{
+				Project: "kargo-demo",
+			},
+			objects: []client.Object{
+				mustNewObject[corev1.Namespace]("testdata/namespace.yaml"),
+			},
+			assertions: func(t *testing.T, res *connect.Response[svcv1alpha1.GetPromotionWavesResponse], err error) {
+				require.NoError(t, err)
+				require.Empty(t, res.Msg.GetProgress())
+			},
+		},
+		"non-existing policy": {
+			req: &svcv1alpha1.GetPromotionWavesRequest{
+				Project: "kargo-demo",
+				Policy:  "non-existing",
+			},
+			objects: objects,
+			assertions: func(t *testing.T, res *connect.Response[svcv1alpha1.GetPromotionWavesResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+				require.Nil(t, res)
+			},
+		},
+		"non-existing Freight": {
+			req: &svcv1alpha1.GetPromotionWavesRequest{
+				Project: "kargo-demo",
+				Freight: "non-existing",
+			},
+			objects: objects,
+			assertions: func(t *testing.T, res *connect.Response[svcv1alpha1.GetPromotionWavesResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+				require.Nil(t, res)
+			},
+		},
+		"newest Freight of all policies": {
+			req: &svcv1alpha1.GetPromotionWavesRequest{
+				Project: "kargo-demo",
+			},
+			objects: objects,
+			assertions: func(t *testing.T, res *connect.Response[svcv1alpha1.GetPromotionWavesResponse], err error) {
+				require.NoError(t, err)
+				progress := res.Msg.GetProgress()
+				require.Len(t, progress, 2)
+
+				require.Equal(t, "prod", progress[0].Policy)
+				require.Equal(t, "old-freight", progress[0].Freight)
+				require.Len(t, progress[0].Waves, 2)
+				require.Equal(t, []string{"prod-a"}, progress[0].Waves[0].Stages)
+				require.Equal(t, kargoapi.PromotionWavePhaseCompleted, progress[0].Waves[0].Phase)
+				require.Equal(t, []string{"prod-b"}, progress[0].Waves[1].Stages)
+				require.Equal(t, kargoapi.PromotionWavePhaseCompleted, progress[0].Waves[1].Phase)
+
+				require.Equal(t, "test", progress[1].Policy)
+				require.Equal(t, "new-freight", progress[1].Freight)
+			},
+		},
+		"specific policy and Freight": {
+			req: &svcv1alpha1.GetPromotionWavesRequest{
+				Project: "kargo-demo",
+				Policy:  "prod",
+				Freight: "new-freight",
+			},
+			objects: objects,
+			assertions: func(t *testing.T, res *connect.Response[svcv1alpha1.GetPromotionWavesResponse], err error) {
+				require.NoError(t, err)
+				progress := res.Msg.GetProgress()
+				require.Len(t, progress, 1)
+				require.Equal(t, "prod", progress[0].Policy)
+				require.Equal(t, "new-freight", progress[0].Freight)
+				require.Equal(t, kargoapi.PromotionWavePhasePending, progress[0].Waves[0].Phase)
+				require.Equal(t, kargoapi.PromotionWavePhasePending, progress[0].Waves[1].Phase)
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			client, err := kubernetes.NewClient(
+				ctx,
+				&rest.Config{},
+				kubernetes.ClientOptions{
+					SkipAuthorization: true,
+					NewInternalClient: func(
+						_ context.Context,
+						_ *rest.Config,
+						scheme *runtime.Scheme,
+					) (client.Client, error) {
+						c := fake.NewClientBuilder().WithScheme(scheme)
+						if len(testCase.objects) > 0 {
+							c.WithObjects(testCase.objects...)
+						}
+						return c.Build(), nil
+					},
+				},
+			)
+			require.NoError(t, err)
+
+			svr := &server{
+				client:                    client,
+				externalValidateProjectFn: validation.ValidateProject,
+			}
+			svr.validateProjectExistsFn = svr.validateProjectExists
+			res, err := svr.GetPromotionWaves(ctx, connect.NewRequest(testCase.req))
+			testCase.assertions(t, res, err)
+		})
+	}
+}
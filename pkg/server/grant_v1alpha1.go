@@ -4,11 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"connectrpc.com/connect"
 
 	rbacapi "github.com/akuity/kargo/api/rbac/v1alpha1"
 	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/audit"
 )
 
 func (s *server) Grant(
@@ -49,9 +51,46 @@ func (s *server) Grant(
 		)
 	}
 
+	s.auditor.Record(ctx, audit.Entry{
+		Action:  audit.ActionGrantRole,
+		Project: project,
+		Object: audit.ObjectReference{
+			Kind: "Role",
+			Name: req.Msg.Role,
+		},
+		Details: roleChangeAuditDetails(
+			req.Msg.GetUserClaims(),
+			req.Msg.GetResourceDetails(),
+		),
+	})
+
 	return connect.NewResponse(
 		&svcv1alpha1.GrantResponse{
 			Role: role,
 		},
 	), nil
 }
+
+// roleChangeAuditDetails flattens the subject of a Grant or Revoke request into
+// details suitable for inclusion in an audit.Entry.
+func roleChangeAuditDetails(
+	userClaims *svcv1alpha1.Claims,
+	resources *rbacapi.ResourceDetails,
+) map[string]string {
+	details := map[string]string{}
+	if userClaims != nil {
+		claims := make([]string, len(userClaims.Claims))
+		for i, claim := range userClaims.Claims {
+			claims[i] = fmt.Sprintf("%s=%s", claim.Name, strings.Join(claim.Values, ","))
+		}
+		details["userClaims"] = strings.Join(claims, ";")
+	}
+	if resources != nil {
+		details["resourceType"] = resources.ResourceType
+		if resources.ResourceName != "" {
+			details["resourceName"] = resources.ResourceName
+		}
+		details["verbs"] = strings.Join(resources.Verbs, ",")
+	}
+	return details
+}
@@ -803,8 +803,9 @@ func getAuthorizedClient(globalServiceAccountNamespaces []string) func(
 		}
 
 		// sub is a standard claim. If the user has this claim, we can infer that
-		// they authenticated using OIDC.
-		if _, ok := userInfo.Claims["sub"]; ok {
+		// they authenticated using OIDC. Users who authenticated using a Kargo API
+		// token are likewise mapped to the ServiceAccount of the token's Role.
+		if _, ok := userInfo.Claims["sub"]; ok || userInfo.APIToken != nil {
 			namespacesToCheck := make(
 				[]string,
 				0,
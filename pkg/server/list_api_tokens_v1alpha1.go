@@ -0,0 +1,33 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+
+	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+)
+
+func (s *server) ListAPITokens(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.ListAPITokensRequest],
+) (*connect.Response[svcv1alpha1.ListAPITokensResponse], error) {
+	project := req.Msg.GetProject()
+	if err := validateFieldNotEmpty("project", project); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateProjectExistsFn(ctx, project); err != nil {
+		return nil, err
+	}
+
+	tokens, err := s.tokensDB.List(ctx, project)
+	if err != nil {
+		return nil, fmt.Errorf("error listing API tokens in project %q: %w", project, err)
+	}
+
+	return connect.NewResponse(&svcv1alpha1.ListAPITokensResponse{
+		Tokens: tokens,
+	}), nil
+}
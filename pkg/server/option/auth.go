@@ -22,10 +22,12 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	libClient "sigs.k8s.io/controller-runtime/pkg/client"
 
+	rbacapi "github.com/akuity/kargo/api/rbac/v1alpha1"
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/pkg/indexer"
 	"github.com/akuity/kargo/pkg/logging"
 	"github.com/akuity/kargo/pkg/server/config"
+	"github.com/akuity/kargo/pkg/server/rbac"
 	"github.com/akuity/kargo/pkg/server/user"
 )
 
@@ -60,6 +62,10 @@ type authInterceptor struct {
 		ctx context.Context,
 		c claims,
 	) (map[string]map[types.NamespacedName]struct{}, error)
+	verifyAPITokenFn func(
+		ctx context.Context,
+		rawToken string,
+	) (*rbacapi.APIToken, error)
 }
 
 // goOIDCIDTokenVerifyFn is a github.com/coreos/go-oidc/v3/oidc/IDTokenVerifier.Verify() function
@@ -83,6 +89,10 @@ func newAuthInterceptor(
 	a.verifyIDPIssuedTokenFn = a.verifyIDPIssuedToken
 	a.oidcExtractClaimsFn = oidcExtractClaims
 	a.listServiceAccountsFn = a.listServiceAccounts
+	a.verifyAPITokenFn = rbac.NewKubernetesAPITokensDatabase(
+		client,
+		cfg.APITokenSigningKey,
+	).Verify
 	return a
 }
 
@@ -366,6 +376,38 @@ func (a *authInterceptor) authenticate(
 		return ctx, errors.New("no token provided")
 	}
 
+	// Are we dealing with a Kargo API token? These are opaque and verified
+	// entirely against state stored in the cluster, so no identity provider
+	// needs to be involved.
+	if rbac.IsAPIToken(rawToken) {
+		token, err := a.verifyAPITokenFn(ctx, rawToken)
+		if err != nil {
+			return ctx, err
+		}
+		logger.Debug(
+			"API token verified",
+			"namespace", token.Namespace,
+			"name", token.Name,
+		)
+		return user.ContextWithInfo(
+			ctx,
+			user.Info{
+				BearerToken: rawToken,
+				APIToken: &types.NamespacedName{
+					Namespace: token.Namespace,
+					Name:      token.Name,
+				},
+				// The token grants the permissions of its Role, and only within the
+				// token's own Project.
+				ServiceAccountsByNamespace: map[string]map[types.NamespacedName]struct{}{
+					token.Namespace: {
+						{Namespace: token.Namespace, Name: token.RoleName}: {},
+					},
+				},
+			},
+		), nil
+	}
+
 	// Are we dealing with a JWT?
 	//
 	// Note: If this is a JWT, we cannot trust these claims yet because we're not
@@ -7,17 +7,21 @@ import (
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/coreos/go-oidc/v3/oidc"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
+	rbacapi "github.com/akuity/kargo/api/rbac/v1alpha1"
 	"github.com/akuity/kargo/pkg/server/config"
 	"github.com/akuity/kargo/pkg/server/dex"
 	libOIDC "github.com/akuity/kargo/pkg/server/oidc"
+	"github.com/akuity/kargo/pkg/server/rbac"
 	"github.com/akuity/kargo/pkg/server/user"
 )
 
@@ -54,6 +58,7 @@ func TestNewAuthInterceptor(t *testing.T) {
 	require.NotNil(t, a.verifyIDPIssuedTokenFn)
 	require.NotNil(t, a.oidcExtractClaimsFn)
 	require.NotNil(t, a.listServiceAccountsFn)
+	require.NotNil(t, a.verifyAPITokenFn)
 }
 
 func TestGetKeySet(t *testing.T) {
@@ -161,6 +166,7 @@ func TestAuthenticate(t *testing.T) {
 		testKargoIssuer = "fake-kargo-issuer"
 		testToken       = "some-token"
 	)
+	testAPIToken := "kargo_fake-project_fake-token_" + strings.Repeat("0", 64)
 	testSets := map[string]struct {
 		procedure       string
 		authInterceptor *authInterceptor
@@ -209,6 +215,58 @@ func TestAuthenticate(t *testing.T) {
 				require.Equal(t, testToken, u.BearerToken)
 			},
 		},
+		"failure verifying API token": {
+			procedure: testProcedure,
+			authInterceptor: &authInterceptor{
+				verifyAPITokenFn: func(context.Context, string) (*rbacapi.APIToken, error) {
+					return nil, rbac.ErrInvalidAPIToken
+				},
+			},
+			token: testAPIToken,
+			assertions: func(ctx context.Context, err error) {
+				require.ErrorIs(t, err, rbac.ErrInvalidAPIToken)
+				_, ok := user.InfoFromContext(ctx)
+				require.False(t, ok)
+			},
+		},
+		"success verifying API token": {
+			procedure: testProcedure,
+			authInterceptor: &authInterceptor{
+				verifyAPITokenFn: func(context.Context, string) (*rbacapi.APIToken, error) {
+					return &rbacapi.APIToken{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-project",
+							Name:      "fake-token",
+						},
+						RoleName: "fake-role",
+					}, nil
+				},
+			},
+			token: testAPIToken,
+			// We expect user info mapping the user to only the ServiceAccount
+			// underlying the token's Role to be bound to the context.
+			assertions: func(ctx context.Context, err error) {
+				require.NoError(t, err)
+				u, ok := user.InfoFromContext(ctx)
+				require.True(t, ok)
+				require.Equal(t, testAPIToken, u.BearerToken)
+				require.Equal(
+					t,
+					&types.NamespacedName{Namespace: "fake-project", Name: "fake-token"},
+					u.APIToken,
+				)
+				require.Equal(
+					t,
+					map[string]map[types.NamespacedName]struct{}{
+						"fake-project": {
+							{Namespace: "fake-project", Name: "fake-role"}: {},
+						},
+					},
+					u.ServiceAccountsByNamespace,
+				)
+				require.Empty(t, u.Claims)
+			},
+		},
 		"failure verifying Kargo-issued token": {
 			procedure: testProcedure,
 			authInterceptor: &authInterceptor{
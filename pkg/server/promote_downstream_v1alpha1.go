@@ -150,6 +150,7 @@ func (s *server) PromoteDownstream(
 			continue
 		}
 		s.recordPromotionCreatedEvent(ctx, newPromo, freight)
+		s.recordPromotionCreatedAudit(ctx, newPromo)
 		createdPromos = append(createdPromos, newPromo)
 	}
 
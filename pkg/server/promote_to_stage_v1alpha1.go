@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 
 	"connectrpc.com/connect"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -12,6 +13,7 @@ import (
 	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/pkg/api"
+	"github.com/akuity/kargo/pkg/audit"
 	"github.com/akuity/kargo/pkg/event"
 	"github.com/akuity/kargo/pkg/kargo"
 	"github.com/akuity/kargo/pkg/logging"
@@ -126,6 +128,7 @@ func (s *server) PromoteToStage(
 		return nil, fmt.Errorf("create promotion: %w", err)
 	}
 	s.recordPromotionCreatedEvent(ctx, promotion, freight)
+	s.recordPromotionCreatedAudit(ctx, promotion)
 	return connect.NewResponse(&svcv1alpha1.PromoteToStageResponse{
 		Promotion: promotion,
 	}), nil
@@ -155,3 +158,26 @@ func (s *server) recordPromotionCreatedEvent(
 		logging.LoggerFromContext(ctx).Error(err, "Error when publishing new promotion event")
 	}
 }
+
+func (s *server) recordPromotionCreatedAudit(
+	ctx context.Context,
+	p *kargoapi.Promotion,
+) {
+	details := map[string]string{
+		"stage":   p.Spec.Stage,
+		"freight": p.Spec.Freight,
+	}
+	if p.Spec.ResumeFrom != nil {
+		details["resumeFrom"] = p.Spec.ResumeFrom.Promotion
+		details["resumeFromStep"] = strconv.FormatInt(p.Spec.ResumeFrom.Step, 10)
+	}
+	s.auditor.Record(ctx, audit.Entry{
+		Action:  audit.ActionPromote,
+		Project: p.Namespace,
+		Object: audit.ObjectReference{
+			Kind: "Promotion",
+			Name: p.Name,
+		},
+		Details: details,
+	})
+}
@@ -56,10 +56,12 @@ func (s *server) QueryFreight(
 	origins := req.Msg.GetOrigins()
 	reverse := req.Msg.GetReverse()
 
+	var stage *kargoapi.Stage
 	var freight []kargoapi.Freight
 	switch {
 	case stageName != "":
-		stage, err := s.getStageFn(
+		var err error
+		stage, err = s.getStageFn(
 			ctx,
 			s.client,
 			types.NamespacedName{
@@ -117,6 +119,21 @@ func (s *server) QueryFreight(
 		freightGroups = noGroupBy(freight)
 	}
 
+	staleFreight, err := s.getStaleFreightFn(ctx, stage, freight)
+	if err != nil {
+		return nil, fmt.Errorf("get stale freight: %w", err)
+	}
+	for _, group := range freightGroups {
+		for _, f := range group.Freight {
+			if reason, stale := staleFreight[f.Name]; stale {
+				if group.Stale == nil {
+					group.Stale = map[string]string{}
+				}
+				group.Stale[f.Name] = reason
+			}
+		}
+	}
+
 	sortFreightGroups(orderBy, reverse, freightGroups)
 
 	return connect.NewResponse(&svcv1alpha1.QueryFreightResponse{
@@ -131,6 +148,14 @@ func (s *server) getAvailableFreightForStage(
 	return api.ListFreightAvailableToStage(ctx, s.client, stage)
 }
 
+func (s *server) getStaleFreight(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	freight []kargoapi.Freight,
+) (map[string]string, error) {
+	return api.GetStaleFreight(ctx, s.client, stage, freight)
+}
+
 func (s *server) getFreightFromWarehouses(
 	ctx context.Context,
 	project string,
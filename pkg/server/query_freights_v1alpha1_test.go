@@ -205,6 +205,96 @@ func TestQueryFreight(t *testing.T) {
 			},
 		},
 
+		{
+			name: "error getting stale Freight",
+			req: &svcv1alpha1.QueryFreightRequest{
+				Project: "fake-project",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				listFreightFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return nil
+				},
+				getStaleFreightFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					[]kargoapi.Freight,
+				) (map[string]string, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *connect.Response[svcv1alpha1.QueryFreightResponse],
+				err error,
+			) {
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+
+		{
+			name: "stale Freight",
+			req: &svcv1alpha1.QueryFreightRequest{
+				Project: "fake-project",
+				Stage:   "fake-stage",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						ObjectMeta: metav1.ObjectMeta{Name: "fake-stage"},
+					}, nil
+				},
+				getAvailableFreightForStageFn: func(
+					context.Context,
+					*kargoapi.Stage,
+				) ([]kargoapi.Freight, error) {
+					return []kargoapi.Freight{
+						{ObjectMeta: metav1.ObjectMeta{Name: "fake-freight"}},
+						{ObjectMeta: metav1.ObjectMeta{Name: "stale-freight"}},
+					}, nil
+				},
+				getStaleFreightFn: func(
+					_ context.Context,
+					stage *kargoapi.Stage,
+					_ []kargoapi.Freight,
+				) (map[string]string, error) {
+					require.Equal(t, "fake-stage", stage.Name)
+					return map[string]string{
+						"stale-freight": "Freight is superseded by 3 newer Freight",
+					}, nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				res *connect.Response[svcv1alpha1.QueryFreightResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				group := res.Msg.GetGroups()[""]
+				require.Len(t, group.GetFreight(), 2)
+				require.Equal(
+					t,
+					map[string]string{
+						"stale-freight": "Freight is superseded by 3 newer Freight",
+					},
+					group.GetStale(),
+				)
+			},
+		},
+
 		{
 			name: "group by image repo",
 			req: &svcv1alpha1.QueryFreightRequest{
@@ -215,6 +305,13 @@ func TestQueryFreight(t *testing.T) {
 				validateProjectExistsFn: func(context.Context, string) error {
 					return nil
 				},
+				getStaleFreightFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					[]kargoapi.Freight,
+				) (map[string]string, error) {
+					return nil, nil
+				},
 				listFreightFn: func(
 					_ context.Context,
 					objList client.ObjectList,
@@ -253,6 +350,13 @@ func TestQueryFreight(t *testing.T) {
 				validateProjectExistsFn: func(context.Context, string) error {
 					return nil
 				},
+				getStaleFreightFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					[]kargoapi.Freight,
+				) (map[string]string, error) {
+					return nil, nil
+				},
 				listFreightFn: func(
 					_ context.Context,
 					objList client.ObjectList,
@@ -291,6 +395,13 @@ func TestQueryFreight(t *testing.T) {
 				validateProjectExistsFn: func(context.Context, string) error {
 					return nil
 				},
+				getStaleFreightFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					[]kargoapi.Freight,
+				) (map[string]string, error) {
+					return nil, nil
+				},
 				listFreightFn: func(
 					_ context.Context,
 					objList client.ObjectList,
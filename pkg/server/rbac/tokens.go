@@ -0,0 +1,415 @@
+package rbac
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	rbacapi "github.com/akuity/kargo/api/rbac/v1alpha1"
+	"github.com/akuity/kargo/pkg/logging"
+)
+
+const (
+	// APITokenPrefix is the prefix of every raw Kargo API token. It permits
+	// Kargo API tokens to be distinguished from other bearer tokens without
+	// looking them up.
+	APITokenPrefix = "kargo_"
+
+	// apiTokenSignatureKey is the key in the data of a Secret underlying a
+	// Kargo API token under which the token's signature is stored. The
+	// signature is an HMAC of the token's secret part and of the attributes of
+	// the token which must not be altered. The secret part itself is never
+	// stored.
+	apiTokenSignatureKey = "tokenSignature"
+
+	// apiTokenSecretBytes is the number of random bytes in the secret part of a
+	// Kargo API token.
+	apiTokenSecretBytes = 32
+
+	// apiTokenLastUsedInterval is the minimum interval between updates of the
+	// time at which a Kargo API token was last used. This bounds the number of
+	// writes incurred by frequent use of the same token.
+	apiTokenLastUsedInterval = time.Minute
+)
+
+// ErrInvalidAPIToken is returned when a raw Kargo API token is malformed,
+// unknown, revoked, or expired. Deliberately, no further detail is given.
+var ErrInvalidAPIToken = errors.New("invalid API token")
+
+// ErrAPITokensNotEnabled is returned when a Kargo API token is to be created,
+// but no key for signing Kargo API tokens has been configured.
+var ErrAPITokensNotEnabled = errors.New(
+	"API tokens are not enabled because no API token signing key is configured",
+)
+
+// APITokensDatabase is an interface for the Kargo API tokens store.
+type APITokensDatabase interface {
+	// Create creates the Secret underlying a new Kargo API token bound to the
+	// specified Kargo Role and returns a Kargo API token representation of it
+	// along with the raw token. The raw token is not stored and cannot be
+	// retrieved again. It will return an error if a token by the same name
+	// already exists.
+	Create(
+		ctx context.Context,
+		project string,
+		name string,
+		roleName string,
+		expiresAt time.Time,
+	) (*rbacapi.APIToken, string, error)
+	// Delete deletes the Secret underlying a Kargo API token, thereby revoking
+	// the token. It will return an error if no such token exists.
+	Delete(ctx context.Context, project, name string) error
+	// Get returns a Kargo API token representation of an underlying Secret. It
+	// will return an error if no such token exists.
+	Get(ctx context.Context, project, name string) (*rbacapi.APIToken, error)
+	// List returns Kargo API token representations of all underlying Secrets in
+	// the specified project.
+	List(ctx context.Context, project string) ([]*rbacapi.APIToken, error)
+	// Verify verifies a raw Kargo API token and, if it is valid, returns a Kargo
+	// API token representation of it. The time at which the token was last used
+	// is updated as a side effect. ErrInvalidAPIToken is returned if the token
+	// is malformed, unknown, revoked, or expired.
+	Verify(ctx context.Context, rawToken string) (*rbacapi.APIToken, error)
+}
+
+// apiTokensDatabase is an implementation of the APITokensDatabase interface
+// that utilizes a Kubernetes controller runtime client to store and retrieve
+// Kargo API tokens stored in Kubernetes in the form of labeled Secrets.
+//
+// Anyone permitted to write Secrets in a Project namespace could write a
+// Secret resembling one underlying a Kargo API token. Such Secrets are not to
+// be trusted, so every Secret created by the database carries a signature
+// computed with a key known only to the API server. Secrets without a valid
+// signature, including those whose Role or expiry was altered after the fact,
+// are rejected.
+type apiTokensDatabase struct {
+	client     client.Client
+	signingKey []byte
+	nowFn      func() time.Time
+}
+
+// NewKubernetesAPITokensDatabase returns an implementation of the
+// APITokensDatabase interface that utilizes a Kubernetes controller runtime
+// client to store and retrieve Kargo API tokens stored in Kubernetes in the
+// form of labeled Secrets. Kargo API tokens are signed using the provided key.
+// If the key is empty, Kargo API tokens can neither be created nor verified.
+func NewKubernetesAPITokensDatabase(
+	c client.Client,
+	signingKey []byte,
+) APITokensDatabase {
+	return &apiTokensDatabase{
+		client:     c,
+		signingKey: signingKey,
+		nowFn:      time.Now,
+	}
+}
+
+// Create implements the APITokensDatabase interface.
+func (a *apiTokensDatabase) Create(
+	ctx context.Context,
+	project string,
+	name string,
+	roleName string,
+	expiresAt time.Time,
+) (*rbacapi.APIToken, string, error) {
+	if len(a.signingKey) == 0 {
+		return nil, "", ErrAPITokensNotEnabled
+	}
+	if strings.Contains(name, "_") {
+		return nil, "", fmt.Errorf("API token name %q must not contain underscores", name)
+	}
+	secretPart := make([]byte, apiTokenSecretBytes)
+	if _, err := rand.Read(secretPart); err != nil {
+		return nil, "", fmt.Errorf("error generating API token: %w", err)
+	}
+	encodedSecretPart := hex.EncodeToString(secretPart)
+	formattedExpiresAt := expiresAt.UTC().Format(time.RFC3339)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: project,
+			Name:      name,
+			Labels: map[string]string{
+				rbacapi.LabelKeyAPIToken: rbacapi.LabelValueTrue,
+			},
+			Annotations: map[string]string{
+				rbacapi.AnnotationKeyAPITokenRole:      roleName,
+				rbacapi.AnnotationKeyAPITokenExpiresAt: formattedExpiresAt,
+			},
+		},
+		Data: map[string][]byte{
+			apiTokenSignatureKey: []byte(a.signAPIToken(
+				project, name, roleName, formattedExpiresAt, encodedSecretPart,
+			)),
+		},
+	}
+	if err := a.client.Create(ctx, secret); err != nil {
+		return nil, "", fmt.Errorf(
+			"error creating Secret %q in namespace %q: %w", name, project, err,
+		)
+	}
+	token, err := SecretToAPIToken(secret)
+	if err != nil {
+		return nil, "", err
+	}
+	return token, formatAPIToken(project, name, encodedSecretPart), nil
+}
+
+// Delete implements the APITokensDatabase interface.
+func (a *apiTokensDatabase) Delete(ctx context.Context, project, name string) error {
+	secret, err := a.getSecret(ctx, project, name)
+	if err != nil {
+		return err
+	}
+	if err = a.client.Delete(ctx, secret); err != nil {
+		return fmt.Errorf(
+			"error deleting Secret %q in namespace %q: %w", name, project, err,
+		)
+	}
+	return nil
+}
+
+// Get implements the APITokensDatabase interface.
+func (a *apiTokensDatabase) Get(
+	ctx context.Context,
+	project string,
+	name string,
+) (*rbacapi.APIToken, error) {
+	secret, err := a.getSecret(ctx, project, name)
+	if err != nil {
+		return nil, err
+	}
+	return SecretToAPIToken(secret)
+}
+
+// List implements the APITokensDatabase interface.
+func (a *apiTokensDatabase) List(
+	ctx context.Context,
+	project string,
+) ([]*rbacapi.APIToken, error) {
+	secretList := &corev1.SecretList{}
+	if err := a.client.List(
+		ctx,
+		secretList,
+		client.InNamespace(project),
+		client.MatchingLabels{rbacapi.LabelKeyAPIToken: rbacapi.LabelValueTrue},
+	); err != nil {
+		return nil, fmt.Errorf("error listing Secrets in namespace %q: %w", project, err)
+	}
+	slices.SortFunc(secretList.Items, func(lhs, rhs corev1.Secret) int {
+		return strings.Compare(lhs.Name, rhs.Name)
+	})
+	tokens := make([]*rbacapi.APIToken, 0, len(secretList.Items))
+	for i := range secretList.Items {
+		token, err := SecretToAPIToken(&secretList.Items[i])
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, token)
+	}
+	return tokens, nil
+}
+
+// Verify implements the APITokensDatabase interface.
+func (a *apiTokensDatabase) Verify(
+	ctx context.Context,
+	rawToken string,
+) (*rbacapi.APIToken, error) {
+	project, name, secretPart, ok := parseAPIToken(rawToken)
+	if !ok || len(a.signingKey) == 0 {
+		return nil, ErrInvalidAPIToken
+	}
+	secret := &corev1.Secret{}
+	if err := a.client.Get(
+		ctx,
+		client.ObjectKey{Namespace: project, Name: name},
+		secret,
+	); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, ErrInvalidAPIToken
+		}
+		return nil, fmt.Errorf(
+			"error getting Secret %q in namespace %q: %w", name, project, err,
+		)
+	}
+	if secret.Labels[rbacapi.LabelKeyAPIToken] != rbacapi.LabelValueTrue {
+		return nil, ErrInvalidAPIToken
+	}
+	// The signature covers the Role and expiry of the token, so a Secret that
+	// was not created by the API server, or whose Role or expiry was altered,
+	// does not verify.
+	if !hmac.Equal(
+		secret.Data[apiTokenSignatureKey],
+		[]byte(a.signAPIToken(
+			project,
+			name,
+			secret.Annotations[rbacapi.AnnotationKeyAPITokenRole],
+			secret.Annotations[rbacapi.AnnotationKeyAPITokenExpiresAt],
+			secretPart,
+		)),
+	) {
+		return nil, ErrInvalidAPIToken
+	}
+	token, err := SecretToAPIToken(secret)
+	if err != nil {
+		return nil, err
+	}
+	now := metav1.NewTime(a.nowFn())
+	if token.ExpiresAt == nil || token.IsExpired(now) {
+		return nil, ErrInvalidAPIToken
+	}
+
+	if token.LastUsedAt == nil ||
+		now.Sub(token.LastUsedAt.Time) >= apiTokenLastUsedInterval {
+		secret.Annotations[rbacapi.AnnotationKeyAPITokenLastUsedAt] =
+			now.UTC().Format(time.RFC3339)
+		// Failing to record when the token was last used is not a reason to fail
+		// authentication. A conflict, for instance, only means another request
+		// using the same token beat us to it.
+		if err = a.client.Update(ctx, secret); err != nil {
+			logging.LoggerFromContext(ctx).Error(
+				err, "error recording API token use",
+				"namespace", project, "name", name,
+			)
+		} else {
+			token.LastUsedAt = &now
+		}
+	}
+
+	return token, nil
+}
+
+// getSecret returns the Secret underlying a Kargo API token. It will return a
+// NotFound error if the Secret does not exist or is not labeled as underlying
+// a Kargo API token.
+func (a *apiTokensDatabase) getSecret(
+	ctx context.Context,
+	project string,
+	name string,
+) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	if err := a.client.Get(
+		ctx,
+		client.ObjectKey{Namespace: project, Name: name},
+		secret,
+	); err != nil {
+		return nil, fmt.Errorf(
+			"error getting Secret %q in namespace %q: %w", name, project, err,
+		)
+	}
+	if secret.Labels[rbacapi.LabelKeyAPIToken] != rbacapi.LabelValueTrue {
+		return nil, apierrors.NewNotFound(
+			schema.GroupResource{
+				Group:    rbacapi.GroupVersion.Group,
+				Resource: "apitoken",
+			},
+			name,
+		)
+	}
+	return secret, nil
+}
+
+// SecretToAPIToken converts a Secret underlying a Kargo API token to a Kargo
+// API token representation. The token's signature is never included.
+func SecretToAPIToken(secret *corev1.Secret) (*rbacapi.APIToken, error) {
+	token := &rbacapi.APIToken{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         secret.Namespace,
+			Name:              secret.Name,
+			CreationTimestamp: secret.CreationTimestamp,
+		},
+		RoleName: secret.Annotations[rbacapi.AnnotationKeyAPITokenRole],
+	}
+	var err error
+	if token.ExpiresAt, err = parseAPITokenTime(
+		secret.Annotations[rbacapi.AnnotationKeyAPITokenExpiresAt],
+	); err != nil {
+		return nil, fmt.Errorf(
+			"error parsing expiry of API token %q in namespace %q: %w",
+			secret.Name, secret.Namespace, err,
+		)
+	}
+	if token.LastUsedAt, err = parseAPITokenTime(
+		secret.Annotations[rbacapi.AnnotationKeyAPITokenLastUsedAt],
+	); err != nil {
+		return nil, fmt.Errorf(
+			"error parsing last use of API token %q in namespace %q: %w",
+			secret.Name, secret.Namespace, err,
+		)
+	}
+	return token, nil
+}
+
+// IsAPIToken returns true if the provided raw token looks like a Kargo API
+// token. It does not verify the token.
+func IsAPIToken(rawToken string) bool {
+	_, _, _, ok := parseAPIToken(rawToken)
+	return ok
+}
+
+// formatAPIToken returns a raw Kargo API token. The project and name are
+// embedded in the token so that its underlying Secret can be located without
+// searching.
+func formatAPIToken(project, name, secretPart string) string {
+	return fmt.Sprintf("%s%s_%s_%s", APITokenPrefix, project, name, secretPart)
+}
+
+// parseAPIToken parses a raw Kargo API token into its constituent project,
+// name, and secret part. Neither project names nor token names can contain
+// underscores, so underscores unambiguously delimit the parts.
+func parseAPIToken(rawToken string) (string, string, string, bool) {
+	rest, ok := strings.CutPrefix(rawToken, APITokenPrefix)
+	if !ok {
+		return "", "", "", false
+	}
+	parts := strings.Split(rest, "_")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" ||
+		len(parts[2]) != 2*apiTokenSecretBytes {
+		return "", "", "", false
+	}
+	return parts[0], parts[1], parts[2], true
+}
+
+// signAPIToken returns the signature of a Kargo API token. It is an
+// HMAC-SHA256 of the token's project, name, Role, expiry, and secret part,
+// keyed with the database's signing key.
+func (a *apiTokensDatabase) signAPIToken(
+	project string,
+	name string,
+	roleName string,
+	expiresAt string,
+	secretPart string,
+) string {
+	mac := hmac.New(sha256.New, a.signingKey)
+	for _, part := range []string{project, name, roleName, expiresAt, secretPart} {
+		// Each part is terminated with a NUL byte so that no two distinct
+		// sequences of parts are signed identically.
+		_, _ = mac.Write([]byte(part))
+		_, _ = mac.Write([]byte{0})
+	}
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func parseAPITokenTime(value string) (*metav1.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	mt := metav1.NewTime(t)
+	return &mt, nil
+}
@@ -0,0 +1,358 @@
+package rbac
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	rbacapi "github.com/akuity/kargo/api/rbac/v1alpha1"
+)
+
+const testAPITokenName = "fake-token"
+
+var testAPITokenSigningKey = []byte("fake-signing-key")
+
+func TestAPITokensDatabase_Create(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	t.Run("API tokens not enabled", func(t *testing.T) {
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+		db := NewKubernetesAPITokensDatabase(c, nil)
+		_, _, err := db.Create(
+			context.Background(), testProject, testAPITokenName, testKargoRoleName, expiresAt,
+		)
+		require.ErrorIs(t, err, ErrAPITokensNotEnabled)
+	})
+
+	t.Run("invalid name", func(t *testing.T) {
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+		db := NewKubernetesAPITokensDatabase(c, testAPITokenSigningKey)
+		_, _, err := db.Create(
+			context.Background(), testProject, "fake_token", testKargoRoleName, expiresAt,
+		)
+		require.ErrorContains(t, err, "must not contain underscores")
+	})
+
+	t.Run("token already exists", func(t *testing.T) {
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			apiTokenSecret(t, "", nil),
+		).Build()
+		db := NewKubernetesAPITokensDatabase(c, testAPITokenSigningKey)
+		token, rawToken, err := db.Create(
+			context.Background(), testProject, testAPITokenName, testKargoRoleName, expiresAt,
+		)
+		require.True(t, apierrors.IsAlreadyExists(err))
+		require.Nil(t, token)
+		require.Empty(t, rawToken)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+		db := NewKubernetesAPITokensDatabase(c, testAPITokenSigningKey)
+		token, rawToken, err := db.Create(
+			context.Background(), testProject, testAPITokenName, testKargoRoleName, expiresAt,
+		)
+		require.NoError(t, err)
+		require.Equal(t, testProject, token.Namespace)
+		require.Equal(t, testAPITokenName, token.Name)
+		require.Equal(t, testKargoRoleName, token.RoleName)
+		require.True(t, expiresAt.Equal(token.ExpiresAt.Time))
+		require.Nil(t, token.LastUsedAt)
+		require.True(t, strings.HasPrefix(rawToken, "kargo_fake-project_fake-token_"))
+		require.True(t, IsAPIToken(rawToken))
+
+		secret := &corev1.Secret{}
+		require.NoError(t, c.Get(
+			context.Background(),
+			client.ObjectKey{Namespace: testProject, Name: testAPITokenName},
+			secret,
+		))
+		require.Equal(t, rbacapi.LabelValueTrue, secret.Labels[rbacapi.LabelKeyAPIToken])
+		// The raw token must never be stored
+		require.NotContains(t, string(secret.Data[apiTokenSignatureKey]), rawToken[len(rawToken)-64:])
+
+		verified, err := db.Verify(context.Background(), rawToken)
+		require.NoError(t, err)
+		require.Equal(t, testKargoRoleName, verified.RoleName)
+	})
+}
+
+func TestAPITokensDatabase_Delete(t *testing.T) {
+	t.Run("token does not exist", func(t *testing.T) {
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+		db := NewKubernetesAPITokensDatabase(c, testAPITokenSigningKey)
+		err := db.Delete(context.Background(), testProject, testAPITokenName)
+		require.True(t, apierrors.IsNotFound(err))
+	})
+
+	t.Run("Secret is not a token", func(t *testing.T) {
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: testProject,
+					Name:      testAPITokenName,
+				},
+			},
+		).Build()
+		db := NewKubernetesAPITokensDatabase(c, testAPITokenSigningKey)
+		err := db.Delete(context.Background(), testProject, testAPITokenName)
+		require.True(t, apierrors.IsNotFound(err))
+	})
+
+	t.Run("success", func(t *testing.T) {
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			apiTokenSecret(t, "", nil),
+		).Build()
+		db := NewKubernetesAPITokensDatabase(c, testAPITokenSigningKey)
+		err := db.Delete(context.Background(), testProject, testAPITokenName)
+		require.NoError(t, err)
+		err = c.Get(
+			context.Background(),
+			client.ObjectKey{Namespace: testProject, Name: testAPITokenName},
+			&corev1.Secret{},
+		)
+		require.True(t, apierrors.IsNotFound(err))
+	})
+}
+
+func TestAPITokensDatabase_Get(t *testing.T) {
+	lastUsedAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		apiTokenSecret(t, "", &lastUsedAt),
+	).Build()
+	db := NewKubernetesAPITokensDatabase(c, testAPITokenSigningKey)
+	token, err := db.Get(context.Background(), testProject, testAPITokenName)
+	require.NoError(t, err)
+	require.Equal(t, testKargoRoleName, token.RoleName)
+	require.NotNil(t, token.ExpiresAt)
+	require.True(t, lastUsedAt.Equal(token.LastUsedAt.Time))
+}
+
+func TestAPITokensDatabase_List(t *testing.T) {
+	other := apiTokenSecret(t, "", nil)
+	other.Name = "another-token"
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		apiTokenSecret(t, "", nil),
+		other,
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testProject,
+				Name:      "not-a-token",
+			},
+		},
+	).Build()
+	db := NewKubernetesAPITokensDatabase(c, testAPITokenSigningKey)
+	tokens, err := db.List(context.Background(), testProject)
+	require.NoError(t, err)
+	require.Len(t, tokens, 2)
+	require.Equal(t, "another-token", tokens[0].Name)
+	require.Equal(t, testAPITokenName, tokens[1].Name)
+}
+
+func TestAPITokensDatabase_Verify(t *testing.T) {
+	secretPart := strings.Repeat("ab", apiTokenSecretBytes)
+	rawToken := formatAPIToken(testProject, testAPITokenName, secretPart)
+
+	testCases := []struct {
+		name       string
+		rawToken   string
+		objects    []client.Object
+		signingKey []byte
+		assertions func(*testing.T, client.Client, *rbacapi.APIToken, error)
+	}{
+		{
+			name:       "API tokens not enabled",
+			rawToken:   rawToken,
+			objects:    []client.Object{apiTokenSecret(t, secretPart, nil)},
+			signingKey: []byte{},
+			assertions: func(t *testing.T, _ client.Client, _ *rbacapi.APIToken, err error) {
+				require.ErrorIs(t, err, ErrInvalidAPIToken)
+			},
+		},
+		{
+			name:     "malformed token",
+			rawToken: "kargo_fake-project_fake-token",
+			assertions: func(t *testing.T, _ client.Client, _ *rbacapi.APIToken, err error) {
+				require.ErrorIs(t, err, ErrInvalidAPIToken)
+			},
+		},
+		{
+			name:     "unknown token",
+			rawToken: rawToken,
+			assertions: func(t *testing.T, _ client.Client, _ *rbacapi.APIToken, err error) {
+				require.ErrorIs(t, err, ErrInvalidAPIToken)
+			},
+		},
+		{
+			name:     "wrong secret",
+			rawToken: formatAPIToken(testProject, testAPITokenName, strings.Repeat("cd", apiTokenSecretBytes)),
+			objects:  []client.Object{apiTokenSecret(t, secretPart, nil)},
+			assertions: func(t *testing.T, _ client.Client, _ *rbacapi.APIToken, err error) {
+				require.ErrorIs(t, err, ErrInvalidAPIToken)
+			},
+		},
+		{
+			name:     "Secret not created by the API server",
+			rawToken: rawToken,
+			objects: []client.Object{
+				func() client.Object {
+					secret := apiTokenSecret(t, secretPart, nil)
+					sum := sha256.Sum256([]byte(secretPart))
+					secret.Data[apiTokenSignatureKey] = []byte(hex.EncodeToString(sum[:]))
+					return secret
+				}(),
+			},
+			assertions: func(t *testing.T, _ client.Client, _ *rbacapi.APIToken, err error) {
+				require.ErrorIs(t, err, ErrInvalidAPIToken)
+			},
+		},
+		{
+			name:     "Role altered",
+			rawToken: rawToken,
+			objects: []client.Object{
+				func() client.Object {
+					secret := apiTokenSecret(t, secretPart, nil)
+					secret.Annotations[rbacapi.AnnotationKeyAPITokenRole] = "kargo-admin"
+					return secret
+				}(),
+			},
+			assertions: func(t *testing.T, _ client.Client, _ *rbacapi.APIToken, err error) {
+				require.ErrorIs(t, err, ErrInvalidAPIToken)
+			},
+		},
+		{
+			name:     "expiry altered",
+			rawToken: rawToken,
+			objects: []client.Object{
+				func() client.Object {
+					secret := apiTokenSecret(t, secretPart, nil)
+					secret.Annotations[rbacapi.AnnotationKeyAPITokenExpiresAt] =
+						time.Now().Add(24 * time.Hour).UTC().Format(time.RFC3339)
+					return secret
+				}(),
+			},
+			assertions: func(t *testing.T, _ client.Client, _ *rbacapi.APIToken, err error) {
+				require.ErrorIs(t, err, ErrInvalidAPIToken)
+			},
+		},
+		{
+			name:     "expired token",
+			rawToken: rawToken,
+			objects: []client.Object{
+				func() client.Object {
+					secret := apiTokenSecret(t, secretPart, nil)
+					secret.Annotations[rbacapi.AnnotationKeyAPITokenExpiresAt] =
+						time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)
+					// Re-sign so that only the expiry causes the token to be rejected
+					signAPITokenSecret(secret, secretPart)
+					return secret
+				}(),
+			},
+			assertions: func(t *testing.T, _ client.Client, _ *rbacapi.APIToken, err error) {
+				require.ErrorIs(t, err, ErrInvalidAPIToken)
+			},
+		},
+		{
+			name:     "recently used token",
+			rawToken: rawToken,
+			objects: []client.Object{
+				apiTokenSecret(t, secretPart, ptr.To(time.Now().Add(-time.Second).Truncate(time.Second))),
+			},
+			assertions: func(t *testing.T, c client.Client, token *rbacapi.APIToken, err error) {
+				require.NoError(t, err)
+				require.Equal(t, testKargoRoleName, token.RoleName)
+				secret := &corev1.Secret{}
+				require.NoError(t, c.Get(
+					context.Background(),
+					client.ObjectKey{Namespace: testProject, Name: testAPITokenName},
+					secret,
+				))
+				// Not updated because it was updated too recently
+				require.Equal(t, "999", secret.ResourceVersion)
+			},
+		},
+		{
+			name:     "success",
+			rawToken: rawToken,
+			objects:  []client.Object{apiTokenSecret(t, secretPart, nil)},
+			assertions: func(t *testing.T, c client.Client, token *rbacapi.APIToken, err error) {
+				require.NoError(t, err)
+				require.Equal(t, testKargoRoleName, token.RoleName)
+				require.NotNil(t, token.LastUsedAt)
+				secret := &corev1.Secret{}
+				require.NoError(t, c.Get(
+					context.Background(),
+					client.ObjectKey{Namespace: testProject, Name: testAPITokenName},
+					secret,
+				))
+				require.NotEmpty(t, secret.Annotations[rbacapi.AnnotationKeyAPITokenLastUsedAt])
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(testCase.objects...).Build()
+			signingKey := testCase.signingKey
+			if signingKey == nil {
+				signingKey = testAPITokenSigningKey
+			}
+			db := NewKubernetesAPITokensDatabase(c, signingKey)
+			token, err := db.Verify(context.Background(), testCase.rawToken)
+			testCase.assertions(t, c, token, err)
+		})
+	}
+}
+
+func TestIsAPIToken(t *testing.T) {
+	require.True(t, IsAPIToken(formatAPIToken("project", "name", strings.Repeat("0", 64))))
+	require.False(t, IsAPIToken(formatAPIToken("project", "na_me", strings.Repeat("0", 64))))
+	require.False(t, IsAPIToken(formatAPIToken("project", "name", "short")))
+	require.False(t, IsAPIToken("eyJhbGciOiJIUzI1NiJ9.e30.abc"))
+}
+
+func apiTokenSecret(t *testing.T, secretPart string, lastUsedAt *time.Time) *corev1.Secret {
+	t.Helper()
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testProject,
+			Name:      testAPITokenName,
+			Labels: map[string]string{
+				rbacapi.LabelKeyAPIToken: rbacapi.LabelValueTrue,
+			},
+			Annotations: map[string]string{
+				rbacapi.AnnotationKeyAPITokenRole:      testKargoRoleName,
+				rbacapi.AnnotationKeyAPITokenExpiresAt: time.Now().Add(time.Hour).UTC().Format(time.RFC3339),
+			},
+		},
+		Data: map[string][]byte{},
+	}
+	signAPITokenSecret(secret, secretPart)
+	if lastUsedAt != nil {
+		secret.Annotations[rbacapi.AnnotationKeyAPITokenLastUsedAt] = lastUsedAt.UTC().Format(time.RFC3339)
+	}
+	return secret
+}
+
+// signAPITokenSecret signs the provided Secret underlying a Kargo API token as
+// the API server would, using testAPITokenSigningKey.
+func signAPITokenSecret(secret *corev1.Secret, secretPart string) {
+	db := &apiTokensDatabase{signingKey: testAPITokenSigningKey}
+	secret.Data[apiTokenSignatureKey] = []byte(db.signAPIToken(
+		secret.Namespace,
+		secret.Name,
+		secret.Annotations[rbacapi.AnnotationKeyAPITokenRole],
+		secret.Annotations[rbacapi.AnnotationKeyAPITokenExpiresAt],
+		secretPart,
+	))
+}
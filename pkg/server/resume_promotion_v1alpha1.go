@@ -0,0 +1,102 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/api"
+	"github.com/akuity/kargo/pkg/kargo"
+)
+
+// ResumePromotion creates a Promotion which resumes a Failed or Errored
+// Promotion from the specified step, or from the step at which it stopped.
+func (s *server) ResumePromotion(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.ResumePromotionRequest],
+) (*connect.Response[svcv1alpha1.ResumePromotionResponse], error) {
+	project := req.Msg.GetProject()
+	if err := validateFieldNotEmpty("project", project); err != nil {
+		return nil, err
+	}
+	name := req.Msg.GetName()
+	if err := validateFieldNotEmpty("name", name); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateProjectExistsFn(ctx, project); err != nil {
+		return nil, err
+	}
+
+	promo, err := api.GetPromotion(ctx, s.client, types.NamespacedName{
+		Namespace: project,
+		Name:      name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get promotion: %w", err)
+	}
+	if promo == nil {
+		// nolint:staticcheck
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			fmt.Errorf("Promotion %q not found in namespace %q", name, project),
+		)
+	}
+
+	step := promo.Status.CurrentStep
+	if req.Msg.Step != nil {
+		step = req.Msg.GetStep()
+	}
+	if err = api.CanResumePromotion(promo, step); err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	if err = s.authorizeFn(
+		ctx,
+		"promote",
+		schema.GroupVersionResource{
+			Group:    kargoapi.GroupVersion.Group,
+			Version:  kargoapi.GroupVersion.Version,
+			Resource: "stages",
+		},
+		"",
+		types.NamespacedName{
+			Namespace: project,
+			Name:      promo.Spec.Stage,
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	freight, err := api.GetFreight(ctx, s.client, types.NamespacedName{
+		Namespace: project,
+		Name:      promo.Spec.Freight,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get freight: %w", err)
+	}
+	if freight == nil {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			fmt.Errorf("freight %q not found in namespace %q", promo.Spec.Freight, project),
+		)
+	}
+
+	promotion, err := kargo.NewPromotionBuilder(s.client).BuildResume(ctx, *promo, step)
+	if err != nil {
+		return nil, fmt.Errorf("build promotion: %w", err)
+	}
+	if err := s.createPromotionFn(ctx, promotion); err != nil {
+		return nil, fmt.Errorf("create promotion: %w", err)
+	}
+	s.recordPromotionCreatedEvent(ctx, promotion, freight)
+	s.recordPromotionCreatedAudit(ctx, promotion)
+	return connect.NewResponse(&svcv1alpha1.ResumePromotionResponse{
+		Promotion: promotion,
+	}), nil
+}
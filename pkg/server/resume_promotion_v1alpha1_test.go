@@ -0,0 +1,321 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	k8sevent "github.com/akuity/kargo/pkg/event/kubernetes"
+	fakeevent "github.com/akuity/kargo/pkg/kubernetes/event/fake"
+	"github.com/akuity/kargo/pkg/server/kubernetes"
+	"github.com/akuity/kargo/pkg/server/validation"
+)
+
+func TestResumePromotion(t *testing.T) {
+	newPromotion := func(name string, phase kargoapi.PromotionPhase) *kargoapi.Promotion {
+		return &kargoapi.Promotion{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "kargo-demo",
+				Name:      name,
+			},
+			Spec: kargoapi.PromotionSpec{
+				Stage:   "test",
+				Freight: "fake-freight",
+				Steps: []kargoapi.PromotionStep{
+					{Uses: "fake-step"},
+					{Uses: "fake-step"},
+					{Uses: "fake-step"},
+				},
+			},
+			Status: kargoapi.PromotionStatus{
+				Phase:       phase,
+				CurrentStep: 1,
+				Workspace:   &kargoapi.PromotionWorkspace{Retained: true},
+			},
+		}
+	}
+	objects := []client.Object{
+		mustNewObject[corev1.Namespace]("testdata/namespace.yaml"),
+		&kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "kargo-demo",
+				Name:      "fake-freight",
+			},
+		},
+		newPromotion("failed", kargoapi.PromotionPhaseFailed),
+		newPromotion("succeeded", kargoapi.PromotionPhaseSucceeded),
+		func() *kargoapi.Promotion {
+			p := newPromotion("not-retained", kargoapi.PromotionPhaseFailed)
+			p.Status.Workspace.Retained = false
+			return p
+		}(),
+	}
+
+	testCases := map[string]struct {
+		req        *svcv1alpha1.ResumePromotionRequest
+		objects    []client.Object
+		authorized bool
+		createErr  error
+		assertions func(
+			*testing.T,
+			*fakeevent.EventRecorder,
+			*connect.Response[svcv1alpha1.ResumePromotionResponse],
+			error,
+		)
+	}{
+		"empty name": {
+			req: &svcv1alpha1.ResumePromotionRequest{
+				Project: "kargo-demo",
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				res *connect.Response[svcv1alpha1.ResumePromotionResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+				require.Nil(t, res)
+			},
+		},
+		"non-existing project": {
+			req: &svcv1alpha1.ResumePromotionRequest{
+				Project: "kargo-x",
+				Name:    "failed",
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				res *connect.Response[svcv1alpha1.ResumePromotionResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+				require.Nil(t, res)
+			},
+		},
+		"non-existing Promotion": {
+			req: &svcv1alpha1.ResumePromotionRequest{
+				Project: "kargo-demo",
+				Name:    "non-existing",
+			},
+			objects: objects,
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				res *connect.Response[svcv1alpha1.ResumePromotionResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+				require.Nil(t, res)
+			},
+		},
+		"Promotion which can not be resumed": {
+			req: &svcv1alpha1.ResumePromotionRequest{
+				Project: "kargo-demo",
+				Name:    "succeeded",
+			},
+			objects: objects,
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				res *connect.Response[svcv1alpha1.ResumePromotionResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+				require.Nil(t, res)
+			},
+		},
+		"invalid step": {
+			req: &svcv1alpha1.ResumePromotionRequest{
+				Project: "kargo-demo",
+				Name:    "failed",
+				Step:    ptr.To[int64](2),
+			},
+			objects: objects,
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				res *connect.Response[svcv1alpha1.ResumePromotionResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+				require.ErrorContains(t, err, "can only be resumed from steps 0 through 1")
+				require.Nil(t, res)
+			},
+		},
+		"working directory no longer exists": {
+			req: &svcv1alpha1.ResumePromotionRequest{
+				Project: "kargo-demo",
+				Name:    "not-retained",
+			},
+			objects:    objects,
+			authorized: true,
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				res *connect.Response[svcv1alpha1.ResumePromotionResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+				require.ErrorContains(t, err, "no longer exists")
+				require.Nil(t, res)
+			},
+		},
+		"promoting not authorized": {
+			req: &svcv1alpha1.ResumePromotionRequest{
+				Project: "kargo-demo",
+				Name:    "failed",
+			},
+			objects: objects,
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				res *connect.Response[svcv1alpha1.ResumePromotionResponse],
+				err error,
+			) {
+				require.ErrorContains(t, err, "not authorized")
+				require.Nil(t, res)
+			},
+		},
+		"error creating Promotion": {
+			req: &svcv1alpha1.ResumePromotionRequest{
+				Project: "kargo-demo",
+				Name:    "failed",
+			},
+			objects:    objects,
+			authorized: true,
+			createErr:  errors.New("something went wrong"),
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				res *connect.Response[svcv1alpha1.ResumePromotionResponse],
+				err error,
+			) {
+				require.ErrorContains(t, err, "create promotion: something went wrong")
+				require.Nil(t, res)
+			},
+		},
+		"success from the step at which the Promotion stopped": {
+			req: &svcv1alpha1.ResumePromotionRequest{
+				Project: "kargo-demo",
+				Name:    "failed",
+			},
+			objects:    objects,
+			authorized: true,
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				res *connect.Response[svcv1alpha1.ResumePromotionResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				promo := res.Msg.GetPromotion()
+				require.NotNil(t, promo)
+				require.Equal(t, "test", promo.Spec.Stage)
+				require.Equal(t, "fake-freight", promo.Spec.Freight)
+				require.Equal(t, &kargoapi.PromotionResume{
+					Promotion: "failed",
+					Step:      1,
+				}, promo.Spec.ResumeFrom)
+				require.Len(t, recorder.Events, 1)
+				event := <-recorder.Events
+				require.Equal(t, string(kargoapi.EventTypePromotionCreated), event.Reason)
+			},
+		},
+		"success from an earlier step": {
+			req: &svcv1alpha1.ResumePromotionRequest{
+				Project: "kargo-demo",
+				Name:    "failed",
+				Step:    ptr.To[int64](0),
+			},
+			objects:    objects,
+			authorized: true,
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				res *connect.Response[svcv1alpha1.ResumePromotionResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, &kargoapi.PromotionResume{
+					Promotion: "failed",
+					Step:      0,
+				}, res.Msg.GetPromotion().Spec.ResumeFrom)
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			kubeClient, err := kubernetes.NewClient(
+				ctx,
+				&rest.Config{},
+				kubernetes.ClientOptions{
+					SkipAuthorization: true,
+					NewInternalClient: func(
+						_ context.Context,
+						_ *rest.Config,
+						scheme *runtime.Scheme,
+					) (client.Client, error) {
+						c := fake.NewClientBuilder().WithScheme(scheme)
+						if len(testCase.objects) > 0 {
+							c.WithObjects(testCase.objects...)
+						}
+						return c.Build(), nil
+					},
+				},
+			)
+			require.NoError(t, err)
+
+			recorder := fakeevent.NewEventRecorder(1)
+			svr := &server{
+				client:                    kubeClient,
+				externalValidateProjectFn: validation.ValidateProject,
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					if !testCase.authorized {
+						return errors.New("not authorized")
+					}
+					return nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return testCase.createErr
+				},
+				sender: k8sevent.NewEventSender(recorder),
+			}
+			svr.validateProjectExistsFn = svr.validateProjectExists
+			res, err := svr.ResumePromotion(ctx, connect.NewRequest(testCase.req))
+			testCase.assertions(t, recorder, res, err)
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	rbacapi "github.com/akuity/kargo/api/rbac/v1alpha1"
 	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/audit"
 )
 
 func (s *server) Revoke(
@@ -49,6 +50,19 @@ func (s *server) Revoke(
 		)
 	}
 
+	s.auditor.Record(ctx, audit.Entry{
+		Action:  audit.ActionRevokeRole,
+		Project: project,
+		Object: audit.ObjectReference{
+			Kind: "Role",
+			Name: req.Msg.Role,
+		},
+		Details: roleChangeAuditDetails(
+			req.Msg.GetUserClaims(),
+			req.Msg.GetResourceDetails(),
+		),
+	})
+
 	return connect.NewResponse(
 		&svcv1alpha1.RevokeResponse{
 			Role: role,
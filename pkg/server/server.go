@@ -27,9 +27,12 @@ import (
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/pkg/api"
 	rollouts "github.com/akuity/kargo/pkg/api/stubs/rollouts"
+	"github.com/akuity/kargo/pkg/audit"
+	libCreds "github.com/akuity/kargo/pkg/credentials"
 	"github.com/akuity/kargo/pkg/event"
 	httputil "github.com/akuity/kargo/pkg/http"
 	"github.com/akuity/kargo/pkg/logging"
+	"github.com/akuity/kargo/pkg/promotion"
 	"github.com/akuity/kargo/pkg/server/config"
 	"github.com/akuity/kargo/pkg/server/dex"
 	"github.com/akuity/kargo/pkg/server/kubernetes"
@@ -46,10 +49,13 @@ var (
 )
 
 type server struct {
-	cfg     config.ServerConfig
-	client  kubernetes.Client
-	rolesDB rbac.RolesDatabase
-	sender  event.Sender
+	cfg      config.ServerConfig
+	client   kubernetes.Client
+	rolesDB  rbac.RolesDatabase
+	tokensDB rbac.APITokensDatabase
+	credsDB  libCreds.Database
+	sender   event.Sender
+	auditor  *audit.Recorder
 
 	// The following behaviors are overridable for testing purposes:
 
@@ -114,6 +120,11 @@ type server struct {
 		project string,
 		upstreams []string,
 	) ([]kargoapi.Freight, error)
+	getStaleFreightFn func(
+		context.Context,
+		*kargoapi.Stage,
+		[]kargoapi.Freight,
+	) (map[string]string, error)
 
 	// Freight aliasing:
 	patchFreightAliasFn func(
@@ -148,6 +159,29 @@ type server struct {
 		types.NamespacedName,
 	) (*rolloutsapi.AnalysisRun, error)
 
+	// Freight creation:
+	getWarehouseFn func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+	) (*kargoapi.Warehouse, error)
+	discoverArtifactsFn func(
+		context.Context,
+		*kargoapi.Warehouse,
+	) (*kargoapi.DiscoveredArtifacts, error)
+	createFreightFn func(
+		context.Context,
+		client.Object,
+		...client.CreateOption,
+	) error
+
+	// Promotion logs:
+	getPromotionLogsFn func(
+		ctx context.Context,
+		project string,
+		promotion string,
+	) (map[int64][]byte, error)
+
 	// Special authorizations:
 	authorizeFn func(
 		ctx context.Context,
@@ -166,13 +200,19 @@ func NewServer(
 	cfg config.ServerConfig,
 	kubeClient kubernetes.Client,
 	rolesDB rbac.RolesDatabase,
+	tokensDB rbac.APITokensDatabase,
+	credsDB libCreds.Database,
 	sender event.Sender,
+	auditor *audit.Recorder,
 ) Server {
 	s := &server{
-		cfg:     cfg,
-		client:  kubeClient,
-		rolesDB: rolesDB,
-		sender:  sender,
+		cfg:      cfg,
+		client:   kubeClient,
+		rolesDB:  rolesDB,
+		tokensDB: tokensDB,
+		credsDB:  credsDB,
+		sender:   sender,
+		auditor:  auditor,
 	}
 
 	s.validateProjectExistsFn = s.validateProjectExists
@@ -186,12 +226,17 @@ func NewServer(
 	s.getAvailableFreightForStageFn = s.getAvailableFreightForStage
 	s.getFreightFromWarehousesFn = s.getFreightFromWarehouses
 	s.getVerifiedFreightFn = s.getVerifiedFreight
+	s.getStaleFreightFn = s.getStaleFreight
 	s.patchFreightAliasFn = s.patchFreightAlias
 	s.patchFreightStatusFn = s.patchFreightStatus
 	s.authorizeFn = kubeClient.Authorize
 	s.getAnalysisTemplateFn = rollouts.GetAnalysisTemplate
 	s.getClusterAnalysisTemplateFn = rollouts.GetClusterAnalysisTemplate
 	s.getAnalysisRunFn = rollouts.GetAnalysisRun
+	s.getWarehouseFn = api.GetWarehouse
+	s.discoverArtifactsFn = s.discoverArtifacts
+	s.createFreightFn = kubeClient.Create
+	s.getPromotionLogsFn = promotion.NewKubernetesStepLogStore(kubeClient.InternalClient()).Get
 
 	return s
 }
@@ -10,6 +10,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/akuity/kargo/pkg/audit"
+	libCreds "github.com/akuity/kargo/pkg/credentials"
 	k8sevent "github.com/akuity/kargo/pkg/event/kubernetes"
 	fakeevent "github.com/akuity/kargo/pkg/kubernetes/event/fake"
 	"github.com/akuity/kargo/pkg/server/config"
@@ -33,20 +35,28 @@ func TestNewServer(t *testing.T) {
 		},
 	)
 	require.NoError(t, err)
+	testCredsDB := &libCreds.FakeDB{}
 	testSender := k8sevent.NewEventSender(fakeevent.NewEventRecorder(0))
+	testAuditor := audit.NewRecorder()
 
 	s, ok := NewServer(
 		testServerConfig,
 		testClient,
 		rbac.NewKubernetesRolesDatabase(testClient),
+		rbac.NewKubernetesAPITokensDatabase(testClient, nil),
+		testCredsDB,
 		testSender,
+		testAuditor,
 	).(*server)
 
 	require.True(t, ok)
 	require.NotNil(t, s)
 	require.Same(t, testClient, s.client)
 	require.NotNil(t, testClient, s.rolesDB)
+	require.NotNil(t, s.tokensDB)
+	require.Same(t, testCredsDB, s.credsDB)
 	require.Same(t, testSender, s.sender)
+	require.Same(t, testAuditor, s.auditor)
 	require.Equal(t, testServerConfig, s.cfg)
 	require.NotNil(t, s.validateProjectExistsFn)
 	require.NotNil(t, s.externalValidateProjectFn)
@@ -63,4 +73,7 @@ func TestNewServer(t *testing.T) {
 	require.NotNil(t, s.patchFreightStatusFn)
 	require.NotNil(t, s.authorizeFn)
 	require.NotNil(t, s.getAnalysisRunFn)
+	require.NotNil(t, s.getWarehouseFn)
+	require.NotNil(t, s.discoverArtifactsFn)
+	require.NotNil(t, s.createFreightFn)
 }
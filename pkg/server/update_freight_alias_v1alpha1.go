@@ -11,6 +11,7 @@ import (
 
 	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/audit"
 )
 
 // UpdateFreightAlias updates a piece of Freight's human-friendly alias.
@@ -85,9 +86,22 @@ func (s *server) UpdateFreightAlias(
 	}
 
 	// Proceed with the update
+	prevAlias := freight.Alias
 	if err = s.patchFreightAliasFn(ctx, freight, newAlias); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	s.auditor.Record(ctx, audit.Entry{
+		Action:  audit.ActionUpdateFreightAlias,
+		Project: project,
+		Object: audit.ObjectReference{
+			Kind: "Freight",
+			Name: freight.Name,
+		},
+		Details: map[string]string{
+			"oldAlias": prevAlias,
+			"newAlias": newAlias,
+		},
+	})
 
 	return connect.NewResponse(&svcv1alpha1.UpdateFreightAliasResponse{}), nil
 }
@@ -33,6 +33,11 @@ type Info struct {
 	// Username is the username of the user. This is often the email address
 	// of the user, but may be different depending on configuration.
 	Username string
+	// APIToken identifies the Kargo API token, if any, that the user
+	// authenticated with. When this is non-nil, ServiceAccountsByNamespace
+	// contains only the ServiceAccount underlying the Kargo Role the token is
+	// bound to.
+	APIToken *types.NamespacedName
 }
 
 // ContextWithInfo returns a context.Context that has been augmented with
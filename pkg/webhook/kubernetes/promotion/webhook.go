@@ -59,6 +59,13 @@ type webhook struct {
 		types.NamespacedName,
 	) (*kargoapi.Stage, error)
 
+	getStaleFreightFn func(
+		context.Context,
+		client.Client,
+		*kargoapi.Stage,
+		[]kargoapi.Freight,
+	) (map[string]string, error)
+
 	validateProjectFn func(
 		context.Context,
 		client.Client,
@@ -113,6 +120,7 @@ func newWebhook(
 	}
 	w.getFreightFn = api.GetFreight
 	w.getStageFn = api.GetStage
+	w.getStaleFreightFn = api.GetStaleFreight
 	w.validateProjectFn = libWebhook.ValidateProject
 	w.authorizeFn = w.authorize
 	w.admissionRequestFromContextFn = admission.RequestFromContext
@@ -278,6 +286,45 @@ func (w *webhook) ValidateCreate(
 		)
 	}
 
+	staleFreight, err := w.getStaleFreightFn(
+		ctx,
+		w.client,
+		stage,
+		[]kargoapi.Freight{*freight},
+	)
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("get stale freight: %w", err))
+	}
+	if reason, stale := staleFreight[freight.Name]; stale {
+		return nil, apierrors.NewInvalid(
+			promotionGroupKind,
+			promo.Name,
+			field.ErrorList{
+				field.Invalid(
+					field.NewPath("spec", "freight"),
+					promo.Spec.Freight,
+					fmt.Sprintf(
+						"%s; stale Freight can only be promoted to this Stage if it was "+
+							"approved for it with stale Freight explicitly allowed",
+						reason,
+					),
+				),
+			},
+		)
+	}
+
+	if promo.Spec.ResumeFrom != nil {
+		if fieldErr, err := w.validateResumeFrom(ctx, promo); err != nil {
+			return nil, apierrors.NewInternalError(err)
+		} else if fieldErr != nil {
+			return nil, apierrors.NewInvalid(
+				promotionGroupKind,
+				promo.Name,
+				field.ErrorList{fieldErr},
+			)
+		}
+	}
+
 	// Record Promotion created event if the request doesn't come from Kargo controlplane
 	if !w.isRequestFromKargoControlplaneFn(req) {
 		w.recordPromotionCreatedEvent(ctx, req, promo, freight)
@@ -286,6 +333,44 @@ func (w *webhook) ValidateCreate(
 	return nil, nil
 }
 
+// validateResumeFrom validates that the Promotion referenced by the ResumeFrom
+// field of the provided Promotion exists, can be resumed from the referenced
+// step, and has the same Stage, Freight and steps as the provided Promotion.
+func (w *webhook) validateResumeFrom(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+) (*field.Error, error) {
+	fieldPath := field.NewPath("spec", "resumeFrom")
+	resumed, err := api.GetPromotion(ctx, w.client, types.NamespacedName{
+		Namespace: promo.Namespace,
+		Name:      promo.Spec.ResumeFrom.Promotion,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get resumed promotion: %w", err)
+	}
+	if resumed == nil {
+		return field.NotFound(fieldPath.Child("promotion"), promo.Spec.ResumeFrom.Promotion), nil
+	}
+	if resumed.Spec.Stage != promo.Spec.Stage || resumed.Spec.Freight != promo.Spec.Freight {
+		return field.Invalid(
+			fieldPath.Child("promotion"),
+			promo.Spec.ResumeFrom.Promotion,
+			"resumed Promotion must be of the same Freight to the same Stage",
+		), nil
+	}
+	if !reflect.DeepEqual(resumed.Spec.Steps, promo.Spec.Steps) {
+		return field.Invalid(
+			fieldPath.Child("promotion"),
+			promo.Spec.ResumeFrom.Promotion,
+			"resumed Promotion must have the same steps",
+		), nil
+	}
+	if err = api.CanResumePromotion(resumed, promo.Spec.ResumeFrom.Step); err != nil {
+		return field.Invalid(fieldPath.Child("step"), promo.Spec.ResumeFrom.Step, err.Error()), nil
+	}
+	return nil, nil
+}
+
 func (w *webhook) ValidateUpdate(
 	ctx context.Context,
 	oldObj runtime.Object,
@@ -804,6 +804,141 @@ func Test_webhook_ValidateCreate(t *testing.T) {
 				)
 			},
 		},
+		{
+			name: "error getting stale Freight",
+			webhook: &webhook{
+				validateProjectFn: func(
+					context.Context,
+					client.Client,
+					client.Object,
+				) error {
+					return nil
+				},
+				authorizeFn: func(context.Context, *kargoapi.Promotion, string) error {
+					return nil
+				},
+				admissionRequestFromContextFn: admission.RequestFromContext,
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Spec: kargoapi.StageSpec{
+							RequestedFreight: []kargoapi.FreightRequest{{
+								Origin: kargoapi.FreightOrigin{
+									Kind: kargoapi.FreightOriginKindWarehouse,
+									Name: testWarehouse,
+								},
+								Sources: kargoapi.FreightSources{Direct: true},
+							}},
+						},
+					}, nil
+				},
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{Name: "fake-freight"},
+						Origin: kargoapi.FreightOrigin{
+							Kind: kargoapi.FreightOriginKindWarehouse,
+							Name: testWarehouse,
+						},
+					}, nil
+				},
+				getStaleFreightFn: func(
+					context.Context,
+					client.Client,
+					*kargoapi.Stage,
+					[]kargoapi.Freight,
+				) (map[string]string, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ *fakeevent.EventRecorder, err error) {
+				var statusErr *apierrors.StatusError
+				require.True(t, errors.As(err, &statusErr))
+				require.Equal(
+					t,
+					metav1.StatusReasonInternalError,
+					statusErr.ErrStatus.Reason,
+				)
+				require.Contains(t, statusErr.ErrStatus.Message, "something went wrong")
+			},
+		},
+		{
+			name: "Freight is stale",
+			webhook: &webhook{
+				validateProjectFn: func(
+					context.Context,
+					client.Client,
+					client.Object,
+				) error {
+					return nil
+				},
+				authorizeFn: func(context.Context, *kargoapi.Promotion, string) error {
+					return nil
+				},
+				admissionRequestFromContextFn: admission.RequestFromContext,
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Spec: kargoapi.StageSpec{
+							RequestedFreight: []kargoapi.FreightRequest{{
+								Origin: kargoapi.FreightOrigin{
+									Kind: kargoapi.FreightOriginKindWarehouse,
+									Name: testWarehouse,
+								},
+								Sources: kargoapi.FreightSources{Direct: true},
+							}},
+						},
+					}, nil
+				},
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{Name: "fake-freight"},
+						Origin: kargoapi.FreightOrigin{
+							Kind: kargoapi.FreightOriginKindWarehouse,
+							Name: testWarehouse,
+						},
+					}, nil
+				},
+				getStaleFreightFn: func(
+					context.Context,
+					client.Client,
+					*kargoapi.Stage,
+					[]kargoapi.Freight,
+				) (map[string]string, error) {
+					return map[string]string{
+						"fake-freight": "Freight is older than 24h0m0s",
+					}, nil
+				},
+			},
+			assertions: func(t *testing.T, _ *fakeevent.EventRecorder, err error) {
+				var statusErr *apierrors.StatusError
+				require.True(t, errors.As(err, &statusErr))
+				require.Equal(t, metav1.StatusReasonInvalid, statusErr.ErrStatus.Reason)
+				require.Contains(
+					t,
+					statusErr.ErrStatus.Message,
+					"Freight is older than 24h0m0s",
+				)
+				require.Contains(
+					t,
+					statusErr.ErrStatus.Message,
+					"approved for it with stale Freight explicitly allowed",
+				)
+			},
+		},
 		{
 			name: "record promotion created event on non-controlplane request",
 			webhook: &webhook{
@@ -847,6 +982,14 @@ func Test_webhook_ValidateCreate(t *testing.T) {
 						},
 					}, nil
 				},
+				getStaleFreightFn: func(
+					context.Context,
+					client.Client,
+					*kargoapi.Stage,
+					[]kargoapi.Freight,
+				) (map[string]string, error) {
+					return nil, nil
+				},
 				isRequestFromKargoControlplaneFn: libWebhook.IsRequestFromKargoControlplane(
 					regexp.MustCompile("^system:serviceaccount:kargo:(kargo-api|kargo-controller)$"),
 				),
@@ -904,6 +1047,14 @@ func Test_webhook_ValidateCreate(t *testing.T) {
 					}, nil
 				},
 				admissionRequestFromContextFn: admission.RequestFromContext,
+				getStaleFreightFn: func(
+					context.Context,
+					client.Client,
+					*kargoapi.Stage,
+					[]kargoapi.Freight,
+				) (map[string]string, error) {
+					return nil, nil
+				},
 				isRequestFromKargoControlplaneFn: libWebhook.IsRequestFromKargoControlplane(
 					regexp.MustCompile("^system:serviceaccount:kargo:(kargo-api|kargo-controller)$"),
 				),
@@ -941,6 +1092,114 @@ func Test_webhook_ValidateCreate(t *testing.T) {
 	}
 }
 
+func Test_webhook_validateResumeFrom(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	steps := []kargoapi.PromotionStep{{As: "step-0"}, {As: "step-1"}, {As: "step-2"}}
+	resumed := &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "failed-promo",
+		},
+		Spec: kargoapi.PromotionSpec{
+			Stage:   "fake-stage",
+			Freight: "fake-freight",
+			Steps:   steps,
+		},
+		Status: kargoapi.PromotionStatus{
+			Phase:       kargoapi.PromotionPhaseFailed,
+			CurrentStep: 1,
+			Workspace:   &kargoapi.PromotionWorkspace{Retained: true},
+		},
+	}
+	newPromo := func(mutate func(*kargoapi.Promotion)) *kargoapi.Promotion {
+		promo := &kargoapi.Promotion{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-namespace",
+				Name:      "fake-promo",
+			},
+			Spec: kargoapi.PromotionSpec{
+				Stage:   "fake-stage",
+				Freight: "fake-freight",
+				Steps:   steps,
+				ResumeFrom: &kargoapi.PromotionResume{
+					Promotion: "failed-promo",
+					Step:      1,
+				},
+			},
+		}
+		if mutate != nil {
+			mutate(promo)
+		}
+		return promo
+	}
+
+	testCases := []struct {
+		name       string
+		promo      *kargoapi.Promotion
+		assertions func(*testing.T, error)
+	}{
+		{
+			name: "resumed Promotion not found",
+			promo: newPromo(func(p *kargoapi.Promotion) {
+				p.Spec.ResumeFrom.Promotion = "non-existing"
+			}),
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "Not found")
+			},
+		},
+		{
+			name: "different Freight",
+			promo: newPromo(func(p *kargoapi.Promotion) {
+				p.Spec.Freight = "other-freight"
+			}),
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "same Freight to the same Stage")
+			},
+		},
+		{
+			name: "different steps",
+			promo: newPromo(func(p *kargoapi.Promotion) {
+				p.Spec.Steps = steps[:2]
+			}),
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "same steps")
+			},
+		},
+		{
+			name: "step beyond the step at which the Promotion stopped",
+			promo: newPromo(func(p *kargoapi.Promotion) {
+				p.Spec.ResumeFrom.Step = 2
+			}),
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "can only be resumed from steps 0 through 1")
+			},
+		},
+		{
+			name:  "valid",
+			promo: newPromo(nil),
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			w := &webhook{
+				client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(resumed).Build(),
+			}
+			fieldErr, err := w.validateResumeFrom(context.Background(), testCase.promo)
+			require.NoError(t, err)
+			if fieldErr != nil {
+				testCase.assertions(t, fieldErr)
+				return
+			}
+			testCase.assertions(t, nil)
+		})
+	}
+}
+
 func Tes_webhook_tValidateUpdate(t *testing.T) {
 	testCases := []struct {
 		name        string
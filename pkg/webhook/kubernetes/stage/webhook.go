@@ -169,6 +169,13 @@ func (w *webhook) ValidateCreate(
 		}
 		errs = append(errs, fieldErr)
 	}
+	agentErr, err := w.validateAgent(ctx, field.NewPath("spec", "agent"), stage)
+	if err != nil {
+		return nil, apierrors.NewInternalError(err)
+	}
+	if agentErr != nil {
+		errs = append(errs, agentErr)
+	}
 	if errs = append(
 		errs,
 		w.validateSpecFn(field.NewPath("spec"), stage.Spec)...,
@@ -179,12 +186,29 @@ func (w *webhook) ValidateCreate(
 }
 
 func (w *webhook) ValidateUpdate(
-	_ context.Context,
-	_ runtime.Object,
+	ctx context.Context,
+	oldObj runtime.Object,
 	newObj runtime.Object,
 ) (admission.Warnings, error) {
-	stage := newObj.(*kargoapi.Stage) // nolint: forcetypeassert
-	if errs := w.validateSpecFn(field.NewPath("spec"), stage.Spec); len(errs) > 0 {
+	oldStage := oldObj.(*kargoapi.Stage) // nolint: forcetypeassert
+	stage := newObj.(*kargoapi.Stage)    // nolint: forcetypeassert
+	var errs field.ErrorList
+	// The agent is only validated when it changes, so that a Stage can still
+	// be updated (e.g. to remove the agent) after the agent stopped serving
+	// the Stage's Project. Steps are never dispatched to such an agent.
+	if stage.Spec.Agent != oldStage.Spec.Agent {
+		agentErr, err := w.validateAgent(ctx, field.NewPath("spec", "agent"), stage)
+		if err != nil {
+			return nil, apierrors.NewInternalError(err)
+		}
+		if agentErr != nil {
+			errs = append(errs, agentErr)
+		}
+	}
+	if errs = append(
+		errs,
+		w.validateSpecFn(field.NewPath("spec"), stage.Spec)...,
+	); len(errs) > 0 {
 		return nil, apierrors.NewInvalid(stageGroupKind, stage.Name, errs)
 	}
 	return nil, nil
@@ -227,6 +251,33 @@ func (w *webhook) validateSpec(
 	return errs
 }
 
+// validateAgent validates that the agent specified by the provided Stage, if
+// any, serves the Stage's Project according to the ClusterConfig.
+func (w *webhook) validateAgent(
+	ctx context.Context,
+	f *field.Path,
+	stage *kargoapi.Stage,
+) (*field.Error, error) {
+	if stage.Spec.Agent == "" {
+		return nil, nil
+	}
+	clusterCfg, err := api.GetClusterConfig(ctx, w.client)
+	if err != nil {
+		return nil, err
+	}
+	if clusterCfg == nil || !clusterCfg.Spec.ServesProject(stage.Spec.Agent, stage.Namespace) {
+		return field.Forbidden(
+			f,
+			fmt.Sprintf(
+				"agent %q does not serve Project %q; agents must be mapped to the "+
+					"Projects they serve in the ClusterConfig",
+				stage.Spec.Agent, stage.Namespace,
+			),
+		), nil
+	}
+	return nil, nil
+}
+
 func (w *webhook) validateRequestedFreight(
 	f *field.Path,
 	reqs []kargoapi.FreightRequest,
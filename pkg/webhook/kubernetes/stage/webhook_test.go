@@ -956,12 +956,126 @@ func Test_webhook_ValidateUpdate(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			_, err := testCase.webhook.ValidateUpdate(
 				context.Background(),
-				nil,
+				&kargoapi.Stage{},
 				&kargoapi.Stage{},
 			)
 			testCase.assertions(t, err)
 		})
 	}
+
+	t.Run("agent is only validated when changed", func(t *testing.T) {
+		scheme := runtime.NewScheme()
+		require.NoError(t, kargoapi.AddToScheme(scheme))
+		w := &webhook{
+			client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+			validateSpecFn: func(*field.Path, kargoapi.StageSpec) field.ErrorList {
+				return nil
+			},
+		}
+		stage := &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fake-project", Name: "fake-stage"},
+			Spec:       kargoapi.StageSpec{Agent: "fake-agent"},
+		}
+
+		_, err := w.ValidateUpdate(context.Background(), stage.DeepCopy(), stage)
+		require.NoError(t, err)
+
+		_, err = w.ValidateUpdate(context.Background(), &kargoapi.Stage{}, stage)
+		require.ErrorContains(t, err, `agent "fake-agent" does not serve Project "fake-project"`)
+	})
+}
+
+func Test_webhook_validateAgent(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	clusterCfg := &kargoapi.ClusterConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: api.ClusterConfigName},
+		Spec: kargoapi.ClusterConfigSpec{
+			Agents: []kargoapi.AgentConfig{{
+				Name:     "fake-agent",
+				Projects: []string{"fake-project"},
+			}},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		objects    []client.Object
+		agent      string
+		project    string
+		assertions func(*testing.T, *field.Error, error)
+	}{
+		{
+			name:    "no agent specified",
+			project: "fake-project",
+			assertions: func(t *testing.T, fieldErr *field.Error, err error) {
+				require.NoError(t, err)
+				require.Nil(t, fieldErr)
+			},
+		},
+		{
+			name:    "no ClusterConfig",
+			agent:   "fake-agent",
+			project: "fake-project",
+			assertions: func(t *testing.T, fieldErr *field.Error, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, fieldErr)
+				require.Equal(t, field.ErrorTypeForbidden, fieldErr.Type)
+			},
+		},
+		{
+			name:    "agent not mapped",
+			objects: []client.Object{clusterCfg},
+			agent:   "other-agent",
+			project: "fake-project",
+			assertions: func(t *testing.T, fieldErr *field.Error, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, fieldErr)
+				require.Contains(t, fieldErr.Detail, `agent "other-agent" does not serve`)
+			},
+		},
+		{
+			name:    "agent does not serve Project",
+			objects: []client.Object{clusterCfg},
+			agent:   "fake-agent",
+			project: "other-project",
+			assertions: func(t *testing.T, fieldErr *field.Error, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, fieldErr)
+				require.Contains(t, fieldErr.Detail, `does not serve Project "other-project"`)
+			},
+		},
+		{
+			name:    "agent serves Project",
+			objects: []client.Object{clusterCfg},
+			agent:   "fake-agent",
+			project: "fake-project",
+			assertions: func(t *testing.T, fieldErr *field.Error, err error) {
+				require.NoError(t, err)
+				require.Nil(t, fieldErr)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			w := &webhook{
+				client: fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(testCase.objects...).
+					Build(),
+			}
+			fieldErr, err := w.validateAgent(
+				context.Background(),
+				field.NewPath("spec", "agent"),
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{Namespace: testCase.project},
+					Spec:       kargoapi.StageSpec{Agent: testCase.agent},
+				},
+			)
+			testCase.assertions(t, fieldErr, err)
+		})
+	}
 }
 
 func Test_webhook_ValidateDelete(t *testing.T) {
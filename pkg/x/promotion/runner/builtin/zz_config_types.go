@@ -7,7 +7,35 @@ type CommonDefs interface{}
 type ComposeOutput map[string]interface{}
 
 type ArgoCDUpdateConfig struct {
-	Apps []ArgoCDAppUpdate `json:"apps"`
+	ApplicationSets []ArgoCDAppSetUpdate `json:"applicationSets,omitempty"`
+	Apps            []ArgoCDAppUpdate    `json:"apps,omitempty"`
+}
+
+type ArgoCDAppSetUpdate struct {
+	// Specifies the name of an Argo CD ApplicationSet resource to be updated.
+	Name string `json:"name"`
+	// Specifies the namespace of an Argo CD ApplicationSet resource to be updated. If left
+	// unspecified, the namespace will be the controller's configured default.
+	Namespace string `json:"namespace,omitempty"`
+	// Describes updates to be applied to the elements of the ApplicationSet's List generators,
+	// including those nested within Matrix or Merge generators.
+	Parameters []ArgoCDAppSetParameterUpdate `json:"parameters,omitempty"`
+	// Describes updates to be applied to various sources of the ApplicationSet's Application
+	// template.
+	Sources []ArgoCDAppSourceUpdate `json:"sources,omitempty"`
+}
+
+// Describes how to update a parameter of the elements of an ApplicationSet's List
+// generators.
+type ArgoCDAppSetParameterUpdate struct {
+	// Specifies the key of the parameter to be updated. Nested keys may be specified using dot
+	// notation.
+	Key string `json:"key"`
+	// If specified, only elements having all of these top-level key/value pairs are updated.
+	// Otherwise, all elements are updated.
+	Match map[string]string `json:"match,omitempty"`
+	// Specifies the new value of the parameter.
+	Value string `json:"value"`
 }
 
 type ArgoCDAppUpdate struct {
@@ -98,6 +126,45 @@ type DeleteConfig struct {
 	Strict bool `json:"strict,omitempty"`
 }
 
+type FluxReconcileConfig struct {
+	// Describes the Flux resources to be reconciled, in order. Each resource must become ready
+	// before the next is reconciled.
+	Resources []FluxResourceReconcile `json:"resources"`
+}
+
+type FluxResourceReconcile struct {
+	// The version of the chart a HelmRelease should be updated to. Only applicable to
+	// HelmRelease resources.
+	ChartVersion string `json:"chartVersion,omitempty"`
+	// The kind of the Flux resource to be reconciled.
+	Kind FluxResourceKind `json:"kind"`
+	// The name of the Flux resource to be reconciled.
+	Name string `json:"name"`
+	// The namespace of the Flux resource to be reconciled. If left unspecified, the namespace
+	// will be the controller's configured default Flux namespace.
+	Namespace string         `json:"namespace,omitempty"`
+	Ref       *FluxSourceRef `json:"ref,omitempty"`
+}
+
+// The reference a GitRepository or OCIRepository should be updated to. Replaces the
+// resource's existing reference in its entirety. Only applicable to GitRepository and
+// OCIRepository resources.
+type FluxSourceRef struct {
+	// The Git branch to check out. Only applicable to GitRepository resources.
+	Branch string `json:"branch,omitempty"`
+	// The Git commit SHA to check out. Only applicable to GitRepository resources.
+	Commit string `json:"commit,omitempty"`
+	// The OCI artifact digest to pull. Only applicable to OCIRepository resources.
+	Digest string `json:"digest,omitempty"`
+	// The Git reference name (e.g. refs/tags/v1.0.0) to check out. Only applicable to
+	// GitRepository resources.
+	Name string `json:"name,omitempty"`
+	// The semantic version range used to select a Git tag or OCI artifact tag.
+	Semver string `json:"semver,omitempty"`
+	// The Git tag or OCI artifact tag to use.
+	Tag string `json:"tag,omitempty"`
+}
+
 type GitClearConfig struct {
 	// Path to a working directory of a local repository from which to remove all files,
 	// excluding the .git/ directory.
@@ -114,6 +181,11 @@ type GitCloneConfig struct {
 	Checkout []Checkout `json:"checkout"`
 	// Indicates whether to skip TLS verification when cloning the repository. Default is false.
 	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
+	// Optionally performs a partial clone of the repository. 'blobless' omits file contents and
+	// 'treeless' omits both file contents and directory listings until they are needed by a
+	// checkout. Partial clones are most useful for very large repositories, especially in
+	// combination with sparse checkouts. If not specified, a full clone is performed.
+	PartialClone *PartialClone `json:"partialClone,omitempty"`
 	// The URL of a remote Git repository to clone. Required.
 	RepoURL string `json:"repoURL"`
 }
@@ -145,6 +217,10 @@ type Checkout struct {
 	Create bool `json:"create,omitempty"`
 	// The path where the repository should be checked out.
 	Path string `json:"path"`
+	// Directories to check out. If specified, only files in the repository's root directory and
+	// within these directories are checked out. If not specified, the entire repository is
+	// checked out.
+	Sparse []string `json:"sparse,omitempty"`
 	// The tag to checkout. Mutually exclusive with 'branch' and 'commit'. If none of these are
 	// specified, the default branch is checked out.
 	Tag string `json:"tag,omitempty"`
@@ -253,6 +329,20 @@ type GitWaitForPRConfig struct {
 	RepoURL string `json:"repoURL"`
 }
 
+type HelmSetValuesConfig struct {
+	// The path to the directory containing the Helm chart.
+	Path string `json:"path"`
+	// Whether to update the chart's dependencies (and its Chart.lock file) after updating the
+	// values file. Default is false.
+	UpdateDependencies bool `json:"updateDependencies,omitempty"`
+	// Values to merge into the values file. Nested objects are merged with existing values,
+	// while all other values (including lists) replace existing values.
+	Values map[string]interface{} `json:"values"`
+	// The path to the values file to update, relative to the chart directory. If not
+	// specified, the chart's values.yaml file is updated.
+	ValuesFile string `json:"valuesFile,omitempty"`
+}
+
 type HelmTemplateConfig struct {
 	// APIVersions allows a manual set of supported API Versions to be passed when rendering the
 	// manifests.
@@ -450,6 +540,100 @@ type HelmClass struct {
 	KubeVersion string `json:"kubeVersion,omitempty"`
 }
 
+type KustomizeEditConfig struct {
+	// Components to add to the Kustomization's 'components' field. Components that are already
+	// present are not added again.
+	AddComponents []string `json:"addComponents,omitempty"`
+	// Resources (files, directories, or URLs) to add to the Kustomization's 'resources' field.
+	// Resources that are already present are not added again.
+	AddResources []string `json:"addResources,omitempty"`
+	// Annotations to set in the Kustomization's 'commonAnnotations' field. Existing annotations
+	// with the same keys are overwritten and all other existing annotations are preserved.
+	CommonAnnotations map[string]string `json:"commonAnnotations,omitempty"`
+	// Labels to set in the Kustomization's 'commonLabels' field. Existing labels with the same
+	// keys are overwritten and all other existing labels are preserved.
+	CommonLabels map[string]string `json:"commonLabels,omitempty"`
+	// Versions to set for charts inflated by the Kustomization's 'helmCharts' field. Each chart
+	// must already be present in the Kustomization.
+	HelmCharts []KustomizeHelmChart `json:"helmCharts,omitempty"`
+	// Prefix to set in the Kustomization's 'namePrefix' field.
+	NamePrefix string `json:"namePrefix,omitempty"`
+	// Suffix to set in the Kustomization's 'nameSuffix' field.
+	NameSuffix string `json:"nameSuffix,omitempty"`
+	// Namespace to set in the Kustomization's 'namespace' field.
+	Namespace string `json:"namespace,omitempty"`
+	// Patches to add to the Kustomization's 'patches' field. Patches identical to ones that are
+	// already present are not added again.
+	Patches []KustomizePatch `json:"patches,omitempty"`
+	// Path to the directory containing the Kustomization file.
+	Path string `json:"path"`
+	// Components to remove from the Kustomization's 'components' field. Components that are not
+	// present are ignored.
+	RemoveComponents []string `json:"removeComponents,omitempty"`
+	// Resources to remove from the Kustomization's 'resources' field. Resources that are not
+	// present are ignored.
+	RemoveResources []string `json:"removeResources,omitempty"`
+	// Replica counts to set in the Kustomization's 'replicas' field. Existing replica counts for
+	// resources with the same names are overwritten.
+	Replicas []KustomizeReplica `json:"replicas,omitempty"`
+}
+
+type KustomizeHelmChart struct {
+	// Name of the chart.
+	Name string `json:"name"`
+	// Release name of the chart. Only required to disambiguate between multiple inflations of
+	// the same chart. If not specified, the version of every inflation of the chart is set.
+	ReleaseName string `json:"releaseName,omitempty"`
+	// The version of the chart to set.
+	Version string `json:"version"`
+}
+
+type KustomizePatch struct {
+	// Options for the patch.
+	Options *KustomizePatchOptions `json:"options,omitempty"`
+	// Inline content of a strategic merge patch or JSON 6902 patch. Mutually exclusive with
+	// 'path'.
+	Patch string `json:"patch,omitempty"`
+	// Path to a file containing the patch, relative to the Kustomization file. Mutually
+	// exclusive with 'patch'.
+	Path string `json:"path,omitempty"`
+	// Selects the resources the patch is applied to.
+	Target *KustomizePatchTarget `json:"target,omitempty"`
+}
+
+// Options for the patch.
+type KustomizePatchOptions struct {
+	// Whether the patch may change the kinds of the resources it is applied to.
+	AllowKindChange bool `json:"allowKindChange,omitempty"`
+	// Whether the patch may change the names of the resources it is applied to.
+	AllowNameChange bool `json:"allowNameChange,omitempty"`
+}
+
+// Selects the resources the patch is applied to.
+type KustomizePatchTarget struct {
+	// Annotation selector the resources to select must match.
+	AnnotationSelector string `json:"annotationSelector,omitempty"`
+	// API group of the resources to select.
+	Group string `json:"group,omitempty"`
+	// Kind of the resources to select.
+	Kind string `json:"kind,omitempty"`
+	// Label selector the resources to select must match.
+	LabelSelector string `json:"labelSelector,omitempty"`
+	// Name (or regular expression matching the names) of the resources to select.
+	Name string `json:"name,omitempty"`
+	// Namespace of the resources to select.
+	Namespace string `json:"namespace,omitempty"`
+	// API version of the resources to select.
+	Version string `json:"version,omitempty"`
+}
+
+type KustomizeReplica struct {
+	// The replica count.
+	Count int64 `json:"count"`
+	// Name of the resource whose replica count should be set.
+	Name string `json:"name"`
+}
+
 type KustomizeSetImageConfig struct {
 	// Images is a list of container images to set or update in the Kustomization file. When
 	// left unspecified, all images from the Freight collection will be set in the Kustomization
@@ -491,6 +675,28 @@ type OCIDownloadConfig struct {
 	OutPath string `json:"outPath"`
 }
 
+type RunJobConfig struct {
+	// The spec of the Kubernetes Job to run. This has the same structure as the spec field of a
+	// Job resource. If backoffLimit is not specified, it defaults to 0, so that a failed Job is
+	// retried according to the step's retry configuration instead of by Kubernetes.
+	Spec map[string]interface{} `json:"spec"`
+	// Mounts the contents of a directory in the promotion's working directory into every
+	// container of the Job. The contents are copied into a ConfigMap, so they are read-only and
+	// limited to 1MiB in total.
+	WorkDir *WorkDir `json:"workDir,omitempty"`
+}
+
+// Mounts the contents of a directory in the promotion's working directory into every
+// container of the Job. The contents are copied into a ConfigMap, so they are read-only and
+// limited to 1MiB in total.
+type WorkDir struct {
+	// The absolute path at which the directory is mounted in the Job's containers.
+	MountPath string `json:"mountPath"`
+	// The path to the directory to mount, relative to the promotion's working directory. If not
+	// specified, the entire working directory is mounted. .git directories are never mounted.
+	Path string `json:"path,omitempty"`
+}
+
 type SetMetadataConfig struct {
 	// List of metadata updates to apply to various resources
 	Updates []Update `json:"updates"`
@@ -547,6 +753,28 @@ type YAMLUpdate struct {
 	Value interface{} `json:"value"`
 }
 
+// The kind of the Flux resource to be reconciled.
+type FluxResourceKind string
+
+const (
+	GitRepository  FluxResourceKind = "GitRepository"
+	HelmRelease    FluxResourceKind = "HelmRelease"
+	HelmRepository FluxResourceKind = "HelmRepository"
+	Kustomization  FluxResourceKind = "Kustomization"
+	OCIRepository  FluxResourceKind = "OCIRepository"
+)
+
+// Optionally performs a partial clone of the repository. 'blobless' omits file contents and
+// 'treeless' omits both file contents and directory listings until they are needed by a
+// checkout. Partial clones are most useful for very large repositories, especially in
+// combination with sparse checkouts. If not specified, a full clone is performed.
+type PartialClone string
+
+const (
+	Blobless PartialClone = "blobless"
+	Treeless PartialClone = "treeless"
+)
+
 // The name of the Git provider to use. Currently 'azure', 'bitbucket', 'gitea', 'github',
 // and 'gitlab' are supported. Kargo will try to infer the provider if it is not explicitly
 // specified.
@@ -2,6 +2,8 @@ package yaml
 
 import (
 	"fmt"
+	"maps"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -23,6 +25,63 @@ func UpdateField(node *yaml.Node, key string, value any) error {
 	return updateNodeRecursively(node, parts, value)
 }
 
+// MergeValues deep merges the provided values into a YAML mapping. Nested maps
+// are merged recursively, while all other values (including sequences) replace
+// any existing value for the same key. Keys that do not exist are created. The
+// YAML node is modified in place, preserving comments and style. An empty
+// document is treated as an empty mapping.
+func MergeValues(node *yaml.Node, values map[string]any) error {
+	switch node.Kind {
+	case 0:
+		*node = yaml.Node{
+			Kind:    yaml.DocumentNode,
+			Content: []*yaml.Node{{Kind: yaml.MappingNode}},
+		}
+		return MergeValues(node.Content[0], values)
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			node.Content = []*yaml.Node{{Kind: yaml.MappingNode}}
+		}
+		return MergeValues(node.Content[0], values)
+	case yaml.AliasNode:
+		return MergeValues(node.Alias, values)
+	case yaml.MappingNode:
+	default:
+		return fmt.Errorf("cannot merge values into node of kind %v", node.Kind)
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(values)) {
+		value := values[key]
+		var existing *yaml.Node
+		for i := 0; i < len(node.Content); i += 2 {
+			if node.Content[i].Value == key {
+				existing = node.Content[i+1]
+				break
+			}
+		}
+		if existing == nil {
+			if err := addNewField(node, key, nil, value); err != nil {
+				return err
+			}
+			continue
+		}
+		target := existing
+		if target.Kind == yaml.AliasNode {
+			target = target.Alias
+		}
+		if nested, ok := value.(map[string]any); ok && target.Kind == yaml.MappingNode {
+			if err := MergeValues(target, nested); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := updateNodeInPlace(existing, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // updateNodeRecursively traverses the YAML node structure and updates or adds
 // the specified field. It recursively descends into mapping nodes and sequence
 // nodes to find the target field. If the field does not exist, it is created.
@@ -528,6 +528,114 @@ root:
 	}
 }
 
+func TestMergeValues(t *testing.T) {
+	tests := []struct {
+		name       string
+		yaml       string
+		values     map[string]any
+		assertions func(*testing.T, string, error)
+	}{
+		{
+			name:   "empty document",
+			yaml:   "",
+			values: map[string]any{"key": "value"},
+			assertions: func(t *testing.T, result string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "key: value\n", result)
+			},
+		},
+		{
+			name: "merge nested maps preserving comments",
+			yaml: `# Image settings
+image:
+    repository: nginx # The repository
+    tag: 1.0.0 # The tag
+replicas: 1
+`,
+			values: map[string]any{
+				"image": map[string]any{
+					"tag": "2.0.0",
+				},
+				"resources": map[string]any{
+					"limits": map[string]any{"cpu": "100m"},
+				},
+			},
+			assertions: func(t *testing.T, result string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, `# Image settings
+image:
+    repository: nginx # The repository
+    tag: 2.0.0 # The tag
+replicas: 1
+resources:
+    limits:
+        cpu: 100m
+`, result)
+			},
+		},
+		{
+			name: "sequences and scalars are replaced",
+			yaml: `hosts:
+    - a.example.com
+    - b.example.com
+config:
+    enabled: false
+`,
+			values: map[string]any{
+				"hosts":  []any{"c.example.com"},
+				"config": "disabled",
+			},
+			assertions: func(t *testing.T, result string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, `hosts:
+    - c.example.com
+config: disabled
+`, result)
+			},
+		},
+		{
+			name: "keys containing dots",
+			yaml: `annotations:
+    example.com/owner: old
+`,
+			values: map[string]any{
+				"annotations": map[string]any{"example.com/owner": "new"},
+			},
+			assertions: func(t *testing.T, result string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, `annotations:
+    example.com/owner: new
+`, result)
+			},
+		},
+		{
+			name:   "document is not a mapping",
+			yaml:   "- item",
+			values: map[string]any{"key": "value"},
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "cannot merge values into node")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var node yaml.Node
+			err := yaml.Unmarshal([]byte(tt.yaml), &node)
+			require.NoError(t, err)
+
+			err = MergeValues(&node, tt.values)
+			var result string
+			if err == nil {
+				output, marshalErr := yaml.Marshal(&node)
+				require.NoError(t, marshalErr)
+				result = string(output)
+			}
+			tt.assertions(t, result, err)
+		})
+	}
+}
+
 func TestSplitKey(t *testing.T) {
 	testCases := []struct {
 		name        string
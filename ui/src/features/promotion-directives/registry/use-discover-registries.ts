@@ -4,19 +4,23 @@ import { JSONSchema7 } from 'json-schema';
 import argocdUpdateConfig from '@ui/gen/directives/argocd-update-config.json';
 import copyConfig from '@ui/gen/directives/copy-config.json';
 import deleteConfig from '@ui/gen/directives/delete-config.json';
+import fluxReconcileConfig from '@ui/gen/directives/flux-reconcile-config.json';
 import gitOverwriteConfig from '@ui/gen/directives/git-clear-config.json';
 import gitCloneConfig from '@ui/gen/directives/git-clone-config.json';
 import gitCommitConfig from '@ui/gen/directives/git-commit-config.json';
 import gitOpenPR from '@ui/gen/directives/git-open-pr-config.json';
 import gitPushConfig from '@ui/gen/directives/git-push-config.json';
 import gitWaitForPR from '@ui/gen/directives/git-wait-for-pr-config.json';
+import helmSetValuesConfig from '@ui/gen/directives/helm-set-values-config.json';
 import helmTemplateConfig from '@ui/gen/directives/helm-template-config.json';
 import helmUpdateChartConfig from '@ui/gen/directives/helm-update-chart-config.json';
 import httpConfig from '@ui/gen/directives/http-config.json';
 import jsonParseConfig from '@ui/gen/directives/json-parse-config.json';
 import jsonUpdateConfig from '@ui/gen/directives/json-update-config.json';
 import kustomizeBuildConfig from '@ui/gen/directives/kustomize-build-config.json';
+import kustomizeEditConfig from '@ui/gen/directives/kustomize-edit-config.json';
 import kustomizeSetImageConfig from '@ui/gen/directives/kustomize-set-image-config.json';
+import runJobConfig from '@ui/gen/directives/run-job-config.json';
 import yamlParseConfig from '@ui/gen/directives/yaml-parse-config.json';
 import yamlUpdateConfig from '@ui/gen/directives/yaml-update-config.json';
 
@@ -41,6 +45,10 @@ export const useDiscoverPromotionDirectivesRegistries = (): PromotionDirectivesR
         unstable_icons: [],
         config: deleteConfig as JSONSchema7
       },
+      {
+        identifier: 'flux-reconcile',
+        config: fluxReconcileConfig as JSONSchema7
+      },
       {
         identifier: 'git-clone',
         config: gitCloneConfig as JSONSchema7
@@ -89,6 +97,10 @@ export const useDiscoverPromotionDirectivesRegistries = (): PromotionDirectivesR
         identifier: 'helm-update-chart',
         config: helmUpdateChartConfig as JSONSchema7
       },
+      {
+        identifier: 'helm-set-values',
+        config: helmSetValuesConfig as JSONSchema7
+      },
       {
         identifier: 'helm-template',
         config: helmTemplateConfig as JSONSchema7
@@ -97,6 +109,10 @@ export const useDiscoverPromotionDirectivesRegistries = (): PromotionDirectivesR
         identifier: 'kustomize-build',
         config: kustomizeBuildConfig as JSONSchema7
       },
+      {
+        identifier: 'kustomize-edit',
+        config: kustomizeEditConfig as JSONSchema7
+      },
       {
         identifier: 'kustomize-set-image',
         config: kustomizeSetImageConfig as JSONSchema7
@@ -104,6 +120,10 @@ export const useDiscoverPromotionDirectivesRegistries = (): PromotionDirectivesR
       {
         identifier: 'http',
         config: httpConfig as JSONSchema7
+      },
+      {
+        identifier: 'run-job',
+        config: runJobConfig as JSONSchema7
       }
     ]
   };
@@ -0,0 +1,5 @@
+// @generated by protoc-gen-connect-query v2.0.0 with parameter "target=ts"
+// @generated from file api/agent/v1alpha1/agent.proto (package akuity.io.kargo.agent.v1alpha1, syntax proto3)
+/* eslint-disable */
+
+import { AgentService } from "./agent_pb";
@@ -0,0 +1,233 @@
+// @generated by protoc-gen-es v2.2.2 with parameter "target=ts"
+// @generated from file api/agent/v1alpha1/agent.proto (package akuity.io.kargo.agent.v1alpha1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv1";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv1";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file api/agent/v1alpha1/agent.proto.
+ */
+export const file_api_agent_v1alpha1_agent: GenFile = /*@__PURE__*/
+  fileDesc("Ch5hcGkvYWdlbnQvdjFhbHBoYTEvYWdlbnQucHJvdG8SHmFrdWl0eS5pby5rYXJnby5hZ2VudC52MWFscGhhMSKWAQoOQ29ubmVjdFJlcXVlc3QSNgoFaGVsbG8YASABKAsyJS5ha3VpdHkuaW8ua2FyZ28uYWdlbnQudjFhbHBoYTEuSGVsbG9IABJBCgtzdGVwX3Jlc3VsdBgCIAEoCzIqLmFrdWl0eS5pby5rYXJnby5hZ2VudC52MWFscGhhMS5TdGVwUmVzdWx0SABCCQoHbWVzc2FnZSKkAQoPQ29ubmVjdFJlc3BvbnNlEkMKDHN0ZXBfcmVxdWVzdBgBIAEoCzIrLmFrdWl0eS5pby5rYXJnby5hZ2VudC52MWFscGhhMS5TdGVwUmVxdWVzdEgAEkEKC2NhbmNlbF9zdGVwGAIgASgLMiouYWt1aXR5LmlvLmthcmdvLmFnZW50LnYxYWxwaGExLkNhbmNlbFN0ZXBIAEIJCgdtZXNzYWdlIhgKBUhlbGxvEg8KB3ZlcnNpb24YASABKAkiKgoLU3RlcFJlcXVlc3QSCgoCaWQYASABKAkSDwoHcmVxdWVzdBgCIAEoDCIYCgpDYW5jZWxTdGVwEgoKAmlkGAEgASgJIkkKClN0ZXBSZXN1bHQSCgoCaWQYASABKAkSDgoGcmVzdWx0GAIgASgMEg0KBWVycm9yGAMgASgJEhAKCHRlcm1pbmFsGAQgASgIMn4KDEFnZW50U2VydmljZRJuCgdDb25uZWN0Ei4uYWt1aXR5LmlvLmthcmdvLmFnZW50LnYxYWxwaGExLkNvbm5lY3RSZXF1ZXN0Gi8uYWt1aXR5LmlvLmthcmdvLmFnZW50LnYxYWxwaGExLkNvbm5lY3RSZXNwb25zZSgBMAFChwIKImNvbS5ha3VpdHkuaW8ua2FyZ28uYWdlbnQudjFhbHBoYTFCCkFnZW50UHJvdG9QAVo4Z2l0aHViLmNvbS9ha3VpdHkva2FyZ28vYXBpL2FnZW50L3YxYWxwaGExO2FnZW50djFhbHBoYTGiAgRBSUtBqgIeQWt1aXR5LklvLkthcmdvLkFnZW50LlYxYWxwaGExygIeQWt1aXR5XElvXEthcmdvXEFnZW50XFYxYWxwaGEx4gIqQWt1aXR5XElvXEthcmdvXEFnZW50XFYxYWxwaGExXEdQQk1ldGFkYXRh6gIiQWt1aXR5OjpJbzo6S2FyZ286OkFnZW50OjpWMWFscGhhMWIGcHJvdG8z");
+
+/**
+ * ConnectRequest is a message sent by an agent to the controller.
+ *
+ * @generated from message akuity.io.kargo.agent.v1alpha1.ConnectRequest
+ */
+export type ConnectRequest = Message<"akuity.io.kargo.agent.v1alpha1.ConnectRequest"> & {
+  /**
+   * @generated from oneof akuity.io.kargo.agent.v1alpha1.ConnectRequest.message
+   */
+  message: {
+    /**
+     * hello announces the agent. It is the first message sent by an agent.
+     *
+     * @generated from field: akuity.io.kargo.agent.v1alpha1.Hello hello = 1;
+     */
+    value: Hello;
+    case: "hello";
+  } | {
+    /**
+     * step_result is the result of the execution of a step.
+     *
+     * @generated from field: akuity.io.kargo.agent.v1alpha1.StepResult step_result = 2;
+     */
+    value: StepResult;
+    case: "stepResult";
+  } | { case: undefined; value?: undefined };
+};
+
+/**
+ * Describes the message akuity.io.kargo.agent.v1alpha1.ConnectRequest.
+ * Use `create(ConnectRequestSchema)` to create a new message.
+ */
+export const ConnectRequestSchema: GenMessage<ConnectRequest> = /*@__PURE__*/
+  messageDesc(file_api_agent_v1alpha1_agent, 0);
+
+/**
+ * ConnectResponse is a message sent by the controller to an agent.
+ *
+ * @generated from message akuity.io.kargo.agent.v1alpha1.ConnectResponse
+ */
+export type ConnectResponse = Message<"akuity.io.kargo.agent.v1alpha1.ConnectResponse"> & {
+  /**
+   * @generated from oneof akuity.io.kargo.agent.v1alpha1.ConnectResponse.message
+   */
+  message: {
+    /**
+     * step_request is a request to execute a step.
+     *
+     * @generated from field: akuity.io.kargo.agent.v1alpha1.StepRequest step_request = 1;
+     */
+    value: StepRequest;
+    case: "stepRequest";
+  } | {
+    /**
+     * cancel_step is a request to cancel the execution of a step.
+     *
+     * @generated from field: akuity.io.kargo.agent.v1alpha1.CancelStep cancel_step = 2;
+     */
+    value: CancelStep;
+    case: "cancelStep";
+  } | { case: undefined; value?: undefined };
+};
+
+/**
+ * Describes the message akuity.io.kargo.agent.v1alpha1.ConnectResponse.
+ * Use `create(ConnectResponseSchema)` to create a new message.
+ */
+export const ConnectResponseSchema: GenMessage<ConnectResponse> = /*@__PURE__*/
+  messageDesc(file_api_agent_v1alpha1_agent, 1);
+
+/**
+ * Hello announces an agent to the controller.
+ *
+ * @generated from message akuity.io.kargo.agent.v1alpha1.Hello
+ */
+export type Hello = Message<"akuity.io.kargo.agent.v1alpha1.Hello"> & {
+  /**
+   * version is the version of the agent. The controller only accepts agents
+   * of the same minor version as itself.
+   *
+   * @generated from field: string version = 1;
+   */
+  version: string;
+};
+
+/**
+ * Describes the message akuity.io.kargo.agent.v1alpha1.Hello.
+ * Use `create(HelloSchema)` to create a new message.
+ */
+export const HelloSchema: GenMessage<Hello> = /*@__PURE__*/
+  messageDesc(file_api_agent_v1alpha1_agent, 2);
+
+/**
+ * StepRequest is a request to execute a single promotion step.
+ *
+ * @generated from message akuity.io.kargo.agent.v1alpha1.StepRequest
+ */
+export type StepRequest = Message<"akuity.io.kargo.agent.v1alpha1.StepRequest"> & {
+  /**
+   * id uniquely identifies the request. The result of the execution of the
+   * step carries the same id.
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * request is the JSON-encoded request to execute the step, including the
+   * context in which it is executed.
+   *
+   * @generated from field: bytes request = 2;
+   */
+  request: Uint8Array;
+};
+
+/**
+ * Describes the message akuity.io.kargo.agent.v1alpha1.StepRequest.
+ * Use `create(StepRequestSchema)` to create a new message.
+ */
+export const StepRequestSchema: GenMessage<StepRequest> = /*@__PURE__*/
+  messageDesc(file_api_agent_v1alpha1_agent, 3);
+
+/**
+ * CancelStep is a request to cancel the execution of a step. The agent
+ * cancels the context in which the step is executed. It still sends the
+ * result of the step, but the controller discards it.
+ *
+ * @generated from message akuity.io.kargo.agent.v1alpha1.CancelStep
+ */
+export type CancelStep = Message<"akuity.io.kargo.agent.v1alpha1.CancelStep"> & {
+  /**
+   * id is the id of the request to cancel.
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message akuity.io.kargo.agent.v1alpha1.CancelStep.
+ * Use `create(CancelStepSchema)` to create a new message.
+ */
+export const CancelStepSchema: GenMessage<CancelStep> = /*@__PURE__*/
+  messageDesc(file_api_agent_v1alpha1_agent, 4);
+
+/**
+ * StepResult is the result of the execution of a single promotion step.
+ *
+ * @generated from message akuity.io.kargo.agent.v1alpha1.StepResult
+ */
+export type StepResult = Message<"akuity.io.kargo.agent.v1alpha1.StepResult"> & {
+  /**
+   * id is the id of the request this is the result of.
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * result is the JSON-encoded result of the execution of the step.
+   *
+   * @generated from field: bytes result = 2;
+   */
+  result: Uint8Array;
+
+  /**
+   * error is the message of the error returned by the execution of the step,
+   * if any.
+   *
+   * @generated from field: string error = 3;
+   */
+  error: string;
+
+  /**
+   * terminal indicates whether the error is terminal, in which case the step
+   * must not be retried.
+   *
+   * @generated from field: bool terminal = 4;
+   */
+  terminal: boolean;
+};
+
+/**
+ * Describes the message akuity.io.kargo.agent.v1alpha1.StepResult.
+ * Use `create(StepResultSchema)` to create a new message.
+ */
+export const StepResultSchema: GenMessage<StepResult> = /*@__PURE__*/
+  messageDesc(file_api_agent_v1alpha1_agent, 5);
+
+/**
+ * AgentService is served by the Kargo controller to agents which execute
+ * promotion steps out-of-process, typically close to the environments those
+ * steps target.
+ *
+ * Agents always dial the controller, so they do not need to be reachable from
+ * the controller. An agent is identified by the bearer token it presents when
+ * it connects.
+ *
+ * @generated from service akuity.io.kargo.agent.v1alpha1.AgentService
+ */
+export const AgentService: GenService<{
+  /**
+   * Connect opens a bidirectional stream between an agent and the controller.
+   * The agent first sends a hello, after which the controller sends requests
+   * for the execution of steps and the agent sends back the result of each of
+   * them. Requests may be executed concurrently and their results may be sent
+   * in any order. The controller cancels a request it is no longer waiting for
+   * the result of, e.g. because the step timed out.
+   *
+   * @generated from rpc akuity.io.kargo.agent.v1alpha1.AgentService.Connect
+   */
+  connect: {
+    methodKind: "bidi_streaming";
+    input: typeof ConnectRequestSchema;
+    output: typeof ConnectResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_api_agent_v1alpha1_agent, 0);
+
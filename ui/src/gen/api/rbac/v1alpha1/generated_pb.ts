@@ -10,7 +10,7 @@ import type { ServiceAccount } from "../../../k8s.io/api/core/v1/generated_pb";
 import { file_k8s_io_api_core_v1_generated } from "../../../k8s.io/api/core/v1/generated_pb";
 import type { PolicyRule, Role as Role$1, RoleBinding } from "../../../k8s.io/api/rbac/v1/generated_pb";
 import { file_k8s_io_api_rbac_v1_generated } from "../../../k8s.io/api/rbac/v1/generated_pb";
-import type { ObjectMeta } from "../../../k8s.io/apimachinery/pkg/apis/meta/v1/generated_pb";
+import type { ObjectMeta, Time } from "../../../k8s.io/apimachinery/pkg/apis/meta/v1/generated_pb";
 import { file_k8s_io_apimachinery_pkg_apis_meta_v1_generated } from "../../../k8s.io/apimachinery/pkg/apis/meta/v1/generated_pb";
 import { file_k8s_io_apimachinery_pkg_runtime_generated } from "../../../k8s.io/apimachinery/pkg/runtime/generated_pb";
 import { file_k8s_io_apimachinery_pkg_runtime_schema_generated } from "../../../k8s.io/apimachinery/pkg/runtime/schema/generated_pb";
@@ -20,7 +20,50 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/rbac/v1alpha1/generated.proto.
  */
 export const file_api_rbac_v1alpha1_generated: GenFile = /*@__PURE__*/
-  fileDesc("CiFhcGkvcmJhYy92MWFscGhhMS9nZW5lcmF0ZWQucHJvdG8SKWdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS5yYmFjLnYxYWxwaGExIiUKBUNsYWltEgwKBG5hbWUYASABKAkSDgoGdmFsdWVzGAIgAygJIkwKD1Jlc291cmNlRGV0YWlscxIUCgxyZXNvdXJjZVR5cGUYASABKAkSFAoMcmVzb3VyY2VOYW1lGAIgASgJEg0KBXZlcmJzGAMgAygJItEBCgRSb2xlEkIKCG1ldGFkYXRhGAEgASgLMjAuazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLk9iamVjdE1ldGESFAoMa2FyZ29NYW5hZ2VkGAIgASgIEkAKBmNsYWltcxgHIAMoCzIwLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS5yYmFjLnYxYWxwaGExLkNsYWltEi0KBXJ1bGVzGAYgAygLMh4uazhzLmlvLmFwaS5yYmFjLnYxLlBvbGljeVJ1bGUi7wEKDVJvbGVSZXNvdXJjZXMSQgoIbWV0YWRhdGEYASABKAsyMC5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuT2JqZWN0TWV0YRI6Cg5zZXJ2aWNlQWNjb3VudBgCIAEoCzIiLms4cy5pby5hcGkuY29yZS52MS5TZXJ2aWNlQWNjb3VudBInCgVyb2xlcxgDIAMoCzIYLms4cy5pby5hcGkucmJhYy52MS5Sb2xlEjUKDHJvbGVCaW5kaW5ncxgEIAMoCzIfLms4cy5pby5hcGkucmJhYy52MS5Sb2xlQmluZGluZ0K3AgotY29tLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS5yYmFjLnYxYWxwaGExQg5HZW5lcmF0ZWRQcm90b1ABWilnaXRodWIuY29tL2FrdWl0eS9rYXJnby9hcGkvcmJhYy92MWFscGhhMaICBkdDQUtBUqoCKUdpdGh1Yi5Db20uQWt1aXR5LkthcmdvLkFwaS5SYmFjLlYxYWxwaGExygIpR2l0aHViXENvbVxBa3VpdHlcS2FyZ29cQXBpXFJiYWNcVjFhbHBoYTHiAjVHaXRodWJcQ29tXEFrdWl0eVxLYXJnb1xBcGlcUmJhY1xWMWFscGhhMVxHUEJNZXRhZGF0YeoCL0dpdGh1Yjo6Q29tOjpBa3VpdHk6OkthcmdvOjpBcGk6OlJiYWM6OlYxYWxwaGEx", [file_k8s_io_api_core_v1_generated, file_k8s_io_api_rbac_v1_generated, file_k8s_io_apimachinery_pkg_apis_meta_v1_generated, file_k8s_io_apimachinery_pkg_runtime_generated, file_k8s_io_apimachinery_pkg_runtime_schema_generated]);
+  fileDesc("CiFhcGkvcmJhYy92MWFscGhhMS9nZW5lcmF0ZWQucHJvdG8SKWdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS5yYmFjLnYxYWxwaGExIt8BCghBUElUb2tlbhJCCghtZXRhZGF0YRgBIAEoCzIwLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5PYmplY3RNZXRhEhAKCHJvbGVOYW1lGAIgASgJEj0KCWV4cGlyZXNBdBgDIAEoCzIqLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5UaW1lEj4KCmxhc3RVc2VkQXQYBCABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZSIlCgVDbGFpbRIMCgRuYW1lGAEgASgJEg4KBnZhbHVlcxgCIAMoCSJMCg9SZXNvdXJjZURldGFpbHMSFAoMcmVzb3VyY2VUeXBlGAEgASgJEhQKDHJlc291cmNlTmFtZRgCIAEoCRINCgV2ZXJicxgDIAMoCSLRAQoEUm9sZRJCCghtZXRhZGF0YRgBIAEoCzIwLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5PYmplY3RNZXRhEhQKDGthcmdvTWFuYWdlZBgCIAEoCBJACgZjbGFpbXMYByADKAsyMC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkucmJhYy52MWFscGhhMS5DbGFpbRItCgVydWxlcxgGIAMoCzIeLms4cy5pby5hcGkucmJhYy52MS5Qb2xpY3lSdWxlIu8BCg1Sb2xlUmVzb3VyY2VzEkIKCG1ldGFkYXRhGAEgASgLMjAuazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLk9iamVjdE1ldGESOgoOc2VydmljZUFjY291bnQYAiABKAsyIi5rOHMuaW8uYXBpLmNvcmUudjEuU2VydmljZUFjY291bnQSJwoFcm9sZXMYAyADKAsyGC5rOHMuaW8uYXBpLnJiYWMudjEuUm9sZRI1Cgxyb2xlQmluZGluZ3MYBCADKAsyHy5rOHMuaW8uYXBpLnJiYWMudjEuUm9sZUJpbmRpbmdCtwIKLWNvbS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkucmJhYy52MWFscGhhMUIOR2VuZXJhdGVkUHJvdG9QAVopZ2l0aHViLmNvbS9ha3VpdHkva2FyZ28vYXBpL3JiYWMvdjFhbHBoYTGiAgZHQ0FLQVKqAilHaXRodWIuQ29tLkFrdWl0eS5LYXJnby5BcGkuUmJhYy5WMWFscGhhMcoCKUdpdGh1YlxDb21cQWt1aXR5XEthcmdvXEFwaVxSYmFjXFYxYWxwaGEx4gI1R2l0aHViXENvbVxBa3VpdHlcS2FyZ29cQXBpXFJiYWNcVjFhbHBoYTFcR1BCTWV0YWRhdGHqAi9HaXRodWI6OkNvbTo6QWt1aXR5OjpLYXJnbzo6QXBpOjpSYmFjOjpWMWFscGhhMQ", [file_k8s_io_api_core_v1_generated, file_k8s_io_api_rbac_v1_generated, file_k8s_io_apimachinery_pkg_apis_meta_v1_generated, file_k8s_io_apimachinery_pkg_runtime_generated, file_k8s_io_apimachinery_pkg_runtime_schema_generated]);
+
+/**
+ * +kubebuilder:object:root=true
+ *
+ * @generated from message github.com.akuity.kargo.api.rbac.v1alpha1.APIToken
+ */
+export type APIToken = Message<"github.com.akuity.kargo.api.rbac.v1alpha1.APIToken"> & {
+  /**
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.ObjectMeta metadata = 1;
+   */
+  metadata?: ObjectMeta;
+
+  /**
+   * RoleName is the name of the Kargo Role the token is bound to. A request
+   * authenticated using the token is granted the permissions of that Role,
+   * within the token's Project only.
+   *
+   * @generated from field: optional string roleName = 2;
+   */
+  roleName: string;
+
+  /**
+   * ExpiresAt is the time after which the token is no longer valid.
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Time expiresAt = 3;
+   */
+  expiresAt?: Time;
+
+  /**
+   * LastUsedAt is the approximate time at which the token was last used to
+   * authenticate a request. It is not updated on every use.
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Time lastUsedAt = 4;
+   */
+  lastUsedAt?: Time;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.rbac.v1alpha1.APIToken.
+ * Use `create(APITokenSchema)` to create a new message.
+ */
+export const APITokenSchema: GenMessage<APIToken> = /*@__PURE__*/
+  messageDesc(file_api_rbac_v1alpha1_generated, 0);
 
 /**
  * @generated from message github.com.akuity.kargo.api.rbac.v1alpha1.Claim
@@ -42,7 +85,7 @@ export type Claim = Message<"github.com.akuity.kargo.api.rbac.v1alpha1.Claim"> &
  * Use `create(ClaimSchema)` to create a new message.
  */
 export const ClaimSchema: GenMessage<Claim> = /*@__PURE__*/
-  messageDesc(file_api_rbac_v1alpha1_generated, 0);
+  messageDesc(file_api_rbac_v1alpha1_generated, 1);
 
 /**
  * @generated from message github.com.akuity.kargo.api.rbac.v1alpha1.ResourceDetails
@@ -69,7 +112,7 @@ export type ResourceDetails = Message<"github.com.akuity.kargo.api.rbac.v1alpha1
  * Use `create(ResourceDetailsSchema)` to create a new message.
  */
 export const ResourceDetailsSchema: GenMessage<ResourceDetails> = /*@__PURE__*/
-  messageDesc(file_api_rbac_v1alpha1_generated, 1);
+  messageDesc(file_api_rbac_v1alpha1_generated, 2);
 
 /**
  * +kubebuilder:object:root=true
@@ -103,7 +146,7 @@ export type Role = Message<"github.com.akuity.kargo.api.rbac.v1alpha1.Role"> & {
  * Use `create(RoleSchema)` to create a new message.
  */
 export const RoleSchema: GenMessage<Role> = /*@__PURE__*/
-  messageDesc(file_api_rbac_v1alpha1_generated, 2);
+  messageDesc(file_api_rbac_v1alpha1_generated, 3);
 
 /**
  * +kubebuilder:object:root=true
@@ -137,5 +180,5 @@ export type RoleResources = Message<"github.com.akuity.kargo.api.rbac.v1alpha1.R
  * Use `create(RoleResourcesSchema)` to create a new message.
  */
 export const RoleResourcesSchema: GenMessage<RoleResources> = /*@__PURE__*/
-  messageDesc(file_api_rbac_v1alpha1_generated, 3);
+  messageDesc(file_api_rbac_v1alpha1_generated, 4);
 
@@ -139,6 +139,13 @@ export const getPromotion = KargoService.method.getPromotion;
  */
 export const abortPromotion = KargoService.method.abortPromotion;
 
+/**
+ * ResumePromotion creates a promotion which resumes a failed or errored promotion.
+ *
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ResumePromotion
+ */
+export const resumePromotion = KargoService.method.resumePromotion;
+
 /**
  * DeleteProject removes a project and all associated resources.
  *
@@ -181,6 +188,13 @@ export const deleteProjectConfig = KargoService.method.deleteProjectConfig;
  */
 export const refreshProjectConfig = KargoService.method.refreshProjectConfig;
 
+/**
+ * GetPromotionWaves retrieves the progress of freight through the promotion waves defined in project-level configuration.
+ *
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.GetPromotionWaves
+ */
+export const getPromotionWaves = KargoService.method.getPromotionWaves;
+
 /**
  * ApproveFreight marks freight as approved for promotion to a specific stage.
  *
@@ -188,6 +202,13 @@ export const refreshProjectConfig = KargoService.method.refreshProjectConfig;
  */
 export const approveFreight = KargoService.method.approveFreight;
 
+/**
+ * CreateFreight creates freight from specific artifacts subscribed to by a warehouse, resolving their details through artifact discovery.
+ *
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.CreateFreight
+ */
+export const createFreight = KargoService.method.createFreight;
+
 /**
  * DeleteFreight removes freight from the system.
  *
@@ -202,6 +223,13 @@ export const deleteFreight = KargoService.method.deleteFreight;
  */
 export const getFreight = KargoService.method.getFreight;
 
+/**
+ * GetFreightLineage retrieves the lineage of specific freight, from the artifacts it references to every stage it has been through.
+ *
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.GetFreightLineage
+ */
+export const getFreightLineage = KargoService.method.getFreightLineage;
+
 /**
  * PromoteToStage initiates a promotion of freight to a specific stage.
  *
@@ -482,6 +510,34 @@ export const revoke = KargoService.method.revoke;
  */
 export const updateRole = KargoService.method.updateRole;
 
+/**
+ * CreateAPIToken creates a new API token bound to an RBAC role within a project.
+ *
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.CreateAPIToken
+ */
+export const createAPIToken = KargoService.method.createAPIToken;
+
+/**
+ * DeleteAPIToken revokes an API token by removing it from a project.
+ *
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.DeleteAPIToken
+ */
+export const deleteAPIToken = KargoService.method.deleteAPIToken;
+
+/**
+ * GetAPIToken retrieves details of a specific API token.
+ *
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.GetAPIToken
+ */
+export const getAPIToken = KargoService.method.getAPIToken;
+
+/**
+ * ListAPITokens retrieves all API tokens within a project.
+ *
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ListAPITokens
+ */
+export const listAPITokens = KargoService.method.listAPITokens;
+
 /**
  * ListClusterSecrets retrieves all cluster-scoped secrets.
  *
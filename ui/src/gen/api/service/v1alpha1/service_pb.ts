@@ -6,13 +6,13 @@ import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobu
 import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv1";
 import type { Timestamp } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
-import type { ClusterConfig, ClusterPromotionTask, Freight, Project, ProjectConfig, Promotion, PromotionTask, Stage, Warehouse } from "../../v1alpha1/generated_pb";
+import type { ApprovedStage, Chart, ClusterConfig, ClusterPromotionTask, CurrentStage, DiscoveredCommit, DiscoveredImageReference, Freight, GitCommit, Image, Project, ProjectConfig, Promotion, PromotionTask, PromotionWaveProgress, Stage, VerificationInfo, VerifiedStage, Warehouse } from "../../v1alpha1/generated_pb";
 import { file_api_v1alpha1_generated } from "../../v1alpha1/generated_pb";
 import type { ConfigMap, Event, Secret } from "../../../k8s.io/api/core/v1/generated_pb";
 import { file_k8s_io_api_core_v1_generated } from "../../../k8s.io/api/core/v1/generated_pb";
 import type { AnalysisRun, AnalysisTemplate, ClusterAnalysisTemplate } from "../../stubs/rollouts/v1alpha1/generated_pb";
 import { file_api_stubs_rollouts_v1alpha1_generated } from "../../stubs/rollouts/v1alpha1/generated_pb";
-import type { Claim, ResourceDetails, Role, RoleResources } from "../../rbac/v1alpha1/generated_pb";
+import type { APIToken, Claim, ResourceDetails, Role, RoleResources } from "../../rbac/v1alpha1/generated_pb";
 import { file_api_rbac_v1alpha1_generated } from "../../rbac/v1alpha1/generated_pb";
 import type { Message } from "@bufbuild/protobuf";
 
@@ -20,7 +20,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/service/v1alpha1/service.proto.
  */
 export const file_api_service_v1alpha1_service: GenFile = /*@__PURE__*/
-  fileDesc("CiJhcGkvc2VydmljZS92MWFscGhhMS9zZXJ2aWNlLnByb3RvEiBha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMSKrAQoRQ29tcG9uZW50VmVyc2lvbnMSQgoGc2VydmVyGAEgASgLMi0uYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuVmVyc2lvbkluZm9IAIgBARI/CgNjbGkYAiABKAsyLS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5WZXJzaW9uSW5mb0gBiAEBQgkKB19zZXJ2ZXJCBgoEX2NsaSKyAQoLVmVyc2lvbkluZm8SDwoHdmVyc2lvbhgBIAEoCRISCgpnaXRfY29tbWl0GAIgASgJEhYKDmdpdF90cmVlX2RpcnR5GAMgASgIEi4KCmJ1aWxkX3RpbWUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhIKCmdvX3ZlcnNpb24YBSABKAkSEAoIY29tcGlsZXIYBiABKAkSEAoIcGxhdGZvcm0YByABKAkiFwoVR2V0VmVyc2lvbkluZm9SZXF1ZXN0Il0KFkdldFZlcnNpb25JbmZvUmVzcG9uc2USQwoMdmVyc2lvbl9pbmZvGAEgASgLMi0uYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuVmVyc2lvbkluZm8iEgoQR2V0Q29uZmlnUmVxdWVzdCItCgtBcmdvQ0RTaGFyZBILCgN1cmwYASABKAkSEQoJbmFtZXNwYWNlGAIgASgJIscCChFHZXRDb25maWdSZXNwb25zZRJcCg1hcmdvY2Rfc2hhcmRzGAEgAygLMkUuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0Q29uZmlnUmVzcG9uc2UuQXJnb2NkU2hhcmRzRW50cnkSIQoZc2VjcmV0X21hbmFnZW1lbnRfZW5hYmxlZBgCIAEoCBIhChljbHVzdGVyX3NlY3JldHNfbmFtZXNwYWNlGAMgASgJEioKImhhc19hbmFseXNpc19ydW5fbG9nc191cmxfdGVtcGxhdGUYBCABKAgaYgoRQXJnb2NkU2hhcmRzRW50cnkSCwoDa2V5GAEgASgJEjwKBXZhbHVlGAIgASgLMi0uYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuQXJnb0NEU2hhcmQ6AjgBIhgKFkdldFB1YmxpY0NvbmZpZ1JlcXVlc3QijgEKF0dldFB1YmxpY0NvbmZpZ1Jlc3BvbnNlEkEKC29pZGNfY29uZmlnGAEgASgLMiwuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuT0lEQ0NvbmZpZxIdChVhZG1pbl9hY2NvdW50X2VuYWJsZWQYAiABKAgSEQoJc2tpcF9hdXRoGAMgASgIIloKCk9JRENDb25maWcSEgoKaXNzdWVyX3VybBgBIAEoCRIRCgljbGllbnRfaWQYAiABKAkSDgoGc2NvcGVzGAMgAygJEhUKDWNsaV9jbGllbnRfaWQYBCABKAkiJQoRQWRtaW5Mb2dpblJlcXVlc3QSEAoIcGFzc3dvcmQYASABKAkiJgoSQWRtaW5Mb2dpblJlc3BvbnNlEhAKCGlkX3Rva2VuGAEgASgJIikKFUNyZWF0ZVJlc291cmNlUmVxdWVzdBIQCghtYW5pZmVzdBgBIAEoDCJWChRDcmVhdGVSZXNvdXJjZVJlc3VsdBIjChljcmVhdGVkX3Jlc291cmNlX21hbmlmZXN0GAEgASgMSAASDwoFZXJyb3IYAiABKAlIAEIICgZyZXN1bHQiYQoWQ3JlYXRlUmVzb3VyY2VSZXNwb25zZRJHCgdyZXN1bHRzGAEgAygLMjYuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuQ3JlYXRlUmVzb3VyY2VSZXN1bHQiMQodQ3JlYXRlT3JVcGRhdGVSZXNvdXJjZVJlcXVlc3QSEAoIbWFuaWZlc3QYASABKAwigwEKHENyZWF0ZU9yVXBkYXRlUmVzb3VyY2VSZXN1bHQSIwoZY3JlYXRlZF9yZXNvdXJjZV9tYW5pZmVzdBgBIAEoDEgAEiMKGXVwZGF0ZWRfcmVzb3VyY2VfbWFuaWZlc3QYAiABKAxIABIPCgVlcnJvchgDIAEoCUgAQggKBnJlc3VsdCJxCh5DcmVhdGVPclVwZGF0ZVJlc291cmNlUmVzcG9uc2USTwoHcmVzdWx0cxgBIAMoCzI+LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkNyZWF0ZU9yVXBkYXRlUmVzb3VyY2VSZXN1bHQiKQoVVXBkYXRlUmVzb3VyY2VSZXF1ZXN0EhAKCG1hbmlmZXN0GAEgASgMIlYKFFVwZGF0ZVJlc291cmNlUmVzdWx0EiMKGXVwZGF0ZWRfcmVzb3VyY2VfbWFuaWZlc3QYASABKAxIABIPCgVlcnJvchgCIAEoCUgAQggKBnJlc3VsdCJhChZVcGRhdGVSZXNvdXJjZVJlc3BvbnNlEkcKB3Jlc3VsdHMYASADKAsyNi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5VcGRhdGVSZXNvdXJjZVJlc3VsdCIpChVEZWxldGVSZXNvdXJjZVJlcXVlc3QSEAoIbWFuaWZlc3QYASABKAwiVgoURGVsZXRlUmVzb3VyY2VSZXN1bHQSIwoZZGVsZXRlZF9yZXNvdXJjZV9tYW5pZmVzdBgBIAEoDEgAEg8KBWVycm9yGAIgASgJSABCCAoGcmVzdWx0ImEKFkRlbGV0ZVJlc291cmNlUmVzcG9uc2USRwoHcmVzdWx0cxgBIAMoCzI2LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkRlbGV0ZVJlc291cmNlUmVzdWx0IiQKEUxpc3RTdGFnZXNSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkiUQoSTGlzdFN0YWdlc1Jlc3BvbnNlEjsKBnN0YWdlcxgBIAMoCzIrLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5TdGFnZSIkChFMaXN0SW1hZ2VzUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJIr8BChJMaXN0SW1hZ2VzUmVzcG9uc2USUAoGaW1hZ2VzGAIgAygLMkAuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdEltYWdlc1Jlc3BvbnNlLkltYWdlc0VudHJ5GlcKC0ltYWdlc0VudHJ5EgsKA2tleRgBIAEoCRI3CgV2YWx1ZRgCIAEoCzIoLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlRhZ01hcDoCOAEiqAEKBlRhZ01hcBJACgR0YWdzGAEgAygLMjIuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuVGFnTWFwLlRhZ3NFbnRyeRpcCglUYWdzRW50cnkSCwoDa2V5GAEgASgJEj4KBXZhbHVlGAIgASgLMi8uYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuSW1hZ2VTdGFnZU1hcDoCOAEiiwEKDUltYWdlU3RhZ2VNYXASSwoGc3RhZ2VzGAEgAygLMjsuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuSW1hZ2VTdGFnZU1hcC5TdGFnZXNFbnRyeRotCgtTdGFnZXNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAU6AjgBIm0KD0dldFN0YWdlUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkSOwoGZm9ybWF0GAMgASgOMisuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUmF3Rm9ybWF0ImkKEEdldFN0YWdlUmVzcG9uc2USPAoFc3RhZ2UYASABKAsyKy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuU3RhZ2VIABINCgNyYXcYAiABKAxIAEIICgZyZXN1bHQiMwoSV2F0Y2hTdGFnZXNSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCSJfChNXYXRjaFN0YWdlc1Jlc3BvbnNlEjoKBXN0YWdlGAEgASgLMisuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlN0YWdlEgwKBHR5cGUYAiABKAkiMwoSRGVsZXRlU3RhZ2VSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCSIVChNEZWxldGVTdGFnZVJlc3BvbnNlIjQKE1JlZnJlc2hTdGFnZVJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJIlIKFFJlZnJlc2hTdGFnZVJlc3BvbnNlEjoKBXN0YWdlGAEgASgLMisuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlN0YWdlIlYKF0dldENsdXN0ZXJDb25maWdSZXF1ZXN0EjsKBmZvcm1hdBgBIAEoDjIrLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlJhd0Zvcm1hdCKCAQoYR2V0Q2x1c3RlckNvbmZpZ1Jlc3BvbnNlEk0KDmNsdXN0ZXJfY29uZmlnGAEgASgLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkNsdXN0ZXJDb25maWdIABINCgNyYXcYAiABKAxIAEIICgZyZXN1bHQiHAoaRGVsZXRlQ2x1c3RlckNvbmZpZ1JlcXVlc3QiHQobRGVsZXRlQ2x1c3RlckNvbmZpZ1Jlc3BvbnNlIhsKGVdhdGNoQ2x1c3RlckNvbmZpZ1JlcXVlc3QidwoaV2F0Y2hDbHVzdGVyQ29uZmlnUmVzcG9uc2USSwoOY2x1c3Rlcl9jb25maWcYASABKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQ2x1c3RlckNvbmZpZxIMCgR0eXBlGAIgASgJIh0KG1JlZnJlc2hDbHVzdGVyQ29uZmlnUmVxdWVzdCJrChxSZWZyZXNoQ2x1c3RlckNvbmZpZ1Jlc3BvbnNlEksKDmNsdXN0ZXJfY29uZmlnGAEgASgLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkNsdXN0ZXJDb25maWciRgoVTGlzdFByb21vdGlvbnNSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSEgoFc3RhZ2UYAiABKAlIAIgBAUIICgZfc3RhZ2UiXQoWTGlzdFByb21vdGlvbnNSZXNwb25zZRJDCgpwcm9tb3Rpb25zGAEgAygLMi8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvbiJHChZXYXRjaFByb21vdGlvbnNSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSEgoFc3RhZ2UYAiABKAlIAIgBAUIICgZfc3RhZ2UiawoXV2F0Y2hQcm9tb3Rpb25zUmVzcG9uc2USQgoJcHJvbW90aW9uGAEgASgLMi8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvbhIMCgR0eXBlGAIgASgJInEKE0dldFByb21vdGlvblJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJEjsKBmZvcm1hdBgDIAEoDjIrLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlJhd0Zvcm1hdCJ1ChRHZXRQcm9tb3Rpb25SZXNwb25zZRJECglwcm9tb3Rpb24YASABKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uSAASDQoDcmF3GAIgASgMSABCCAoGcmVzdWx0IjYKFVdhdGNoUHJvbW90aW9uUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkiagoWV2F0Y2hQcm9tb3Rpb25SZXNwb25zZRJCCglwcm9tb3Rpb24YASABKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uEgwKBHR5cGUYAiABKAkiNgoVQWJvcnRQcm9tb3Rpb25SZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCSIYChZBYm9ydFByb21vdGlvblJlc3BvbnNlIiQKFERlbGV0ZVByb2plY3RSZXF1ZXN0EgwKBG5hbWUYASABKAkiFwoVRGVsZXRlUHJvamVjdFJlc3BvbnNlIl4KEUdldFByb2plY3RSZXF1ZXN0EgwKBG5hbWUYASABKAkSOwoGZm9ybWF0GAIgASgOMisuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUmF3Rm9ybWF0Im8KEkdldFByb2plY3RSZXNwb25zZRJACgdwcm9qZWN0GAEgASgLMi0uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb2plY3RIABINCgNyYXcYAiABKAxIAEIICgZyZXN1bHQihAEKE0xpc3RQcm9qZWN0c1JlcXVlc3QSFgoJcGFnZV9zaXplGAEgASgFSACIAQESEQoEcGFnZRgCIAEoBUgBiAEBEhMKBmZpbHRlchgDIAEoCUgCiAEBEgsKA3VpZBgEIAMoCUIMCgpfcGFnZV9zaXplQgcKBV9wYWdlQgkKB19maWx0ZXIiZgoUTGlzdFByb2plY3RzUmVzcG9uc2USPwoIcHJvamVjdHMYASADKAsyLS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvamVjdBINCgV0b3RhbBgCIAEoBSJnChdHZXRQcm9qZWN0Q29uZmlnUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEjsKBmZvcm1hdBgCIAEoDjIrLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlJhd0Zvcm1hdCKCAQoYR2V0UHJvamVjdENvbmZpZ1Jlc3BvbnNlEk0KDnByb2plY3RfY29uZmlnGAEgASgLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb2plY3RDb25maWdIABINCgNyYXcYAiABKAxIAEIICgZyZXN1bHQiLQoaRGVsZXRlUHJvamVjdENvbmZpZ1JlcXVlc3QSDwoHcHJvamVjdBgBIAEoCSIdChtEZWxldGVQcm9qZWN0Q29uZmlnUmVzcG9uc2UiLAoZV2F0Y2hQcm9qZWN0Q29uZmlnUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJIncKGldhdGNoUHJvamVjdENvbmZpZ1Jlc3BvbnNlEksKDnByb2plY3RfY29uZmlnGAEgASgLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb2plY3RDb25maWcSDAoEdHlwZRgCIAEoCSIuChtSZWZyZXNoUHJvamVjdENvbmZpZ1JlcXVlc3QSDwoHcHJvamVjdBgBIAEoCSJrChxSZWZyZXNoUHJvamVjdENvbmZpZ1Jlc3BvbnNlEksKDnByb2plY3RfY29uZmlnGAEgASgLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb2plY3RDb25maWciVAoVQXBwcm92ZUZyZWlnaHRSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCRINCgVhbGlhcxgDIAEoCRINCgVzdGFnZRgEIAEoCSIYChZBcHByb3ZlRnJlaWdodFJlc3BvbnNlIkQKFERlbGV0ZUZyZWlnaHRSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCRINCgVhbGlhcxgDIAEoCSIXChVEZWxldGVGcmVpZ2h0UmVzcG9uc2UifgoRR2V0RnJlaWdodFJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJEg0KBWFsaWFzGAMgASgJEjsKBmZvcm1hdBgEIAEoDjIrLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlJhd0Zvcm1hdCJvChJHZXRGcmVpZ2h0UmVzcG9uc2USQAoHZnJlaWdodBgBIAEoCzItLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0SAASDQoDcmF3GAIgASgMSABCCAoGcmVzdWx0IiYKE1dhdGNoRnJlaWdodFJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCSJkChRXYXRjaEZyZWlnaHRSZXNwb25zZRI+CgdmcmVpZ2h0GAEgASgLMi0uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHQSDAoEdHlwZRgCIAEoCSJfChVQcm9tb3RlVG9TdGFnZVJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRINCgVzdGFnZRgCIAEoCRIPCgdmcmVpZ2h0GAMgASgJEhUKDWZyZWlnaHRfYWxpYXMYBCABKAkiXAoWUHJvbW90ZVRvU3RhZ2VSZXNwb25zZRJCCglwcm9tb3Rpb24YASABKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uImIKGFByb21vdGVEb3duc3RyZWFtUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEg0KBXN0YWdlGAIgASgJEg8KB2ZyZWlnaHQYAyABKAkSFQoNZnJlaWdodF9hbGlhcxgEIAEoCSJgChlQcm9tb3RlRG93bnN0cmVhbVJlc3BvbnNlEkMKCnByb21vdGlvbnMYASADKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uIooBChNRdWVyeUZyZWlnaHRSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDQoFc3RhZ2UYAiABKAkSEAoIZ3JvdXBfYnkYAyABKAkSDQoFZ3JvdXAYBCABKAkSEAoIb3JkZXJfYnkYBSABKAkSDwoHcmV2ZXJzZRgGIAEoCBIPCgdvcmlnaW5zGAcgAygJIsgBChRRdWVyeUZyZWlnaHRSZXNwb25zZRJSCgZncm91cHMYASADKAsyQi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5RdWVyeUZyZWlnaHRSZXNwb25zZS5Hcm91cHNFbnRyeRpcCgtHcm91cHNFbnRyeRILCgNrZXkYASABKAkSPAoFdmFsdWUYAiABKAsyLS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5GcmVpZ2h0TGlzdDoCOAEiTQoLRnJlaWdodExpc3QSPgoHZnJlaWdodBgBIAMoCzItLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0ImAKGVVwZGF0ZUZyZWlnaHRBbGlhc1JlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJEhEKCW9sZF9hbGlhcxgDIAEoCRIRCgluZXdfYWxpYXMYBCABKAkiHAoaVXBkYXRlRnJlaWdodEFsaWFzUmVzcG9uc2UiMQoPUmV2ZXJpZnlSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDQoFc3RhZ2UYAiABKAkiEgoQUmV2ZXJpZnlSZXNwb25zZSI6ChhBYm9ydFZlcmlmaWNhdGlvblJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRINCgVzdGFnZRgCIAEoCSIbChlBYm9ydFZlcmlmaWNhdGlvblJlc3BvbnNlIigKFUxpc3RXYXJlaG91c2VzUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJIl0KFkxpc3RXYXJlaG91c2VzUmVzcG9uc2USQwoKd2FyZWhvdXNlcxgBIAMoCzIvLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5XYXJlaG91c2UicQoTR2V0V2FyZWhvdXNlUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkSOwoGZm9ybWF0GAMgASgOMisuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUmF3Rm9ybWF0InUKFEdldFdhcmVob3VzZVJlc3BvbnNlEkQKCXdhcmVob3VzZRgBIAEoCzIvLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5XYXJlaG91c2VIABINCgNyYXcYAiABKAxIAEIICgZyZXN1bHQiNwoWV2F0Y2hXYXJlaG91c2VzUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkiawoXV2F0Y2hXYXJlaG91c2VzUmVzcG9uc2USQgoJd2FyZWhvdXNlGAEgASgLMi8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLldhcmVob3VzZRIMCgR0eXBlGAIgASgJIjcKFkRlbGV0ZVdhcmVob3VzZVJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJIhkKF0RlbGV0ZVdhcmVob3VzZVJlc3BvbnNlIjgKF1JlZnJlc2hXYXJlaG91c2VSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCSJeChhSZWZyZXNoV2FyZWhvdXNlUmVzcG9uc2USQgoJd2FyZWhvdXNlGAEgASgLMi8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLldhcmVob3VzZSIoChVMaXN0Q29uZmlnTWFwc1JlcXVlc3QSDwoHcHJvamVjdBgBIAEoCSJMChZMaXN0Q29uZmlnTWFwc1Jlc3BvbnNlEjIKC2NvbmZpZ19tYXBzGAEgAygLMh0uazhzLmlvLmFwaS5jb3JlLnYxLkNvbmZpZ01hcCJxChNHZXRDb25maWdNYXBSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCRI7CgZmb3JtYXQYAyABKA4yKy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5SYXdGb3JtYXQiZAoUR2V0Q29uZmlnTWFwUmVzcG9uc2USMwoKY29uZmlnX21hcBgBIAEoCzIdLms4cy5pby5hcGkuY29yZS52MS5Db25maWdNYXBIABINCgNyYXcYAiABKAxIAEIICgZyZXN1bHQixgEKGENyZWF0ZUNyZWRlbnRpYWxzUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkSEwoLZGVzY3JpcHRpb24YCCABKAkSDAoEdHlwZRgDIAEoCRIZCghyZXBvX3VybBgEIAEoCVIHcmVwb1VSTBIpChFyZXBvX3VybF9pc19yZWdleBgFIAEoCFIOcmVwb1VSTElzUmVnZXgSEAoIdXNlcm5hbWUYBiABKAkSEAoIcGFzc3dvcmQYByABKAkiTAoZQ3JlYXRlQ3JlZGVudGlhbHNSZXNwb25zZRIvCgtjcmVkZW50aWFscxgBIAEoCzIaLms4cy5pby5hcGkuY29yZS52MS5TZWNyZXQiOQoYRGVsZXRlQ3JlZGVudGlhbHNSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCSIbChlEZWxldGVDcmVkZW50aWFsc1Jlc3BvbnNlInMKFUdldENyZWRlbnRpYWxzUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkSOwoGZm9ybWF0GAMgASgOMisuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUmF3Rm9ybWF0ImQKFkdldENyZWRlbnRpYWxzUmVzcG9uc2USMQoLY3JlZGVudGlhbHMYASABKAsyGi5rOHMuaW8uYXBpLmNvcmUudjEuU2VjcmV0SAASDQoDcmF3GAIgASgMSABCCAoGcmVzdWx0IikKFkxpc3RDcmVkZW50aWFsc1JlcXVlc3QSDwoHcHJvamVjdBgBIAEoCSJKChdMaXN0Q3JlZGVudGlhbHNSZXNwb25zZRIvCgtjcmVkZW50aWFscxgBIAMoCzIaLms4cy5pby5hcGkuY29yZS52MS5TZWNyZXQixgEKGFVwZGF0ZUNyZWRlbnRpYWxzUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkSEwoLZGVzY3JpcHRpb24YCCABKAkSDAoEdHlwZRgDIAEoCRIZCghyZXBvX3VybBgEIAEoCVIHcmVwb1VSTBIpChFyZXBvX3VybF9pc19yZWdleBgFIAEoCFIOcmVwb1VSTElzUmVnZXgSEAoIdXNlcm5hbWUYBiABKAkSEAoIcGFzc3dvcmQYByABKAkiTAoZVXBkYXRlQ3JlZGVudGlhbHNSZXNwb25zZRIvCgtjcmVkZW50aWFscxgBIAEoCzIaLms4cy5pby5hcGkuY29yZS52MS5TZWNyZXQiLAoZTGlzdFByb2plY3RTZWNyZXRzUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJIkkKGkxpc3RQcm9qZWN0U2VjcmV0c1Jlc3BvbnNlEisKB3NlY3JldHMYASADKAsyGi5rOHMuaW8uYXBpLmNvcmUudjEuU2VjcmV0ItMBChpDcmVhdGVQcm9qZWN0U2VjcmV0UmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSVAoEZGF0YRgEIAMoCzJGLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkNyZWF0ZVByb2plY3RTZWNyZXRSZXF1ZXN0LkRhdGFFbnRyeRorCglEYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJJChtDcmVhdGVQcm9qZWN0U2VjcmV0UmVzcG9uc2USKgoGc2VjcmV0GAEgASgLMhouazhzLmlvLmFwaS5jb3JlLnYxLlNlY3JldCLTAQoaVXBkYXRlUHJvamVjdFNlY3JldFJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJElQKBGRhdGEYBCADKAsyRi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5VcGRhdGVQcm9qZWN0U2VjcmV0UmVxdWVzdC5EYXRhRW50cnkaKwoJRGF0YUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiSQobVXBkYXRlUHJvamVjdFNlY3JldFJlc3BvbnNlEioKBnNlY3JldBgBIAEoCzIaLms4cy5pby5hcGkuY29yZS52MS5TZWNyZXQiOwoaRGVsZXRlUHJvamVjdFNlY3JldFJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJIh0KG0RlbGV0ZVByb2plY3RTZWNyZXRSZXNwb25zZSIvChxMaXN0QW5hbHlzaXNUZW1wbGF0ZXNSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkiggEKHUxpc3RBbmFseXNpc1RlbXBsYXRlc1Jlc3BvbnNlEmEKEmFuYWx5c2lzX3RlbXBsYXRlcxgBIAMoCzJFLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS5zdHVicy5yb2xsb3V0cy52MWFscGhhMS5BbmFseXNpc1RlbXBsYXRlIngKGkdldEFuYWx5c2lzVGVtcGxhdGVSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCRI7CgZmb3JtYXQYAyABKA4yKy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5SYXdGb3JtYXQimgEKG0dldEFuYWx5c2lzVGVtcGxhdGVSZXNwb25zZRJiChFhbmFseXNpc190ZW1wbGF0ZRgBIAEoCzJFLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS5zdHVicy5yb2xsb3V0cy52MWFscGhhMS5BbmFseXNpc1RlbXBsYXRlSAASDQoDcmF3GAIgASgMSABCCAoGcmVzdWx0Ij4KHURlbGV0ZUFuYWx5c2lzVGVtcGxhdGVSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCSIgCh5EZWxldGVBbmFseXNpc1RlbXBsYXRlUmVzcG9uc2UiJQojTGlzdENsdXN0ZXJBbmFseXNpc1RlbXBsYXRlc1JlcXVlc3QiswEKJExpc3RDbHVzdGVyQW5hbHlzaXNUZW1wbGF0ZXNSZXNwb25zZRKKAQoaY2x1c3Rlcl9hbmFseXNpc190ZW1wbGF0ZXMYASADKAsyTC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkuc3R1YnMucm9sbG91dHMudjFhbHBoYTEuQ2x1c3RlckFuYWx5c2lzVGVtcGxhdGVSGGNsdXN0ZXJhbmFseXNpc1RlbXBsYXRlcyJuCiFHZXRDbHVzdGVyQW5hbHlzaXNUZW1wbGF0ZVJlcXVlc3QSDAoEbmFtZRgCIAEoCRI7CgZmb3JtYXQYAyABKA4yKy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5SYXdGb3JtYXQisAEKIkdldENsdXN0ZXJBbmFseXNpc1RlbXBsYXRlUmVzcG9uc2UScQoZY2x1c3Rlcl9hbmFseXNpc190ZW1wbGF0ZRgBIAEoCzJMLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS5zdHVicy5yb2xsb3V0cy52MWFscGhhMS5DbHVzdGVyQW5hbHlzaXNUZW1wbGF0ZUgAEg0KA3JhdxgCIAEoDEgAQggKBnJlc3VsdCI0CiREZWxldGVDbHVzdGVyQW5hbHlzaXNUZW1wbGF0ZVJlcXVlc3QSDAoEbmFtZRgCIAEoCSInCiVEZWxldGVDbHVzdGVyQW5hbHlzaXNUZW1wbGF0ZVJlc3BvbnNlInUKFUdldEFuYWx5c2lzUnVuUmVxdWVzdBIRCgluYW1lc3BhY2UYASABKAkSDAoEbmFtZRgCIAEoCRI7CgZmb3JtYXQYAyABKA4yKy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5SYXdGb3JtYXQiiwEKFkdldEFuYWx5c2lzUnVuUmVzcG9uc2USWAoMYW5hbHlzaXNfcnVuGAEgASgLMkAuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnN0dWJzLnJvbGxvdXRzLnYxYWxwaGExLkFuYWx5c2lzUnVuSAASDQoDcmF3GAIgASgMSABCCAoGcmVzdWx0ImkKGUdldEFuYWx5c2lzUnVuTG9nc1JlcXVlc3QSEQoJbmFtZXNwYWNlGAEgASgJEgwKBG5hbWUYAiABKAkSEwoLbWV0cmljX25hbWUYAyABKAkSFgoOY29udGFpbmVyX25hbWUYBCABKAkiKwoaR2V0QW5hbHlzaXNSdW5Mb2dzUmVzcG9uc2USDQoFY2h1bmsYASABKAkiKwoYTGlzdFByb2plY3RFdmVudHNSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkiRgoZTGlzdFByb2plY3RFdmVudHNSZXNwb25zZRIpCgZldmVudHMYASADKAsyGS5rOHMuaW8uYXBpLmNvcmUudjEuRXZlbnQiLAoZTGlzdFByb21vdGlvblRhc2tzUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJImoKGkxpc3RQcm9tb3Rpb25UYXNrc1Jlc3BvbnNlEkwKD3Byb21vdGlvbl90YXNrcxgBIAMoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25UYXNrInUKF0dldFByb21vdGlvblRhc2tSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCRI7CgZmb3JtYXQYAyABKA4yKy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5SYXdGb3JtYXQiggEKGEdldFByb21vdGlvblRhc2tSZXNwb25zZRJNCg5wcm9tb3Rpb25fdGFzaxgBIAEoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25UYXNrSAASDQoDcmF3GAIgASgMSABCCAoGcmVzdWx0IiIKIExpc3RDbHVzdGVyUHJvbW90aW9uVGFza3NSZXF1ZXN0IoABCiFMaXN0Q2x1c3RlclByb21vdGlvblRhc2tzUmVzcG9uc2USWwoXY2x1c3Rlcl9wcm9tb3Rpb25fdGFza3MYASADKAsyOi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQ2x1c3RlclByb21vdGlvblRhc2siawoeR2V0Q2x1c3RlclByb21vdGlvblRhc2tSZXF1ZXN0EgwKBG5hbWUYAiABKAkSOwoGZm9ybWF0GAMgASgOMisuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUmF3Rm9ybWF0IqYBCh9HZXRDbHVzdGVyUHJvbW90aW9uVGFza1Jlc3BvbnNlEmoKDnByb21vdGlvbl90YXNrGAEgASgLMjouZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkNsdXN0ZXJQcm9tb3Rpb25UYXNrSABSFGNsdXN0ZXJQcm9tb3Rpb25UYXNrEg0KA3JhdxgCIAEoDEgAQggKBnJlc3VsdCJSChFDcmVhdGVSb2xlUmVxdWVzdBI9CgRyb2xlGAEgASgLMi8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnJiYWMudjFhbHBoYTEuUm9sZSJTChJDcmVhdGVSb2xlUmVzcG9uc2USPQoEcm9sZRgBIAEoCzIvLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS5yYmFjLnYxYWxwaGExLlJvbGUiMgoRRGVsZXRlUm9sZVJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJIhQKEkRlbGV0ZVJvbGVSZXNwb25zZSKCAQoOR2V0Um9sZVJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJEhQKDGFzX3Jlc291cmNlcxgDIAEoCBI7CgZmb3JtYXQYBCABKA4yKy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5SYXdGb3JtYXQiugEKD0dldFJvbGVSZXNwb25zZRI/CgRyb2xlGAEgASgLMi8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnJiYWMudjFhbHBoYTEuUm9sZUgAEk0KCXJlc291cmNlcxgCIAEoCzI4LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS5yYmFjLnYxYWxwaGExLlJvbGVSZXNvdXJjZXNIABINCgNyYXcYAyABKAxIAEIICgZyZXN1bHQiSgoGQ2xhaW1zEkAKBmNsYWltcxgBIAMoCzIwLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS5yYmFjLnYxYWxwaGExLkNsYWltItEBCgxHcmFudFJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRyb2xlGAIgASgJEj8KC3VzZXJfY2xhaW1zGAMgASgLMiguYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuQ2xhaW1zSAASVgoQcmVzb3VyY2VfZGV0YWlscxgEIAEoCzI6LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS5yYmFjLnYxYWxwaGExLlJlc291cmNlRGV0YWlsc0gAQgkKB3JlcXVlc3QiTgoNR3JhbnRSZXNwb25zZRI9CgRyb2xlGAEgASgLMi8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnJiYWMudjFhbHBoYTEuUm9sZSI5ChBMaXN0Um9sZXNSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSFAoMYXNfcmVzb3VyY2VzGAIgASgIIqABChFMaXN0Um9sZXNSZXNwb25zZRI+CgVyb2xlcxgBIAMoCzIvLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS5yYmFjLnYxYWxwaGExLlJvbGUSSwoJcmVzb3VyY2VzGAIgAygLMjguZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnJiYWMudjFhbHBoYTEuUm9sZVJlc291cmNlcyLSAQoNUmV2b2tlUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBHJvbGUYAiABKAkSPwoLdXNlcl9jbGFpbXMYAyABKAsyKC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5DbGFpbXNIABJWChByZXNvdXJjZV9kZXRhaWxzGAQgASgLMjouZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnJiYWMudjFhbHBoYTEuUmVzb3VyY2VEZXRhaWxzSABCCQoHcmVxdWVzdCJPCg5SZXZva2VSZXNwb25zZRI9CgRyb2xlGAEgASgLMi8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnJiYWMudjFhbHBoYTEuUm9sZSJSChFVcGRhdGVSb2xlUmVxdWVzdBI9CgRyb2xlGAEgASgLMi8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnJiYWMudjFhbHBoYTEuUm9sZSJTChJVcGRhdGVSb2xlUmVzcG9uc2USPQoEcm9sZRgBIAEoCzIvLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS5yYmFjLnYxYWxwaGExLlJvbGUiGwoZTGlzdENsdXN0ZXJTZWNyZXRzUmVxdWVzdCJJChpMaXN0Q2x1c3RlclNlY3JldHNSZXNwb25zZRIrCgdzZWNyZXRzGAEgAygLMhouazhzLmlvLmFwaS5jb3JlLnYxLlNlY3JldCKtAQoaQ3JlYXRlQ2x1c3RlclNlY3JldFJlcXVlc3QSDAoEbmFtZRgBIAEoCRJUCgRkYXRhGAIgAygLMkYuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuQ3JlYXRlQ2x1c3RlclNlY3JldFJlcXVlc3QuRGF0YUVudHJ5GisKCURhdGFFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIkkKG0NyZWF0ZUNsdXN0ZXJTZWNyZXRSZXNwb25zZRIqCgZzZWNyZXQYASABKAsyGi5rOHMuaW8uYXBpLmNvcmUudjEuU2VjcmV0Iq0BChpVcGRhdGVDbHVzdGVyU2VjcmV0UmVxdWVzdBIMCgRuYW1lGAEgASgJElQKBGRhdGEYAiADKAsyRi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5VcGRhdGVDbHVzdGVyU2VjcmV0UmVxdWVzdC5EYXRhRW50cnkaKwoJRGF0YUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiSQobVXBkYXRlQ2x1c3RlclNlY3JldFJlc3BvbnNlEioKBnNlY3JldBgBIAEoCzIaLms4cy5pby5hcGkuY29yZS52MS5TZWNyZXQiKgoaRGVsZXRlQ2x1c3RlclNlY3JldFJlcXVlc3QSDAoEbmFtZRgBIAEoCSIdChtEZWxldGVDbHVzdGVyU2VjcmV0UmVzcG9uc2UqUQoJUmF3Rm9ybWF0EhoKFlJBV19GT1JNQVRfVU5TUEVDSUZJRUQQABITCg9SQVdfRk9STUFUX0pTT04QARITCg9SQVdfRk9STUFUX1lBTUwQAjKSVgoMS2FyZ29TZXJ2aWNlEoMBCg5HZXRWZXJzaW9uSW5mbxI3LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldFZlcnNpb25JbmZvUmVxdWVzdBo4LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldFZlcnNpb25JbmZvUmVzcG9uc2USdAoJR2V0Q29uZmlnEjIuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0Q29uZmlnUmVxdWVzdBozLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldENvbmZpZ1Jlc3BvbnNlEoYBCg9HZXRQdWJsaWNDb25maWcSOC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRQdWJsaWNDb25maWdSZXF1ZXN0GjkuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0UHVibGljQ29uZmlnUmVzcG9uc2USdwoKQWRtaW5Mb2dpbhIzLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkFkbWluTG9naW5SZXF1ZXN0GjQuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuQWRtaW5Mb2dpblJlc3BvbnNlEoMBCg5DcmVhdGVSZXNvdXJjZRI3LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkNyZWF0ZVJlc291cmNlUmVxdWVzdBo4LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkNyZWF0ZVJlc291cmNlUmVzcG9uc2USmwEKFkNyZWF0ZU9yVXBkYXRlUmVzb3VyY2USPy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5DcmVhdGVPclVwZGF0ZVJlc291cmNlUmVxdWVzdBpALmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkNyZWF0ZU9yVXBkYXRlUmVzb3VyY2VSZXNwb25zZRKDAQoOVXBkYXRlUmVzb3VyY2USNy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5VcGRhdGVSZXNvdXJjZVJlcXVlc3QaOC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5VcGRhdGVSZXNvdXJjZVJlc3BvbnNlEoMBCg5EZWxldGVSZXNvdXJjZRI3LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkRlbGV0ZVJlc291cmNlUmVxdWVzdBo4LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkRlbGV0ZVJlc291cmNlUmVzcG9uc2USdwoKTGlzdFN0YWdlcxIzLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkxpc3RTdGFnZXNSZXF1ZXN0GjQuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdFN0YWdlc1Jlc3BvbnNlEncKCkxpc3RJbWFnZXMSMy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5MaXN0SW1hZ2VzUmVxdWVzdBo0LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkxpc3RJbWFnZXNSZXNwb25zZRJxCghHZXRTdGFnZRIxLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldFN0YWdlUmVxdWVzdBoyLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldFN0YWdlUmVzcG9uc2USfAoLV2F0Y2hTdGFnZXMSNC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5XYXRjaFN0YWdlc1JlcXVlc3QaNS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5XYXRjaFN0YWdlc1Jlc3BvbnNlMAESegoLRGVsZXRlU3RhZ2USNC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5EZWxldGVTdGFnZVJlcXVlc3QaNS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5EZWxldGVTdGFnZVJlc3BvbnNlEn0KDFJlZnJlc2hTdGFnZRI1LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlJlZnJlc2hTdGFnZVJlcXVlc3QaNi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5SZWZyZXNoU3RhZ2VSZXNwb25zZRKJAQoQR2V0Q2x1c3RlckNvbmZpZxI5LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldENsdXN0ZXJDb25maWdSZXF1ZXN0GjouYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0Q2x1c3RlckNvbmZpZ1Jlc3BvbnNlEpIBChNEZWxldGVDbHVzdGVyQ29uZmlnEjwuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuRGVsZXRlQ2x1c3RlckNvbmZpZ1JlcXVlc3QaPS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5EZWxldGVDbHVzdGVyQ29uZmlnUmVzcG9uc2USkQEKEldhdGNoQ2x1c3RlckNvbmZpZxI7LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLldhdGNoQ2x1c3RlckNvbmZpZ1JlcXVlc3QaPC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5XYXRjaENsdXN0ZXJDb25maWdSZXNwb25zZTABEpUBChRSZWZyZXNoQ2x1c3RlckNvbmZpZxI9LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlJlZnJlc2hDbHVzdGVyQ29uZmlnUmVxdWVzdBo+LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlJlZnJlc2hDbHVzdGVyQ29uZmlnUmVzcG9uc2USgwEKDkxpc3RQcm9tb3Rpb25zEjcuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdFByb21vdGlvbnNSZXF1ZXN0GjguYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdFByb21vdGlvbnNSZXNwb25zZRKIAQoPV2F0Y2hQcm9tb3Rpb25zEjguYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuV2F0Y2hQcm9tb3Rpb25zUmVxdWVzdBo5LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLldhdGNoUHJvbW90aW9uc1Jlc3BvbnNlMAESfQoMR2V0UHJvbW90aW9uEjUuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0UHJvbW90aW9uUmVxdWVzdBo2LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldFByb21vdGlvblJlc3BvbnNlEoUBCg5XYXRjaFByb21vdGlvbhI3LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLldhdGNoUHJvbW90aW9uUmVxdWVzdBo4LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLldhdGNoUHJvbW90aW9uUmVzcG9uc2UwARKDAQoOQWJvcnRQcm9tb3Rpb24SNy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5BYm9ydFByb21vdGlvblJlcXVlc3QaOC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5BYm9ydFByb21vdGlvblJlc3BvbnNlEoABCg1EZWxldGVQcm9qZWN0EjYuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuRGVsZXRlUHJvamVjdFJlcXVlc3QaNy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5EZWxldGVQcm9qZWN0UmVzcG9uc2USdwoKR2V0UHJvamVjdBIzLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldFByb2plY3RSZXF1ZXN0GjQuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0UHJvamVjdFJlc3BvbnNlEn0KDExpc3RQcm9qZWN0cxI1LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkxpc3RQcm9qZWN0c1JlcXVlc3QaNi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5MaXN0UHJvamVjdHNSZXNwb25zZRKJAQoQR2V0UHJvamVjdENvbmZpZxI5LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldFByb2plY3RDb25maWdSZXF1ZXN0GjouYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0UHJvamVjdENvbmZpZ1Jlc3BvbnNlEpIBChNEZWxldGVQcm9qZWN0Q29uZmlnEjwuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuRGVsZXRlUHJvamVjdENvbmZpZ1JlcXVlc3QaPS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5EZWxldGVQcm9qZWN0Q29uZmlnUmVzcG9uc2USkQEKEldhdGNoUHJvamVjdENvbmZpZxI7LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLldhdGNoUHJvamVjdENvbmZpZ1JlcXVlc3QaPC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5XYXRjaFByb2plY3RDb25maWdSZXNwb25zZTABEpUBChRSZWZyZXNoUHJvamVjdENvbmZpZxI9LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlJlZnJlc2hQcm9qZWN0Q29uZmlnUmVxdWVzdBo+LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlJlZnJlc2hQcm9qZWN0Q29uZmlnUmVzcG9uc2USgwEKDkFwcHJvdmVGcmVpZ2h0EjcuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuQXBwcm92ZUZyZWlnaHRSZXF1ZXN0GjguYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuQXBwcm92ZUZyZWlnaHRSZXNwb25zZRKAAQoNRGVsZXRlRnJlaWdodBI2LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkRlbGV0ZUZyZWlnaHRSZXF1ZXN0GjcuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuRGVsZXRlRnJlaWdodFJlc3BvbnNlEncKCkdldEZyZWlnaHQSMy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRGcmVpZ2h0UmVxdWVzdBo0LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldEZyZWlnaHRSZXNwb25zZRJ/CgxXYXRjaEZyZWlnaHQSNS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5XYXRjaEZyZWlnaHRSZXF1ZXN0GjYuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuV2F0Y2hGcmVpZ2h0UmVzcG9uc2UwARKDAQoOUHJvbW90ZVRvU3RhZ2USNy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5Qcm9tb3RlVG9TdGFnZVJlcXVlc3QaOC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5Qcm9tb3RlVG9TdGFnZVJlc3BvbnNlEowBChFQcm9tb3RlRG93bnN0cmVhbRI6LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlByb21vdGVEb3duc3RyZWFtUmVxdWVzdBo7LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlByb21vdGVEb3duc3RyZWFtUmVzcG9uc2USfQoMUXVlcnlGcmVpZ2h0EjUuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUXVlcnlGcmVpZ2h0UmVxdWVzdBo2LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlF1ZXJ5RnJlaWdodFJlc3BvbnNlEo8BChJVcGRhdGVGcmVpZ2h0QWxpYXMSOy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5VcGRhdGVGcmVpZ2h0QWxpYXNSZXF1ZXN0GjwuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuVXBkYXRlRnJlaWdodEFsaWFzUmVzcG9uc2UScQoIUmV2ZXJpZnkSMS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5SZXZlcmlmeVJlcXVlc3QaMi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5SZXZlcmlmeVJlc3BvbnNlEowBChFBYm9ydFZlcmlmaWNhdGlvbhI6LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkFib3J0VmVyaWZpY2F0aW9uUmVxdWVzdBo7LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkFib3J0VmVyaWZpY2F0aW9uUmVzcG9uc2USgwEKDkxpc3RXYXJlaG91c2VzEjcuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdFdhcmVob3VzZXNSZXF1ZXN0GjguYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdFdhcmVob3VzZXNSZXNwb25zZRJ9CgxHZXRXYXJlaG91c2USNS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRXYXJlaG91c2VSZXF1ZXN0GjYuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0V2FyZWhvdXNlUmVzcG9uc2USiAEKD1dhdGNoV2FyZWhvdXNlcxI4LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLldhdGNoV2FyZWhvdXNlc1JlcXVlc3QaOS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5XYXRjaFdhcmVob3VzZXNSZXNwb25zZTABEoYBCg9EZWxldGVXYXJlaG91c2USOC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5EZWxldGVXYXJlaG91c2VSZXF1ZXN0GjkuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuRGVsZXRlV2FyZWhvdXNlUmVzcG9uc2USiQEKEFJlZnJlc2hXYXJlaG91c2USOS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5SZWZyZXNoV2FyZWhvdXNlUmVxdWVzdBo6LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlJlZnJlc2hXYXJlaG91c2VSZXNwb25zZRKMAQoRQ3JlYXRlQ3JlZGVudGlhbHMSOi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5DcmVhdGVDcmVkZW50aWFsc1JlcXVlc3QaOy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5DcmVhdGVDcmVkZW50aWFsc1Jlc3BvbnNlEowBChFEZWxldGVDcmVkZW50aWFscxI6LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkRlbGV0ZUNyZWRlbnRpYWxzUmVxdWVzdBo7LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkRlbGV0ZUNyZWRlbnRpYWxzUmVzcG9uc2USgwEKDkdldENyZWRlbnRpYWxzEjcuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0Q3JlZGVudGlhbHNSZXF1ZXN0GjguYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0Q3JlZGVudGlhbHNSZXNwb25zZRKGAQoPTGlzdENyZWRlbnRpYWxzEjguYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdENyZWRlbnRpYWxzUmVxdWVzdBo5LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkxpc3RDcmVkZW50aWFsc1Jlc3BvbnNlEowBChFVcGRhdGVDcmVkZW50aWFscxI6LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlVwZGF0ZUNyZWRlbnRpYWxzUmVxdWVzdBo7LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlVwZGF0ZUNyZWRlbnRpYWxzUmVzcG9uc2USjwEKEkxpc3RQcm9qZWN0U2VjcmV0cxI7LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkxpc3RQcm9qZWN0U2VjcmV0c1JlcXVlc3QaPC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5MaXN0UHJvamVjdFNlY3JldHNSZXNwb25zZRKSAQoTQ3JlYXRlUHJvamVjdFNlY3JldBI8LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkNyZWF0ZVByb2plY3RTZWNyZXRSZXF1ZXN0Gj0uYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuQ3JlYXRlUHJvamVjdFNlY3JldFJlc3BvbnNlEpIBChNVcGRhdGVQcm9qZWN0U2VjcmV0EjwuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuVXBkYXRlUHJvamVjdFNlY3JldFJlcXVlc3QaPS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5VcGRhdGVQcm9qZWN0U2VjcmV0UmVzcG9uc2USkgEKE0RlbGV0ZVByb2plY3RTZWNyZXQSPC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5EZWxldGVQcm9qZWN0U2VjcmV0UmVxdWVzdBo9LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkRlbGV0ZVByb2plY3RTZWNyZXRSZXNwb25zZRKDAQoOTGlzdENvbmZpZ01hcHMSNy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5MaXN0Q29uZmlnTWFwc1JlcXVlc3QaOC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5MaXN0Q29uZmlnTWFwc1Jlc3BvbnNlEn0KDEdldENvbmZpZ01hcBI1LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldENvbmZpZ01hcFJlcXVlc3QaNi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRDb25maWdNYXBSZXNwb25zZRKYAQoVTGlzdEFuYWx5c2lzVGVtcGxhdGVzEj4uYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdEFuYWx5c2lzVGVtcGxhdGVzUmVxdWVzdBo/LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkxpc3RBbmFseXNpc1RlbXBsYXRlc1Jlc3BvbnNlEpIBChNHZXRBbmFseXNpc1RlbXBsYXRlEjwuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0QW5hbHlzaXNUZW1wbGF0ZVJlcXVlc3QaPS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRBbmFseXNpc1RlbXBsYXRlUmVzcG9uc2USmwEKFkRlbGV0ZUFuYWx5c2lzVGVtcGxhdGUSPy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5EZWxldGVBbmFseXNpc1RlbXBsYXRlUmVxdWVzdBpALmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkRlbGV0ZUFuYWx5c2lzVGVtcGxhdGVSZXNwb25zZRKtAQocTGlzdENsdXN0ZXJBbmFseXNpc1RlbXBsYXRlcxJFLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkxpc3RDbHVzdGVyQW5hbHlzaXNUZW1wbGF0ZXNSZXF1ZXN0GkYuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdENsdXN0ZXJBbmFseXNpc1RlbXBsYXRlc1Jlc3BvbnNlEqcBChpHZXRDbHVzdGVyQW5hbHlzaXNUZW1wbGF0ZRJDLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldENsdXN0ZXJBbmFseXNpc1RlbXBsYXRlUmVxdWVzdBpELmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldENsdXN0ZXJBbmFseXNpc1RlbXBsYXRlUmVzcG9uc2USsAEKHURlbGV0ZUNsdXN0ZXJBbmFseXNpc1RlbXBsYXRlEkYuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuRGVsZXRlQ2x1c3RlckFuYWx5c2lzVGVtcGxhdGVSZXF1ZXN0GkcuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuRGVsZXRlQ2x1c3RlckFuYWx5c2lzVGVtcGxhdGVSZXNwb25zZRKDAQoOR2V0QW5hbHlzaXNSdW4SNy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRBbmFseXNpc1J1blJlcXVlc3QaOC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRBbmFseXNpc1J1blJlc3BvbnNlEpEBChJHZXRBbmFseXNpc1J1bkxvZ3MSOy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRBbmFseXNpc1J1bkxvZ3NSZXF1ZXN0GjwuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0QW5hbHlzaXNSdW5Mb2dzUmVzcG9uc2UwARKMAQoRTGlzdFByb2plY3RFdmVudHMSOi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5MaXN0UHJvamVjdEV2ZW50c1JlcXVlc3QaOy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5MaXN0UHJvamVjdEV2ZW50c1Jlc3BvbnNlEo8BChJMaXN0UHJvbW90aW9uVGFza3MSOy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5MaXN0UHJvbW90aW9uVGFza3NSZXF1ZXN0GjwuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdFByb21vdGlvblRhc2tzUmVzcG9uc2USpAEKGUxpc3RDbHVzdGVyUHJvbW90aW9uVGFza3MSQi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5MaXN0Q2x1c3RlclByb21vdGlvblRhc2tzUmVxdWVzdBpDLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkxpc3RDbHVzdGVyUHJvbW90aW9uVGFza3NSZXNwb25zZRKJAQoQR2V0UHJvbW90aW9uVGFzaxI5LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldFByb21vdGlvblRhc2tSZXF1ZXN0GjouYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0UHJvbW90aW9uVGFza1Jlc3BvbnNlEp4BChdHZXRDbHVzdGVyUHJvbW90aW9uVGFzaxJALmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldENsdXN0ZXJQcm9tb3Rpb25UYXNrUmVxdWVzdBpBLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldENsdXN0ZXJQcm9tb3Rpb25UYXNrUmVzcG9uc2USdwoKQ3JlYXRlUm9sZRIzLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkNyZWF0ZVJvbGVSZXF1ZXN0GjQuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuQ3JlYXRlUm9sZVJlc3BvbnNlEncKCkRlbGV0ZVJvbGUSMy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5EZWxldGVSb2xlUmVxdWVzdBo0LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkRlbGV0ZVJvbGVSZXNwb25zZRJuCgdHZXRSb2xlEjAuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0Um9sZVJlcXVlc3QaMS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRSb2xlUmVzcG9uc2USaAoFR3JhbnQSLi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HcmFudFJlcXVlc3QaLy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HcmFudFJlc3BvbnNlEnQKCUxpc3RSb2xlcxIyLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkxpc3RSb2xlc1JlcXVlc3QaMy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5MaXN0Um9sZXNSZXNwb25zZRJrCgZSZXZva2USLy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5SZXZva2VSZXF1ZXN0GjAuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUmV2b2tlUmVzcG9uc2USdwoKVXBkYXRlUm9sZRIzLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlVwZGF0ZVJvbGVSZXF1ZXN0GjQuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuVXBkYXRlUm9sZVJlc3BvbnNlEo8BChJMaXN0Q2x1c3RlclNlY3JldHMSOy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5MaXN0Q2x1c3RlclNlY3JldHNSZXF1ZXN0GjwuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdENsdXN0ZXJTZWNyZXRzUmVzcG9uc2USkgEKE0NyZWF0ZUNsdXN0ZXJTZWNyZXQSPC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5DcmVhdGVDbHVzdGVyU2VjcmV0UmVxdWVzdBo9LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkNyZWF0ZUNsdXN0ZXJTZWNyZXRSZXNwb25zZRKSAQoTVXBkYXRlQ2x1c3RlclNlY3JldBI8LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlVwZGF0ZUNsdXN0ZXJTZWNyZXRSZXF1ZXN0Gj0uYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuVXBkYXRlQ2x1c3RlclNlY3JldFJlc3BvbnNlEpIBChNEZWxldGVDbHVzdGVyU2VjcmV0EjwuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuRGVsZXRlQ2x1c3RlclNlY3JldFJlcXVlc3QaPS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5EZWxldGVDbHVzdGVyU2VjcmV0UmVzcG9uc2VCkwIKJGNvbS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMUIMU2VydmljZVByb3RvUAFaOGdpdGh1Yi5jb20vYWt1aXR5L2thcmdvL2FwaS9zZXJ2aWNlL3YxYWxwaGExO3N2Y3YxYWxwaGExogIEQUlLU6oCIEFrdWl0eS5Jby5LYXJnby5TZXJ2aWNlLlYxYWxwaGExygIgQWt1aXR5XElvXEthcmdvXFNlcnZpY2VcVjFhbHBoYTHiAixBa3VpdHlcSW9cS2FyZ29cU2VydmljZVxWMWFscGhhMVxHUEJNZXRhZGF0YeoCJEFrdWl0eTo6SW86OkthcmdvOjpTZXJ2aWNlOjpWMWFscGhhMWIGcHJvdG8z", [file_google_protobuf_timestamp, file_api_v1alpha1_generated, file_k8s_io_api_core_v1_generated, file_api_stubs_rollouts_v1alpha1_generated, file_api_rbac_v1alpha1_generated]);
+  fileDesc("CiJhcGkvc2VydmljZS92MWFscGhhMS9zZXJ2aWNlLnByb3RvEiBha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMSKrAQoRQ29tcG9uZW50VmVyc2lvbnMSQgoGc2VydmVyGAEgASgLMi0uYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuVmVyc2lvbkluZm9IAIgBARI/CgNjbGkYAiABKAsyLS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5WZXJzaW9uSW5mb0gBiAEBQgkKB19zZXJ2ZXJCBgoEX2NsaSKyAQoLVmVyc2lvbkluZm8SDwoHdmVyc2lvbhgBIAEoCRISCgpnaXRfY29tbWl0GAIgASgJEhYKDmdpdF90cmVlX2RpcnR5GAMgASgIEi4KCmJ1aWxkX3RpbWUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhIKCmdvX3ZlcnNpb24YBSABKAkSEAoIY29tcGlsZXIYBiABKAkSEAoIcGxhdGZvcm0YByABKAkiFwoVR2V0VmVyc2lvbkluZm9SZXF1ZXN0Il0KFkdldFZlcnNpb25JbmZvUmVzcG9uc2USQwoMdmVyc2lvbl9pbmZvGAEgASgLMi0uYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuVmVyc2lvbkluZm8iEgoQR2V0Q29uZmlnUmVxdWVzdCItCgtBcmdvQ0RTaGFyZBILCgN1cmwYASABKAkSEQoJbmFtZXNwYWNlGAIgASgJIscCChFHZXRDb25maWdSZXNwb25zZRJcCg1hcmdvY2Rfc2hhcmRzGAEgAygLMkUuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0Q29uZmlnUmVzcG9uc2UuQXJnb2NkU2hhcmRzRW50cnkSIQoZc2VjcmV0X21hbmFnZW1lbnRfZW5hYmxlZBgCIAEoCBIhChljbHVzdGVyX3NlY3JldHNfbmFtZXNwYWNlGAMgASgJEioKImhhc19hbmFseXNpc19ydW5fbG9nc191cmxfdGVtcGxhdGUYBCABKAgaYgoRQXJnb2NkU2hhcmRzRW50cnkSCwoDa2V5GAEgASgJEjwKBXZhbHVlGAIgASgLMi0uYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuQXJnb0NEU2hhcmQ6AjgBIhgKFkdldFB1YmxpY0NvbmZpZ1JlcXVlc3QijgEKF0dldFB1YmxpY0NvbmZpZ1Jlc3BvbnNlEkEKC29pZGNfY29uZmlnGAEgASgLMiwuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuT0lEQ0NvbmZpZxIdChVhZG1pbl9hY2NvdW50X2VuYWJsZWQYAiABKAgSEQoJc2tpcF9hdXRoGAMgASgIIloKCk9JRENDb25maWcSEgoKaXNzdWVyX3VybBgBIAEoCRIRCgljbGllbnRfaWQYAiABKAkSDgoGc2NvcGVzGAMgAygJEhUKDWNsaV9jbGllbnRfaWQYBCABKAkiJQoRQWRtaW5Mb2dpblJlcXVlc3QSEAoIcGFzc3dvcmQYASABKAkiJgoSQWRtaW5Mb2dpblJlc3BvbnNlEhAKCGlkX3Rva2VuGAEgASgJIikKFUNyZWF0ZVJlc291cmNlUmVxdWVzdBIQCghtYW5pZmVzdBgBIAEoDCJWChRDcmVhdGVSZXNvdXJjZVJlc3VsdBIjChljcmVhdGVkX3Jlc291cmNlX21hbmlmZXN0GAEgASgMSAASDwoFZXJyb3IYAiABKAlIAEIICgZyZXN1bHQiYQoWQ3JlYXRlUmVzb3VyY2VSZXNwb25zZRJHCgdyZXN1bHRzGAEgAygLMjYuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuQ3JlYXRlUmVzb3VyY2VSZXN1bHQiQgodQ3JlYXRlT3JVcGRhdGVSZXNvdXJjZVJlcXVlc3QSEAoIbWFuaWZlc3QYASABKAwSDwoHZHJ5X3J1bhgCIAEoCCKjAQocQ3JlYXRlT3JVcGRhdGVSZXNvdXJjZVJlc3VsdBIjChljcmVhdGVkX3Jlc291cmNlX21hbmlmZXN0GAEgASgMSAASIwoZdXBkYXRlZF9yZXNvdXJjZV9tYW5pZmVzdBgCIAEoDEgAEg8KBWVycm9yGAMgASgJSAASHgoWbGl2ZV9yZXNvdXJjZV9tYW5pZmVzdBgEIAEoDEIICgZyZXN1bHQicQoeQ3JlYXRlT3JVcGRhdGVSZXNvdXJjZVJlc3BvbnNlEk8KB3Jlc3VsdHMYASADKAsyPi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5DcmVhdGVPclVwZGF0ZVJlc291cmNlUmVzdWx0IikKFVVwZGF0ZVJlc291cmNlUmVxdWVzdBIQCghtYW5pZmVzdBgBIAEoDCJWChRVcGRhdGVSZXNvdXJjZVJlc3VsdBIjChl1cGRhdGVkX3Jlc291cmNlX21hbmlmZXN0GAEgASgMSAASDwoFZXJyb3IYAiABKAlIAEIICgZyZXN1bHQiYQoWVXBkYXRlUmVzb3VyY2VSZXNwb25zZRJHCgdyZXN1bHRzGAEgAygLMjYuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuVXBkYXRlUmVzb3VyY2VSZXN1bHQiKQoVRGVsZXRlUmVzb3VyY2VSZXF1ZXN0EhAKCG1hbmlmZXN0GAEgASgMIlYKFERlbGV0ZVJlc291cmNlUmVzdWx0EiMKGWRlbGV0ZWRfcmVzb3VyY2VfbWFuaWZlc3QYASABKAxIABIPCgVlcnJvchgCIAEoCUgAQggKBnJlc3VsdCJhChZEZWxldGVSZXNvdXJjZVJlc3BvbnNlEkcKB3Jlc3VsdHMYASADKAsyNi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5EZWxldGVSZXNvdXJjZVJlc3VsdCIkChFMaXN0U3RhZ2VzUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJIlEKEkxpc3RTdGFnZXNSZXNwb25zZRI7CgZzdGFnZXMYASADKAsyKy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuU3RhZ2UiJAoRTGlzdEltYWdlc1JlcXVlc3QSDwoHcHJvamVjdBgBIAEoCSK/AQoSTGlzdEltYWdlc1Jlc3BvbnNlElAKBmltYWdlcxgCIAMoCzJALmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkxpc3RJbWFnZXNSZXNwb25zZS5JbWFnZXNFbnRyeRpXCgtJbWFnZXNFbnRyeRILCgNrZXkYASABKAkSNwoFdmFsdWUYAiABKAsyKC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5UYWdNYXA6AjgBIqgBCgZUYWdNYXASQAoEdGFncxgBIAMoCzIyLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlRhZ01hcC5UYWdzRW50cnkaXAoJVGFnc0VudHJ5EgsKA2tleRgBIAEoCRI+CgV2YWx1ZRgCIAEoCzIvLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkltYWdlU3RhZ2VNYXA6AjgBIosBCg1JbWFnZVN0YWdlTWFwEksKBnN0YWdlcxgBIAMoCzI7LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkltYWdlU3RhZ2VNYXAuU3RhZ2VzRW50cnkaLQoLU3RhZ2VzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgFOgI4ASJtCg9HZXRTdGFnZVJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJEjsKBmZvcm1hdBgDIAEoDjIrLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlJhd0Zvcm1hdCJpChBHZXRTdGFnZVJlc3BvbnNlEjwKBXN0YWdlGAEgASgLMisuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlN0YWdlSAASDQoDcmF3GAIgASgMSABCCAoGcmVzdWx0IjMKEldhdGNoU3RhZ2VzUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkiXwoTV2F0Y2hTdGFnZXNSZXNwb25zZRI6CgVzdGFnZRgBIAEoCzIrLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5TdGFnZRIMCgR0eXBlGAIgASgJIjMKEkRlbGV0ZVN0YWdlUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkiFQoTRGVsZXRlU3RhZ2VSZXNwb25zZSI0ChNSZWZyZXNoU3RhZ2VSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCSJSChRSZWZyZXNoU3RhZ2VSZXNwb25zZRI6CgVzdGFnZRgBIAEoCzIrLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5TdGFnZSJWChdHZXRDbHVzdGVyQ29uZmlnUmVxdWVzdBI7CgZmb3JtYXQYASABKA4yKy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5SYXdGb3JtYXQiggEKGEdldENsdXN0ZXJDb25maWdSZXNwb25zZRJNCg5jbHVzdGVyX2NvbmZpZxgBIAEoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5DbHVzdGVyQ29uZmlnSAASDQoDcmF3GAIgASgMSABCCAoGcmVzdWx0IhwKGkRlbGV0ZUNsdXN0ZXJDb25maWdSZXF1ZXN0Ih0KG0RlbGV0ZUNsdXN0ZXJDb25maWdSZXNwb25zZSIbChlXYXRjaENsdXN0ZXJDb25maWdSZXF1ZXN0IncKGldhdGNoQ2x1c3RlckNvbmZpZ1Jlc3BvbnNlEksKDmNsdXN0ZXJfY29uZmlnGAEgASgLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkNsdXN0ZXJDb25maWcSDAoEdHlwZRgCIAEoCSIdChtSZWZyZXNoQ2x1c3RlckNvbmZpZ1JlcXVlc3QiawocUmVmcmVzaENsdXN0ZXJDb25maWdSZXNwb25zZRJLCg5jbHVzdGVyX2NvbmZpZxgBIAEoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5DbHVzdGVyQ29uZmlnIkYKFUxpc3RQcm9tb3Rpb25zUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEhIKBXN0YWdlGAIgASgJSACIAQFCCAoGX3N0YWdlIl0KFkxpc3RQcm9tb3Rpb25zUmVzcG9uc2USQwoKcHJvbW90aW9ucxgBIAMoCzIvLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb24iRwoWV2F0Y2hQcm9tb3Rpb25zUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEhIKBXN0YWdlGAIgASgJSACIAQFCCAoGX3N0YWdlImsKF1dhdGNoUHJvbW90aW9uc1Jlc3BvbnNlEkIKCXByb21vdGlvbhgBIAEoCzIvLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb24SDAoEdHlwZRgCIAEoCSJxChNHZXRQcm9tb3Rpb25SZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCRI7CgZmb3JtYXQYAyABKA4yKy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5SYXdGb3JtYXQidQoUR2V0UHJvbW90aW9uUmVzcG9uc2USRAoJcHJvbW90aW9uGAEgASgLMi8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvbkgAEg0KA3JhdxgCIAEoDEgAQggKBnJlc3VsdCI2ChVXYXRjaFByb21vdGlvblJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJImoKFldhdGNoUHJvbW90aW9uUmVzcG9uc2USQgoJcHJvbW90aW9uGAEgASgLMi8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvbhIMCgR0eXBlGAIgASgJIjYKFUFib3J0UHJvbW90aW9uUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkiGAoWQWJvcnRQcm9tb3Rpb25SZXNwb25zZSJWChdHZXRQcm9tb3Rpb25Mb2dzUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkSDAoEc3RlcBgDIAEoCRIOCgZmb2xsb3cYBCABKAgiNwoYR2V0UHJvbW90aW9uTG9nc1Jlc3BvbnNlEgwKBHN0ZXAYASABKAkSDQoFY2h1bmsYAiABKAkiUwoWUmVzdW1lUHJvbW90aW9uUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkSEQoEc3RlcBgDIAEoA0gAiAEBQgcKBV9zdGVwIl0KF1Jlc3VtZVByb21vdGlvblJlc3BvbnNlEkIKCXByb21vdGlvbhgBIAEoCzIvLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb24iJAoURGVsZXRlUHJvamVjdFJlcXVlc3QSDAoEbmFtZRgBIAEoCSIXChVEZWxldGVQcm9qZWN0UmVzcG9uc2UiXgoRR2V0UHJvamVjdFJlcXVlc3QSDAoEbmFtZRgBIAEoCRI7CgZmb3JtYXQYAiABKA4yKy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5SYXdGb3JtYXQibwoSR2V0UHJvamVjdFJlc3BvbnNlEkAKB3Byb2plY3QYASABKAsyLS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvamVjdEgAEg0KA3JhdxgCIAEoDEgAQggKBnJlc3VsdCKEAQoTTGlzdFByb2plY3RzUmVxdWVzdBIWCglwYWdlX3NpemUYASABKAVIAIgBARIRCgRwYWdlGAIgASgFSAGIAQESEwoGZmlsdGVyGAMgASgJSAKIAQESCwoDdWlkGAQgAygJQgwKCl9wYWdlX3NpemVCBwoFX3BhZ2VCCQoHX2ZpbHRlciJmChRMaXN0UHJvamVjdHNSZXNwb25zZRI/Cghwcm9qZWN0cxgBIAMoCzItLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9qZWN0Eg0KBXRvdGFsGAIgASgFImcKF0dldFByb2plY3RDb25maWdSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSOwoGZm9ybWF0GAIgASgOMisuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUmF3Rm9ybWF0IoIBChhHZXRQcm9qZWN0Q29uZmlnUmVzcG9uc2USTQoOcHJvamVjdF9jb25maWcYASABKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvamVjdENvbmZpZ0gAEg0KA3JhdxgCIAEoDEgAQggKBnJlc3VsdCItChpEZWxldGVQcm9qZWN0Q29uZmlnUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJIh0KG0RlbGV0ZVByb2plY3RDb25maWdSZXNwb25zZSIsChlXYXRjaFByb2plY3RDb25maWdSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkidwoaV2F0Y2hQcm9qZWN0Q29uZmlnUmVzcG9uc2USSwoOcHJvamVjdF9jb25maWcYASABKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvamVjdENvbmZpZxIMCgR0eXBlGAIgASgJIi4KG1JlZnJlc2hQcm9qZWN0Q29uZmlnUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJImsKHFJlZnJlc2hQcm9qZWN0Q29uZmlnUmVzcG9uc2USSwoOcHJvamVjdF9jb25maWcYASABKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvamVjdENvbmZpZyJMChhHZXRQcm9tb3Rpb25XYXZlc1JlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIOCgZwb2xpY3kYAiABKAkSDwoHZnJlaWdodBgDIAEoCSJqChlHZXRQcm9tb3Rpb25XYXZlc1Jlc3BvbnNlEk0KCHByb2dyZXNzGAEgAygLMjsuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvbldhdmVQcm9ncmVzcyJpChVBcHByb3ZlRnJlaWdodFJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJEg0KBWFsaWFzGAMgASgJEg0KBXN0YWdlGAQgASgJEhMKC2FsbG93X3N0YWxlGAUgASgIIhgKFkFwcHJvdmVGcmVpZ2h0UmVzcG9uc2UihQIKFENyZWF0ZUZyZWlnaHRSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSEQoJd2FyZWhvdXNlGAIgASgJEkAKB2NvbW1pdHMYAyADKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuR2l0Q29tbWl0EjsKBmltYWdlcxgEIAMoCzIrLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5JbWFnZRI7CgZjaGFydHMYBSADKAsyKy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQ2hhcnQSDQoFYWxpYXMYBiABKAkiVwoVQ3JlYXRlRnJlaWdodFJlc3BvbnNlEj4KB2ZyZWlnaHQYASABKAsyLS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodCJEChREZWxldGVGcmVpZ2h0UmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkSDQoFYWxpYXMYAyABKAkiFwoVRGVsZXRlRnJlaWdodFJlc3BvbnNlIn4KEUdldEZyZWlnaHRSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCRINCgVhbGlhcxgDIAEoCRI7CgZmb3JtYXQYBCABKA4yKy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5SYXdGb3JtYXQibwoSR2V0RnJlaWdodFJlc3BvbnNlEkAKB2ZyZWlnaHQYASABKAsyLS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodEgAEg0KA3JhdxgCIAEoDEgAQggKBnJlc3VsdCJIChhHZXRGcmVpZ2h0TGluZWFnZVJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJEg0KBWFsaWFzGAMgASgJIl4KGUdldEZyZWlnaHRMaW5lYWdlUmVzcG9uc2USQQoHbGluZWFnZRgBIAEoCzIwLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkZyZWlnaHRMaW5lYWdlIqcCCg5GcmVpZ2h0TGluZWFnZRI+CgdmcmVpZ2h0GAEgASgLMi0uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHQSRwoHY29tbWl0cxgCIAMoCzI2LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkZyZWlnaHRDb21taXRMaW5lYWdlEkUKBmltYWdlcxgDIAMoCzI1LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkZyZWlnaHRJbWFnZUxpbmVhZ2USRQoGc3RhZ2VzGAQgAygLMjUuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuRnJlaWdodFN0YWdlTGluZWFnZSKjAQoURnJlaWdodENvbW1pdExpbmVhZ2USPwoGY29tbWl0GAEgASgLMi8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkdpdENvbW1pdBJKCgpkaXNjb3ZlcmVkGAIgASgLMjYuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkRpc2NvdmVyZWRDb21taXQipQEKE0ZyZWlnaHRJbWFnZUxpbmVhZ2USOgoFaW1hZ2UYASABKAsyKy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuSW1hZ2USUgoKZGlzY292ZXJlZBgCIAEoCzI+LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5EaXNjb3ZlcmVkSW1hZ2VSZWZlcmVuY2UiwQIKE0ZyZWlnaHRTdGFnZUxpbmVhZ2USDQoFc3RhZ2UYASABKAkSRQoIYXBwcm92ZWQYAiABKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQXBwcm92ZWRTdGFnZRJFCgh2ZXJpZmllZBgDIAEoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5WZXJpZmllZFN0YWdlEkMKB2N1cnJlbnQYBCABKAsyMi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQ3VycmVudFN0YWdlEkgKC2RlcGxveW1lbnRzGAUgAygLMjMuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuRnJlaWdodERlcGxveW1lbnQiugEKEUZyZWlnaHREZXBsb3ltZW50EkIKCXByb21vdGlvbhgBIAEoCzIvLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb24STQoNdmVyaWZpY2F0aW9ucxgCIAMoCzI2LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5WZXJpZmljYXRpb25JbmZvEhIKCnByb3ZlbmFuY2UYAyABKAwiJgoTV2F0Y2hGcmVpZ2h0UmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJImQKFFdhdGNoRnJlaWdodFJlc3BvbnNlEj4KB2ZyZWlnaHQYASABKAsyLS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodBIMCgR0eXBlGAIgASgJIl8KFVByb21vdGVUb1N0YWdlUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEg0KBXN0YWdlGAIgASgJEg8KB2ZyZWlnaHQYAyABKAkSFQoNZnJlaWdodF9hbGlhcxgEIAEoCSJcChZQcm9tb3RlVG9TdGFnZVJlc3BvbnNlEkIKCXByb21vdGlvbhgBIAEoCzIvLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb24iYgoYUHJvbW90ZURvd25zdHJlYW1SZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDQoFc3RhZ2UYAiABKAkSDwoHZnJlaWdodBgDIAEoCRIVCg1mcmVpZ2h0X2FsaWFzGAQgASgJImAKGVByb21vdGVEb3duc3RyZWFtUmVzcG9uc2USQwoKcHJvbW90aW9ucxgBIAMoCzIvLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb24iigEKE1F1ZXJ5RnJlaWdodFJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRINCgVzdGFnZRgCIAEoCRIQCghncm91cF9ieRgDIAEoCRINCgVncm91cBgEIAEoCRIQCghvcmRlcl9ieRgFIAEoCRIPCgdyZXZlcnNlGAYgASgIEg8KB29yaWdpbnMYByADKAkiyAEKFFF1ZXJ5RnJlaWdodFJlc3BvbnNlElIKBmdyb3VwcxgBIAMoCzJCLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlF1ZXJ5RnJlaWdodFJlc3BvbnNlLkdyb3Vwc0VudHJ5GlwKC0dyb3Vwc0VudHJ5EgsKA2tleRgBIAEoCRI8CgV2YWx1ZRgCIAEoCzItLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkZyZWlnaHRMaXN0OgI4ASLEAQoLRnJlaWdodExpc3QSPgoHZnJlaWdodBgBIAMoCzItLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0EkcKBXN0YWxlGAIgAygLMjguYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuRnJlaWdodExpc3QuU3RhbGVFbnRyeRosCgpTdGFsZUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiYAoZVXBkYXRlRnJlaWdodEFsaWFzUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkSEQoJb2xkX2FsaWFzGAMgASgJEhEKCW5ld19hbGlhcxgEIAEoCSIcChpVcGRhdGVGcmVpZ2h0QWxpYXNSZXNwb25zZSIxCg9SZXZlcmlmeVJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRINCgVzdGFnZRgCIAEoCSISChBSZXZlcmlmeVJlc3BvbnNlIjoKGEFib3J0VmVyaWZpY2F0aW9uUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEg0KBXN0YWdlGAIgASgJIhsKGUFib3J0VmVyaWZpY2F0aW9uUmVzcG9uc2UiKAoVTGlzdFdhcmVob3VzZXNSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkiXQoWTGlzdFdhcmVob3VzZXNSZXNwb25zZRJDCgp3YXJlaG91c2VzGAEgAygLMi8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLldhcmVob3VzZSJxChNHZXRXYXJlaG91c2VSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCRI7CgZmb3JtYXQYAyABKA4yKy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5SYXdGb3JtYXQidQoUR2V0V2FyZWhvdXNlUmVzcG9uc2USRAoJd2FyZWhvdXNlGAEgASgLMi8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLldhcmVob3VzZUgAEg0KA3JhdxgCIAEoDEgAQggKBnJlc3VsdCI3ChZXYXRjaFdhcmVob3VzZXNSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCSJrChdXYXRjaFdhcmVob3VzZXNSZXNwb25zZRJCCgl3YXJlaG91c2UYASABKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuV2FyZWhvdXNlEgwKBHR5cGUYAiABKAkiNwoWRGVsZXRlV2FyZWhvdXNlUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkiGQoXRGVsZXRlV2FyZWhvdXNlUmVzcG9uc2UiOAoXUmVmcmVzaFdhcmVob3VzZVJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJIl4KGFJlZnJlc2hXYXJlaG91c2VSZXNwb25zZRJCCgl3YXJlaG91c2UYASABKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuV2FyZWhvdXNlIigKFUxpc3RDb25maWdNYXBzUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJIkwKFkxpc3RDb25maWdNYXBzUmVzcG9uc2USMgoLY29uZmlnX21hcHMYASADKAsyHS5rOHMuaW8uYXBpLmNvcmUudjEuQ29uZmlnTWFwInEKE0dldENvbmZpZ01hcFJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJEjsKBmZvcm1hdBgDIAEoDjIrLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlJhd0Zvcm1hdCJkChRHZXRDb25maWdNYXBSZXNwb25zZRIzCgpjb25maWdfbWFwGAEgASgLMh0uazhzLmlvLmFwaS5jb3JlLnYxLkNvbmZpZ01hcEgAEg0KA3JhdxgCIAEoDEgAQggKBnJlc3VsdCLGAQoYQ3JlYXRlQ3JlZGVudGlhbHNSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgIIAEoCRIMCgR0eXBlGAMgASgJEhkKCHJlcG9fdXJsGAQgASgJUgdyZXBvVVJMEikKEXJlcG9fdXJsX2lzX3JlZ2V4GAUgASgIUg5yZXBvVVJMSXNSZWdleBIQCgh1c2VybmFtZRgGIAEoCRIQCghwYXNzd29yZBgHIAEoCSJMChlDcmVhdGVDcmVkZW50aWFsc1Jlc3BvbnNlEi8KC2NyZWRlbnRpYWxzGAEgASgLMhouazhzLmlvLmFwaS5jb3JlLnYxLlNlY3JldCI5ChhEZWxldGVDcmVkZW50aWFsc1JlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJIhsKGURlbGV0ZUNyZWRlbnRpYWxzUmVzcG9uc2UicwoVR2V0Q3JlZGVudGlhbHNSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCRI7CgZmb3JtYXQYAyABKA4yKy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5SYXdGb3JtYXQiZAoWR2V0Q3JlZGVudGlhbHNSZXNwb25zZRIxCgtjcmVkZW50aWFscxgBIAEoCzIaLms4cy5pby5hcGkuY29yZS52MS5TZWNyZXRIABINCgNyYXcYAiABKAxIAEIICgZyZXN1bHQiKQoWTGlzdENyZWRlbnRpYWxzUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJIkoKF0xpc3RDcmVkZW50aWFsc1Jlc3BvbnNlEi8KC2NyZWRlbnRpYWxzGAEgAygLMhouazhzLmlvLmFwaS5jb3JlLnYxLlNlY3JldCLGAQoYVXBkYXRlQ3JlZGVudGlhbHNSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgIIAEoCRIMCgR0eXBlGAMgASgJEhkKCHJlcG9fdXJsGAQgASgJUgdyZXBvVVJMEikKEXJlcG9fdXJsX2lzX3JlZ2V4GAUgASgIUg5yZXBvVVJMSXNSZWdleBIQCgh1c2VybmFtZRgGIAEoCRIQCghwYXNzd29yZBgHIAEoCSJMChlVcGRhdGVDcmVkZW50aWFsc1Jlc3BvbnNlEi8KC2NyZWRlbnRpYWxzGAEgASgLMhouazhzLmlvLmFwaS5jb3JlLnYxLlNlY3JldCIsChlMaXN0UHJvamVjdFNlY3JldHNSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkiSQoaTGlzdFByb2plY3RTZWNyZXRzUmVzcG9uc2USKwoHc2VjcmV0cxgBIAMoCzIaLms4cy5pby5hcGkuY29yZS52MS5TZWNyZXQi0wEKGkNyZWF0ZVByb2plY3RTZWNyZXRSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRJUCgRkYXRhGAQgAygLMkYuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuQ3JlYXRlUHJvamVjdFNlY3JldFJlcXVlc3QuRGF0YUVudHJ5GisKCURhdGFFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIkkKG0NyZWF0ZVByb2plY3RTZWNyZXRSZXNwb25zZRIqCgZzZWNyZXQYASABKAsyGi5rOHMuaW8uYXBpLmNvcmUudjEuU2VjcmV0ItMBChpVcGRhdGVQcm9qZWN0U2VjcmV0UmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSVAoEZGF0YRgEIAMoCzJGLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlVwZGF0ZVByb2plY3RTZWNyZXRSZXF1ZXN0LkRhdGFFbnRyeRorCglEYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJJChtVcGRhdGVQcm9qZWN0U2VjcmV0UmVzcG9uc2USKgoGc2VjcmV0GAEgASgLMhouazhzLmlvLmFwaS5jb3JlLnYxLlNlY3JldCI7ChpEZWxldGVQcm9qZWN0U2VjcmV0UmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkiHQobRGVsZXRlUHJvamVjdFNlY3JldFJlc3BvbnNlIi8KHExpc3RBbmFseXNpc1RlbXBsYXRlc1JlcXVlc3QSDwoHcHJvamVjdBgBIAEoCSKCAQodTGlzdEFuYWx5c2lzVGVtcGxhdGVzUmVzcG9uc2USYQoSYW5hbHlzaXNfdGVtcGxhdGVzGAEgAygLMkUuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnN0dWJzLnJvbGxvdXRzLnYxYWxwaGExLkFuYWx5c2lzVGVtcGxhdGUieAoaR2V0QW5hbHlzaXNUZW1wbGF0ZVJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJEjsKBmZvcm1hdBgDIAEoDjIrLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlJhd0Zvcm1hdCKaAQobR2V0QW5hbHlzaXNUZW1wbGF0ZVJlc3BvbnNlEmIKEWFuYWx5c2lzX3RlbXBsYXRlGAEgASgLMkUuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnN0dWJzLnJvbGxvdXRzLnYxYWxwaGExLkFuYWx5c2lzVGVtcGxhdGVIABINCgNyYXcYAiABKAxIAEIICgZyZXN1bHQiPgodRGVsZXRlQW5hbHlzaXNUZW1wbGF0ZVJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJIiAKHkRlbGV0ZUFuYWx5c2lzVGVtcGxhdGVSZXNwb25zZSIlCiNMaXN0Q2x1c3RlckFuYWx5c2lzVGVtcGxhdGVzUmVxdWVzdCKzAQokTGlzdENsdXN0ZXJBbmFseXNpc1RlbXBsYXRlc1Jlc3BvbnNlEooBChpjbHVzdGVyX2FuYWx5c2lzX3RlbXBsYXRlcxgBIAMoCzJMLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS5zdHVicy5yb2xsb3V0cy52MWFscGhhMS5DbHVzdGVyQW5hbHlzaXNUZW1wbGF0ZVIYY2x1c3RlcmFuYWx5c2lzVGVtcGxhdGVzIm4KIUdldENsdXN0ZXJBbmFseXNpc1RlbXBsYXRlUmVxdWVzdBIMCgRuYW1lGAIgASgJEjsKBmZvcm1hdBgDIAEoDjIrLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlJhd0Zvcm1hdCKwAQoiR2V0Q2x1c3RlckFuYWx5c2lzVGVtcGxhdGVSZXNwb25zZRJxChljbHVzdGVyX2FuYWx5c2lzX3RlbXBsYXRlGAEgASgLMkwuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnN0dWJzLnJvbGxvdXRzLnYxYWxwaGExLkNsdXN0ZXJBbmFseXNpc1RlbXBsYXRlSAASDQoDcmF3GAIgASgMSABCCAoGcmVzdWx0IjQKJERlbGV0ZUNsdXN0ZXJBbmFseXNpc1RlbXBsYXRlUmVxdWVzdBIMCgRuYW1lGAIgASgJIicKJURlbGV0ZUNsdXN0ZXJBbmFseXNpc1RlbXBsYXRlUmVzcG9uc2UidQoVR2V0QW5hbHlzaXNSdW5SZXF1ZXN0EhEKCW5hbWVzcGFjZRgBIAEoCRIMCgRuYW1lGAIgASgJEjsKBmZvcm1hdBgDIAEoDjIrLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlJhd0Zvcm1hdCKLAQoWR2V0QW5hbHlzaXNSdW5SZXNwb25zZRJYCgxhbmFseXNpc19ydW4YASABKAsyQC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkuc3R1YnMucm9sbG91dHMudjFhbHBoYTEuQW5hbHlzaXNSdW5IABINCgNyYXcYAiABKAxIAEIICgZyZXN1bHQiaQoZR2V0QW5hbHlzaXNSdW5Mb2dzUmVxdWVzdBIRCgluYW1lc3BhY2UYASABKAkSDAoEbmFtZRgCIAEoCRITCgttZXRyaWNfbmFtZRgDIAEoCRIWCg5jb250YWluZXJfbmFtZRgEIAEoCSIrChpHZXRBbmFseXNpc1J1bkxvZ3NSZXNwb25zZRINCgVjaHVuaxgBIAEoCSIrChhMaXN0UHJvamVjdEV2ZW50c1JlcXVlc3QSDwoHcHJvamVjdBgBIAEoCSJGChlMaXN0UHJvamVjdEV2ZW50c1Jlc3BvbnNlEikKBmV2ZW50cxgBIAMoCzIZLms4cy5pby5hcGkuY29yZS52MS5FdmVudCIsChlMaXN0UHJvbW90aW9uVGFza3NSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkiagoaTGlzdFByb21vdGlvblRhc2tzUmVzcG9uc2USTAoPcHJvbW90aW9uX3Rhc2tzGAEgAygLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvblRhc2sidQoXR2V0UHJvbW90aW9uVGFza1JlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJEjsKBmZvcm1hdBgDIAEoDjIrLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlJhd0Zvcm1hdCKCAQoYR2V0UHJvbW90aW9uVGFza1Jlc3BvbnNlEk0KDnByb21vdGlvbl90YXNrGAEgASgLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvblRhc2tIABINCgNyYXcYAiABKAxIAEIICgZyZXN1bHQiIgogTGlzdENsdXN0ZXJQcm9tb3Rpb25UYXNrc1JlcXVlc3QigAEKIUxpc3RDbHVzdGVyUHJvbW90aW9uVGFza3NSZXNwb25zZRJbChdjbHVzdGVyX3Byb21vdGlvbl90YXNrcxgBIAMoCzI6LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5DbHVzdGVyUHJvbW90aW9uVGFzayJrCh5HZXRDbHVzdGVyUHJvbW90aW9uVGFza1JlcXVlc3QSDAoEbmFtZRgCIAEoCRI7CgZmb3JtYXQYAyABKA4yKy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5SYXdGb3JtYXQipgEKH0dldENsdXN0ZXJQcm9tb3Rpb25UYXNrUmVzcG9uc2USagoOcHJvbW90aW9uX3Rhc2sYASABKAsyOi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQ2x1c3RlclByb21vdGlvblRhc2tIAFIUY2x1c3RlclByb21vdGlvblRhc2sSDQoDcmF3GAIgASgMSABCCAoGcmVzdWx0IlIKEUNyZWF0ZVJvbGVSZXF1ZXN0Ej0KBHJvbGUYASABKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkucmJhYy52MWFscGhhMS5Sb2xlIlMKEkNyZWF0ZVJvbGVSZXNwb25zZRI9CgRyb2xlGAEgASgLMi8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnJiYWMudjFhbHBoYTEuUm9sZSIyChFEZWxldGVSb2xlUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkiFAoSRGVsZXRlUm9sZVJlc3BvbnNlIoIBCg5HZXRSb2xlUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkSFAoMYXNfcmVzb3VyY2VzGAMgASgIEjsKBmZvcm1hdBgEIAEoDjIrLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlJhd0Zvcm1hdCK6AQoPR2V0Um9sZVJlc3BvbnNlEj8KBHJvbGUYASABKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkucmJhYy52MWFscGhhMS5Sb2xlSAASTQoJcmVzb3VyY2VzGAIgASgLMjguZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnJiYWMudjFhbHBoYTEuUm9sZVJlc291cmNlc0gAEg0KA3JhdxgDIAEoDEgAQggKBnJlc3VsdCJKCgZDbGFpbXMSQAoGY2xhaW1zGAEgAygLMjAuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnJiYWMudjFhbHBoYTEuQ2xhaW0i0QEKDEdyYW50UmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBHJvbGUYAiABKAkSPwoLdXNlcl9jbGFpbXMYAyABKAsyKC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5DbGFpbXNIABJWChByZXNvdXJjZV9kZXRhaWxzGAQgASgLMjouZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnJiYWMudjFhbHBoYTEuUmVzb3VyY2VEZXRhaWxzSABCCQoHcmVxdWVzdCJOCg1HcmFudFJlc3BvbnNlEj0KBHJvbGUYASABKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkucmJhYy52MWFscGhhMS5Sb2xlIjkKEExpc3RSb2xlc1JlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIUCgxhc19yZXNvdXJjZXMYAiABKAgioAEKEUxpc3RSb2xlc1Jlc3BvbnNlEj4KBXJvbGVzGAEgAygLMi8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnJiYWMudjFhbHBoYTEuUm9sZRJLCglyZXNvdXJjZXMYAiADKAsyOC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkucmJhYy52MWFscGhhMS5Sb2xlUmVzb3VyY2VzItIBCg1SZXZva2VSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEcm9sZRgCIAEoCRI/Cgt1c2VyX2NsYWltcxgDIAEoCzIoLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkNsYWltc0gAElYKEHJlc291cmNlX2RldGFpbHMYBCABKAsyOi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkucmJhYy52MWFscGhhMS5SZXNvdXJjZURldGFpbHNIAEIJCgdyZXF1ZXN0Ik8KDlJldm9rZVJlc3BvbnNlEj0KBHJvbGUYASABKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkucmJhYy52MWFscGhhMS5Sb2xlIlIKEVVwZGF0ZVJvbGVSZXF1ZXN0Ej0KBHJvbGUYASABKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkucmJhYy52MWFscGhhMS5Sb2xlIlMKElVwZGF0ZVJvbGVSZXNwb25zZRI9CgRyb2xlGAEgASgLMi8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnJiYWMudjFhbHBoYTEuUm9sZSJ5ChVDcmVhdGVBUElUb2tlblJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJEhEKCXJvbGVfbmFtZRgDIAEoCRIuCgpleHBpcmVzX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJvChZDcmVhdGVBUElUb2tlblJlc3BvbnNlEkIKBXRva2VuGAEgASgLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnJiYWMudjFhbHBoYTEuQVBJVG9rZW4SEQoJcmF3X3Rva2VuGAIgASgJIjYKFURlbGV0ZUFQSVRva2VuUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkiGAoWRGVsZXRlQVBJVG9rZW5SZXNwb25zZSIzChJHZXRBUElUb2tlblJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJIlkKE0dldEFQSVRva2VuUmVzcG9uc2USQgoFdG9rZW4YASABKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkucmJhYy52MWFscGhhMS5BUElUb2tlbiInChRMaXN0QVBJVG9rZW5zUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJIlwKFUxpc3RBUElUb2tlbnNSZXNwb25zZRJDCgZ0b2tlbnMYASADKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkucmJhYy52MWFscGhhMS5BUElUb2tlbiIbChlMaXN0Q2x1c3RlclNlY3JldHNSZXF1ZXN0IkkKGkxpc3RDbHVzdGVyU2VjcmV0c1Jlc3BvbnNlEisKB3NlY3JldHMYASADKAsyGi5rOHMuaW8uYXBpLmNvcmUudjEuU2VjcmV0Iq0BChpDcmVhdGVDbHVzdGVyU2VjcmV0UmVxdWVzdBIMCgRuYW1lGAEgASgJElQKBGRhdGEYAiADKAsyRi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5DcmVhdGVDbHVzdGVyU2VjcmV0UmVxdWVzdC5EYXRhRW50cnkaKwoJRGF0YUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiSQobQ3JlYXRlQ2x1c3RlclNlY3JldFJlc3BvbnNlEioKBnNlY3JldBgBIAEoCzIaLms4cy5pby5hcGkuY29yZS52MS5TZWNyZXQirQEKGlVwZGF0ZUNsdXN0ZXJTZWNyZXRSZXF1ZXN0EgwKBG5hbWUYASABKAkSVAoEZGF0YRgCIAMoCzJGLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlVwZGF0ZUNsdXN0ZXJTZWNyZXRSZXF1ZXN0LkRhdGFFbnRyeRorCglEYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJJChtVcGRhdGVDbHVzdGVyU2VjcmV0UmVzcG9uc2USKgoGc2VjcmV0GAEgASgLMhouazhzLmlvLmFwaS5jb3JlLnYxLlNlY3JldCIqChpEZWxldGVDbHVzdGVyU2VjcmV0UmVxdWVzdBIMCgRuYW1lGAEgASgJIh0KG0RlbGV0ZUNsdXN0ZXJTZWNyZXRSZXNwb25zZSpRCglSYXdGb3JtYXQSGgoWUkFXX0ZPUk1BVF9VTlNQRUNJRklFRBAAEhMKD1JBV19GT1JNQVRfSlNPThABEhMKD1JBV19GT1JNQVRfWUFNTBACMtVfCgxLYXJnb1NlcnZpY2USgwEKDkdldFZlcnNpb25JbmZvEjcuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0VmVyc2lvbkluZm9SZXF1ZXN0GjguYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0VmVyc2lvbkluZm9SZXNwb25zZRJ0CglHZXRDb25maWcSMi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRDb25maWdSZXF1ZXN0GjMuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0Q29uZmlnUmVzcG9uc2UShgEKD0dldFB1YmxpY0NvbmZpZxI4LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldFB1YmxpY0NvbmZpZ1JlcXVlc3QaOS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRQdWJsaWNDb25maWdSZXNwb25zZRJ3CgpBZG1pbkxvZ2luEjMuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuQWRtaW5Mb2dpblJlcXVlc3QaNC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5BZG1pbkxvZ2luUmVzcG9uc2USgwEKDkNyZWF0ZVJlc291cmNlEjcuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuQ3JlYXRlUmVzb3VyY2VSZXF1ZXN0GjguYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuQ3JlYXRlUmVzb3VyY2VSZXNwb25zZRKbAQoWQ3JlYXRlT3JVcGRhdGVSZXNvdXJjZRI/LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkNyZWF0ZU9yVXBkYXRlUmVzb3VyY2VSZXF1ZXN0GkAuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuQ3JlYXRlT3JVcGRhdGVSZXNvdXJjZVJlc3BvbnNlEoMBCg5VcGRhdGVSZXNvdXJjZRI3LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlVwZGF0ZVJlc291cmNlUmVxdWVzdBo4LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlVwZGF0ZVJlc291cmNlUmVzcG9uc2USgwEKDkRlbGV0ZVJlc291cmNlEjcuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuRGVsZXRlUmVzb3VyY2VSZXF1ZXN0GjguYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuRGVsZXRlUmVzb3VyY2VSZXNwb25zZRJ3CgpMaXN0U3RhZ2VzEjMuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdFN0YWdlc1JlcXVlc3QaNC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5MaXN0U3RhZ2VzUmVzcG9uc2USdwoKTGlzdEltYWdlcxIzLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkxpc3RJbWFnZXNSZXF1ZXN0GjQuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdEltYWdlc1Jlc3BvbnNlEnEKCEdldFN0YWdlEjEuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0U3RhZ2VSZXF1ZXN0GjIuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0U3RhZ2VSZXNwb25zZRJ8CgtXYXRjaFN0YWdlcxI0LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLldhdGNoU3RhZ2VzUmVxdWVzdBo1LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLldhdGNoU3RhZ2VzUmVzcG9uc2UwARJ6CgtEZWxldGVTdGFnZRI0LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkRlbGV0ZVN0YWdlUmVxdWVzdBo1LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkRlbGV0ZVN0YWdlUmVzcG9uc2USfQoMUmVmcmVzaFN0YWdlEjUuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUmVmcmVzaFN0YWdlUmVxdWVzdBo2LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlJlZnJlc2hTdGFnZVJlc3BvbnNlEokBChBHZXRDbHVzdGVyQ29uZmlnEjkuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0Q2x1c3RlckNvbmZpZ1JlcXVlc3QaOi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRDbHVzdGVyQ29uZmlnUmVzcG9uc2USkgEKE0RlbGV0ZUNsdXN0ZXJDb25maWcSPC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5EZWxldGVDbHVzdGVyQ29uZmlnUmVxdWVzdBo9LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkRlbGV0ZUNsdXN0ZXJDb25maWdSZXNwb25zZRKRAQoSV2F0Y2hDbHVzdGVyQ29uZmlnEjsuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuV2F0Y2hDbHVzdGVyQ29uZmlnUmVxdWVzdBo8LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLldhdGNoQ2x1c3RlckNvbmZpZ1Jlc3BvbnNlMAESlQEKFFJlZnJlc2hDbHVzdGVyQ29uZmlnEj0uYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUmVmcmVzaENsdXN0ZXJDb25maWdSZXF1ZXN0Gj4uYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUmVmcmVzaENsdXN0ZXJDb25maWdSZXNwb25zZRKDAQoOTGlzdFByb21vdGlvbnMSNy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5MaXN0UHJvbW90aW9uc1JlcXVlc3QaOC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5MaXN0UHJvbW90aW9uc1Jlc3BvbnNlEogBCg9XYXRjaFByb21vdGlvbnMSOC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5XYXRjaFByb21vdGlvbnNSZXF1ZXN0GjkuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuV2F0Y2hQcm9tb3Rpb25zUmVzcG9uc2UwARJ9CgxHZXRQcm9tb3Rpb24SNS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRQcm9tb3Rpb25SZXF1ZXN0GjYuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0UHJvbW90aW9uUmVzcG9uc2UShQEKDldhdGNoUHJvbW90aW9uEjcuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuV2F0Y2hQcm9tb3Rpb25SZXF1ZXN0GjguYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuV2F0Y2hQcm9tb3Rpb25SZXNwb25zZTABEoMBCg5BYm9ydFByb21vdGlvbhI3LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkFib3J0UHJvbW90aW9uUmVxdWVzdBo4LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkFib3J0UHJvbW90aW9uUmVzcG9uc2USiwEKEEdldFByb21vdGlvbkxvZ3MSOS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRQcm9tb3Rpb25Mb2dzUmVxdWVzdBo6LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldFByb21vdGlvbkxvZ3NSZXNwb25zZTABEoYBCg9SZXN1bWVQcm9tb3Rpb24SOC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5SZXN1bWVQcm9tb3Rpb25SZXF1ZXN0GjkuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUmVzdW1lUHJvbW90aW9uUmVzcG9uc2USgAEKDURlbGV0ZVByb2plY3QSNi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5EZWxldGVQcm9qZWN0UmVxdWVzdBo3LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkRlbGV0ZVByb2plY3RSZXNwb25zZRJ3CgpHZXRQcm9qZWN0EjMuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0UHJvamVjdFJlcXVlc3QaNC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRQcm9qZWN0UmVzcG9uc2USfQoMTGlzdFByb2plY3RzEjUuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdFByb2plY3RzUmVxdWVzdBo2LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkxpc3RQcm9qZWN0c1Jlc3BvbnNlEokBChBHZXRQcm9qZWN0Q29uZmlnEjkuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0UHJvamVjdENvbmZpZ1JlcXVlc3QaOi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRQcm9qZWN0Q29uZmlnUmVzcG9uc2USkgEKE0RlbGV0ZVByb2plY3RDb25maWcSPC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5EZWxldGVQcm9qZWN0Q29uZmlnUmVxdWVzdBo9LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkRlbGV0ZVByb2plY3RDb25maWdSZXNwb25zZRKRAQoSV2F0Y2hQcm9qZWN0Q29uZmlnEjsuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuV2F0Y2hQcm9qZWN0Q29uZmlnUmVxdWVzdBo8LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLldhdGNoUHJvamVjdENvbmZpZ1Jlc3BvbnNlMAESlQEKFFJlZnJlc2hQcm9qZWN0Q29uZmlnEj0uYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUmVmcmVzaFByb2plY3RDb25maWdSZXF1ZXN0Gj4uYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUmVmcmVzaFByb2plY3RDb25maWdSZXNwb25zZRKMAQoRR2V0UHJvbW90aW9uV2F2ZXMSOi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRQcm9tb3Rpb25XYXZlc1JlcXVlc3QaOy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRQcm9tb3Rpb25XYXZlc1Jlc3BvbnNlEoMBCg5BcHByb3ZlRnJlaWdodBI3LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkFwcHJvdmVGcmVpZ2h0UmVxdWVzdBo4LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkFwcHJvdmVGcmVpZ2h0UmVzcG9uc2USgAEKDUNyZWF0ZUZyZWlnaHQSNi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5DcmVhdGVGcmVpZ2h0UmVxdWVzdBo3LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkNyZWF0ZUZyZWlnaHRSZXNwb25zZRKAAQoNRGVsZXRlRnJlaWdodBI2LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkRlbGV0ZUZyZWlnaHRSZXF1ZXN0GjcuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuRGVsZXRlRnJlaWdodFJlc3BvbnNlEncKCkdldEZyZWlnaHQSMy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRGcmVpZ2h0UmVxdWVzdBo0LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldEZyZWlnaHRSZXNwb25zZRKMAQoRR2V0RnJlaWdodExpbmVhZ2USOi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRGcmVpZ2h0TGluZWFnZVJlcXVlc3QaOy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRGcmVpZ2h0TGluZWFnZVJlc3BvbnNlEn8KDFdhdGNoRnJlaWdodBI1LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLldhdGNoRnJlaWdodFJlcXVlc3QaNi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5XYXRjaEZyZWlnaHRSZXNwb25zZTABEoMBCg5Qcm9tb3RlVG9TdGFnZRI3LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlByb21vdGVUb1N0YWdlUmVxdWVzdBo4LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlByb21vdGVUb1N0YWdlUmVzcG9uc2USjAEKEVByb21vdGVEb3duc3RyZWFtEjouYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUHJvbW90ZURvd25zdHJlYW1SZXF1ZXN0GjsuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUHJvbW90ZURvd25zdHJlYW1SZXNwb25zZRJ9CgxRdWVyeUZyZWlnaHQSNS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5RdWVyeUZyZWlnaHRSZXF1ZXN0GjYuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUXVlcnlGcmVpZ2h0UmVzcG9uc2USjwEKElVwZGF0ZUZyZWlnaHRBbGlhcxI7LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlVwZGF0ZUZyZWlnaHRBbGlhc1JlcXVlc3QaPC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5VcGRhdGVGcmVpZ2h0QWxpYXNSZXNwb25zZRJxCghSZXZlcmlmeRIxLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlJldmVyaWZ5UmVxdWVzdBoyLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlJldmVyaWZ5UmVzcG9uc2USjAEKEUFib3J0VmVyaWZpY2F0aW9uEjouYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuQWJvcnRWZXJpZmljYXRpb25SZXF1ZXN0GjsuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuQWJvcnRWZXJpZmljYXRpb25SZXNwb25zZRKDAQoOTGlzdFdhcmVob3VzZXMSNy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5MaXN0V2FyZWhvdXNlc1JlcXVlc3QaOC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5MaXN0V2FyZWhvdXNlc1Jlc3BvbnNlEn0KDEdldFdhcmVob3VzZRI1LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldFdhcmVob3VzZVJlcXVlc3QaNi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRXYXJlaG91c2VSZXNwb25zZRKIAQoPV2F0Y2hXYXJlaG91c2VzEjguYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuV2F0Y2hXYXJlaG91c2VzUmVxdWVzdBo5LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLldhdGNoV2FyZWhvdXNlc1Jlc3BvbnNlMAEShgEKD0RlbGV0ZVdhcmVob3VzZRI4LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkRlbGV0ZVdhcmVob3VzZVJlcXVlc3QaOS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5EZWxldGVXYXJlaG91c2VSZXNwb25zZRKJAQoQUmVmcmVzaFdhcmVob3VzZRI5LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlJlZnJlc2hXYXJlaG91c2VSZXF1ZXN0GjouYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUmVmcmVzaFdhcmVob3VzZVJlc3BvbnNlEowBChFDcmVhdGVDcmVkZW50aWFscxI6LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkNyZWF0ZUNyZWRlbnRpYWxzUmVxdWVzdBo7LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkNyZWF0ZUNyZWRlbnRpYWxzUmVzcG9uc2USjAEKEURlbGV0ZUNyZWRlbnRpYWxzEjouYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuRGVsZXRlQ3JlZGVudGlhbHNSZXF1ZXN0GjsuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuRGVsZXRlQ3JlZGVudGlhbHNSZXNwb25zZRKDAQoOR2V0Q3JlZGVudGlhbHMSNy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRDcmVkZW50aWFsc1JlcXVlc3QaOC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRDcmVkZW50aWFsc1Jlc3BvbnNlEoYBCg9MaXN0Q3JlZGVudGlhbHMSOC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5MaXN0Q3JlZGVudGlhbHNSZXF1ZXN0GjkuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdENyZWRlbnRpYWxzUmVzcG9uc2USjAEKEVVwZGF0ZUNyZWRlbnRpYWxzEjouYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuVXBkYXRlQ3JlZGVudGlhbHNSZXF1ZXN0GjsuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuVXBkYXRlQ3JlZGVudGlhbHNSZXNwb25zZRKPAQoSTGlzdFByb2plY3RTZWNyZXRzEjsuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdFByb2plY3RTZWNyZXRzUmVxdWVzdBo8LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkxpc3RQcm9qZWN0U2VjcmV0c1Jlc3BvbnNlEpIBChNDcmVhdGVQcm9qZWN0U2VjcmV0EjwuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuQ3JlYXRlUHJvamVjdFNlY3JldFJlcXVlc3QaPS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5DcmVhdGVQcm9qZWN0U2VjcmV0UmVzcG9uc2USkgEKE1VwZGF0ZVByb2plY3RTZWNyZXQSPC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5VcGRhdGVQcm9qZWN0U2VjcmV0UmVxdWVzdBo9LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlVwZGF0ZVByb2plY3RTZWNyZXRSZXNwb25zZRKSAQoTRGVsZXRlUHJvamVjdFNlY3JldBI8LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkRlbGV0ZVByb2plY3RTZWNyZXRSZXF1ZXN0Gj0uYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuRGVsZXRlUHJvamVjdFNlY3JldFJlc3BvbnNlEoMBCg5MaXN0Q29uZmlnTWFwcxI3LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkxpc3RDb25maWdNYXBzUmVxdWVzdBo4LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkxpc3RDb25maWdNYXBzUmVzcG9uc2USfQoMR2V0Q29uZmlnTWFwEjUuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0Q29uZmlnTWFwUmVxdWVzdBo2LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldENvbmZpZ01hcFJlc3BvbnNlEpgBChVMaXN0QW5hbHlzaXNUZW1wbGF0ZXMSPi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5MaXN0QW5hbHlzaXNUZW1wbGF0ZXNSZXF1ZXN0Gj8uYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdEFuYWx5c2lzVGVtcGxhdGVzUmVzcG9uc2USkgEKE0dldEFuYWx5c2lzVGVtcGxhdGUSPC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRBbmFseXNpc1RlbXBsYXRlUmVxdWVzdBo9LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldEFuYWx5c2lzVGVtcGxhdGVSZXNwb25zZRKbAQoWRGVsZXRlQW5hbHlzaXNUZW1wbGF0ZRI/LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkRlbGV0ZUFuYWx5c2lzVGVtcGxhdGVSZXF1ZXN0GkAuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuRGVsZXRlQW5hbHlzaXNUZW1wbGF0ZVJlc3BvbnNlEq0BChxMaXN0Q2x1c3RlckFuYWx5c2lzVGVtcGxhdGVzEkUuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdENsdXN0ZXJBbmFseXNpc1RlbXBsYXRlc1JlcXVlc3QaRi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5MaXN0Q2x1c3RlckFuYWx5c2lzVGVtcGxhdGVzUmVzcG9uc2USpwEKGkdldENsdXN0ZXJBbmFseXNpc1RlbXBsYXRlEkMuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0Q2x1c3RlckFuYWx5c2lzVGVtcGxhdGVSZXF1ZXN0GkQuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0Q2x1c3RlckFuYWx5c2lzVGVtcGxhdGVSZXNwb25zZRKwAQodRGVsZXRlQ2x1c3RlckFuYWx5c2lzVGVtcGxhdGUSRi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5EZWxldGVDbHVzdGVyQW5hbHlzaXNUZW1wbGF0ZVJlcXVlc3QaRy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5EZWxldGVDbHVzdGVyQW5hbHlzaXNUZW1wbGF0ZVJlc3BvbnNlEoMBCg5HZXRBbmFseXNpc1J1bhI3LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldEFuYWx5c2lzUnVuUmVxdWVzdBo4LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldEFuYWx5c2lzUnVuUmVzcG9uc2USkQEKEkdldEFuYWx5c2lzUnVuTG9ncxI7LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldEFuYWx5c2lzUnVuTG9nc1JlcXVlc3QaPC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRBbmFseXNpc1J1bkxvZ3NSZXNwb25zZTABEowBChFMaXN0UHJvamVjdEV2ZW50cxI6LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkxpc3RQcm9qZWN0RXZlbnRzUmVxdWVzdBo7LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkxpc3RQcm9qZWN0RXZlbnRzUmVzcG9uc2USjwEKEkxpc3RQcm9tb3Rpb25UYXNrcxI7LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkxpc3RQcm9tb3Rpb25UYXNrc1JlcXVlc3QaPC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5MaXN0UHJvbW90aW9uVGFza3NSZXNwb25zZRKkAQoZTGlzdENsdXN0ZXJQcm9tb3Rpb25UYXNrcxJCLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkxpc3RDbHVzdGVyUHJvbW90aW9uVGFza3NSZXF1ZXN0GkMuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdENsdXN0ZXJQcm9tb3Rpb25UYXNrc1Jlc3BvbnNlEokBChBHZXRQcm9tb3Rpb25UYXNrEjkuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0UHJvbW90aW9uVGFza1JlcXVlc3QaOi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRQcm9tb3Rpb25UYXNrUmVzcG9uc2USngEKF0dldENsdXN0ZXJQcm9tb3Rpb25UYXNrEkAuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0Q2x1c3RlclByb21vdGlvblRhc2tSZXF1ZXN0GkEuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0Q2x1c3RlclByb21vdGlvblRhc2tSZXNwb25zZRJ3CgpDcmVhdGVSb2xlEjMuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuQ3JlYXRlUm9sZVJlcXVlc3QaNC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5DcmVhdGVSb2xlUmVzcG9uc2USdwoKRGVsZXRlUm9sZRIzLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkRlbGV0ZVJvbGVSZXF1ZXN0GjQuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuRGVsZXRlUm9sZVJlc3BvbnNlEm4KB0dldFJvbGUSMC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRSb2xlUmVxdWVzdBoxLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldFJvbGVSZXNwb25zZRJoCgVHcmFudBIuLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdyYW50UmVxdWVzdBovLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdyYW50UmVzcG9uc2USdAoJTGlzdFJvbGVzEjIuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdFJvbGVzUmVxdWVzdBozLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkxpc3RSb2xlc1Jlc3BvbnNlEmsKBlJldm9rZRIvLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlJldm9rZVJlcXVlc3QaMC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5SZXZva2VSZXNwb25zZRJ3CgpVcGRhdGVSb2xlEjMuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuVXBkYXRlUm9sZVJlcXVlc3QaNC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5VcGRhdGVSb2xlUmVzcG9uc2USgwEKDkNyZWF0ZUFQSVRva2VuEjcuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuQ3JlYXRlQVBJVG9rZW5SZXF1ZXN0GjguYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuQ3JlYXRlQVBJVG9rZW5SZXNwb25zZRKDAQoORGVsZXRlQVBJVG9rZW4SNy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5EZWxldGVBUElUb2tlblJlcXVlc3QaOC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5EZWxldGVBUElUb2tlblJlc3BvbnNlEnoKC0dldEFQSVRva2VuEjQuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0QVBJVG9rZW5SZXF1ZXN0GjUuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0QVBJVG9rZW5SZXNwb25zZRKAAQoNTGlzdEFQSVRva2VucxI2LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkxpc3RBUElUb2tlbnNSZXF1ZXN0GjcuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdEFQSVRva2Vuc1Jlc3BvbnNlEo8BChJMaXN0Q2x1c3RlclNlY3JldHMSOy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5MaXN0Q2x1c3RlclNlY3JldHNSZXF1ZXN0GjwuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdENsdXN0ZXJTZWNyZXRzUmVzcG9uc2USkgEKE0NyZWF0ZUNsdXN0ZXJTZWNyZXQSPC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5DcmVhdGVDbHVzdGVyU2VjcmV0UmVxdWVzdBo9LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkNyZWF0ZUNsdXN0ZXJTZWNyZXRSZXNwb25zZRKSAQoTVXBkYXRlQ2x1c3RlclNlY3JldBI8LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlVwZGF0ZUNsdXN0ZXJTZWNyZXRSZXF1ZXN0Gj0uYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuVXBkYXRlQ2x1c3RlclNlY3JldFJlc3BvbnNlEpIBChNEZWxldGVDbHVzdGVyU2VjcmV0EjwuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuRGVsZXRlQ2x1c3RlclNlY3JldFJlcXVlc3QaPS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5EZWxldGVDbHVzdGVyU2VjcmV0UmVzcG9uc2VCkwIKJGNvbS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMUIMU2VydmljZVByb3RvUAFaOGdpdGh1Yi5jb20vYWt1aXR5L2thcmdvL2FwaS9zZXJ2aWNlL3YxYWxwaGExO3N2Y3YxYWxwaGExogIEQUlLU6oCIEFrdWl0eS5Jby5LYXJnby5TZXJ2aWNlLlYxYWxwaGExygIgQWt1aXR5XElvXEthcmdvXFNlcnZpY2VcVjFhbHBoYTHiAixBa3VpdHlcSW9cS2FyZ29cU2VydmljZVxWMWFscGhhMVxHUEJNZXRhZGF0YeoCJEFrdWl0eTo6SW86OkthcmdvOjpTZXJ2aWNlOjpWMWFscGhhMWIGcHJvdG8z", [file_google_protobuf_timestamp, file_api_v1alpha1_generated, file_k8s_io_api_core_v1_generated, file_api_stubs_rollouts_v1alpha1_generated, file_api_rbac_v1alpha1_generated]);
 
 /**
  * ComponentVersions contains version information for different Kargo components.
@@ -457,6 +457,13 @@ export type CreateOrUpdateResourceRequest = Message<"akuity.io.kargo.service.v1a
    * @generated from field: bytes manifest = 1;
    */
   manifest: Uint8Array;
+
+  /**
+   * dry_run indicates that resources should be validated and defaulted by the server without being persisted.
+   *
+   * @generated from field: bool dry_run = 2;
+   */
+  dryRun: boolean;
 };
 
 /**
@@ -500,6 +507,13 @@ export type CreateOrUpdateResourceResult = Message<"akuity.io.kargo.service.v1al
     value: string;
     case: "error";
   } | { case: undefined; value?: undefined };
+
+  /**
+   * live_resource_manifest contains the manifest of the existing resource prior to the update. It is only populated for dry run updates.
+   *
+   * @generated from field: bytes live_resource_manifest = 4;
+   */
+  liveResourceManifest: Uint8Array;
 };
 
 /**
@@ -1453,6 +1467,137 @@ export type AbortPromotionResponse = Message<"akuity.io.kargo.service.v1alpha1.A
 export const AbortPromotionResponseSchema: GenMessage<AbortPromotionResponse> = /*@__PURE__*/
   messageDesc(file_api_service_v1alpha1_service, 55);
 
+/**
+ * GetPromotionLogsRequest is the request for retrieving logs captured during
+ * the execution of a promotion's steps.
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.GetPromotionLogsRequest
+ */
+export type GetPromotionLogsRequest = Message<"akuity.io.kargo.service.v1alpha1.GetPromotionLogsRequest"> & {
+  /**
+   * project is the name of the project containing the promotion.
+   *
+   * @generated from field: string project = 1;
+   */
+  project: string;
+
+  /**
+   * name is the name of the promotion whose logs to retrieve.
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * step is the optional alias of a specific step whose logs to retrieve. If
+   * not specified, logs from all steps are retrieved.
+   *
+   * @generated from field: string step = 3;
+   */
+  step: string;
+
+  /**
+   * follow indicates whether to continue streaming logs until the promotion
+   * reaches a terminal phase.
+   *
+   * @generated from field: bool follow = 4;
+   */
+  follow: boolean;
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.GetPromotionLogsRequest.
+ * Use `create(GetPromotionLogsRequestSchema)` to create a new message.
+ */
+export const GetPromotionLogsRequestSchema: GenMessage<GetPromotionLogsRequest> = /*@__PURE__*/
+  messageDesc(file_api_service_v1alpha1_service, 56);
+
+/**
+ * GetPromotionLogsResponse contains a chunk of logs from a promotion step.
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.GetPromotionLogsResponse
+ */
+export type GetPromotionLogsResponse = Message<"akuity.io.kargo.service.v1alpha1.GetPromotionLogsResponse"> & {
+  /**
+   * step is the alias of the step that produced the chunk.
+   *
+   * @generated from field: string step = 1;
+   */
+  step: string;
+
+  /**
+   * chunk is a portion of the log output from the step.
+   *
+   * @generated from field: string chunk = 2;
+   */
+  chunk: string;
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.GetPromotionLogsResponse.
+ * Use `create(GetPromotionLogsResponseSchema)` to create a new message.
+ */
+export const GetPromotionLogsResponseSchema: GenMessage<GetPromotionLogsResponse> = /*@__PURE__*/
+  messageDesc(file_api_service_v1alpha1_service, 57);
+
+/**
+ * ResumePromotionRequest is the request for resuming a failed or errored
+ * promotion.
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.ResumePromotionRequest
+ */
+export type ResumePromotionRequest = Message<"akuity.io.kargo.service.v1alpha1.ResumePromotionRequest"> & {
+  /**
+   * project is the name of the project containing the promotion.
+   *
+   * @generated from field: string project = 1;
+   */
+  project: string;
+
+  /**
+   * name is the name of the promotion to resume.
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * step is the optional index of the step to resume the promotion from. If
+   * not specified, the promotion is resumed from the step at which it stopped.
+   *
+   * @generated from field: optional int64 step = 3;
+   */
+  step?: bigint;
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.ResumePromotionRequest.
+ * Use `create(ResumePromotionRequestSchema)` to create a new message.
+ */
+export const ResumePromotionRequestSchema: GenMessage<ResumePromotionRequest> = /*@__PURE__*/
+  messageDesc(file_api_service_v1alpha1_service, 58);
+
+/**
+ * ResumePromotionResponse contains the promotion created to resume a promotion.
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.ResumePromotionResponse
+ */
+export type ResumePromotionResponse = Message<"akuity.io.kargo.service.v1alpha1.ResumePromotionResponse"> & {
+  /**
+   * promotion is the newly created promotion.
+   *
+   * @generated from field: github.com.akuity.kargo.api.v1alpha1.Promotion promotion = 1;
+   */
+  promotion?: Promotion;
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.ResumePromotionResponse.
+ * Use `create(ResumePromotionResponseSchema)` to create a new message.
+ */
+export const ResumePromotionResponseSchema: GenMessage<ResumePromotionResponse> = /*@__PURE__*/
+  messageDesc(file_api_service_v1alpha1_service, 59);
+
 /**
  * DeleteProjectRequest is the request for deleting a project and all associated resources.
  *
@@ -1472,7 +1617,7 @@ export type DeleteProjectRequest = Message<"akuity.io.kargo.service.v1alpha1.Del
  * Use `create(DeleteProjectRequestSchema)` to create a new message.
  */
 export const DeleteProjectRequestSchema: GenMessage<DeleteProjectRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 56);
+  messageDesc(file_api_service_v1alpha1_service, 60);
 
 /**
  * DeleteProjectResponse is the response after deleting a project.
@@ -1489,7 +1634,7 @@ export type DeleteProjectResponse = Message<"akuity.io.kargo.service.v1alpha1.De
  * Use `create(DeleteProjectResponseSchema)` to create a new message.
  */
 export const DeleteProjectResponseSchema: GenMessage<DeleteProjectResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 57);
+  messageDesc(file_api_service_v1alpha1_service, 61);
 
 /**
  * GetProjectRequest is the request for retrieving details of a specific project.
@@ -1517,7 +1662,7 @@ export type GetProjectRequest = Message<"akuity.io.kargo.service.v1alpha1.GetPro
  * Use `create(GetProjectRequestSchema)` to create a new message.
  */
 export const GetProjectRequestSchema: GenMessage<GetProjectRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 58);
+  messageDesc(file_api_service_v1alpha1_service, 62);
 
 /**
  * GetProjectResponse contains the requested project information.
@@ -1552,7 +1697,7 @@ export type GetProjectResponse = Message<"akuity.io.kargo.service.v1alpha1.GetPr
  * Use `create(GetProjectResponseSchema)` to create a new message.
  */
 export const GetProjectResponseSchema: GenMessage<GetProjectResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 59);
+  messageDesc(file_api_service_v1alpha1_service, 63);
 
 /**
  * ListProjectsRequest is the request for listing all projects with optional filtering and pagination.
@@ -1594,7 +1739,7 @@ export type ListProjectsRequest = Message<"akuity.io.kargo.service.v1alpha1.List
  * Use `create(ListProjectsRequestSchema)` to create a new message.
  */
 export const ListProjectsRequestSchema: GenMessage<ListProjectsRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 60);
+  messageDesc(file_api_service_v1alpha1_service, 64);
 
 /**
  * ListProjectsResponse contains the list of projects and pagination information.
@@ -1622,7 +1767,7 @@ export type ListProjectsResponse = Message<"akuity.io.kargo.service.v1alpha1.Lis
  * Use `create(ListProjectsResponseSchema)` to create a new message.
  */
 export const ListProjectsResponseSchema: GenMessage<ListProjectsResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 61);
+  messageDesc(file_api_service_v1alpha1_service, 65);
 
 /**
  * GetProjectConfigRequest is the request for retrieving project-level configuration settings.
@@ -1650,7 +1795,7 @@ export type GetProjectConfigRequest = Message<"akuity.io.kargo.service.v1alpha1.
  * Use `create(GetProjectConfigRequestSchema)` to create a new message.
  */
 export const GetProjectConfigRequestSchema: GenMessage<GetProjectConfigRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 62);
+  messageDesc(file_api_service_v1alpha1_service, 66);
 
 /**
  * GetProjectConfigResponse contains the requested project configuration.
@@ -1685,7 +1830,7 @@ export type GetProjectConfigResponse = Message<"akuity.io.kargo.service.v1alpha1
  * Use `create(GetProjectConfigResponseSchema)` to create a new message.
  */
 export const GetProjectConfigResponseSchema: GenMessage<GetProjectConfigResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 63);
+  messageDesc(file_api_service_v1alpha1_service, 67);
 
 /**
  * DeleteProjectConfigRequest is the request for removing project-level configuration.
@@ -1706,7 +1851,7 @@ export type DeleteProjectConfigRequest = Message<"akuity.io.kargo.service.v1alph
  * Use `create(DeleteProjectConfigRequestSchema)` to create a new message.
  */
 export const DeleteProjectConfigRequestSchema: GenMessage<DeleteProjectConfigRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 64);
+  messageDesc(file_api_service_v1alpha1_service, 68);
 
 /**
  * DeleteProjectConfigResponse is the response after deleting project configuration.
@@ -1723,7 +1868,7 @@ export type DeleteProjectConfigResponse = Message<"akuity.io.kargo.service.v1alp
  * Use `create(DeleteProjectConfigResponseSchema)` to create a new message.
  */
 export const DeleteProjectConfigResponseSchema: GenMessage<DeleteProjectConfigResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 65);
+  messageDesc(file_api_service_v1alpha1_service, 69);
 
 /**
  * WatchProjectConfigRequest is the request for streaming project configuration changes.
@@ -1744,7 +1889,7 @@ export type WatchProjectConfigRequest = Message<"akuity.io.kargo.service.v1alpha
  * Use `create(WatchProjectConfigRequestSchema)` to create a new message.
  */
 export const WatchProjectConfigRequestSchema: GenMessage<WatchProjectConfigRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 66);
+  messageDesc(file_api_service_v1alpha1_service, 70);
 
 /**
  * WatchProjectConfigResponse provides streaming updates for project configuration changes.
@@ -1772,7 +1917,7 @@ export type WatchProjectConfigResponse = Message<"akuity.io.kargo.service.v1alph
  * Use `create(WatchProjectConfigResponseSchema)` to create a new message.
  */
 export const WatchProjectConfigResponseSchema: GenMessage<WatchProjectConfigResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 67);
+  messageDesc(file_api_service_v1alpha1_service, 71);
 
 /**
  * RefreshProjectConfigRequest is the request for triggering a refresh of project configuration.
@@ -1793,7 +1938,7 @@ export type RefreshProjectConfigRequest = Message<"akuity.io.kargo.service.v1alp
  * Use `create(RefreshProjectConfigRequestSchema)` to create a new message.
  */
 export const RefreshProjectConfigRequestSchema: GenMessage<RefreshProjectConfigRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 68);
+  messageDesc(file_api_service_v1alpha1_service, 72);
 
 /**
  * RefreshProjectConfigResponse contains the refreshed project configuration.
@@ -1814,7 +1959,63 @@ export type RefreshProjectConfigResponse = Message<"akuity.io.kargo.service.v1al
  * Use `create(RefreshProjectConfigResponseSchema)` to create a new message.
  */
 export const RefreshProjectConfigResponseSchema: GenMessage<RefreshProjectConfigResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 69);
+  messageDesc(file_api_service_v1alpha1_service, 73);
+
+/**
+ * GetPromotionWavesRequest is the request for retrieving the progress of freight through promotion waves.
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.GetPromotionWavesRequest
+ */
+export type GetPromotionWavesRequest = Message<"akuity.io.kargo.service.v1alpha1.GetPromotionWavesRequest"> & {
+  /**
+   * project is the name of the project whose promotion waves to retrieve.
+   *
+   * @generated from field: string project = 1;
+   */
+  project: string;
+
+  /**
+   * policy is the name of the promotion wave policy to retrieve. If empty, all policies are retrieved.
+   *
+   * @generated from field: string policy = 2;
+   */
+  policy: string;
+
+  /**
+   * freight is the name of the freight whose progress to retrieve. If empty, the progress of the newest freight to have reached any stage selected by each policy is retrieved.
+   *
+   * @generated from field: string freight = 3;
+   */
+  freight: string;
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.GetPromotionWavesRequest.
+ * Use `create(GetPromotionWavesRequestSchema)` to create a new message.
+ */
+export const GetPromotionWavesRequestSchema: GenMessage<GetPromotionWavesRequest> = /*@__PURE__*/
+  messageDesc(file_api_service_v1alpha1_service, 74);
+
+/**
+ * GetPromotionWavesResponse contains the progress of freight through promotion waves.
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.GetPromotionWavesResponse
+ */
+export type GetPromotionWavesResponse = Message<"akuity.io.kargo.service.v1alpha1.GetPromotionWavesResponse"> & {
+  /**
+   * progress contains the progress of freight through the waves of each promotion wave policy.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.PromotionWaveProgress progress = 1;
+   */
+  progress: PromotionWaveProgress[];
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.GetPromotionWavesResponse.
+ * Use `create(GetPromotionWavesResponseSchema)` to create a new message.
+ */
+export const GetPromotionWavesResponseSchema: GenMessage<GetPromotionWavesResponse> = /*@__PURE__*/
+  messageDesc(file_api_service_v1alpha1_service, 75);
 
 /**
  * ApproveFreightRequest is the request for approving freight for promotion to a stage.
@@ -1849,6 +2050,14 @@ export type ApproveFreightRequest = Message<"akuity.io.kargo.service.v1alpha1.Ap
    * @generated from field: string stage = 4;
    */
   stage: string;
+
+  /**
+   * allow_stale permits the freight to be promoted to the stage even if it is
+   * stale according to the staleness policy that applies to it.
+   *
+   * @generated from field: bool allow_stale = 5;
+   */
+  allowStale: boolean;
 };
 
 /**
@@ -1856,7 +2065,7 @@ export type ApproveFreightRequest = Message<"akuity.io.kargo.service.v1alpha1.Ap
  * Use `create(ApproveFreightRequestSchema)` to create a new message.
  */
 export const ApproveFreightRequestSchema: GenMessage<ApproveFreightRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 70);
+  messageDesc(file_api_service_v1alpha1_service, 76);
 
 /**
  * ApproveFreightResponse is the response after approving freight.
@@ -1873,7 +2082,84 @@ export type ApproveFreightResponse = Message<"akuity.io.kargo.service.v1alpha1.A
  * Use `create(ApproveFreightResponseSchema)` to create a new message.
  */
 export const ApproveFreightResponseSchema: GenMessage<ApproveFreightResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 71);
+  messageDesc(file_api_service_v1alpha1_service, 77);
+
+/**
+ * CreateFreightRequest is the request for creating freight from specific artifacts subscribed to by a warehouse.
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.CreateFreightRequest
+ */
+export type CreateFreightRequest = Message<"akuity.io.kargo.service.v1alpha1.CreateFreightRequest"> & {
+  /**
+   * project is the name of the project containing the warehouse.
+   *
+   * @generated from field: string project = 1;
+   */
+  project: string;
+
+  /**
+   * warehouse is the name of the warehouse the freight will originate from.
+   *
+   * @generated from field: string warehouse = 2;
+   */
+  warehouse: string;
+
+  /**
+   * commits specifies the git commits to include. Each must specify a repo_url and either an id or a tag.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.GitCommit commits = 3;
+   */
+  commits: GitCommit[];
+
+  /**
+   * images specifies the container images to include. Each must specify a repo_url and either a tag or a digest.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.Image images = 4;
+   */
+  images: Image[];
+
+  /**
+   * charts specifies the helm charts to include. Each must specify a repo_url and a version, and, for classic chart repositories, a name.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.Chart charts = 5;
+   */
+  charts: Chart[];
+
+  /**
+   * alias is an optional human-friendly alias for the freight. If empty, one is generated.
+   *
+   * @generated from field: string alias = 6;
+   */
+  alias: string;
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.CreateFreightRequest.
+ * Use `create(CreateFreightRequestSchema)` to create a new message.
+ */
+export const CreateFreightRequestSchema: GenMessage<CreateFreightRequest> = /*@__PURE__*/
+  messageDesc(file_api_service_v1alpha1_service, 78);
+
+/**
+ * CreateFreightResponse is the response after creating freight.
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.CreateFreightResponse
+ */
+export type CreateFreightResponse = Message<"akuity.io.kargo.service.v1alpha1.CreateFreightResponse"> & {
+  /**
+   * freight is the created freight.
+   *
+   * @generated from field: github.com.akuity.kargo.api.v1alpha1.Freight freight = 1;
+   */
+  freight?: Freight;
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.CreateFreightResponse.
+ * Use `create(CreateFreightResponseSchema)` to create a new message.
+ */
+export const CreateFreightResponseSchema: GenMessage<CreateFreightResponse> = /*@__PURE__*/
+  messageDesc(file_api_service_v1alpha1_service, 79);
 
 /**
  * DeleteFreightRequest is the request for deleting freight.
@@ -1908,7 +2194,7 @@ export type DeleteFreightRequest = Message<"akuity.io.kargo.service.v1alpha1.Del
  * Use `create(DeleteFreightRequestSchema)` to create a new message.
  */
 export const DeleteFreightRequestSchema: GenMessage<DeleteFreightRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 72);
+  messageDesc(file_api_service_v1alpha1_service, 80);
 
 /**
  * DeleteFreightResponse is the response after deleting freight.
@@ -1925,7 +2211,7 @@ export type DeleteFreightResponse = Message<"akuity.io.kargo.service.v1alpha1.De
  * Use `create(DeleteFreightResponseSchema)` to create a new message.
  */
 export const DeleteFreightResponseSchema: GenMessage<DeleteFreightResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 73);
+  messageDesc(file_api_service_v1alpha1_service, 81);
 
 /**
  * GetFreightRequest is the request for retrieving details of specific freight.
@@ -1967,7 +2253,7 @@ export type GetFreightRequest = Message<"akuity.io.kargo.service.v1alpha1.GetFre
  * Use `create(GetFreightRequestSchema)` to create a new message.
  */
 export const GetFreightRequestSchema: GenMessage<GetFreightRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 74);
+  messageDesc(file_api_service_v1alpha1_service, 82);
 
 /**
  * GetFreightResponse contains the requested freight information.
@@ -2002,7 +2288,245 @@ export type GetFreightResponse = Message<"akuity.io.kargo.service.v1alpha1.GetFr
  * Use `create(GetFreightResponseSchema)` to create a new message.
  */
 export const GetFreightResponseSchema: GenMessage<GetFreightResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 75);
+  messageDesc(file_api_service_v1alpha1_service, 83);
+
+/**
+ * GetFreightLineageRequest is the request for retrieving the lineage of specific freight.
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.GetFreightLineageRequest
+ */
+export type GetFreightLineageRequest = Message<"akuity.io.kargo.service.v1alpha1.GetFreightLineageRequest"> & {
+  /**
+   * project is the name of the project containing the freight.
+   *
+   * @generated from field: string project = 1;
+   */
+  project: string;
+
+  /**
+   * name is the name of the freight whose lineage to retrieve.
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * alias is the alias of the freight whose lineage to retrieve.
+   *
+   * @generated from field: string alias = 3;
+   */
+  alias: string;
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.GetFreightLineageRequest.
+ * Use `create(GetFreightLineageRequestSchema)` to create a new message.
+ */
+export const GetFreightLineageRequestSchema: GenMessage<GetFreightLineageRequest> = /*@__PURE__*/
+  messageDesc(file_api_service_v1alpha1_service, 84);
+
+/**
+ * GetFreightLineageResponse contains the lineage of the requested freight.
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.GetFreightLineageResponse
+ */
+export type GetFreightLineageResponse = Message<"akuity.io.kargo.service.v1alpha1.GetFreightLineageResponse"> & {
+  /**
+   * lineage is the lineage of the freight.
+   *
+   * @generated from field: akuity.io.kargo.service.v1alpha1.FreightLineage lineage = 1;
+   */
+  lineage?: FreightLineage;
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.GetFreightLineageResponse.
+ * Use `create(GetFreightLineageResponseSchema)` to create a new message.
+ */
+export const GetFreightLineageResponseSchema: GenMessage<GetFreightLineageResponse> = /*@__PURE__*/
+  messageDesc(file_api_service_v1alpha1_service, 85);
+
+/**
+ * FreightLineage describes where freight originated from and every stage it has been through.
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.FreightLineage
+ */
+export type FreightLineage = Message<"akuity.io.kargo.service.v1alpha1.FreightLineage"> & {
+  /**
+   * freight is the Freight resource. Its creation timestamp is the time at which it was discovered by its warehouse.
+   *
+   * @generated from field: github.com.akuity.kargo.api.v1alpha1.Freight freight = 1;
+   */
+  freight?: Freight;
+
+  /**
+   * commits contains the Git commits referenced by the freight, along with the details discovered about them by the warehouse.
+   *
+   * @generated from field: repeated akuity.io.kargo.service.v1alpha1.FreightCommitLineage commits = 2;
+   */
+  commits: FreightCommitLineage[];
+
+  /**
+   * images contains the container images referenced by the freight, along with the details discovered about them by the warehouse.
+   *
+   * @generated from field: repeated akuity.io.kargo.service.v1alpha1.FreightImageLineage images = 3;
+   */
+  images: FreightImageLineage[];
+
+  /**
+   * stages contains the stages the freight has been approved for, promoted to, verified in or is currently in use by.
+   *
+   * @generated from field: repeated akuity.io.kargo.service.v1alpha1.FreightStageLineage stages = 4;
+   */
+  stages: FreightStageLineage[];
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.FreightLineage.
+ * Use `create(FreightLineageSchema)` to create a new message.
+ */
+export const FreightLineageSchema: GenMessage<FreightLineage> = /*@__PURE__*/
+  messageDesc(file_api_service_v1alpha1_service, 86);
+
+/**
+ * FreightCommitLineage describes a Git commit referenced by freight.
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.FreightCommitLineage
+ */
+export type FreightCommitLineage = Message<"akuity.io.kargo.service.v1alpha1.FreightCommitLineage"> & {
+  /**
+   * commit is the Git commit as referenced by the freight.
+   *
+   * @generated from field: github.com.akuity.kargo.api.v1alpha1.GitCommit commit = 1;
+   */
+  commit?: GitCommit;
+
+  /**
+   * discovered contains the details discovered about the commit by the warehouse, if they are still available.
+   *
+   * @generated from field: github.com.akuity.kargo.api.v1alpha1.DiscoveredCommit discovered = 2;
+   */
+  discovered?: DiscoveredCommit;
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.FreightCommitLineage.
+ * Use `create(FreightCommitLineageSchema)` to create a new message.
+ */
+export const FreightCommitLineageSchema: GenMessage<FreightCommitLineage> = /*@__PURE__*/
+  messageDesc(file_api_service_v1alpha1_service, 87);
+
+/**
+ * FreightImageLineage describes a container image referenced by freight.
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.FreightImageLineage
+ */
+export type FreightImageLineage = Message<"akuity.io.kargo.service.v1alpha1.FreightImageLineage"> & {
+  /**
+   * image is the container image as referenced by the freight.
+   *
+   * @generated from field: github.com.akuity.kargo.api.v1alpha1.Image image = 1;
+   */
+  image?: Image;
+
+  /**
+   * discovered contains the details discovered about the image by the warehouse, if they are still available.
+   *
+   * @generated from field: github.com.akuity.kargo.api.v1alpha1.DiscoveredImageReference discovered = 2;
+   */
+  discovered?: DiscoveredImageReference;
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.FreightImageLineage.
+ * Use `create(FreightImageLineageSchema)` to create a new message.
+ */
+export const FreightImageLineageSchema: GenMessage<FreightImageLineage> = /*@__PURE__*/
+  messageDesc(file_api_service_v1alpha1_service, 88);
+
+/**
+ * FreightStageLineage describes the history of freight in a single stage.
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.FreightStageLineage
+ */
+export type FreightStageLineage = Message<"akuity.io.kargo.service.v1alpha1.FreightStageLineage"> & {
+  /**
+   * stage is the name of the stage.
+   *
+   * @generated from field: string stage = 1;
+   */
+  stage: string;
+
+  /**
+   * approved is set if the freight has been manually approved for the stage.
+   *
+   * @generated from field: github.com.akuity.kargo.api.v1alpha1.ApprovedStage approved = 2;
+   */
+  approved?: ApprovedStage;
+
+  /**
+   * verified is set if the freight has been verified in the stage.
+   *
+   * @generated from field: github.com.akuity.kargo.api.v1alpha1.VerifiedStage verified = 3;
+   */
+  verified?: VerifiedStage;
+
+  /**
+   * current is set if the freight is currently in use by the stage.
+   *
+   * @generated from field: github.com.akuity.kargo.api.v1alpha1.CurrentStage current = 4;
+   */
+  current?: CurrentStage;
+
+  /**
+   * deployments contains the promotions of the freight to the stage, oldest first.
+   *
+   * @generated from field: repeated akuity.io.kargo.service.v1alpha1.FreightDeployment deployments = 5;
+   */
+  deployments: FreightDeployment[];
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.FreightStageLineage.
+ * Use `create(FreightStageLineageSchema)` to create a new message.
+ */
+export const FreightStageLineageSchema: GenMessage<FreightStageLineage> = /*@__PURE__*/
+  messageDesc(file_api_service_v1alpha1_service, 89);
+
+/**
+ * FreightDeployment describes a single promotion of freight to a stage.
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.FreightDeployment
+ */
+export type FreightDeployment = Message<"akuity.io.kargo.service.v1alpha1.FreightDeployment"> & {
+  /**
+   * promotion is the Promotion resource.
+   *
+   * @generated from field: github.com.akuity.kargo.api.v1alpha1.Promotion promotion = 1;
+   */
+  promotion?: Promotion;
+
+  /**
+   * verifications contains the verifications of the freight collection that resulted from the promotion, if they are still available.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.VerificationInfo verifications = 2;
+   */
+  verifications: VerificationInfo[];
+
+  /**
+   * provenance is an in-toto statement with a SLSA provenance predicate in JSON format, attesting to the deployment. It is only populated for successful promotions.
+   *
+   * @generated from field: bytes provenance = 3;
+   */
+  provenance: Uint8Array;
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.FreightDeployment.
+ * Use `create(FreightDeploymentSchema)` to create a new message.
+ */
+export const FreightDeploymentSchema: GenMessage<FreightDeployment> = /*@__PURE__*/
+  messageDesc(file_api_service_v1alpha1_service, 90);
 
 /**
  * WatchFreightRequest is the request for watching freight changes via streaming.
@@ -2023,7 +2547,7 @@ export type WatchFreightRequest = Message<"akuity.io.kargo.service.v1alpha1.Watc
  * Use `create(WatchFreightRequestSchema)` to create a new message.
  */
 export const WatchFreightRequestSchema: GenMessage<WatchFreightRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 76);
+  messageDesc(file_api_service_v1alpha1_service, 91);
 
 /**
  * WatchFreightResponse contains freight change notifications.
@@ -2053,7 +2577,7 @@ export type WatchFreightResponse = Message<"akuity.io.kargo.service.v1alpha1.Wat
  * Use `create(WatchFreightResponseSchema)` to create a new message.
  */
 export const WatchFreightResponseSchema: GenMessage<WatchFreightResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 77);
+  messageDesc(file_api_service_v1alpha1_service, 92);
 
 /**
  * PromoteToStageRequest is the request for promoting freight to a specific stage.
@@ -2095,7 +2619,7 @@ export type PromoteToStageRequest = Message<"akuity.io.kargo.service.v1alpha1.Pr
  * Use `create(PromoteToStageRequestSchema)` to create a new message.
  */
 export const PromoteToStageRequestSchema: GenMessage<PromoteToStageRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 78);
+  messageDesc(file_api_service_v1alpha1_service, 93);
 
 /**
  * PromoteToStageResponse contains the promotion created for the freight promotion.
@@ -2116,7 +2640,7 @@ export type PromoteToStageResponse = Message<"akuity.io.kargo.service.v1alpha1.P
  * Use `create(PromoteToStageResponseSchema)` to create a new message.
  */
 export const PromoteToStageResponseSchema: GenMessage<PromoteToStageResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 79);
+  messageDesc(file_api_service_v1alpha1_service, 94);
 
 /**
  * PromoteDownstreamRequest is the request for automatically promoting freight to downstream stages.
@@ -2158,7 +2682,7 @@ export type PromoteDownstreamRequest = Message<"akuity.io.kargo.service.v1alpha1
  * Use `create(PromoteDownstreamRequestSchema)` to create a new message.
  */
 export const PromoteDownstreamRequestSchema: GenMessage<PromoteDownstreamRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 80);
+  messageDesc(file_api_service_v1alpha1_service, 95);
 
 /**
  * PromoteDownstreamResponse contains the promotions created for downstream freight promotions.
@@ -2179,7 +2703,7 @@ export type PromoteDownstreamResponse = Message<"akuity.io.kargo.service.v1alpha
  * Use `create(PromoteDownstreamResponseSchema)` to create a new message.
  */
 export const PromoteDownstreamResponseSchema: GenMessage<PromoteDownstreamResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 81);
+  messageDesc(file_api_service_v1alpha1_service, 96);
 
 /**
  * QueryFreightRequest is the request for searching freight based on specified criteria.
@@ -2242,7 +2766,7 @@ export type QueryFreightRequest = Message<"akuity.io.kargo.service.v1alpha1.Quer
  * Use `create(QueryFreightRequestSchema)` to create a new message.
  */
 export const QueryFreightRequestSchema: GenMessage<QueryFreightRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 82);
+  messageDesc(file_api_service_v1alpha1_service, 97);
 
 /**
  * QueryFreightResponse contains the grouped freight search results.
@@ -2263,7 +2787,7 @@ export type QueryFreightResponse = Message<"akuity.io.kargo.service.v1alpha1.Que
  * Use `create(QueryFreightResponseSchema)` to create a new message.
  */
 export const QueryFreightResponseSchema: GenMessage<QueryFreightResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 83);
+  messageDesc(file_api_service_v1alpha1_service, 98);
 
 /**
  * FreightList contains a list of freight resources.
@@ -2277,6 +2801,16 @@ export type FreightList = Message<"akuity.io.kargo.service.v1alpha1.FreightList"
    * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.Freight freight = 1;
    */
   freight: Freight[];
+
+  /**
+   * stale maps the names of stale Freight in the list to the reasons they are
+   * stale. Staleness is determined by the staleness policy of the stage
+   * specified in the request, if any, or else by that of the Warehouse the
+   * Freight originated from.
+   *
+   * @generated from field: map<string, string> stale = 2;
+   */
+  stale: { [key: string]: string };
 };
 
 /**
@@ -2284,7 +2818,7 @@ export type FreightList = Message<"akuity.io.kargo.service.v1alpha1.FreightList"
  * Use `create(FreightListSchema)` to create a new message.
  */
 export const FreightListSchema: GenMessage<FreightList> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 84);
+  messageDesc(file_api_service_v1alpha1_service, 99);
 
 /**
  * UpdateFreightAliasRequest is the request for updating a freight's alias.
@@ -2326,7 +2860,7 @@ export type UpdateFreightAliasRequest = Message<"akuity.io.kargo.service.v1alpha
  * Use `create(UpdateFreightAliasRequestSchema)` to create a new message.
  */
 export const UpdateFreightAliasRequestSchema: GenMessage<UpdateFreightAliasRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 85);
+  messageDesc(file_api_service_v1alpha1_service, 100);
 
 /**
  * UpdateFreightAliasResponse is the response after updating a freight's alias.
@@ -2343,7 +2877,7 @@ export type UpdateFreightAliasResponse = Message<"akuity.io.kargo.service.v1alph
  * Use `create(UpdateFreightAliasResponseSchema)` to create a new message.
  */
 export const UpdateFreightAliasResponseSchema: GenMessage<UpdateFreightAliasResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 86);
+  messageDesc(file_api_service_v1alpha1_service, 101);
 
 /**
  * ReverifyRequest is the request for triggering re-execution of verification processes for a stage.
@@ -2371,7 +2905,7 @@ export type ReverifyRequest = Message<"akuity.io.kargo.service.v1alpha1.Reverify
  * Use `create(ReverifyRequestSchema)` to create a new message.
  */
 export const ReverifyRequestSchema: GenMessage<ReverifyRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 87);
+  messageDesc(file_api_service_v1alpha1_service, 102);
 
 /**
  * ReverifyResponse is the response after triggering reverification.
@@ -2388,7 +2922,7 @@ export type ReverifyResponse = Message<"akuity.io.kargo.service.v1alpha1.Reverif
  * Use `create(ReverifyResponseSchema)` to create a new message.
  */
 export const ReverifyResponseSchema: GenMessage<ReverifyResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 88);
+  messageDesc(file_api_service_v1alpha1_service, 103);
 
 /**
  * AbortVerificationRequest is the request for canceling running verification processes for a stage.
@@ -2416,7 +2950,7 @@ export type AbortVerificationRequest = Message<"akuity.io.kargo.service.v1alpha1
  * Use `create(AbortVerificationRequestSchema)` to create a new message.
  */
 export const AbortVerificationRequestSchema: GenMessage<AbortVerificationRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 89);
+  messageDesc(file_api_service_v1alpha1_service, 104);
 
 /**
  * AbortVerificationResponse is the response after aborting verification.
@@ -2433,7 +2967,7 @@ export type AbortVerificationResponse = Message<"akuity.io.kargo.service.v1alpha
  * Use `create(AbortVerificationResponseSchema)` to create a new message.
  */
 export const AbortVerificationResponseSchema: GenMessage<AbortVerificationResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 90);
+  messageDesc(file_api_service_v1alpha1_service, 105);
 
 /**
  * ListWarehousesRequest is the request for listing warehouses within a project.
@@ -2454,7 +2988,7 @@ export type ListWarehousesRequest = Message<"akuity.io.kargo.service.v1alpha1.Li
  * Use `create(ListWarehousesRequestSchema)` to create a new message.
  */
 export const ListWarehousesRequestSchema: GenMessage<ListWarehousesRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 91);
+  messageDesc(file_api_service_v1alpha1_service, 106);
 
 /**
  * ListWarehousesResponse contains a list of warehouses within a project.
@@ -2475,7 +3009,7 @@ export type ListWarehousesResponse = Message<"akuity.io.kargo.service.v1alpha1.L
  * Use `create(ListWarehousesResponseSchema)` to create a new message.
  */
 export const ListWarehousesResponseSchema: GenMessage<ListWarehousesResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 92);
+  messageDesc(file_api_service_v1alpha1_service, 107);
 
 /**
  * GetWarehouseRequest is the request for retrieving details of a specific warehouse.
@@ -2510,7 +3044,7 @@ export type GetWarehouseRequest = Message<"akuity.io.kargo.service.v1alpha1.GetW
  * Use `create(GetWarehouseRequestSchema)` to create a new message.
  */
 export const GetWarehouseRequestSchema: GenMessage<GetWarehouseRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 93);
+  messageDesc(file_api_service_v1alpha1_service, 108);
 
 /**
  * GetWarehouseResponse contains the requested warehouse information.
@@ -2545,7 +3079,7 @@ export type GetWarehouseResponse = Message<"akuity.io.kargo.service.v1alpha1.Get
  * Use `create(GetWarehouseResponseSchema)` to create a new message.
  */
 export const GetWarehouseResponseSchema: GenMessage<GetWarehouseResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 94);
+  messageDesc(file_api_service_v1alpha1_service, 109);
 
 /**
  * WatchWarehousesRequest is the request for watching warehouse changes via streaming.
@@ -2573,7 +3107,7 @@ export type WatchWarehousesRequest = Message<"akuity.io.kargo.service.v1alpha1.W
  * Use `create(WatchWarehousesRequestSchema)` to create a new message.
  */
 export const WatchWarehousesRequestSchema: GenMessage<WatchWarehousesRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 95);
+  messageDesc(file_api_service_v1alpha1_service, 110);
 
 /**
  * WatchWarehousesResponse contains warehouse change notifications.
@@ -2601,7 +3135,7 @@ export type WatchWarehousesResponse = Message<"akuity.io.kargo.service.v1alpha1.
  * Use `create(WatchWarehousesResponseSchema)` to create a new message.
  */
 export const WatchWarehousesResponseSchema: GenMessage<WatchWarehousesResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 96);
+  messageDesc(file_api_service_v1alpha1_service, 111);
 
 /**
  * DeleteWarehouseRequest is the request for deleting a warehouse.
@@ -2629,7 +3163,7 @@ export type DeleteWarehouseRequest = Message<"akuity.io.kargo.service.v1alpha1.D
  * Use `create(DeleteWarehouseRequestSchema)` to create a new message.
  */
 export const DeleteWarehouseRequestSchema: GenMessage<DeleteWarehouseRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 97);
+  messageDesc(file_api_service_v1alpha1_service, 112);
 
 /**
  * DeleteWarehouseResponse is the response after deleting a warehouse.
@@ -2646,7 +3180,7 @@ export type DeleteWarehouseResponse = Message<"akuity.io.kargo.service.v1alpha1.
  * Use `create(DeleteWarehouseResponseSchema)` to create a new message.
  */
 export const DeleteWarehouseResponseSchema: GenMessage<DeleteWarehouseResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 98);
+  messageDesc(file_api_service_v1alpha1_service, 113);
 
 /**
  * RefreshWarehouseRequest is the request for refreshing a warehouse's status and freight discovery.
@@ -2674,7 +3208,7 @@ export type RefreshWarehouseRequest = Message<"akuity.io.kargo.service.v1alpha1.
  * Use `create(RefreshWarehouseRequestSchema)` to create a new message.
  */
 export const RefreshWarehouseRequestSchema: GenMessage<RefreshWarehouseRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 99);
+  messageDesc(file_api_service_v1alpha1_service, 114);
 
 /**
  * RefreshWarehouseResponse contains the refreshed warehouse information.
@@ -2695,7 +3229,7 @@ export type RefreshWarehouseResponse = Message<"akuity.io.kargo.service.v1alpha1
  * Use `create(RefreshWarehouseResponseSchema)` to create a new message.
  */
 export const RefreshWarehouseResponseSchema: GenMessage<RefreshWarehouseResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 100);
+  messageDesc(file_api_service_v1alpha1_service, 115);
 
 /**
  * ListConfigMapsRequest is the request for retrieving all ConfigMaps in a project.
@@ -2716,7 +3250,7 @@ export type ListConfigMapsRequest = Message<"akuity.io.kargo.service.v1alpha1.Li
  * Use `create(ListConfigMapsRequestSchema)` to create a new message.
  */
 export const ListConfigMapsRequestSchema: GenMessage<ListConfigMapsRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 101);
+  messageDesc(file_api_service_v1alpha1_service, 116);
 
 /**
  * ListConfigMapsResponse contains the list of ConfigMaps in a project.
@@ -2737,7 +3271,7 @@ export type ListConfigMapsResponse = Message<"akuity.io.kargo.service.v1alpha1.L
  * Use `create(ListConfigMapsResponseSchema)` to create a new message.
  */
 export const ListConfigMapsResponseSchema: GenMessage<ListConfigMapsResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 102);
+  messageDesc(file_api_service_v1alpha1_service, 117);
 
 /**
  * GetConfigMapRequest is the request for retrieving a specific ConfigMap.
@@ -2772,7 +3306,7 @@ export type GetConfigMapRequest = Message<"akuity.io.kargo.service.v1alpha1.GetC
  * Use `create(GetConfigMapRequestSchema)` to create a new message.
  */
 export const GetConfigMapRequestSchema: GenMessage<GetConfigMapRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 103);
+  messageDesc(file_api_service_v1alpha1_service, 118);
 
 /**
  * GetConfigMapResponse contains the requested ConfigMap information.
@@ -2807,7 +3341,7 @@ export type GetConfigMapResponse = Message<"akuity.io.kargo.service.v1alpha1.Get
  * Use `create(GetConfigMapResponseSchema)` to create a new message.
  */
 export const GetConfigMapResponseSchema: GenMessage<GetConfigMapResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 104);
+  messageDesc(file_api_service_v1alpha1_service, 119);
 
 /**
  * CreateCredentialsRequest is the request for creating new credentials for accessing external resources.
@@ -2877,7 +3411,7 @@ export type CreateCredentialsRequest = Message<"akuity.io.kargo.service.v1alpha1
  * Use `create(CreateCredentialsRequestSchema)` to create a new message.
  */
 export const CreateCredentialsRequestSchema: GenMessage<CreateCredentialsRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 105);
+  messageDesc(file_api_service_v1alpha1_service, 120);
 
 /**
  * CreateCredentialsResponse contains the newly created credentials.
@@ -2898,7 +3432,7 @@ export type CreateCredentialsResponse = Message<"akuity.io.kargo.service.v1alpha
  * Use `create(CreateCredentialsResponseSchema)` to create a new message.
  */
 export const CreateCredentialsResponseSchema: GenMessage<CreateCredentialsResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 106);
+  messageDesc(file_api_service_v1alpha1_service, 121);
 
 /**
  * DeleteCredentialsRequest is the request for deleting existing credentials.
@@ -2926,7 +3460,7 @@ export type DeleteCredentialsRequest = Message<"akuity.io.kargo.service.v1alpha1
  * Use `create(DeleteCredentialsRequestSchema)` to create a new message.
  */
 export const DeleteCredentialsRequestSchema: GenMessage<DeleteCredentialsRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 107);
+  messageDesc(file_api_service_v1alpha1_service, 122);
 
 /**
  * DeleteCredentialsResponse is the response returned after deleting credentials.
@@ -2943,7 +3477,7 @@ export type DeleteCredentialsResponse = Message<"akuity.io.kargo.service.v1alpha
  * Use `create(DeleteCredentialsResponseSchema)` to create a new message.
  */
 export const DeleteCredentialsResponseSchema: GenMessage<DeleteCredentialsResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 108);
+  messageDesc(file_api_service_v1alpha1_service, 123);
 
 /**
  * GetCredentialsRequest is the request for retrieving existing credentials.
@@ -2978,7 +3512,7 @@ export type GetCredentialsRequest = Message<"akuity.io.kargo.service.v1alpha1.Ge
  * Use `create(GetCredentialsRequestSchema)` to create a new message.
  */
 export const GetCredentialsRequestSchema: GenMessage<GetCredentialsRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 109);
+  messageDesc(file_api_service_v1alpha1_service, 124);
 
 /**
  * GetCredentialsResponse contains the requested credentials information.
@@ -3013,7 +3547,7 @@ export type GetCredentialsResponse = Message<"akuity.io.kargo.service.v1alpha1.G
  * Use `create(GetCredentialsResponseSchema)` to create a new message.
  */
 export const GetCredentialsResponseSchema: GenMessage<GetCredentialsResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 110);
+  messageDesc(file_api_service_v1alpha1_service, 125);
 
 /**
  * ListCredentialsRequest is the request for listing all credentials in a project.
@@ -3034,7 +3568,7 @@ export type ListCredentialsRequest = Message<"akuity.io.kargo.service.v1alpha1.L
  * Use `create(ListCredentialsRequestSchema)` to create a new message.
  */
 export const ListCredentialsRequestSchema: GenMessage<ListCredentialsRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 111);
+  messageDesc(file_api_service_v1alpha1_service, 126);
 
 /**
  * ListCredentialsResponse contains a list of credentials for the specified project.
@@ -3055,7 +3589,7 @@ export type ListCredentialsResponse = Message<"akuity.io.kargo.service.v1alpha1.
  * Use `create(ListCredentialsResponseSchema)` to create a new message.
  */
 export const ListCredentialsResponseSchema: GenMessage<ListCredentialsResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 112);
+  messageDesc(file_api_service_v1alpha1_service, 127);
 
 /**
  * UpdateCredentialsRequest is the request for updating existing credentials.
@@ -3125,7 +3659,7 @@ export type UpdateCredentialsRequest = Message<"akuity.io.kargo.service.v1alpha1
  * Use `create(UpdateCredentialsRequestSchema)` to create a new message.
  */
 export const UpdateCredentialsRequestSchema: GenMessage<UpdateCredentialsRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 113);
+  messageDesc(file_api_service_v1alpha1_service, 128);
 
 /**
  * UpdateCredentialsResponse contains the updated credentials information.
@@ -3146,7 +3680,7 @@ export type UpdateCredentialsResponse = Message<"akuity.io.kargo.service.v1alpha
  * Use `create(UpdateCredentialsResponseSchema)` to create a new message.
  */
 export const UpdateCredentialsResponseSchema: GenMessage<UpdateCredentialsResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 114);
+  messageDesc(file_api_service_v1alpha1_service, 129);
 
 /**
  * ListProjectSecretsRequest is the request for listing all secrets in a project.
@@ -3167,7 +3701,7 @@ export type ListProjectSecretsRequest = Message<"akuity.io.kargo.service.v1alpha
  * Use `create(ListProjectSecretsRequestSchema)` to create a new message.
  */
 export const ListProjectSecretsRequestSchema: GenMessage<ListProjectSecretsRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 115);
+  messageDesc(file_api_service_v1alpha1_service, 130);
 
 /**
  * ListProjectSecretsResponse contains a list of secrets for the specified project.
@@ -3188,7 +3722,7 @@ export type ListProjectSecretsResponse = Message<"akuity.io.kargo.service.v1alph
  * Use `create(ListProjectSecretsResponseSchema)` to create a new message.
  */
 export const ListProjectSecretsResponseSchema: GenMessage<ListProjectSecretsResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 116);
+  messageDesc(file_api_service_v1alpha1_service, 131);
 
 /**
  * CreateProjectSecretRequest is the request for creating a new secret within a project.
@@ -3230,7 +3764,7 @@ export type CreateProjectSecretRequest = Message<"akuity.io.kargo.service.v1alph
  * Use `create(CreateProjectSecretRequestSchema)` to create a new message.
  */
 export const CreateProjectSecretRequestSchema: GenMessage<CreateProjectSecretRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 117);
+  messageDesc(file_api_service_v1alpha1_service, 132);
 
 /**
  * CreateProjectSecretResponse contains the newly created project secret.
@@ -3251,7 +3785,7 @@ export type CreateProjectSecretResponse = Message<"akuity.io.kargo.service.v1alp
  * Use `create(CreateProjectSecretResponseSchema)` to create a new message.
  */
 export const CreateProjectSecretResponseSchema: GenMessage<CreateProjectSecretResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 118);
+  messageDesc(file_api_service_v1alpha1_service, 133);
 
 /**
  * UpdateProjectSecretRequest is the request for updating an existing project secret.
@@ -3293,7 +3827,7 @@ export type UpdateProjectSecretRequest = Message<"akuity.io.kargo.service.v1alph
  * Use `create(UpdateProjectSecretRequestSchema)` to create a new message.
  */
 export const UpdateProjectSecretRequestSchema: GenMessage<UpdateProjectSecretRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 119);
+  messageDesc(file_api_service_v1alpha1_service, 134);
 
 /**
  * UpdateProjectSecretResponse contains the updated project secret information.
@@ -3314,7 +3848,7 @@ export type UpdateProjectSecretResponse = Message<"akuity.io.kargo.service.v1alp
  * Use `create(UpdateProjectSecretResponseSchema)` to create a new message.
  */
 export const UpdateProjectSecretResponseSchema: GenMessage<UpdateProjectSecretResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 120);
+  messageDesc(file_api_service_v1alpha1_service, 135);
 
 /**
  * DeleteProjectSecretRequest is the request for deleting a project secret.
@@ -3342,7 +3876,7 @@ export type DeleteProjectSecretRequest = Message<"akuity.io.kargo.service.v1alph
  * Use `create(DeleteProjectSecretRequestSchema)` to create a new message.
  */
 export const DeleteProjectSecretRequestSchema: GenMessage<DeleteProjectSecretRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 121);
+  messageDesc(file_api_service_v1alpha1_service, 136);
 
 /**
  * DeleteProjectSecretResponse is the response returned after deleting a project secret.
@@ -3359,7 +3893,7 @@ export type DeleteProjectSecretResponse = Message<"akuity.io.kargo.service.v1alp
  * Use `create(DeleteProjectSecretResponseSchema)` to create a new message.
  */
 export const DeleteProjectSecretResponseSchema: GenMessage<DeleteProjectSecretResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 122);
+  messageDesc(file_api_service_v1alpha1_service, 137);
 
 /**
  * ListAnalysisTemplatesRequest is the request for listing all analysis templates in a project.
@@ -3380,7 +3914,7 @@ export type ListAnalysisTemplatesRequest = Message<"akuity.io.kargo.service.v1al
  * Use `create(ListAnalysisTemplatesRequestSchema)` to create a new message.
  */
 export const ListAnalysisTemplatesRequestSchema: GenMessage<ListAnalysisTemplatesRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 123);
+  messageDesc(file_api_service_v1alpha1_service, 138);
 
 /**
  * ListAnalysisTemplatesResponse contains a list of analysis templates for the specified project.
@@ -3401,7 +3935,7 @@ export type ListAnalysisTemplatesResponse = Message<"akuity.io.kargo.service.v1a
  * Use `create(ListAnalysisTemplatesResponseSchema)` to create a new message.
  */
 export const ListAnalysisTemplatesResponseSchema: GenMessage<ListAnalysisTemplatesResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 124);
+  messageDesc(file_api_service_v1alpha1_service, 139);
 
 /**
  * GetAnalysisTemplateRequest is the request for retrieving a specific analysis template.
@@ -3436,7 +3970,7 @@ export type GetAnalysisTemplateRequest = Message<"akuity.io.kargo.service.v1alph
  * Use `create(GetAnalysisTemplateRequestSchema)` to create a new message.
  */
 export const GetAnalysisTemplateRequestSchema: GenMessage<GetAnalysisTemplateRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 125);
+  messageDesc(file_api_service_v1alpha1_service, 140);
 
 /**
  * GetAnalysisTemplateResponse contains the requested analysis template information.
@@ -3471,7 +4005,7 @@ export type GetAnalysisTemplateResponse = Message<"akuity.io.kargo.service.v1alp
  * Use `create(GetAnalysisTemplateResponseSchema)` to create a new message.
  */
 export const GetAnalysisTemplateResponseSchema: GenMessage<GetAnalysisTemplateResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 126);
+  messageDesc(file_api_service_v1alpha1_service, 141);
 
 /**
  * DeleteAnalysisTemplateRequest is the request for deleting an analysis template.
@@ -3499,7 +4033,7 @@ export type DeleteAnalysisTemplateRequest = Message<"akuity.io.kargo.service.v1a
  * Use `create(DeleteAnalysisTemplateRequestSchema)` to create a new message.
  */
 export const DeleteAnalysisTemplateRequestSchema: GenMessage<DeleteAnalysisTemplateRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 127);
+  messageDesc(file_api_service_v1alpha1_service, 142);
 
 /**
  * DeleteAnalysisTemplateResponse is the response returned after deleting an analysis template.
@@ -3516,7 +4050,7 @@ export type DeleteAnalysisTemplateResponse = Message<"akuity.io.kargo.service.v1
  * Use `create(DeleteAnalysisTemplateResponseSchema)` to create a new message.
  */
 export const DeleteAnalysisTemplateResponseSchema: GenMessage<DeleteAnalysisTemplateResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 128);
+  messageDesc(file_api_service_v1alpha1_service, 143);
 
 /**
  * ListClusterAnalysisTemplatesRequest is the request for listing all cluster-level analysis templates.
@@ -3531,7 +4065,7 @@ export type ListClusterAnalysisTemplatesRequest = Message<"akuity.io.kargo.servi
  * Use `create(ListClusterAnalysisTemplatesRequestSchema)` to create a new message.
  */
 export const ListClusterAnalysisTemplatesRequestSchema: GenMessage<ListClusterAnalysisTemplatesRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 129);
+  messageDesc(file_api_service_v1alpha1_service, 144);
 
 /**
  * ListClusterAnalysisTemplatesResponse contains a list of cluster-level analysis templates.
@@ -3552,7 +4086,7 @@ export type ListClusterAnalysisTemplatesResponse = Message<"akuity.io.kargo.serv
  * Use `create(ListClusterAnalysisTemplatesResponseSchema)` to create a new message.
  */
 export const ListClusterAnalysisTemplatesResponseSchema: GenMessage<ListClusterAnalysisTemplatesResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 130);
+  messageDesc(file_api_service_v1alpha1_service, 145);
 
 /**
  * GetClusterAnalysisTemplateRequest is the request for retrieving a specific cluster analysis template.
@@ -3580,7 +4114,7 @@ export type GetClusterAnalysisTemplateRequest = Message<"akuity.io.kargo.service
  * Use `create(GetClusterAnalysisTemplateRequestSchema)` to create a new message.
  */
 export const GetClusterAnalysisTemplateRequestSchema: GenMessage<GetClusterAnalysisTemplateRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 131);
+  messageDesc(file_api_service_v1alpha1_service, 146);
 
 /**
  * GetClusterAnalysisTemplateResponse contains the requested cluster analysis template information.
@@ -3615,7 +4149,7 @@ export type GetClusterAnalysisTemplateResponse = Message<"akuity.io.kargo.servic
  * Use `create(GetClusterAnalysisTemplateResponseSchema)` to create a new message.
  */
 export const GetClusterAnalysisTemplateResponseSchema: GenMessage<GetClusterAnalysisTemplateResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 132);
+  messageDesc(file_api_service_v1alpha1_service, 147);
 
 /**
  * DeleteClusterAnalysisTemplateRequest is the request for deleting a cluster analysis template.
@@ -3636,7 +4170,7 @@ export type DeleteClusterAnalysisTemplateRequest = Message<"akuity.io.kargo.serv
  * Use `create(DeleteClusterAnalysisTemplateRequestSchema)` to create a new message.
  */
 export const DeleteClusterAnalysisTemplateRequestSchema: GenMessage<DeleteClusterAnalysisTemplateRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 133);
+  messageDesc(file_api_service_v1alpha1_service, 148);
 
 /**
  * DeleteClusterAnalysisTemplateResponse is the response returned after deleting a cluster analysis template.
@@ -3653,7 +4187,7 @@ export type DeleteClusterAnalysisTemplateResponse = Message<"akuity.io.kargo.ser
  * Use `create(DeleteClusterAnalysisTemplateResponseSchema)` to create a new message.
  */
 export const DeleteClusterAnalysisTemplateResponseSchema: GenMessage<DeleteClusterAnalysisTemplateResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 134);
+  messageDesc(file_api_service_v1alpha1_service, 149);
 
 /**
  * GetAnalysisRunRequest is the request for retrieving a specific analysis run.
@@ -3688,7 +4222,7 @@ export type GetAnalysisRunRequest = Message<"akuity.io.kargo.service.v1alpha1.Ge
  * Use `create(GetAnalysisRunRequestSchema)` to create a new message.
  */
 export const GetAnalysisRunRequestSchema: GenMessage<GetAnalysisRunRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 135);
+  messageDesc(file_api_service_v1alpha1_service, 150);
 
 /**
  * GetAnalysisRunResponse contains the requested analysis run information.
@@ -3723,7 +4257,7 @@ export type GetAnalysisRunResponse = Message<"akuity.io.kargo.service.v1alpha1.G
  * Use `create(GetAnalysisRunResponseSchema)` to create a new message.
  */
 export const GetAnalysisRunResponseSchema: GenMessage<GetAnalysisRunResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 136);
+  messageDesc(file_api_service_v1alpha1_service, 151);
 
 /**
  * GetAnalysisRunLogsRequest is the request for retrieving logs from an analysis run.
@@ -3765,7 +4299,7 @@ export type GetAnalysisRunLogsRequest = Message<"akuity.io.kargo.service.v1alpha
  * Use `create(GetAnalysisRunLogsRequestSchema)` to create a new message.
  */
 export const GetAnalysisRunLogsRequestSchema: GenMessage<GetAnalysisRunLogsRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 137);
+  messageDesc(file_api_service_v1alpha1_service, 152);
 
 /**
  * GetAnalysisRunLogsResponse contains a chunk of logs from the analysis run.
@@ -3786,7 +4320,7 @@ export type GetAnalysisRunLogsResponse = Message<"akuity.io.kargo.service.v1alph
  * Use `create(GetAnalysisRunLogsResponseSchema)` to create a new message.
  */
 export const GetAnalysisRunLogsResponseSchema: GenMessage<GetAnalysisRunLogsResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 138);
+  messageDesc(file_api_service_v1alpha1_service, 153);
 
 /**
  * ListProjectEventsRequest is the request for listing events in a project.
@@ -3807,7 +4341,7 @@ export type ListProjectEventsRequest = Message<"akuity.io.kargo.service.v1alpha1
  * Use `create(ListProjectEventsRequestSchema)` to create a new message.
  */
 export const ListProjectEventsRequestSchema: GenMessage<ListProjectEventsRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 139);
+  messageDesc(file_api_service_v1alpha1_service, 154);
 
 /**
  * ListProjectEventsResponse contains a list of events for the specified project.
@@ -3828,7 +4362,7 @@ export type ListProjectEventsResponse = Message<"akuity.io.kargo.service.v1alpha
  * Use `create(ListProjectEventsResponseSchema)` to create a new message.
  */
 export const ListProjectEventsResponseSchema: GenMessage<ListProjectEventsResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 140);
+  messageDesc(file_api_service_v1alpha1_service, 155);
 
 /**
  * ListPromotionTasksRequest is the request for listing promotion tasks in a project.
@@ -3849,7 +4383,7 @@ export type ListPromotionTasksRequest = Message<"akuity.io.kargo.service.v1alpha
  * Use `create(ListPromotionTasksRequestSchema)` to create a new message.
  */
 export const ListPromotionTasksRequestSchema: GenMessage<ListPromotionTasksRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 141);
+  messageDesc(file_api_service_v1alpha1_service, 156);
 
 /**
  * ListPromotionTasksResponse contains a list of promotion tasks for the specified project.
@@ -3870,7 +4404,7 @@ export type ListPromotionTasksResponse = Message<"akuity.io.kargo.service.v1alph
  * Use `create(ListPromotionTasksResponseSchema)` to create a new message.
  */
 export const ListPromotionTasksResponseSchema: GenMessage<ListPromotionTasksResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 142);
+  messageDesc(file_api_service_v1alpha1_service, 157);
 
 /**
  * GetPromotionTaskRequest is the request for retrieving a specific promotion task.
@@ -3905,7 +4439,7 @@ export type GetPromotionTaskRequest = Message<"akuity.io.kargo.service.v1alpha1.
  * Use `create(GetPromotionTaskRequestSchema)` to create a new message.
  */
 export const GetPromotionTaskRequestSchema: GenMessage<GetPromotionTaskRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 143);
+  messageDesc(file_api_service_v1alpha1_service, 158);
 
 /**
  * GetPromotionTaskResponse contains the requested promotion task information.
@@ -3940,7 +4474,7 @@ export type GetPromotionTaskResponse = Message<"akuity.io.kargo.service.v1alpha1
  * Use `create(GetPromotionTaskResponseSchema)` to create a new message.
  */
 export const GetPromotionTaskResponseSchema: GenMessage<GetPromotionTaskResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 144);
+  messageDesc(file_api_service_v1alpha1_service, 159);
 
 /**
  * ListClusterPromotionTasksRequest is the request for listing all cluster-level promotion tasks.
@@ -3955,7 +4489,7 @@ export type ListClusterPromotionTasksRequest = Message<"akuity.io.kargo.service.
  * Use `create(ListClusterPromotionTasksRequestSchema)` to create a new message.
  */
 export const ListClusterPromotionTasksRequestSchema: GenMessage<ListClusterPromotionTasksRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 145);
+  messageDesc(file_api_service_v1alpha1_service, 160);
 
 /**
  * ListClusterPromotionTasksResponse contains a list of cluster-level promotion tasks.
@@ -3976,7 +4510,7 @@ export type ListClusterPromotionTasksResponse = Message<"akuity.io.kargo.service
  * Use `create(ListClusterPromotionTasksResponseSchema)` to create a new message.
  */
 export const ListClusterPromotionTasksResponseSchema: GenMessage<ListClusterPromotionTasksResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 146);
+  messageDesc(file_api_service_v1alpha1_service, 161);
 
 /**
  * GetClusterPromotionTaskRequest is the request for retrieving a specific cluster promotion task.
@@ -4004,7 +4538,7 @@ export type GetClusterPromotionTaskRequest = Message<"akuity.io.kargo.service.v1
  * Use `create(GetClusterPromotionTaskRequestSchema)` to create a new message.
  */
 export const GetClusterPromotionTaskRequestSchema: GenMessage<GetClusterPromotionTaskRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 147);
+  messageDesc(file_api_service_v1alpha1_service, 162);
 
 /**
  * GetClusterPromotionTaskResponse contains the requested cluster promotion task information.
@@ -4039,7 +4573,7 @@ export type GetClusterPromotionTaskResponse = Message<"akuity.io.kargo.service.v
  * Use `create(GetClusterPromotionTaskResponseSchema)` to create a new message.
  */
 export const GetClusterPromotionTaskResponseSchema: GenMessage<GetClusterPromotionTaskResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 148);
+  messageDesc(file_api_service_v1alpha1_service, 163);
 
 /**
  * CreateRoleRequest is the request for creating a new RBAC role.
@@ -4060,7 +4594,7 @@ export type CreateRoleRequest = Message<"akuity.io.kargo.service.v1alpha1.Create
  * Use `create(CreateRoleRequestSchema)` to create a new message.
  */
 export const CreateRoleRequestSchema: GenMessage<CreateRoleRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 149);
+  messageDesc(file_api_service_v1alpha1_service, 164);
 
 /**
  * CreateRoleResponse contains the newly created role information.
@@ -4081,7 +4615,7 @@ export type CreateRoleResponse = Message<"akuity.io.kargo.service.v1alpha1.Creat
  * Use `create(CreateRoleResponseSchema)` to create a new message.
  */
 export const CreateRoleResponseSchema: GenMessage<CreateRoleResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 150);
+  messageDesc(file_api_service_v1alpha1_service, 165);
 
 /**
  * DeleteRoleRequest is the request for deleting an RBAC role.
@@ -4109,7 +4643,7 @@ export type DeleteRoleRequest = Message<"akuity.io.kargo.service.v1alpha1.Delete
  * Use `create(DeleteRoleRequestSchema)` to create a new message.
  */
 export const DeleteRoleRequestSchema: GenMessage<DeleteRoleRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 151);
+  messageDesc(file_api_service_v1alpha1_service, 166);
 
 /**
  * DeleteRoleResponse is the response returned after deleting a role.
@@ -4126,7 +4660,7 @@ export type DeleteRoleResponse = Message<"akuity.io.kargo.service.v1alpha1.Delet
  * Use `create(DeleteRoleResponseSchema)` to create a new message.
  */
 export const DeleteRoleResponseSchema: GenMessage<DeleteRoleResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 152);
+  messageDesc(file_api_service_v1alpha1_service, 167);
 
 /**
  * GetRoleRequest is the request for retrieving a specific RBAC role.
@@ -4168,7 +4702,7 @@ export type GetRoleRequest = Message<"akuity.io.kargo.service.v1alpha1.GetRoleRe
  * Use `create(GetRoleRequestSchema)` to create a new message.
  */
 export const GetRoleRequestSchema: GenMessage<GetRoleRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 153);
+  messageDesc(file_api_service_v1alpha1_service, 168);
 
 /**
  * GetRoleResponse contains the requested role information.
@@ -4211,7 +4745,7 @@ export type GetRoleResponse = Message<"akuity.io.kargo.service.v1alpha1.GetRoleR
  * Use `create(GetRoleResponseSchema)` to create a new message.
  */
 export const GetRoleResponseSchema: GenMessage<GetRoleResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 154);
+  messageDesc(file_api_service_v1alpha1_service, 169);
 
 /**
  * Claims represents a collection of OIDC claims for role-based access control.
@@ -4233,7 +4767,7 @@ export type Claims = Message<"akuity.io.kargo.service.v1alpha1.Claims"> & {
  * Use `create(ClaimsSchema)` to create a new message.
  */
 export const ClaimsSchema: GenMessage<Claims> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 155);
+  messageDesc(file_api_service_v1alpha1_service, 170);
 
 /**
  * GrantRequest is the request for granting a role to a user or resource.
@@ -4282,7 +4816,7 @@ export type GrantRequest = Message<"akuity.io.kargo.service.v1alpha1.GrantReques
  * Use `create(GrantRequestSchema)` to create a new message.
  */
 export const GrantRequestSchema: GenMessage<GrantRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 156);
+  messageDesc(file_api_service_v1alpha1_service, 171);
 
 /**
  * GrantResponse contains information about the granted role.
@@ -4303,7 +4837,7 @@ export type GrantResponse = Message<"akuity.io.kargo.service.v1alpha1.GrantRespo
  * Use `create(GrantResponseSchema)` to create a new message.
  */
 export const GrantResponseSchema: GenMessage<GrantResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 157);
+  messageDesc(file_api_service_v1alpha1_service, 172);
 
 /**
  * ListRolesRequest is the request for listing all roles in a project.
@@ -4331,7 +4865,7 @@ export type ListRolesRequest = Message<"akuity.io.kargo.service.v1alpha1.ListRol
  * Use `create(ListRolesRequestSchema)` to create a new message.
  */
 export const ListRolesRequestSchema: GenMessage<ListRolesRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 158);
+  messageDesc(file_api_service_v1alpha1_service, 173);
 
 /**
  * ListRolesResponse contains a list of roles for the specified project.
@@ -4360,7 +4894,7 @@ export type ListRolesResponse = Message<"akuity.io.kargo.service.v1alpha1.ListRo
  * Use `create(ListRolesResponseSchema)` to create a new message.
  */
 export const ListRolesResponseSchema: GenMessage<ListRolesResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 159);
+  messageDesc(file_api_service_v1alpha1_service, 174);
 
 /**
  * RevokeRequest is the request for revoking a role from a user or resource.
@@ -4409,7 +4943,7 @@ export type RevokeRequest = Message<"akuity.io.kargo.service.v1alpha1.RevokeRequ
  * Use `create(RevokeRequestSchema)` to create a new message.
  */
 export const RevokeRequestSchema: GenMessage<RevokeRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 160);
+  messageDesc(file_api_service_v1alpha1_service, 175);
 
 /**
  * RevokeResponse contains information about the revoked role.
@@ -4430,7 +4964,7 @@ export type RevokeResponse = Message<"akuity.io.kargo.service.v1alpha1.RevokeRes
  * Use `create(RevokeResponseSchema)` to create a new message.
  */
 export const RevokeResponseSchema: GenMessage<RevokeResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 161);
+  messageDesc(file_api_service_v1alpha1_service, 176);
 
 /**
  * UpdateRoleRequest is the request for updating an existing RBAC role.
@@ -4451,7 +4985,7 @@ export type UpdateRoleRequest = Message<"akuity.io.kargo.service.v1alpha1.Update
  * Use `create(UpdateRoleRequestSchema)` to create a new message.
  */
 export const UpdateRoleRequestSchema: GenMessage<UpdateRoleRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 162);
+  messageDesc(file_api_service_v1alpha1_service, 177);
 
 /**
  * UpdateRoleResponse contains the updated role information.
@@ -4472,7 +5006,213 @@ export type UpdateRoleResponse = Message<"akuity.io.kargo.service.v1alpha1.Updat
  * Use `create(UpdateRoleResponseSchema)` to create a new message.
  */
 export const UpdateRoleResponseSchema: GenMessage<UpdateRoleResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 163);
+  messageDesc(file_api_service_v1alpha1_service, 178);
+
+/**
+ * CreateAPITokenRequest is the request for creating a new API token.
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.CreateAPITokenRequest
+ */
+export type CreateAPITokenRequest = Message<"akuity.io.kargo.service.v1alpha1.CreateAPITokenRequest"> & {
+  /**
+   * project is the name of the project the token will be scoped to.
+   *
+   * @generated from field: string project = 1;
+   */
+  project: string;
+
+  /**
+   * name is the name of the token to create.
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * role_name is the name of the role whose permissions the token will grant.
+   *
+   * @generated from field: string role_name = 3;
+   */
+  roleName: string;
+
+  /**
+   * expires_at is the time after which the token will no longer be valid.
+   *
+   * @generated from field: google.protobuf.Timestamp expires_at = 4;
+   */
+  expiresAt?: Timestamp;
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.CreateAPITokenRequest.
+ * Use `create(CreateAPITokenRequestSchema)` to create a new message.
+ */
+export const CreateAPITokenRequestSchema: GenMessage<CreateAPITokenRequest> = /*@__PURE__*/
+  messageDesc(file_api_service_v1alpha1_service, 179);
+
+/**
+ * CreateAPITokenResponse contains the newly created token information.
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.CreateAPITokenResponse
+ */
+export type CreateAPITokenResponse = Message<"akuity.io.kargo.service.v1alpha1.CreateAPITokenResponse"> & {
+  /**
+   * token is the created APIToken resource.
+   *
+   * @generated from field: github.com.akuity.kargo.api.rbac.v1alpha1.APIToken token = 1;
+   */
+  token?: APIToken;
+
+  /**
+   * raw_token is the token itself. It is not stored and cannot be retrieved again.
+   *
+   * @generated from field: string raw_token = 2;
+   */
+  rawToken: string;
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.CreateAPITokenResponse.
+ * Use `create(CreateAPITokenResponseSchema)` to create a new message.
+ */
+export const CreateAPITokenResponseSchema: GenMessage<CreateAPITokenResponse> = /*@__PURE__*/
+  messageDesc(file_api_service_v1alpha1_service, 180);
+
+/**
+ * DeleteAPITokenRequest is the request for revoking an API token.
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.DeleteAPITokenRequest
+ */
+export type DeleteAPITokenRequest = Message<"akuity.io.kargo.service.v1alpha1.DeleteAPITokenRequest"> & {
+  /**
+   * project is the name of the project containing the token.
+   *
+   * @generated from field: string project = 1;
+   */
+  project: string;
+
+  /**
+   * name is the name of the token to revoke.
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.DeleteAPITokenRequest.
+ * Use `create(DeleteAPITokenRequestSchema)` to create a new message.
+ */
+export const DeleteAPITokenRequestSchema: GenMessage<DeleteAPITokenRequest> = /*@__PURE__*/
+  messageDesc(file_api_service_v1alpha1_service, 181);
+
+/**
+ * DeleteAPITokenResponse is the response returned after revoking a token.
+ *
+ * explicitly empty 
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.DeleteAPITokenResponse
+ */
+export type DeleteAPITokenResponse = Message<"akuity.io.kargo.service.v1alpha1.DeleteAPITokenResponse"> & {
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.DeleteAPITokenResponse.
+ * Use `create(DeleteAPITokenResponseSchema)` to create a new message.
+ */
+export const DeleteAPITokenResponseSchema: GenMessage<DeleteAPITokenResponse> = /*@__PURE__*/
+  messageDesc(file_api_service_v1alpha1_service, 182);
+
+/**
+ * GetAPITokenRequest is the request for retrieving a specific API token.
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.GetAPITokenRequest
+ */
+export type GetAPITokenRequest = Message<"akuity.io.kargo.service.v1alpha1.GetAPITokenRequest"> & {
+  /**
+   * project is the name of the project containing the token.
+   *
+   * @generated from field: string project = 1;
+   */
+  project: string;
+
+  /**
+   * name is the name of the token to retrieve.
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.GetAPITokenRequest.
+ * Use `create(GetAPITokenRequestSchema)` to create a new message.
+ */
+export const GetAPITokenRequestSchema: GenMessage<GetAPITokenRequest> = /*@__PURE__*/
+  messageDesc(file_api_service_v1alpha1_service, 183);
+
+/**
+ * GetAPITokenResponse contains the requested token information.
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.GetAPITokenResponse
+ */
+export type GetAPITokenResponse = Message<"akuity.io.kargo.service.v1alpha1.GetAPITokenResponse"> & {
+  /**
+   * token is the APIToken resource.
+   *
+   * @generated from field: github.com.akuity.kargo.api.rbac.v1alpha1.APIToken token = 1;
+   */
+  token?: APIToken;
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.GetAPITokenResponse.
+ * Use `create(GetAPITokenResponseSchema)` to create a new message.
+ */
+export const GetAPITokenResponseSchema: GenMessage<GetAPITokenResponse> = /*@__PURE__*/
+  messageDesc(file_api_service_v1alpha1_service, 184);
+
+/**
+ * ListAPITokensRequest is the request for listing all API tokens in a project.
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.ListAPITokensRequest
+ */
+export type ListAPITokensRequest = Message<"akuity.io.kargo.service.v1alpha1.ListAPITokensRequest"> & {
+  /**
+   * project is the name of the project whose tokens should be listed.
+   *
+   * @generated from field: string project = 1;
+   */
+  project: string;
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.ListAPITokensRequest.
+ * Use `create(ListAPITokensRequestSchema)` to create a new message.
+ */
+export const ListAPITokensRequestSchema: GenMessage<ListAPITokensRequest> = /*@__PURE__*/
+  messageDesc(file_api_service_v1alpha1_service, 185);
+
+/**
+ * ListAPITokensResponse contains the list of API tokens in a project.
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.ListAPITokensResponse
+ */
+export type ListAPITokensResponse = Message<"akuity.io.kargo.service.v1alpha1.ListAPITokensResponse"> & {
+  /**
+   * tokens is the list of APIToken resources.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.rbac.v1alpha1.APIToken tokens = 1;
+   */
+  tokens: APIToken[];
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.ListAPITokensResponse.
+ * Use `create(ListAPITokensResponseSchema)` to create a new message.
+ */
+export const ListAPITokensResponseSchema: GenMessage<ListAPITokensResponse> = /*@__PURE__*/
+  messageDesc(file_api_service_v1alpha1_service, 186);
 
 /**
  * ListClusterSecretsRequest is the request for listing all cluster-level secrets.
@@ -4489,7 +5229,7 @@ export type ListClusterSecretsRequest = Message<"akuity.io.kargo.service.v1alpha
  * Use `create(ListClusterSecretsRequestSchema)` to create a new message.
  */
 export const ListClusterSecretsRequestSchema: GenMessage<ListClusterSecretsRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 164);
+  messageDesc(file_api_service_v1alpha1_service, 187);
 
 /**
  * ListClusterSecretsResponse contains a list of cluster-level secrets.
@@ -4510,7 +5250,7 @@ export type ListClusterSecretsResponse = Message<"akuity.io.kargo.service.v1alph
  * Use `create(ListClusterSecretsResponseSchema)` to create a new message.
  */
 export const ListClusterSecretsResponseSchema: GenMessage<ListClusterSecretsResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 165);
+  messageDesc(file_api_service_v1alpha1_service, 188);
 
 /**
  * CreateClusterSecretRequest is the request for creating a new cluster-level secret.
@@ -4538,7 +5278,7 @@ export type CreateClusterSecretRequest = Message<"akuity.io.kargo.service.v1alph
  * Use `create(CreateClusterSecretRequestSchema)` to create a new message.
  */
 export const CreateClusterSecretRequestSchema: GenMessage<CreateClusterSecretRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 166);
+  messageDesc(file_api_service_v1alpha1_service, 189);
 
 /**
  * CreateClusterSecretResponse contains the newly created cluster secret.
@@ -4559,7 +5299,7 @@ export type CreateClusterSecretResponse = Message<"akuity.io.kargo.service.v1alp
  * Use `create(CreateClusterSecretResponseSchema)` to create a new message.
  */
 export const CreateClusterSecretResponseSchema: GenMessage<CreateClusterSecretResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 167);
+  messageDesc(file_api_service_v1alpha1_service, 190);
 
 /**
  * UpdateClusterSecretRequest is the request for updating an existing cluster secret.
@@ -4587,7 +5327,7 @@ export type UpdateClusterSecretRequest = Message<"akuity.io.kargo.service.v1alph
  * Use `create(UpdateClusterSecretRequestSchema)` to create a new message.
  */
 export const UpdateClusterSecretRequestSchema: GenMessage<UpdateClusterSecretRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 168);
+  messageDesc(file_api_service_v1alpha1_service, 191);
 
 /**
  * UpdateClusterSecretResponse contains the updated cluster secret information.
@@ -4608,7 +5348,7 @@ export type UpdateClusterSecretResponse = Message<"akuity.io.kargo.service.v1alp
  * Use `create(UpdateClusterSecretResponseSchema)` to create a new message.
  */
 export const UpdateClusterSecretResponseSchema: GenMessage<UpdateClusterSecretResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 169);
+  messageDesc(file_api_service_v1alpha1_service, 192);
 
 /**
  * DeleteClusterSecretRequest is the request for deleting a cluster secret.
@@ -4629,7 +5369,7 @@ export type DeleteClusterSecretRequest = Message<"akuity.io.kargo.service.v1alph
  * Use `create(DeleteClusterSecretRequestSchema)` to create a new message.
  */
 export const DeleteClusterSecretRequestSchema: GenMessage<DeleteClusterSecretRequest> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 170);
+  messageDesc(file_api_service_v1alpha1_service, 193);
 
 /**
  * DeleteClusterSecretResponse is the response returned after deleting a cluster secret.
@@ -4646,7 +5386,7 @@ export type DeleteClusterSecretResponse = Message<"akuity.io.kargo.service.v1alp
  * Use `create(DeleteClusterSecretResponseSchema)` to create a new message.
  */
 export const DeleteClusterSecretResponseSchema: GenMessage<DeleteClusterSecretResponse> = /*@__PURE__*/
-  messageDesc(file_api_service_v1alpha1_service, 171);
+  messageDesc(file_api_service_v1alpha1_service, 194);
 
 /**
  * RawFormat specifies the format for raw resource representation.
@@ -4922,6 +5662,26 @@ export const KargoService: GenService<{
     input: typeof AbortPromotionRequestSchema;
     output: typeof AbortPromotionResponseSchema;
   },
+  /**
+   * GetPromotionLogs streams logs captured during the execution of a promotion's steps.
+   *
+   * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.GetPromotionLogs
+   */
+  getPromotionLogs: {
+    methodKind: "server_streaming";
+    input: typeof GetPromotionLogsRequestSchema;
+    output: typeof GetPromotionLogsResponseSchema;
+  },
+  /**
+   * ResumePromotion creates a promotion which resumes a failed or errored promotion.
+   *
+   * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ResumePromotion
+   */
+  resumePromotion: {
+    methodKind: "unary";
+    input: typeof ResumePromotionRequestSchema;
+    output: typeof ResumePromotionResponseSchema;
+  },
   /**
    * DeleteProject removes a project and all associated resources.
    *
@@ -4992,6 +5752,16 @@ export const KargoService: GenService<{
     input: typeof RefreshProjectConfigRequestSchema;
     output: typeof RefreshProjectConfigResponseSchema;
   },
+  /**
+   * GetPromotionWaves retrieves the progress of freight through the promotion waves defined in project-level configuration.
+   *
+   * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.GetPromotionWaves
+   */
+  getPromotionWaves: {
+    methodKind: "unary";
+    input: typeof GetPromotionWavesRequestSchema;
+    output: typeof GetPromotionWavesResponseSchema;
+  },
   /**
    * ApproveFreight marks freight as approved for promotion to a specific stage.
    *
@@ -5002,6 +5772,16 @@ export const KargoService: GenService<{
     input: typeof ApproveFreightRequestSchema;
     output: typeof ApproveFreightResponseSchema;
   },
+  /**
+   * CreateFreight creates freight from specific artifacts subscribed to by a warehouse, resolving their details through artifact discovery.
+   *
+   * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.CreateFreight
+   */
+  createFreight: {
+    methodKind: "unary";
+    input: typeof CreateFreightRequestSchema;
+    output: typeof CreateFreightResponseSchema;
+  },
   /**
    * DeleteFreight removes freight from the system.
    *
@@ -5022,6 +5802,16 @@ export const KargoService: GenService<{
     input: typeof GetFreightRequestSchema;
     output: typeof GetFreightResponseSchema;
   },
+  /**
+   * GetFreightLineage retrieves the lineage of specific freight, from the artifacts it references to every stage it has been through.
+   *
+   * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.GetFreightLineage
+   */
+  getFreightLineage: {
+    methodKind: "unary";
+    input: typeof GetFreightLineageRequestSchema;
+    output: typeof GetFreightLineageResponseSchema;
+  },
   /**
    * WatchFreight provides streaming updates for freight changes.
    *
@@ -5452,6 +6242,46 @@ export const KargoService: GenService<{
     input: typeof UpdateRoleRequestSchema;
     output: typeof UpdateRoleResponseSchema;
   },
+  /**
+   * CreateAPIToken creates a new API token bound to an RBAC role within a project.
+   *
+   * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.CreateAPIToken
+   */
+  createAPIToken: {
+    methodKind: "unary";
+    input: typeof CreateAPITokenRequestSchema;
+    output: typeof CreateAPITokenResponseSchema;
+  },
+  /**
+   * DeleteAPIToken revokes an API token by removing it from a project.
+   *
+   * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.DeleteAPIToken
+   */
+  deleteAPIToken: {
+    methodKind: "unary";
+    input: typeof DeleteAPITokenRequestSchema;
+    output: typeof DeleteAPITokenResponseSchema;
+  },
+  /**
+   * GetAPIToken retrieves details of a specific API token.
+   *
+   * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.GetAPIToken
+   */
+  getAPIToken: {
+    methodKind: "unary";
+    input: typeof GetAPITokenRequestSchema;
+    output: typeof GetAPITokenResponseSchema;
+  },
+  /**
+   * ListAPITokens retrieves all API tokens within a project.
+   *
+   * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ListAPITokens
+   */
+  listAPITokens: {
+    methodKind: "unary";
+    input: typeof ListAPITokensRequestSchema;
+    output: typeof ListAPITokensResponseSchema;
+  },
   /**
    * ListClusterSecrets retrieves all cluster-scoped secrets.
    *
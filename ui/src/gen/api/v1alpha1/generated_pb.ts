@@ -10,6 +10,8 @@ import type { LocalObjectReference } from "../../k8s.io/api/core/v1/generated_pb
 import { file_k8s_io_api_core_v1_generated } from "../../k8s.io/api/core/v1/generated_pb";
 import type { JSON } from "../../k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1/generated_pb";
 import { file_k8s_io_apiextensions_apiserver_pkg_apis_apiextensions_v1_generated } from "../../k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1/generated_pb";
+import type { Quantity } from "../../k8s.io/apimachinery/pkg/api/resource/generated_pb";
+import { file_k8s_io_apimachinery_pkg_api_resource_generated } from "../../k8s.io/apimachinery/pkg/api/resource/generated_pb";
 import type { Condition, Duration, LabelSelector, ListMeta, ObjectMeta, Time } from "../../k8s.io/apimachinery/pkg/apis/meta/v1/generated_pb";
 import { file_k8s_io_apimachinery_pkg_apis_meta_v1_generated } from "../../k8s.io/apimachinery/pkg/apis/meta/v1/generated_pb";
 import { file_k8s_io_apimachinery_pkg_runtime_generated } from "../../k8s.io/apimachinery/pkg/runtime/generated_pb";
@@ -20,7 +22,42 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/v1alpha1/generated.proto.
  */
 export const file_api_v1alpha1_generated: GenFile = /*@__PURE__*/
-  fileDesc("ChxhcGkvdjFhbHBoYTEvZ2VuZXJhdGVkLnByb3RvEiRnaXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEiMgoTQW5hbHlzaXNSdW5Bcmd1bWVudBIMCgRuYW1lGAEgASgJEg0KBXZhbHVlGAIgASgJIrACChNBbmFseXNpc1J1bk1ldGFkYXRhElUKBmxhYmVscxgBIAMoCzJFLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5BbmFseXNpc1J1bk1ldGFkYXRhLkxhYmVsc0VudHJ5El8KC2Fubm90YXRpb25zGAIgAygLMkouZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkFuYWx5c2lzUnVuTWV0YWRhdGEuQW5ub3RhdGlvbnNFbnRyeRotCgtMYWJlbHNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBGjIKEEFubm90YXRpb25zRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJGChRBbmFseXNpc1J1blJlZmVyZW5jZRIRCgluYW1lc3BhY2UYASABKAkSDAoEbmFtZRgCIAEoCRINCgVwaGFzZRgDIAEoCSI3ChlBbmFseXNpc1RlbXBsYXRlUmVmZXJlbmNlEgwKBG5hbWUYASABKAkSDAoEa2luZBgCIAEoCSJPCg1BcHByb3ZlZFN0YWdlEj4KCmFwcHJvdmVkQXQYASABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZSI4ChVBcmdvQ0RBcHBIZWFsdGhTdGF0dXMSDgoGc3RhdHVzGAEgASgJEg8KB21lc3NhZ2UYAiABKAki1AEKD0FyZ29DREFwcFN0YXR1cxIRCgluYW1lc3BhY2UYASABKAkSDAoEbmFtZRgCIAEoCRJRCgxoZWFsdGhTdGF0dXMYAyABKAsyOy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQXJnb0NEQXBwSGVhbHRoU3RhdHVzEk0KCnN5bmNTdGF0dXMYBCABKAsyOS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQXJnb0NEQXBwU3luY1N0YXR1cyJKChNBcmdvQ0RBcHBTeW5jU3RhdHVzEg4KBnN0YXR1cxgBIAEoCRIQCghyZXZpc2lvbhgCIAEoCRIRCglyZXZpc2lvbnMYAyADKAkieAogQXJ0aWZhY3RvcnlXZWJob29rUmVjZWl2ZXJDb25maWcSOwoJc2VjcmV0UmVmGAEgASgLMiguazhzLmlvLmFwaS5jb3JlLnYxLkxvY2FsT2JqZWN0UmVmZXJlbmNlEhcKD3ZpcnR1YWxSZXBvTmFtZRgCIAEoCSIvChRBdXRvUHJvbW90aW9uT3B0aW9ucxIXCg9zZWxlY3Rpb25Qb2xpY3kYASABKAkiWQoaQXp1cmVXZWJob29rUmVjZWl2ZXJDb25maWcSOwoJc2VjcmV0UmVmGAEgASgLMiguazhzLmlvLmFwaS5jb3JlLnYxLkxvY2FsT2JqZWN0UmVmZXJlbmNlIl0KHkJpdGJ1Y2tldFdlYmhvb2tSZWNlaXZlckNvbmZpZxI7CglzZWNyZXRSZWYYASABKAsyKC5rOHMuaW8uYXBpLmNvcmUudjEuTG9jYWxPYmplY3RSZWZlcmVuY2UiNwoFQ2hhcnQSDwoHcmVwb1VSTBgBIAEoCRIMCgRuYW1lGAIgASgJEg8KB3ZlcnNpb24YAyABKAkiYQoUQ2hhcnREaXNjb3ZlcnlSZXN1bHQSDwoHcmVwb1VSTBgBIAEoCRIMCgRuYW1lGAIgASgJEhgKEHNlbXZlckNvbnN0cmFpbnQYAyABKAkSEAoIdmVyc2lvbnMYBCADKAkiZAoRQ2hhcnRTdWJzY3JpcHRpb24SDwoHcmVwb1VSTBgBIAEoCRIMCgRuYW1lGAIgASgJEhgKEHNlbXZlckNvbnN0cmFpbnQYAyABKAkSFgoOZGlzY292ZXJ5TGltaXQYBCABKAUi5QEKDUNsdXN0ZXJDb25maWcSQgoIbWV0YWRhdGEYASABKAsyMC5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuT2JqZWN0TWV0YRJFCgRzcGVjGAIgASgLMjcuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkNsdXN0ZXJDb25maWdTcGVjEkkKBnN0YXR1cxgDIAEoCzI5LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5DbHVzdGVyQ29uZmlnU3RhdHVzIpkBChFDbHVzdGVyQ29uZmlnTGlzdBJACghtZXRhZGF0YRgBIAEoCzIuLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5MaXN0TWV0YRJCCgVpdGVtcxgCIAMoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5DbHVzdGVyQ29uZmlnImoKEUNsdXN0ZXJDb25maWdTcGVjElUKEHdlYmhvb2tSZWNlaXZlcnMYASADKAsyOy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuV2ViaG9va1JlY2VpdmVyQ29uZmlnIuoBChNDbHVzdGVyQ29uZmlnU3RhdHVzEkMKCmNvbmRpdGlvbnMYASADKAsyLy5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuQ29uZGl0aW9uEhoKEm9ic2VydmVkR2VuZXJhdGlvbhgDIAEoAxIaChJsYXN0SGFuZGxlZFJlZnJlc2gYBCABKAkSVgoQd2ViaG9va1JlY2VpdmVycxgCIAMoCzI8LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5XZWJob29rUmVjZWl2ZXJEZXRhaWxzIqEBChRDbHVzdGVyUHJvbW90aW9uVGFzaxJCCghtZXRhZGF0YRgBIAEoCzIwLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5PYmplY3RNZXRhEkUKBHNwZWMYAiABKAsyNy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uVGFza1NwZWMipwEKGENsdXN0ZXJQcm9tb3Rpb25UYXNrTGlzdBJACghtZXRhZGF0YRgBIAEoCzIuLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5MaXN0TWV0YRJJCgVpdGVtcxgCIAMoCzI6LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5DbHVzdGVyUHJvbW90aW9uVGFzayJJCgxDdXJyZW50U3RhZ2USOQoFc2luY2UYASABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZSK2AgoTRGlzY292ZXJlZEFydGlmYWN0cxJACgxkaXNjb3ZlcmVkQXQYBCABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZRJFCgNnaXQYASADKAsyOC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuR2l0RGlzY292ZXJ5UmVzdWx0EkoKBmltYWdlcxgCIAMoCzI6LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5JbWFnZURpc2NvdmVyeVJlc3VsdBJKCgZjaGFydHMYAyADKAsyOi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQ2hhcnREaXNjb3ZlcnlSZXN1bHQisAEKEERpc2NvdmVyZWRDb21taXQSCgoCaWQYASABKAkSDgoGYnJhbmNoGAIgASgJEgsKA3RhZxgDIAEoCRIPCgdzdWJqZWN0GAQgASgJEg4KBmF1dGhvchgFIAEoCRIRCgljb21taXR0ZXIYBiABKAkSPwoLY3JlYXRvckRhdGUYByABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZSKQAgoYRGlzY292ZXJlZEltYWdlUmVmZXJlbmNlEgsKA3RhZxgBIAEoCRIOCgZkaWdlc3QYAiABKAkSZAoLYW5ub3RhdGlvbnMYBSADKAsyTy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRGlzY292ZXJlZEltYWdlUmVmZXJlbmNlLkFubm90YXRpb25zRW50cnkSPQoJY3JlYXRlZEF0GAQgASgLMiouazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLlRpbWUaMgoQQW5ub3RhdGlvbnNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIl0KHkRvY2tlckh1YldlYmhvb2tSZWNlaXZlckNvbmZpZxI7CglzZWNyZXRSZWYYASABKAsyKC5rOHMuaW8uYXBpLmNvcmUudjEuTG9jYWxPYmplY3RSZWZlcmVuY2UiMQoSRXhwcmVzc2lvblZhcmlhYmxlEgwKBG5hbWUYASABKAkSDQoFdmFsdWUYAiABKAkiogMKB0ZyZWlnaHQSQgoIbWV0YWRhdGEYASABKAsyMC5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuT2JqZWN0TWV0YRINCgVhbGlhcxgHIAEoCRJDCgZvcmlnaW4YCSABKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodE9yaWdpbhJACgdjb21taXRzGAMgAygLMi8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkdpdENvbW1pdBI7CgZpbWFnZXMYBCADKAsyKy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuSW1hZ2USOwoGY2hhcnRzGAUgAygLMisuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkNoYXJ0EkMKBnN0YXR1cxgGIAEoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0U3RhdHVzIq0CChFGcmVpZ2h0Q29sbGVjdGlvbhIKCgJpZBgDIAEoCRJRCgVpdGVtcxgBIAMoCzJCLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0Q29sbGVjdGlvbi5JdGVtc0VudHJ5ElMKE3ZlcmlmaWNhdGlvbkhpc3RvcnkYAiADKAsyNi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuVmVyaWZpY2F0aW9uSW5mbxpkCgpJdGVtc0VudHJ5EgsKA2tleRgBIAEoCRJFCgV2YWx1ZRgCIAEoCzI2LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0UmVmZXJlbmNlOgI4ASItChdGcmVpZ2h0Q3JlYXRpb25Dcml0ZXJpYRISCgpleHByZXNzaW9uGAEgASgJIo0BCgtGcmVpZ2h0TGlzdBJACghtZXRhZGF0YRgBIAEoCzIuLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5MaXN0TWV0YRI8CgVpdGVtcxgCIAMoCzItLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0IisKDUZyZWlnaHRPcmlnaW4SDAoEa2luZBgBIAEoCRIMCgRuYW1lGAIgASgJIqECChBGcmVpZ2h0UmVmZXJlbmNlEgwKBG5hbWUYASABKAkSQwoGb3JpZ2luGAggASgLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRPcmlnaW4SQAoHY29tbWl0cxgCIAMoCzIvLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5HaXRDb21taXQSOwoGaW1hZ2VzGAMgAygLMisuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkltYWdlEjsKBmNoYXJ0cxgEIAMoCzIrLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5DaGFydCKcAQoORnJlaWdodFJlcXVlc3QSQwoGb3JpZ2luGAEgASgLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRPcmlnaW4SRQoHc291cmNlcxgCIAEoCzI0LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0U291cmNlcyLyAQoORnJlaWdodFNvdXJjZXMSDgoGZGlyZWN0GAEgASgIEg4KBnN0YWdlcxgCIAMoCRJIChByZXF1aXJlZFNvYWtUaW1lGAMgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkR1cmF0aW9uEhwKFGF2YWlsYWJpbGl0eVN0cmF0ZWd5GAQgASgJElgKFGF1dG9Qcm9tb3Rpb25PcHRpb25zGAUgASgLMjouZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkF1dG9Qcm9tb3Rpb25PcHRpb25zIp0GCg1GcmVpZ2h0U3RhdHVzElkKC2N1cnJlbnRseUluGAMgAygLMkQuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRTdGF0dXMuQ3VycmVudGx5SW5FbnRyeRJXCgp2ZXJpZmllZEluGAEgAygLMkMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRTdGF0dXMuVmVyaWZpZWRJbkVudHJ5ElkKC2FwcHJvdmVkRm9yGAIgAygLMkQuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRTdGF0dXMuQXBwcm92ZWRGb3JFbnRyeRJTCghtZXRhZGF0YRgEIAMoCzJBLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0U3RhdHVzLk1ldGFkYXRhRW50cnkaZgoQQ3VycmVudGx5SW5FbnRyeRILCgNrZXkYASABKAkSQQoFdmFsdWUYAiABKAsyMi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQ3VycmVudFN0YWdlOgI4ARpmCg9WZXJpZmllZEluRW50cnkSCwoDa2V5GAEgASgJEkIKBXZhbHVlGAIgASgLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlZlcmlmaWVkU3RhZ2U6AjgBGmcKEEFwcHJvdmVkRm9yRW50cnkSCwoDa2V5GAEgASgJEkIKBXZhbHVlGAIgASgLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkFwcHJvdmVkU3RhZ2U6AjgBGm8KDU1ldGFkYXRhRW50cnkSCwoDa2V5GAEgASgJEk0KBXZhbHVlGAIgASgLMj4uazhzLmlvLmFwaWV4dGVuc2lvbnNfYXBpc2VydmVyLnBrZy5hcGlzLmFwaWV4dGVuc2lvbnMudjEuSlNPTjoCOAEieQoJR2l0Q29tbWl0Eg8KB3JlcG9VUkwYASABKAkSCgoCaWQYAiABKAkSDgoGYnJhbmNoGAMgASgJEgsKA3RhZxgEIAEoCRIPCgdtZXNzYWdlGAYgASgJEg4KBmF1dGhvchgHIAEoCRIRCgljb21taXR0ZXIYCCABKAkibgoSR2l0RGlzY292ZXJ5UmVzdWx0Eg8KB3JlcG9VUkwYASABKAkSRwoHY29tbWl0cxgCIAMoCzI2LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5EaXNjb3ZlcmVkQ29tbWl0IloKG0dpdEh1YldlYmhvb2tSZWNlaXZlckNvbmZpZxI7CglzZWNyZXRSZWYYASABKAsyKC5rOHMuaW8uYXBpLmNvcmUudjEuTG9jYWxPYmplY3RSZWZlcmVuY2UiWgobR2l0TGFiV2ViaG9va1JlY2VpdmVyQ29uZmlnEjsKCXNlY3JldFJlZhgBIAEoCzIoLms4cy5pby5hcGkuY29yZS52MS5Mb2NhbE9iamVjdFJlZmVyZW5jZSLdAgoPR2l0U3Vic2NyaXB0aW9uEg8KB3JlcG9VUkwYASABKAkSHwoXY29tbWl0U2VsZWN0aW9uU3RyYXRlZ3kYAiABKAkSDgoGYnJhbmNoGAMgASgJEhUKDXN0cmljdFNlbXZlcnMYCyABKAgSGAoQc2VtdmVyQ29uc3RyYWludBgEIAEoCRIRCglhbGxvd1RhZ3MYBSABKAkSGAoQYWxsb3dUYWdzUmVnZXhlcxgNIAMoCRISCgppZ25vcmVUYWdzGAYgAygJEhkKEWlnbm9yZVRhZ3NSZWdleGVzGA4gAygJEhgKEGV4cHJlc3Npb25GaWx0ZXIYDCABKAkSHQoVaW5zZWN1cmVTa2lwVExTVmVyaWZ5GAcgASgIEhQKDGluY2x1ZGVQYXRocxgIIAMoCRIUCgxleGNsdWRlUGF0aHMYCSADKAkSFgoOZGlzY292ZXJ5TGltaXQYCiABKAUiWQoaR2l0ZWFXZWJob29rUmVjZWl2ZXJDb25maWcSOwoJc2VjcmV0UmVmGAEgASgLMiguazhzLmlvLmFwaS5jb3JlLnYxLkxvY2FsT2JqZWN0UmVmZXJlbmNlIloKG0hhcmJvcldlYmhvb2tSZWNlaXZlckNvbmZpZxI7CglzZWNyZXRSZWYYASABKAsyKC5rOHMuaW8uYXBpLmNvcmUudjEuTG9jYWxPYmplY3RSZWZlcmVuY2UiyAEKBkhlYWx0aBIOCgZzdGF0dXMYASABKAkSDgoGaXNzdWVzGAIgAygJEk4KBmNvbmZpZxgEIAEoCzI+Lms4cy5pby5hcGlleHRlbnNpb25zX2FwaXNlcnZlci5wa2cuYXBpcy5hcGlleHRlbnNpb25zLnYxLkpTT04STgoGb3V0cHV0GAUgASgLMj4uazhzLmlvLmFwaWV4dGVuc2lvbnNfYXBpc2VydmVyLnBrZy5hcGlzLmFwaWV4dGVuc2lvbnMudjEuSlNPTiJvCg9IZWFsdGhDaGVja1N0ZXASDAoEdXNlcxgBIAEoCRJOCgZjb25maWcYAiABKAsyPi5rOHMuaW8uYXBpZXh0ZW5zaW9uc19hcGlzZXJ2ZXIucGtnLmFwaXMuYXBpZXh0ZW5zaW9ucy52MS5KU09OIh4KC0hlYWx0aFN0YXRzEg8KB2hlYWx0aHkYASABKAMivAEKBUltYWdlEg8KB3JlcG9VUkwYASABKAkSCwoDdGFnGAMgASgJEg4KBmRpZ2VzdBgEIAEoCRJRCgthbm5vdGF0aW9ucxgFIAMoCzI8LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5JbWFnZS5Bbm5vdGF0aW9uc0VudHJ5GjIKEEFubm90YXRpb25zRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASKNAQoUSW1hZ2VEaXNjb3ZlcnlSZXN1bHQSDwoHcmVwb1VSTBgBIAEoCRIQCghwbGF0Zm9ybRgCIAEoCRJSCgpyZWZlcmVuY2VzGAMgAygLMj4uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkRpc2NvdmVyZWRJbWFnZVJlZmVyZW5jZSKUAgoRSW1hZ2VTdWJzY3JpcHRpb24SDwoHcmVwb1VSTBgBIAEoCRIeChZpbWFnZVNlbGVjdGlvblN0cmF0ZWd5GAMgASgJEhUKDXN0cmljdFNlbXZlcnMYCiABKAgSEgoKY29uc3RyYWludBgLIAEoCRIRCglhbGxvd1RhZ3MYBSABKAkSGAoQYWxsb3dUYWdzUmVnZXhlcxgNIAMoCRISCgppZ25vcmVUYWdzGAYgAygJEhkKEWlnbm9yZVRhZ3NSZWdleGVzGA4gAygJEhAKCHBsYXRmb3JtGAcgASgJEh0KFWluc2VjdXJlU2tpcFRMU1ZlcmlmeRgIIAEoCBIWCg5kaXNjb3ZlcnlMaW1pdBgJIAEoBSKSAQoHUHJvamVjdBJCCghtZXRhZGF0YRgBIAEoCzIwLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5PYmplY3RNZXRhEkMKBnN0YXR1cxgDIAEoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9qZWN0U3RhdHVzIuUBCg1Qcm9qZWN0Q29uZmlnEkIKCG1ldGFkYXRhGAEgASgLMjAuazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLk9iamVjdE1ldGESRQoEc3BlYxgCIAEoCzI3LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9qZWN0Q29uZmlnU3BlYxJJCgZzdGF0dXMYAyABKAsyOS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvamVjdENvbmZpZ1N0YXR1cyKZAQoRUHJvamVjdENvbmZpZ0xpc3QSQAoIbWV0YWRhdGEYASABKAsyLi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuTGlzdE1ldGESQgoFaXRlbXMYAiADKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvamVjdENvbmZpZyK8AQoRUHJvamVjdENvbmZpZ1NwZWMSUAoRcHJvbW90aW9uUG9saWNpZXMYASADKAsyNS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uUG9saWN5ElUKEHdlYmhvb2tSZWNlaXZlcnMYAiADKAsyOy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuV2ViaG9va1JlY2VpdmVyQ29uZmlnIuoBChNQcm9qZWN0Q29uZmlnU3RhdHVzEkMKCmNvbmRpdGlvbnMYASADKAsyLy5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuQ29uZGl0aW9uEhoKEm9ic2VydmVkR2VuZXJhdGlvbhgDIAEoAxIaChJsYXN0SGFuZGxlZFJlZnJlc2gYBCABKAkSVgoQd2ViaG9va1JlY2VpdmVycxgCIAMoCzI8LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5XZWJob29rUmVjZWl2ZXJEZXRhaWxzIo0BCgtQcm9qZWN0TGlzdBJACghtZXRhZGF0YRgBIAEoCzIuLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5MaXN0TWV0YRI8CgVpdGVtcxgCIAMoCzItLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9qZWN0IpoBCgxQcm9qZWN0U3RhdHMSSAoKd2FyZWhvdXNlcxgBIAEoCzI0LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5XYXJlaG91c2VTdGF0cxJACgZzdGFnZXMYAiABKAsyMC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuU3RhZ2VTdGF0cyKXAQoNUHJvamVjdFN0YXR1cxJDCgpjb25kaXRpb25zGAMgAygLMi8uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkNvbmRpdGlvbhJBCgVzdGF0cxgEIAEoCzIyLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9qZWN0U3RhdHMi2QEKCVByb21vdGlvbhJCCghtZXRhZGF0YRgBIAEoCzIwLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5PYmplY3RNZXRhEkEKBHNwZWMYAiABKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uU3BlYxJFCgZzdGF0dXMYAyABKAsyNS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uU3RhdHVzIpEBCg1Qcm9tb3Rpb25MaXN0EkAKCG1ldGFkYXRhGAEgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkxpc3RNZXRhEj4KBWl0ZW1zGAIgAygLMi8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvbiKUAQoPUHJvbW90aW9uUG9saWN5Eg0KBXN0YWdlGAEgASgJElQKDXN0YWdlU2VsZWN0b3IYAyABKAsyPS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uUG9saWN5U2VsZWN0b3ISHAoUYXV0b1Byb21vdGlvbkVuYWJsZWQYAiABKAgicwoXUHJvbW90aW9uUG9saWN5U2VsZWN0b3ISDAoEbmFtZRgBIAEoCRJKCg1sYWJlbFNlbGVjdG9yGAIgASgLMjMuazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkxhYmVsU2VsZWN0b3Ii8gEKElByb21vdGlvblJlZmVyZW5jZRIMCgRuYW1lGAEgASgJEkcKB2ZyZWlnaHQYAiABKAsyNi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodFJlZmVyZW5jZRJFCgZzdGF0dXMYAyABKAsyNS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uU3RhdHVzEj4KCmZpbmlzaGVkQXQYBCABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZSK7AQoNUHJvbW90aW9uU3BlYxINCgVzdGFnZRgBIAEoCRIPCgdmcmVpZ2h0GAIgASgJEkYKBHZhcnMYBCADKAsyOC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRXhwcmVzc2lvblZhcmlhYmxlEkIKBXN0ZXBzGAMgAygLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvblN0ZXAi9gQKD1Byb21vdGlvblN0YXR1cxIaChJsYXN0SGFuZGxlZFJlZnJlc2gYBCABKAkSDQoFcGhhc2UYASABKAkSDwoHbWVzc2FnZRgCIAEoCRJHCgdmcmVpZ2h0GAUgASgLMjYuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRSZWZlcmVuY2USUgoRZnJlaWdodENvbGxlY3Rpb24YByABKAsyNy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodENvbGxlY3Rpb24SSwoMaGVhbHRoQ2hlY2tzGAggAygLMjUuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkhlYWx0aENoZWNrU3RlcBI9CglzdGFydGVkQXQYDCABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZRI+CgpmaW5pc2hlZEF0GAYgASgLMiouazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLlRpbWUSEwoLY3VycmVudFN0ZXAYCSABKAMSWgoVc3RlcEV4ZWN1dGlvbk1ldGFkYXRhGAsgAygLMjsuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlN0ZXBFeGVjdXRpb25NZXRhZGF0YRJNCgVzdGF0ZRgKIAEoCzI+Lms4cy5pby5hcGlleHRlbnNpb25zX2FwaXNlcnZlci5wa2cuYXBpcy5hcGlleHRlbnNpb25zLnYxLkpTT04i+wIKDVByb21vdGlvblN0ZXASDAoEdXNlcxgBIAEoCRJKCgR0YXNrGAUgASgLMjwuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvblRhc2tSZWZlcmVuY2USCgoCYXMYAiABKAkSCgoCaWYYByABKAkSFwoPY29udGludWVPbkVycm9yGAggASgIEkcKBXJldHJ5GAQgASgLMjguZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvblN0ZXBSZXRyeRJGCgR2YXJzGAYgAygLMjguZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkV4cHJlc3Npb25WYXJpYWJsZRJOCgZjb25maWcYAyABKAsyPi5rOHMuaW8uYXBpZXh0ZW5zaW9uc19hcGlzZXJ2ZXIucGtnLmFwaXMuYXBpZXh0ZW5zaW9ucy52MS5KU09OIm0KElByb21vdGlvblN0ZXBSZXRyeRI/Cgd0aW1lb3V0GAEgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkR1cmF0aW9uEhYKDmVycm9yVGhyZXNob2xkGAIgASgNIpoBCg1Qcm9tb3Rpb25UYXNrEkIKCG1ldGFkYXRhGAEgASgLMjAuazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLk9iamVjdE1ldGESRQoEc3BlYxgCIAEoCzI3LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25UYXNrU3BlYyKZAQoRUHJvbW90aW9uVGFza0xpc3QSQAoIbWV0YWRhdGEYASABKAsyLi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuTGlzdE1ldGESQgoFaXRlbXMYAiADKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uVGFzayI0ChZQcm9tb3Rpb25UYXNrUmVmZXJlbmNlEgwKBG5hbWUYASABKAkSDAoEa2luZBgCIAEoCSKfAQoRUHJvbW90aW9uVGFza1NwZWMSRgoEdmFycxgBIAMoCzI4LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5FeHByZXNzaW9uVmFyaWFibGUSQgoFc3RlcHMYAiADKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uU3RlcCJeChFQcm9tb3Rpb25UZW1wbGF0ZRJJCgRzcGVjGAEgASgLMjsuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvblRlbXBsYXRlU3BlYyKjAQoVUHJvbW90aW9uVGVtcGxhdGVTcGVjEkYKBHZhcnMYAiADKAsyOC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRXhwcmVzc2lvblZhcmlhYmxlEkIKBXN0ZXBzGAEgAygLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvblN0ZXAiWAoZUXVheVdlYmhvb2tSZWNlaXZlckNvbmZpZxI7CglzZWNyZXRSZWYYASABKAsyKC5rOHMuaW8uYXBpLmNvcmUudjEuTG9jYWxPYmplY3RSZWZlcmVuY2Ui5gEKEFJlcG9TdWJzY3JpcHRpb24SQgoDZ2l0GAEgASgLMjUuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkdpdFN1YnNjcmlwdGlvbhJGCgVpbWFnZRgCIAEoCzI3LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5JbWFnZVN1YnNjcmlwdGlvbhJGCgVjaGFydBgDIAEoCzI3LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5DaGFydFN1YnNjcmlwdGlvbiLNAQoFU3RhZ2USQgoIbWV0YWRhdGEYASABKAsyMC5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuT2JqZWN0TWV0YRI9CgRzcGVjGAIgASgLMi8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlN0YWdlU3BlYxJBCgZzdGF0dXMYAyABKAsyMS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuU3RhZ2VTdGF0dXMiiQEKCVN0YWdlTGlzdBJACghtZXRhZGF0YRgBIAEoCzIuLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5MaXN0TWV0YRI6CgVpdGVtcxgCIAMoCzIrLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5TdGFnZSLQAgoJU3RhZ2VTcGVjEg0KBXNoYXJkGAQgASgJEkYKBHZhcnMYByADKAsyOC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRXhwcmVzc2lvblZhcmlhYmxlEk4KEHJlcXVlc3RlZEZyZWlnaHQYBSADKAsyNC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodFJlcXVlc3QSUgoRcHJvbW90aW9uVGVtcGxhdGUYBiABKAsyNy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uVGVtcGxhdGUSSAoMdmVyaWZpY2F0aW9uGAMgASgLMjIuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlZlcmlmaWNhdGlvbiJeCgpTdGFnZVN0YXRzEg0KBWNvdW50GAIgASgDEkEKBmhlYWx0aBgBIAEoCzIxLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5IZWFsdGhTdGF0cyK4BQoLU3RhZ2VTdGF0dXMSQwoKY29uZGl0aW9ucxgNIAMoCzIvLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5Db25kaXRpb24SGgoSbGFzdEhhbmRsZWRSZWZyZXNoGAsgASgJEk8KDmZyZWlnaHRIaXN0b3J5GAQgAygLMjcuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRDb2xsZWN0aW9uEhYKDmZyZWlnaHRTdW1tYXJ5GAwgASgJEjwKBmhlYWx0aBgIIAEoCzIsLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5IZWFsdGgSGgoSb2JzZXJ2ZWRHZW5lcmF0aW9uGAYgASgDElIKEGN1cnJlbnRQcm9tb3Rpb24YByABKAsyOC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uUmVmZXJlbmNlEk8KDWxhc3RQcm9tb3Rpb24YCiABKAsyOC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uUmVmZXJlbmNlEhwKFGF1dG9Qcm9tb3Rpb25FbmFibGVkGA4gASgIElEKCG1ldGFkYXRhGA8gAygLMj8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlN0YWdlU3RhdHVzLk1ldGFkYXRhRW50cnkabwoNTWV0YWRhdGFFbnRyeRILCgNrZXkYASABKAkSTQoFdmFsdWUYAiABKAsyPi5rOHMuaW8uYXBpZXh0ZW5zaW9uc19hcGlzZXJ2ZXIucGtnLmFwaXMuYXBpZXh0ZW5zaW9ucy52MS5KU09OOgI4ASLzAQoVU3RlcEV4ZWN1dGlvbk1ldGFkYXRhEg0KBWFsaWFzGAEgASgJEj0KCXN0YXJ0ZWRBdBgCIAEoCzIqLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5UaW1lEj4KCmZpbmlzaGVkQXQYAyABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZRISCgplcnJvckNvdW50GAQgASgNEg4KBnN0YXR1cxgFIAEoCRIPCgdtZXNzYWdlGAYgASgJEhcKD2NvbnRpbnVlT25FcnJvchgHIAEoCCKLAgoMVmVyaWZpY2F0aW9uEloKEWFuYWx5c2lzVGVtcGxhdGVzGAEgAygLMj8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkFuYWx5c2lzVGVtcGxhdGVSZWZlcmVuY2USVgoTYW5hbHlzaXNSdW5NZXRhZGF0YRgCIAEoCzI5LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5BbmFseXNpc1J1bk1ldGFkYXRhEkcKBGFyZ3MYAyADKAsyOS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQW5hbHlzaXNSdW5Bcmd1bWVudCKdAgoQVmVyaWZpY2F0aW9uSW5mbxIKCgJpZBgEIAEoCRINCgVhY3RvchgHIAEoCRI9CglzdGFydFRpbWUYBSABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZRINCgVwaGFzZRgBIAEoCRIPCgdtZXNzYWdlGAIgASgJEk8KC2FuYWx5c2lzUnVuGAMgASgLMjouZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkFuYWx5c2lzUnVuUmVmZXJlbmNlEj4KCmZpbmlzaFRpbWUYBiABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZSKUAQoNVmVyaWZpZWRTdGFnZRI+Cgp2ZXJpZmllZEF0GAEgASgLMiouazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLlRpbWUSQwoLbG9uZ2VzdFNvYWsYAiABKAsyLi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuRHVyYXRpb24i2QEKCVdhcmVob3VzZRJCCghtZXRhZGF0YRgBIAEoCzIwLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5PYmplY3RNZXRhEkEKBHNwZWMYAiABKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuV2FyZWhvdXNlU3BlYxJFCgZzdGF0dXMYAyABKAsyNS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuV2FyZWhvdXNlU3RhdHVzIpEBCg1XYXJlaG91c2VMaXN0EkAKCG1ldGFkYXRhGAEgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkxpc3RNZXRhEj4KBWl0ZW1zGAIgAygLMi8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLldhcmVob3VzZSKuAgoNV2FyZWhvdXNlU3BlYxINCgVzaGFyZBgCIAEoCRJACghpbnRlcnZhbBgEIAEoCzIuLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5EdXJhdGlvbhIdChVmcmVpZ2h0Q3JlYXRpb25Qb2xpY3kYAyABKAkSTQoNc3Vic2NyaXB0aW9ucxgBIAMoCzI2LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5SZXBvU3Vic2NyaXB0aW9uEl4KF2ZyZWlnaHRDcmVhdGlvbkNyaXRlcmlhGAUgASgLMj0uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRDcmVhdGlvbkNyaXRlcmlhImIKDldhcmVob3VzZVN0YXRzEg0KBWNvdW50GAIgASgDEkEKBmhlYWx0aBgBIAEoCzIxLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5IZWFsdGhTdGF0cyL9AQoPV2FyZWhvdXNlU3RhdHVzEkMKCmNvbmRpdGlvbnMYCSADKAsyLy5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuQ29uZGl0aW9uEhoKEmxhc3RIYW5kbGVkUmVmcmVzaBgGIAEoCRIaChJvYnNlcnZlZEdlbmVyYXRpb24YBCABKAMSFQoNbGFzdEZyZWlnaHRJRBgIIAEoCRJWChNkaXNjb3ZlcmVkQXJ0aWZhY3RzGAcgASgLMjkuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkRpc2NvdmVyZWRBcnRpZmFjdHMingYKFVdlYmhvb2tSZWNlaXZlckNvbmZpZxIMCgRuYW1lGAEgASgJElcKCWJpdGJ1Y2tldBgFIAEoCzJELmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5CaXRidWNrZXRXZWJob29rUmVjZWl2ZXJDb25maWcSVwoJZG9ja2VyaHViGAYgASgLMkQuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkRvY2tlckh1YldlYmhvb2tSZWNlaXZlckNvbmZpZxJRCgZnaXRodWIYAiABKAsyQS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuR2l0SHViV2ViaG9va1JlY2VpdmVyQ29uZmlnElEKBmdpdGxhYhgDIAEoCzJBLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5HaXRMYWJXZWJob29rUmVjZWl2ZXJDb25maWcSUQoGaGFyYm9yGAogASgLMkEuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkhhcmJvcldlYmhvb2tSZWNlaXZlckNvbmZpZxJNCgRxdWF5GAQgASgLMj8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlF1YXlXZWJob29rUmVjZWl2ZXJDb25maWcSWwoLYXJ0aWZhY3RvcnkYCSABKAsyRi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQXJ0aWZhY3RvcnlXZWJob29rUmVjZWl2ZXJDb25maWcSTwoFYXp1cmUYCCABKAsyQC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQXp1cmVXZWJob29rUmVjZWl2ZXJDb25maWcSTwoFZ2l0ZWEYByABKAsyQC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuR2l0ZWFXZWJob29rUmVjZWl2ZXJDb25maWciQQoWV2ViaG9va1JlY2VpdmVyRGV0YWlscxIMCgRuYW1lGAEgASgJEgwKBHBhdGgYAyABKAkSCwoDdXJsGAQgASgJQpcCCihjb20uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExQg5HZW5lcmF0ZWRQcm90b1ABWiRnaXRodWIuY29tL2FrdWl0eS9rYXJnby9hcGkvdjFhbHBoYTGiAgVHQ0FLQaoCJEdpdGh1Yi5Db20uQWt1aXR5LkthcmdvLkFwaS5WMWFscGhhMcoCJEdpdGh1YlxDb21cQWt1aXR5XEthcmdvXEFwaVxWMWFscGhhMeICMEdpdGh1YlxDb21cQWt1aXR5XEthcmdvXEFwaVxWMWFscGhhMVxHUEJNZXRhZGF0YeoCKUdpdGh1Yjo6Q29tOjpBa3VpdHk6OkthcmdvOjpBcGk6OlYxYWxwaGEx", [file_k8s_io_api_core_v1_generated, file_k8s_io_apiextensions_apiserver_pkg_apis_apiextensions_v1_generated, file_k8s_io_apimachinery_pkg_apis_meta_v1_generated, file_k8s_io_apimachinery_pkg_runtime_generated, file_k8s_io_apimachinery_pkg_runtime_schema_generated]);
+  fileDesc("ChxhcGkvdjFhbHBoYTEvZ2VuZXJhdGVkLnByb3RvEiRnaXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEiLQoLQWdlbnRDb25maWcSDAoEbmFtZRgBIAEoCRIQCghwcm9qZWN0cxgCIAMoCSIyChNBbmFseXNpc1J1bkFyZ3VtZW50EgwKBG5hbWUYASABKAkSDQoFdmFsdWUYAiABKAkisAIKE0FuYWx5c2lzUnVuTWV0YWRhdGESVQoGbGFiZWxzGAEgAygLMkUuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkFuYWx5c2lzUnVuTWV0YWRhdGEuTGFiZWxzRW50cnkSXwoLYW5ub3RhdGlvbnMYAiADKAsySi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQW5hbHlzaXNSdW5NZXRhZGF0YS5Bbm5vdGF0aW9uc0VudHJ5Gi0KC0xhYmVsc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEaMgoQQW5ub3RhdGlvbnNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIkYKFEFuYWx5c2lzUnVuUmVmZXJlbmNlEhEKCW5hbWVzcGFjZRgBIAEoCRIMCgRuYW1lGAIgASgJEg0KBXBoYXNlGAMgASgJIjcKGUFuYWx5c2lzVGVtcGxhdGVSZWZlcmVuY2USDAoEbmFtZRgBIAEoCRIMCgRraW5kGAIgASgJImMKDUFwcHJvdmVkU3RhZ2USPgoKYXBwcm92ZWRBdBgBIAEoCzIqLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5UaW1lEhIKCmFsbG93U3RhbGUYAiABKAgiOAoVQXJnb0NEQXBwSGVhbHRoU3RhdHVzEg4KBnN0YXR1cxgBIAEoCRIPCgdtZXNzYWdlGAIgASgJItQBCg9BcmdvQ0RBcHBTdGF0dXMSEQoJbmFtZXNwYWNlGAEgASgJEgwKBG5hbWUYAiABKAkSUQoMaGVhbHRoU3RhdHVzGAMgASgLMjsuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkFyZ29DREFwcEhlYWx0aFN0YXR1cxJNCgpzeW5jU3RhdHVzGAQgASgLMjkuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkFyZ29DREFwcFN5bmNTdGF0dXMiSgoTQXJnb0NEQXBwU3luY1N0YXR1cxIOCgZzdGF0dXMYASABKAkSEAoIcmV2aXNpb24YAiABKAkSEQoJcmV2aXNpb25zGAMgAygJIngKIEFydGlmYWN0b3J5V2ViaG9va1JlY2VpdmVyQ29uZmlnEjsKCXNlY3JldFJlZhgBIAEoCzIoLms4cy5pby5hcGkuY29yZS52MS5Mb2NhbE9iamVjdFJlZmVyZW5jZRIXCg92aXJ0dWFsUmVwb05hbWUYAiABKAkiQgoUQXV0b1Byb21vdGlvbk9wdGlvbnMSFwoPc2VsZWN0aW9uUG9saWN5GAEgASgJEhEKCWNvbmRpdGlvbhgCIAEoCSJZChpBenVyZVdlYmhvb2tSZWNlaXZlckNvbmZpZxI7CglzZWNyZXRSZWYYASABKAsyKC5rOHMuaW8uYXBpLmNvcmUudjEuTG9jYWxPYmplY3RSZWZlcmVuY2UiXQoeQml0YnVja2V0V2ViaG9va1JlY2VpdmVyQ29uZmlnEjsKCXNlY3JldFJlZhgBIAEoCzIoLms4cy5pby5hcGkuY29yZS52MS5Mb2NhbE9iamVjdFJlZmVyZW5jZSI3CgVDaGFydBIPCgdyZXBvVVJMGAEgASgJEgwKBG5hbWUYAiABKAkSDwoHdmVyc2lvbhgDIAEoCSJhChRDaGFydERpc2NvdmVyeVJlc3VsdBIPCgdyZXBvVVJMGAEgASgJEgwKBG5hbWUYAiABKAkSGAoQc2VtdmVyQ29uc3RyYWludBgDIAEoCRIQCgh2ZXJzaW9ucxgEIAMoCSJkChFDaGFydFN1YnNjcmlwdGlvbhIPCgdyZXBvVVJMGAEgASgJEgwKBG5hbWUYAiABKAkSGAoQc2VtdmVyQ29uc3RyYWludBgDIAEoCRIWCg5kaXNjb3ZlcnlMaW1pdBgEIAEoBSLlAQoNQ2x1c3RlckNvbmZpZxJCCghtZXRhZGF0YRgBIAEoCzIwLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5PYmplY3RNZXRhEkUKBHNwZWMYAiABKAsyNy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQ2x1c3RlckNvbmZpZ1NwZWMSSQoGc3RhdHVzGAMgASgLMjkuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkNsdXN0ZXJDb25maWdTdGF0dXMimQEKEUNsdXN0ZXJDb25maWdMaXN0EkAKCG1ldGFkYXRhGAEgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkxpc3RNZXRhEkIKBWl0ZW1zGAIgAygLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkNsdXN0ZXJDb25maWcirQEKEUNsdXN0ZXJDb25maWdTcGVjElUKEHdlYmhvb2tSZWNlaXZlcnMYASADKAsyOy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuV2ViaG9va1JlY2VpdmVyQ29uZmlnEkEKBmFnZW50cxgCIAMoCzIxLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5BZ2VudENvbmZpZyLqAQoTQ2x1c3RlckNvbmZpZ1N0YXR1cxJDCgpjb25kaXRpb25zGAEgAygLMi8uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkNvbmRpdGlvbhIaChJvYnNlcnZlZEdlbmVyYXRpb24YAyABKAMSGgoSbGFzdEhhbmRsZWRSZWZyZXNoGAQgASgJElYKEHdlYmhvb2tSZWNlaXZlcnMYAiADKAsyPC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuV2ViaG9va1JlY2VpdmVyRGV0YWlscyKhAQoUQ2x1c3RlclByb21vdGlvblRhc2sSQgoIbWV0YWRhdGEYASABKAsyMC5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuT2JqZWN0TWV0YRJFCgRzcGVjGAIgASgLMjcuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvblRhc2tTcGVjIqcBChhDbHVzdGVyUHJvbW90aW9uVGFza0xpc3QSQAoIbWV0YWRhdGEYASABKAsyLi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuTGlzdE1ldGESSQoFaXRlbXMYAiADKAsyOi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQ2x1c3RlclByb21vdGlvblRhc2siLwoQQ29uY3VycmVuY3lHcm91cBIMCgRuYW1lGAEgASgJEg0KBWxpbWl0GAIgASgFIkkKDEN1cnJlbnRTdGFnZRI5CgVzaW5jZRgBIAEoCzIqLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5UaW1lIrYCChNEaXNjb3ZlcmVkQXJ0aWZhY3RzEkAKDGRpc2NvdmVyZWRBdBgEIAEoCzIqLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5UaW1lEkUKA2dpdBgBIAMoCzI4LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5HaXREaXNjb3ZlcnlSZXN1bHQSSgoGaW1hZ2VzGAIgAygLMjouZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkltYWdlRGlzY292ZXJ5UmVzdWx0EkoKBmNoYXJ0cxgDIAMoCzI6LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5DaGFydERpc2NvdmVyeVJlc3VsdCKwAQoQRGlzY292ZXJlZENvbW1pdBIKCgJpZBgBIAEoCRIOCgZicmFuY2gYAiABKAkSCwoDdGFnGAMgASgJEg8KB3N1YmplY3QYBCABKAkSDgoGYXV0aG9yGAUgASgJEhEKCWNvbW1pdHRlchgGIAEoCRI/CgtjcmVhdG9yRGF0ZRgHIAEoCzIqLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5UaW1lIpACChhEaXNjb3ZlcmVkSW1hZ2VSZWZlcmVuY2USCwoDdGFnGAEgASgJEg4KBmRpZ2VzdBgCIAEoCRJkCgthbm5vdGF0aW9ucxgFIAMoCzJPLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5EaXNjb3ZlcmVkSW1hZ2VSZWZlcmVuY2UuQW5ub3RhdGlvbnNFbnRyeRI9CgljcmVhdGVkQXQYBCABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZRoyChBBbm5vdGF0aW9uc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiXQoeRG9ja2VySHViV2ViaG9va1JlY2VpdmVyQ29uZmlnEjsKCXNlY3JldFJlZhgBIAEoCzIoLms4cy5pby5hcGkuY29yZS52MS5Mb2NhbE9iamVjdFJlZmVyZW5jZSIxChJFeHByZXNzaW9uVmFyaWFibGUSDAoEbmFtZRgBIAEoCRINCgV2YWx1ZRgCIAEoCSKiAwoHRnJlaWdodBJCCghtZXRhZGF0YRgBIAEoCzIwLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5PYmplY3RNZXRhEg0KBWFsaWFzGAcgASgJEkMKBm9yaWdpbhgJIAEoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0T3JpZ2luEkAKB2NvbW1pdHMYAyADKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuR2l0Q29tbWl0EjsKBmltYWdlcxgEIAMoCzIrLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5JbWFnZRI7CgZjaGFydHMYBSADKAsyKy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQ2hhcnQSQwoGc3RhdHVzGAYgASgLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRTdGF0dXMirQIKEUZyZWlnaHRDb2xsZWN0aW9uEgoKAmlkGAMgASgJElEKBWl0ZW1zGAEgAygLMkIuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRDb2xsZWN0aW9uLkl0ZW1zRW50cnkSUwoTdmVyaWZpY2F0aW9uSGlzdG9yeRgCIAMoCzI2LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5WZXJpZmljYXRpb25JbmZvGmQKCkl0ZW1zRW50cnkSCwoDa2V5GAEgASgJEkUKBXZhbHVlGAIgASgLMjYuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRSZWZlcmVuY2U6AjgBIi0KF0ZyZWlnaHRDcmVhdGlvbkNyaXRlcmlhEhIKCmV4cHJlc3Npb24YASABKAkijQEKC0ZyZWlnaHRMaXN0EkAKCG1ldGFkYXRhGAEgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkxpc3RNZXRhEjwKBWl0ZW1zGAIgAygLMi0uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHQiKwoNRnJlaWdodE9yaWdpbhIMCgRraW5kGAEgASgJEgwKBG5hbWUYAiABKAkioQIKEEZyZWlnaHRSZWZlcmVuY2USDAoEbmFtZRgBIAEoCRJDCgZvcmlnaW4YCCABKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodE9yaWdpbhJACgdjb21taXRzGAIgAygLMi8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkdpdENvbW1pdBI7CgZpbWFnZXMYAyADKAsyKy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuSW1hZ2USOwoGY2hhcnRzGAQgAygLMisuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkNoYXJ0IvMBCg5GcmVpZ2h0UmVxdWVzdBJDCgZvcmlnaW4YASABKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodE9yaWdpbhJFCgdzb3VyY2VzGAIgASgLMjQuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRTb3VyY2VzElUKD3N0YWxlbmVzc1BvbGljeRgDIAEoCzI8LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0U3RhbGVuZXNzUG9saWN5IvIBCg5GcmVpZ2h0U291cmNlcxIOCgZkaXJlY3QYASABKAgSDgoGc3RhZ2VzGAIgAygJEkgKEHJlcXVpcmVkU29ha1RpbWUYAyABKAsyLi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuRHVyYXRpb24SHAoUYXZhaWxhYmlsaXR5U3RyYXRlZ3kYBCABKAkSWAoUYXV0b1Byb21vdGlvbk9wdGlvbnMYBSABKAsyOi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQXV0b1Byb21vdGlvbk9wdGlvbnMibgoWRnJlaWdodFN0YWxlbmVzc1BvbGljeRI+CgZtYXhBZ2UYASABKAsyLi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuRHVyYXRpb24SFAoMc3VwZXJzZWRlZEJ5GAIgASgFIp0GCg1GcmVpZ2h0U3RhdHVzElkKC2N1cnJlbnRseUluGAMgAygLMkQuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRTdGF0dXMuQ3VycmVudGx5SW5FbnRyeRJXCgp2ZXJpZmllZEluGAEgAygLMkMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRTdGF0dXMuVmVyaWZpZWRJbkVudHJ5ElkKC2FwcHJvdmVkRm9yGAIgAygLMkQuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRTdGF0dXMuQXBwcm92ZWRGb3JFbnRyeRJTCghtZXRhZGF0YRgEIAMoCzJBLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0U3RhdHVzLk1ldGFkYXRhRW50cnkaZgoQQ3VycmVudGx5SW5FbnRyeRILCgNrZXkYASABKAkSQQoFdmFsdWUYAiABKAsyMi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQ3VycmVudFN0YWdlOgI4ARpmCg9WZXJpZmllZEluRW50cnkSCwoDa2V5GAEgASgJEkIKBXZhbHVlGAIgASgLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlZlcmlmaWVkU3RhZ2U6AjgBGmcKEEFwcHJvdmVkRm9yRW50cnkSCwoDa2V5GAEgASgJEkIKBXZhbHVlGAIgASgLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkFwcHJvdmVkU3RhZ2U6AjgBGm8KDU1ldGFkYXRhRW50cnkSCwoDa2V5GAEgASgJEk0KBXZhbHVlGAIgASgLMj4uazhzLmlvLmFwaWV4dGVuc2lvbnNfYXBpc2VydmVyLnBrZy5hcGlzLmFwaWV4dGVuc2lvbnMudjEuSlNPTjoCOAEieQoJR2l0Q29tbWl0Eg8KB3JlcG9VUkwYASABKAkSCgoCaWQYAiABKAkSDgoGYnJhbmNoGAMgASgJEgsKA3RhZxgEIAEoCRIPCgdtZXNzYWdlGAYgASgJEg4KBmF1dGhvchgHIAEoCRIRCgljb21taXR0ZXIYCCABKAkibgoSR2l0RGlzY292ZXJ5UmVzdWx0Eg8KB3JlcG9VUkwYASABKAkSRwoHY29tbWl0cxgCIAMoCzI2LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5EaXNjb3ZlcmVkQ29tbWl0IloKG0dpdEh1YldlYmhvb2tSZWNlaXZlckNvbmZpZxI7CglzZWNyZXRSZWYYASABKAsyKC5rOHMuaW8uYXBpLmNvcmUudjEuTG9jYWxPYmplY3RSZWZlcmVuY2UiWgobR2l0TGFiV2ViaG9va1JlY2VpdmVyQ29uZmlnEjsKCXNlY3JldFJlZhgBIAEoCzIoLms4cy5pby5hcGkuY29yZS52MS5Mb2NhbE9iamVjdFJlZmVyZW5jZSLdAgoPR2l0U3Vic2NyaXB0aW9uEg8KB3JlcG9VUkwYASABKAkSHwoXY29tbWl0U2VsZWN0aW9uU3RyYXRlZ3kYAiABKAkSDgoGYnJhbmNoGAMgASgJEhUKDXN0cmljdFNlbXZlcnMYCyABKAgSGAoQc2VtdmVyQ29uc3RyYWludBgEIAEoCRIRCglhbGxvd1RhZ3MYBSABKAkSGAoQYWxsb3dUYWdzUmVnZXhlcxgNIAMoCRISCgppZ25vcmVUYWdzGAYgAygJEhkKEWlnbm9yZVRhZ3NSZWdleGVzGA4gAygJEhgKEGV4cHJlc3Npb25GaWx0ZXIYDCABKAkSHQoVaW5zZWN1cmVTa2lwVExTVmVyaWZ5GAcgASgIEhQKDGluY2x1ZGVQYXRocxgIIAMoCRIUCgxleGNsdWRlUGF0aHMYCSADKAkSFgoOZGlzY292ZXJ5TGltaXQYCiABKAUiWQoaR2l0ZWFXZWJob29rUmVjZWl2ZXJDb25maWcSOwoJc2VjcmV0UmVmGAEgASgLMiguazhzLmlvLmFwaS5jb3JlLnYxLkxvY2FsT2JqZWN0UmVmZXJlbmNlIloKG0hhcmJvcldlYmhvb2tSZWNlaXZlckNvbmZpZxI7CglzZWNyZXRSZWYYASABKAsyKC5rOHMuaW8uYXBpLmNvcmUudjEuTG9jYWxPYmplY3RSZWZlcmVuY2UiyAEKBkhlYWx0aBIOCgZzdGF0dXMYASABKAkSDgoGaXNzdWVzGAIgAygJEk4KBmNvbmZpZxgEIAEoCzI+Lms4cy5pby5hcGlleHRlbnNpb25zX2FwaXNlcnZlci5wa2cuYXBpcy5hcGlleHRlbnNpb25zLnYxLkpTT04STgoGb3V0cHV0GAUgASgLMj4uazhzLmlvLmFwaWV4dGVuc2lvbnNfYXBpc2VydmVyLnBrZy5hcGlzLmFwaWV4dGVuc2lvbnMudjEuSlNPTiJvCg9IZWFsdGhDaGVja1N0ZXASDAoEdXNlcxgBIAEoCRJOCgZjb25maWcYAiABKAsyPi5rOHMuaW8uYXBpZXh0ZW5zaW9uc19hcGlzZXJ2ZXIucGtnLmFwaXMuYXBpZXh0ZW5zaW9ucy52MS5KU09OIh4KC0hlYWx0aFN0YXRzEg8KB2hlYWx0aHkYASABKAMivAEKBUltYWdlEg8KB3JlcG9VUkwYASABKAkSCwoDdGFnGAMgASgJEg4KBmRpZ2VzdBgEIAEoCRJRCgthbm5vdGF0aW9ucxgFIAMoCzI8LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5JbWFnZS5Bbm5vdGF0aW9uc0VudHJ5GjIKEEFubm90YXRpb25zRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASKNAQoUSW1hZ2VEaXNjb3ZlcnlSZXN1bHQSDwoHcmVwb1VSTBgBIAEoCRIQCghwbGF0Zm9ybRgCIAEoCRJSCgpyZWZlcmVuY2VzGAMgAygLMj4uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkRpc2NvdmVyZWRJbWFnZVJlZmVyZW5jZSKUAgoRSW1hZ2VTdWJzY3JpcHRpb24SDwoHcmVwb1VSTBgBIAEoCRIeChZpbWFnZVNlbGVjdGlvblN0cmF0ZWd5GAMgASgJEhUKDXN0cmljdFNlbXZlcnMYCiABKAgSEgoKY29uc3RyYWludBgLIAEoCRIRCglhbGxvd1RhZ3MYBSABKAkSGAoQYWxsb3dUYWdzUmVnZXhlcxgNIAMoCRISCgppZ25vcmVUYWdzGAYgAygJEhkKEWlnbm9yZVRhZ3NSZWdleGVzGA4gAygJEhAKCHBsYXRmb3JtGAcgASgJEh0KFWluc2VjdXJlU2tpcFRMU1ZlcmlmeRgIIAEoCBIWCg5kaXNjb3ZlcnlMaW1pdBgJIAEoBSKSAQoHUHJvamVjdBJCCghtZXRhZGF0YRgBIAEoCzIwLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5PYmplY3RNZXRhEkMKBnN0YXR1cxgDIAEoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9qZWN0U3RhdHVzIuUBCg1Qcm9qZWN0Q29uZmlnEkIKCG1ldGFkYXRhGAEgASgLMjAuazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLk9iamVjdE1ldGESRQoEc3BlYxgCIAEoCzI3LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9qZWN0Q29uZmlnU3BlYxJJCgZzdGF0dXMYAyABKAsyOS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvamVjdENvbmZpZ1N0YXR1cyKZAQoRUHJvamVjdENvbmZpZ0xpc3QSQAoIbWV0YWRhdGEYASABKAsyLi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuTGlzdE1ldGESQgoFaXRlbXMYAiADKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvamVjdENvbmZpZyLiAgoRUHJvamVjdENvbmZpZ1NwZWMSUAoRcHJvbW90aW9uUG9saWNpZXMYASADKAsyNS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uUG9saWN5ElUKEHdlYmhvb2tSZWNlaXZlcnMYAiADKAsyOy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuV2ViaG9va1JlY2VpdmVyQ29uZmlnElEKDnByb21vdGlvbldhdmVzGAMgAygLMjkuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvbldhdmVQb2xpY3kSUQoRY29uY3VycmVuY3lHcm91cHMYBCADKAsyNi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQ29uY3VycmVuY3lHcm91cCLqAQoTUHJvamVjdENvbmZpZ1N0YXR1cxJDCgpjb25kaXRpb25zGAEgAygLMi8uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkNvbmRpdGlvbhIaChJvYnNlcnZlZEdlbmVyYXRpb24YAyABKAMSGgoSbGFzdEhhbmRsZWRSZWZyZXNoGAQgASgJElYKEHdlYmhvb2tSZWNlaXZlcnMYAiADKAsyPC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuV2ViaG9va1JlY2VpdmVyRGV0YWlscyKNAQoLUHJvamVjdExpc3QSQAoIbWV0YWRhdGEYASABKAsyLi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuTGlzdE1ldGESPAoFaXRlbXMYAiADKAsyLS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvamVjdCKaAQoMUHJvamVjdFN0YXRzEkgKCndhcmVob3VzZXMYASABKAsyNC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuV2FyZWhvdXNlU3RhdHMSQAoGc3RhZ2VzGAIgASgLMjAuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlN0YWdlU3RhdHMilwEKDVByb2plY3RTdGF0dXMSQwoKY29uZGl0aW9ucxgDIAMoCzIvLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5Db25kaXRpb24SQQoFc3RhdHMYBCABKAsyMi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvamVjdFN0YXRzItkBCglQcm9tb3Rpb24SQgoIbWV0YWRhdGEYASABKAsyMC5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuT2JqZWN0TWV0YRJBCgRzcGVjGAIgASgLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvblNwZWMSRQoGc3RhdHVzGAMgASgLMjUuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvblN0YXR1cyJRChRQcm9tb3Rpb25Db25jdXJyZW5jeRINCgVncm91cBgBIAEoCRIQCghwcmlvcml0eRgCIAEoBRIYChBjYW5jZWxTdXBlcnNlZGVkGAMgASgIIpEBCg1Qcm9tb3Rpb25MaXN0EkAKCG1ldGFkYXRhGAEgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkxpc3RNZXRhEj4KBWl0ZW1zGAIgAygLMi8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvbiKUAQoPUHJvbW90aW9uUG9saWN5Eg0KBXN0YWdlGAEgASgJElQKDXN0YWdlU2VsZWN0b3IYAyABKAsyPS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uUG9saWN5U2VsZWN0b3ISHAoUYXV0b1Byb21vdGlvbkVuYWJsZWQYAiABKAgicwoXUHJvbW90aW9uUG9saWN5U2VsZWN0b3ISDAoEbmFtZRgBIAEoCRJKCg1sYWJlbFNlbGVjdG9yGAIgASgLMjMuazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkxhYmVsU2VsZWN0b3Ii8gEKElByb21vdGlvblJlZmVyZW5jZRIMCgRuYW1lGAEgASgJEkcKB2ZyZWlnaHQYAiABKAsyNi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodFJlZmVyZW5jZRJFCgZzdGF0dXMYAyABKAsyNS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uU3RhdHVzEj4KCmZpbmlzaGVkQXQYBCABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZSIyCg9Qcm9tb3Rpb25SZXN1bWUSEQoJcHJvbW90aW9uGAEgASgJEgwKBHN0ZXAYAiABKAMi1wIKDVByb21vdGlvblNwZWMSDQoFc3RhZ2UYASABKAkSDwoHZnJlaWdodBgCIAEoCRJGCgR2YXJzGAQgAygLMjguZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkV4cHJlc3Npb25WYXJpYWJsZRJCCgVzdGVwcxgDIAMoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25TdGVwEk8KC2NvbmN1cnJlbmN5GAUgASgLMjouZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvbkNvbmN1cnJlbmN5EkkKCnJlc3VtZUZyb20YBiABKAsyNS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uUmVzdW1lItoFCg9Qcm9tb3Rpb25TdGF0dXMSGgoSbGFzdEhhbmRsZWRSZWZyZXNoGAQgASgJEg0KBXBoYXNlGAEgASgJEg8KB21lc3NhZ2UYAiABKAkSRwoHZnJlaWdodBgFIAEoCzI2LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0UmVmZXJlbmNlElIKEWZyZWlnaHRDb2xsZWN0aW9uGAcgASgLMjcuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRDb2xsZWN0aW9uEksKDGhlYWx0aENoZWNrcxgIIAMoCzI1LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5IZWFsdGhDaGVja1N0ZXASPQoJc3RhcnRlZEF0GAwgASgLMiouazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLlRpbWUSFQoNcXVldWVQb3NpdGlvbhgNIAEoBRI+CgpmaW5pc2hlZEF0GAYgASgLMiouazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLlRpbWUSEwoLY3VycmVudFN0ZXAYCSABKAMSWgoVc3RlcEV4ZWN1dGlvbk1ldGFkYXRhGAsgAygLMjsuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlN0ZXBFeGVjdXRpb25NZXRhZGF0YRJNCgVzdGF0ZRgKIAEoCzI+Lms4cy5pby5hcGlleHRlbnNpb25zX2FwaXNlcnZlci5wa2cuYXBpcy5hcGlleHRlbnNpb25zLnYxLkpTT04SSwoJd29ya3NwYWNlGA4gASgLMjguZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvbldvcmtzcGFjZSL7AgoNUHJvbW90aW9uU3RlcBIMCgR1c2VzGAEgASgJEkoKBHRhc2sYBSABKAsyPC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uVGFza1JlZmVyZW5jZRIKCgJhcxgCIAEoCRIKCgJpZhgHIAEoCRIXCg9jb250aW51ZU9uRXJyb3IYCCABKAgSRwoFcmV0cnkYBCABKAsyOC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uU3RlcFJldHJ5EkYKBHZhcnMYBiADKAsyOC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRXhwcmVzc2lvblZhcmlhYmxlEk4KBmNvbmZpZxgDIAEoCzI+Lms4cy5pby5hcGlleHRlbnNpb25zX2FwaXNlcnZlci5wa2cuYXBpcy5hcGlleHRlbnNpb25zLnYxLkpTT04ibQoSUHJvbW90aW9uU3RlcFJldHJ5Ej8KB3RpbWVvdXQYASABKAsyLi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuRHVyYXRpb24SFgoOZXJyb3JUaHJlc2hvbGQYAiABKA0imgEKDVByb21vdGlvblRhc2sSQgoIbWV0YWRhdGEYASABKAsyMC5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuT2JqZWN0TWV0YRJFCgRzcGVjGAIgASgLMjcuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvblRhc2tTcGVjIpkBChFQcm9tb3Rpb25UYXNrTGlzdBJACghtZXRhZGF0YRgBIAEoCzIuLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5MaXN0TWV0YRJCCgVpdGVtcxgCIAMoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25UYXNrIjQKFlByb21vdGlvblRhc2tSZWZlcmVuY2USDAoEbmFtZRgBIAEoCRIMCgRraW5kGAIgASgJIp8BChFQcm9tb3Rpb25UYXNrU3BlYxJGCgR2YXJzGAEgAygLMjguZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkV4cHJlc3Npb25WYXJpYWJsZRJCCgVzdGVwcxgCIAMoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25TdGVwIl4KEVByb21vdGlvblRlbXBsYXRlEkkKBHNwZWMYASABKAsyOy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uVGVtcGxhdGVTcGVjIvQBChVQcm9tb3Rpb25UZW1wbGF0ZVNwZWMSRgoEdmFycxgCIAMoCzI4LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5FeHByZXNzaW9uVmFyaWFibGUSQgoFc3RlcHMYASADKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uU3RlcBJPCgtjb25jdXJyZW5jeRgDIAEoCzI6LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25Db25jdXJyZW5jeSLgAQoNUHJvbW90aW9uV2F2ZRIMCgRuYW1lGAEgASgJElQKDXN0YWdlU2VsZWN0b3IYAiABKAsyPS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uUG9saWN5U2VsZWN0b3ISDwoHcGVyY2VudBgDIAEoBRJACghzb2FrVGltZRgEIAEoCzIuLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5EdXJhdGlvbhIYChBmYWlsdXJlVGhyZXNob2xkGAUgASgFIr0BChNQcm9tb3Rpb25XYXZlUG9saWN5EgwKBG5hbWUYASABKAkSVAoNc3RhZ2VTZWxlY3RvchgCIAEoCzI9LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25Qb2xpY3lTZWxlY3RvchJCCgV3YXZlcxgDIAMoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25XYXZlIpIBChVQcm9tb3Rpb25XYXZlUHJvZ3Jlc3MSDgoGcG9saWN5GAEgASgJEg8KB2ZyZWlnaHQYAiABKAkSDgoGaGFsdGVkGAMgASgIEkgKBXdhdmVzGAQgAygLMjkuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvbldhdmVTdGF0dXMicAoTUHJvbW90aW9uV2F2ZVN0YXR1cxIMCgRuYW1lGAEgASgJEg0KBXBoYXNlGAIgASgJEg4KBnN0YWdlcxgDIAMoCRIWCg52ZXJpZmllZFN0YWdlcxgEIAMoCRIUCgxmYWlsZWRTdGFnZXMYBSADKAkiaQoSUHJvbW90aW9uV29ya3NwYWNlEkEKCWRpc2tVc2FnZRgBIAEoCzIuLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaS5yZXNvdXJjZS5RdWFudGl0eRIQCghyZXRhaW5lZBgCIAEoCCJYChlRdWF5V2ViaG9va1JlY2VpdmVyQ29uZmlnEjsKCXNlY3JldFJlZhgBIAEoCzIoLms4cy5pby5hcGkuY29yZS52MS5Mb2NhbE9iamVjdFJlZmVyZW5jZSLmAQoQUmVwb1N1YnNjcmlwdGlvbhJCCgNnaXQYASABKAsyNS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuR2l0U3Vic2NyaXB0aW9uEkYKBWltYWdlGAIgASgLMjcuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkltYWdlU3Vic2NyaXB0aW9uEkYKBWNoYXJ0GAMgASgLMjcuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkNoYXJ0U3Vic2NyaXB0aW9uIs0BCgVTdGFnZRJCCghtZXRhZGF0YRgBIAEoCzIwLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5PYmplY3RNZXRhEj0KBHNwZWMYAiABKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuU3RhZ2VTcGVjEkEKBnN0YXR1cxgDIAEoCzIxLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5TdGFnZVN0YXR1cyKJAQoJU3RhZ2VMaXN0EkAKCG1ldGFkYXRhGAEgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkxpc3RNZXRhEjoKBWl0ZW1zGAIgAygLMisuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlN0YWdlIt8CCglTdGFnZVNwZWMSDQoFc2hhcmQYBCABKAkSDQoFYWdlbnQYCCABKAkSRgoEdmFycxgHIAMoCzI4LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5FeHByZXNzaW9uVmFyaWFibGUSTgoQcmVxdWVzdGVkRnJlaWdodBgFIAMoCzI0LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0UmVxdWVzdBJSChFwcm9tb3Rpb25UZW1wbGF0ZRgGIAEoCzI3LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25UZW1wbGF0ZRJICgx2ZXJpZmljYXRpb24YAyABKAsyMi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuVmVyaWZpY2F0aW9uIl4KClN0YWdlU3RhdHMSDQoFY291bnQYAiABKAMSQQoGaGVhbHRoGAEgASgLMjEuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkhlYWx0aFN0YXRzIrgFCgtTdGFnZVN0YXR1cxJDCgpjb25kaXRpb25zGA0gAygLMi8uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkNvbmRpdGlvbhIaChJsYXN0SGFuZGxlZFJlZnJlc2gYCyABKAkSTwoOZnJlaWdodEhpc3RvcnkYBCADKAsyNy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodENvbGxlY3Rpb24SFgoOZnJlaWdodFN1bW1hcnkYDCABKAkSPAoGaGVhbHRoGAggASgLMiwuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkhlYWx0aBIaChJvYnNlcnZlZEdlbmVyYXRpb24YBiABKAMSUgoQY3VycmVudFByb21vdGlvbhgHIAEoCzI4LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25SZWZlcmVuY2USTwoNbGFzdFByb21vdGlvbhgKIAEoCzI4LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25SZWZlcmVuY2USHAoUYXV0b1Byb21vdGlvbkVuYWJsZWQYDiABKAgSUQoIbWV0YWRhdGEYDyADKAsyPy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuU3RhZ2VTdGF0dXMuTWV0YWRhdGFFbnRyeRpvCg1NZXRhZGF0YUVudHJ5EgsKA2tleRgBIAEoCRJNCgV2YWx1ZRgCIAEoCzI+Lms4cy5pby5hcGlleHRlbnNpb25zX2FwaXNlcnZlci5wa2cuYXBpcy5hcGlleHRlbnNpb25zLnYxLkpTT046AjgBIvMBChVTdGVwRXhlY3V0aW9uTWV0YWRhdGESDQoFYWxpYXMYASABKAkSPQoJc3RhcnRlZEF0GAIgASgLMiouazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLlRpbWUSPgoKZmluaXNoZWRBdBgDIAEoCzIqLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5UaW1lEhIKCmVycm9yQ291bnQYBCABKA0SDgoGc3RhdHVzGAUgASgJEg8KB21lc3NhZ2UYBiABKAkSFwoPY29udGludWVPbkVycm9yGAcgASgIIs8CCgxWZXJpZmljYXRpb24SWgoRYW5hbHlzaXNUZW1wbGF0ZXMYASADKAsyPy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQW5hbHlzaXNUZW1wbGF0ZVJlZmVyZW5jZRJWChNhbmFseXNpc1J1bk1ldGFkYXRhGAIgASgLMjkuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkFuYWx5c2lzUnVuTWV0YWRhdGESRwoEYXJncxgDIAMoCzI5LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5BbmFseXNpc1J1bkFyZ3VtZW50EkIKBXN0ZXBzGAQgAygLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvblN0ZXAi3QMKEFZlcmlmaWNhdGlvbkluZm8SCgoCaWQYBCABKAkSDQoFYWN0b3IYByABKAkSPQoJc3RhcnRUaW1lGAUgASgLMiouazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLlRpbWUSDQoFcGhhc2UYASABKAkSDwoHbWVzc2FnZRgCIAEoCRJPCgthbmFseXNpc1J1bhgDIAEoCzI6LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5BbmFseXNpc1J1blJlZmVyZW5jZRI+CgpmaW5pc2hUaW1lGAYgASgLMiouazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLlRpbWUSEwoLY3VycmVudFN0ZXAYCCABKAMSWgoVc3RlcEV4ZWN1dGlvbk1ldGFkYXRhGAkgAygLMjsuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlN0ZXBFeGVjdXRpb25NZXRhZGF0YRJNCgVzdGF0ZRgKIAEoCzI+Lms4cy5pby5hcGlleHRlbnNpb25zX2FwaXNlcnZlci5wa2cuYXBpcy5hcGlleHRlbnNpb25zLnYxLkpTT04ilAEKDVZlcmlmaWVkU3RhZ2USPgoKdmVyaWZpZWRBdBgBIAEoCzIqLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5UaW1lEkMKC2xvbmdlc3RTb2FrGAIgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkR1cmF0aW9uItkBCglXYXJlaG91c2USQgoIbWV0YWRhdGEYASABKAsyMC5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuT2JqZWN0TWV0YRJBCgRzcGVjGAIgASgLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLldhcmVob3VzZVNwZWMSRQoGc3RhdHVzGAMgASgLMjUuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLldhcmVob3VzZVN0YXR1cyKRAQoNV2FyZWhvdXNlTGlzdBJACghtZXRhZGF0YRgBIAEoCzIuLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5MaXN0TWV0YRI+CgVpdGVtcxgCIAMoCzIvLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5XYXJlaG91c2UijAMKDVdhcmVob3VzZVNwZWMSDQoFc2hhcmQYAiABKAkSQAoIaW50ZXJ2YWwYBCABKAsyLi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuRHVyYXRpb24SHQoVZnJlaWdodENyZWF0aW9uUG9saWN5GAMgASgJEk0KDXN1YnNjcmlwdGlvbnMYASADKAsyNi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUmVwb1N1YnNjcmlwdGlvbhJeChdmcmVpZ2h0Q3JlYXRpb25Dcml0ZXJpYRgFIAEoCzI9LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0Q3JlYXRpb25Dcml0ZXJpYRJcChZmcmVpZ2h0U3RhbGVuZXNzUG9saWN5GAYgASgLMjwuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRTdGFsZW5lc3NQb2xpY3kiYgoOV2FyZWhvdXNlU3RhdHMSDQoFY291bnQYAiABKAMSQQoGaGVhbHRoGAEgASgLMjEuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkhlYWx0aFN0YXRzIv0BCg9XYXJlaG91c2VTdGF0dXMSQwoKY29uZGl0aW9ucxgJIAMoCzIvLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5Db25kaXRpb24SGgoSbGFzdEhhbmRsZWRSZWZyZXNoGAYgASgJEhoKEm9ic2VydmVkR2VuZXJhdGlvbhgEIAEoAxIVCg1sYXN0RnJlaWdodElEGAggASgJElYKE2Rpc2NvdmVyZWRBcnRpZmFjdHMYByABKAsyOS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRGlzY292ZXJlZEFydGlmYWN0cyKeBgoVV2ViaG9va1JlY2VpdmVyQ29uZmlnEgwKBG5hbWUYASABKAkSVwoJYml0YnVja2V0GAUgASgLMkQuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkJpdGJ1Y2tldFdlYmhvb2tSZWNlaXZlckNvbmZpZxJXCglkb2NrZXJodWIYBiABKAsyRC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRG9ja2VySHViV2ViaG9va1JlY2VpdmVyQ29uZmlnElEKBmdpdGh1YhgCIAEoCzJBLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5HaXRIdWJXZWJob29rUmVjZWl2ZXJDb25maWcSUQoGZ2l0bGFiGAMgASgLMkEuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkdpdExhYldlYmhvb2tSZWNlaXZlckNvbmZpZxJRCgZoYXJib3IYCiABKAsyQS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuSGFyYm9yV2ViaG9va1JlY2VpdmVyQ29uZmlnEk0KBHF1YXkYBCABKAsyPy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUXVheVdlYmhvb2tSZWNlaXZlckNvbmZpZxJbCgthcnRpZmFjdG9yeRgJIAEoCzJGLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5BcnRpZmFjdG9yeVdlYmhvb2tSZWNlaXZlckNvbmZpZxJPCgVhenVyZRgIIAEoCzJALmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5BenVyZVdlYmhvb2tSZWNlaXZlckNvbmZpZxJPCgVnaXRlYRgHIAEoCzJALmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5HaXRlYVdlYmhvb2tSZWNlaXZlckNvbmZpZyJBChZXZWJob29rUmVjZWl2ZXJEZXRhaWxzEgwKBG5hbWUYASABKAkSDAoEcGF0aBgDIAEoCRILCgN1cmwYBCABKAlClwIKKGNvbS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTFCDkdlbmVyYXRlZFByb3RvUAFaJGdpdGh1Yi5jb20vYWt1aXR5L2thcmdvL2FwaS92MWFscGhhMaICBUdDQUtBqgIkR2l0aHViLkNvbS5Ba3VpdHkuS2FyZ28uQXBpLlYxYWxwaGExygIkR2l0aHViXENvbVxBa3VpdHlcS2FyZ29cQXBpXFYxYWxwaGEx4gIwR2l0aHViXENvbVxBa3VpdHlcS2FyZ29cQXBpXFYxYWxwaGExXEdQQk1ldGFkYXRh6gIpR2l0aHViOjpDb206OkFrdWl0eTo6S2FyZ286OkFwaTo6VjFhbHBoYTE", [file_k8s_io_api_core_v1_generated, file_k8s_io_apiextensions_apiserver_pkg_apis_apiextensions_v1_generated, file_k8s_io_apimachinery_pkg_api_resource_generated, file_k8s_io_apimachinery_pkg_apis_meta_v1_generated, file_k8s_io_apimachinery_pkg_runtime_generated, file_k8s_io_apimachinery_pkg_runtime_schema_generated]);
+
+/**
+ * AgentConfig describes the Projects an agent serves.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.AgentConfig
+ */
+export type AgentConfig = Message<"github.com.akuity.kargo.api.v1alpha1.AgentConfig"> & {
+  /**
+   * Name is the name of the agent.
+   *
+   * +kubebuilder:validation:Required
+   * +kubebuilder:validation:MinLength=1
+   *
+   * @generated from field: optional string name = 1;
+   */
+  name: string;
+
+  /**
+   * Projects is the list of Projects the agent serves. Stages in other
+   * Projects may not specify the agent and steps of Promotions in other
+   * Projects are never dispatched to it.
+   *
+   * +kubebuilder:validation:MinItems=1
+   *
+   * @generated from field: repeated string projects = 2;
+   */
+  projects: string[];
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.AgentConfig.
+ * Use `create(AgentConfigSchema)` to create a new message.
+ */
+export const AgentConfigSchema: GenMessage<AgentConfig> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 0);
 
 /**
  * AnalysisRunArgument represents an argument to be added to an AnalysisRun.
@@ -52,7 +89,7 @@ export type AnalysisRunArgument = Message<"github.com.akuity.kargo.api.v1alpha1.
  * Use `create(AnalysisRunArgumentSchema)` to create a new message.
  */
 export const AnalysisRunArgumentSchema: GenMessage<AnalysisRunArgument> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 0);
+  messageDesc(file_api_v1alpha1_generated, 1);
 
 /**
  * AnalysisRunMetadata contains optional metadata that should be applied to all
@@ -81,7 +118,7 @@ export type AnalysisRunMetadata = Message<"github.com.akuity.kargo.api.v1alpha1.
  * Use `create(AnalysisRunMetadataSchema)` to create a new message.
  */
 export const AnalysisRunMetadataSchema: GenMessage<AnalysisRunMetadata> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 1);
+  messageDesc(file_api_v1alpha1_generated, 2);
 
 /**
  * AnalysisRunReference is a reference to an AnalysisRun.
@@ -116,7 +153,7 @@ export type AnalysisRunReference = Message<"github.com.akuity.kargo.api.v1alpha1
  * Use `create(AnalysisRunReferenceSchema)` to create a new message.
  */
 export const AnalysisRunReferenceSchema: GenMessage<AnalysisRunReference> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 2);
+  messageDesc(file_api_v1alpha1_generated, 3);
 
 /**
  * AnalysisTemplateReference is a reference to an AnalysisTemplate.
@@ -151,7 +188,7 @@ export type AnalysisTemplateReference = Message<"github.com.akuity.kargo.api.v1a
  * Use `create(AnalysisTemplateReferenceSchema)` to create a new message.
  */
 export const AnalysisTemplateReferenceSchema: GenMessage<AnalysisTemplateReference> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 3);
+  messageDesc(file_api_v1alpha1_generated, 4);
 
 /**
  * ApprovedStage describes a Stage for which Freight has been (manually)
@@ -166,6 +203,16 @@ export type ApprovedStage = Message<"github.com.akuity.kargo.api.v1alpha1.Approv
    * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Time approvedAt = 1;
    */
   approvedAt?: Time;
+
+  /**
+   * AllowStale indicates whether the Freight may be promoted to the Stage even
+   * if it is stale according to the staleness policy that applies to it. This
+   * is only the case if it was explicitly requested when the Freight was
+   * approved.
+   *
+   * @generated from field: optional bool allowStale = 2;
+   */
+  allowStale: boolean;
 };
 
 /**
@@ -173,7 +220,7 @@ export type ApprovedStage = Message<"github.com.akuity.kargo.api.v1alpha1.Approv
  * Use `create(ApprovedStageSchema)` to create a new message.
  */
 export const ApprovedStageSchema: GenMessage<ApprovedStage> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 4);
+  messageDesc(file_api_v1alpha1_generated, 5);
 
 /**
  * ArgoCDAppHealthStatus describes the health of an ArgoCD Application.
@@ -197,7 +244,7 @@ export type ArgoCDAppHealthStatus = Message<"github.com.akuity.kargo.api.v1alpha
  * Use `create(ArgoCDAppHealthStatusSchema)` to create a new message.
  */
 export const ArgoCDAppHealthStatusSchema: GenMessage<ArgoCDAppHealthStatus> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 5);
+  messageDesc(file_api_v1alpha1_generated, 6);
 
 /**
  * ArgoCDAppStatus describes the current state of a single ArgoCD Application.
@@ -239,7 +286,7 @@ export type ArgoCDAppStatus = Message<"github.com.akuity.kargo.api.v1alpha1.Argo
  * Use `create(ArgoCDAppStatusSchema)` to create a new message.
  */
 export const ArgoCDAppStatusSchema: GenMessage<ArgoCDAppStatus> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 6);
+  messageDesc(file_api_v1alpha1_generated, 7);
 
 /**
  * ArgoCDAppSyncStatus describes the sync status of an ArgoCD Application.
@@ -268,7 +315,7 @@ export type ArgoCDAppSyncStatus = Message<"github.com.akuity.kargo.api.v1alpha1.
  * Use `create(ArgoCDAppSyncStatusSchema)` to create a new message.
  */
 export const ArgoCDAppSyncStatusSchema: GenMessage<ArgoCDAppSyncStatus> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 7);
+  messageDesc(file_api_v1alpha1_generated, 8);
 
 /**
  * ArtifactoryWebhookReceiverConfig describes a webhook receiver that is
@@ -335,7 +382,7 @@ export type ArtifactoryWebhookReceiverConfig = Message<"github.com.akuity.kargo.
  * Use `create(ArtifactoryWebhookReceiverConfigSchema)` to create a new message.
  */
 export const ArtifactoryWebhookReceiverConfigSchema: GenMessage<ArtifactoryWebhookReceiverConfig> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 8);
+  messageDesc(file_api_v1alpha1_generated, 9);
 
 /**
  * AutoPromotionOptions specifies options pertaining to auto-promotion.
@@ -361,6 +408,31 @@ export type AutoPromotionOptions = Message<"github.com.akuity.kargo.api.v1alpha1
    * @generated from field: optional string selectionPolicy = 1;
    */
   selectionPolicy: string;
+
+  /**
+   * Condition is an optional expr-lang expression that Freight must satisfy
+   * to be auto-promoted to this Stage. Freight that does not satisfy it is
+   * skipped and remains available for manual promotion. With the
+   * "NewestFreight" selection policy, the newest Freight that is newer than
+   * the Stage's current Freight and satisfies the condition is auto-promoted.
+   *
+   * The expression is evaluated separately for each candidate Freight. The
+   * commitFrom(), imageFrom(), and chartFrom() functions resolve artifacts
+   * from the candidate Freight, while the functions of the same names on the
+   * `current` object (e.g. current.imageFrom()) resolve artifacts from the
+   * Stage's current Freight and return nil if the Stage has none. The
+   * semverDiff() function, the `freight` object (with `name` and `alias`
+   * fields) and the `ctx` object (with `project` and `stage` fields) are also
+   * available. For example, the following condition permits only patch
+   * releases of an image to be auto-promoted:
+   *
+   *   semverDiff(current.imageFrom("example/app")?.Tag ?? "", imageFrom("example/app").Tag) == "Patch"
+   *
+   * +optional
+   *
+   * @generated from field: optional string condition = 2;
+   */
+  condition: string;
 };
 
 /**
@@ -368,7 +440,7 @@ export type AutoPromotionOptions = Message<"github.com.akuity.kargo.api.v1alpha1
  * Use `create(AutoPromotionOptionsSchema)` to create a new message.
  */
 export const AutoPromotionOptionsSchema: GenMessage<AutoPromotionOptions> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 9);
+  messageDesc(file_api_v1alpha1_generated, 10);
 
 /**
  * AzureWebhookReceiverConfig describes a webhook receiver that is compatible
@@ -409,7 +481,7 @@ export type AzureWebhookReceiverConfig = Message<"github.com.akuity.kargo.api.v1
  * Use `create(AzureWebhookReceiverConfigSchema)` to create a new message.
  */
 export const AzureWebhookReceiverConfigSchema: GenMessage<AzureWebhookReceiverConfig> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 10);
+  messageDesc(file_api_v1alpha1_generated, 11);
 
 /**
  * BitbucketWebhookReceiverConfig describes a webhook receiver that is
@@ -444,7 +516,7 @@ export type BitbucketWebhookReceiverConfig = Message<"github.com.akuity.kargo.ap
  * Use `create(BitbucketWebhookReceiverConfigSchema)` to create a new message.
  */
 export const BitbucketWebhookReceiverConfigSchema: GenMessage<BitbucketWebhookReceiverConfig> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 11);
+  messageDesc(file_api_v1alpha1_generated, 12);
 
 /**
  * Chart describes a specific version of a Helm chart.
@@ -484,7 +556,7 @@ export type Chart = Message<"github.com.akuity.kargo.api.v1alpha1.Chart"> & {
  * Use `create(ChartSchema)` to create a new message.
  */
 export const ChartSchema: GenMessage<Chart> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 12);
+  messageDesc(file_api_v1alpha1_generated, 13);
 
 /**
  * ChartDiscoveryResult represents the result of a chart discovery operation for
@@ -537,7 +609,7 @@ export type ChartDiscoveryResult = Message<"github.com.akuity.kargo.api.v1alpha1
  * Use `create(ChartDiscoveryResultSchema)` to create a new message.
  */
 export const ChartDiscoveryResultSchema: GenMessage<ChartDiscoveryResult> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 13);
+  messageDesc(file_api_v1alpha1_generated, 14);
 
 /**
  * ChartSubscription defines a subscription to a Helm chart repository.
@@ -608,7 +680,7 @@ export type ChartSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.Ch
  * Use `create(ChartSubscriptionSchema)` to create a new message.
  */
 export const ChartSubscriptionSchema: GenMessage<ChartSubscription> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 14);
+  messageDesc(file_api_v1alpha1_generated, 15);
 
 /**
  * ClusterConfig is a resource type that describes cluster-level Kargo
@@ -642,7 +714,7 @@ export type ClusterConfig = Message<"github.com.akuity.kargo.api.v1alpha1.Cluste
  * Use `create(ClusterConfigSchema)` to create a new message.
  */
 export const ClusterConfigSchema: GenMessage<ClusterConfig> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 15);
+  messageDesc(file_api_v1alpha1_generated, 16);
 
 /**
  * ClusterConfigList contains a list of ClusterConfigs.
@@ -666,7 +738,7 @@ export type ClusterConfigList = Message<"github.com.akuity.kargo.api.v1alpha1.Cl
  * Use `create(ClusterConfigListSchema)` to create a new message.
  */
 export const ClusterConfigListSchema: GenMessage<ClusterConfigList> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 16);
+  messageDesc(file_api_v1alpha1_generated, 17);
 
 /**
  * ClusterConfigSpec describes cluster-level Kargo configuration.
@@ -681,6 +753,18 @@ export type ClusterConfigSpec = Message<"github.com.akuity.kargo.api.v1alpha1.Cl
    * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.WebhookReceiverConfig webhookReceivers = 1;
    */
   webhookReceivers: WebhookReceiverConfig[];
+
+  /**
+   * Agents describes the agents that execute the steps of Promotions
+   * out-of-process and the Projects each of them serves. A Stage may only
+   * specify an agent that serves the Stage's Project.
+   *
+   * +listType=map
+   * +listMapKey=name
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.AgentConfig agents = 2;
+   */
+  agents: AgentConfig[];
 };
 
 /**
@@ -688,7 +772,7 @@ export type ClusterConfigSpec = Message<"github.com.akuity.kargo.api.v1alpha1.Cl
  * Use `create(ClusterConfigSpecSchema)` to create a new message.
  */
 export const ClusterConfigSpecSchema: GenMessage<ClusterConfigSpec> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 17);
+  messageDesc(file_api_v1alpha1_generated, 18);
 
 /**
  * ClusterConfigStatus describes the current status of a ClusterConfig.
@@ -740,7 +824,7 @@ export type ClusterConfigStatus = Message<"github.com.akuity.kargo.api.v1alpha1.
  * Use `create(ClusterConfigStatusSchema)` to create a new message.
  */
 export const ClusterConfigStatusSchema: GenMessage<ClusterConfigStatus> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 18);
+  messageDesc(file_api_v1alpha1_generated, 19);
 
 /**
  * @generated from message github.com.akuity.kargo.api.v1alpha1.ClusterPromotionTask
@@ -767,7 +851,7 @@ export type ClusterPromotionTask = Message<"github.com.akuity.kargo.api.v1alpha1
  * Use `create(ClusterPromotionTaskSchema)` to create a new message.
  */
 export const ClusterPromotionTaskSchema: GenMessage<ClusterPromotionTask> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 19);
+  messageDesc(file_api_v1alpha1_generated, 20);
 
 /**
  * ClusterPromotionTaskList contains a list of PromotionTasks.
@@ -791,7 +875,48 @@ export type ClusterPromotionTaskList = Message<"github.com.akuity.kargo.api.v1al
  * Use `create(ClusterPromotionTaskListSchema)` to create a new message.
  */
 export const ClusterPromotionTaskListSchema: GenMessage<ClusterPromotionTaskList> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 20);
+  messageDesc(file_api_v1alpha1_generated, 21);
+
+/**
+ * ConcurrencyGroup limits the number of Promotions of a group that may be
+ * running at the same time.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.ConcurrencyGroup
+ */
+export type ConcurrencyGroup = Message<"github.com.akuity.kargo.api.v1alpha1.ConcurrencyGroup"> & {
+  /**
+   * Name is the name of the group.
+   *
+   * +kubebuilder:validation:Required
+   * +kubebuilder:validation:MinLength=1
+   * +kubebuilder:validation:MaxLength=253
+   * +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+   * +akuity:test-kubebuilder-pattern=KubernetesName
+   *
+   * @generated from field: optional string name = 1;
+   */
+  name: string;
+
+  /**
+   * Limit is the maximum number of Promotions of the group that may be
+   * running at the same time. Other Promotions of the group are queued until
+   * a running one finishes. Defaults to 1.
+   *
+   * +kubebuilder:validation:Minimum=1
+   * +kubebuilder:default=1
+   * +optional
+   *
+   * @generated from field: optional int32 limit = 2;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.ConcurrencyGroup.
+ * Use `create(ConcurrencyGroupSchema)` to create a new message.
+ */
+export const ConcurrencyGroupSchema: GenMessage<ConcurrencyGroup> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 22);
 
 /**
  * CurrentStage reflects a Stage's current use of Freight.
@@ -814,7 +939,7 @@ export type CurrentStage = Message<"github.com.akuity.kargo.api.v1alpha1.Current
  * Use `create(CurrentStageSchema)` to create a new message.
  */
 export const CurrentStageSchema: GenMessage<CurrentStage> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 21);
+  messageDesc(file_api_v1alpha1_generated, 23);
 
 /**
  * DiscoveredArtifacts holds the artifacts discovered by the Warehouse for its
@@ -868,7 +993,7 @@ export type DiscoveredArtifacts = Message<"github.com.akuity.kargo.api.v1alpha1.
  * Use `create(DiscoveredArtifactsSchema)` to create a new message.
  */
 export const DiscoveredArtifactsSchema: GenMessage<DiscoveredArtifacts> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 22);
+  messageDesc(file_api_v1alpha1_generated, 24);
 
 /**
  * DiscoveredCommit represents a commit discovered by a Warehouse for a
@@ -939,7 +1064,7 @@ export type DiscoveredCommit = Message<"github.com.akuity.kargo.api.v1alpha1.Dis
  * Use `create(DiscoveredCommitSchema)` to create a new message.
  */
 export const DiscoveredCommitSchema: GenMessage<DiscoveredCommit> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 23);
+  messageDesc(file_api_v1alpha1_generated, 25);
 
 /**
  * DiscoveredImageReference represents an image reference discovered by a
@@ -993,7 +1118,7 @@ export type DiscoveredImageReference = Message<"github.com.akuity.kargo.api.v1al
  * Use `create(DiscoveredImageReferenceSchema)` to create a new message.
  */
 export const DiscoveredImageReferenceSchema: GenMessage<DiscoveredImageReference> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 24);
+  messageDesc(file_api_v1alpha1_generated, 26);
 
 /**
  * DockerHubWebhookReceiverConfig describes a webhook receiver that is
@@ -1026,7 +1151,7 @@ export type DockerHubWebhookReceiverConfig = Message<"github.com.akuity.kargo.ap
  * Use `create(DockerHubWebhookReceiverConfigSchema)` to create a new message.
  */
 export const DockerHubWebhookReceiverConfigSchema: GenMessage<DockerHubWebhookReceiverConfig> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 25);
+  messageDesc(file_api_v1alpha1_generated, 27);
 
 /**
  * ExpressionVariable describes a single variable that may be referenced by
@@ -1064,7 +1189,7 @@ export type ExpressionVariable = Message<"github.com.akuity.kargo.api.v1alpha1.E
  * Use `create(ExpressionVariableSchema)` to create a new message.
  */
 export const ExpressionVariableSchema: GenMessage<ExpressionVariable> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 26);
+  messageDesc(file_api_v1alpha1_generated, 28);
 
 /**
  * Freight represents a collection of versioned artifacts.
@@ -1135,7 +1260,7 @@ export type Freight = Message<"github.com.akuity.kargo.api.v1alpha1.Freight"> &
  * Use `create(FreightSchema)` to create a new message.
  */
 export const FreightSchema: GenMessage<Freight> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 27);
+  messageDesc(file_api_v1alpha1_generated, 29);
 
 /**
  * FreightCollection is a collection of FreightReferences, each of which
@@ -1175,7 +1300,7 @@ export type FreightCollection = Message<"github.com.akuity.kargo.api.v1alpha1.Fr
  * Use `create(FreightCollectionSchema)` to create a new message.
  */
 export const FreightCollectionSchema: GenMessage<FreightCollection> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 28);
+  messageDesc(file_api_v1alpha1_generated, 30);
 
 /**
  * FreightCreationCriteria defines criteria that must be satisfied for Freight
@@ -1198,7 +1323,7 @@ export type FreightCreationCriteria = Message<"github.com.akuity.kargo.api.v1alp
  * Use `create(FreightCreationCriteriaSchema)` to create a new message.
  */
 export const FreightCreationCriteriaSchema: GenMessage<FreightCreationCriteria> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 29);
+  messageDesc(file_api_v1alpha1_generated, 31);
 
 /**
  * FreightList is a list of Freight resources.
@@ -1222,7 +1347,7 @@ export type FreightList = Message<"github.com.akuity.kargo.api.v1alpha1.FreightL
  * Use `create(FreightListSchema)` to create a new message.
  */
 export const FreightListSchema: GenMessage<FreightList> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 30);
+  messageDesc(file_api_v1alpha1_generated, 32);
 
 /**
  * FreightOrigin describes a kind of Freight in terms of where it may have
@@ -1259,7 +1384,7 @@ export type FreightOrigin = Message<"github.com.akuity.kargo.api.v1alpha1.Freigh
  * Use `create(FreightOriginSchema)` to create a new message.
  */
 export const FreightOriginSchema: GenMessage<FreightOrigin> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 31);
+  messageDesc(file_api_v1alpha1_generated, 33);
 
 /**
  * FreightReference is a simplified representation of a piece of Freight -- not
@@ -1311,7 +1436,7 @@ export type FreightReference = Message<"github.com.akuity.kargo.api.v1alpha1.Fre
  * Use `create(FreightReferenceSchema)` to create a new message.
  */
 export const FreightReferenceSchema: GenMessage<FreightReference> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 32);
+  messageDesc(file_api_v1alpha1_generated, 34);
 
 /**
  * FreightRequest expresses a Stage's need for Freight having originated from a
@@ -1337,6 +1462,18 @@ export type FreightRequest = Message<"github.com.akuity.kargo.api.v1alpha1.Freig
    * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.FreightSources sources = 2;
    */
   sources?: FreightSources;
+
+  /**
+   * StalenessPolicy describes when the requested Freight becomes stale. Stale
+   * Freight is not available for promotion to this Stage, unless it has been
+   * manually approved for this Stage with stale Freight explicitly allowed.
+   * This is an optional field. If not
+   * specified, the FreightStalenessPolicy of the Warehouse from which the
+   * Freight originated applies.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.FreightStalenessPolicy stalenessPolicy = 3;
+   */
+  stalenessPolicy?: FreightStalenessPolicy;
 };
 
 /**
@@ -1344,7 +1481,7 @@ export type FreightRequest = Message<"github.com.akuity.kargo.api.v1alpha1.Freig
  * Use `create(FreightRequestSchema)` to create a new message.
  */
 export const FreightRequestSchema: GenMessage<FreightRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 33);
+  messageDesc(file_api_v1alpha1_generated, 35);
 
 /**
  * @generated from message github.com.akuity.kargo.api.v1alpha1.FreightSources
@@ -1422,7 +1559,48 @@ export type FreightSources = Message<"github.com.akuity.kargo.api.v1alpha1.Freig
  * Use `create(FreightSourcesSchema)` to create a new message.
  */
 export const FreightSourcesSchema: GenMessage<FreightSources> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 34);
+  messageDesc(file_api_v1alpha1_generated, 36);
+
+/**
+ * FreightStalenessPolicy describes when Freight becomes stale. Stale Freight
+ * is not available for promotion, unless it has been manually approved for the
+ * Stage it is to be promoted to.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.FreightStalenessPolicy
+ */
+export type FreightStalenessPolicy = Message<"github.com.akuity.kargo.api.v1alpha1.FreightStalenessPolicy"> & {
+  /**
+   * MaxAge specifies the maximum age of Freight. Freight created longer ago
+   * than this is stale. This is an optional field. If nil or zero, Freight
+   * does not become stale because of its age.
+   *
+   * +kubebuilder:validation:Type=string
+   * +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(s|m|h))+$`
+   * +akuity:test-kubebuilder-pattern=Duration
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Duration maxAge = 1;
+   */
+  maxAge?: Duration;
+
+  /**
+   * SupersededBy specifies the number of newer pieces of Freight from the
+   * same origin by which Freight must be superseded to be stale. This is an
+   * optional field. If zero, Freight does not become stale because newer
+   * Freight exists.
+   *
+   * +kubebuilder:validation:Minimum=0
+   *
+   * @generated from field: optional int32 supersededBy = 2;
+   */
+  supersededBy: number;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.FreightStalenessPolicy.
+ * Use `create(FreightStalenessPolicySchema)` to create a new message.
+ */
+export const FreightStalenessPolicySchema: GenMessage<FreightStalenessPolicy> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 37);
 
 /**
  * FreightStatus describes a piece of Freight's most recently observed state.
@@ -1470,7 +1648,7 @@ export type FreightStatus = Message<"github.com.akuity.kargo.api.v1alpha1.Freigh
  * Use `create(FreightStatusSchema)` to create a new message.
  */
 export const FreightStatusSchema: GenMessage<FreightStatus> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 35);
+  messageDesc(file_api_v1alpha1_generated, 38);
 
 /**
  * GitCommit describes a specific commit from a specific Git repository.
@@ -1536,7 +1714,7 @@ export type GitCommit = Message<"github.com.akuity.kargo.api.v1alpha1.GitCommit"
  * Use `create(GitCommitSchema)` to create a new message.
  */
 export const GitCommitSchema: GenMessage<GitCommit> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 36);
+  messageDesc(file_api_v1alpha1_generated, 39);
 
 /**
  * GitDiscoveryResult represents the result of a Git discovery operation for a
@@ -1573,7 +1751,7 @@ export type GitDiscoveryResult = Message<"github.com.akuity.kargo.api.v1alpha1.G
  * Use `create(GitDiscoveryResultSchema)` to create a new message.
  */
 export const GitDiscoveryResultSchema: GenMessage<GitDiscoveryResult> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 37);
+  messageDesc(file_api_v1alpha1_generated, 40);
 
 /**
  * GitHubWebhookReceiverConfig describes a webhook receiver that is compatible
@@ -1607,7 +1785,7 @@ export type GitHubWebhookReceiverConfig = Message<"github.com.akuity.kargo.api.v
  * Use `create(GitHubWebhookReceiverConfigSchema)` to create a new message.
  */
 export const GitHubWebhookReceiverConfigSchema: GenMessage<GitHubWebhookReceiverConfig> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 38);
+  messageDesc(file_api_v1alpha1_generated, 41);
 
 /**
  * GitLabWebhookReceiverConfig describes a webhook receiver that is compatible
@@ -1641,7 +1819,7 @@ export type GitLabWebhookReceiverConfig = Message<"github.com.akuity.kargo.api.v
  * Use `create(GitLabWebhookReceiverConfigSchema)` to create a new message.
  */
 export const GitLabWebhookReceiverConfigSchema: GenMessage<GitLabWebhookReceiverConfig> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 39);
+  messageDesc(file_api_v1alpha1_generated, 42);
 
 /**
  * GitSubscription defines a subscription to a Git repository.
@@ -1918,7 +2096,7 @@ export type GitSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.GitS
  * Use `create(GitSubscriptionSchema)` to create a new message.
  */
 export const GitSubscriptionSchema: GenMessage<GitSubscription> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 40);
+  messageDesc(file_api_v1alpha1_generated, 43);
 
 /**
  * GiteaWebhookReceiverConfig describes a webhook receiver that is compatible
@@ -1952,7 +2130,7 @@ export type GiteaWebhookReceiverConfig = Message<"github.com.akuity.kargo.api.v1
  * Use `create(GiteaWebhookReceiverConfigSchema)` to create a new message.
  */
 export const GiteaWebhookReceiverConfigSchema: GenMessage<GiteaWebhookReceiverConfig> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 41);
+  messageDesc(file_api_v1alpha1_generated, 44);
 
 /**
  * HarborWebhookReceiverConfig describes a webhook receiver that is compatible
@@ -1986,7 +2164,7 @@ export type HarborWebhookReceiverConfig = Message<"github.com.akuity.kargo.api.v
  * Use `create(HarborWebhookReceiverConfigSchema)` to create a new message.
  */
 export const HarborWebhookReceiverConfigSchema: GenMessage<HarborWebhookReceiverConfig> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 42);
+  messageDesc(file_api_v1alpha1_generated, 45);
 
 /**
  * Health describes the health of a Stage.
@@ -2030,7 +2208,7 @@ export type Health = Message<"github.com.akuity.kargo.api.v1alpha1.Health"> & {
  * Use `create(HealthSchema)` to create a new message.
  */
 export const HealthSchema: GenMessage<Health> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 43);
+  messageDesc(file_api_v1alpha1_generated, 46);
 
 /**
  * HealthCheckStep describes a health check directive which can be executed by
@@ -2061,7 +2239,7 @@ export type HealthCheckStep = Message<"github.com.akuity.kargo.api.v1alpha1.Heal
  * Use `create(HealthCheckStepSchema)` to create a new message.
  */
 export const HealthCheckStepSchema: GenMessage<HealthCheckStep> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 44);
+  messageDesc(file_api_v1alpha1_generated, 47);
 
 /**
  * HealthStats contains a summary of the collective health of some resource
@@ -2083,7 +2261,7 @@ export type HealthStats = Message<"github.com.akuity.kargo.api.v1alpha1.HealthSt
  * Use `create(HealthStatsSchema)` to create a new message.
  */
 export const HealthStatsSchema: GenMessage<HealthStats> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 45);
+  messageDesc(file_api_v1alpha1_generated, 48);
 
 /**
  * Image describes a specific version of a container image.
@@ -2127,7 +2305,7 @@ export type Image = Message<"github.com.akuity.kargo.api.v1alpha1.Image"> & {
  * Use `create(ImageSchema)` to create a new message.
  */
 export const ImageSchema: GenMessage<Image> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 46);
+  messageDesc(file_api_v1alpha1_generated, 49);
 
 /**
  * ImageDiscoveryResult represents the result of an image discovery operation
@@ -2173,7 +2351,7 @@ export type ImageDiscoveryResult = Message<"github.com.akuity.kargo.api.v1alpha1
  * Use `create(ImageDiscoveryResultSchema)` to create a new message.
  */
 export const ImageDiscoveryResultSchema: GenMessage<ImageDiscoveryResult> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 47);
+  messageDesc(file_api_v1alpha1_generated, 50);
 
 /**
  * ImageSubscription defines a subscription to an image repository.
@@ -2354,7 +2532,7 @@ export type ImageSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.Im
  * Use `create(ImageSubscriptionSchema)` to create a new message.
  */
 export const ImageSubscriptionSchema: GenMessage<ImageSubscription> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 48);
+  messageDesc(file_api_v1alpha1_generated, 51);
 
 /**
  * Project is a resource type that reconciles to a specially labeled namespace
@@ -2381,7 +2559,7 @@ export type Project = Message<"github.com.akuity.kargo.api.v1alpha1.Project"> &
  * Use `create(ProjectSchema)` to create a new message.
  */
 export const ProjectSchema: GenMessage<Project> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 49);
+  messageDesc(file_api_v1alpha1_generated, 52);
 
 /**
  * ProjectConfig is a resource type that describes the configuration of a
@@ -2415,7 +2593,7 @@ export type ProjectConfig = Message<"github.com.akuity.kargo.api.v1alpha1.Projec
  * Use `create(ProjectConfigSchema)` to create a new message.
  */
 export const ProjectConfigSchema: GenMessage<ProjectConfig> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 50);
+  messageDesc(file_api_v1alpha1_generated, 53);
 
 /**
  * ProjectConfigList is a list of ProjectConfig resources.
@@ -2439,7 +2617,7 @@ export type ProjectConfigList = Message<"github.com.akuity.kargo.api.v1alpha1.Pr
  * Use `create(ProjectConfigListSchema)` to create a new message.
  */
 export const ProjectConfigListSchema: GenMessage<ProjectConfigList> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 51);
+  messageDesc(file_api_v1alpha1_generated, 54);
 
 /**
  * ProjectConfigSpec describes the configuration of a Project.
@@ -2462,6 +2640,29 @@ export type ProjectConfigSpec = Message<"github.com.akuity.kargo.api.v1alpha1.Pr
    * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.WebhookReceiverConfig webhookReceivers = 2;
    */
   webhookReceivers: WebhookReceiverConfig[];
+
+  /**
+   * PromotionWaves defines policies governing the automatic promotion of
+   * Freight to groups of Stages in successive waves.
+   *
+   * +listType=map
+   * +listMapKey=name
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.PromotionWavePolicy promotionWaves = 3;
+   */
+  promotionWaves: PromotionWavePolicy[];
+
+  /**
+   * ConcurrencyGroups defines limits on the number of Promotions that may be
+   * running at the same time across the Stages of the Project. Promotions
+   * join a group through their concurrency configuration.
+   *
+   * +listType=map
+   * +listMapKey=name
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.ConcurrencyGroup concurrencyGroups = 4;
+   */
+  concurrencyGroups: ConcurrencyGroup[];
 };
 
 /**
@@ -2469,7 +2670,7 @@ export type ProjectConfigSpec = Message<"github.com.akuity.kargo.api.v1alpha1.Pr
  * Use `create(ProjectConfigSpecSchema)` to create a new message.
  */
 export const ProjectConfigSpecSchema: GenMessage<ProjectConfigSpec> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 52);
+  messageDesc(file_api_v1alpha1_generated, 55);
 
 /**
  * ProjectConfigStatus describes the current status of a ProjectConfig.
@@ -2522,7 +2723,7 @@ export type ProjectConfigStatus = Message<"github.com.akuity.kargo.api.v1alpha1.
  * Use `create(ProjectConfigStatusSchema)` to create a new message.
  */
 export const ProjectConfigStatusSchema: GenMessage<ProjectConfigStatus> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 53);
+  messageDesc(file_api_v1alpha1_generated, 56);
 
 /**
  * ProjectList is a list of Project resources.
@@ -2546,7 +2747,7 @@ export type ProjectList = Message<"github.com.akuity.kargo.api.v1alpha1.ProjectL
  * Use `create(ProjectListSchema)` to create a new message.
  */
 export const ProjectListSchema: GenMessage<ProjectList> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 54);
+  messageDesc(file_api_v1alpha1_generated, 57);
 
 /**
  * ProjectStats contains a summary of the collective state of a Project's
@@ -2576,7 +2777,7 @@ export type ProjectStats = Message<"github.com.akuity.kargo.api.v1alpha1.Project
  * Use `create(ProjectStatsSchema)` to create a new message.
  */
 export const ProjectStatsSchema: GenMessage<ProjectStats> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 55);
+  messageDesc(file_api_v1alpha1_generated, 58);
 
 /**
  * ProjectStatus describes a Project's current status.
@@ -2610,7 +2811,7 @@ export type ProjectStatus = Message<"github.com.akuity.kargo.api.v1alpha1.Projec
  * Use `create(ProjectStatusSchema)` to create a new message.
  */
 export const ProjectStatusSchema: GenMessage<ProjectStatus> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 56);
+  messageDesc(file_api_v1alpha1_generated, 59);
 
 /**
  * Promotion represents a request to transition a particular Stage into a
@@ -2648,7 +2849,62 @@ export type Promotion = Message<"github.com.akuity.kargo.api.v1alpha1.Promotion"
  * Use `create(PromotionSchema)` to create a new message.
  */
 export const PromotionSchema: GenMessage<Promotion> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 57);
+  messageDesc(file_api_v1alpha1_generated, 60);
+
+/**
+ * PromotionConcurrency describes how a Promotion is queued with other
+ * Promotions.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.PromotionConcurrency
+ */
+export type PromotionConcurrency = Message<"github.com.akuity.kargo.api.v1alpha1.PromotionConcurrency"> & {
+  /**
+   * Group is the name of the concurrency group the Promotion joins. The
+   * number of Promotions of the same group that may be running at the same
+   * time is limited by the limit of the group defined in the ProjectConfig.
+   * Groups that are not defined in the ProjectConfig have a limit of 1.
+   *
+   * +kubebuilder:validation:MaxLength=253
+   * +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+   * +akuity:test-kubebuilder-pattern=KubernetesName
+   * +optional
+   *
+   * @generated from field: optional string group = 1;
+   */
+  group: string;
+
+  /**
+   * Priority is the priority of the Promotion within its concurrency group.
+   * Queued Promotions with a higher priority are started before those with a
+   * lower priority. Queued Promotions with the same priority are started in
+   * the order in which they were created. Promotions to the same Stage are
+   * always started in the order in which they were created, regardless of
+   * their priority. Defaults to 0.
+   *
+   * +optional
+   *
+   * @generated from field: optional int32 priority = 2;
+   */
+  priority: number;
+
+  /**
+   * CancelSuperseded indicates whether Promotions to the same Stage that
+   * were created before this Promotion, and have not started running yet,
+   * should be aborted when this Promotion is created.
+   *
+   * +optional
+   *
+   * @generated from field: optional bool cancelSuperseded = 3;
+   */
+  cancelSuperseded: boolean;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.PromotionConcurrency.
+ * Use `create(PromotionConcurrencySchema)` to create a new message.
+ */
+export const PromotionConcurrencySchema: GenMessage<PromotionConcurrency> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 61);
 
 /**
  * PromotionList contains a list of Promotion
@@ -2672,7 +2928,7 @@ export type PromotionList = Message<"github.com.akuity.kargo.api.v1alpha1.Promot
  * Use `create(PromotionListSchema)` to create a new message.
  */
 export const PromotionListSchema: GenMessage<PromotionList> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 58);
+  messageDesc(file_api_v1alpha1_generated, 62);
 
 /**
  * PromotionPolicy defines policies governing the promotion of Freight to a
@@ -2721,7 +2977,7 @@ export type PromotionPolicy = Message<"github.com.akuity.kargo.api.v1alpha1.Prom
  * Use `create(PromotionPolicySchema)` to create a new message.
  */
 export const PromotionPolicySchema: GenMessage<PromotionPolicy> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 59);
+  messageDesc(file_api_v1alpha1_generated, 63);
 
 /**
  * PromotionPolicySelector is a selector that matches the resource to which
@@ -2777,7 +3033,7 @@ export type PromotionPolicySelector = Message<"github.com.akuity.kargo.api.v1alp
  * Use `create(PromotionPolicySelectorSchema)` to create a new message.
  */
 export const PromotionPolicySelectorSchema: GenMessage<PromotionPolicySelector> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 60);
+  messageDesc(file_api_v1alpha1_generated, 64);
 
 /**
  * PromotionReference contains the relevant information about a Promotion
@@ -2820,7 +3076,47 @@ export type PromotionReference = Message<"github.com.akuity.kargo.api.v1alpha1.P
  * Use `create(PromotionReferenceSchema)` to create a new message.
  */
 export const PromotionReferenceSchema: GenMessage<PromotionReference> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 61);
+  messageDesc(file_api_v1alpha1_generated, 65);
+
+/**
+ * PromotionResume references a Promotion which is resumed by another
+ * Promotion, and the step from which it is resumed.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.PromotionResume
+ */
+export type PromotionResume = Message<"github.com.akuity.kargo.api.v1alpha1.PromotionResume"> & {
+  /**
+   * Promotion is the name of the Promotion which is resumed.
+   *
+   * +kubebuilder:validation:Required
+   * +kubebuilder:validation:MinLength=1
+   * +kubebuilder:validation:MaxLength=253
+   * +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+   * +akuity:test-kubebuilder-pattern=KubernetesName
+   *
+   * @generated from field: optional string promotion = 1;
+   */
+  promotion: string;
+
+  /**
+   * Step is the index of the step from which the Promotion is resumed. It
+   * must not be greater than the index of the step at which the resumed
+   * Promotion stopped.
+   *
+   * +kubebuilder:validation:Minimum=0
+   * +optional
+   *
+   * @generated from field: optional int64 step = 2;
+   */
+  step: bigint;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.PromotionResume.
+ * Use `create(PromotionResumeSchema)` to create a new message.
+ */
+export const PromotionResumeSchema: GenMessage<PromotionResume> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 66);
 
 /**
  * PromotionSpec describes the desired transition of a specific Stage into a
@@ -2878,6 +3174,28 @@ export type PromotionSpec = Message<"github.com.akuity.kargo.api.v1alpha1.Promot
    * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.PromotionStep steps = 3;
    */
   steps: PromotionStep[];
+
+  /**
+   * Concurrency optionally describes how this Promotion is queued with
+   * Promotions to other Stages.
+   *
+   * +optional
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.PromotionConcurrency concurrency = 5;
+   */
+  concurrency?: PromotionConcurrency;
+
+  /**
+   * ResumeFrom optionally references a Failed or Errored Promotion of the
+   * same Freight to the same Stage which this Promotion resumes. Steps
+   * preceding the step this Promotion resumes from are not executed again;
+   * instead, their outputs are restored from the referenced Promotion.
+   *
+   * +optional
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.PromotionResume resumeFrom = 6;
+   */
+  resumeFrom?: PromotionResume;
 };
 
 /**
@@ -2885,7 +3203,7 @@ export type PromotionSpec = Message<"github.com.akuity.kargo.api.v1alpha1.Promot
  * Use `create(PromotionSpecSchema)` to create a new message.
  */
 export const PromotionSpecSchema: GenMessage<PromotionSpec> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 62);
+  messageDesc(file_api_v1alpha1_generated, 67);
 
 /**
  * PromotionStatus describes the current state of the transition represented by
@@ -2952,6 +3270,16 @@ export type PromotionStatus = Message<"github.com.akuity.kargo.api.v1alpha1.Prom
    */
   startedAt?: Time;
 
+  /**
+   * QueuePosition is the position of the Promotion in the queue of its
+   * concurrency group while it is waiting for other Promotions of the group
+   * to finish. The first position in the queue is 1. It is unset while the
+   * Promotion is not queued.
+   *
+   * @generated from field: optional int32 queuePosition = 13;
+   */
+  queuePosition: number;
+
   /**
    * FinishedAt is the time when the promotion was completed.
    *
@@ -2983,6 +3311,16 @@ export type PromotionStatus = Message<"github.com.akuity.kargo.api.v1alpha1.Prom
    * @generated from field: optional k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1.JSON state = 10;
    */
   state?: JSON;
+
+  /**
+   * Workspace describes the working directory in which the steps of the
+   * Promotion are executed, as last observed by the controller.
+   *
+   * +optional
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.PromotionWorkspace workspace = 14;
+   */
+  workspace?: PromotionWorkspace;
 };
 
 /**
@@ -2990,7 +3328,7 @@ export type PromotionStatus = Message<"github.com.akuity.kargo.api.v1alpha1.Prom
  * Use `create(PromotionStatusSchema)` to create a new message.
  */
 export const PromotionStatusSchema: GenMessage<PromotionStatus> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 63);
+  messageDesc(file_api_v1alpha1_generated, 68);
 
 /**
  * PromotionStep describes a directive to be executed as part of a Promotion.
@@ -3075,7 +3413,7 @@ export type PromotionStep = Message<"github.com.akuity.kargo.api.v1alpha1.Promot
  * Use `create(PromotionStepSchema)` to create a new message.
  */
 export const PromotionStepSchema: GenMessage<PromotionStep> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 64);
+  messageDesc(file_api_v1alpha1_generated, 69);
 
 /**
  * PromotionStepRetry describes the retry policy for a PromotionStep.
@@ -3134,7 +3472,7 @@ export type PromotionStepRetry = Message<"github.com.akuity.kargo.api.v1alpha1.P
  * Use `create(PromotionStepRetrySchema)` to create a new message.
  */
 export const PromotionStepRetrySchema: GenMessage<PromotionStepRetry> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 65);
+  messageDesc(file_api_v1alpha1_generated, 70);
 
 /**
  * @generated from message github.com.akuity.kargo.api.v1alpha1.PromotionTask
@@ -3161,7 +3499,7 @@ export type PromotionTask = Message<"github.com.akuity.kargo.api.v1alpha1.Promot
  * Use `create(PromotionTaskSchema)` to create a new message.
  */
 export const PromotionTaskSchema: GenMessage<PromotionTask> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 66);
+  messageDesc(file_api_v1alpha1_generated, 71);
 
 /**
  * PromotionTaskList contains a list of PromotionTasks.
@@ -3185,7 +3523,7 @@ export type PromotionTaskList = Message<"github.com.akuity.kargo.api.v1alpha1.Pr
  * Use `create(PromotionTaskListSchema)` to create a new message.
  */
 export const PromotionTaskListSchema: GenMessage<PromotionTaskList> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 67);
+  messageDesc(file_api_v1alpha1_generated, 72);
 
 /**
  * PromotionTaskReference describes a reference to a PromotionTask.
@@ -3223,7 +3561,7 @@ export type PromotionTaskReference = Message<"github.com.akuity.kargo.api.v1alph
  * Use `create(PromotionTaskReferenceSchema)` to create a new message.
  */
 export const PromotionTaskReferenceSchema: GenMessage<PromotionTaskReference> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 68);
+  messageDesc(file_api_v1alpha1_generated, 73);
 
 /**
  * @generated from message github.com.akuity.kargo.api.v1alpha1.PromotionTaskSpec
@@ -3257,7 +3595,7 @@ export type PromotionTaskSpec = Message<"github.com.akuity.kargo.api.v1alpha1.Pr
  * Use `create(PromotionTaskSpecSchema)` to create a new message.
  */
 export const PromotionTaskSpecSchema: GenMessage<PromotionTaskSpec> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 69);
+  messageDesc(file_api_v1alpha1_generated, 74);
 
 /**
  * PromotionTemplate defines a template for a Promotion that can be used to
@@ -3277,7 +3615,7 @@ export type PromotionTemplate = Message<"github.com.akuity.kargo.api.v1alpha1.Pr
  * Use `create(PromotionTemplateSchema)` to create a new message.
  */
 export const PromotionTemplateSchema: GenMessage<PromotionTemplate> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 70);
+  messageDesc(file_api_v1alpha1_generated, 75);
 
 /**
  * PromotionTemplateSpec describes the (partial) specification of a Promotion
@@ -3308,6 +3646,16 @@ export type PromotionTemplateSpec = Message<"github.com.akuity.kargo.api.v1alpha
    * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.PromotionStep steps = 1;
    */
   steps: PromotionStep[];
+
+  /**
+   * Concurrency optionally describes how Promotions created from this
+   * template are queued with Promotions to other Stages.
+   *
+   * +optional
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.PromotionConcurrency concurrency = 3;
+   */
+  concurrency?: PromotionConcurrency;
 };
 
 /**
@@ -3315,7 +3663,272 @@ export type PromotionTemplateSpec = Message<"github.com.akuity.kargo.api.v1alpha
  * Use `create(PromotionTemplateSpecSchema)` to create a new message.
  */
 export const PromotionTemplateSpecSchema: GenMessage<PromotionTemplateSpec> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 71);
+  messageDesc(file_api_v1alpha1_generated, 76);
+
+/**
+ * PromotionWave describes a single wave of a PromotionWavePolicy.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.PromotionWave
+ */
+export type PromotionWave = Message<"github.com.akuity.kargo.api.v1alpha1.PromotionWave"> & {
+  /**
+   * Name is the name of the wave.
+   *
+   * +kubebuilder:validation:Required
+   * +kubebuilder:validation:MinLength=1
+   *
+   * @generated from field: optional string name = 1;
+   */
+  name: string;
+
+  /**
+   * StageSelector optionally narrows down the Stages, among those selected by
+   * the policy and not assigned to any preceding wave, that qualify for this
+   * wave. If not specified, all such Stages qualify.
+   *
+   * +optional
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.PromotionPolicySelector stageSelector = 2;
+   */
+  stageSelector?: PromotionPolicySelector;
+
+  /**
+   * Percent optionally limits the number of Stages assigned to this wave to
+   * the given percentage (rounded up) of all Stages selected by the policy.
+   * Qualifying Stages are assigned in order of their names. If not specified,
+   * all qualifying Stages are assigned to this wave.
+   *
+   * +kubebuilder:validation:Minimum=1
+   * +kubebuilder:validation:Maximum=100
+   * +optional
+   *
+   * @generated from field: optional int32 percent = 3;
+   */
+  percent: number;
+
+  /**
+   * SoakTime is the minimum amount of time Freight must have been in every
+   * Stage of this wave, after being verified there, before it can be
+   * automatically promoted to the Stages of the next wave.
+   *
+   * +kubebuilder:validation:Type=string
+   * +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(s|m|h))+$`
+   * +akuity:test-kubebuilder-pattern=Duration
+   * +optional
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Duration soakTime = 4;
+   */
+  soakTime?: Duration;
+
+  /**
+   * FailureThreshold is the number of Stages in this wave that must fail
+   * verification of a piece of Freight for its rollout to be halted. A halted
+   * rollout does not proceed to any further Stages in this or subsequent
+   * waves. Stages that failed verification do not hold back the next wave for
+   * as long as the threshold is not reached. Defaults to 1.
+   *
+   * +kubebuilder:validation:Minimum=1
+   * +kubebuilder:default=1
+   * +optional
+   *
+   * @generated from field: optional int32 failureThreshold = 5;
+   */
+  failureThreshold: number;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.PromotionWave.
+ * Use `create(PromotionWaveSchema)` to create a new message.
+ */
+export const PromotionWaveSchema: GenMessage<PromotionWave> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 77);
+
+/**
+ * PromotionWavePolicy defines a group of Stages to which Freight is
+ * automatically promoted in successive waves. Freight is only auto-promoted to
+ * a Stage in a wave once it has been verified, and has soaked for the
+ * configured amount of time, in every Stage of all preceding waves.
+ *
+ * Waves only govern auto-promotion. Auto-promotion must still be enabled for
+ * each Stage using a PromotionPolicy, and manual promotions are never
+ * restricted by waves.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.PromotionWavePolicy
+ */
+export type PromotionWavePolicy = Message<"github.com.akuity.kargo.api.v1alpha1.PromotionWavePolicy"> & {
+  /**
+   * Name is the name of the policy.
+   *
+   * +kubebuilder:validation:Required
+   * +kubebuilder:validation:MinLength=1
+   * +kubebuilder:validation:MaxLength=253
+   * +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+   * +akuity:test-kubebuilder-pattern=KubernetesName
+   *
+   * @generated from field: optional string name = 1;
+   */
+  name: string;
+
+  /**
+   * StageSelector selects all Stages to which Freight is promoted in waves
+   * according to this policy. A Stage should not be selected by more than one
+   * policy. If it is, only the first policy selecting it applies.
+   *
+   * +kubebuilder:validation:Required
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.PromotionPolicySelector stageSelector = 2;
+   */
+  stageSelector?: PromotionPolicySelector;
+
+  /**
+   * Waves is the ordered list of waves. Stages selected by the policy are
+   * assigned to the first wave they qualify for. Stages that do not qualify
+   * for any wave are assigned to the last wave.
+   *
+   * +kubebuilder:validation:MinItems=1
+   * +listType=map
+   * +listMapKey=name
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.PromotionWave waves = 3;
+   */
+  waves: PromotionWave[];
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.PromotionWavePolicy.
+ * Use `create(PromotionWavePolicySchema)` to create a new message.
+ */
+export const PromotionWavePolicySchema: GenMessage<PromotionWavePolicy> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 78);
+
+/**
+ * PromotionWaveProgress describes the progress of a piece of Freight through
+ * the waves of a PromotionWavePolicy.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.PromotionWaveProgress
+ */
+export type PromotionWaveProgress = Message<"github.com.akuity.kargo.api.v1alpha1.PromotionWaveProgress"> & {
+  /**
+   * Policy is the name of the PromotionWavePolicy.
+   *
+   * @generated from field: optional string policy = 1;
+   */
+  policy: string;
+
+  /**
+   * Freight is the name of the Freight whose progress is described. It is
+   * empty if no Freight has reached any Stage selected by the policy yet.
+   *
+   * @generated from field: optional string freight = 2;
+   */
+  freight: string;
+
+  /**
+   * Halted indicates whether the rollout of the Freight was halted because
+   * it failed verification in too many Stages of a wave.
+   *
+   * @generated from field: optional bool halted = 3;
+   */
+  halted: boolean;
+
+  /**
+   * Waves describes the progress of the Freight through each wave.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.PromotionWaveStatus waves = 4;
+   */
+  waves: PromotionWaveStatus[];
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.PromotionWaveProgress.
+ * Use `create(PromotionWaveProgressSchema)` to create a new message.
+ */
+export const PromotionWaveProgressSchema: GenMessage<PromotionWaveProgress> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 79);
+
+/**
+ * PromotionWaveStatus describes the progress of a piece of Freight through a
+ * single wave of a PromotionWavePolicy.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.PromotionWaveStatus
+ */
+export type PromotionWaveStatus = Message<"github.com.akuity.kargo.api.v1alpha1.PromotionWaveStatus"> & {
+  /**
+   * Name is the name of the wave.
+   *
+   * @generated from field: optional string name = 1;
+   */
+  name: string;
+
+  /**
+   * Phase is the phase of the wave.
+   *
+   * @generated from field: optional string phase = 2;
+   */
+  phase: string;
+
+  /**
+   * Stages are the names of the Stages assigned to the wave.
+   *
+   * @generated from field: repeated string stages = 3;
+   */
+  stages: string[];
+
+  /**
+   * VerifiedStages are the names of the Stages of the wave in which the
+   * Freight has been verified.
+   *
+   * @generated from field: repeated string verifiedStages = 4;
+   */
+  verifiedStages: string[];
+
+  /**
+   * FailedStages are the names of the Stages of the wave in which the
+   * Freight failed verification.
+   *
+   * @generated from field: repeated string failedStages = 5;
+   */
+  failedStages: string[];
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.PromotionWaveStatus.
+ * Use `create(PromotionWaveStatusSchema)` to create a new message.
+ */
+export const PromotionWaveStatusSchema: GenMessage<PromotionWaveStatus> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 80);
+
+/**
+ * PromotionWorkspace describes the working directory in which the steps of a
+ * Promotion are executed.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.PromotionWorkspace
+ */
+export type PromotionWorkspace = Message<"github.com.akuity.kargo.api.v1alpha1.PromotionWorkspace"> & {
+  /**
+   * DiskUsage is the amount of disk space used by the working directory.
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.api.resource.Quantity diskUsage = 1;
+   */
+  diskUsage?: Quantity;
+
+  /**
+   * Retained indicates whether the working directory was retained after the
+   * Promotion reached a terminal phase, so that a Promotion which resumes it
+   * can skip the steps that already ran. Only Promotions with a retained
+   * working directory can be resumed from a step other than the first.
+   *
+   * @generated from field: optional bool retained = 2;
+   */
+  retained: boolean;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.PromotionWorkspace.
+ * Use `create(PromotionWorkspaceSchema)` to create a new message.
+ */
+export const PromotionWorkspaceSchema: GenMessage<PromotionWorkspace> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 81);
 
 /**
  * QuayWebhookReceiverConfig describes a webhook receiver that is compatible
@@ -3351,7 +3964,7 @@ export type QuayWebhookReceiverConfig = Message<"github.com.akuity.kargo.api.v1a
  * Use `create(QuayWebhookReceiverConfigSchema)` to create a new message.
  */
 export const QuayWebhookReceiverConfigSchema: GenMessage<QuayWebhookReceiverConfig> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 72);
+  messageDesc(file_api_v1alpha1_generated, 82);
 
 /**
  * RepoSubscription describes a subscription to ONE OF a Git repository, a
@@ -3387,7 +4000,7 @@ export type RepoSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.Rep
  * Use `create(RepoSubscriptionSchema)` to create a new message.
  */
 export const RepoSubscriptionSchema: GenMessage<RepoSubscription> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 73);
+  messageDesc(file_api_v1alpha1_generated, 83);
 
 /**
  * Stage is the Kargo API's main type.
@@ -3423,7 +4036,7 @@ export type Stage = Message<"github.com.akuity.kargo.api.v1alpha1.Stage"> & {
  * Use `create(StageSchema)` to create a new message.
  */
 export const StageSchema: GenMessage<Stage> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 74);
+  messageDesc(file_api_v1alpha1_generated, 84);
 
 /**
  * StageList is a list of Stage resources.
@@ -3447,7 +4060,7 @@ export type StageList = Message<"github.com.akuity.kargo.api.v1alpha1.StageList"
  * Use `create(StageListSchema)` to create a new message.
  */
 export const StageListSchema: GenMessage<StageList> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 75);
+  messageDesc(file_api_v1alpha1_generated, 85);
 
 /**
  * StageSpec describes the sources of Freight used by a Stage and how to
@@ -3467,6 +4080,19 @@ export type StageSpec = Message<"github.com.akuity.kargo.api.v1alpha1.StageSpec"
    */
   shard: string;
 
+  /**
+   * Agent is the name of the agent that executes the steps of Promotions to
+   * this Stage. This is an optional field. If not specified, the steps are
+   * executed by the controller itself. Agents run out-of-process, typically
+   * close to the environments the steps target, and dial the controller to
+   * receive the steps to execute. The agent must be mapped to the Stage's
+   * Project in the ClusterConfig. A step errors if the agent is not
+   * connected when the step is to be executed.
+   *
+   * @generated from field: optional string agent = 8;
+   */
+  agent: string;
+
   /**
    * Vars is a list of variables that can be referenced anywhere in the
    * StageSpec that supports expressions. For example, the PromotionTemplate
@@ -3514,7 +4140,7 @@ export type StageSpec = Message<"github.com.akuity.kargo.api.v1alpha1.StageSpec"
  * Use `create(StageSpecSchema)` to create a new message.
  */
 export const StageSpecSchema: GenMessage<StageSpec> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 76);
+  messageDesc(file_api_v1alpha1_generated, 86);
 
 /**
  * StageStats contains a summary of the collective state of a Project's
@@ -3543,7 +4169,7 @@ export type StageStats = Message<"github.com.akuity.kargo.api.v1alpha1.StageStat
  * Use `create(StageStatsSchema)` to create a new message.
  */
 export const StageStatsSchema: GenMessage<StageStats> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 77);
+  messageDesc(file_api_v1alpha1_generated, 87);
 
 /**
  * StageStatus describes a Stages's current and recent Freight, health, and
@@ -3652,7 +4278,7 @@ export type StageStatus = Message<"github.com.akuity.kargo.api.v1alpha1.StageSta
  * Use `create(StageStatusSchema)` to create a new message.
  */
 export const StageStatusSchema: GenMessage<StageStatus> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 78);
+  messageDesc(file_api_v1alpha1_generated, 88);
 
 /**
  * StepExecutionMetadata tracks metadata pertaining to the execution of
@@ -3721,11 +4347,13 @@ export type StepExecutionMetadata = Message<"github.com.akuity.kargo.api.v1alpha
  * Use `create(StepExecutionMetadataSchema)` to create a new message.
  */
 export const StepExecutionMetadataSchema: GenMessage<StepExecutionMetadata> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 79);
+  messageDesc(file_api_v1alpha1_generated, 89);
 
 /**
  * Verification describes how to verify that a Promotion has been successful
- * using Argo Rollouts AnalysisTemplates.
+ * using either Argo Rollouts AnalysisTemplates or promotion steps.
+ *
+ * +kubebuilder:validation:XValidation:message="Verification must not have both analysisTemplates and steps set",rule="!(has(self.analysisTemplates) && size(self.analysisTemplates) > 0 && has(self.steps) && size(self.steps) > 0)"
  *
  * @generated from message github.com.akuity.kargo.api.v1alpha1.Verification
  */
@@ -3753,6 +4381,20 @@ export type Verification = Message<"github.com.akuity.kargo.api.v1alpha1.Verific
    * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.AnalysisRunArgument args = 3;
    */
   args: AnalysisRunArgument[];
+
+  /**
+   * Steps is a list of promotion steps that should be executed to verify a
+   * Stage's current Freight is fit to be promoted downstream. The steps are
+   * executed by the Stage controller in the same manner as the steps of a
+   * Promotion, which means they do not require Argo Rollouts to be installed.
+   * The verification succeeds if all steps succeed. Steps may not reference
+   * PromotionTasks. This field is mutually exclusive with AnalysisTemplates.
+   *
+   * +kubebuilder:validation:items:XValidation:message="Verification step must have uses set and must not reference a task",rule="has(self.uses) && !has(self.task)"
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.PromotionStep steps = 4;
+   */
+  steps: PromotionStep[];
 };
 
 /**
@@ -3760,7 +4402,7 @@ export type Verification = Message<"github.com.akuity.kargo.api.v1alpha1.Verific
  * Use `create(VerificationSchema)` to create a new message.
  */
 export const VerificationSchema: GenMessage<Verification> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 80);
+  messageDesc(file_api_v1alpha1_generated, 90);
 
 /**
  * VerificationInfo contains the details of an instance of a Verification
@@ -3823,6 +4465,32 @@ export type VerificationInfo = Message<"github.com.akuity.kargo.api.v1alpha1.Ver
    * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Time finishTime = 6;
    */
   finishTime?: Time;
+
+  /**
+   * CurrentStep is the index of the current verification step being executed.
+   * This is only set for Verification processes that execute steps.
+   *
+   * @generated from field: optional int64 currentStep = 8;
+   */
+  currentStep: bigint;
+
+  /**
+   * StepExecutionMetadata tracks metadata pertaining to the execution of
+   * individual verification steps. This is only set for Verification
+   * processes that execute steps.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.StepExecutionMetadata stepExecutionMetadata = 9;
+   */
+  stepExecutionMetadata: StepExecutionMetadata[];
+
+  /**
+   * State stores the state of the verification steps between reconciliation
+   * attempts. This is only set for Verification processes that execute
+   * steps.
+   *
+   * @generated from field: optional k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1.JSON state = 10;
+   */
+  state?: JSON;
 };
 
 /**
@@ -3830,7 +4498,7 @@ export type VerificationInfo = Message<"github.com.akuity.kargo.api.v1alpha1.Ver
  * Use `create(VerificationInfoSchema)` to create a new message.
  */
 export const VerificationInfoSchema: GenMessage<VerificationInfo> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 81);
+  messageDesc(file_api_v1alpha1_generated, 91);
 
 /**
  * VerifiedStage describes a Stage in which Freight has been verified.
@@ -3862,7 +4530,7 @@ export type VerifiedStage = Message<"github.com.akuity.kargo.api.v1alpha1.Verifi
  * Use `create(VerifiedStageSchema)` to create a new message.
  */
 export const VerifiedStageSchema: GenMessage<VerifiedStage> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 82);
+  messageDesc(file_api_v1alpha1_generated, 92);
 
 /**
  * Warehouse is a source of Freight.
@@ -3897,7 +4565,7 @@ export type Warehouse = Message<"github.com.akuity.kargo.api.v1alpha1.Warehouse"
  * Use `create(WarehouseSchema)` to create a new message.
  */
 export const WarehouseSchema: GenMessage<Warehouse> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 83);
+  messageDesc(file_api_v1alpha1_generated, 93);
 
 /**
  * WarehouseList is a list of Warehouse resources.
@@ -3921,7 +4589,7 @@ export type WarehouseList = Message<"github.com.akuity.kargo.api.v1alpha1.Wareho
  * Use `create(WarehouseListSchema)` to create a new message.
  */
 export const WarehouseListSchema: GenMessage<WarehouseList> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 84);
+  messageDesc(file_api_v1alpha1_generated, 94);
 
 /**
  * WarehouseSpec describes sources of versioned artifacts to be included in
@@ -3997,6 +4665,19 @@ export type WarehouseSpec = Message<"github.com.akuity.kargo.api.v1alpha1.Wareho
    * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.FreightCreationCriteria freightCreationCriteria = 5;
    */
   freightCreationCriteria?: FreightCreationCriteria;
+
+  /**
+   * FreightStalenessPolicy describes when Freight produced by this Warehouse
+   * becomes stale. Stale Freight is not available for promotion, unless it
+   * has been manually approved for the Stage it is to be promoted to with
+   * stale Freight explicitly allowed. A Stage may override this policy for
+   * the Freight it requests from this Warehouse.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.FreightStalenessPolicy freightStalenessPolicy = 6;
+   */
+  freightStalenessPolicy?: FreightStalenessPolicy;
 };
 
 /**
@@ -4004,7 +4685,7 @@ export type WarehouseSpec = Message<"github.com.akuity.kargo.api.v1alpha1.Wareho
  * Use `create(WarehouseSpecSchema)` to create a new message.
  */
 export const WarehouseSpecSchema: GenMessage<WarehouseSpec> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 85);
+  messageDesc(file_api_v1alpha1_generated, 95);
 
 /**
  * WarehouseStats contains a summary of the collective state of a Project's
@@ -4034,7 +4715,7 @@ export type WarehouseStats = Message<"github.com.akuity.kargo.api.v1alpha1.Wareh
  * Use `create(WarehouseStatsSchema)` to create a new message.
  */
 export const WarehouseStatsSchema: GenMessage<WarehouseStats> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 86);
+  messageDesc(file_api_v1alpha1_generated, 96);
 
 /**
  * WarehouseStatus describes a Warehouse's most recently observed state.
@@ -4093,7 +4774,7 @@ export type WarehouseStatus = Message<"github.com.akuity.kargo.api.v1alpha1.Ware
  * Use `create(WarehouseStatusSchema)` to create a new message.
  */
 export const WarehouseStatusSchema: GenMessage<WarehouseStatus> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 87);
+  messageDesc(file_api_v1alpha1_generated, 97);
 
 /**
  * WebhookReceiverConfig describes the configuration for a single webhook
@@ -4193,7 +4874,7 @@ export type WebhookReceiverConfig = Message<"github.com.akuity.kargo.api.v1alpha
  * Use `create(WebhookReceiverConfigSchema)` to create a new message.
  */
 export const WebhookReceiverConfigSchema: GenMessage<WebhookReceiverConfig> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 88);
+  messageDesc(file_api_v1alpha1_generated, 98);
 
 /**
  * WebhookReceiverDetails encapsulates the details of a webhook receiver.
@@ -4228,5 +4909,5 @@ export type WebhookReceiverDetails = Message<"github.com.akuity.kargo.api.v1alph
  * Use `create(WebhookReceiverDetailsSchema)` to create a new message.
  */
 export const WebhookReceiverDetailsSchema: GenMessage<WebhookReceiverDetails> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 89);
+  messageDesc(file_api_v1alpha1_generated, 99);
 